@@ -3,6 +3,7 @@ package osin
 import (
 	"net/http"
 	"net/url"
+	"regexp"
 	"time"
 )
 
@@ -12,8 +13,14 @@ type AuthorizeRequestType string
 const (
 	CODE  AuthorizeRequestType = "code"
 	TOKEN AuthorizeRequestType = "token"
+
+	PKCE_PLAIN = "plain"
+	PKCE_S256  = "S256"
 )
 
+// pkceMatcher matches valid code challenges and code verifiers, as described in RFC 7636 4.1 and 4.2
+var pkceMatcher = regexp.MustCompile("^[a-zA-Z0-9~._-]{43,128}$")
+
 // Authorize request information
 type AuthorizeRequest struct {
 	Type        AuthorizeRequestType
@@ -22,6 +29,10 @@ type AuthorizeRequest struct {
 	RedirectUri string
 	State       string
 
+	// Optional code_challenge and code_challenge_method as described in RFC 7636
+	CodeChallenge       string
+	CodeChallengeMethod string
+
 	// Set if request is authorized
 	Authorized bool
 
@@ -56,6 +67,10 @@ type AuthorizeData struct {
 	// State data from request
 	State string
 
+	// Optional code_challenge and code_challenge_method from request, as described in RFC 7636
+	CodeChallenge       string
+	CodeChallengeMethod string
+
 	// Date created
 	CreatedAt time.Time
 
@@ -126,7 +141,7 @@ func (s *Server) HandleAuthorizeRequest(w *Response, r *http.Request) *Authorize
 		ret.RedirectUri = FirstUri(ret.Client.GetRedirectUri(), s.Config.RedirectUriSeparator)
 	}
 
-	if err = ValidateUriList(ret.Client.GetRedirectUri(), ret.RedirectUri, s.Config.RedirectUriSeparator); err != nil {
+	if err = ValidateClientRedirectUri(ret.Client, ret.RedirectUri, s.Config.RedirectUriSeparator); err != nil {
 		w.SetErrorState(E_INVALID_REQUEST, "", ret.State)
 		w.InternalError = err
 		return nil
@@ -140,6 +155,30 @@ func (s *Server) HandleAuthorizeRequest(w *Response, r *http.Request) *Authorize
 		case CODE:
 			ret.Type = CODE
 			ret.Expiration = s.Config.AuthorizationExpiration
+
+			// Optional PKCE support (RFC 7636), required for native clients
+			if codeChallenge := r.Form.Get("code_challenge"); len(codeChallenge) == 0 {
+				if IsNativeClient(ret.Client) {
+					w.SetErrorState(E_INVALID_REQUEST, "code_challenge (rfc7636) required for native clients", ret.State)
+					return nil
+				}
+			} else {
+				codeChallengeMethod := r.Form.Get("code_challenge_method")
+				// allowed values are "plain" (default) and "S256", per RFC 7636 4.3
+				if len(codeChallengeMethod) == 0 {
+					codeChallengeMethod = PKCE_PLAIN
+				}
+				if codeChallengeMethod != PKCE_PLAIN && codeChallengeMethod != PKCE_S256 {
+					w.SetErrorState(E_INVALID_REQUEST, "code_challenge_method transform algorithm not supported (rfc7636)", ret.State)
+					return nil
+				}
+				if !pkceMatcher.MatchString(codeChallenge) {
+					w.SetErrorState(E_INVALID_REQUEST, "code_challenge invalid (rfc7636)", ret.State)
+					return nil
+				}
+				ret.CodeChallenge = codeChallenge
+				ret.CodeChallengeMethod = codeChallengeMethod
+			}
 		case TOKEN:
 			ret.Type = TOKEN
 			ret.Expiration = s.Config.AccessExpiration
@@ -191,6 +230,9 @@ func (s *Server) FinishAuthorizeRequest(w *Response, r *http.Request, ar *Author
 				State:       ar.State,
 				Scope:       ar.Scope,
 				UserData:    ar.UserData,
+
+				CodeChallenge:       ar.CodeChallenge,
+				CodeChallengeMethod: ar.CodeChallengeMethod,
 			}
 
 			// generate token code
@@ -86,3 +86,43 @@ func TestAuthorizeToken(t *testing.T) {
 		t.Fatalf("Unexpected access token: %s", d)
 	}
 }
+
+func TestAuthorizeCodePKCERequiredForNativeClients(t *testing.T) {
+	sconfig := NewServerConfig()
+	sconfig.AllowedAuthorizeTypes = AllowedAuthorizeType{CODE}
+	storage := NewTestingStorage()
+	storage.clients["native"] = &testNativeClient{DefaultClient: DefaultClient{Id: "native", RedirectUri: "http://127.0.0.1/callback"}, native: true}
+	server := NewServer(sconfig, storage)
+	server.AuthorizeTokenGen = &TestingAuthorizeTokenGen{}
+
+	for _, challenge := range []string{"", "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"} {
+		resp := server.NewResponse()
+		req, err := http.NewRequest("GET", "http://localhost:14000/appauth", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Form = make(url.Values)
+		req.Form.Set("response_type", string(CODE))
+		req.Form.Set("client_id", "native")
+		req.Form.Set("redirect_uri", "http://127.0.0.1:53412/callback")
+		req.Form.Set("state", "a")
+		if len(challenge) > 0 {
+			req.Form.Set("code_challenge", challenge)
+			req.Form.Set("code_challenge_method", PKCE_S256)
+		}
+
+		if ar := server.HandleAuthorizeRequest(resp, req); ar != nil {
+			ar.Authorized = true
+			server.FinishAuthorizeRequest(resp, req, ar)
+		}
+
+		switch {
+		case len(challenge) == 0 && (!resp.IsError || resp.ErrorId != E_INVALID_REQUEST):
+			t.Errorf("Expected an invalid_request error without a code challenge, got %#v", resp)
+		case len(challenge) > 0 && resp.IsError:
+			t.Errorf("Unexpected error with a code challenge: %s %v", resp.ErrorId, resp.InternalError)
+		case len(challenge) > 0 && storage.authorize["1"].CodeChallenge != challenge:
+			t.Errorf("Expected the code challenge to be saved, got %#v", storage.authorize["1"])
+		}
+	}
+}
@@ -15,6 +15,22 @@ type Client interface {
 	GetUserData() interface{}
 }
 
+// NativeClient is implemented by clients that can be native applications, which receive redirects on ephemeral
+// ports of loopback addresses as described in RFC 8252 7.3. Native applications cannot keep a secret, so they
+// must use PKCE (RFC 7636) for authorization code requests.
+type NativeClient interface {
+	Client
+
+	// IsNative returns true if the client is a native application
+	IsNative() bool
+}
+
+// IsNativeClient returns true if client is a native application
+func IsNativeClient(client Client) bool {
+	native, ok := client.(NativeClient)
+	return ok && native.IsNative()
+}
+
 // DefaultClient stores all data in struct variables
 type DefaultClient struct {
 	Id          string
@@ -3,6 +3,7 @@ package osin
 import (
 	"errors"
 	"fmt"
+	"net"
 	"net/url"
 	"strings"
 )
@@ -22,6 +23,16 @@ func newUriValidationError(msg string, base string, redirect string) UriValidati
 // baseUriList may be a string separated by separator.
 // If separator is blank, validate only 1 URI.
 func ValidateUriList(baseUriList string, redirectUri string, separator string) error {
+	return validateUriList(baseUriList, redirectUri, separator, false)
+}
+
+// ValidateClientRedirectUri validates that redirectUri is contained in the redirect uris of client.
+// The ports of the loopback redirect uris of native clients are not matched.
+func ValidateClientRedirectUri(client Client, redirectUri string, separator string) error {
+	return validateUriList(client.GetRedirectUri(), redirectUri, separator, IsNativeClient(client))
+}
+
+func validateUriList(baseUriList string, redirectUri string, separator string, anyLoopbackPort bool) error {
 	// make a list of uris
 	var slist []string
 	if separator != "" {
@@ -32,7 +43,7 @@ func ValidateUriList(baseUriList string, redirectUri string, separator string) e
 	}
 
 	for _, sitem := range slist {
-		err := ValidateUri(sitem, redirectUri)
+		err := validateUri(sitem, redirectUri, anyLoopbackPort)
 		// validated, return no error
 		if err == nil {
 			return nil
@@ -49,6 +60,10 @@ func ValidateUriList(baseUriList string, redirectUri string, separator string) e
 
 // ValidateUri validates that redirectUri is contained in baseUri
 func ValidateUri(baseUri string, redirectUri string) error {
+	return validateUri(baseUri, redirectUri, false)
+}
+
+func validateUri(baseUri string, redirectUri string, anyLoopbackPort bool) error {
 	if baseUri == "" || redirectUri == "" {
 		return errors.New("urls cannot be blank.")
 	}
@@ -74,7 +89,7 @@ func ValidateUri(baseUri string, redirectUri string) error {
 	if base.Scheme != redirect.Scheme {
 		return newUriValidationError("scheme mismatch", baseUri, redirectUri)
 	}
-	if base.Host != redirect.Host {
+	if base.Host != redirect.Host && !(anyLoopbackPort && isLoopbackPortMatch(base, redirect)) {
 		return newUriValidationError("host mismatch", baseUri, redirectUri)
 	}
 
@@ -99,6 +114,20 @@ func ValidateUri(baseUri string, redirectUri string) error {
 	return nil
 }
 
+// isLoopbackPortMatch returns true if base is an http URI on a loopback IP address without a port, and redirect
+// uses the same address with any port. Native applications listen on ephemeral ports, as described in RFC 8252 7.3
+func isLoopbackPortMatch(base *url.URL, redirect *url.URL) bool {
+	if base.Scheme != "http" {
+		return false
+	}
+	baseHost := strings.TrimSuffix(strings.TrimPrefix(base.Host, "["), "]")
+	if ip := net.ParseIP(baseHost); ip == nil || !ip.IsLoopback() {
+		return false
+	}
+	redirectHost, redirectPort, err := net.SplitHostPort(redirect.Host)
+	return err == nil && redirectHost == baseHost && len(redirectPort) > 0
+}
+
 // Returns the first uri from an uri list
 func FirstUri(baseUriList string, separator string) string {
 	if separator != "" {
@@ -118,3 +118,50 @@ func TestURIListValidate(t *testing.T) {
 		t.Error("V4 should have failed")
 	}
 }
+
+type testNativeClient struct {
+	DefaultClient
+	native bool
+}
+
+func (c *testNativeClient) IsNative() bool {
+	return c.native
+}
+
+func TestClientRedirectUriValidate(t *testing.T) {
+	native := &testNativeClient{DefaultClient: DefaultClient{RedirectUri: "http://127.0.0.1/callback;http://[::1]/callback;http://localhost/callback"}, native: true}
+	notNative := &testNativeClient{DefaultClient: native.DefaultClient}
+
+	valid := []string{
+		// Any port of a loopback IP address
+		"http://127.0.0.1:53412/callback",
+		// Any port of a loopback IPv6 address
+		"http://[::1]:53412/callback",
+		// Registered ports still match
+		"http://127.0.0.1/callback",
+	}
+	for _, v := range valid {
+		if err := ValidateClientRedirectUri(native, v, ";"); err != nil {
+			t.Errorf("Expected %s to be valid for a native client, got %v", v, err)
+		}
+		if v != "http://127.0.0.1/callback" {
+			if err := ValidateClientRedirectUri(notNative, v, ";"); err == nil {
+				t.Errorf("Expected %s to be invalid for a client that is not native", v)
+			}
+		}
+	}
+
+	invalid := []string{
+		// Ports are only ignored for loopback IP addresses
+		"http://localhost:53412/callback",
+		// Ports are only ignored for http
+		"https://127.0.0.1:53412/callback",
+		// Paths must match
+		"http://127.0.0.1:53412/other",
+	}
+	for _, v := range invalid {
+		if err := ValidateClientRedirectUri(native, v, ";"); err == nil {
+			t.Errorf("Expected %s to be invalid for a native client", v)
+		}
+	}
+}
@@ -38,6 +38,10 @@ import (
 	"k8s.io/kubernetes/pkg/watch"
 )
 
+// ServiceServingCASecretKey is the key in a service account token secret that holds the CA bundle used
+// to verify serving certificates signed for services.  This is an OpenShift carry.
+const ServiceServingCASecretKey = "service-ca.crt"
+
 // RemoveTokenBackoff is the recommended (empirical) retry interval for removing
 // a secret reference from a service account when the secret is deleted. It is
 // exported for use by custom secret controllers.
@@ -59,6 +63,8 @@ type TokensControllerOptions struct {
 	SecretResync time.Duration
 	// This CA will be added in the secretes of service accounts
 	RootCA []byte
+	// ServiceServingCA will be added in the secrets of service accounts.  This is an OpenShift carry.
+	ServiceServingCA []byte
 }
 
 // NewTokensController returns a new *TokensController.
@@ -67,6 +73,8 @@ func NewTokensController(cl clientset.Interface, options TokensControllerOptions
 		client: cl,
 		token:  options.TokenGenerator,
 		rootCA: options.RootCA,
+
+		serviceServingCA: options.ServiceServingCA,
 	}
 
 	e.serviceAccounts, e.serviceAccountController = framework.NewIndexerInformer(
@@ -125,6 +133,8 @@ type TokensController struct {
 
 	rootCA []byte
 
+	serviceServingCA []byte
+
 	serviceAccounts cache.Indexer
 	secrets         cache.Indexer
 
@@ -329,6 +339,9 @@ func (e *TokensController) createSecret(serviceAccount *api.ServiceAccount) erro
 	if e.rootCA != nil && len(e.rootCA) > 0 {
 		secret.Data[api.ServiceAccountRootCAKey] = e.rootCA
 	}
+	if len(e.serviceServingCA) > 0 {
+		secret.Data[ServiceServingCASecretKey] = e.serviceServingCA
+	}
 
 	// Save the secret
 	if createdToken, err := e.client.Core().Secrets(serviceAccount.Namespace).Create(secret); err != nil {
@@ -369,12 +382,15 @@ func (e *TokensController) generateTokenIfNeeded(serviceAccount *api.ServiceAcco
 	caData := secret.Data[api.ServiceAccountRootCAKey]
 	needsCA := len(e.rootCA) > 0 && bytes.Compare(caData, e.rootCA) != 0
 
+	serviceServingCAData := secret.Data[ServiceServingCASecretKey]
+	needsServiceServingCA := len(e.serviceServingCA) > 0 && bytes.Compare(serviceServingCAData, e.serviceServingCA) != 0
+
 	needsNamespace := len(secret.Data[api.ServiceAccountNamespaceKey]) == 0
 
 	tokenData := secret.Data[api.ServiceAccountTokenKey]
 	needsToken := len(tokenData) == 0
 
-	if !needsCA && !needsToken && !needsNamespace {
+	if !needsCA && !needsServiceServingCA && !needsToken && !needsNamespace {
 		return nil
 	}
 
@@ -382,6 +398,9 @@ func (e *TokensController) generateTokenIfNeeded(serviceAccount *api.ServiceAcco
 	if needsCA {
 		secret.Data[api.ServiceAccountRootCAKey] = e.rootCA
 	}
+	if needsServiceServingCA {
+		secret.Data[ServiceServingCASecretKey] = e.serviceServingCA
+	}
 	// Set the namespace
 	if needsNamespace {
 		secret.Data[api.ServiceAccountNamespaceKey] = []byte(secret.Namespace)
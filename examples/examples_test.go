@@ -1,7 +1,9 @@
 package examples
 
 import (
+	cryptorand "crypto/rand"
 	"io/ioutil"
+	"math/rand"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -21,7 +23,9 @@ import (
 	imageapi "github.com/openshift/origin/pkg/image/api"
 	projectapi "github.com/openshift/origin/pkg/project/api"
 	routeapi "github.com/openshift/origin/pkg/route/api"
+	"github.com/openshift/origin/pkg/template"
 	templateapi "github.com/openshift/origin/pkg/template/api"
+	"github.com/openshift/origin/pkg/template/generator"
 
 	// install all APIs
 	_ "github.com/openshift/origin/pkg/api/install"
@@ -124,9 +128,11 @@ func TestExampleObjectSchemas(t *testing.T) {
 			"test-buildcli-beta2":                 &kapi.List{},
 		},
 		"../test/templates/fixtures": {
-			"crunchydata-pod": nil, // Explicitly fails validation, but should pass transformation
-			"guestbook_list":  &templateapi.Template{},
-			"guestbook":       &templateapi.Template{},
+			"crunchydata-pod":    nil, // Explicitly fails validation, but should pass transformation
+			"guestbook_list":     &templateapi.Template{},
+			"guestbook":          &templateapi.Template{},
+			"guestbook-test":     nil, // skip a template test fixture
+			"multiple-templates": nil, // skip a multi-document yaml file
 		},
 	}
 
@@ -218,3 +224,64 @@ func TestReadme(t *testing.T) {
 		t.Fatalf("Unable to read file: %v", err)
 	}
 }
+
+// TestExampleTemplatesProcess processes every template under examples/ with its declared parameters, so that a
+// template referencing an undeclared parameter fails here rather than when users instantiate it.
+func TestExampleTemplatesProcess(t *testing.T) {
+	generators := map[string]generator.Generator{
+		"expression": generator.NewExpressionValueGenerator(rand.New(rand.NewSource(1))),
+		"base64":     generator.NewBase64ValueGenerator(cryptorand.Reader),
+		"uuid":       generator.NewUUIDValueGenerator(cryptorand.Reader),
+		"bcrypt":     generator.NewBcryptValueGenerator(),
+		"htpasswd":   generator.NewHtpasswdValueGenerator(),
+		"privatekey": generator.NewPrivateKeyValueGenerator(cryptorand.Reader),
+		"publickey":  generator.NewPublicKeyValueGenerator(),
+		"sshkey":     generator.NewSSHPublicKeyValueGenerator(),
+		"tlscert":    generator.NewCertificateValueGenerator(cryptorand.Reader),
+		"charset":    generator.NewCharsetValueGenerator(rand.New(rand.NewSource(1))),
+	}
+	count := 0
+	err := filepath.Walk(".", func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		ext := filepath.Ext(path)
+		if !(ext == ".json" || ext == ".yaml") {
+			return nil
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if ext == ".yaml" {
+			if data, err = yaml.ToJSON(data); err != nil {
+				return nil
+			}
+		}
+		obj, err := runtime.Decode(kapi.Codecs.UniversalDecoder(), data)
+		if err != nil {
+			return nil
+		}
+		tpl, ok := obj.(*templateapi.Template)
+		if !ok {
+			return nil
+		}
+		// required parameters without a default are provided by the user
+		for i := range tpl.Parameters {
+			if param := &tpl.Parameters[i]; param.Required && len(param.Value) == 0 && len(param.Generate) == 0 {
+				param.Value = "value"
+			}
+		}
+		count++
+		if errs := template.NewProcessor(generators).Process(tpl); len(errs) > 0 {
+			t.Errorf("%s: unexpected error processing the template: %v", path, errs.ToAggregate())
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count == 0 {
+		t.Errorf("no templates found")
+	}
+}
@@ -20,6 +20,22 @@ import (
 	sets "k8s.io/kubernetes/pkg/util/sets"
 )
 
+func deepCopy_api_AggregationRule(in api.AggregationRule, out *api.AggregationRule, c *conversion.Cloner) error {
+	if in.ClusterRoleSelectors != nil {
+		out.ClusterRoleSelectors = make([]unversioned.LabelSelector, len(in.ClusterRoleSelectors))
+		for i := range in.ClusterRoleSelectors {
+			if newVal, err := c.DeepCopy(in.ClusterRoleSelectors[i]); err != nil {
+				return err
+			} else {
+				out.ClusterRoleSelectors[i] = newVal.(unversioned.LabelSelector)
+			}
+		}
+	} else {
+		out.ClusterRoleSelectors = nil
+	}
+	return nil
+}
+
 func deepCopy_api_AuthorizationAttributes(in api.AuthorizationAttributes, out *api.AuthorizationAttributes, c *conversion.Cloner) error {
 	out.Namespace = in.Namespace
 	out.Verb = in.Verb
@@ -34,6 +50,8 @@ func deepCopy_api_AuthorizationAttributes(in api.AuthorizationAttributes, out *a
 	} else {
 		out.Content = newVal.(runtime.Object)
 	}
+	out.IsNonResourceURL = in.IsNonResourceURL
+	out.Path = in.Path
 	return nil
 }
 
@@ -173,6 +191,14 @@ func deepCopy_api_ClusterRole(in api.ClusterRole, out *api.ClusterRole, c *conve
 	} else {
 		out.Rules = nil
 	}
+	if in.AggregationRule != nil {
+		out.AggregationRule = new(api.AggregationRule)
+		if err := deepCopy_api_AggregationRule(*in.AggregationRule, out.AggregationRule, c); err != nil {
+			return err
+		}
+	} else {
+		out.AggregationRule = nil
+	}
 	return nil
 }
 
@@ -483,6 +509,33 @@ func deepCopy_api_PolicyRule(in api.PolicyRule, out *api.PolicyRule, c *conversi
 	return nil
 }
 
+func deepCopy_api_ResourceAccessGrant(in api.ResourceAccessGrant, out *api.ResourceAccessGrant, c *conversion.Cloner) error {
+	out.Namespace = in.Namespace
+	out.RoleBinding = in.RoleBinding
+	if newVal, err := c.DeepCopy(in.RoleRef); err != nil {
+		return err
+	} else {
+		out.RoleRef = newVal.(pkgapi.ObjectReference)
+	}
+	if in.Users != nil {
+		out.Users = make([]string, len(in.Users))
+		for i := range in.Users {
+			out.Users[i] = in.Users[i]
+		}
+	} else {
+		out.Users = nil
+	}
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	return nil
+}
+
 func deepCopy_api_ResourceAccessReview(in api.ResourceAccessReview, out *api.ResourceAccessReview, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -526,6 +579,16 @@ func deepCopy_api_ResourceAccessReviewResponse(in api.ResourceAccessReviewRespon
 	} else {
 		out.Groups = nil
 	}
+	if in.Grants != nil {
+		out.Grants = make([]api.ResourceAccessGrant, len(in.Grants))
+		for i := range in.Grants {
+			if err := deepCopy_api_ResourceAccessGrant(in.Grants[i], &out.Grants[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Grants = nil
+	}
 	return nil
 }
 
@@ -550,6 +613,14 @@ func deepCopy_api_Role(in api.Role, out *api.Role, c *conversion.Cloner) error {
 	} else {
 		out.Rules = nil
 	}
+	if in.AggregationRule != nil {
+		out.AggregationRule = new(api.AggregationRule)
+		if err := deepCopy_api_AggregationRule(*in.AggregationRule, out.AggregationRule, c); err != nil {
+			return err
+		}
+	} else {
+		out.AggregationRule = nil
+	}
 	return nil
 }
 
@@ -773,6 +844,19 @@ func deepCopy_api_BuildConfigSpec(in buildapi.BuildConfigSpec, out *buildapi.Bui
 	if err := deepCopy_api_BuildSpec(in.BuildSpec, &out.BuildSpec, c); err != nil {
 		return err
 	}
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
+	out.RunPolicy = in.RunPolicy
 	return nil
 }
 
@@ -858,6 +942,19 @@ func deepCopy_api_BuildLogOptions(in buildapi.BuildLogOptions, out *buildapi.Bui
 	} else {
 		out.Version = nil
 	}
+	if in.OffsetBytes != nil {
+		out.OffsetBytes = new(int64)
+		*out.OffsetBytes = *in.OffsetBytes
+	} else {
+		out.OffsetBytes = nil
+	}
+	if in.OffsetLines != nil {
+		out.OffsetLines = new(int64)
+		*out.OffsetLines = *in.OffsetLines
+	} else {
+		out.OffsetLines = nil
+	}
+	out.Format = in.Format
 	return nil
 }
 
@@ -1050,6 +1147,14 @@ func deepCopy_api_BuildSpec(in buildapi.BuildSpec, out *buildapi.BuildSpec, c *c
 	} else {
 		out.Resources = newVal.(pkgapi.ResourceRequirements)
 	}
+	if in.NodeSelector != nil {
+		out.NodeSelector = make(map[string]string)
+		for key, val := range in.NodeSelector {
+			out.NodeSelector[key] = val
+		}
+	} else {
+		out.NodeSelector = nil
+	}
 	if err := deepCopy_api_BuildPostCommitSpec(in.PostCommit, &out.PostCommit, c); err != nil {
 		return err
 	}
@@ -1145,6 +1250,22 @@ func deepCopy_api_BuildTriggerPolicy(in buildapi.BuildTriggerPolicy, out *builda
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(buildapi.WebHookTrigger)
+		if err := deepCopy_api_WebHookTrigger(*in.GitLabWebHook, out.GitLabWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(buildapi.WebHookTrigger)
+		if err := deepCopy_api_WebHookTrigger(*in.BitbucketWebHook, out.BitbucketWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(buildapi.ImageChangeTrigger)
 		if err := deepCopy_api_ImageChangeTrigger(*in.ImageChange, out.ImageChange, c); err != nil {
@@ -1251,6 +1372,21 @@ func deepCopy_api_GitBuildSource(in buildapi.GitBuildSource, out *buildapi.GitBu
 	} else {
 		out.HTTPSProxy = nil
 	}
+	if in.NoProxy != nil {
+		out.NoProxy = new(string)
+		*out.NoProxy = *in.NoProxy
+	} else {
+		out.NoProxy = nil
+	}
+	out.SkipSubmodules = in.SkipSubmodules
+	if in.SparseCheckoutPaths != nil {
+		out.SparseCheckoutPaths = make([]string, len(in.SparseCheckoutPaths))
+		for i := range in.SparseCheckoutPaths {
+			out.SparseCheckoutPaths[i] = in.SparseCheckoutPaths[i]
+		}
+	} else {
+		out.SparseCheckoutPaths = nil
+	}
 	return nil
 }
 
@@ -1364,6 +1500,15 @@ func deepCopy_api_SourceBuildStrategy(in buildapi.SourceBuildStrategy, out *buil
 	out.Scripts = in.Scripts
 	out.Incremental = in.Incremental
 	out.ForcePull = in.ForcePull
+	if in.ArtifactCache != nil {
+		if newVal, err := c.DeepCopy(in.ArtifactCache); err != nil {
+			return err
+		} else {
+			out.ArtifactCache = newVal.(*pkgapi.LocalObjectReference)
+		}
+	} else {
+		out.ArtifactCache = nil
+	}
 	return nil
 }
 
@@ -1387,6 +1532,53 @@ func deepCopy_api_SourceRevision(in buildapi.SourceRevision, out *buildapi.Sourc
 
 func deepCopy_api_WebHookTrigger(in buildapi.WebHookTrigger, out *buildapi.WebHookTrigger, c *conversion.Cloner) error {
 	out.Secret = in.Secret
+	out.RefFilter = in.RefFilter
+	out.PathFilter = in.PathFilter
+	return nil
+}
+
+func deepCopy_api_CanaryDeploymentStrategyParams(in deployapi.CanaryDeploymentStrategyParams, out *deployapi.CanaryDeploymentStrategyParams, c *conversion.Cloner) error {
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if newVal, err := c.DeepCopy(in.Size); err != nil {
+		return err
+	} else {
+		out.Size = newVal.(intstr.IntOrString)
+	}
+	if in.VerificationSeconds != nil {
+		out.VerificationSeconds = new(int64)
+		*out.VerificationSeconds = *in.VerificationSeconds
+	} else {
+		out.VerificationSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Verify != nil {
+		out.Verify = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Verify, out.Verify, c); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
 	return nil
 }
 
@@ -1437,6 +1629,24 @@ func deepCopy_api_DeploymentCauseImageTrigger(in deployapi.DeploymentCauseImageT
 	return nil
 }
 
+func deepCopy_api_DeploymentCondition(in deployapi.DeploymentCondition, out *deployapi.DeploymentCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if newVal, err := c.DeepCopy(in.LastUpdateTime); err != nil {
+		return err
+	} else {
+		out.LastUpdateTime = newVal.(unversioned.Time)
+	}
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
 func deepCopy_api_DeploymentConfig(in deployapi.DeploymentConfig, out *deployapi.DeploymentConfig, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -1522,6 +1732,13 @@ func deepCopy_api_DeploymentConfigSpec(in deployapi.DeploymentConfigSpec, out *d
 	}
 	out.Replicas = in.Replicas
 	out.Test = in.Test
+	out.Paused = in.Paused
+	if in.ProgressDeadlineSeconds != nil {
+		out.ProgressDeadlineSeconds = new(int64)
+		*out.ProgressDeadlineSeconds = *in.ProgressDeadlineSeconds
+	} else {
+		out.ProgressDeadlineSeconds = nil
+	}
 	if in.Selector != nil {
 		out.Selector = make(map[string]string)
 		for key, val := range in.Selector {
@@ -1552,6 +1769,16 @@ func deepCopy_api_DeploymentConfigStatus(in deployapi.DeploymentConfigStatus, ou
 	} else {
 		out.Details = nil
 	}
+	if in.Conditions != nil {
+		out.Conditions = make([]deployapi.DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_api_DeploymentCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -1627,6 +1854,18 @@ func deepCopy_api_DeploymentLogOptions(in deployapi.DeploymentLogOptions, out *d
 	} else {
 		out.Version = nil
 	}
+	out.AllPods = in.AllPods
+	out.DeploymentPods = in.DeploymentPods
+	return nil
+}
+
+func deepCopy_api_DeploymentRequest(in deployapi.DeploymentRequest, out *deployapi.DeploymentRequest, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	out.Name = in.Name
 	return nil
 }
 
@@ -1656,6 +1895,14 @@ func deepCopy_api_DeploymentStrategy(in deployapi.DeploymentStrategy, out *deplo
 	} else {
 		out.RollingParams = nil
 	}
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapi.CanaryDeploymentStrategyParams)
+		if err := deepCopy_api_CanaryDeploymentStrategyParams(*in.CanaryParams, out.CanaryParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
 	if newVal, err := c.DeepCopy(in.Resources); err != nil {
 		return err
 	} else {
@@ -1680,6 +1927,11 @@ func deepCopy_api_DeploymentStrategy(in deployapi.DeploymentStrategy, out *deplo
 	return nil
 }
 
+func deepCopy_api_DeploymentTriggerConfigChangeParams(in deployapi.DeploymentTriggerConfigChangeParams, out *deployapi.DeploymentTriggerConfigChangeParams, c *conversion.Cloner) error {
+	out.DebounceSeconds = in.DebounceSeconds
+	return nil
+}
+
 func deepCopy_api_DeploymentTriggerImageChangeParams(in deployapi.DeploymentTriggerImageChangeParams, out *deployapi.DeploymentTriggerImageChangeParams, c *conversion.Cloner) error {
 	out.Automatic = in.Automatic
 	if in.ContainerNames != nil {
@@ -1709,6 +1961,14 @@ func deepCopy_api_DeploymentTriggerPolicy(in deployapi.DeploymentTriggerPolicy,
 	} else {
 		out.ImageChangeParams = nil
 	}
+	if in.ConfigChangeParams != nil {
+		out.ConfigChangeParams = new(deployapi.DeploymentTriggerConfigChangeParams)
+		if err := deepCopy_api_DeploymentTriggerConfigChangeParams(*in.ConfigChangeParams, out.ConfigChangeParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigChangeParams = nil
+	}
 	return nil
 }
 
@@ -1734,6 +1994,7 @@ func deepCopy_api_ExecNewPodHook(in deployapi.ExecNewPodHook, out *deployapi.Exe
 		out.Env = nil
 	}
 	out.ContainerName = in.ContainerName
+	out.Image = in.Image
 	if in.Volumes != nil {
 		out.Volumes = make([]string, len(in.Volumes))
 		for i := range in.Volumes {
@@ -2035,6 +2296,8 @@ func deepCopy_api_Image(in imageapi.Image, out *imageapi.Image, c *conversion.Cl
 	} else {
 		out.DockerImageLayers = nil
 	}
+	out.DockerImageConfig = in.DockerImageConfig
+	out.DockerImageManifestMediaType = in.DockerImageManifestMediaType
 	return nil
 }
 
@@ -2081,6 +2344,7 @@ func deepCopy_api_ImageImportStatus(in imageapi.ImageImportStatus, out *imageapi
 func deepCopy_api_ImageLayer(in imageapi.ImageLayer, out *imageapi.ImageLayer, c *conversion.Cloner) error {
 	out.Name = in.Name
 	out.Size = in.Size
+	out.MediaType = in.MediaType
 	return nil
 }
 
@@ -2480,6 +2744,41 @@ func deepCopy_api_TagReference(in imageapi.TagReference, out *imageapi.TagRefere
 	if err := deepCopy_api_TagImportPolicy(in.ImportPolicy, &out.ImportPolicy, c); err != nil {
 		return err
 	}
+	if in.HistoryLimit != nil {
+		out.HistoryLimit = new(int32)
+		*out.HistoryLimit = *in.HistoryLimit
+	} else {
+		out.HistoryLimit = nil
+	}
+	if err := deepCopy_api_TagReferencePolicy(in.ReferencePolicy, &out.ReferencePolicy, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_api_TagReferencePolicy(in imageapi.TagReferencePolicy, out *imageapi.TagReferencePolicy, c *conversion.Cloner) error {
+	out.Type = in.Type
+	return nil
+}
+
+func deepCopy_api_ClusterRoleScopeRestriction(in oauthapi.ClusterRoleScopeRestriction, out *oauthapi.ClusterRoleScopeRestriction, c *conversion.Cloner) error {
+	if in.RoleNames != nil {
+		out.RoleNames = make([]string, len(in.RoleNames))
+		for i := range in.RoleNames {
+			out.RoleNames[i] = in.RoleNames[i]
+		}
+	} else {
+		out.RoleNames = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	out.AllowEscalation = in.AllowEscalation
 	return nil
 }
 
@@ -2509,6 +2808,7 @@ func deepCopy_api_OAuthAccessToken(in oauthapi.OAuthAccessToken, out *oauthapi.O
 	out.UserUID = in.UserUID
 	out.AuthorizeToken = in.AuthorizeToken
 	out.RefreshToken = in.RefreshToken
+	out.InactivityTimeoutSeconds = in.InactivityTimeoutSeconds
 	return nil
 }
 
@@ -2561,6 +2861,8 @@ func deepCopy_api_OAuthAuthorizeToken(in oauthapi.OAuthAuthorizeToken, out *oaut
 	out.State = in.State
 	out.UserName = in.UserName
 	out.UserUID = in.UserUID
+	out.CodeChallenge = in.CodeChallenge
+	out.CodeChallengeMethod = in.CodeChallengeMethod
 	return nil
 }
 
@@ -2609,6 +2911,28 @@ func deepCopy_api_OAuthClient(in oauthapi.OAuthClient, out *oauthapi.OAuthClient
 	} else {
 		out.RedirectURIs = nil
 	}
+	if in.AccessTokenMaxAgeSeconds != nil {
+		out.AccessTokenMaxAgeSeconds = new(int32)
+		*out.AccessTokenMaxAgeSeconds = *in.AccessTokenMaxAgeSeconds
+	} else {
+		out.AccessTokenMaxAgeSeconds = nil
+	}
+	if in.AccessTokenInactivityTimeoutSeconds != nil {
+		out.AccessTokenInactivityTimeoutSeconds = new(int32)
+		*out.AccessTokenInactivityTimeoutSeconds = *in.AccessTokenInactivityTimeoutSeconds
+	} else {
+		out.AccessTokenInactivityTimeoutSeconds = nil
+	}
+	if in.ScopeRestrictions != nil {
+		out.ScopeRestrictions = make([]oauthapi.ScopeRestriction, len(in.ScopeRestrictions))
+		for i := range in.ScopeRestrictions {
+			if err := deepCopy_api_ScopeRestriction(in.ScopeRestrictions[i], &out.ScopeRestrictions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ScopeRestrictions = nil
+	}
 	return nil
 }
 
@@ -2685,6 +3009,26 @@ func deepCopy_api_OAuthClientList(in oauthapi.OAuthClientList, out *oauthapi.OAu
 	return nil
 }
 
+func deepCopy_api_ScopeRestriction(in oauthapi.ScopeRestriction, out *oauthapi.ScopeRestriction, c *conversion.Cloner) error {
+	if in.ExactValues != nil {
+		out.ExactValues = make([]string, len(in.ExactValues))
+		for i := range in.ExactValues {
+			out.ExactValues[i] = in.ExactValues[i]
+		}
+	} else {
+		out.ExactValues = nil
+	}
+	if in.ClusterRole != nil {
+		out.ClusterRole = new(oauthapi.ClusterRoleScopeRestriction)
+		if err := deepCopy_api_ClusterRoleScopeRestriction(*in.ClusterRole, out.ClusterRole, c); err != nil {
+			return err
+		}
+	} else {
+		out.ClusterRole = nil
+	}
+	return nil
+}
+
 func deepCopy_api_Project(in projectapi.Project, out *projectapi.Project, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2782,6 +3126,12 @@ func deepCopy_api_Route(in routeapi.Route, out *routeapi.Route, c *conversion.Cl
 	return nil
 }
 
+func deepCopy_api_RouteHealthCheck(in routeapi.RouteHealthCheck, out *routeapi.RouteHealthCheck, c *conversion.Cloner) error {
+	out.Path = in.Path
+	out.IntervalSeconds = in.IntervalSeconds
+	return nil
+}
+
 func deepCopy_api_RouteIngress(in routeapi.RouteIngress, out *routeapi.RouteIngress, c *conversion.Cloner) error {
 	out.Host = in.Host
 	out.RouterName = in.RouterName
@@ -2851,10 +3201,18 @@ func deepCopy_api_RoutePort(in routeapi.RoutePort, out *routeapi.RoutePort, c *c
 func deepCopy_api_RouteSpec(in routeapi.RouteSpec, out *routeapi.RouteSpec, c *conversion.Cloner) error {
 	out.Host = in.Host
 	out.Path = in.Path
-	if newVal, err := c.DeepCopy(in.To); err != nil {
+	if err := deepCopy_api_RouteTargetReference(in.To, &out.To, c); err != nil {
 		return err
+	}
+	if in.AlternateBackends != nil {
+		out.AlternateBackends = make([]routeapi.RouteTargetReference, len(in.AlternateBackends))
+		for i := range in.AlternateBackends {
+			if err := deepCopy_api_RouteTargetReference(in.AlternateBackends[i], &out.AlternateBackends[i], c); err != nil {
+				return err
+			}
+		}
 	} else {
-		out.To = newVal.(pkgapi.ObjectReference)
+		out.AlternateBackends = nil
 	}
 	if in.Port != nil {
 		out.Port = new(routeapi.RoutePort)
@@ -2864,6 +3222,14 @@ func deepCopy_api_RouteSpec(in routeapi.RouteSpec, out *routeapi.RouteSpec, c *c
 	} else {
 		out.Port = nil
 	}
+	if in.HealthCheck != nil {
+		out.HealthCheck = new(routeapi.RouteHealthCheck)
+		if err := deepCopy_api_RouteHealthCheck(*in.HealthCheck, out.HealthCheck, c); err != nil {
+			return err
+		}
+	} else {
+		out.HealthCheck = nil
+	}
 	if in.TLS != nil {
 		out.TLS = new(routeapi.TLSConfig)
 		if err := deepCopy_api_TLSConfig(*in.TLS, out.TLS, c); err != nil {
@@ -2889,6 +3255,18 @@ func deepCopy_api_RouteStatus(in routeapi.RouteStatus, out *routeapi.RouteStatus
 	return nil
 }
 
+func deepCopy_api_RouteTargetReference(in routeapi.RouteTargetReference, out *routeapi.RouteTargetReference, c *conversion.Cloner) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	return nil
+}
+
 func deepCopy_api_TLSConfig(in routeapi.TLSConfig, out *routeapi.TLSConfig, c *conversion.Cloner) error {
 	out.Termination = in.Termination
 	out.Certificate = in.Certificate
@@ -3021,6 +3399,15 @@ func deepCopy_api_NetNamespaceList(in sdnapi.NetNamespaceList, out *sdnapi.NetNa
 	return nil
 }
 
+func deepCopy_api_GeneratorOptions(in templateapi.GeneratorOptions, out *templateapi.GeneratorOptions, c *conversion.Cloner) error {
+	out.Charset = in.Charset
+	out.Exclude = in.Exclude
+	out.ExcludeAmbiguous = in.ExcludeAmbiguous
+	out.MinLength = in.MinLength
+	out.MaxLength = in.MaxLength
+	return nil
+}
+
 func deepCopy_api_Parameter(in templateapi.Parameter, out *templateapi.Parameter, c *conversion.Cloner) error {
 	out.Name = in.Name
 	out.DisplayName = in.DisplayName
@@ -3028,7 +3415,26 @@ func deepCopy_api_Parameter(in templateapi.Parameter, out *templateapi.Parameter
 	out.Value = in.Value
 	out.Generate = in.Generate
 	out.From = in.From
+	out.FromEnv = in.FromEnv
 	out.Required = in.Required
+	out.Type = in.Type
+	if in.Allowed != nil {
+		out.Allowed = make([]string, len(in.Allowed))
+		for i := range in.Allowed {
+			out.Allowed[i] = in.Allowed[i]
+		}
+	} else {
+		out.Allowed = nil
+	}
+	out.Sensitive = in.Sensitive
+	if in.GeneratorOptions != nil {
+		out.GeneratorOptions = new(templateapi.GeneratorOptions)
+		if err := deepCopy_api_GeneratorOptions(*in.GeneratorOptions, out.GeneratorOptions, c); err != nil {
+			return err
+		}
+	} else {
+		out.GeneratorOptions = nil
+	}
 	return nil
 }
 
@@ -3075,6 +3481,82 @@ func deepCopy_api_Template(in templateapi.Template, out *templateapi.Template, c
 	} else {
 		out.ObjectLabels = nil
 	}
+	if in.ObjectAnnotations != nil {
+		out.ObjectAnnotations = make(map[string]string)
+		for key, val := range in.ObjectAnnotations {
+			out.ObjectAnnotations[key] = val
+		}
+	} else {
+		out.ObjectAnnotations = nil
+	}
+	if in.Includes != nil {
+		out.Includes = make([]templateapi.TemplateInclude, len(in.Includes))
+		for i := range in.Includes {
+			if err := deepCopy_api_TemplateInclude(in.Includes[i], &out.Includes[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Includes = nil
+	}
+	out.Message = in.Message
+	return nil
+}
+
+func deepCopy_api_TemplateInclude(in templateapi.TemplateInclude, out *templateapi.TemplateInclude, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+func deepCopy_api_TemplateInstance(in templateapi.TemplateInstance, out *templateapi.TemplateInstance, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapi.ObjectMeta)
+	}
+	out.Template = in.Template
+	if in.Objects != nil {
+		out.Objects = make([]pkgapi.ObjectReference, len(in.Objects))
+		for i := range in.Objects {
+			if newVal, err := c.DeepCopy(in.Objects[i]); err != nil {
+				return err
+			} else {
+				out.Objects[i] = newVal.(pkgapi.ObjectReference)
+			}
+		}
+	} else {
+		out.Objects = nil
+	}
+	return nil
+}
+
+func deepCopy_api_TemplateInstanceList(in templateapi.TemplateInstanceList, out *templateapi.TemplateInstanceList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(unversioned.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]templateapi.TemplateInstance, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_api_TemplateInstance(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -3102,6 +3584,77 @@ func deepCopy_api_TemplateList(in templateapi.TemplateList, out *templateapi.Tem
 	return nil
 }
 
+func deepCopy_api_TemplateRepository(in templateapi.TemplateRepository, out *templateapi.TemplateRepository, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapi.ObjectMeta)
+	}
+	if err := deepCopy_api_TemplateRepositorySpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_api_TemplateRepositoryStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_api_TemplateRepositoryList(in templateapi.TemplateRepositoryList, out *templateapi.TemplateRepositoryList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(unversioned.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]templateapi.TemplateRepository, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_api_TemplateRepository(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func deepCopy_api_TemplateRepositorySpec(in templateapi.TemplateRepositorySpec, out *templateapi.TemplateRepositorySpec, c *conversion.Cloner) error {
+	out.Git = in.Git
+	out.Index = in.Index
+	out.Prune = in.Prune
+	return nil
+}
+
+func deepCopy_api_TemplateRepositoryStatus(in templateapi.TemplateRepositoryStatus, out *templateapi.TemplateRepositoryStatus, c *conversion.Cloner) error {
+	if in.LastSyncTime != nil {
+		if newVal, err := c.DeepCopy(in.LastSyncTime); err != nil {
+			return err
+		} else {
+			out.LastSyncTime = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.LastSyncTime = nil
+	}
+	if in.Templates != nil {
+		out.Templates = make([]string, len(in.Templates))
+		copy(out.Templates, in.Templates)
+	} else {
+		out.Templates = nil
+	}
+	out.Message = in.Message
+	return nil
+}
+
 func deepCopy_api_Group(in userapi.Group, out *userapi.Group, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -3282,6 +3835,7 @@ func deepCopy_api_UserList(in userapi.UserList, out *userapi.UserList, c *conver
 
 func init() {
 	err := pkgapi.Scheme.AddGeneratedDeepCopyFuncs(
+		deepCopy_api_AggregationRule,
 		deepCopy_api_AuthorizationAttributes,
 		deepCopy_api_ClusterPolicy,
 		deepCopy_api_ClusterPolicyBinding,
@@ -3299,6 +3853,7 @@ func init() {
 		deepCopy_api_PolicyBindingList,
 		deepCopy_api_PolicyList,
 		deepCopy_api_PolicyRule,
+		deepCopy_api_ResourceAccessGrant,
 		deepCopy_api_ResourceAccessReview,
 		deepCopy_api_ResourceAccessReviewResponse,
 		deepCopy_api_Role,
@@ -3338,9 +3893,11 @@ func init() {
 		deepCopy_api_SourceControlUser,
 		deepCopy_api_SourceRevision,
 		deepCopy_api_WebHookTrigger,
+		deepCopy_api_CanaryDeploymentStrategyParams,
 		deepCopy_api_CustomDeploymentStrategyParams,
 		deepCopy_api_DeploymentCause,
 		deepCopy_api_DeploymentCauseImageTrigger,
+		deepCopy_api_DeploymentCondition,
 		deepCopy_api_DeploymentConfig,
 		deepCopy_api_DeploymentConfigList,
 		deepCopy_api_DeploymentConfigRollback,
@@ -3350,7 +3907,9 @@ func init() {
 		deepCopy_api_DeploymentDetails,
 		deepCopy_api_DeploymentLog,
 		deepCopy_api_DeploymentLogOptions,
+		deepCopy_api_DeploymentRequest,
 		deepCopy_api_DeploymentStrategy,
+		deepCopy_api_DeploymentTriggerConfigChangeParams,
 		deepCopy_api_DeploymentTriggerImageChangeParams,
 		deepCopy_api_DeploymentTriggerPolicy,
 		deepCopy_api_ExecNewPodHook,
@@ -3383,6 +3942,8 @@ func init() {
 		deepCopy_api_TagEventList,
 		deepCopy_api_TagImportPolicy,
 		deepCopy_api_TagReference,
+		deepCopy_api_TagReferencePolicy,
+		deepCopy_api_ClusterRoleScopeRestriction,
 		deepCopy_api_OAuthAccessToken,
 		deepCopy_api_OAuthAccessTokenList,
 		deepCopy_api_OAuthAuthorizeToken,
@@ -3391,18 +3952,21 @@ func init() {
 		deepCopy_api_OAuthClientAuthorization,
 		deepCopy_api_OAuthClientAuthorizationList,
 		deepCopy_api_OAuthClientList,
+		deepCopy_api_ScopeRestriction,
 		deepCopy_api_Project,
 		deepCopy_api_ProjectList,
 		deepCopy_api_ProjectRequest,
 		deepCopy_api_ProjectSpec,
 		deepCopy_api_ProjectStatus,
 		deepCopy_api_Route,
+		deepCopy_api_RouteHealthCheck,
 		deepCopy_api_RouteIngress,
 		deepCopy_api_RouteIngressCondition,
 		deepCopy_api_RouteList,
 		deepCopy_api_RoutePort,
 		deepCopy_api_RouteSpec,
 		deepCopy_api_RouteStatus,
+		deepCopy_api_RouteTargetReference,
 		deepCopy_api_TLSConfig,
 		deepCopy_api_ClusterNetwork,
 		deepCopy_api_ClusterNetworkList,
@@ -3410,9 +3974,17 @@ func init() {
 		deepCopy_api_HostSubnetList,
 		deepCopy_api_NetNamespace,
 		deepCopy_api_NetNamespaceList,
+		deepCopy_api_GeneratorOptions,
 		deepCopy_api_Parameter,
 		deepCopy_api_Template,
+		deepCopy_api_TemplateInclude,
+		deepCopy_api_TemplateInstance,
+		deepCopy_api_TemplateInstanceList,
 		deepCopy_api_TemplateList,
+		deepCopy_api_TemplateRepository,
+		deepCopy_api_TemplateRepositoryList,
+		deepCopy_api_TemplateRepositorySpec,
+		deepCopy_api_TemplateRepositoryStatus,
 		deepCopy_api_Group,
 		deepCopy_api_GroupList,
 		deepCopy_api_Identity,
@@ -56,6 +56,11 @@ func fuzzInternalObject(t *testing.T, forVersion unversioned.GroupVersion, item
 		func(j *authorizationapi.ClusterPolicyBinding, c fuzz.Continue) {
 			j.RoleBindings = make(map[string]*authorizationapi.ClusterRoleBinding)
 		},
+		// only cluster roles carry an aggregation rule
+		func(j *authorizationapi.Role, c fuzz.Continue) {
+			c.FuzzNoCustom(j)
+			j.AggregationRule = nil
+		},
 		func(j *authorizationapi.RoleBinding, c fuzz.Continue) {
 			c.FuzzNoCustom(j)
 			for i := range j.Subjects {
@@ -221,6 +226,11 @@ func fuzzInternalObject(t *testing.T, forVersion unversioned.GroupVersion, item
 			j.From.ResourceVersion = ""
 			j.From.FieldPath = ""
 		},
+		func(j *build.BuildConfigSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(j)
+			policies := []build.BuildRunPolicy{build.BuildRunPolicySerial, build.BuildRunPolicySerialLatestOnly, build.BuildRunPolicyParallel}
+			j.RunPolicy = policies[c.Intn(len(policies))]
+		},
 		func(j *build.BuildOutput, c fuzz.Continue) {
 			c.FuzzNoCustom(j)
 			if j.To != nil && (len(j.To.Kind) == 0 || j.To.Kind == "ImageStream") {
@@ -232,9 +242,9 @@ func fuzzInternalObject(t *testing.T, forVersion unversioned.GroupVersion, item
 		},
 		func(j *route.RouteSpec, c fuzz.Continue) {
 			c.FuzzNoCustom(j)
-			j.To = kapi.ObjectReference{
-				Kind: "Service",
-				Name: j.To.Name,
+			j.To.Kind = "Service"
+			for i := range j.AlternateBackends {
+				j.AlternateBackends[i].Kind = "Service"
 			}
 		},
 		func(j *route.TLSConfig, c fuzz.Continue) {
@@ -249,8 +259,8 @@ func fuzzInternalObject(t *testing.T, forVersion unversioned.GroupVersion, item
 		},
 		func(j *deploy.DeploymentStrategy, c fuzz.Continue) {
 			c.FuzzNoCustom(j)
-			j.RecreateParams, j.RollingParams, j.CustomParams = nil, nil, nil
-			strategyTypes := []deploy.DeploymentStrategyType{deploy.DeploymentStrategyTypeRecreate, deploy.DeploymentStrategyTypeRolling, deploy.DeploymentStrategyTypeCustom}
+			j.RecreateParams, j.RollingParams, j.CanaryParams, j.CustomParams = nil, nil, nil, nil
+			strategyTypes := []deploy.DeploymentStrategyType{deploy.DeploymentStrategyTypeRecreate, deploy.DeploymentStrategyTypeRolling, deploy.DeploymentStrategyTypeCanary, deploy.DeploymentStrategyTypeCustom}
 			j.Type = strategyTypes[c.Rand.Intn(len(strategyTypes))]
 			switch j.Type {
 			case deploy.DeploymentStrategyTypeRecreate:
@@ -305,6 +315,22 @@ func fuzzInternalObject(t *testing.T, forVersion unversioned.GroupVersion, item
 					params.MaxUnavailable = intstr.FromString(fmt.Sprintf("%d%%", c.RandUint64()))
 				}
 				j.RollingParams = params
+			case deploy.DeploymentStrategyTypeCanary:
+				params := &deploy.CanaryDeploymentStrategyParams{}
+				c.Fuzz(params)
+				if params.TimeoutSeconds == nil {
+					s := int64(120)
+					params.TimeoutSeconds = &s
+				}
+				if params.VerificationSeconds == nil {
+					s := int64(60)
+					params.VerificationSeconds = &s
+				}
+				params.Size = intstr.FromString(fmt.Sprintf("%d%%", c.Rand.Intn(100)+1))
+				defaultLifecycleHook(params.Pre)
+				defaultLifecycleHook(params.Verify)
+				defaultLifecycleHook(params.Post)
+				j.CanaryParams = params
 			}
 		},
 		func(j *deploy.DeploymentCauseImageTrigger, c fuzz.Continue) {
@@ -32,6 +32,27 @@ import (
 	reflect "reflect"
 )
 
+func autoConvert_api_AggregationRule_To_v1_AggregationRule(in *authorizationapi.AggregationRule, out *authorizationapiv1.AggregationRule, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*authorizationapi.AggregationRule))(in)
+	}
+	if in.ClusterRoleSelectors != nil {
+		out.ClusterRoleSelectors = make([]unversioned.LabelSelector, len(in.ClusterRoleSelectors))
+		for i := range in.ClusterRoleSelectors {
+			if err := s.Convert(&in.ClusterRoleSelectors[i], &out.ClusterRoleSelectors[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ClusterRoleSelectors = nil
+	}
+	return nil
+}
+
+func Convert_api_AggregationRule_To_v1_AggregationRule(in *authorizationapi.AggregationRule, out *authorizationapiv1.AggregationRule, s conversion.Scope) error {
+	return autoConvert_api_AggregationRule_To_v1_AggregationRule(in, out, s)
+}
+
 func autoConvert_api_ClusterPolicy_To_v1_ClusterPolicy(in *authorizationapi.ClusterPolicy, out *authorizationapiv1.ClusterPolicy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*authorizationapi.ClusterPolicy))(in)
@@ -132,6 +153,15 @@ func autoConvert_api_ClusterRole_To_v1_ClusterRole(in *authorizationapi.ClusterR
 	} else {
 		out.Rules = nil
 	}
+	// unable to generate simple pointer conversion for api.AggregationRule -> v1.AggregationRule
+	if in.AggregationRule != nil {
+		out.AggregationRule = new(authorizationapiv1.AggregationRule)
+		if err := Convert_api_AggregationRule_To_v1_AggregationRule(in.AggregationRule, out.AggregationRule, s); err != nil {
+			return err
+		}
+	} else {
+		out.AggregationRule = nil
+	}
 	return nil
 }
 
@@ -344,6 +374,38 @@ func autoConvert_api_PolicyRule_To_v1_PolicyRule(in *authorizationapi.PolicyRule
 	return nil
 }
 
+func autoConvert_api_ResourceAccessGrant_To_v1_ResourceAccessGrant(in *authorizationapi.ResourceAccessGrant, out *authorizationapiv1.ResourceAccessGrant, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*authorizationapi.ResourceAccessGrant))(in)
+	}
+	out.Namespace = in.Namespace
+	out.RoleBinding = in.RoleBinding
+	if err := Convert_api_ObjectReference_To_v1_ObjectReference(&in.RoleRef, &out.RoleRef, s); err != nil {
+		return err
+	}
+	if in.Users != nil {
+		out.Users = make([]string, len(in.Users))
+		for i := range in.Users {
+			out.Users[i] = in.Users[i]
+		}
+	} else {
+		out.Users = nil
+	}
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	return nil
+}
+
+func Convert_api_ResourceAccessGrant_To_v1_ResourceAccessGrant(in *authorizationapi.ResourceAccessGrant, out *authorizationapiv1.ResourceAccessGrant, s conversion.Scope) error {
+	return autoConvert_api_ResourceAccessGrant_To_v1_ResourceAccessGrant(in, out, s)
+}
+
 func autoConvert_api_ResourceAccessReview_To_v1_ResourceAccessReview(in *authorizationapi.ResourceAccessReview, out *authorizationapiv1.ResourceAccessReview, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*authorizationapi.ResourceAccessReview))(in)
@@ -359,6 +421,16 @@ func autoConvert_api_ResourceAccessReviewResponse_To_v1_ResourceAccessReviewResp
 	out.Namespace = in.Namespace
 	// in.Users has no peer in out
 	// in.Groups has no peer in out
+	if in.Grants != nil {
+		out.Grants = make([]authorizationapiv1.ResourceAccessGrant, len(in.Grants))
+		for i := range in.Grants {
+			if err := Convert_api_ResourceAccessGrant_To_v1_ResourceAccessGrant(&in.Grants[i], &out.Grants[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Grants = nil
+	}
 	return nil
 }
 
@@ -379,6 +451,7 @@ func autoConvert_api_Role_To_v1_Role(in *authorizationapi.Role, out *authorizati
 	} else {
 		out.Rules = nil
 	}
+	// in.AggregationRule has no peer in out
 	return nil
 }
 
@@ -481,6 +554,27 @@ func Convert_api_SubjectAccessReviewResponse_To_v1_SubjectAccessReviewResponse(i
 	return autoConvert_api_SubjectAccessReviewResponse_To_v1_SubjectAccessReviewResponse(in, out, s)
 }
 
+func autoConvert_v1_AggregationRule_To_api_AggregationRule(in *authorizationapiv1.AggregationRule, out *authorizationapi.AggregationRule, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*authorizationapiv1.AggregationRule))(in)
+	}
+	if in.ClusterRoleSelectors != nil {
+		out.ClusterRoleSelectors = make([]unversioned.LabelSelector, len(in.ClusterRoleSelectors))
+		for i := range in.ClusterRoleSelectors {
+			if err := s.Convert(&in.ClusterRoleSelectors[i], &out.ClusterRoleSelectors[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ClusterRoleSelectors = nil
+	}
+	return nil
+}
+
+func Convert_v1_AggregationRule_To_api_AggregationRule(in *authorizationapiv1.AggregationRule, out *authorizationapi.AggregationRule, s conversion.Scope) error {
+	return autoConvert_v1_AggregationRule_To_api_AggregationRule(in, out, s)
+}
+
 func autoConvert_v1_ClusterPolicy_To_api_ClusterPolicy(in *authorizationapiv1.ClusterPolicy, out *authorizationapi.ClusterPolicy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*authorizationapiv1.ClusterPolicy))(in)
@@ -581,6 +675,15 @@ func autoConvert_v1_ClusterRole_To_api_ClusterRole(in *authorizationapiv1.Cluste
 	} else {
 		out.Rules = nil
 	}
+	// unable to generate simple pointer conversion for v1.AggregationRule -> api.AggregationRule
+	if in.AggregationRule != nil {
+		out.AggregationRule = new(authorizationapi.AggregationRule)
+		if err := Convert_v1_AggregationRule_To_api_AggregationRule(in.AggregationRule, out.AggregationRule, s); err != nil {
+			return err
+		}
+	} else {
+		out.AggregationRule = nil
+	}
 	return nil
 }
 
@@ -795,6 +898,38 @@ func autoConvert_v1_PolicyRule_To_api_PolicyRule(in *authorizationapiv1.PolicyRu
 	return nil
 }
 
+func autoConvert_v1_ResourceAccessGrant_To_api_ResourceAccessGrant(in *authorizationapiv1.ResourceAccessGrant, out *authorizationapi.ResourceAccessGrant, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*authorizationapiv1.ResourceAccessGrant))(in)
+	}
+	out.Namespace = in.Namespace
+	out.RoleBinding = in.RoleBinding
+	if err := Convert_v1_ObjectReference_To_api_ObjectReference(&in.RoleRef, &out.RoleRef, s); err != nil {
+		return err
+	}
+	if in.Users != nil {
+		out.Users = make([]string, len(in.Users))
+		for i := range in.Users {
+			out.Users[i] = in.Users[i]
+		}
+	} else {
+		out.Users = nil
+	}
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	return nil
+}
+
+func Convert_v1_ResourceAccessGrant_To_api_ResourceAccessGrant(in *authorizationapiv1.ResourceAccessGrant, out *authorizationapi.ResourceAccessGrant, s conversion.Scope) error {
+	return autoConvert_v1_ResourceAccessGrant_To_api_ResourceAccessGrant(in, out, s)
+}
+
 func autoConvert_v1_ResourceAccessReview_To_api_ResourceAccessReview(in *authorizationapiv1.ResourceAccessReview, out *authorizationapi.ResourceAccessReview, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*authorizationapiv1.ResourceAccessReview))(in)
@@ -810,6 +945,16 @@ func autoConvert_v1_ResourceAccessReviewResponse_To_api_ResourceAccessReviewResp
 	out.Namespace = in.Namespace
 	// in.UsersSlice has no peer in out
 	// in.GroupsSlice has no peer in out
+	if in.Grants != nil {
+		out.Grants = make([]authorizationapi.ResourceAccessGrant, len(in.Grants))
+		for i := range in.Grants {
+			if err := Convert_v1_ResourceAccessGrant_To_api_ResourceAccessGrant(&in.Grants[i], &out.Grants[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Grants = nil
+	}
 	return nil
 }
 
@@ -1044,6 +1189,19 @@ func autoConvert_api_BuildConfigSpec_To_v1_BuildConfigSpec(in *buildapi.BuildCon
 	if err := Convert_api_BuildSpec_To_v1_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
+	out.RunPolicy = v1.BuildRunPolicy(in.RunPolicy)
 	return nil
 }
 
@@ -1140,6 +1298,19 @@ func autoConvert_api_BuildLogOptions_To_v1_BuildLogOptions(in *buildapi.BuildLog
 	} else {
 		out.Version = nil
 	}
+	if in.OffsetBytes != nil {
+		out.OffsetBytes = new(int64)
+		*out.OffsetBytes = *in.OffsetBytes
+	} else {
+		out.OffsetBytes = nil
+	}
+	if in.OffsetLines != nil {
+		out.OffsetLines = new(int64)
+		*out.OffsetLines = *in.OffsetLines
+	} else {
+		out.OffsetLines = nil
+	}
+	out.Format = v1.BuildLogFormat(in.Format)
 	return nil
 }
 
@@ -1351,6 +1522,14 @@ func autoConvert_api_BuildSpec_To_v1_BuildSpec(in *buildapi.BuildSpec, out *v1.B
 	if err := Convert_api_ResourceRequirements_To_v1_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
+	if in.NodeSelector != nil {
+		out.NodeSelector = make(map[string]string)
+		for key, val := range in.NodeSelector {
+			out.NodeSelector[key] = val
+		}
+	} else {
+		out.NodeSelector = nil
+	}
 	if err := Convert_api_BuildPostCommitSpec_To_v1_BuildPostCommitSpec(&in.PostCommit, &out.PostCommit, s); err != nil {
 		return err
 	}
@@ -1465,6 +1644,24 @@ func autoConvert_api_BuildTriggerPolicy_To_v1_BuildTriggerPolicy(in *buildapi.Bu
 	} else {
 		out.GenericWebHook = nil
 	}
+	// unable to generate simple pointer conversion for api.WebHookTrigger -> v1.WebHookTrigger
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(v1.WebHookTrigger)
+		if err := Convert_api_WebHookTrigger_To_v1_WebHookTrigger(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	// unable to generate simple pointer conversion for api.WebHookTrigger -> v1.WebHookTrigger
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(v1.WebHookTrigger)
+		if err := Convert_api_WebHookTrigger_To_v1_WebHookTrigger(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	// unable to generate simple pointer conversion for api.ImageChangeTrigger -> v1.ImageChangeTrigger
 	if in.ImageChange != nil {
 		out.ImageChange = new(v1.ImageChangeTrigger)
@@ -1575,6 +1772,21 @@ func autoConvert_api_GitBuildSource_To_v1_GitBuildSource(in *buildapi.GitBuildSo
 	} else {
 		out.HTTPSProxy = nil
 	}
+	if in.NoProxy != nil {
+		out.NoProxy = new(string)
+		*out.NoProxy = *in.NoProxy
+	} else {
+		out.NoProxy = nil
+	}
+	out.SkipSubmodules = in.SkipSubmodules
+	if in.SparseCheckoutPaths != nil {
+		out.SparseCheckoutPaths = make([]string, len(in.SparseCheckoutPaths))
+		for i := range in.SparseCheckoutPaths {
+			out.SparseCheckoutPaths[i] = in.SparseCheckoutPaths[i]
+		}
+	} else {
+		out.SparseCheckoutPaths = nil
+	}
 	return nil
 }
 
@@ -1727,6 +1939,15 @@ func autoConvert_api_SourceBuildStrategy_To_v1_SourceBuildStrategy(in *buildapi.
 	out.Scripts = in.Scripts
 	out.Incremental = in.Incremental
 	out.ForcePull = in.ForcePull
+	// unable to generate simple pointer conversion for api.LocalObjectReference -> v1.LocalObjectReference
+	if in.ArtifactCache != nil {
+		out.ArtifactCache = new(apiv1.LocalObjectReference)
+		if err := Convert_api_LocalObjectReference_To_v1_LocalObjectReference(in.ArtifactCache, out.ArtifactCache, s); err != nil {
+			return err
+		}
+	} else {
+		out.ArtifactCache = nil
+	}
 	return nil
 }
 
@@ -1764,6 +1985,8 @@ func autoConvert_api_WebHookTrigger_To_v1_WebHookTrigger(in *buildapi.WebHookTri
 		defaulting.(func(*buildapi.WebHookTrigger))(in)
 	}
 	out.Secret = in.Secret
+	out.RefFilter = in.RefFilter
+	out.PathFilter = in.PathFilter
 	return nil
 }
 
@@ -1881,6 +2104,19 @@ func autoConvert_v1_BuildConfigSpec_To_api_BuildConfigSpec(in *v1.BuildConfigSpe
 	if err := Convert_v1_BuildSpec_To_api_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
+	out.RunPolicy = buildapi.BuildRunPolicy(in.RunPolicy)
 	return nil
 }
 
@@ -1977,6 +2213,19 @@ func autoConvert_v1_BuildLogOptions_To_api_BuildLogOptions(in *v1.BuildLogOption
 	} else {
 		out.Version = nil
 	}
+	if in.OffsetBytes != nil {
+		out.OffsetBytes = new(int64)
+		*out.OffsetBytes = *in.OffsetBytes
+	} else {
+		out.OffsetBytes = nil
+	}
+	if in.OffsetLines != nil {
+		out.OffsetLines = new(int64)
+		*out.OffsetLines = *in.OffsetLines
+	} else {
+		out.OffsetLines = nil
+	}
+	out.Format = buildapi.BuildLogFormat(in.Format)
 	return nil
 }
 
@@ -2189,6 +2438,14 @@ func autoConvert_v1_BuildSpec_To_api_BuildSpec(in *v1.BuildSpec, out *buildapi.B
 	if err := Convert_v1_ResourceRequirements_To_api_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
+	if in.NodeSelector != nil {
+		out.NodeSelector = make(map[string]string)
+		for key, val := range in.NodeSelector {
+			out.NodeSelector[key] = val
+		}
+	} else {
+		out.NodeSelector = nil
+	}
 	if err := Convert_v1_BuildPostCommitSpec_To_api_BuildPostCommitSpec(&in.PostCommit, &out.PostCommit, s); err != nil {
 		return err
 	}
@@ -2304,6 +2561,24 @@ func autoConvert_v1_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *v1.BuildTri
 	} else {
 		out.GenericWebHook = nil
 	}
+	// unable to generate simple pointer conversion for v1.WebHookTrigger -> api.WebHookTrigger
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(buildapi.WebHookTrigger)
+		if err := Convert_v1_WebHookTrigger_To_api_WebHookTrigger(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	// unable to generate simple pointer conversion for v1.WebHookTrigger -> api.WebHookTrigger
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(buildapi.WebHookTrigger)
+		if err := Convert_v1_WebHookTrigger_To_api_WebHookTrigger(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	// unable to generate simple pointer conversion for v1.ImageChangeTrigger -> api.ImageChangeTrigger
 	if in.ImageChange != nil {
 		out.ImageChange = new(buildapi.ImageChangeTrigger)
@@ -2414,6 +2689,21 @@ func autoConvert_v1_GitBuildSource_To_api_GitBuildSource(in *v1.GitBuildSource,
 	} else {
 		out.HTTPSProxy = nil
 	}
+	if in.NoProxy != nil {
+		out.NoProxy = new(string)
+		*out.NoProxy = *in.NoProxy
+	} else {
+		out.NoProxy = nil
+	}
+	out.SkipSubmodules = in.SkipSubmodules
+	if in.SparseCheckoutPaths != nil {
+		out.SparseCheckoutPaths = make([]string, len(in.SparseCheckoutPaths))
+		for i := range in.SparseCheckoutPaths {
+			out.SparseCheckoutPaths[i] = in.SparseCheckoutPaths[i]
+		}
+	} else {
+		out.SparseCheckoutPaths = nil
+	}
 	return nil
 }
 
@@ -2566,6 +2856,15 @@ func autoConvert_v1_SourceBuildStrategy_To_api_SourceBuildStrategy(in *v1.Source
 	out.Scripts = in.Scripts
 	out.Incremental = in.Incremental
 	out.ForcePull = in.ForcePull
+	// unable to generate simple pointer conversion for v1.LocalObjectReference -> api.LocalObjectReference
+	if in.ArtifactCache != nil {
+		out.ArtifactCache = new(api.LocalObjectReference)
+		if err := Convert_v1_LocalObjectReference_To_api_LocalObjectReference(in.ArtifactCache, out.ArtifactCache, s); err != nil {
+			return err
+		}
+	} else {
+		out.ArtifactCache = nil
+	}
 	return nil
 }
 
@@ -2604,6 +2903,8 @@ func autoConvert_v1_WebHookTrigger_To_api_WebHookTrigger(in *v1.WebHookTrigger,
 		defaulting.(func(*v1.WebHookTrigger))(in)
 	}
 	out.Secret = in.Secret
+	out.RefFilter = in.RefFilter
+	out.PathFilter = in.PathFilter
 	return nil
 }
 
@@ -2611,6 +2912,59 @@ func Convert_v1_WebHookTrigger_To_api_WebHookTrigger(in *v1.WebHookTrigger, out
 	return autoConvert_v1_WebHookTrigger_To_api_WebHookTrigger(in, out, s)
 }
 
+func autoConvert_api_CanaryDeploymentStrategyParams_To_v1_CanaryDeploymentStrategyParams(in *deployapi.CanaryDeploymentStrategyParams, out *deployapiv1.CanaryDeploymentStrategyParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.CanaryDeploymentStrategyParams))(in)
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if err := api.Convert_intstr_IntOrString_To_intstr_IntOrString(&in.Size, &out.Size, s); err != nil {
+		return err
+	}
+	if in.VerificationSeconds != nil {
+		out.VerificationSeconds = new(int64)
+		*out.VerificationSeconds = *in.VerificationSeconds
+	} else {
+		out.VerificationSeconds = nil
+	}
+	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1.LifecycleHook)
+		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	if in.Verify != nil {
+		out.Verify = new(deployapiv1.LifecycleHook)
+		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Verify, out.Verify, s); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	if in.Post != nil {
+		out.Post = new(deployapiv1.LifecycleHook)
+		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Post, out.Post, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func Convert_api_CanaryDeploymentStrategyParams_To_v1_CanaryDeploymentStrategyParams(in *deployapi.CanaryDeploymentStrategyParams, out *deployapiv1.CanaryDeploymentStrategyParams, s conversion.Scope) error {
+	return autoConvert_api_CanaryDeploymentStrategyParams_To_v1_CanaryDeploymentStrategyParams(in, out, s)
+}
+
 func autoConvert_api_CustomDeploymentStrategyParams_To_v1_CustomDeploymentStrategyParams(in *deployapi.CustomDeploymentStrategyParams, out *deployapiv1.CustomDeploymentStrategyParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.CustomDeploymentStrategyParams))(in)
@@ -2676,6 +3030,27 @@ func Convert_api_DeploymentCauseImageTrigger_To_v1_DeploymentCauseImageTrigger(i
 	return autoConvert_api_DeploymentCauseImageTrigger_To_v1_DeploymentCauseImageTrigger(in, out, s)
 }
 
+func autoConvert_api_DeploymentCondition_To_v1_DeploymentCondition(in *deployapi.DeploymentCondition, out *deployapiv1.DeploymentCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentCondition))(in)
+	}
+	out.Type = deployapiv1.DeploymentConditionType(in.Type)
+	out.Status = apiv1.ConditionStatus(in.Status)
+	if err := api.Convert_unversioned_Time_To_unversioned_Time(&in.LastUpdateTime, &out.LastUpdateTime, s); err != nil {
+		return err
+	}
+	if err := api.Convert_unversioned_Time_To_unversioned_Time(&in.LastTransitionTime, &out.LastTransitionTime, s); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func Convert_api_DeploymentCondition_To_v1_DeploymentCondition(in *deployapi.DeploymentCondition, out *deployapiv1.DeploymentCondition, s conversion.Scope) error {
+	return autoConvert_api_DeploymentCondition_To_v1_DeploymentCondition(in, out, s)
+}
+
 func autoConvert_api_DeploymentConfig_To_v1_DeploymentConfig(in *deployapi.DeploymentConfig, out *deployapiv1.DeploymentConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.DeploymentConfig))(in)
@@ -2771,6 +3146,13 @@ func autoConvert_api_DeploymentConfigSpec_To_v1_DeploymentConfigSpec(in *deploya
 	}
 	out.Replicas = in.Replicas
 	out.Test = in.Test
+	out.Paused = in.Paused
+	if in.ProgressDeadlineSeconds != nil {
+		out.ProgressDeadlineSeconds = new(int64)
+		*out.ProgressDeadlineSeconds = *in.ProgressDeadlineSeconds
+	} else {
+		out.ProgressDeadlineSeconds = nil
+	}
 	if in.Selector != nil {
 		out.Selector = make(map[string]string)
 		for key, val := range in.Selector {
@@ -2809,6 +3191,16 @@ func autoConvert_api_DeploymentConfigStatus_To_v1_DeploymentConfigStatus(in *dep
 	} else {
 		out.Details = nil
 	}
+	if in.Conditions != nil {
+		out.Conditions = make([]deployapiv1.DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := Convert_api_DeploymentCondition_To_v1_DeploymentCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -2891,6 +3283,8 @@ func autoConvert_api_DeploymentLogOptions_To_v1_DeploymentLogOptions(in *deploya
 	} else {
 		out.Version = nil
 	}
+	out.AllPods = in.AllPods
+	out.DeploymentPods = in.DeploymentPods
 	return nil
 }
 
@@ -2898,6 +3292,18 @@ func Convert_api_DeploymentLogOptions_To_v1_DeploymentLogOptions(in *deployapi.D
 	return autoConvert_api_DeploymentLogOptions_To_v1_DeploymentLogOptions(in, out, s)
 }
 
+func autoConvert_api_DeploymentRequest_To_v1_DeploymentRequest(in *deployapi.DeploymentRequest, out *deployapiv1.DeploymentRequest, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentRequest))(in)
+	}
+	out.Name = in.Name
+	return nil
+}
+
+func Convert_api_DeploymentRequest_To_v1_DeploymentRequest(in *deployapi.DeploymentRequest, out *deployapiv1.DeploymentRequest, s conversion.Scope) error {
+	return autoConvert_api_DeploymentRequest_To_v1_DeploymentRequest(in, out, s)
+}
+
 func autoConvert_api_DeploymentStrategy_To_v1_DeploymentStrategy(in *deployapi.DeploymentStrategy, out *deployapiv1.DeploymentStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.DeploymentStrategy))(in)
@@ -2929,6 +3335,15 @@ func autoConvert_api_DeploymentStrategy_To_v1_DeploymentStrategy(in *deployapi.D
 	} else {
 		out.RollingParams = nil
 	}
+	// unable to generate simple pointer conversion for api.CanaryDeploymentStrategyParams -> v1.CanaryDeploymentStrategyParams
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapiv1.CanaryDeploymentStrategyParams)
+		if err := Convert_api_CanaryDeploymentStrategyParams_To_v1_CanaryDeploymentStrategyParams(in.CanaryParams, out.CanaryParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
 	if err := Convert_api_ResourceRequirements_To_v1_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
@@ -2955,6 +3370,18 @@ func Convert_api_DeploymentStrategy_To_v1_DeploymentStrategy(in *deployapi.Deplo
 	return autoConvert_api_DeploymentStrategy_To_v1_DeploymentStrategy(in, out, s)
 }
 
+func autoConvert_api_DeploymentTriggerConfigChangeParams_To_v1_DeploymentTriggerConfigChangeParams(in *deployapi.DeploymentTriggerConfigChangeParams, out *deployapiv1.DeploymentTriggerConfigChangeParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentTriggerConfigChangeParams))(in)
+	}
+	out.DebounceSeconds = in.DebounceSeconds
+	return nil
+}
+
+func Convert_api_DeploymentTriggerConfigChangeParams_To_v1_DeploymentTriggerConfigChangeParams(in *deployapi.DeploymentTriggerConfigChangeParams, out *deployapiv1.DeploymentTriggerConfigChangeParams, s conversion.Scope) error {
+	return autoConvert_api_DeploymentTriggerConfigChangeParams_To_v1_DeploymentTriggerConfigChangeParams(in, out, s)
+}
+
 func autoConvert_api_DeploymentTriggerImageChangeParams_To_v1_DeploymentTriggerImageChangeParams(in *deployapi.DeploymentTriggerImageChangeParams, out *deployapiv1.DeploymentTriggerImageChangeParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.DeploymentTriggerImageChangeParams))(in)
@@ -2988,6 +3415,15 @@ func autoConvert_api_DeploymentTriggerPolicy_To_v1_DeploymentTriggerPolicy(in *d
 	} else {
 		out.ImageChangeParams = nil
 	}
+	// unable to generate simple pointer conversion for api.DeploymentTriggerConfigChangeParams -> v1.DeploymentTriggerConfigChangeParams
+	if in.ConfigChangeParams != nil {
+		out.ConfigChangeParams = new(deployapiv1.DeploymentTriggerConfigChangeParams)
+		if err := Convert_api_DeploymentTriggerConfigChangeParams_To_v1_DeploymentTriggerConfigChangeParams(in.ConfigChangeParams, out.ConfigChangeParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigChangeParams = nil
+	}
 	return nil
 }
 
@@ -3018,6 +3454,7 @@ func autoConvert_api_ExecNewPodHook_To_v1_ExecNewPodHook(in *deployapi.ExecNewPo
 		out.Env = nil
 	}
 	out.ContainerName = in.ContainerName
+	out.Image = in.Image
 	if in.Volumes != nil {
 		out.Volumes = make([]string, len(in.Volumes))
 		for i := range in.Volumes {
@@ -3178,6 +3615,59 @@ func Convert_api_TagImageHook_To_v1_TagImageHook(in *deployapi.TagImageHook, out
 	return autoConvert_api_TagImageHook_To_v1_TagImageHook(in, out, s)
 }
 
+func autoConvert_v1_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams(in *deployapiv1.CanaryDeploymentStrategyParams, out *deployapi.CanaryDeploymentStrategyParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.CanaryDeploymentStrategyParams))(in)
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if err := api.Convert_intstr_IntOrString_To_intstr_IntOrString(&in.Size, &out.Size, s); err != nil {
+		return err
+	}
+	if in.VerificationSeconds != nil {
+		out.VerificationSeconds = new(int64)
+		*out.VerificationSeconds = *in.VerificationSeconds
+	} else {
+		out.VerificationSeconds = nil
+	}
+	// unable to generate simple pointer conversion for v1.LifecycleHook -> api.LifecycleHook
+	if in.Pre != nil {
+		out.Pre = new(deployapi.LifecycleHook)
+		if err := Convert_v1_LifecycleHook_To_api_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	// unable to generate simple pointer conversion for v1.LifecycleHook -> api.LifecycleHook
+	if in.Verify != nil {
+		out.Verify = new(deployapi.LifecycleHook)
+		if err := Convert_v1_LifecycleHook_To_api_LifecycleHook(in.Verify, out.Verify, s); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	// unable to generate simple pointer conversion for v1.LifecycleHook -> api.LifecycleHook
+	if in.Post != nil {
+		out.Post = new(deployapi.LifecycleHook)
+		if err := Convert_v1_LifecycleHook_To_api_LifecycleHook(in.Post, out.Post, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func Convert_v1_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams(in *deployapiv1.CanaryDeploymentStrategyParams, out *deployapi.CanaryDeploymentStrategyParams, s conversion.Scope) error {
+	return autoConvert_v1_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams(in, out, s)
+}
+
 func autoConvert_v1_CustomDeploymentStrategyParams_To_api_CustomDeploymentStrategyParams(in *deployapiv1.CustomDeploymentStrategyParams, out *deployapi.CustomDeploymentStrategyParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.CustomDeploymentStrategyParams))(in)
@@ -3243,6 +3733,27 @@ func Convert_v1_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrigger(i
 	return autoConvert_v1_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrigger(in, out, s)
 }
 
+func autoConvert_v1_DeploymentCondition_To_api_DeploymentCondition(in *deployapiv1.DeploymentCondition, out *deployapi.DeploymentCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.DeploymentCondition))(in)
+	}
+	out.Type = deployapi.DeploymentConditionType(in.Type)
+	out.Status = api.ConditionStatus(in.Status)
+	if err := api.Convert_unversioned_Time_To_unversioned_Time(&in.LastUpdateTime, &out.LastUpdateTime, s); err != nil {
+		return err
+	}
+	if err := api.Convert_unversioned_Time_To_unversioned_Time(&in.LastTransitionTime, &out.LastTransitionTime, s); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func Convert_v1_DeploymentCondition_To_api_DeploymentCondition(in *deployapiv1.DeploymentCondition, out *deployapi.DeploymentCondition, s conversion.Scope) error {
+	return autoConvert_v1_DeploymentCondition_To_api_DeploymentCondition(in, out, s)
+}
+
 func autoConvert_v1_DeploymentConfig_To_api_DeploymentConfig(in *deployapiv1.DeploymentConfig, out *deployapi.DeploymentConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.DeploymentConfig))(in)
@@ -3338,6 +3849,13 @@ func autoConvert_v1_DeploymentConfigSpec_To_api_DeploymentConfigSpec(in *deploya
 	}
 	out.Replicas = in.Replicas
 	out.Test = in.Test
+	out.Paused = in.Paused
+	if in.ProgressDeadlineSeconds != nil {
+		out.ProgressDeadlineSeconds = new(int64)
+		*out.ProgressDeadlineSeconds = *in.ProgressDeadlineSeconds
+	} else {
+		out.ProgressDeadlineSeconds = nil
+	}
 	if in.Selector != nil {
 		out.Selector = make(map[string]string)
 		for key, val := range in.Selector {
@@ -3376,6 +3894,16 @@ func autoConvert_v1_DeploymentConfigStatus_To_api_DeploymentConfigStatus(in *dep
 	} else {
 		out.Details = nil
 	}
+	if in.Conditions != nil {
+		out.Conditions = make([]deployapi.DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := Convert_v1_DeploymentCondition_To_api_DeploymentCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -3458,6 +3986,8 @@ func autoConvert_v1_DeploymentLogOptions_To_api_DeploymentLogOptions(in *deploya
 	} else {
 		out.Version = nil
 	}
+	out.AllPods = in.AllPods
+	out.DeploymentPods = in.DeploymentPods
 	return nil
 }
 
@@ -3465,6 +3995,18 @@ func Convert_v1_DeploymentLogOptions_To_api_DeploymentLogOptions(in *deployapiv1
 	return autoConvert_v1_DeploymentLogOptions_To_api_DeploymentLogOptions(in, out, s)
 }
 
+func autoConvert_v1_DeploymentRequest_To_api_DeploymentRequest(in *deployapiv1.DeploymentRequest, out *deployapi.DeploymentRequest, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.DeploymentRequest))(in)
+	}
+	out.Name = in.Name
+	return nil
+}
+
+func Convert_v1_DeploymentRequest_To_api_DeploymentRequest(in *deployapiv1.DeploymentRequest, out *deployapi.DeploymentRequest, s conversion.Scope) error {
+	return autoConvert_v1_DeploymentRequest_To_api_DeploymentRequest(in, out, s)
+}
+
 func autoConvert_v1_DeploymentStrategy_To_api_DeploymentStrategy(in *deployapiv1.DeploymentStrategy, out *deployapi.DeploymentStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.DeploymentStrategy))(in)
@@ -3496,6 +4038,15 @@ func autoConvert_v1_DeploymentStrategy_To_api_DeploymentStrategy(in *deployapiv1
 	} else {
 		out.RollingParams = nil
 	}
+	// unable to generate simple pointer conversion for v1.CanaryDeploymentStrategyParams -> api.CanaryDeploymentStrategyParams
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapi.CanaryDeploymentStrategyParams)
+		if err := Convert_v1_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams(in.CanaryParams, out.CanaryParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
 	if err := Convert_v1_ResourceRequirements_To_api_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
@@ -3522,6 +4073,18 @@ func Convert_v1_DeploymentStrategy_To_api_DeploymentStrategy(in *deployapiv1.Dep
 	return autoConvert_v1_DeploymentStrategy_To_api_DeploymentStrategy(in, out, s)
 }
 
+func autoConvert_v1_DeploymentTriggerConfigChangeParams_To_api_DeploymentTriggerConfigChangeParams(in *deployapiv1.DeploymentTriggerConfigChangeParams, out *deployapi.DeploymentTriggerConfigChangeParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.DeploymentTriggerConfigChangeParams))(in)
+	}
+	out.DebounceSeconds = in.DebounceSeconds
+	return nil
+}
+
+func Convert_v1_DeploymentTriggerConfigChangeParams_To_api_DeploymentTriggerConfigChangeParams(in *deployapiv1.DeploymentTriggerConfigChangeParams, out *deployapi.DeploymentTriggerConfigChangeParams, s conversion.Scope) error {
+	return autoConvert_v1_DeploymentTriggerConfigChangeParams_To_api_DeploymentTriggerConfigChangeParams(in, out, s)
+}
+
 func autoConvert_v1_DeploymentTriggerImageChangeParams_To_api_DeploymentTriggerImageChangeParams(in *deployapiv1.DeploymentTriggerImageChangeParams, out *deployapi.DeploymentTriggerImageChangeParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.DeploymentTriggerImageChangeParams))(in)
@@ -3555,6 +4118,15 @@ func autoConvert_v1_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy(in *d
 	} else {
 		out.ImageChangeParams = nil
 	}
+	// unable to generate simple pointer conversion for v1.DeploymentTriggerConfigChangeParams -> api.DeploymentTriggerConfigChangeParams
+	if in.ConfigChangeParams != nil {
+		out.ConfigChangeParams = new(deployapi.DeploymentTriggerConfigChangeParams)
+		if err := Convert_v1_DeploymentTriggerConfigChangeParams_To_api_DeploymentTriggerConfigChangeParams(in.ConfigChangeParams, out.ConfigChangeParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigChangeParams = nil
+	}
 	return nil
 }
 
@@ -3585,6 +4157,7 @@ func autoConvert_v1_ExecNewPodHook_To_api_ExecNewPodHook(in *deployapiv1.ExecNew
 		out.Env = nil
 	}
 	out.ContainerName = in.ContainerName
+	out.Image = in.Image
 	if in.Volumes != nil {
 		out.Volumes = make([]string, len(in.Volumes))
 		for i := range in.Volumes {
@@ -3764,6 +4337,8 @@ func autoConvert_api_Image_To_v1_Image(in *imageapi.Image, out *imageapiv1.Image
 	} else {
 		out.DockerImageLayers = nil
 	}
+	out.DockerImageConfig = in.DockerImageConfig
+	out.DockerImageManifestMediaType = in.DockerImageManifestMediaType
 	return nil
 }
 
@@ -4204,6 +4779,15 @@ func autoConvert_api_TagReference_To_v1_TagReference(in *imageapi.TagReference,
 	if err := Convert_api_TagImportPolicy_To_v1_TagImportPolicy(&in.ImportPolicy, &out.ImportPolicy, s); err != nil {
 		return err
 	}
+	if in.HistoryLimit != nil {
+		out.HistoryLimit = new(int32)
+		*out.HistoryLimit = *in.HistoryLimit
+	} else {
+		out.HistoryLimit = nil
+	}
+	if err := Convert_api_TagReferencePolicy_To_v1_TagReferencePolicy(&in.ReferencePolicy, &out.ReferencePolicy, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4211,6 +4795,18 @@ func Convert_api_TagReference_To_v1_TagReference(in *imageapi.TagReference, out
 	return autoConvert_api_TagReference_To_v1_TagReference(in, out, s)
 }
 
+func autoConvert_api_TagReferencePolicy_To_v1_TagReferencePolicy(in *imageapi.TagReferencePolicy, out *imageapiv1.TagReferencePolicy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapi.TagReferencePolicy))(in)
+	}
+	out.Type = imageapiv1.TagReferencePolicyType(in.Type)
+	return nil
+}
+
+func Convert_api_TagReferencePolicy_To_v1_TagReferencePolicy(in *imageapi.TagReferencePolicy, out *imageapiv1.TagReferencePolicy, s conversion.Scope) error {
+	return autoConvert_api_TagReferencePolicy_To_v1_TagReferencePolicy(in, out, s)
+}
+
 func autoConvert_v1_Image_To_api_Image(in *imageapiv1.Image, out *imageapi.Image, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*imageapiv1.Image))(in)
@@ -4234,6 +4830,8 @@ func autoConvert_v1_Image_To_api_Image(in *imageapiv1.Image, out *imageapi.Image
 	} else {
 		out.DockerImageLayers = nil
 	}
+	out.DockerImageConfig = in.DockerImageConfig
+	out.DockerImageManifestMediaType = in.DockerImageManifestMediaType
 	return nil
 }
 
@@ -4673,6 +5271,15 @@ func autoConvert_v1_TagReference_To_api_TagReference(in *imageapiv1.TagReference
 	if err := Convert_v1_TagImportPolicy_To_api_TagImportPolicy(&in.ImportPolicy, &out.ImportPolicy, s); err != nil {
 		return err
 	}
+	if in.HistoryLimit != nil {
+		out.HistoryLimit = new(int32)
+		*out.HistoryLimit = *in.HistoryLimit
+	} else {
+		out.HistoryLimit = nil
+	}
+	if err := Convert_v1_TagReferencePolicy_To_api_TagReferencePolicy(&in.ReferencePolicy, &out.ReferencePolicy, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4680,6 +5287,46 @@ func Convert_v1_TagReference_To_api_TagReference(in *imageapiv1.TagReference, ou
 	return autoConvert_v1_TagReference_To_api_TagReference(in, out, s)
 }
 
+func autoConvert_v1_TagReferencePolicy_To_api_TagReferencePolicy(in *imageapiv1.TagReferencePolicy, out *imageapi.TagReferencePolicy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapiv1.TagReferencePolicy))(in)
+	}
+	out.Type = imageapi.TagReferencePolicyType(in.Type)
+	return nil
+}
+
+func Convert_v1_TagReferencePolicy_To_api_TagReferencePolicy(in *imageapiv1.TagReferencePolicy, out *imageapi.TagReferencePolicy, s conversion.Scope) error {
+	return autoConvert_v1_TagReferencePolicy_To_api_TagReferencePolicy(in, out, s)
+}
+
+func autoConvert_api_ClusterRoleScopeRestriction_To_v1_ClusterRoleScopeRestriction(in *oauthapi.ClusterRoleScopeRestriction, out *oauthapiv1.ClusterRoleScopeRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*oauthapi.ClusterRoleScopeRestriction))(in)
+	}
+	if in.RoleNames != nil {
+		out.RoleNames = make([]string, len(in.RoleNames))
+		for i := range in.RoleNames {
+			out.RoleNames[i] = in.RoleNames[i]
+		}
+	} else {
+		out.RoleNames = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	out.AllowEscalation = in.AllowEscalation
+	return nil
+}
+
+func Convert_api_ClusterRoleScopeRestriction_To_v1_ClusterRoleScopeRestriction(in *oauthapi.ClusterRoleScopeRestriction, out *oauthapiv1.ClusterRoleScopeRestriction, s conversion.Scope) error {
+	return autoConvert_api_ClusterRoleScopeRestriction_To_v1_ClusterRoleScopeRestriction(in, out, s)
+}
+
 func autoConvert_api_OAuthAccessToken_To_v1_OAuthAccessToken(in *oauthapi.OAuthAccessToken, out *oauthapiv1.OAuthAccessToken, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*oauthapi.OAuthAccessToken))(in)
@@ -4702,6 +5349,7 @@ func autoConvert_api_OAuthAccessToken_To_v1_OAuthAccessToken(in *oauthapi.OAuthA
 	out.UserUID = in.UserUID
 	out.AuthorizeToken = in.AuthorizeToken
 	out.RefreshToken = in.RefreshToken
+	out.InactivityTimeoutSeconds = in.InactivityTimeoutSeconds
 	return nil
 }
 
@@ -4754,6 +5402,8 @@ func autoConvert_api_OAuthAuthorizeToken_To_v1_OAuthAuthorizeToken(in *oauthapi.
 	out.State = in.State
 	out.UserName = in.UserName
 	out.UserUID = in.UserUID
+	out.CodeChallenge = in.CodeChallenge
+	out.CodeChallengeMethod = in.CodeChallengeMethod
 	return nil
 }
 
@@ -4802,6 +5452,28 @@ func autoConvert_api_OAuthClient_To_v1_OAuthClient(in *oauthapi.OAuthClient, out
 	} else {
 		out.RedirectURIs = nil
 	}
+	if in.AccessTokenMaxAgeSeconds != nil {
+		out.AccessTokenMaxAgeSeconds = new(int32)
+		*out.AccessTokenMaxAgeSeconds = *in.AccessTokenMaxAgeSeconds
+	} else {
+		out.AccessTokenMaxAgeSeconds = nil
+	}
+	if in.AccessTokenInactivityTimeoutSeconds != nil {
+		out.AccessTokenInactivityTimeoutSeconds = new(int32)
+		*out.AccessTokenInactivityTimeoutSeconds = *in.AccessTokenInactivityTimeoutSeconds
+	} else {
+		out.AccessTokenInactivityTimeoutSeconds = nil
+	}
+	if in.ScopeRestrictions != nil {
+		out.ScopeRestrictions = make([]oauthapiv1.ScopeRestriction, len(in.ScopeRestrictions))
+		for i := range in.ScopeRestrictions {
+			if err := Convert_api_ScopeRestriction_To_v1_ScopeRestriction(&in.ScopeRestrictions[i], &out.ScopeRestrictions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ScopeRestrictions = nil
+	}
 	return nil
 }
 
@@ -4882,6 +5554,62 @@ func Convert_api_OAuthClientList_To_v1_OAuthClientList(in *oauthapi.OAuthClientL
 	return autoConvert_api_OAuthClientList_To_v1_OAuthClientList(in, out, s)
 }
 
+func autoConvert_api_ScopeRestriction_To_v1_ScopeRestriction(in *oauthapi.ScopeRestriction, out *oauthapiv1.ScopeRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*oauthapi.ScopeRestriction))(in)
+	}
+	if in.ExactValues != nil {
+		out.ExactValues = make([]string, len(in.ExactValues))
+		for i := range in.ExactValues {
+			out.ExactValues[i] = in.ExactValues[i]
+		}
+	} else {
+		out.ExactValues = nil
+	}
+	// unable to generate simple pointer conversion for api.ClusterRoleScopeRestriction -> v1.ClusterRoleScopeRestriction
+	if in.ClusterRole != nil {
+		out.ClusterRole = new(oauthapiv1.ClusterRoleScopeRestriction)
+		if err := Convert_api_ClusterRoleScopeRestriction_To_v1_ClusterRoleScopeRestriction(in.ClusterRole, out.ClusterRole, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClusterRole = nil
+	}
+	return nil
+}
+
+func Convert_api_ScopeRestriction_To_v1_ScopeRestriction(in *oauthapi.ScopeRestriction, out *oauthapiv1.ScopeRestriction, s conversion.Scope) error {
+	return autoConvert_api_ScopeRestriction_To_v1_ScopeRestriction(in, out, s)
+}
+
+func autoConvert_v1_ClusterRoleScopeRestriction_To_api_ClusterRoleScopeRestriction(in *oauthapiv1.ClusterRoleScopeRestriction, out *oauthapi.ClusterRoleScopeRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*oauthapiv1.ClusterRoleScopeRestriction))(in)
+	}
+	if in.RoleNames != nil {
+		out.RoleNames = make([]string, len(in.RoleNames))
+		for i := range in.RoleNames {
+			out.RoleNames[i] = in.RoleNames[i]
+		}
+	} else {
+		out.RoleNames = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	out.AllowEscalation = in.AllowEscalation
+	return nil
+}
+
+func Convert_v1_ClusterRoleScopeRestriction_To_api_ClusterRoleScopeRestriction(in *oauthapiv1.ClusterRoleScopeRestriction, out *oauthapi.ClusterRoleScopeRestriction, s conversion.Scope) error {
+	return autoConvert_v1_ClusterRoleScopeRestriction_To_api_ClusterRoleScopeRestriction(in, out, s)
+}
+
 func autoConvert_v1_OAuthAccessToken_To_api_OAuthAccessToken(in *oauthapiv1.OAuthAccessToken, out *oauthapi.OAuthAccessToken, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*oauthapiv1.OAuthAccessToken))(in)
@@ -4904,6 +5632,7 @@ func autoConvert_v1_OAuthAccessToken_To_api_OAuthAccessToken(in *oauthapiv1.OAut
 	out.UserUID = in.UserUID
 	out.AuthorizeToken = in.AuthorizeToken
 	out.RefreshToken = in.RefreshToken
+	out.InactivityTimeoutSeconds = in.InactivityTimeoutSeconds
 	return nil
 }
 
@@ -4956,6 +5685,8 @@ func autoConvert_v1_OAuthAuthorizeToken_To_api_OAuthAuthorizeToken(in *oauthapiv
 	out.State = in.State
 	out.UserName = in.UserName
 	out.UserUID = in.UserUID
+	out.CodeChallenge = in.CodeChallenge
+	out.CodeChallengeMethod = in.CodeChallengeMethod
 	return nil
 }
 
@@ -5004,6 +5735,28 @@ func autoConvert_v1_OAuthClient_To_api_OAuthClient(in *oauthapiv1.OAuthClient, o
 	} else {
 		out.RedirectURIs = nil
 	}
+	if in.AccessTokenMaxAgeSeconds != nil {
+		out.AccessTokenMaxAgeSeconds = new(int32)
+		*out.AccessTokenMaxAgeSeconds = *in.AccessTokenMaxAgeSeconds
+	} else {
+		out.AccessTokenMaxAgeSeconds = nil
+	}
+	if in.AccessTokenInactivityTimeoutSeconds != nil {
+		out.AccessTokenInactivityTimeoutSeconds = new(int32)
+		*out.AccessTokenInactivityTimeoutSeconds = *in.AccessTokenInactivityTimeoutSeconds
+	} else {
+		out.AccessTokenInactivityTimeoutSeconds = nil
+	}
+	if in.ScopeRestrictions != nil {
+		out.ScopeRestrictions = make([]oauthapi.ScopeRestriction, len(in.ScopeRestrictions))
+		for i := range in.ScopeRestrictions {
+			if err := Convert_v1_ScopeRestriction_To_api_ScopeRestriction(&in.ScopeRestrictions[i], &out.ScopeRestrictions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ScopeRestrictions = nil
+	}
 	return nil
 }
 
@@ -5084,6 +5837,34 @@ func Convert_v1_OAuthClientList_To_api_OAuthClientList(in *oauthapiv1.OAuthClien
 	return autoConvert_v1_OAuthClientList_To_api_OAuthClientList(in, out, s)
 }
 
+func autoConvert_v1_ScopeRestriction_To_api_ScopeRestriction(in *oauthapiv1.ScopeRestriction, out *oauthapi.ScopeRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*oauthapiv1.ScopeRestriction))(in)
+	}
+	if in.ExactValues != nil {
+		out.ExactValues = make([]string, len(in.ExactValues))
+		for i := range in.ExactValues {
+			out.ExactValues[i] = in.ExactValues[i]
+		}
+	} else {
+		out.ExactValues = nil
+	}
+	// unable to generate simple pointer conversion for v1.ClusterRoleScopeRestriction -> api.ClusterRoleScopeRestriction
+	if in.ClusterRole != nil {
+		out.ClusterRole = new(oauthapi.ClusterRoleScopeRestriction)
+		if err := Convert_v1_ClusterRoleScopeRestriction_To_api_ClusterRoleScopeRestriction(in.ClusterRole, out.ClusterRole, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClusterRole = nil
+	}
+	return nil
+}
+
+func Convert_v1_ScopeRestriction_To_api_ScopeRestriction(in *oauthapiv1.ScopeRestriction, out *oauthapi.ScopeRestriction, s conversion.Scope) error {
+	return autoConvert_v1_ScopeRestriction_To_api_ScopeRestriction(in, out, s)
+}
+
 func autoConvert_api_Project_To_v1_Project(in *projectapi.Project, out *projectapiv1.Project, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*projectapi.Project))(in)
@@ -5286,6 +6067,19 @@ func Convert_api_Route_To_v1_Route(in *routeapi.Route, out *routeapiv1.Route, s
 	return autoConvert_api_Route_To_v1_Route(in, out, s)
 }
 
+func autoConvert_api_RouteHealthCheck_To_v1_RouteHealthCheck(in *routeapi.RouteHealthCheck, out *routeapiv1.RouteHealthCheck, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.RouteHealthCheck))(in)
+	}
+	out.Path = in.Path
+	out.IntervalSeconds = in.IntervalSeconds
+	return nil
+}
+
+func Convert_api_RouteHealthCheck_To_v1_RouteHealthCheck(in *routeapi.RouteHealthCheck, out *routeapiv1.RouteHealthCheck, s conversion.Scope) error {
+	return autoConvert_api_RouteHealthCheck_To_v1_RouteHealthCheck(in, out, s)
+}
+
 func autoConvert_api_RouteIngress_To_v1_RouteIngress(in *routeapi.RouteIngress, out *routeapiv1.RouteIngress, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapi.RouteIngress))(in)
@@ -5377,9 +6171,19 @@ func autoConvert_api_RouteSpec_To_v1_RouteSpec(in *routeapi.RouteSpec, out *rout
 	}
 	out.Host = in.Host
 	out.Path = in.Path
-	if err := Convert_api_ObjectReference_To_v1_ObjectReference(&in.To, &out.To, s); err != nil {
+	if err := Convert_api_RouteTargetReference_To_v1_RouteTargetReference(&in.To, &out.To, s); err != nil {
 		return err
 	}
+	if in.AlternateBackends != nil {
+		out.AlternateBackends = make([]routeapiv1.RouteTargetReference, len(in.AlternateBackends))
+		for i := range in.AlternateBackends {
+			if err := Convert_api_RouteTargetReference_To_v1_RouteTargetReference(&in.AlternateBackends[i], &out.AlternateBackends[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AlternateBackends = nil
+	}
 	// unable to generate simple pointer conversion for api.RoutePort -> v1.RoutePort
 	if in.Port != nil {
 		out.Port = new(routeapiv1.RoutePort)
@@ -5389,6 +6193,15 @@ func autoConvert_api_RouteSpec_To_v1_RouteSpec(in *routeapi.RouteSpec, out *rout
 	} else {
 		out.Port = nil
 	}
+	// unable to generate simple pointer conversion for api.RouteHealthCheck -> v1.RouteHealthCheck
+	if in.HealthCheck != nil {
+		out.HealthCheck = new(routeapiv1.RouteHealthCheck)
+		if err := Convert_api_RouteHealthCheck_To_v1_RouteHealthCheck(in.HealthCheck, out.HealthCheck, s); err != nil {
+			return err
+		}
+	} else {
+		out.HealthCheck = nil
+	}
 	// unable to generate simple pointer conversion for api.TLSConfig -> v1.TLSConfig
 	if in.TLS != nil {
 		out.TLS = new(routeapiv1.TLSConfig)
@@ -5426,6 +6239,25 @@ func Convert_api_RouteStatus_To_v1_RouteStatus(in *routeapi.RouteStatus, out *ro
 	return autoConvert_api_RouteStatus_To_v1_RouteStatus(in, out, s)
 }
 
+func autoConvert_api_RouteTargetReference_To_v1_RouteTargetReference(in *routeapi.RouteTargetReference, out *routeapiv1.RouteTargetReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.RouteTargetReference))(in)
+	}
+	out.Kind = in.Kind
+	out.Name = in.Name
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	return nil
+}
+
+func Convert_api_RouteTargetReference_To_v1_RouteTargetReference(in *routeapi.RouteTargetReference, out *routeapiv1.RouteTargetReference, s conversion.Scope) error {
+	return autoConvert_api_RouteTargetReference_To_v1_RouteTargetReference(in, out, s)
+}
+
 func autoConvert_api_TLSConfig_To_v1_TLSConfig(in *routeapi.TLSConfig, out *routeapiv1.TLSConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapi.TLSConfig))(in)
@@ -5463,6 +6295,19 @@ func Convert_v1_Route_To_api_Route(in *routeapiv1.Route, out *routeapi.Route, s
 	return autoConvert_v1_Route_To_api_Route(in, out, s)
 }
 
+func autoConvert_v1_RouteHealthCheck_To_api_RouteHealthCheck(in *routeapiv1.RouteHealthCheck, out *routeapi.RouteHealthCheck, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1.RouteHealthCheck))(in)
+	}
+	out.Path = in.Path
+	out.IntervalSeconds = in.IntervalSeconds
+	return nil
+}
+
+func Convert_v1_RouteHealthCheck_To_api_RouteHealthCheck(in *routeapiv1.RouteHealthCheck, out *routeapi.RouteHealthCheck, s conversion.Scope) error {
+	return autoConvert_v1_RouteHealthCheck_To_api_RouteHealthCheck(in, out, s)
+}
+
 func autoConvert_v1_RouteIngress_To_api_RouteIngress(in *routeapiv1.RouteIngress, out *routeapi.RouteIngress, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapiv1.RouteIngress))(in)
@@ -5554,9 +6399,19 @@ func autoConvert_v1_RouteSpec_To_api_RouteSpec(in *routeapiv1.RouteSpec, out *ro
 	}
 	out.Host = in.Host
 	out.Path = in.Path
-	if err := Convert_v1_ObjectReference_To_api_ObjectReference(&in.To, &out.To, s); err != nil {
+	if err := Convert_v1_RouteTargetReference_To_api_RouteTargetReference(&in.To, &out.To, s); err != nil {
 		return err
 	}
+	if in.AlternateBackends != nil {
+		out.AlternateBackends = make([]routeapi.RouteTargetReference, len(in.AlternateBackends))
+		for i := range in.AlternateBackends {
+			if err := Convert_v1_RouteTargetReference_To_api_RouteTargetReference(&in.AlternateBackends[i], &out.AlternateBackends[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AlternateBackends = nil
+	}
 	// unable to generate simple pointer conversion for v1.RoutePort -> api.RoutePort
 	if in.Port != nil {
 		out.Port = new(routeapi.RoutePort)
@@ -5566,6 +6421,15 @@ func autoConvert_v1_RouteSpec_To_api_RouteSpec(in *routeapiv1.RouteSpec, out *ro
 	} else {
 		out.Port = nil
 	}
+	// unable to generate simple pointer conversion for v1.RouteHealthCheck -> api.RouteHealthCheck
+	if in.HealthCheck != nil {
+		out.HealthCheck = new(routeapi.RouteHealthCheck)
+		if err := Convert_v1_RouteHealthCheck_To_api_RouteHealthCheck(in.HealthCheck, out.HealthCheck, s); err != nil {
+			return err
+		}
+	} else {
+		out.HealthCheck = nil
+	}
 	// unable to generate simple pointer conversion for v1.TLSConfig -> api.TLSConfig
 	if in.TLS != nil {
 		out.TLS = new(routeapi.TLSConfig)
@@ -5603,6 +6467,25 @@ func Convert_v1_RouteStatus_To_api_RouteStatus(in *routeapiv1.RouteStatus, out *
 	return autoConvert_v1_RouteStatus_To_api_RouteStatus(in, out, s)
 }
 
+func autoConvert_v1_RouteTargetReference_To_api_RouteTargetReference(in *routeapiv1.RouteTargetReference, out *routeapi.RouteTargetReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1.RouteTargetReference))(in)
+	}
+	out.Kind = in.Kind
+	out.Name = in.Name
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	return nil
+}
+
+func Convert_v1_RouteTargetReference_To_api_RouteTargetReference(in *routeapiv1.RouteTargetReference, out *routeapi.RouteTargetReference, s conversion.Scope) error {
+	return autoConvert_v1_RouteTargetReference_To_api_RouteTargetReference(in, out, s)
+}
+
 func autoConvert_v1_TLSConfig_To_api_TLSConfig(in *routeapiv1.TLSConfig, out *routeapi.TLSConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapiv1.TLSConfig))(in)
@@ -5864,6 +6747,22 @@ func Convert_v1_NetNamespaceList_To_api_NetNamespaceList(in *sdnapiv1.NetNamespa
 	return autoConvert_v1_NetNamespaceList_To_api_NetNamespaceList(in, out, s)
 }
 
+func autoConvert_api_GeneratorOptions_To_v1_GeneratorOptions(in *templateapi.GeneratorOptions, out *templateapiv1.GeneratorOptions, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapi.GeneratorOptions))(in)
+	}
+	out.Charset = in.Charset
+	out.Exclude = in.Exclude
+	out.ExcludeAmbiguous = in.ExcludeAmbiguous
+	out.MinLength = in.MinLength
+	out.MaxLength = in.MaxLength
+	return nil
+}
+
+func Convert_api_GeneratorOptions_To_v1_GeneratorOptions(in *templateapi.GeneratorOptions, out *templateapiv1.GeneratorOptions, s conversion.Scope) error {
+	return autoConvert_api_GeneratorOptions_To_v1_GeneratorOptions(in, out, s)
+}
+
 func autoConvert_api_Parameter_To_v1_Parameter(in *templateapi.Parameter, out *templateapiv1.Parameter, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*templateapi.Parameter))(in)
@@ -5874,7 +6773,27 @@ func autoConvert_api_Parameter_To_v1_Parameter(in *templateapi.Parameter, out *t
 	out.Value = in.Value
 	out.Generate = in.Generate
 	out.From = in.From
+	out.FromEnv = in.FromEnv
 	out.Required = in.Required
+	out.Type = templateapiv1.ParameterType(in.Type)
+	if in.Allowed != nil {
+		out.Allowed = make([]string, len(in.Allowed))
+		for i := range in.Allowed {
+			out.Allowed[i] = in.Allowed[i]
+		}
+	} else {
+		out.Allowed = nil
+	}
+	out.Sensitive = in.Sensitive
+	// unable to generate simple pointer conversion for api.GeneratorOptions -> v1.GeneratorOptions
+	if in.GeneratorOptions != nil {
+		out.GeneratorOptions = new(templateapiv1.GeneratorOptions)
+		if err := Convert_api_GeneratorOptions_To_v1_GeneratorOptions(in.GeneratorOptions, out.GeneratorOptions, s); err != nil {
+			return err
+		}
+	} else {
+		out.GeneratorOptions = nil
+	}
 	return nil
 }
 
@@ -5910,9 +6829,90 @@ func autoConvert_api_Template_To_v1_Template(in *templateapi.Template, out *temp
 		out.Objects = nil
 	}
 	// in.ObjectLabels has no peer in out
+	if in.ObjectAnnotations != nil {
+		out.ObjectAnnotations = make(map[string]string)
+		for key, val := range in.ObjectAnnotations {
+			out.ObjectAnnotations[key] = val
+		}
+	} else {
+		out.ObjectAnnotations = nil
+	}
+	if in.Includes != nil {
+		out.Includes = make([]templateapiv1.TemplateInclude, len(in.Includes))
+		for i := range in.Includes {
+			if err := Convert_api_TemplateInclude_To_v1_TemplateInclude(&in.Includes[i], &out.Includes[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Includes = nil
+	}
+	out.Message = in.Message
+	return nil
+}
+
+func autoConvert_api_TemplateInclude_To_v1_TemplateInclude(in *templateapi.TemplateInclude, out *templateapiv1.TemplateInclude, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapi.TemplateInclude))(in)
+	}
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+func Convert_api_TemplateInclude_To_v1_TemplateInclude(in *templateapi.TemplateInclude, out *templateapiv1.TemplateInclude, s conversion.Scope) error {
+	return autoConvert_api_TemplateInclude_To_v1_TemplateInclude(in, out, s)
+}
+
+func autoConvert_api_TemplateInstance_To_v1_TemplateInstance(in *templateapi.TemplateInstance, out *templateapiv1.TemplateInstance, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapi.TemplateInstance))(in)
+	}
+	if err := Convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	out.Template = in.Template
+	if in.Objects != nil {
+		out.Objects = make([]apiv1.ObjectReference, len(in.Objects))
+		for i := range in.Objects {
+			if err := Convert_api_ObjectReference_To_v1_ObjectReference(&in.Objects[i], &out.Objects[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Objects = nil
+	}
+	return nil
+}
+
+func Convert_api_TemplateInstance_To_v1_TemplateInstance(in *templateapi.TemplateInstance, out *templateapiv1.TemplateInstance, s conversion.Scope) error {
+	return autoConvert_api_TemplateInstance_To_v1_TemplateInstance(in, out, s)
+}
+
+func autoConvert_api_TemplateInstanceList_To_v1_TemplateInstanceList(in *templateapi.TemplateInstanceList, out *templateapiv1.TemplateInstanceList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapi.TemplateInstanceList))(in)
+	}
+	if err := api.Convert_unversioned_ListMeta_To_unversioned_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]templateapiv1.TemplateInstance, len(in.Items))
+		for i := range in.Items {
+			if err := Convert_api_TemplateInstance_To_v1_TemplateInstance(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
+func Convert_api_TemplateInstanceList_To_v1_TemplateInstanceList(in *templateapi.TemplateInstanceList, out *templateapiv1.TemplateInstanceList, s conversion.Scope) error {
+	return autoConvert_api_TemplateInstanceList_To_v1_TemplateInstanceList(in, out, s)
+}
+
 func autoConvert_api_TemplateList_To_v1_TemplateList(in *templateapi.TemplateList, out *templateapiv1.TemplateList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*templateapi.TemplateList))(in)
@@ -5937,6 +6937,109 @@ func Convert_api_TemplateList_To_v1_TemplateList(in *templateapi.TemplateList, o
 	return autoConvert_api_TemplateList_To_v1_TemplateList(in, out, s)
 }
 
+func autoConvert_api_TemplateRepository_To_v1_TemplateRepository(in *templateapi.TemplateRepository, out *templateapiv1.TemplateRepository, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapi.TemplateRepository))(in)
+	}
+	if err := Convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := Convert_api_TemplateRepositorySpec_To_v1_TemplateRepositorySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_api_TemplateRepositoryStatus_To_v1_TemplateRepositoryStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func Convert_api_TemplateRepository_To_v1_TemplateRepository(in *templateapi.TemplateRepository, out *templateapiv1.TemplateRepository, s conversion.Scope) error {
+	return autoConvert_api_TemplateRepository_To_v1_TemplateRepository(in, out, s)
+}
+
+func autoConvert_api_TemplateRepositoryList_To_v1_TemplateRepositoryList(in *templateapi.TemplateRepositoryList, out *templateapiv1.TemplateRepositoryList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapi.TemplateRepositoryList))(in)
+	}
+	if err := api.Convert_unversioned_ListMeta_To_unversioned_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]templateapiv1.TemplateRepository, len(in.Items))
+		for i := range in.Items {
+			if err := Convert_api_TemplateRepository_To_v1_TemplateRepository(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func Convert_api_TemplateRepositoryList_To_v1_TemplateRepositoryList(in *templateapi.TemplateRepositoryList, out *templateapiv1.TemplateRepositoryList, s conversion.Scope) error {
+	return autoConvert_api_TemplateRepositoryList_To_v1_TemplateRepositoryList(in, out, s)
+}
+
+func autoConvert_api_TemplateRepositorySpec_To_v1_TemplateRepositorySpec(in *templateapi.TemplateRepositorySpec, out *templateapiv1.TemplateRepositorySpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapi.TemplateRepositorySpec))(in)
+	}
+	out.Git = in.Git
+	out.Index = in.Index
+	out.Prune = in.Prune
+	return nil
+}
+
+func Convert_api_TemplateRepositorySpec_To_v1_TemplateRepositorySpec(in *templateapi.TemplateRepositorySpec, out *templateapiv1.TemplateRepositorySpec, s conversion.Scope) error {
+	return autoConvert_api_TemplateRepositorySpec_To_v1_TemplateRepositorySpec(in, out, s)
+}
+
+func autoConvert_api_TemplateRepositoryStatus_To_v1_TemplateRepositoryStatus(in *templateapi.TemplateRepositoryStatus, out *templateapiv1.TemplateRepositoryStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapi.TemplateRepositoryStatus))(in)
+	}
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.LastSyncTime != nil {
+		out.LastSyncTime = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.LastSyncTime, out.LastSyncTime, s); err != nil {
+			return err
+		}
+	} else {
+		out.LastSyncTime = nil
+	}
+	if in.Templates != nil {
+		out.Templates = make([]string, len(in.Templates))
+		for i := range in.Templates {
+			out.Templates[i] = in.Templates[i]
+		}
+	} else {
+		out.Templates = nil
+	}
+	out.Message = in.Message
+	return nil
+}
+
+func Convert_api_TemplateRepositoryStatus_To_v1_TemplateRepositoryStatus(in *templateapi.TemplateRepositoryStatus, out *templateapiv1.TemplateRepositoryStatus, s conversion.Scope) error {
+	return autoConvert_api_TemplateRepositoryStatus_To_v1_TemplateRepositoryStatus(in, out, s)
+}
+
+func autoConvert_v1_GeneratorOptions_To_api_GeneratorOptions(in *templateapiv1.GeneratorOptions, out *templateapi.GeneratorOptions, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapiv1.GeneratorOptions))(in)
+	}
+	out.Charset = in.Charset
+	out.Exclude = in.Exclude
+	out.ExcludeAmbiguous = in.ExcludeAmbiguous
+	out.MinLength = in.MinLength
+	out.MaxLength = in.MaxLength
+	return nil
+}
+
+func Convert_v1_GeneratorOptions_To_api_GeneratorOptions(in *templateapiv1.GeneratorOptions, out *templateapi.GeneratorOptions, s conversion.Scope) error {
+	return autoConvert_v1_GeneratorOptions_To_api_GeneratorOptions(in, out, s)
+}
+
 func autoConvert_v1_Parameter_To_api_Parameter(in *templateapiv1.Parameter, out *templateapi.Parameter, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*templateapiv1.Parameter))(in)
@@ -5947,7 +7050,27 @@ func autoConvert_v1_Parameter_To_api_Parameter(in *templateapiv1.Parameter, out
 	out.Value = in.Value
 	out.Generate = in.Generate
 	out.From = in.From
+	out.FromEnv = in.FromEnv
 	out.Required = in.Required
+	out.Type = templateapi.ParameterType(in.Type)
+	if in.Allowed != nil {
+		out.Allowed = make([]string, len(in.Allowed))
+		for i := range in.Allowed {
+			out.Allowed[i] = in.Allowed[i]
+		}
+	} else {
+		out.Allowed = nil
+	}
+	out.Sensitive = in.Sensitive
+	// unable to generate simple pointer conversion for v1.GeneratorOptions -> api.GeneratorOptions
+	if in.GeneratorOptions != nil {
+		out.GeneratorOptions = new(templateapi.GeneratorOptions)
+		if err := Convert_v1_GeneratorOptions_To_api_GeneratorOptions(in.GeneratorOptions, out.GeneratorOptions, s); err != nil {
+			return err
+		}
+	} else {
+		out.GeneratorOptions = nil
+	}
 	return nil
 }
 
@@ -5990,9 +7113,90 @@ func autoConvert_v1_Template_To_api_Template(in *templateapiv1.Template, out *te
 	} else {
 		out.Labels = nil
 	}
+	if in.ObjectAnnotations != nil {
+		out.ObjectAnnotations = make(map[string]string)
+		for key, val := range in.ObjectAnnotations {
+			out.ObjectAnnotations[key] = val
+		}
+	} else {
+		out.ObjectAnnotations = nil
+	}
+	if in.Includes != nil {
+		out.Includes = make([]templateapi.TemplateInclude, len(in.Includes))
+		for i := range in.Includes {
+			if err := Convert_v1_TemplateInclude_To_api_TemplateInclude(&in.Includes[i], &out.Includes[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Includes = nil
+	}
+	out.Message = in.Message
+	return nil
+}
+
+func autoConvert_v1_TemplateInclude_To_api_TemplateInclude(in *templateapiv1.TemplateInclude, out *templateapi.TemplateInclude, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapiv1.TemplateInclude))(in)
+	}
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+func Convert_v1_TemplateInclude_To_api_TemplateInclude(in *templateapiv1.TemplateInclude, out *templateapi.TemplateInclude, s conversion.Scope) error {
+	return autoConvert_v1_TemplateInclude_To_api_TemplateInclude(in, out, s)
+}
+
+func autoConvert_v1_TemplateInstance_To_api_TemplateInstance(in *templateapiv1.TemplateInstance, out *templateapi.TemplateInstance, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapiv1.TemplateInstance))(in)
+	}
+	if err := Convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	out.Template = in.Template
+	if in.Objects != nil {
+		out.Objects = make([]api.ObjectReference, len(in.Objects))
+		for i := range in.Objects {
+			if err := Convert_v1_ObjectReference_To_api_ObjectReference(&in.Objects[i], &out.Objects[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Objects = nil
+	}
+	return nil
+}
+
+func Convert_v1_TemplateInstance_To_api_TemplateInstance(in *templateapiv1.TemplateInstance, out *templateapi.TemplateInstance, s conversion.Scope) error {
+	return autoConvert_v1_TemplateInstance_To_api_TemplateInstance(in, out, s)
+}
+
+func autoConvert_v1_TemplateInstanceList_To_api_TemplateInstanceList(in *templateapiv1.TemplateInstanceList, out *templateapi.TemplateInstanceList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapiv1.TemplateInstanceList))(in)
+	}
+	if err := api.Convert_unversioned_ListMeta_To_unversioned_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]templateapi.TemplateInstance, len(in.Items))
+		for i := range in.Items {
+			if err := Convert_v1_TemplateInstance_To_api_TemplateInstance(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
+func Convert_v1_TemplateInstanceList_To_api_TemplateInstanceList(in *templateapiv1.TemplateInstanceList, out *templateapi.TemplateInstanceList, s conversion.Scope) error {
+	return autoConvert_v1_TemplateInstanceList_To_api_TemplateInstanceList(in, out, s)
+}
+
 func autoConvert_v1_TemplateList_To_api_TemplateList(in *templateapiv1.TemplateList, out *templateapi.TemplateList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*templateapiv1.TemplateList))(in)
@@ -6017,6 +7221,93 @@ func Convert_v1_TemplateList_To_api_TemplateList(in *templateapiv1.TemplateList,
 	return autoConvert_v1_TemplateList_To_api_TemplateList(in, out, s)
 }
 
+func autoConvert_v1_TemplateRepository_To_api_TemplateRepository(in *templateapiv1.TemplateRepository, out *templateapi.TemplateRepository, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapiv1.TemplateRepository))(in)
+	}
+	if err := Convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_TemplateRepositorySpec_To_api_TemplateRepositorySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_TemplateRepositoryStatus_To_api_TemplateRepositoryStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func Convert_v1_TemplateRepository_To_api_TemplateRepository(in *templateapiv1.TemplateRepository, out *templateapi.TemplateRepository, s conversion.Scope) error {
+	return autoConvert_v1_TemplateRepository_To_api_TemplateRepository(in, out, s)
+}
+
+func autoConvert_v1_TemplateRepositoryList_To_api_TemplateRepositoryList(in *templateapiv1.TemplateRepositoryList, out *templateapi.TemplateRepositoryList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapiv1.TemplateRepositoryList))(in)
+	}
+	if err := api.Convert_unversioned_ListMeta_To_unversioned_ListMeta(&in.ListMeta, &out.ListMeta, s); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]templateapi.TemplateRepository, len(in.Items))
+		for i := range in.Items {
+			if err := Convert_v1_TemplateRepository_To_api_TemplateRepository(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func Convert_v1_TemplateRepositoryList_To_api_TemplateRepositoryList(in *templateapiv1.TemplateRepositoryList, out *templateapi.TemplateRepositoryList, s conversion.Scope) error {
+	return autoConvert_v1_TemplateRepositoryList_To_api_TemplateRepositoryList(in, out, s)
+}
+
+func autoConvert_v1_TemplateRepositorySpec_To_api_TemplateRepositorySpec(in *templateapiv1.TemplateRepositorySpec, out *templateapi.TemplateRepositorySpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapiv1.TemplateRepositorySpec))(in)
+	}
+	out.Git = in.Git
+	out.Index = in.Index
+	out.Prune = in.Prune
+	return nil
+}
+
+func Convert_v1_TemplateRepositorySpec_To_api_TemplateRepositorySpec(in *templateapiv1.TemplateRepositorySpec, out *templateapi.TemplateRepositorySpec, s conversion.Scope) error {
+	return autoConvert_v1_TemplateRepositorySpec_To_api_TemplateRepositorySpec(in, out, s)
+}
+
+func autoConvert_v1_TemplateRepositoryStatus_To_api_TemplateRepositoryStatus(in *templateapiv1.TemplateRepositoryStatus, out *templateapi.TemplateRepositoryStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapiv1.TemplateRepositoryStatus))(in)
+	}
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.LastSyncTime != nil {
+		out.LastSyncTime = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.LastSyncTime, out.LastSyncTime, s); err != nil {
+			return err
+		}
+	} else {
+		out.LastSyncTime = nil
+	}
+	if in.Templates != nil {
+		out.Templates = make([]string, len(in.Templates))
+		for i := range in.Templates {
+			out.Templates[i] = in.Templates[i]
+		}
+	} else {
+		out.Templates = nil
+	}
+	out.Message = in.Message
+	return nil
+}
+
+func Convert_v1_TemplateRepositoryStatus_To_api_TemplateRepositoryStatus(in *templateapiv1.TemplateRepositoryStatus, out *templateapi.TemplateRepositoryStatus, s conversion.Scope) error {
+	return autoConvert_v1_TemplateRepositoryStatus_To_api_TemplateRepositoryStatus(in, out, s)
+}
+
 func autoConvert_api_Group_To_v1_Group(in *userapi.Group, out *userapiv1.Group, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*userapi.Group))(in)
@@ -8876,6 +10167,7 @@ func autoConvert_v1_VolumeSource_To_api_VolumeSource(in *apiv1.VolumeSource, out
 func init() {
 	err := api.Scheme.AddGeneratedConversionFuncs(
 		autoConvert_api_AWSElasticBlockStoreVolumeSource_To_v1_AWSElasticBlockStoreVolumeSource,
+		autoConvert_api_AggregationRule_To_v1_AggregationRule,
 		autoConvert_api_AzureFileVolumeSource_To_v1_AzureFileVolumeSource,
 		autoConvert_api_BinaryBuildRequestOptions_To_v1_BinaryBuildRequestOptions,
 		autoConvert_api_BinaryBuildSource_To_v1_BinaryBuildSource,
@@ -8895,6 +10187,7 @@ func init() {
 		autoConvert_api_BuildStrategy_To_v1_BuildStrategy,
 		autoConvert_api_BuildTriggerPolicy_To_v1_BuildTriggerPolicy,
 		autoConvert_api_Build_To_v1_Build,
+		autoConvert_api_CanaryDeploymentStrategyParams_To_v1_CanaryDeploymentStrategyParams,
 		autoConvert_api_Capabilities_To_v1_Capabilities,
 		autoConvert_api_CephFSVolumeSource_To_v1_CephFSVolumeSource,
 		autoConvert_api_CinderVolumeSource_To_v1_CinderVolumeSource,
@@ -8907,6 +10200,7 @@ func init() {
 		autoConvert_api_ClusterRoleBindingList_To_v1_ClusterRoleBindingList,
 		autoConvert_api_ClusterRoleBinding_To_v1_ClusterRoleBinding,
 		autoConvert_api_ClusterRoleList_To_v1_ClusterRoleList,
+		autoConvert_api_ClusterRoleScopeRestriction_To_v1_ClusterRoleScopeRestriction,
 		autoConvert_api_ClusterRole_To_v1_ClusterRole,
 		autoConvert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector,
 		autoConvert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource,
@@ -8916,6 +10210,7 @@ func init() {
 		autoConvert_api_CustomDeploymentStrategyParams_To_v1_CustomDeploymentStrategyParams,
 		autoConvert_api_DeploymentCauseImageTrigger_To_v1_DeploymentCauseImageTrigger,
 		autoConvert_api_DeploymentCause_To_v1_DeploymentCause,
+		autoConvert_api_DeploymentCondition_To_v1_DeploymentCondition,
 		autoConvert_api_DeploymentConfigList_To_v1_DeploymentConfigList,
 		autoConvert_api_DeploymentConfigRollbackSpec_To_v1_DeploymentConfigRollbackSpec,
 		autoConvert_api_DeploymentConfigRollback_To_v1_DeploymentConfigRollback,
@@ -8925,7 +10220,9 @@ func init() {
 		autoConvert_api_DeploymentDetails_To_v1_DeploymentDetails,
 		autoConvert_api_DeploymentLogOptions_To_v1_DeploymentLogOptions,
 		autoConvert_api_DeploymentLog_To_v1_DeploymentLog,
+		autoConvert_api_DeploymentRequest_To_v1_DeploymentRequest,
 		autoConvert_api_DeploymentStrategy_To_v1_DeploymentStrategy,
+		autoConvert_api_DeploymentTriggerConfigChangeParams_To_v1_DeploymentTriggerConfigChangeParams,
 		autoConvert_api_DeploymentTriggerImageChangeParams_To_v1_DeploymentTriggerImageChangeParams,
 		autoConvert_api_DeploymentTriggerPolicy_To_v1_DeploymentTriggerPolicy,
 		autoConvert_api_DockerBuildStrategy_To_v1_DockerBuildStrategy,
@@ -8940,6 +10237,7 @@ func init() {
 		autoConvert_api_FlexVolumeSource_To_v1_FlexVolumeSource,
 		autoConvert_api_FlockerVolumeSource_To_v1_FlockerVolumeSource,
 		autoConvert_api_GCEPersistentDiskVolumeSource_To_v1_GCEPersistentDiskVolumeSource,
+		autoConvert_api_GeneratorOptions_To_v1_GeneratorOptions,
 		autoConvert_api_GitBuildSource_To_v1_GitBuildSource,
 		autoConvert_api_GitRepoVolumeSource_To_v1_GitRepoVolumeSource,
 		autoConvert_api_GitSourceRevision_To_v1_GitSourceRevision,
@@ -9013,6 +10311,7 @@ func init() {
 		autoConvert_api_RecreateDeploymentStrategyParams_To_v1_RecreateDeploymentStrategyParams,
 		autoConvert_api_RepositoryImportSpec_To_v1_RepositoryImportSpec,
 		autoConvert_api_RepositoryImportStatus_To_v1_RepositoryImportStatus,
+		autoConvert_api_ResourceAccessGrant_To_v1_ResourceAccessGrant,
 		autoConvert_api_ResourceAccessReviewResponse_To_v1_ResourceAccessReviewResponse,
 		autoConvert_api_ResourceAccessReview_To_v1_ResourceAccessReview,
 		autoConvert_api_ResourceRequirements_To_v1_ResourceRequirements,
@@ -9021,14 +10320,17 @@ func init() {
 		autoConvert_api_RoleList_To_v1_RoleList,
 		autoConvert_api_Role_To_v1_Role,
 		autoConvert_api_RollingDeploymentStrategyParams_To_v1_RollingDeploymentStrategyParams,
+		autoConvert_api_RouteHealthCheck_To_v1_RouteHealthCheck,
 		autoConvert_api_RouteIngressCondition_To_v1_RouteIngressCondition,
 		autoConvert_api_RouteIngress_To_v1_RouteIngress,
 		autoConvert_api_RouteList_To_v1_RouteList,
 		autoConvert_api_RoutePort_To_v1_RoutePort,
 		autoConvert_api_RouteSpec_To_v1_RouteSpec,
 		autoConvert_api_RouteStatus_To_v1_RouteStatus,
+		autoConvert_api_RouteTargetReference_To_v1_RouteTargetReference,
 		autoConvert_api_Route_To_v1_Route,
 		autoConvert_api_SELinuxOptions_To_v1_SELinuxOptions,
+		autoConvert_api_ScopeRestriction_To_v1_ScopeRestriction,
 		autoConvert_api_SecretBuildSource_To_v1_SecretBuildSource,
 		autoConvert_api_SecretKeySelector_To_v1_SecretKeySelector,
 		autoConvert_api_SecretSpec_To_v1_SecretSpec,
@@ -9044,8 +10346,16 @@ func init() {
 		autoConvert_api_TagEventCondition_To_v1_TagEventCondition,
 		autoConvert_api_TagImageHook_To_v1_TagImageHook,
 		autoConvert_api_TagImportPolicy_To_v1_TagImportPolicy,
+		autoConvert_api_TagReferencePolicy_To_v1_TagReferencePolicy,
 		autoConvert_api_TagReference_To_v1_TagReference,
+		autoConvert_api_TemplateInclude_To_v1_TemplateInclude,
+		autoConvert_api_TemplateInstanceList_To_v1_TemplateInstanceList,
+		autoConvert_api_TemplateInstance_To_v1_TemplateInstance,
 		autoConvert_api_TemplateList_To_v1_TemplateList,
+		autoConvert_api_TemplateRepositoryList_To_v1_TemplateRepositoryList,
+		autoConvert_api_TemplateRepositorySpec_To_v1_TemplateRepositorySpec,
+		autoConvert_api_TemplateRepositoryStatus_To_v1_TemplateRepositoryStatus,
+		autoConvert_api_TemplateRepository_To_v1_TemplateRepository,
 		autoConvert_api_Template_To_v1_Template,
 		autoConvert_api_UserIdentityMapping_To_v1_UserIdentityMapping,
 		autoConvert_api_UserList_To_v1_UserList,
@@ -9055,6 +10365,7 @@ func init() {
 		autoConvert_api_Volume_To_v1_Volume,
 		autoConvert_api_WebHookTrigger_To_v1_WebHookTrigger,
 		autoConvert_v1_AWSElasticBlockStoreVolumeSource_To_api_AWSElasticBlockStoreVolumeSource,
+		autoConvert_v1_AggregationRule_To_api_AggregationRule,
 		autoConvert_v1_AzureFileVolumeSource_To_api_AzureFileVolumeSource,
 		autoConvert_v1_BinaryBuildRequestOptions_To_api_BinaryBuildRequestOptions,
 		autoConvert_v1_BinaryBuildSource_To_api_BinaryBuildSource,
@@ -9074,6 +10385,7 @@ func init() {
 		autoConvert_v1_BuildStrategy_To_api_BuildStrategy,
 		autoConvert_v1_BuildTriggerPolicy_To_api_BuildTriggerPolicy,
 		autoConvert_v1_Build_To_api_Build,
+		autoConvert_v1_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams,
 		autoConvert_v1_Capabilities_To_api_Capabilities,
 		autoConvert_v1_CephFSVolumeSource_To_api_CephFSVolumeSource,
 		autoConvert_v1_CinderVolumeSource_To_api_CinderVolumeSource,
@@ -9086,6 +10398,7 @@ func init() {
 		autoConvert_v1_ClusterRoleBindingList_To_api_ClusterRoleBindingList,
 		autoConvert_v1_ClusterRoleBinding_To_api_ClusterRoleBinding,
 		autoConvert_v1_ClusterRoleList_To_api_ClusterRoleList,
+		autoConvert_v1_ClusterRoleScopeRestriction_To_api_ClusterRoleScopeRestriction,
 		autoConvert_v1_ClusterRole_To_api_ClusterRole,
 		autoConvert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector,
 		autoConvert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource,
@@ -9095,6 +10408,7 @@ func init() {
 		autoConvert_v1_CustomDeploymentStrategyParams_To_api_CustomDeploymentStrategyParams,
 		autoConvert_v1_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrigger,
 		autoConvert_v1_DeploymentCause_To_api_DeploymentCause,
+		autoConvert_v1_DeploymentCondition_To_api_DeploymentCondition,
 		autoConvert_v1_DeploymentConfigList_To_api_DeploymentConfigList,
 		autoConvert_v1_DeploymentConfigRollbackSpec_To_api_DeploymentConfigRollbackSpec,
 		autoConvert_v1_DeploymentConfigRollback_To_api_DeploymentConfigRollback,
@@ -9104,7 +10418,9 @@ func init() {
 		autoConvert_v1_DeploymentDetails_To_api_DeploymentDetails,
 		autoConvert_v1_DeploymentLogOptions_To_api_DeploymentLogOptions,
 		autoConvert_v1_DeploymentLog_To_api_DeploymentLog,
+		autoConvert_v1_DeploymentRequest_To_api_DeploymentRequest,
 		autoConvert_v1_DeploymentStrategy_To_api_DeploymentStrategy,
+		autoConvert_v1_DeploymentTriggerConfigChangeParams_To_api_DeploymentTriggerConfigChangeParams,
 		autoConvert_v1_DeploymentTriggerImageChangeParams_To_api_DeploymentTriggerImageChangeParams,
 		autoConvert_v1_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy,
 		autoConvert_v1_DockerBuildStrategy_To_api_DockerBuildStrategy,
@@ -9119,6 +10435,7 @@ func init() {
 		autoConvert_v1_FlexVolumeSource_To_api_FlexVolumeSource,
 		autoConvert_v1_FlockerVolumeSource_To_api_FlockerVolumeSource,
 		autoConvert_v1_GCEPersistentDiskVolumeSource_To_api_GCEPersistentDiskVolumeSource,
+		autoConvert_v1_GeneratorOptions_To_api_GeneratorOptions,
 		autoConvert_v1_GitBuildSource_To_api_GitBuildSource,
 		autoConvert_v1_GitRepoVolumeSource_To_api_GitRepoVolumeSource,
 		autoConvert_v1_GitSourceRevision_To_api_GitSourceRevision,
@@ -9192,6 +10509,7 @@ func init() {
 		autoConvert_v1_RecreateDeploymentStrategyParams_To_api_RecreateDeploymentStrategyParams,
 		autoConvert_v1_RepositoryImportSpec_To_api_RepositoryImportSpec,
 		autoConvert_v1_RepositoryImportStatus_To_api_RepositoryImportStatus,
+		autoConvert_v1_ResourceAccessGrant_To_api_ResourceAccessGrant,
 		autoConvert_v1_ResourceAccessReviewResponse_To_api_ResourceAccessReviewResponse,
 		autoConvert_v1_ResourceAccessReview_To_api_ResourceAccessReview,
 		autoConvert_v1_ResourceRequirements_To_api_ResourceRequirements,
@@ -9200,14 +10518,17 @@ func init() {
 		autoConvert_v1_RoleList_To_api_RoleList,
 		autoConvert_v1_Role_To_api_Role,
 		autoConvert_v1_RollingDeploymentStrategyParams_To_api_RollingDeploymentStrategyParams,
+		autoConvert_v1_RouteHealthCheck_To_api_RouteHealthCheck,
 		autoConvert_v1_RouteIngressCondition_To_api_RouteIngressCondition,
 		autoConvert_v1_RouteIngress_To_api_RouteIngress,
 		autoConvert_v1_RouteList_To_api_RouteList,
 		autoConvert_v1_RoutePort_To_api_RoutePort,
 		autoConvert_v1_RouteSpec_To_api_RouteSpec,
 		autoConvert_v1_RouteStatus_To_api_RouteStatus,
+		autoConvert_v1_RouteTargetReference_To_api_RouteTargetReference,
 		autoConvert_v1_Route_To_api_Route,
 		autoConvert_v1_SELinuxOptions_To_api_SELinuxOptions,
+		autoConvert_v1_ScopeRestriction_To_api_ScopeRestriction,
 		autoConvert_v1_SecretBuildSource_To_api_SecretBuildSource,
 		autoConvert_v1_SecretKeySelector_To_api_SecretKeySelector,
 		autoConvert_v1_SecretSpec_To_api_SecretSpec,
@@ -9223,8 +10544,16 @@ func init() {
 		autoConvert_v1_TagEventCondition_To_api_TagEventCondition,
 		autoConvert_v1_TagImageHook_To_api_TagImageHook,
 		autoConvert_v1_TagImportPolicy_To_api_TagImportPolicy,
+		autoConvert_v1_TagReferencePolicy_To_api_TagReferencePolicy,
 		autoConvert_v1_TagReference_To_api_TagReference,
+		autoConvert_v1_TemplateInclude_To_api_TemplateInclude,
+		autoConvert_v1_TemplateInstanceList_To_api_TemplateInstanceList,
+		autoConvert_v1_TemplateInstance_To_api_TemplateInstance,
 		autoConvert_v1_TemplateList_To_api_TemplateList,
+		autoConvert_v1_TemplateRepositoryList_To_api_TemplateRepositoryList,
+		autoConvert_v1_TemplateRepositorySpec_To_api_TemplateRepositorySpec,
+		autoConvert_v1_TemplateRepositoryStatus_To_api_TemplateRepositoryStatus,
+		autoConvert_v1_TemplateRepository_To_api_TemplateRepository,
 		autoConvert_v1_Template_To_api_Template,
 		autoConvert_v1_UserIdentityMapping_To_api_UserIdentityMapping,
 		autoConvert_v1_UserList_To_api_UserList,
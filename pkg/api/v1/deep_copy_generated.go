@@ -20,6 +20,22 @@ import (
 	intstr "k8s.io/kubernetes/pkg/util/intstr"
 )
 
+func deepCopy_v1_AggregationRule(in v1.AggregationRule, out *v1.AggregationRule, c *conversion.Cloner) error {
+	if in.ClusterRoleSelectors != nil {
+		out.ClusterRoleSelectors = make([]unversioned.LabelSelector, len(in.ClusterRoleSelectors))
+		for i := range in.ClusterRoleSelectors {
+			if newVal, err := c.DeepCopy(in.ClusterRoleSelectors[i]); err != nil {
+				return err
+			} else {
+				out.ClusterRoleSelectors[i] = newVal.(unversioned.LabelSelector)
+			}
+		}
+	} else {
+		out.ClusterRoleSelectors = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_AuthorizationAttributes(in v1.AuthorizationAttributes, out *v1.AuthorizationAttributes, c *conversion.Cloner) error {
 	out.Namespace = in.Namespace
 	out.Verb = in.Verb
@@ -32,6 +48,8 @@ func deepCopy_v1_AuthorizationAttributes(in v1.AuthorizationAttributes, out *v1.
 	} else {
 		out.Content = newVal.(runtime.RawExtension)
 	}
+	out.IsNonResourceURL = in.IsNonResourceURL
+	out.Path = in.Path
 	return nil
 }
 
@@ -167,6 +185,14 @@ func deepCopy_v1_ClusterRole(in v1.ClusterRole, out *v1.ClusterRole, c *conversi
 	} else {
 		out.Rules = nil
 	}
+	if in.AggregationRule != nil {
+		out.AggregationRule = new(v1.AggregationRule)
+		if err := deepCopy_v1_AggregationRule(*in.AggregationRule, out.AggregationRule, c); err != nil {
+			return err
+		}
+	} else {
+		out.AggregationRule = nil
+	}
 	return nil
 }
 
@@ -499,6 +525,33 @@ func deepCopy_v1_PolicyRule(in v1.PolicyRule, out *v1.PolicyRule, c *conversion.
 	return nil
 }
 
+func deepCopy_v1_ResourceAccessGrant(in v1.ResourceAccessGrant, out *v1.ResourceAccessGrant, c *conversion.Cloner) error {
+	out.Namespace = in.Namespace
+	out.RoleBinding = in.RoleBinding
+	if newVal, err := c.DeepCopy(in.RoleRef); err != nil {
+		return err
+	} else {
+		out.RoleRef = newVal.(pkgapiv1.ObjectReference)
+	}
+	if in.Users != nil {
+		out.Users = make([]string, len(in.Users))
+		for i := range in.Users {
+			out.Users[i] = in.Users[i]
+		}
+	} else {
+		out.Users = nil
+	}
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_ResourceAccessReview(in v1.ResourceAccessReview, out *v1.ResourceAccessReview, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -534,6 +587,16 @@ func deepCopy_v1_ResourceAccessReviewResponse(in v1.ResourceAccessReviewResponse
 	} else {
 		out.GroupsSlice = nil
 	}
+	if in.Grants != nil {
+		out.Grants = make([]v1.ResourceAccessGrant, len(in.Grants))
+		for i := range in.Grants {
+			if err := deepCopy_v1_ResourceAccessGrant(in.Grants[i], &out.Grants[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Grants = nil
+	}
 	return nil
 }
 
@@ -793,6 +856,19 @@ func deepCopy_v1_BuildConfigSpec(in apiv1.BuildConfigSpec, out *apiv1.BuildConfi
 	if err := deepCopy_v1_BuildSpec(in.BuildSpec, &out.BuildSpec, c); err != nil {
 		return err
 	}
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
+	out.RunPolicy = in.RunPolicy
 	return nil
 }
 
@@ -878,6 +954,19 @@ func deepCopy_v1_BuildLogOptions(in apiv1.BuildLogOptions, out *apiv1.BuildLogOp
 	} else {
 		out.Version = nil
 	}
+	if in.OffsetBytes != nil {
+		out.OffsetBytes = new(int64)
+		*out.OffsetBytes = *in.OffsetBytes
+	} else {
+		out.OffsetBytes = nil
+	}
+	if in.OffsetLines != nil {
+		out.OffsetLines = new(int64)
+		*out.OffsetLines = *in.OffsetLines
+	} else {
+		out.OffsetLines = nil
+	}
+	out.Format = in.Format
 	return nil
 }
 
@@ -1071,6 +1160,14 @@ func deepCopy_v1_BuildSpec(in apiv1.BuildSpec, out *apiv1.BuildSpec, c *conversi
 	} else {
 		out.Resources = newVal.(pkgapiv1.ResourceRequirements)
 	}
+	if in.NodeSelector != nil {
+		out.NodeSelector = make(map[string]string)
+		for key, val := range in.NodeSelector {
+			out.NodeSelector[key] = val
+		}
+	} else {
+		out.NodeSelector = nil
+	}
 	if err := deepCopy_v1_BuildPostCommitSpec(in.PostCommit, &out.PostCommit, c); err != nil {
 		return err
 	}
@@ -1167,6 +1264,22 @@ func deepCopy_v1_BuildTriggerPolicy(in apiv1.BuildTriggerPolicy, out *apiv1.Buil
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(apiv1.WebHookTrigger)
+		if err := deepCopy_v1_WebHookTrigger(*in.GitLabWebHook, out.GitLabWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(apiv1.WebHookTrigger)
+		if err := deepCopy_v1_WebHookTrigger(*in.BitbucketWebHook, out.BitbucketWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(apiv1.ImageChangeTrigger)
 		if err := deepCopy_v1_ImageChangeTrigger(*in.ImageChange, out.ImageChange, c); err != nil {
@@ -1273,6 +1386,21 @@ func deepCopy_v1_GitBuildSource(in apiv1.GitBuildSource, out *apiv1.GitBuildSour
 	} else {
 		out.HTTPSProxy = nil
 	}
+	if in.NoProxy != nil {
+		out.NoProxy = new(string)
+		*out.NoProxy = *in.NoProxy
+	} else {
+		out.NoProxy = nil
+	}
+	out.SkipSubmodules = in.SkipSubmodules
+	if in.SparseCheckoutPaths != nil {
+		out.SparseCheckoutPaths = make([]string, len(in.SparseCheckoutPaths))
+		for i := range in.SparseCheckoutPaths {
+			out.SparseCheckoutPaths[i] = in.SparseCheckoutPaths[i]
+		}
+	} else {
+		out.SparseCheckoutPaths = nil
+	}
 	return nil
 }
 
@@ -1386,6 +1514,15 @@ func deepCopy_v1_SourceBuildStrategy(in apiv1.SourceBuildStrategy, out *apiv1.So
 	out.Scripts = in.Scripts
 	out.Incremental = in.Incremental
 	out.ForcePull = in.ForcePull
+	if in.ArtifactCache != nil {
+		if newVal, err := c.DeepCopy(in.ArtifactCache); err != nil {
+			return err
+		} else {
+			out.ArtifactCache = newVal.(*pkgapiv1.LocalObjectReference)
+		}
+	} else {
+		out.ArtifactCache = nil
+	}
 	return nil
 }
 
@@ -1410,6 +1547,53 @@ func deepCopy_v1_SourceRevision(in apiv1.SourceRevision, out *apiv1.SourceRevisi
 
 func deepCopy_v1_WebHookTrigger(in apiv1.WebHookTrigger, out *apiv1.WebHookTrigger, c *conversion.Cloner) error {
 	out.Secret = in.Secret
+	out.RefFilter = in.RefFilter
+	out.PathFilter = in.PathFilter
+	return nil
+}
+
+func deepCopy_v1_CanaryDeploymentStrategyParams(in deployapiv1.CanaryDeploymentStrategyParams, out *deployapiv1.CanaryDeploymentStrategyParams, c *conversion.Cloner) error {
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if newVal, err := c.DeepCopy(in.Size); err != nil {
+		return err
+	} else {
+		out.Size = newVal.(intstr.IntOrString)
+	}
+	if in.VerificationSeconds != nil {
+		out.VerificationSeconds = new(int64)
+		*out.VerificationSeconds = *in.VerificationSeconds
+	} else {
+		out.VerificationSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Verify != nil {
+		out.Verify = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Verify, out.Verify, c); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
 	return nil
 }
 
@@ -1460,6 +1644,24 @@ func deepCopy_v1_DeploymentCauseImageTrigger(in deployapiv1.DeploymentCauseImage
 	return nil
 }
 
+func deepCopy_v1_DeploymentCondition(in deployapiv1.DeploymentCondition, out *deployapiv1.DeploymentCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if newVal, err := c.DeepCopy(in.LastUpdateTime); err != nil {
+		return err
+	} else {
+		out.LastUpdateTime = newVal.(unversioned.Time)
+	}
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
 func deepCopy_v1_DeploymentConfig(in deployapiv1.DeploymentConfig, out *deployapiv1.DeploymentConfig, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -1545,6 +1747,13 @@ func deepCopy_v1_DeploymentConfigSpec(in deployapiv1.DeploymentConfigSpec, out *
 	}
 	out.Replicas = in.Replicas
 	out.Test = in.Test
+	out.Paused = in.Paused
+	if in.ProgressDeadlineSeconds != nil {
+		out.ProgressDeadlineSeconds = new(int64)
+		*out.ProgressDeadlineSeconds = *in.ProgressDeadlineSeconds
+	} else {
+		out.ProgressDeadlineSeconds = nil
+	}
 	if in.Selector != nil {
 		out.Selector = make(map[string]string)
 		for key, val := range in.Selector {
@@ -1575,6 +1784,16 @@ func deepCopy_v1_DeploymentConfigStatus(in deployapiv1.DeploymentConfigStatus, o
 	} else {
 		out.Details = nil
 	}
+	if in.Conditions != nil {
+		out.Conditions = make([]deployapiv1.DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_v1_DeploymentCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -1650,6 +1869,18 @@ func deepCopy_v1_DeploymentLogOptions(in deployapiv1.DeploymentLogOptions, out *
 	} else {
 		out.Version = nil
 	}
+	out.AllPods = in.AllPods
+	out.DeploymentPods = in.DeploymentPods
+	return nil
+}
+
+func deepCopy_v1_DeploymentRequest(in deployapiv1.DeploymentRequest, out *deployapiv1.DeploymentRequest, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	out.Name = in.Name
 	return nil
 }
 
@@ -1679,6 +1910,14 @@ func deepCopy_v1_DeploymentStrategy(in deployapiv1.DeploymentStrategy, out *depl
 	} else {
 		out.RollingParams = nil
 	}
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapiv1.CanaryDeploymentStrategyParams)
+		if err := deepCopy_v1_CanaryDeploymentStrategyParams(*in.CanaryParams, out.CanaryParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
 	if newVal, err := c.DeepCopy(in.Resources); err != nil {
 		return err
 	} else {
@@ -1703,6 +1942,11 @@ func deepCopy_v1_DeploymentStrategy(in deployapiv1.DeploymentStrategy, out *depl
 	return nil
 }
 
+func deepCopy_v1_DeploymentTriggerConfigChangeParams(in deployapiv1.DeploymentTriggerConfigChangeParams, out *deployapiv1.DeploymentTriggerConfigChangeParams, c *conversion.Cloner) error {
+	out.DebounceSeconds = in.DebounceSeconds
+	return nil
+}
+
 func deepCopy_v1_DeploymentTriggerImageChangeParams(in deployapiv1.DeploymentTriggerImageChangeParams, out *deployapiv1.DeploymentTriggerImageChangeParams, c *conversion.Cloner) error {
 	out.Automatic = in.Automatic
 	if in.ContainerNames != nil {
@@ -1732,6 +1976,14 @@ func deepCopy_v1_DeploymentTriggerPolicy(in deployapiv1.DeploymentTriggerPolicy,
 	} else {
 		out.ImageChangeParams = nil
 	}
+	if in.ConfigChangeParams != nil {
+		out.ConfigChangeParams = new(deployapiv1.DeploymentTriggerConfigChangeParams)
+		if err := deepCopy_v1_DeploymentTriggerConfigChangeParams(*in.ConfigChangeParams, out.ConfigChangeParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigChangeParams = nil
+	}
 	return nil
 }
 
@@ -1757,6 +2009,7 @@ func deepCopy_v1_ExecNewPodHook(in deployapiv1.ExecNewPodHook, out *deployapiv1.
 		out.Env = nil
 	}
 	out.ContainerName = in.ContainerName
+	out.Image = in.Image
 	if in.Volumes != nil {
 		out.Volumes = make([]string, len(in.Volumes))
 		for i := range in.Volumes {
@@ -1926,6 +2179,8 @@ func deepCopy_v1_Image(in imageapiv1.Image, out *imageapiv1.Image, c *conversion
 	} else {
 		out.DockerImageLayers = nil
 	}
+	out.DockerImageConfig = in.DockerImageConfig
+	out.DockerImageManifestMediaType = in.DockerImageManifestMediaType
 	return nil
 }
 
@@ -1972,6 +2227,7 @@ func deepCopy_v1_ImageImportStatus(in imageapiv1.ImageImportStatus, out *imageap
 func deepCopy_v1_ImageLayer(in imageapiv1.ImageLayer, out *imageapiv1.ImageLayer, c *conversion.Cloner) error {
 	out.Name = in.Name
 	out.Size = in.Size
+	out.MediaType = in.MediaType
 	return nil
 }
 
@@ -2367,6 +2623,41 @@ func deepCopy_v1_TagReference(in imageapiv1.TagReference, out *imageapiv1.TagRef
 	if err := deepCopy_v1_TagImportPolicy(in.ImportPolicy, &out.ImportPolicy, c); err != nil {
 		return err
 	}
+	if in.HistoryLimit != nil {
+		out.HistoryLimit = new(int32)
+		*out.HistoryLimit = *in.HistoryLimit
+	} else {
+		out.HistoryLimit = nil
+	}
+	if err := deepCopy_v1_TagReferencePolicy(in.ReferencePolicy, &out.ReferencePolicy, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1_TagReferencePolicy(in imageapiv1.TagReferencePolicy, out *imageapiv1.TagReferencePolicy, c *conversion.Cloner) error {
+	out.Type = in.Type
+	return nil
+}
+
+func deepCopy_v1_ClusterRoleScopeRestriction(in oauthapiv1.ClusterRoleScopeRestriction, out *oauthapiv1.ClusterRoleScopeRestriction, c *conversion.Cloner) error {
+	if in.RoleNames != nil {
+		out.RoleNames = make([]string, len(in.RoleNames))
+		for i := range in.RoleNames {
+			out.RoleNames[i] = in.RoleNames[i]
+		}
+	} else {
+		out.RoleNames = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	out.AllowEscalation = in.AllowEscalation
 	return nil
 }
 
@@ -2396,6 +2687,7 @@ func deepCopy_v1_OAuthAccessToken(in oauthapiv1.OAuthAccessToken, out *oauthapiv
 	out.UserUID = in.UserUID
 	out.AuthorizeToken = in.AuthorizeToken
 	out.RefreshToken = in.RefreshToken
+	out.InactivityTimeoutSeconds = in.InactivityTimeoutSeconds
 	return nil
 }
 
@@ -2448,6 +2740,8 @@ func deepCopy_v1_OAuthAuthorizeToken(in oauthapiv1.OAuthAuthorizeToken, out *oau
 	out.State = in.State
 	out.UserName = in.UserName
 	out.UserUID = in.UserUID
+	out.CodeChallenge = in.CodeChallenge
+	out.CodeChallengeMethod = in.CodeChallengeMethod
 	return nil
 }
 
@@ -2496,6 +2790,28 @@ func deepCopy_v1_OAuthClient(in oauthapiv1.OAuthClient, out *oauthapiv1.OAuthCli
 	} else {
 		out.RedirectURIs = nil
 	}
+	if in.AccessTokenMaxAgeSeconds != nil {
+		out.AccessTokenMaxAgeSeconds = new(int32)
+		*out.AccessTokenMaxAgeSeconds = *in.AccessTokenMaxAgeSeconds
+	} else {
+		out.AccessTokenMaxAgeSeconds = nil
+	}
+	if in.AccessTokenInactivityTimeoutSeconds != nil {
+		out.AccessTokenInactivityTimeoutSeconds = new(int32)
+		*out.AccessTokenInactivityTimeoutSeconds = *in.AccessTokenInactivityTimeoutSeconds
+	} else {
+		out.AccessTokenInactivityTimeoutSeconds = nil
+	}
+	if in.ScopeRestrictions != nil {
+		out.ScopeRestrictions = make([]oauthapiv1.ScopeRestriction, len(in.ScopeRestrictions))
+		for i := range in.ScopeRestrictions {
+			if err := deepCopy_v1_ScopeRestriction(in.ScopeRestrictions[i], &out.ScopeRestrictions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ScopeRestrictions = nil
+	}
 	return nil
 }
 
@@ -2572,6 +2888,26 @@ func deepCopy_v1_OAuthClientList(in oauthapiv1.OAuthClientList, out *oauthapiv1.
 	return nil
 }
 
+func deepCopy_v1_ScopeRestriction(in oauthapiv1.ScopeRestriction, out *oauthapiv1.ScopeRestriction, c *conversion.Cloner) error {
+	if in.ExactValues != nil {
+		out.ExactValues = make([]string, len(in.ExactValues))
+		for i := range in.ExactValues {
+			out.ExactValues[i] = in.ExactValues[i]
+		}
+	} else {
+		out.ExactValues = nil
+	}
+	if in.ClusterRole != nil {
+		out.ClusterRole = new(oauthapiv1.ClusterRoleScopeRestriction)
+		if err := deepCopy_v1_ClusterRoleScopeRestriction(*in.ClusterRole, out.ClusterRole, c); err != nil {
+			return err
+		}
+	} else {
+		out.ClusterRole = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_Project(in projectapiv1.Project, out *projectapiv1.Project, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2669,6 +3005,12 @@ func deepCopy_v1_Route(in routeapiv1.Route, out *routeapiv1.Route, c *conversion
 	return nil
 }
 
+func deepCopy_v1_RouteHealthCheck(in routeapiv1.RouteHealthCheck, out *routeapiv1.RouteHealthCheck, c *conversion.Cloner) error {
+	out.Path = in.Path
+	out.IntervalSeconds = in.IntervalSeconds
+	return nil
+}
+
 func deepCopy_v1_RouteIngress(in routeapiv1.RouteIngress, out *routeapiv1.RouteIngress, c *conversion.Cloner) error {
 	out.Host = in.Host
 	out.RouterName = in.RouterName
@@ -2738,10 +3080,18 @@ func deepCopy_v1_RoutePort(in routeapiv1.RoutePort, out *routeapiv1.RoutePort, c
 func deepCopy_v1_RouteSpec(in routeapiv1.RouteSpec, out *routeapiv1.RouteSpec, c *conversion.Cloner) error {
 	out.Host = in.Host
 	out.Path = in.Path
-	if newVal, err := c.DeepCopy(in.To); err != nil {
+	if err := deepCopy_v1_RouteTargetReference(in.To, &out.To, c); err != nil {
 		return err
+	}
+	if in.AlternateBackends != nil {
+		out.AlternateBackends = make([]routeapiv1.RouteTargetReference, len(in.AlternateBackends))
+		for i := range in.AlternateBackends {
+			if err := deepCopy_v1_RouteTargetReference(in.AlternateBackends[i], &out.AlternateBackends[i], c); err != nil {
+				return err
+			}
+		}
 	} else {
-		out.To = newVal.(pkgapiv1.ObjectReference)
+		out.AlternateBackends = nil
 	}
 	if in.Port != nil {
 		out.Port = new(routeapiv1.RoutePort)
@@ -2751,6 +3101,14 @@ func deepCopy_v1_RouteSpec(in routeapiv1.RouteSpec, out *routeapiv1.RouteSpec, c
 	} else {
 		out.Port = nil
 	}
+	if in.HealthCheck != nil {
+		out.HealthCheck = new(routeapiv1.RouteHealthCheck)
+		if err := deepCopy_v1_RouteHealthCheck(*in.HealthCheck, out.HealthCheck, c); err != nil {
+			return err
+		}
+	} else {
+		out.HealthCheck = nil
+	}
 	if in.TLS != nil {
 		out.TLS = new(routeapiv1.TLSConfig)
 		if err := deepCopy_v1_TLSConfig(*in.TLS, out.TLS, c); err != nil {
@@ -2776,6 +3134,18 @@ func deepCopy_v1_RouteStatus(in routeapiv1.RouteStatus, out *routeapiv1.RouteSta
 	return nil
 }
 
+func deepCopy_v1_RouteTargetReference(in routeapiv1.RouteTargetReference, out *routeapiv1.RouteTargetReference, c *conversion.Cloner) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_TLSConfig(in routeapiv1.TLSConfig, out *routeapiv1.TLSConfig, c *conversion.Cloner) error {
 	out.Termination = in.Termination
 	out.Certificate = in.Certificate
@@ -2908,6 +3278,15 @@ func deepCopy_v1_NetNamespaceList(in sdnapiv1.NetNamespaceList, out *sdnapiv1.Ne
 	return nil
 }
 
+func deepCopy_v1_GeneratorOptions(in templateapiv1.GeneratorOptions, out *templateapiv1.GeneratorOptions, c *conversion.Cloner) error {
+	out.Charset = in.Charset
+	out.Exclude = in.Exclude
+	out.ExcludeAmbiguous = in.ExcludeAmbiguous
+	out.MinLength = in.MinLength
+	out.MaxLength = in.MaxLength
+	return nil
+}
+
 func deepCopy_v1_Parameter(in templateapiv1.Parameter, out *templateapiv1.Parameter, c *conversion.Cloner) error {
 	out.Name = in.Name
 	out.DisplayName = in.DisplayName
@@ -2915,7 +3294,26 @@ func deepCopy_v1_Parameter(in templateapiv1.Parameter, out *templateapiv1.Parame
 	out.Value = in.Value
 	out.Generate = in.Generate
 	out.From = in.From
+	out.FromEnv = in.FromEnv
 	out.Required = in.Required
+	out.Type = in.Type
+	if in.Allowed != nil {
+		out.Allowed = make([]string, len(in.Allowed))
+		for i := range in.Allowed {
+			out.Allowed[i] = in.Allowed[i]
+		}
+	} else {
+		out.Allowed = nil
+	}
+	out.Sensitive = in.Sensitive
+	if in.GeneratorOptions != nil {
+		out.GeneratorOptions = new(templateapiv1.GeneratorOptions)
+		if err := deepCopy_v1_GeneratorOptions(*in.GeneratorOptions, out.GeneratorOptions, c); err != nil {
+			return err
+		}
+	} else {
+		out.GeneratorOptions = nil
+	}
 	return nil
 }
 
@@ -2960,6 +3358,82 @@ func deepCopy_v1_Template(in templateapiv1.Template, out *templateapiv1.Template
 	} else {
 		out.Labels = nil
 	}
+	if in.ObjectAnnotations != nil {
+		out.ObjectAnnotations = make(map[string]string)
+		for key, val := range in.ObjectAnnotations {
+			out.ObjectAnnotations[key] = val
+		}
+	} else {
+		out.ObjectAnnotations = nil
+	}
+	if in.Includes != nil {
+		out.Includes = make([]templateapiv1.TemplateInclude, len(in.Includes))
+		for i := range in.Includes {
+			if err := deepCopy_v1_TemplateInclude(in.Includes[i], &out.Includes[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Includes = nil
+	}
+	out.Message = in.Message
+	return nil
+}
+
+func deepCopy_v1_TemplateInclude(in templateapiv1.TemplateInclude, out *templateapiv1.TemplateInclude, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+func deepCopy_v1_TemplateInstance(in templateapiv1.TemplateInstance, out *templateapiv1.TemplateInstance, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapiv1.ObjectMeta)
+	}
+	out.Template = in.Template
+	if in.Objects != nil {
+		out.Objects = make([]pkgapiv1.ObjectReference, len(in.Objects))
+		for i := range in.Objects {
+			if newVal, err := c.DeepCopy(in.Objects[i]); err != nil {
+				return err
+			} else {
+				out.Objects[i] = newVal.(pkgapiv1.ObjectReference)
+			}
+		}
+	} else {
+		out.Objects = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_TemplateInstanceList(in templateapiv1.TemplateInstanceList, out *templateapiv1.TemplateInstanceList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(unversioned.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]templateapiv1.TemplateInstance, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_v1_TemplateInstance(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -2987,6 +3461,77 @@ func deepCopy_v1_TemplateList(in templateapiv1.TemplateList, out *templateapiv1.
 	return nil
 }
 
+func deepCopy_v1_TemplateRepository(in templateapiv1.TemplateRepository, out *templateapiv1.TemplateRepository, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapiv1.ObjectMeta)
+	}
+	if err := deepCopy_v1_TemplateRepositorySpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_v1_TemplateRepositoryStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1_TemplateRepositoryList(in templateapiv1.TemplateRepositoryList, out *templateapiv1.TemplateRepositoryList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(unversioned.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]templateapiv1.TemplateRepository, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_v1_TemplateRepository(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_TemplateRepositorySpec(in templateapiv1.TemplateRepositorySpec, out *templateapiv1.TemplateRepositorySpec, c *conversion.Cloner) error {
+	out.Git = in.Git
+	out.Index = in.Index
+	out.Prune = in.Prune
+	return nil
+}
+
+func deepCopy_v1_TemplateRepositoryStatus(in templateapiv1.TemplateRepositoryStatus, out *templateapiv1.TemplateRepositoryStatus, c *conversion.Cloner) error {
+	if in.LastSyncTime != nil {
+		if newVal, err := c.DeepCopy(in.LastSyncTime); err != nil {
+			return err
+		} else {
+			out.LastSyncTime = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.LastSyncTime = nil
+	}
+	if in.Templates != nil {
+		out.Templates = make([]string, len(in.Templates))
+		copy(out.Templates, in.Templates)
+	} else {
+		out.Templates = nil
+	}
+	out.Message = in.Message
+	return nil
+}
+
 func deepCopy_v1_Group(in userapiv1.Group, out *userapiv1.Group, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -3167,6 +3712,7 @@ func deepCopy_v1_UserList(in userapiv1.UserList, out *userapiv1.UserList, c *con
 
 func init() {
 	err := api.Scheme.AddGeneratedDeepCopyFuncs(
+		deepCopy_v1_AggregationRule,
 		deepCopy_v1_AuthorizationAttributes,
 		deepCopy_v1_ClusterPolicy,
 		deepCopy_v1_ClusterPolicyBinding,
@@ -3188,6 +3734,7 @@ func init() {
 		deepCopy_v1_PolicyBindingList,
 		deepCopy_v1_PolicyList,
 		deepCopy_v1_PolicyRule,
+		deepCopy_v1_ResourceAccessGrant,
 		deepCopy_v1_ResourceAccessReview,
 		deepCopy_v1_ResourceAccessReviewResponse,
 		deepCopy_v1_Role,
@@ -3227,9 +3774,11 @@ func init() {
 		deepCopy_v1_SourceControlUser,
 		deepCopy_v1_SourceRevision,
 		deepCopy_v1_WebHookTrigger,
+		deepCopy_v1_CanaryDeploymentStrategyParams,
 		deepCopy_v1_CustomDeploymentStrategyParams,
 		deepCopy_v1_DeploymentCause,
 		deepCopy_v1_DeploymentCauseImageTrigger,
+		deepCopy_v1_DeploymentCondition,
 		deepCopy_v1_DeploymentConfig,
 		deepCopy_v1_DeploymentConfigList,
 		deepCopy_v1_DeploymentConfigRollback,
@@ -3239,7 +3788,9 @@ func init() {
 		deepCopy_v1_DeploymentDetails,
 		deepCopy_v1_DeploymentLog,
 		deepCopy_v1_DeploymentLogOptions,
+		deepCopy_v1_DeploymentRequest,
 		deepCopy_v1_DeploymentStrategy,
+		deepCopy_v1_DeploymentTriggerConfigChangeParams,
 		deepCopy_v1_DeploymentTriggerImageChangeParams,
 		deepCopy_v1_DeploymentTriggerPolicy,
 		deepCopy_v1_ExecNewPodHook,
@@ -3270,6 +3821,8 @@ func init() {
 		deepCopy_v1_TagEventCondition,
 		deepCopy_v1_TagImportPolicy,
 		deepCopy_v1_TagReference,
+		deepCopy_v1_TagReferencePolicy,
+		deepCopy_v1_ClusterRoleScopeRestriction,
 		deepCopy_v1_OAuthAccessToken,
 		deepCopy_v1_OAuthAccessTokenList,
 		deepCopy_v1_OAuthAuthorizeToken,
@@ -3278,18 +3831,21 @@ func init() {
 		deepCopy_v1_OAuthClientAuthorization,
 		deepCopy_v1_OAuthClientAuthorizationList,
 		deepCopy_v1_OAuthClientList,
+		deepCopy_v1_ScopeRestriction,
 		deepCopy_v1_Project,
 		deepCopy_v1_ProjectList,
 		deepCopy_v1_ProjectRequest,
 		deepCopy_v1_ProjectSpec,
 		deepCopy_v1_ProjectStatus,
 		deepCopy_v1_Route,
+		deepCopy_v1_RouteHealthCheck,
 		deepCopy_v1_RouteIngress,
 		deepCopy_v1_RouteIngressCondition,
 		deepCopy_v1_RouteList,
 		deepCopy_v1_RoutePort,
 		deepCopy_v1_RouteSpec,
 		deepCopy_v1_RouteStatus,
+		deepCopy_v1_RouteTargetReference,
 		deepCopy_v1_TLSConfig,
 		deepCopy_v1_ClusterNetwork,
 		deepCopy_v1_ClusterNetworkList,
@@ -3297,9 +3853,17 @@ func init() {
 		deepCopy_v1_HostSubnetList,
 		deepCopy_v1_NetNamespace,
 		deepCopy_v1_NetNamespaceList,
+		deepCopy_v1_GeneratorOptions,
 		deepCopy_v1_Parameter,
 		deepCopy_v1_Template,
+		deepCopy_v1_TemplateInclude,
+		deepCopy_v1_TemplateInstance,
+		deepCopy_v1_TemplateInstanceList,
 		deepCopy_v1_TemplateList,
+		deepCopy_v1_TemplateRepository,
+		deepCopy_v1_TemplateRepositoryList,
+		deepCopy_v1_TemplateRepositorySpec,
+		deepCopy_v1_TemplateRepositoryStatus,
 		deepCopy_v1_Group,
 		deepCopy_v1_GroupList,
 		deepCopy_v1_Identity,
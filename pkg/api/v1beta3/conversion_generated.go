@@ -135,6 +135,7 @@ func autoConvert_api_ClusterRole_To_v1beta3_ClusterRole(in *authorizationapi.Clu
 	} else {
 		out.Rules = nil
 	}
+	// in.AggregationRule has no peer in out
 	return nil
 }
 
@@ -347,6 +348,38 @@ func autoConvert_api_PolicyRule_To_v1beta3_PolicyRule(in *authorizationapi.Polic
 	return nil
 }
 
+func autoConvert_api_ResourceAccessGrant_To_v1beta3_ResourceAccessGrant(in *authorizationapi.ResourceAccessGrant, out *authorizationapiv1beta3.ResourceAccessGrant, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*authorizationapi.ResourceAccessGrant))(in)
+	}
+	out.Namespace = in.Namespace
+	out.RoleBinding = in.RoleBinding
+	if err := Convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.RoleRef, &out.RoleRef, s); err != nil {
+		return err
+	}
+	if in.Users != nil {
+		out.Users = make([]string, len(in.Users))
+		for i := range in.Users {
+			out.Users[i] = in.Users[i]
+		}
+	} else {
+		out.Users = nil
+	}
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	return nil
+}
+
+func Convert_api_ResourceAccessGrant_To_v1beta3_ResourceAccessGrant(in *authorizationapi.ResourceAccessGrant, out *authorizationapiv1beta3.ResourceAccessGrant, s conversion.Scope) error {
+	return autoConvert_api_ResourceAccessGrant_To_v1beta3_ResourceAccessGrant(in, out, s)
+}
+
 func autoConvert_api_ResourceAccessReview_To_v1beta3_ResourceAccessReview(in *authorizationapi.ResourceAccessReview, out *authorizationapiv1beta3.ResourceAccessReview, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*authorizationapi.ResourceAccessReview))(in)
@@ -362,6 +395,16 @@ func autoConvert_api_ResourceAccessReviewResponse_To_v1beta3_ResourceAccessRevie
 	out.Namespace = in.Namespace
 	// in.Users has no peer in out
 	// in.Groups has no peer in out
+	if in.Grants != nil {
+		out.Grants = make([]authorizationapiv1beta3.ResourceAccessGrant, len(in.Grants))
+		for i := range in.Grants {
+			if err := Convert_api_ResourceAccessGrant_To_v1beta3_ResourceAccessGrant(&in.Grants[i], &out.Grants[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Grants = nil
+	}
 	return nil
 }
 
@@ -382,6 +425,7 @@ func autoConvert_api_Role_To_v1beta3_Role(in *authorizationapi.Role, out *author
 	} else {
 		out.Rules = nil
 	}
+	// in.AggregationRule has no peer in out
 	return nil
 }
 
@@ -803,6 +847,38 @@ func autoConvert_v1beta3_PolicyRule_To_api_PolicyRule(in *authorizationapiv1beta
 	return nil
 }
 
+func autoConvert_v1beta3_ResourceAccessGrant_To_api_ResourceAccessGrant(in *authorizationapiv1beta3.ResourceAccessGrant, out *authorizationapi.ResourceAccessGrant, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*authorizationapiv1beta3.ResourceAccessGrant))(in)
+	}
+	out.Namespace = in.Namespace
+	out.RoleBinding = in.RoleBinding
+	if err := Convert_v1beta3_ObjectReference_To_api_ObjectReference(&in.RoleRef, &out.RoleRef, s); err != nil {
+		return err
+	}
+	if in.Users != nil {
+		out.Users = make([]string, len(in.Users))
+		for i := range in.Users {
+			out.Users[i] = in.Users[i]
+		}
+	} else {
+		out.Users = nil
+	}
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	return nil
+}
+
+func Convert_v1beta3_ResourceAccessGrant_To_api_ResourceAccessGrant(in *authorizationapiv1beta3.ResourceAccessGrant, out *authorizationapi.ResourceAccessGrant, s conversion.Scope) error {
+	return autoConvert_v1beta3_ResourceAccessGrant_To_api_ResourceAccessGrant(in, out, s)
+}
+
 func autoConvert_v1beta3_ResourceAccessReview_To_api_ResourceAccessReview(in *authorizationapiv1beta3.ResourceAccessReview, out *authorizationapi.ResourceAccessReview, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*authorizationapiv1beta3.ResourceAccessReview))(in)
@@ -818,6 +894,16 @@ func autoConvert_v1beta3_ResourceAccessReviewResponse_To_api_ResourceAccessRevie
 	out.Namespace = in.Namespace
 	// in.UsersSlice has no peer in out
 	// in.GroupsSlice has no peer in out
+	if in.Grants != nil {
+		out.Grants = make([]authorizationapi.ResourceAccessGrant, len(in.Grants))
+		for i := range in.Grants {
+			if err := Convert_v1beta3_ResourceAccessGrant_To_api_ResourceAccessGrant(&in.Grants[i], &out.Grants[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Grants = nil
+	}
 	return nil
 }
 
@@ -1052,6 +1138,19 @@ func autoConvert_api_BuildConfigSpec_To_v1beta3_BuildConfigSpec(in *buildapi.Bui
 	if err := Convert_api_BuildSpec_To_v1beta3_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
+	out.RunPolicy = v1beta3.BuildRunPolicy(in.RunPolicy)
 	return nil
 }
 
@@ -1148,6 +1247,19 @@ func autoConvert_api_BuildLogOptions_To_v1beta3_BuildLogOptions(in *buildapi.Bui
 	} else {
 		out.Version = nil
 	}
+	if in.OffsetBytes != nil {
+		out.OffsetBytes = new(int64)
+		*out.OffsetBytes = *in.OffsetBytes
+	} else {
+		out.OffsetBytes = nil
+	}
+	if in.OffsetLines != nil {
+		out.OffsetLines = new(int64)
+		*out.OffsetLines = *in.OffsetLines
+	} else {
+		out.OffsetLines = nil
+	}
+	out.Format = v1beta3.BuildLogFormat(in.Format)
 	return nil
 }
 
@@ -1294,6 +1406,14 @@ func autoConvert_api_BuildSpec_To_v1beta3_BuildSpec(in *buildapi.BuildSpec, out
 	if err := Convert_api_ResourceRequirements_To_v1beta3_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
+	if in.NodeSelector != nil {
+		out.NodeSelector = make(map[string]string)
+		for key, val := range in.NodeSelector {
+			out.NodeSelector[key] = val
+		}
+	} else {
+		out.NodeSelector = nil
+	}
 	if err := Convert_api_BuildPostCommitSpec_To_v1beta3_BuildPostCommitSpec(&in.PostCommit, &out.PostCommit, s); err != nil {
 		return err
 	}
@@ -1408,6 +1528,24 @@ func autoConvert_api_BuildTriggerPolicy_To_v1beta3_BuildTriggerPolicy(in *builda
 	} else {
 		out.GenericWebHook = nil
 	}
+	// unable to generate simple pointer conversion for api.WebHookTrigger -> v1beta3.WebHookTrigger
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(v1beta3.WebHookTrigger)
+		if err := Convert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	// unable to generate simple pointer conversion for api.WebHookTrigger -> v1beta3.WebHookTrigger
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(v1beta3.WebHookTrigger)
+		if err := Convert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	// unable to generate simple pointer conversion for api.ImageChangeTrigger -> v1beta3.ImageChangeTrigger
 	if in.ImageChange != nil {
 		out.ImageChange = new(v1beta3.ImageChangeTrigger)
@@ -1518,6 +1656,21 @@ func autoConvert_api_GitBuildSource_To_v1beta3_GitBuildSource(in *buildapi.GitBu
 	} else {
 		out.HTTPSProxy = nil
 	}
+	if in.NoProxy != nil {
+		out.NoProxy = new(string)
+		*out.NoProxy = *in.NoProxy
+	} else {
+		out.NoProxy = nil
+	}
+	out.SkipSubmodules = in.SkipSubmodules
+	if in.SparseCheckoutPaths != nil {
+		out.SparseCheckoutPaths = make([]string, len(in.SparseCheckoutPaths))
+		for i := range in.SparseCheckoutPaths {
+			out.SparseCheckoutPaths[i] = in.SparseCheckoutPaths[i]
+		}
+	} else {
+		out.SparseCheckoutPaths = nil
+	}
 	return nil
 }
 
@@ -1670,6 +1823,15 @@ func autoConvert_api_SourceBuildStrategy_To_v1beta3_SourceBuildStrategy(in *buil
 	out.Scripts = in.Scripts
 	out.Incremental = in.Incremental
 	out.ForcePull = in.ForcePull
+	// unable to generate simple pointer conversion for api.LocalObjectReference -> v1beta3.LocalObjectReference
+	if in.ArtifactCache != nil {
+		out.ArtifactCache = new(apiv1beta3.LocalObjectReference)
+		if err := Convert_api_LocalObjectReference_To_v1beta3_LocalObjectReference(in.ArtifactCache, out.ArtifactCache, s); err != nil {
+			return err
+		}
+	} else {
+		out.ArtifactCache = nil
+	}
 	return nil
 }
 
@@ -1707,6 +1869,8 @@ func autoConvert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in *buildapi.WebHo
 		defaulting.(func(*buildapi.WebHookTrigger))(in)
 	}
 	out.Secret = in.Secret
+	out.RefFilter = in.RefFilter
+	out.PathFilter = in.PathFilter
 	return nil
 }
 
@@ -1824,6 +1988,19 @@ func autoConvert_v1beta3_BuildConfigSpec_To_api_BuildConfigSpec(in *v1beta3.Buil
 	if err := Convert_v1beta3_BuildSpec_To_api_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
+	out.RunPolicy = buildapi.BuildRunPolicy(in.RunPolicy)
 	return nil
 }
 
@@ -1920,6 +2097,19 @@ func autoConvert_v1beta3_BuildLogOptions_To_api_BuildLogOptions(in *v1beta3.Buil
 	} else {
 		out.Version = nil
 	}
+	if in.OffsetBytes != nil {
+		out.OffsetBytes = new(int64)
+		*out.OffsetBytes = *in.OffsetBytes
+	} else {
+		out.OffsetBytes = nil
+	}
+	if in.OffsetLines != nil {
+		out.OffsetLines = new(int64)
+		*out.OffsetLines = *in.OffsetLines
+	} else {
+		out.OffsetLines = nil
+	}
+	out.Format = buildapi.BuildLogFormat(in.Format)
 	return nil
 }
 
@@ -2067,6 +2257,14 @@ func autoConvert_v1beta3_BuildSpec_To_api_BuildSpec(in *v1beta3.BuildSpec, out *
 	if err := Convert_v1beta3_ResourceRequirements_To_api_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
+	if in.NodeSelector != nil {
+		out.NodeSelector = make(map[string]string)
+		for key, val := range in.NodeSelector {
+			out.NodeSelector[key] = val
+		}
+	} else {
+		out.NodeSelector = nil
+	}
 	if err := Convert_v1beta3_BuildPostCommitSpec_To_api_BuildPostCommitSpec(&in.PostCommit, &out.PostCommit, s); err != nil {
 		return err
 	}
@@ -2182,6 +2380,24 @@ func autoConvert_v1beta3_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *v1beta
 	} else {
 		out.GenericWebHook = nil
 	}
+	// unable to generate simple pointer conversion for v1beta3.WebHookTrigger -> api.WebHookTrigger
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(buildapi.WebHookTrigger)
+		if err := Convert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	// unable to generate simple pointer conversion for v1beta3.WebHookTrigger -> api.WebHookTrigger
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(buildapi.WebHookTrigger)
+		if err := Convert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	// unable to generate simple pointer conversion for v1beta3.ImageChangeTrigger -> api.ImageChangeTrigger
 	if in.ImageChange != nil {
 		out.ImageChange = new(buildapi.ImageChangeTrigger)
@@ -2292,6 +2508,21 @@ func autoConvert_v1beta3_GitBuildSource_To_api_GitBuildSource(in *v1beta3.GitBui
 	} else {
 		out.HTTPSProxy = nil
 	}
+	if in.NoProxy != nil {
+		out.NoProxy = new(string)
+		*out.NoProxy = *in.NoProxy
+	} else {
+		out.NoProxy = nil
+	}
+	out.SkipSubmodules = in.SkipSubmodules
+	if in.SparseCheckoutPaths != nil {
+		out.SparseCheckoutPaths = make([]string, len(in.SparseCheckoutPaths))
+		for i := range in.SparseCheckoutPaths {
+			out.SparseCheckoutPaths[i] = in.SparseCheckoutPaths[i]
+		}
+	} else {
+		out.SparseCheckoutPaths = nil
+	}
 	return nil
 }
 
@@ -2444,6 +2675,15 @@ func autoConvert_v1beta3_SourceBuildStrategy_To_api_SourceBuildStrategy(in *v1be
 	out.Scripts = in.Scripts
 	out.Incremental = in.Incremental
 	out.ForcePull = in.ForcePull
+	// unable to generate simple pointer conversion for v1beta3.LocalObjectReference -> api.LocalObjectReference
+	if in.ArtifactCache != nil {
+		out.ArtifactCache = new(api.LocalObjectReference)
+		if err := Convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(in.ArtifactCache, out.ArtifactCache, s); err != nil {
+			return err
+		}
+	} else {
+		out.ArtifactCache = nil
+	}
 	return nil
 }
 
@@ -2482,6 +2722,8 @@ func autoConvert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in *v1beta3.WebHoo
 		defaulting.(func(*v1beta3.WebHookTrigger))(in)
 	}
 	out.Secret = in.Secret
+	out.RefFilter = in.RefFilter
+	out.PathFilter = in.PathFilter
 	return nil
 }
 
@@ -2524,6 +2766,27 @@ func Convert_api_DeploymentCauseImageTrigger_To_v1beta3_DeploymentCauseImageTrig
 	return autoConvert_api_DeploymentCauseImageTrigger_To_v1beta3_DeploymentCauseImageTrigger(in, out, s)
 }
 
+func autoConvert_api_DeploymentCondition_To_v1beta3_DeploymentCondition(in *deployapi.DeploymentCondition, out *deployapiv1beta3.DeploymentCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentCondition))(in)
+	}
+	out.Type = deployapiv1beta3.DeploymentConditionType(in.Type)
+	out.Status = apiv1beta3.ConditionStatus(in.Status)
+	if err := api.Convert_unversioned_Time_To_unversioned_Time(&in.LastUpdateTime, &out.LastUpdateTime, s); err != nil {
+		return err
+	}
+	if err := api.Convert_unversioned_Time_To_unversioned_Time(&in.LastTransitionTime, &out.LastTransitionTime, s); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func Convert_api_DeploymentCondition_To_v1beta3_DeploymentCondition(in *deployapi.DeploymentCondition, out *deployapiv1beta3.DeploymentCondition, s conversion.Scope) error {
+	return autoConvert_api_DeploymentCondition_To_v1beta3_DeploymentCondition(in, out, s)
+}
+
 func autoConvert_api_DeploymentConfigRollback_To_v1beta3_DeploymentConfigRollback(in *deployapi.DeploymentConfigRollback, out *deployapiv1beta3.DeploymentConfigRollback, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.DeploymentConfigRollback))(in)
@@ -2631,6 +2894,8 @@ func autoConvert_api_DeploymentLogOptions_To_v1beta3_DeploymentLogOptions(in *de
 	} else {
 		out.Version = nil
 	}
+	out.AllPods = in.AllPods
+	out.DeploymentPods = in.DeploymentPods
 	return nil
 }
 
@@ -2638,6 +2903,30 @@ func Convert_api_DeploymentLogOptions_To_v1beta3_DeploymentLogOptions(in *deploy
 	return autoConvert_api_DeploymentLogOptions_To_v1beta3_DeploymentLogOptions(in, out, s)
 }
 
+func autoConvert_api_DeploymentRequest_To_v1beta3_DeploymentRequest(in *deployapi.DeploymentRequest, out *deployapiv1beta3.DeploymentRequest, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentRequest))(in)
+	}
+	out.Name = in.Name
+	return nil
+}
+
+func Convert_api_DeploymentRequest_To_v1beta3_DeploymentRequest(in *deployapi.DeploymentRequest, out *deployapiv1beta3.DeploymentRequest, s conversion.Scope) error {
+	return autoConvert_api_DeploymentRequest_To_v1beta3_DeploymentRequest(in, out, s)
+}
+
+func autoConvert_api_DeploymentTriggerConfigChangeParams_To_v1beta3_DeploymentTriggerConfigChangeParams(in *deployapi.DeploymentTriggerConfigChangeParams, out *deployapiv1beta3.DeploymentTriggerConfigChangeParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentTriggerConfigChangeParams))(in)
+	}
+	out.DebounceSeconds = in.DebounceSeconds
+	return nil
+}
+
+func Convert_api_DeploymentTriggerConfigChangeParams_To_v1beta3_DeploymentTriggerConfigChangeParams(in *deployapi.DeploymentTriggerConfigChangeParams, out *deployapiv1beta3.DeploymentTriggerConfigChangeParams, s conversion.Scope) error {
+	return autoConvert_api_DeploymentTriggerConfigChangeParams_To_v1beta3_DeploymentTriggerConfigChangeParams(in, out, s)
+}
+
 func autoConvert_api_DeploymentTriggerImageChangeParams_To_v1beta3_DeploymentTriggerImageChangeParams(in *deployapi.DeploymentTriggerImageChangeParams, out *deployapiv1beta3.DeploymentTriggerImageChangeParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.DeploymentTriggerImageChangeParams))(in)
@@ -2671,6 +2960,15 @@ func autoConvert_api_DeploymentTriggerPolicy_To_v1beta3_DeploymentTriggerPolicy(
 	} else {
 		out.ImageChangeParams = nil
 	}
+	// unable to generate simple pointer conversion for api.DeploymentTriggerConfigChangeParams -> v1beta3.DeploymentTriggerConfigChangeParams
+	if in.ConfigChangeParams != nil {
+		out.ConfigChangeParams = new(deployapiv1beta3.DeploymentTriggerConfigChangeParams)
+		if err := Convert_api_DeploymentTriggerConfigChangeParams_To_v1beta3_DeploymentTriggerConfigChangeParams(in.ConfigChangeParams, out.ConfigChangeParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigChangeParams = nil
+	}
 	return nil
 }
 
@@ -2781,6 +3079,27 @@ func Convert_v1beta3_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrig
 	return autoConvert_v1beta3_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrigger(in, out, s)
 }
 
+func autoConvert_v1beta3_DeploymentCondition_To_api_DeploymentCondition(in *deployapiv1beta3.DeploymentCondition, out *deployapi.DeploymentCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.DeploymentCondition))(in)
+	}
+	out.Type = deployapi.DeploymentConditionType(in.Type)
+	out.Status = api.ConditionStatus(in.Status)
+	if err := api.Convert_unversioned_Time_To_unversioned_Time(&in.LastUpdateTime, &out.LastUpdateTime, s); err != nil {
+		return err
+	}
+	if err := api.Convert_unversioned_Time_To_unversioned_Time(&in.LastTransitionTime, &out.LastTransitionTime, s); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func Convert_v1beta3_DeploymentCondition_To_api_DeploymentCondition(in *deployapiv1beta3.DeploymentCondition, out *deployapi.DeploymentCondition, s conversion.Scope) error {
+	return autoConvert_v1beta3_DeploymentCondition_To_api_DeploymentCondition(in, out, s)
+}
+
 func autoConvert_v1beta3_DeploymentConfigRollback_To_api_DeploymentConfigRollback(in *deployapiv1beta3.DeploymentConfigRollback, out *deployapi.DeploymentConfigRollback, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1beta3.DeploymentConfigRollback))(in)
@@ -2827,6 +3146,16 @@ func autoConvert_v1beta3_DeploymentConfigStatus_To_api_DeploymentConfigStatus(in
 	} else {
 		out.Details = nil
 	}
+	if in.Conditions != nil {
+		out.Conditions = make([]deployapi.DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := Convert_v1beta3_DeploymentCondition_To_api_DeploymentCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -2909,6 +3238,8 @@ func autoConvert_v1beta3_DeploymentLogOptions_To_api_DeploymentLogOptions(in *de
 	} else {
 		out.Version = nil
 	}
+	out.AllPods = in.AllPods
+	out.DeploymentPods = in.DeploymentPods
 	return nil
 }
 
@@ -2916,6 +3247,30 @@ func Convert_v1beta3_DeploymentLogOptions_To_api_DeploymentLogOptions(in *deploy
 	return autoConvert_v1beta3_DeploymentLogOptions_To_api_DeploymentLogOptions(in, out, s)
 }
 
+func autoConvert_v1beta3_DeploymentRequest_To_api_DeploymentRequest(in *deployapiv1beta3.DeploymentRequest, out *deployapi.DeploymentRequest, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.DeploymentRequest))(in)
+	}
+	out.Name = in.Name
+	return nil
+}
+
+func Convert_v1beta3_DeploymentRequest_To_api_DeploymentRequest(in *deployapiv1beta3.DeploymentRequest, out *deployapi.DeploymentRequest, s conversion.Scope) error {
+	return autoConvert_v1beta3_DeploymentRequest_To_api_DeploymentRequest(in, out, s)
+}
+
+func autoConvert_v1beta3_DeploymentTriggerConfigChangeParams_To_api_DeploymentTriggerConfigChangeParams(in *deployapiv1beta3.DeploymentTriggerConfigChangeParams, out *deployapi.DeploymentTriggerConfigChangeParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.DeploymentTriggerConfigChangeParams))(in)
+	}
+	out.DebounceSeconds = in.DebounceSeconds
+	return nil
+}
+
+func Convert_v1beta3_DeploymentTriggerConfigChangeParams_To_api_DeploymentTriggerConfigChangeParams(in *deployapiv1beta3.DeploymentTriggerConfigChangeParams, out *deployapi.DeploymentTriggerConfigChangeParams, s conversion.Scope) error {
+	return autoConvert_v1beta3_DeploymentTriggerConfigChangeParams_To_api_DeploymentTriggerConfigChangeParams(in, out, s)
+}
+
 func autoConvert_v1beta3_DeploymentTriggerImageChangeParams_To_api_DeploymentTriggerImageChangeParams(in *deployapiv1beta3.DeploymentTriggerImageChangeParams, out *deployapi.DeploymentTriggerImageChangeParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1beta3.DeploymentTriggerImageChangeParams))(in)
@@ -2949,6 +3304,15 @@ func autoConvert_v1beta3_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy(
 	} else {
 		out.ImageChangeParams = nil
 	}
+	// unable to generate simple pointer conversion for v1beta3.DeploymentTriggerConfigChangeParams -> api.DeploymentTriggerConfigChangeParams
+	if in.ConfigChangeParams != nil {
+		out.ConfigChangeParams = new(deployapi.DeploymentTriggerConfigChangeParams)
+		if err := Convert_v1beta3_DeploymentTriggerConfigChangeParams_To_api_DeploymentTriggerConfigChangeParams(in.ConfigChangeParams, out.ConfigChangeParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigChangeParams = nil
+	}
 	return nil
 }
 
@@ -3043,6 +3407,8 @@ func autoConvert_api_Image_To_v1beta3_Image(in *imageapi.Image, out *imageapiv1b
 	} else {
 		out.DockerImageLayers = nil
 	}
+	out.DockerImageConfig = in.DockerImageConfig
+	out.DockerImageManifestMediaType = in.DockerImageManifestMediaType
 	return nil
 }
 
@@ -3223,6 +3589,8 @@ func autoConvert_v1beta3_Image_To_api_Image(in *imageapiv1beta3.Image, out *imag
 	} else {
 		out.DockerImageLayers = nil
 	}
+	out.DockerImageConfig = in.DockerImageConfig
+	out.DockerImageManifestMediaType = in.DockerImageManifestMediaType
 	return nil
 }
 
@@ -3372,6 +3740,34 @@ func Convert_v1beta3_ImageStreamTagList_To_api_ImageStreamTagList(in *imageapiv1
 	return autoConvert_v1beta3_ImageStreamTagList_To_api_ImageStreamTagList(in, out, s)
 }
 
+func autoConvert_api_ClusterRoleScopeRestriction_To_v1beta3_ClusterRoleScopeRestriction(in *oauthapi.ClusterRoleScopeRestriction, out *oauthapiv1beta3.ClusterRoleScopeRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*oauthapi.ClusterRoleScopeRestriction))(in)
+	}
+	if in.RoleNames != nil {
+		out.RoleNames = make([]string, len(in.RoleNames))
+		for i := range in.RoleNames {
+			out.RoleNames[i] = in.RoleNames[i]
+		}
+	} else {
+		out.RoleNames = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	out.AllowEscalation = in.AllowEscalation
+	return nil
+}
+
+func Convert_api_ClusterRoleScopeRestriction_To_v1beta3_ClusterRoleScopeRestriction(in *oauthapi.ClusterRoleScopeRestriction, out *oauthapiv1beta3.ClusterRoleScopeRestriction, s conversion.Scope) error {
+	return autoConvert_api_ClusterRoleScopeRestriction_To_v1beta3_ClusterRoleScopeRestriction(in, out, s)
+}
+
 func autoConvert_api_OAuthAccessToken_To_v1beta3_OAuthAccessToken(in *oauthapi.OAuthAccessToken, out *oauthapiv1beta3.OAuthAccessToken, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*oauthapi.OAuthAccessToken))(in)
@@ -3394,6 +3790,7 @@ func autoConvert_api_OAuthAccessToken_To_v1beta3_OAuthAccessToken(in *oauthapi.O
 	out.UserUID = in.UserUID
 	out.AuthorizeToken = in.AuthorizeToken
 	out.RefreshToken = in.RefreshToken
+	out.InactivityTimeoutSeconds = in.InactivityTimeoutSeconds
 	return nil
 }
 
@@ -3446,6 +3843,8 @@ func autoConvert_api_OAuthAuthorizeToken_To_v1beta3_OAuthAuthorizeToken(in *oaut
 	out.State = in.State
 	out.UserName = in.UserName
 	out.UserUID = in.UserUID
+	out.CodeChallenge = in.CodeChallenge
+	out.CodeChallengeMethod = in.CodeChallengeMethod
 	return nil
 }
 
@@ -3494,6 +3893,28 @@ func autoConvert_api_OAuthClient_To_v1beta3_OAuthClient(in *oauthapi.OAuthClient
 	} else {
 		out.RedirectURIs = nil
 	}
+	if in.AccessTokenMaxAgeSeconds != nil {
+		out.AccessTokenMaxAgeSeconds = new(int32)
+		*out.AccessTokenMaxAgeSeconds = *in.AccessTokenMaxAgeSeconds
+	} else {
+		out.AccessTokenMaxAgeSeconds = nil
+	}
+	if in.AccessTokenInactivityTimeoutSeconds != nil {
+		out.AccessTokenInactivityTimeoutSeconds = new(int32)
+		*out.AccessTokenInactivityTimeoutSeconds = *in.AccessTokenInactivityTimeoutSeconds
+	} else {
+		out.AccessTokenInactivityTimeoutSeconds = nil
+	}
+	if in.ScopeRestrictions != nil {
+		out.ScopeRestrictions = make([]oauthapiv1beta3.ScopeRestriction, len(in.ScopeRestrictions))
+		for i := range in.ScopeRestrictions {
+			if err := Convert_api_ScopeRestriction_To_v1beta3_ScopeRestriction(&in.ScopeRestrictions[i], &out.ScopeRestrictions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ScopeRestrictions = nil
+	}
 	return nil
 }
 
@@ -3574,6 +3995,62 @@ func Convert_api_OAuthClientList_To_v1beta3_OAuthClientList(in *oauthapi.OAuthCl
 	return autoConvert_api_OAuthClientList_To_v1beta3_OAuthClientList(in, out, s)
 }
 
+func autoConvert_api_ScopeRestriction_To_v1beta3_ScopeRestriction(in *oauthapi.ScopeRestriction, out *oauthapiv1beta3.ScopeRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*oauthapi.ScopeRestriction))(in)
+	}
+	if in.ExactValues != nil {
+		out.ExactValues = make([]string, len(in.ExactValues))
+		for i := range in.ExactValues {
+			out.ExactValues[i] = in.ExactValues[i]
+		}
+	} else {
+		out.ExactValues = nil
+	}
+	// unable to generate simple pointer conversion for api.ClusterRoleScopeRestriction -> v1beta3.ClusterRoleScopeRestriction
+	if in.ClusterRole != nil {
+		out.ClusterRole = new(oauthapiv1beta3.ClusterRoleScopeRestriction)
+		if err := Convert_api_ClusterRoleScopeRestriction_To_v1beta3_ClusterRoleScopeRestriction(in.ClusterRole, out.ClusterRole, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClusterRole = nil
+	}
+	return nil
+}
+
+func Convert_api_ScopeRestriction_To_v1beta3_ScopeRestriction(in *oauthapi.ScopeRestriction, out *oauthapiv1beta3.ScopeRestriction, s conversion.Scope) error {
+	return autoConvert_api_ScopeRestriction_To_v1beta3_ScopeRestriction(in, out, s)
+}
+
+func autoConvert_v1beta3_ClusterRoleScopeRestriction_To_api_ClusterRoleScopeRestriction(in *oauthapiv1beta3.ClusterRoleScopeRestriction, out *oauthapi.ClusterRoleScopeRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*oauthapiv1beta3.ClusterRoleScopeRestriction))(in)
+	}
+	if in.RoleNames != nil {
+		out.RoleNames = make([]string, len(in.RoleNames))
+		for i := range in.RoleNames {
+			out.RoleNames[i] = in.RoleNames[i]
+		}
+	} else {
+		out.RoleNames = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	out.AllowEscalation = in.AllowEscalation
+	return nil
+}
+
+func Convert_v1beta3_ClusterRoleScopeRestriction_To_api_ClusterRoleScopeRestriction(in *oauthapiv1beta3.ClusterRoleScopeRestriction, out *oauthapi.ClusterRoleScopeRestriction, s conversion.Scope) error {
+	return autoConvert_v1beta3_ClusterRoleScopeRestriction_To_api_ClusterRoleScopeRestriction(in, out, s)
+}
+
 func autoConvert_v1beta3_OAuthAccessToken_To_api_OAuthAccessToken(in *oauthapiv1beta3.OAuthAccessToken, out *oauthapi.OAuthAccessToken, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*oauthapiv1beta3.OAuthAccessToken))(in)
@@ -3596,6 +4073,7 @@ func autoConvert_v1beta3_OAuthAccessToken_To_api_OAuthAccessToken(in *oauthapiv1
 	out.UserUID = in.UserUID
 	out.AuthorizeToken = in.AuthorizeToken
 	out.RefreshToken = in.RefreshToken
+	out.InactivityTimeoutSeconds = in.InactivityTimeoutSeconds
 	return nil
 }
 
@@ -3648,6 +4126,8 @@ func autoConvert_v1beta3_OAuthAuthorizeToken_To_api_OAuthAuthorizeToken(in *oaut
 	out.State = in.State
 	out.UserName = in.UserName
 	out.UserUID = in.UserUID
+	out.CodeChallenge = in.CodeChallenge
+	out.CodeChallengeMethod = in.CodeChallengeMethod
 	return nil
 }
 
@@ -3696,6 +4176,28 @@ func autoConvert_v1beta3_OAuthClient_To_api_OAuthClient(in *oauthapiv1beta3.OAut
 	} else {
 		out.RedirectURIs = nil
 	}
+	if in.AccessTokenMaxAgeSeconds != nil {
+		out.AccessTokenMaxAgeSeconds = new(int32)
+		*out.AccessTokenMaxAgeSeconds = *in.AccessTokenMaxAgeSeconds
+	} else {
+		out.AccessTokenMaxAgeSeconds = nil
+	}
+	if in.AccessTokenInactivityTimeoutSeconds != nil {
+		out.AccessTokenInactivityTimeoutSeconds = new(int32)
+		*out.AccessTokenInactivityTimeoutSeconds = *in.AccessTokenInactivityTimeoutSeconds
+	} else {
+		out.AccessTokenInactivityTimeoutSeconds = nil
+	}
+	if in.ScopeRestrictions != nil {
+		out.ScopeRestrictions = make([]oauthapi.ScopeRestriction, len(in.ScopeRestrictions))
+		for i := range in.ScopeRestrictions {
+			if err := Convert_v1beta3_ScopeRestriction_To_api_ScopeRestriction(&in.ScopeRestrictions[i], &out.ScopeRestrictions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ScopeRestrictions = nil
+	}
 	return nil
 }
 
@@ -3776,6 +4278,34 @@ func Convert_v1beta3_OAuthClientList_To_api_OAuthClientList(in *oauthapiv1beta3.
 	return autoConvert_v1beta3_OAuthClientList_To_api_OAuthClientList(in, out, s)
 }
 
+func autoConvert_v1beta3_ScopeRestriction_To_api_ScopeRestriction(in *oauthapiv1beta3.ScopeRestriction, out *oauthapi.ScopeRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*oauthapiv1beta3.ScopeRestriction))(in)
+	}
+	if in.ExactValues != nil {
+		out.ExactValues = make([]string, len(in.ExactValues))
+		for i := range in.ExactValues {
+			out.ExactValues[i] = in.ExactValues[i]
+		}
+	} else {
+		out.ExactValues = nil
+	}
+	// unable to generate simple pointer conversion for v1beta3.ClusterRoleScopeRestriction -> api.ClusterRoleScopeRestriction
+	if in.ClusterRole != nil {
+		out.ClusterRole = new(oauthapi.ClusterRoleScopeRestriction)
+		if err := Convert_v1beta3_ClusterRoleScopeRestriction_To_api_ClusterRoleScopeRestriction(in.ClusterRole, out.ClusterRole, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClusterRole = nil
+	}
+	return nil
+}
+
+func Convert_v1beta3_ScopeRestriction_To_api_ScopeRestriction(in *oauthapiv1beta3.ScopeRestriction, out *oauthapi.ScopeRestriction, s conversion.Scope) error {
+	return autoConvert_v1beta3_ScopeRestriction_To_api_ScopeRestriction(in, out, s)
+}
+
 func autoConvert_api_Project_To_v1beta3_Project(in *projectapi.Project, out *projectapiv1beta3.Project, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*projectapi.Project))(in)
@@ -3978,6 +4508,19 @@ func Convert_api_Route_To_v1beta3_Route(in *routeapi.Route, out *routeapiv1beta3
 	return autoConvert_api_Route_To_v1beta3_Route(in, out, s)
 }
 
+func autoConvert_api_RouteHealthCheck_To_v1beta3_RouteHealthCheck(in *routeapi.RouteHealthCheck, out *routeapiv1beta3.RouteHealthCheck, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.RouteHealthCheck))(in)
+	}
+	out.Path = in.Path
+	out.IntervalSeconds = in.IntervalSeconds
+	return nil
+}
+
+func Convert_api_RouteHealthCheck_To_v1beta3_RouteHealthCheck(in *routeapi.RouteHealthCheck, out *routeapiv1beta3.RouteHealthCheck, s conversion.Scope) error {
+	return autoConvert_api_RouteHealthCheck_To_v1beta3_RouteHealthCheck(in, out, s)
+}
+
 func autoConvert_api_RouteIngress_To_v1beta3_RouteIngress(in *routeapi.RouteIngress, out *routeapiv1beta3.RouteIngress, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapi.RouteIngress))(in)
@@ -4069,9 +4612,19 @@ func autoConvert_api_RouteSpec_To_v1beta3_RouteSpec(in *routeapi.RouteSpec, out
 	}
 	out.Host = in.Host
 	out.Path = in.Path
-	if err := Convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.To, &out.To, s); err != nil {
+	if err := Convert_api_RouteTargetReference_To_v1beta3_RouteTargetReference(&in.To, &out.To, s); err != nil {
 		return err
 	}
+	if in.AlternateBackends != nil {
+		out.AlternateBackends = make([]routeapiv1beta3.RouteTargetReference, len(in.AlternateBackends))
+		for i := range in.AlternateBackends {
+			if err := Convert_api_RouteTargetReference_To_v1beta3_RouteTargetReference(&in.AlternateBackends[i], &out.AlternateBackends[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AlternateBackends = nil
+	}
 	// unable to generate simple pointer conversion for api.RoutePort -> v1beta3.RoutePort
 	if in.Port != nil {
 		out.Port = new(routeapiv1beta3.RoutePort)
@@ -4081,6 +4634,15 @@ func autoConvert_api_RouteSpec_To_v1beta3_RouteSpec(in *routeapi.RouteSpec, out
 	} else {
 		out.Port = nil
 	}
+	// unable to generate simple pointer conversion for api.RouteHealthCheck -> v1beta3.RouteHealthCheck
+	if in.HealthCheck != nil {
+		out.HealthCheck = new(routeapiv1beta3.RouteHealthCheck)
+		if err := Convert_api_RouteHealthCheck_To_v1beta3_RouteHealthCheck(in.HealthCheck, out.HealthCheck, s); err != nil {
+			return err
+		}
+	} else {
+		out.HealthCheck = nil
+	}
 	// unable to generate simple pointer conversion for api.TLSConfig -> v1beta3.TLSConfig
 	if in.TLS != nil {
 		out.TLS = new(routeapiv1beta3.TLSConfig)
@@ -4118,6 +4680,25 @@ func Convert_api_RouteStatus_To_v1beta3_RouteStatus(in *routeapi.RouteStatus, ou
 	return autoConvert_api_RouteStatus_To_v1beta3_RouteStatus(in, out, s)
 }
 
+func autoConvert_api_RouteTargetReference_To_v1beta3_RouteTargetReference(in *routeapi.RouteTargetReference, out *routeapiv1beta3.RouteTargetReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.RouteTargetReference))(in)
+	}
+	out.Kind = in.Kind
+	out.Name = in.Name
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	return nil
+}
+
+func Convert_api_RouteTargetReference_To_v1beta3_RouteTargetReference(in *routeapi.RouteTargetReference, out *routeapiv1beta3.RouteTargetReference, s conversion.Scope) error {
+	return autoConvert_api_RouteTargetReference_To_v1beta3_RouteTargetReference(in, out, s)
+}
+
 func autoConvert_api_TLSConfig_To_v1beta3_TLSConfig(in *routeapi.TLSConfig, out *routeapiv1beta3.TLSConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapi.TLSConfig))(in)
@@ -4155,6 +4736,19 @@ func Convert_v1beta3_Route_To_api_Route(in *routeapiv1beta3.Route, out *routeapi
 	return autoConvert_v1beta3_Route_To_api_Route(in, out, s)
 }
 
+func autoConvert_v1beta3_RouteHealthCheck_To_api_RouteHealthCheck(in *routeapiv1beta3.RouteHealthCheck, out *routeapi.RouteHealthCheck, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1beta3.RouteHealthCheck))(in)
+	}
+	out.Path = in.Path
+	out.IntervalSeconds = in.IntervalSeconds
+	return nil
+}
+
+func Convert_v1beta3_RouteHealthCheck_To_api_RouteHealthCheck(in *routeapiv1beta3.RouteHealthCheck, out *routeapi.RouteHealthCheck, s conversion.Scope) error {
+	return autoConvert_v1beta3_RouteHealthCheck_To_api_RouteHealthCheck(in, out, s)
+}
+
 func autoConvert_v1beta3_RouteIngress_To_api_RouteIngress(in *routeapiv1beta3.RouteIngress, out *routeapi.RouteIngress, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapiv1beta3.RouteIngress))(in)
@@ -4246,9 +4840,19 @@ func autoConvert_v1beta3_RouteSpec_To_api_RouteSpec(in *routeapiv1beta3.RouteSpe
 	}
 	out.Host = in.Host
 	out.Path = in.Path
-	if err := Convert_v1beta3_ObjectReference_To_api_ObjectReference(&in.To, &out.To, s); err != nil {
+	if err := Convert_v1beta3_RouteTargetReference_To_api_RouteTargetReference(&in.To, &out.To, s); err != nil {
 		return err
 	}
+	if in.AlternateBackends != nil {
+		out.AlternateBackends = make([]routeapi.RouteTargetReference, len(in.AlternateBackends))
+		for i := range in.AlternateBackends {
+			if err := Convert_v1beta3_RouteTargetReference_To_api_RouteTargetReference(&in.AlternateBackends[i], &out.AlternateBackends[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AlternateBackends = nil
+	}
 	// unable to generate simple pointer conversion for v1beta3.RoutePort -> api.RoutePort
 	if in.Port != nil {
 		out.Port = new(routeapi.RoutePort)
@@ -4258,6 +4862,15 @@ func autoConvert_v1beta3_RouteSpec_To_api_RouteSpec(in *routeapiv1beta3.RouteSpe
 	} else {
 		out.Port = nil
 	}
+	// unable to generate simple pointer conversion for v1beta3.RouteHealthCheck -> api.RouteHealthCheck
+	if in.HealthCheck != nil {
+		out.HealthCheck = new(routeapi.RouteHealthCheck)
+		if err := Convert_v1beta3_RouteHealthCheck_To_api_RouteHealthCheck(in.HealthCheck, out.HealthCheck, s); err != nil {
+			return err
+		}
+	} else {
+		out.HealthCheck = nil
+	}
 	// unable to generate simple pointer conversion for v1beta3.TLSConfig -> api.TLSConfig
 	if in.TLS != nil {
 		out.TLS = new(routeapi.TLSConfig)
@@ -4295,6 +4908,25 @@ func Convert_v1beta3_RouteStatus_To_api_RouteStatus(in *routeapiv1beta3.RouteSta
 	return autoConvert_v1beta3_RouteStatus_To_api_RouteStatus(in, out, s)
 }
 
+func autoConvert_v1beta3_RouteTargetReference_To_api_RouteTargetReference(in *routeapiv1beta3.RouteTargetReference, out *routeapi.RouteTargetReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1beta3.RouteTargetReference))(in)
+	}
+	out.Kind = in.Kind
+	out.Name = in.Name
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	return nil
+}
+
+func Convert_v1beta3_RouteTargetReference_To_api_RouteTargetReference(in *routeapiv1beta3.RouteTargetReference, out *routeapi.RouteTargetReference, s conversion.Scope) error {
+	return autoConvert_v1beta3_RouteTargetReference_To_api_RouteTargetReference(in, out, s)
+}
+
 func autoConvert_v1beta3_TLSConfig_To_api_TLSConfig(in *routeapiv1beta3.TLSConfig, out *routeapi.TLSConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapiv1beta3.TLSConfig))(in)
@@ -4556,6 +5188,22 @@ func Convert_v1beta3_NetNamespaceList_To_api_NetNamespaceList(in *sdnapiv1beta3.
 	return autoConvert_v1beta3_NetNamespaceList_To_api_NetNamespaceList(in, out, s)
 }
 
+func autoConvert_api_GeneratorOptions_To_v1beta3_GeneratorOptions(in *templateapi.GeneratorOptions, out *templateapiv1beta3.GeneratorOptions, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapi.GeneratorOptions))(in)
+	}
+	out.Charset = in.Charset
+	out.Exclude = in.Exclude
+	out.ExcludeAmbiguous = in.ExcludeAmbiguous
+	out.MinLength = in.MinLength
+	out.MaxLength = in.MaxLength
+	return nil
+}
+
+func Convert_api_GeneratorOptions_To_v1beta3_GeneratorOptions(in *templateapi.GeneratorOptions, out *templateapiv1beta3.GeneratorOptions, s conversion.Scope) error {
+	return autoConvert_api_GeneratorOptions_To_v1beta3_GeneratorOptions(in, out, s)
+}
+
 func autoConvert_api_Parameter_To_v1beta3_Parameter(in *templateapi.Parameter, out *templateapiv1beta3.Parameter, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*templateapi.Parameter))(in)
@@ -4566,7 +5214,27 @@ func autoConvert_api_Parameter_To_v1beta3_Parameter(in *templateapi.Parameter, o
 	out.Value = in.Value
 	out.Generate = in.Generate
 	out.From = in.From
+	out.FromEnv = in.FromEnv
 	out.Required = in.Required
+	out.Type = templateapiv1beta3.ParameterType(in.Type)
+	if in.Allowed != nil {
+		out.Allowed = make([]string, len(in.Allowed))
+		for i := range in.Allowed {
+			out.Allowed[i] = in.Allowed[i]
+		}
+	} else {
+		out.Allowed = nil
+	}
+	out.Sensitive = in.Sensitive
+	// unable to generate simple pointer conversion for api.GeneratorOptions -> v1beta3.GeneratorOptions
+	if in.GeneratorOptions != nil {
+		out.GeneratorOptions = new(templateapiv1beta3.GeneratorOptions)
+		if err := Convert_api_GeneratorOptions_To_v1beta3_GeneratorOptions(in.GeneratorOptions, out.GeneratorOptions, s); err != nil {
+			return err
+		}
+	} else {
+		out.GeneratorOptions = nil
+	}
 	return nil
 }
 
@@ -4602,9 +5270,41 @@ func autoConvert_api_Template_To_v1beta3_Template(in *templateapi.Template, out
 		out.Objects = nil
 	}
 	// in.ObjectLabels has no peer in out
+	if in.ObjectAnnotations != nil {
+		out.ObjectAnnotations = make(map[string]string)
+		for key, val := range in.ObjectAnnotations {
+			out.ObjectAnnotations[key] = val
+		}
+	} else {
+		out.ObjectAnnotations = nil
+	}
+	if in.Includes != nil {
+		out.Includes = make([]templateapiv1beta3.TemplateInclude, len(in.Includes))
+		for i := range in.Includes {
+			if err := Convert_api_TemplateInclude_To_v1beta3_TemplateInclude(&in.Includes[i], &out.Includes[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Includes = nil
+	}
+	out.Message = in.Message
 	return nil
 }
 
+func autoConvert_api_TemplateInclude_To_v1beta3_TemplateInclude(in *templateapi.TemplateInclude, out *templateapiv1beta3.TemplateInclude, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapi.TemplateInclude))(in)
+	}
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+func Convert_api_TemplateInclude_To_v1beta3_TemplateInclude(in *templateapi.TemplateInclude, out *templateapiv1beta3.TemplateInclude, s conversion.Scope) error {
+	return autoConvert_api_TemplateInclude_To_v1beta3_TemplateInclude(in, out, s)
+}
+
 func autoConvert_api_TemplateList_To_v1beta3_TemplateList(in *templateapi.TemplateList, out *templateapiv1beta3.TemplateList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*templateapi.TemplateList))(in)
@@ -4629,6 +5329,22 @@ func Convert_api_TemplateList_To_v1beta3_TemplateList(in *templateapi.TemplateLi
 	return autoConvert_api_TemplateList_To_v1beta3_TemplateList(in, out, s)
 }
 
+func autoConvert_v1beta3_GeneratorOptions_To_api_GeneratorOptions(in *templateapiv1beta3.GeneratorOptions, out *templateapi.GeneratorOptions, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapiv1beta3.GeneratorOptions))(in)
+	}
+	out.Charset = in.Charset
+	out.Exclude = in.Exclude
+	out.ExcludeAmbiguous = in.ExcludeAmbiguous
+	out.MinLength = in.MinLength
+	out.MaxLength = in.MaxLength
+	return nil
+}
+
+func Convert_v1beta3_GeneratorOptions_To_api_GeneratorOptions(in *templateapiv1beta3.GeneratorOptions, out *templateapi.GeneratorOptions, s conversion.Scope) error {
+	return autoConvert_v1beta3_GeneratorOptions_To_api_GeneratorOptions(in, out, s)
+}
+
 func autoConvert_v1beta3_Parameter_To_api_Parameter(in *templateapiv1beta3.Parameter, out *templateapi.Parameter, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*templateapiv1beta3.Parameter))(in)
@@ -4639,7 +5355,27 @@ func autoConvert_v1beta3_Parameter_To_api_Parameter(in *templateapiv1beta3.Param
 	out.Value = in.Value
 	out.Generate = in.Generate
 	out.From = in.From
+	out.FromEnv = in.FromEnv
 	out.Required = in.Required
+	out.Type = templateapi.ParameterType(in.Type)
+	if in.Allowed != nil {
+		out.Allowed = make([]string, len(in.Allowed))
+		for i := range in.Allowed {
+			out.Allowed[i] = in.Allowed[i]
+		}
+	} else {
+		out.Allowed = nil
+	}
+	out.Sensitive = in.Sensitive
+	// unable to generate simple pointer conversion for v1beta3.GeneratorOptions -> api.GeneratorOptions
+	if in.GeneratorOptions != nil {
+		out.GeneratorOptions = new(templateapi.GeneratorOptions)
+		if err := Convert_v1beta3_GeneratorOptions_To_api_GeneratorOptions(in.GeneratorOptions, out.GeneratorOptions, s); err != nil {
+			return err
+		}
+	} else {
+		out.GeneratorOptions = nil
+	}
 	return nil
 }
 
@@ -4682,9 +5418,41 @@ func autoConvert_v1beta3_Template_To_api_Template(in *templateapiv1beta3.Templat
 	} else {
 		out.Labels = nil
 	}
+	if in.ObjectAnnotations != nil {
+		out.ObjectAnnotations = make(map[string]string)
+		for key, val := range in.ObjectAnnotations {
+			out.ObjectAnnotations[key] = val
+		}
+	} else {
+		out.ObjectAnnotations = nil
+	}
+	if in.Includes != nil {
+		out.Includes = make([]templateapi.TemplateInclude, len(in.Includes))
+		for i := range in.Includes {
+			if err := Convert_v1beta3_TemplateInclude_To_api_TemplateInclude(&in.Includes[i], &out.Includes[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Includes = nil
+	}
+	out.Message = in.Message
+	return nil
+}
+
+func autoConvert_v1beta3_TemplateInclude_To_api_TemplateInclude(in *templateapiv1beta3.TemplateInclude, out *templateapi.TemplateInclude, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapiv1beta3.TemplateInclude))(in)
+	}
+	out.Name = in.Name
+	out.Namespace = in.Namespace
 	return nil
 }
 
+func Convert_v1beta3_TemplateInclude_To_api_TemplateInclude(in *templateapiv1beta3.TemplateInclude, out *templateapi.TemplateInclude, s conversion.Scope) error {
+	return autoConvert_v1beta3_TemplateInclude_To_api_TemplateInclude(in, out, s)
+}
+
 func autoConvert_v1beta3_TemplateList_To_api_TemplateList(in *templateapiv1beta3.TemplateList, out *templateapi.TemplateList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*templateapiv1beta3.TemplateList))(in)
@@ -6628,17 +7396,21 @@ func init() {
 		autoConvert_api_ClusterRoleBindingList_To_v1beta3_ClusterRoleBindingList,
 		autoConvert_api_ClusterRoleBinding_To_v1beta3_ClusterRoleBinding,
 		autoConvert_api_ClusterRoleList_To_v1beta3_ClusterRoleList,
+		autoConvert_api_ClusterRoleScopeRestriction_To_v1beta3_ClusterRoleScopeRestriction,
 		autoConvert_api_ClusterRole_To_v1beta3_ClusterRole,
 		autoConvert_api_ContainerPort_To_v1beta3_ContainerPort,
 		autoConvert_api_Container_To_v1beta3_Container,
 		autoConvert_api_CustomBuildStrategy_To_v1beta3_CustomBuildStrategy,
 		autoConvert_api_DeploymentCauseImageTrigger_To_v1beta3_DeploymentCauseImageTrigger,
 		autoConvert_api_DeploymentCause_To_v1beta3_DeploymentCause,
+		autoConvert_api_DeploymentCondition_To_v1beta3_DeploymentCondition,
 		autoConvert_api_DeploymentConfigRollbackSpec_To_v1beta3_DeploymentConfigRollbackSpec,
 		autoConvert_api_DeploymentConfigRollback_To_v1beta3_DeploymentConfigRollback,
 		autoConvert_api_DeploymentDetails_To_v1beta3_DeploymentDetails,
 		autoConvert_api_DeploymentLogOptions_To_v1beta3_DeploymentLogOptions,
 		autoConvert_api_DeploymentLog_To_v1beta3_DeploymentLog,
+		autoConvert_api_DeploymentRequest_To_v1beta3_DeploymentRequest,
+		autoConvert_api_DeploymentTriggerConfigChangeParams_To_v1beta3_DeploymentTriggerConfigChangeParams,
 		autoConvert_api_DeploymentTriggerImageChangeParams_To_v1beta3_DeploymentTriggerImageChangeParams,
 		autoConvert_api_DeploymentTriggerPolicy_To_v1beta3_DeploymentTriggerPolicy,
 		autoConvert_api_DockerBuildStrategy_To_v1beta3_DockerBuildStrategy,
@@ -6649,6 +7421,7 @@ func init() {
 		autoConvert_api_FCVolumeSource_To_v1beta3_FCVolumeSource,
 		autoConvert_api_FlockerVolumeSource_To_v1beta3_FlockerVolumeSource,
 		autoConvert_api_GCEPersistentDiskVolumeSource_To_v1beta3_GCEPersistentDiskVolumeSource,
+		autoConvert_api_GeneratorOptions_To_v1beta3_GeneratorOptions,
 		autoConvert_api_GitBuildSource_To_v1beta3_GitBuildSource,
 		autoConvert_api_GitSourceRevision_To_v1beta3_GitSourceRevision,
 		autoConvert_api_GlusterfsVolumeSource_To_v1beta3_GlusterfsVolumeSource,
@@ -6705,6 +7478,7 @@ func init() {
 		autoConvert_api_ProjectStatus_To_v1beta3_ProjectStatus,
 		autoConvert_api_Project_To_v1beta3_Project,
 		autoConvert_api_RBDVolumeSource_To_v1beta3_RBDVolumeSource,
+		autoConvert_api_ResourceAccessGrant_To_v1beta3_ResourceAccessGrant,
 		autoConvert_api_ResourceAccessReviewResponse_To_v1beta3_ResourceAccessReviewResponse,
 		autoConvert_api_ResourceAccessReview_To_v1beta3_ResourceAccessReview,
 		autoConvert_api_ResourceRequirements_To_v1beta3_ResourceRequirements,
@@ -6713,13 +7487,16 @@ func init() {
 		autoConvert_api_RoleList_To_v1beta3_RoleList,
 		autoConvert_api_Role_To_v1beta3_Role,
 		autoConvert_api_RollingDeploymentStrategyParams_To_v1beta3_RollingDeploymentStrategyParams,
+		autoConvert_api_RouteHealthCheck_To_v1beta3_RouteHealthCheck,
 		autoConvert_api_RouteIngressCondition_To_v1beta3_RouteIngressCondition,
 		autoConvert_api_RouteIngress_To_v1beta3_RouteIngress,
 		autoConvert_api_RouteList_To_v1beta3_RouteList,
 		autoConvert_api_RoutePort_To_v1beta3_RoutePort,
 		autoConvert_api_RouteSpec_To_v1beta3_RouteSpec,
 		autoConvert_api_RouteStatus_To_v1beta3_RouteStatus,
+		autoConvert_api_RouteTargetReference_To_v1beta3_RouteTargetReference,
 		autoConvert_api_Route_To_v1beta3_Route,
+		autoConvert_api_ScopeRestriction_To_v1beta3_ScopeRestriction,
 		autoConvert_api_SecretBuildSource_To_v1beta3_SecretBuildSource,
 		autoConvert_api_SecretSpec_To_v1beta3_SecretSpec,
 		autoConvert_api_SecretVolumeSource_To_v1beta3_SecretVolumeSource,
@@ -6731,6 +7508,7 @@ func init() {
 		autoConvert_api_TCPSocketAction_To_v1beta3_TCPSocketAction,
 		autoConvert_api_TLSConfig_To_v1beta3_TLSConfig,
 		autoConvert_api_TagImageHook_To_v1beta3_TagImageHook,
+		autoConvert_api_TemplateInclude_To_v1beta3_TemplateInclude,
 		autoConvert_api_TemplateList_To_v1beta3_TemplateList,
 		autoConvert_api_Template_To_v1beta3_Template,
 		autoConvert_api_UserIdentityMapping_To_v1beta3_UserIdentityMapping,
@@ -6768,18 +7546,22 @@ func init() {
 		autoConvert_v1beta3_ClusterRoleBindingList_To_api_ClusterRoleBindingList,
 		autoConvert_v1beta3_ClusterRoleBinding_To_api_ClusterRoleBinding,
 		autoConvert_v1beta3_ClusterRoleList_To_api_ClusterRoleList,
+		autoConvert_v1beta3_ClusterRoleScopeRestriction_To_api_ClusterRoleScopeRestriction,
 		autoConvert_v1beta3_ClusterRole_To_api_ClusterRole,
 		autoConvert_v1beta3_ContainerPort_To_api_ContainerPort,
 		autoConvert_v1beta3_Container_To_api_Container,
 		autoConvert_v1beta3_CustomBuildStrategy_To_api_CustomBuildStrategy,
 		autoConvert_v1beta3_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrigger,
 		autoConvert_v1beta3_DeploymentCause_To_api_DeploymentCause,
+		autoConvert_v1beta3_DeploymentCondition_To_api_DeploymentCondition,
 		autoConvert_v1beta3_DeploymentConfigRollbackSpec_To_api_DeploymentConfigRollbackSpec,
 		autoConvert_v1beta3_DeploymentConfigRollback_To_api_DeploymentConfigRollback,
 		autoConvert_v1beta3_DeploymentConfigStatus_To_api_DeploymentConfigStatus,
 		autoConvert_v1beta3_DeploymentDetails_To_api_DeploymentDetails,
 		autoConvert_v1beta3_DeploymentLogOptions_To_api_DeploymentLogOptions,
 		autoConvert_v1beta3_DeploymentLog_To_api_DeploymentLog,
+		autoConvert_v1beta3_DeploymentRequest_To_api_DeploymentRequest,
+		autoConvert_v1beta3_DeploymentTriggerConfigChangeParams_To_api_DeploymentTriggerConfigChangeParams,
 		autoConvert_v1beta3_DeploymentTriggerImageChangeParams_To_api_DeploymentTriggerImageChangeParams,
 		autoConvert_v1beta3_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy,
 		autoConvert_v1beta3_DockerBuildStrategy_To_api_DockerBuildStrategy,
@@ -6790,6 +7572,7 @@ func init() {
 		autoConvert_v1beta3_FCVolumeSource_To_api_FCVolumeSource,
 		autoConvert_v1beta3_FlockerVolumeSource_To_api_FlockerVolumeSource,
 		autoConvert_v1beta3_GCEPersistentDiskVolumeSource_To_api_GCEPersistentDiskVolumeSource,
+		autoConvert_v1beta3_GeneratorOptions_To_api_GeneratorOptions,
 		autoConvert_v1beta3_GitBuildSource_To_api_GitBuildSource,
 		autoConvert_v1beta3_GitSourceRevision_To_api_GitSourceRevision,
 		autoConvert_v1beta3_GlusterfsVolumeSource_To_api_GlusterfsVolumeSource,
@@ -6846,6 +7629,7 @@ func init() {
 		autoConvert_v1beta3_ProjectStatus_To_api_ProjectStatus,
 		autoConvert_v1beta3_Project_To_api_Project,
 		autoConvert_v1beta3_RBDVolumeSource_To_api_RBDVolumeSource,
+		autoConvert_v1beta3_ResourceAccessGrant_To_api_ResourceAccessGrant,
 		autoConvert_v1beta3_ResourceAccessReviewResponse_To_api_ResourceAccessReviewResponse,
 		autoConvert_v1beta3_ResourceAccessReview_To_api_ResourceAccessReview,
 		autoConvert_v1beta3_ResourceRequirements_To_api_ResourceRequirements,
@@ -6854,13 +7638,16 @@ func init() {
 		autoConvert_v1beta3_RoleList_To_api_RoleList,
 		autoConvert_v1beta3_Role_To_api_Role,
 		autoConvert_v1beta3_RollingDeploymentStrategyParams_To_api_RollingDeploymentStrategyParams,
+		autoConvert_v1beta3_RouteHealthCheck_To_api_RouteHealthCheck,
 		autoConvert_v1beta3_RouteIngressCondition_To_api_RouteIngressCondition,
 		autoConvert_v1beta3_RouteIngress_To_api_RouteIngress,
 		autoConvert_v1beta3_RouteList_To_api_RouteList,
 		autoConvert_v1beta3_RoutePort_To_api_RoutePort,
 		autoConvert_v1beta3_RouteSpec_To_api_RouteSpec,
 		autoConvert_v1beta3_RouteStatus_To_api_RouteStatus,
+		autoConvert_v1beta3_RouteTargetReference_To_api_RouteTargetReference,
 		autoConvert_v1beta3_Route_To_api_Route,
+		autoConvert_v1beta3_ScopeRestriction_To_api_ScopeRestriction,
 		autoConvert_v1beta3_SecretBuildSource_To_api_SecretBuildSource,
 		autoConvert_v1beta3_SecretSpec_To_api_SecretSpec,
 		autoConvert_v1beta3_SecretVolumeSource_To_api_SecretVolumeSource,
@@ -6872,6 +7659,7 @@ func init() {
 		autoConvert_v1beta3_TCPSocketAction_To_api_TCPSocketAction,
 		autoConvert_v1beta3_TLSConfig_To_api_TLSConfig,
 		autoConvert_v1beta3_TagImageHook_To_api_TagImageHook,
+		autoConvert_v1beta3_TemplateInclude_To_api_TemplateInclude,
 		autoConvert_v1beta3_TemplateList_To_api_TemplateList,
 		autoConvert_v1beta3_Template_To_api_Template,
 		autoConvert_v1beta3_UserIdentityMapping_To_api_UserIdentityMapping,
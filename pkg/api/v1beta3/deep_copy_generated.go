@@ -32,6 +32,8 @@ func deepCopy_v1beta3_AuthorizationAttributes(in v1beta3.AuthorizationAttributes
 	} else {
 		out.Content = newVal.(runtime.RawExtension)
 	}
+	out.IsNonResourceURL = in.IsNonResourceURL
+	out.Path = in.Path
 	return nil
 }
 
@@ -507,6 +509,33 @@ func deepCopy_v1beta3_PolicyRule(in v1beta3.PolicyRule, out *v1beta3.PolicyRule,
 	return nil
 }
 
+func deepCopy_v1beta3_ResourceAccessGrant(in v1beta3.ResourceAccessGrant, out *v1beta3.ResourceAccessGrant, c *conversion.Cloner) error {
+	out.Namespace = in.Namespace
+	out.RoleBinding = in.RoleBinding
+	if newVal, err := c.DeepCopy(in.RoleRef); err != nil {
+		return err
+	} else {
+		out.RoleRef = newVal.(pkgapiv1beta3.ObjectReference)
+	}
+	if in.Users != nil {
+		out.Users = make([]string, len(in.Users))
+		for i := range in.Users {
+			out.Users[i] = in.Users[i]
+		}
+	} else {
+		out.Users = nil
+	}
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_ResourceAccessReview(in v1beta3.ResourceAccessReview, out *v1beta3.ResourceAccessReview, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -542,6 +571,16 @@ func deepCopy_v1beta3_ResourceAccessReviewResponse(in v1beta3.ResourceAccessRevi
 	} else {
 		out.GroupsSlice = nil
 	}
+	if in.Grants != nil {
+		out.Grants = make([]v1beta3.ResourceAccessGrant, len(in.Grants))
+		for i := range in.Grants {
+			if err := deepCopy_v1beta3_ResourceAccessGrant(in.Grants[i], &out.Grants[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Grants = nil
+	}
 	return nil
 }
 
@@ -801,6 +840,19 @@ func deepCopy_v1beta3_BuildConfigSpec(in apiv1beta3.BuildConfigSpec, out *apiv1b
 	if err := deepCopy_v1beta3_BuildSpec(in.BuildSpec, &out.BuildSpec, c); err != nil {
 		return err
 	}
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
+	out.RunPolicy = in.RunPolicy
 	return nil
 }
 
@@ -886,6 +938,19 @@ func deepCopy_v1beta3_BuildLogOptions(in apiv1beta3.BuildLogOptions, out *apiv1b
 	} else {
 		out.Version = nil
 	}
+	if in.OffsetBytes != nil {
+		out.OffsetBytes = new(int64)
+		*out.OffsetBytes = *in.OffsetBytes
+	} else {
+		out.OffsetBytes = nil
+	}
+	if in.OffsetLines != nil {
+		out.OffsetLines = new(int64)
+		*out.OffsetLines = *in.OffsetLines
+	} else {
+		out.OffsetLines = nil
+	}
+	out.Format = in.Format
 	return nil
 }
 
@@ -1079,6 +1144,14 @@ func deepCopy_v1beta3_BuildSpec(in apiv1beta3.BuildSpec, out *apiv1beta3.BuildSp
 	} else {
 		out.Resources = newVal.(pkgapiv1beta3.ResourceRequirements)
 	}
+	if in.NodeSelector != nil {
+		out.NodeSelector = make(map[string]string)
+		for key, val := range in.NodeSelector {
+			out.NodeSelector[key] = val
+		}
+	} else {
+		out.NodeSelector = nil
+	}
 	if err := deepCopy_v1beta3_BuildPostCommitSpec(in.PostCommit, &out.PostCommit, c); err != nil {
 		return err
 	}
@@ -1175,6 +1248,22 @@ func deepCopy_v1beta3_BuildTriggerPolicy(in apiv1beta3.BuildTriggerPolicy, out *
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(apiv1beta3.WebHookTrigger)
+		if err := deepCopy_v1beta3_WebHookTrigger(*in.GitLabWebHook, out.GitLabWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(apiv1beta3.WebHookTrigger)
+		if err := deepCopy_v1beta3_WebHookTrigger(*in.BitbucketWebHook, out.BitbucketWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(apiv1beta3.ImageChangeTrigger)
 		if err := deepCopy_v1beta3_ImageChangeTrigger(*in.ImageChange, out.ImageChange, c); err != nil {
@@ -1281,6 +1370,21 @@ func deepCopy_v1beta3_GitBuildSource(in apiv1beta3.GitBuildSource, out *apiv1bet
 	} else {
 		out.HTTPSProxy = nil
 	}
+	if in.NoProxy != nil {
+		out.NoProxy = new(string)
+		*out.NoProxy = *in.NoProxy
+	} else {
+		out.NoProxy = nil
+	}
+	out.SkipSubmodules = in.SkipSubmodules
+	if in.SparseCheckoutPaths != nil {
+		out.SparseCheckoutPaths = make([]string, len(in.SparseCheckoutPaths))
+		for i := range in.SparseCheckoutPaths {
+			out.SparseCheckoutPaths[i] = in.SparseCheckoutPaths[i]
+		}
+	} else {
+		out.SparseCheckoutPaths = nil
+	}
 	return nil
 }
 
@@ -1394,6 +1498,15 @@ func deepCopy_v1beta3_SourceBuildStrategy(in apiv1beta3.SourceBuildStrategy, out
 	out.Scripts = in.Scripts
 	out.Incremental = in.Incremental
 	out.ForcePull = in.ForcePull
+	if in.ArtifactCache != nil {
+		if newVal, err := c.DeepCopy(in.ArtifactCache); err != nil {
+			return err
+		} else {
+			out.ArtifactCache = newVal.(*pkgapiv1beta3.LocalObjectReference)
+		}
+	} else {
+		out.ArtifactCache = nil
+	}
 	return nil
 }
 
@@ -1418,6 +1531,53 @@ func deepCopy_v1beta3_SourceRevision(in apiv1beta3.SourceRevision, out *apiv1bet
 
 func deepCopy_v1beta3_WebHookTrigger(in apiv1beta3.WebHookTrigger, out *apiv1beta3.WebHookTrigger, c *conversion.Cloner) error {
 	out.Secret = in.Secret
+	out.RefFilter = in.RefFilter
+	out.PathFilter = in.PathFilter
+	return nil
+}
+
+func deepCopy_v1beta3_CanaryDeploymentStrategyParams(in deployapiv1beta3.CanaryDeploymentStrategyParams, out *deployapiv1beta3.CanaryDeploymentStrategyParams, c *conversion.Cloner) error {
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if newVal, err := c.DeepCopy(in.Size); err != nil {
+		return err
+	} else {
+		out.Size = newVal.(intstr.IntOrString)
+	}
+	if in.VerificationSeconds != nil {
+		out.VerificationSeconds = new(int64)
+		*out.VerificationSeconds = *in.VerificationSeconds
+	} else {
+		out.VerificationSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Verify != nil {
+		out.Verify = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Verify, out.Verify, c); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
 	return nil
 }
 
@@ -1468,6 +1628,24 @@ func deepCopy_v1beta3_DeploymentCauseImageTrigger(in deployapiv1beta3.Deployment
 	return nil
 }
 
+func deepCopy_v1beta3_DeploymentCondition(in deployapiv1beta3.DeploymentCondition, out *deployapiv1beta3.DeploymentCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if newVal, err := c.DeepCopy(in.LastUpdateTime); err != nil {
+		return err
+	} else {
+		out.LastUpdateTime = newVal.(unversioned.Time)
+	}
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
 func deepCopy_v1beta3_DeploymentConfig(in deployapiv1beta3.DeploymentConfig, out *deployapiv1beta3.DeploymentConfig, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -1553,6 +1731,13 @@ func deepCopy_v1beta3_DeploymentConfigSpec(in deployapiv1beta3.DeploymentConfigS
 	}
 	out.Replicas = in.Replicas
 	out.Test = in.Test
+	out.Paused = in.Paused
+	if in.ProgressDeadlineSeconds != nil {
+		out.ProgressDeadlineSeconds = new(int64)
+		*out.ProgressDeadlineSeconds = *in.ProgressDeadlineSeconds
+	} else {
+		out.ProgressDeadlineSeconds = nil
+	}
 	if in.Selector != nil {
 		out.Selector = make(map[string]string)
 		for key, val := range in.Selector {
@@ -1583,6 +1768,16 @@ func deepCopy_v1beta3_DeploymentConfigStatus(in deployapiv1beta3.DeploymentConfi
 	} else {
 		out.Details = nil
 	}
+	if in.Conditions != nil {
+		out.Conditions = make([]deployapiv1beta3.DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_v1beta3_DeploymentCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -1658,6 +1853,18 @@ func deepCopy_v1beta3_DeploymentLogOptions(in deployapiv1beta3.DeploymentLogOpti
 	} else {
 		out.Version = nil
 	}
+	out.AllPods = in.AllPods
+	out.DeploymentPods = in.DeploymentPods
+	return nil
+}
+
+func deepCopy_v1beta3_DeploymentRequest(in deployapiv1beta3.DeploymentRequest, out *deployapiv1beta3.DeploymentRequest, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	out.Name = in.Name
 	return nil
 }
 
@@ -1687,6 +1894,14 @@ func deepCopy_v1beta3_DeploymentStrategy(in deployapiv1beta3.DeploymentStrategy,
 	} else {
 		out.RollingParams = nil
 	}
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapiv1beta3.CanaryDeploymentStrategyParams)
+		if err := deepCopy_v1beta3_CanaryDeploymentStrategyParams(*in.CanaryParams, out.CanaryParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
 	if newVal, err := c.DeepCopy(in.Resources); err != nil {
 		return err
 	} else {
@@ -1711,6 +1926,11 @@ func deepCopy_v1beta3_DeploymentStrategy(in deployapiv1beta3.DeploymentStrategy,
 	return nil
 }
 
+func deepCopy_v1beta3_DeploymentTriggerConfigChangeParams(in deployapiv1beta3.DeploymentTriggerConfigChangeParams, out *deployapiv1beta3.DeploymentTriggerConfigChangeParams, c *conversion.Cloner) error {
+	out.DebounceSeconds = in.DebounceSeconds
+	return nil
+}
+
 func deepCopy_v1beta3_DeploymentTriggerImageChangeParams(in deployapiv1beta3.DeploymentTriggerImageChangeParams, out *deployapiv1beta3.DeploymentTriggerImageChangeParams, c *conversion.Cloner) error {
 	out.Automatic = in.Automatic
 	if in.ContainerNames != nil {
@@ -1740,6 +1960,14 @@ func deepCopy_v1beta3_DeploymentTriggerPolicy(in deployapiv1beta3.DeploymentTrig
 	} else {
 		out.ImageChangeParams = nil
 	}
+	if in.ConfigChangeParams != nil {
+		out.ConfigChangeParams = new(deployapiv1beta3.DeploymentTriggerConfigChangeParams)
+		if err := deepCopy_v1beta3_DeploymentTriggerConfigChangeParams(*in.ConfigChangeParams, out.ConfigChangeParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigChangeParams = nil
+	}
 	return nil
 }
 
@@ -1765,6 +1993,7 @@ func deepCopy_v1beta3_ExecNewPodHook(in deployapiv1beta3.ExecNewPodHook, out *de
 		out.Env = nil
 	}
 	out.ContainerName = in.ContainerName
+	out.Image = in.Image
 	if in.Volumes != nil {
 		out.Volumes = make([]string, len(in.Volumes))
 		for i := range in.Volumes {
@@ -1934,12 +2163,15 @@ func deepCopy_v1beta3_Image(in imageapiv1beta3.Image, out *imageapiv1beta3.Image
 	} else {
 		out.DockerImageLayers = nil
 	}
+	out.DockerImageConfig = in.DockerImageConfig
+	out.DockerImageManifestMediaType = in.DockerImageManifestMediaType
 	return nil
 }
 
 func deepCopy_v1beta3_ImageLayer(in imageapiv1beta3.ImageLayer, out *imageapiv1beta3.ImageLayer, c *conversion.Cloner) error {
 	out.Name = in.Name
 	out.Size = in.Size
+	out.MediaType = in.MediaType
 	return nil
 }
 
@@ -2185,6 +2417,41 @@ func deepCopy_v1beta3_TagReference(in imageapiv1beta3.TagReference, out *imageap
 	if err := deepCopy_v1beta3_TagImportPolicy(in.ImportPolicy, &out.ImportPolicy, c); err != nil {
 		return err
 	}
+	if in.HistoryLimit != nil {
+		out.HistoryLimit = new(int32)
+		*out.HistoryLimit = *in.HistoryLimit
+	} else {
+		out.HistoryLimit = nil
+	}
+	if err := deepCopy_v1beta3_TagReferencePolicy(in.ReferencePolicy, &out.ReferencePolicy, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_TagReferencePolicy(in imageapiv1beta3.TagReferencePolicy, out *imageapiv1beta3.TagReferencePolicy, c *conversion.Cloner) error {
+	out.Type = in.Type
+	return nil
+}
+
+func deepCopy_v1beta3_ClusterRoleScopeRestriction(in oauthapiv1beta3.ClusterRoleScopeRestriction, out *oauthapiv1beta3.ClusterRoleScopeRestriction, c *conversion.Cloner) error {
+	if in.RoleNames != nil {
+		out.RoleNames = make([]string, len(in.RoleNames))
+		for i := range in.RoleNames {
+			out.RoleNames[i] = in.RoleNames[i]
+		}
+	} else {
+		out.RoleNames = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	out.AllowEscalation = in.AllowEscalation
 	return nil
 }
 
@@ -2214,6 +2481,7 @@ func deepCopy_v1beta3_OAuthAccessToken(in oauthapiv1beta3.OAuthAccessToken, out
 	out.UserUID = in.UserUID
 	out.AuthorizeToken = in.AuthorizeToken
 	out.RefreshToken = in.RefreshToken
+	out.InactivityTimeoutSeconds = in.InactivityTimeoutSeconds
 	return nil
 }
 
@@ -2266,6 +2534,8 @@ func deepCopy_v1beta3_OAuthAuthorizeToken(in oauthapiv1beta3.OAuthAuthorizeToken
 	out.State = in.State
 	out.UserName = in.UserName
 	out.UserUID = in.UserUID
+	out.CodeChallenge = in.CodeChallenge
+	out.CodeChallengeMethod = in.CodeChallengeMethod
 	return nil
 }
 
@@ -2314,6 +2584,28 @@ func deepCopy_v1beta3_OAuthClient(in oauthapiv1beta3.OAuthClient, out *oauthapiv
 	} else {
 		out.RedirectURIs = nil
 	}
+	if in.AccessTokenMaxAgeSeconds != nil {
+		out.AccessTokenMaxAgeSeconds = new(int32)
+		*out.AccessTokenMaxAgeSeconds = *in.AccessTokenMaxAgeSeconds
+	} else {
+		out.AccessTokenMaxAgeSeconds = nil
+	}
+	if in.AccessTokenInactivityTimeoutSeconds != nil {
+		out.AccessTokenInactivityTimeoutSeconds = new(int32)
+		*out.AccessTokenInactivityTimeoutSeconds = *in.AccessTokenInactivityTimeoutSeconds
+	} else {
+		out.AccessTokenInactivityTimeoutSeconds = nil
+	}
+	if in.ScopeRestrictions != nil {
+		out.ScopeRestrictions = make([]oauthapiv1beta3.ScopeRestriction, len(in.ScopeRestrictions))
+		for i := range in.ScopeRestrictions {
+			if err := deepCopy_v1beta3_ScopeRestriction(in.ScopeRestrictions[i], &out.ScopeRestrictions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ScopeRestrictions = nil
+	}
 	return nil
 }
 
@@ -2390,6 +2682,26 @@ func deepCopy_v1beta3_OAuthClientList(in oauthapiv1beta3.OAuthClientList, out *o
 	return nil
 }
 
+func deepCopy_v1beta3_ScopeRestriction(in oauthapiv1beta3.ScopeRestriction, out *oauthapiv1beta3.ScopeRestriction, c *conversion.Cloner) error {
+	if in.ExactValues != nil {
+		out.ExactValues = make([]string, len(in.ExactValues))
+		for i := range in.ExactValues {
+			out.ExactValues[i] = in.ExactValues[i]
+		}
+	} else {
+		out.ExactValues = nil
+	}
+	if in.ClusterRole != nil {
+		out.ClusterRole = new(oauthapiv1beta3.ClusterRoleScopeRestriction)
+		if err := deepCopy_v1beta3_ClusterRoleScopeRestriction(*in.ClusterRole, out.ClusterRole, c); err != nil {
+			return err
+		}
+	} else {
+		out.ClusterRole = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_Project(in projectapiv1beta3.Project, out *projectapiv1beta3.Project, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2487,6 +2799,12 @@ func deepCopy_v1beta3_Route(in routeapiv1beta3.Route, out *routeapiv1beta3.Route
 	return nil
 }
 
+func deepCopy_v1beta3_RouteHealthCheck(in routeapiv1beta3.RouteHealthCheck, out *routeapiv1beta3.RouteHealthCheck, c *conversion.Cloner) error {
+	out.Path = in.Path
+	out.IntervalSeconds = in.IntervalSeconds
+	return nil
+}
+
 func deepCopy_v1beta3_RouteIngress(in routeapiv1beta3.RouteIngress, out *routeapiv1beta3.RouteIngress, c *conversion.Cloner) error {
 	out.Host = in.Host
 	out.RouterName = in.RouterName
@@ -2556,10 +2874,18 @@ func deepCopy_v1beta3_RoutePort(in routeapiv1beta3.RoutePort, out *routeapiv1bet
 func deepCopy_v1beta3_RouteSpec(in routeapiv1beta3.RouteSpec, out *routeapiv1beta3.RouteSpec, c *conversion.Cloner) error {
 	out.Host = in.Host
 	out.Path = in.Path
-	if newVal, err := c.DeepCopy(in.To); err != nil {
+	if err := deepCopy_v1beta3_RouteTargetReference(in.To, &out.To, c); err != nil {
 		return err
+	}
+	if in.AlternateBackends != nil {
+		out.AlternateBackends = make([]routeapiv1beta3.RouteTargetReference, len(in.AlternateBackends))
+		for i := range in.AlternateBackends {
+			if err := deepCopy_v1beta3_RouteTargetReference(in.AlternateBackends[i], &out.AlternateBackends[i], c); err != nil {
+				return err
+			}
+		}
 	} else {
-		out.To = newVal.(pkgapiv1beta3.ObjectReference)
+		out.AlternateBackends = nil
 	}
 	if in.Port != nil {
 		out.Port = new(routeapiv1beta3.RoutePort)
@@ -2569,6 +2895,14 @@ func deepCopy_v1beta3_RouteSpec(in routeapiv1beta3.RouteSpec, out *routeapiv1bet
 	} else {
 		out.Port = nil
 	}
+	if in.HealthCheck != nil {
+		out.HealthCheck = new(routeapiv1beta3.RouteHealthCheck)
+		if err := deepCopy_v1beta3_RouteHealthCheck(*in.HealthCheck, out.HealthCheck, c); err != nil {
+			return err
+		}
+	} else {
+		out.HealthCheck = nil
+	}
 	if in.TLS != nil {
 		out.TLS = new(routeapiv1beta3.TLSConfig)
 		if err := deepCopy_v1beta3_TLSConfig(*in.TLS, out.TLS, c); err != nil {
@@ -2594,6 +2928,18 @@ func deepCopy_v1beta3_RouteStatus(in routeapiv1beta3.RouteStatus, out *routeapiv
 	return nil
 }
 
+func deepCopy_v1beta3_RouteTargetReference(in routeapiv1beta3.RouteTargetReference, out *routeapiv1beta3.RouteTargetReference, c *conversion.Cloner) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_TLSConfig(in routeapiv1beta3.TLSConfig, out *routeapiv1beta3.TLSConfig, c *conversion.Cloner) error {
 	out.Termination = in.Termination
 	out.Certificate = in.Certificate
@@ -2726,6 +3072,15 @@ func deepCopy_v1beta3_NetNamespaceList(in sdnapiv1beta3.NetNamespaceList, out *s
 	return nil
 }
 
+func deepCopy_v1beta3_GeneratorOptions(in templateapiv1beta3.GeneratorOptions, out *templateapiv1beta3.GeneratorOptions, c *conversion.Cloner) error {
+	out.Charset = in.Charset
+	out.Exclude = in.Exclude
+	out.ExcludeAmbiguous = in.ExcludeAmbiguous
+	out.MinLength = in.MinLength
+	out.MaxLength = in.MaxLength
+	return nil
+}
+
 func deepCopy_v1beta3_Parameter(in templateapiv1beta3.Parameter, out *templateapiv1beta3.Parameter, c *conversion.Cloner) error {
 	out.Name = in.Name
 	out.DisplayName = in.DisplayName
@@ -2733,7 +3088,26 @@ func deepCopy_v1beta3_Parameter(in templateapiv1beta3.Parameter, out *templateap
 	out.Value = in.Value
 	out.Generate = in.Generate
 	out.From = in.From
+	out.FromEnv = in.FromEnv
 	out.Required = in.Required
+	out.Type = in.Type
+	if in.Allowed != nil {
+		out.Allowed = make([]string, len(in.Allowed))
+		for i := range in.Allowed {
+			out.Allowed[i] = in.Allowed[i]
+		}
+	} else {
+		out.Allowed = nil
+	}
+	out.Sensitive = in.Sensitive
+	if in.GeneratorOptions != nil {
+		out.GeneratorOptions = new(templateapiv1beta3.GeneratorOptions)
+		if err := deepCopy_v1beta3_GeneratorOptions(*in.GeneratorOptions, out.GeneratorOptions, c); err != nil {
+			return err
+		}
+	} else {
+		out.GeneratorOptions = nil
+	}
 	return nil
 }
 
@@ -2778,6 +3152,31 @@ func deepCopy_v1beta3_Template(in templateapiv1beta3.Template, out *templateapiv
 	} else {
 		out.Labels = nil
 	}
+	if in.ObjectAnnotations != nil {
+		out.ObjectAnnotations = make(map[string]string)
+		for key, val := range in.ObjectAnnotations {
+			out.ObjectAnnotations[key] = val
+		}
+	} else {
+		out.ObjectAnnotations = nil
+	}
+	if in.Includes != nil {
+		out.Includes = make([]templateapiv1beta3.TemplateInclude, len(in.Includes))
+		for i := range in.Includes {
+			if err := deepCopy_v1beta3_TemplateInclude(in.Includes[i], &out.Includes[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Includes = nil
+	}
+	out.Message = in.Message
+	return nil
+}
+
+func deepCopy_v1beta3_TemplateInclude(in templateapiv1beta3.TemplateInclude, out *templateapiv1beta3.TemplateInclude, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
 	return nil
 }
 
@@ -3006,6 +3405,7 @@ func init() {
 		deepCopy_v1beta3_PolicyBindingList,
 		deepCopy_v1beta3_PolicyList,
 		deepCopy_v1beta3_PolicyRule,
+		deepCopy_v1beta3_ResourceAccessGrant,
 		deepCopy_v1beta3_ResourceAccessReview,
 		deepCopy_v1beta3_ResourceAccessReviewResponse,
 		deepCopy_v1beta3_Role,
@@ -3045,9 +3445,11 @@ func init() {
 		deepCopy_v1beta3_SourceControlUser,
 		deepCopy_v1beta3_SourceRevision,
 		deepCopy_v1beta3_WebHookTrigger,
+		deepCopy_v1beta3_CanaryDeploymentStrategyParams,
 		deepCopy_v1beta3_CustomDeploymentStrategyParams,
 		deepCopy_v1beta3_DeploymentCause,
 		deepCopy_v1beta3_DeploymentCauseImageTrigger,
+		deepCopy_v1beta3_DeploymentCondition,
 		deepCopy_v1beta3_DeploymentConfig,
 		deepCopy_v1beta3_DeploymentConfigList,
 		deepCopy_v1beta3_DeploymentConfigRollback,
@@ -3057,7 +3459,9 @@ func init() {
 		deepCopy_v1beta3_DeploymentDetails,
 		deepCopy_v1beta3_DeploymentLog,
 		deepCopy_v1beta3_DeploymentLogOptions,
+		deepCopy_v1beta3_DeploymentRequest,
 		deepCopy_v1beta3_DeploymentStrategy,
+		deepCopy_v1beta3_DeploymentTriggerConfigChangeParams,
 		deepCopy_v1beta3_DeploymentTriggerImageChangeParams,
 		deepCopy_v1beta3_DeploymentTriggerPolicy,
 		deepCopy_v1beta3_ExecNewPodHook,
@@ -3081,6 +3485,8 @@ func init() {
 		deepCopy_v1beta3_TagEventCondition,
 		deepCopy_v1beta3_TagImportPolicy,
 		deepCopy_v1beta3_TagReference,
+		deepCopy_v1beta3_TagReferencePolicy,
+		deepCopy_v1beta3_ClusterRoleScopeRestriction,
 		deepCopy_v1beta3_OAuthAccessToken,
 		deepCopy_v1beta3_OAuthAccessTokenList,
 		deepCopy_v1beta3_OAuthAuthorizeToken,
@@ -3089,18 +3495,21 @@ func init() {
 		deepCopy_v1beta3_OAuthClientAuthorization,
 		deepCopy_v1beta3_OAuthClientAuthorizationList,
 		deepCopy_v1beta3_OAuthClientList,
+		deepCopy_v1beta3_ScopeRestriction,
 		deepCopy_v1beta3_Project,
 		deepCopy_v1beta3_ProjectList,
 		deepCopy_v1beta3_ProjectRequest,
 		deepCopy_v1beta3_ProjectSpec,
 		deepCopy_v1beta3_ProjectStatus,
 		deepCopy_v1beta3_Route,
+		deepCopy_v1beta3_RouteHealthCheck,
 		deepCopy_v1beta3_RouteIngress,
 		deepCopy_v1beta3_RouteIngressCondition,
 		deepCopy_v1beta3_RouteList,
 		deepCopy_v1beta3_RoutePort,
 		deepCopy_v1beta3_RouteSpec,
 		deepCopy_v1beta3_RouteStatus,
+		deepCopy_v1beta3_RouteTargetReference,
 		deepCopy_v1beta3_TLSConfig,
 		deepCopy_v1beta3_ClusterNetwork,
 		deepCopy_v1beta3_ClusterNetworkList,
@@ -3108,8 +3517,10 @@ func init() {
 		deepCopy_v1beta3_HostSubnetList,
 		deepCopy_v1beta3_NetNamespace,
 		deepCopy_v1beta3_NetNamespaceList,
+		deepCopy_v1beta3_GeneratorOptions,
 		deepCopy_v1beta3_Parameter,
 		deepCopy_v1beta3_Template,
+		deepCopy_v1beta3_TemplateInclude,
 		deepCopy_v1beta3_TemplateList,
 		deepCopy_v1beta3_Group,
 		deepCopy_v1beta3_GroupList,
@@ -13,6 +13,11 @@ const (
 	// This is useful when the immutable providerUserName is different than the login used to authenticate
 	// If present, this extra value is used as the preferred username
 	IdentityPreferredUsernameKey = "preferred_username"
+
+	// ImpersonateUserHeader names the user a request is made as, instead of the authenticated user
+	ImpersonateUserHeader = "Impersonate-User"
+	// ImpersonateGroupHeader names a group the impersonated user is a member of. It can be repeated.
+	ImpersonateGroupHeader = "Impersonate-Group"
 )
 
 // UserIdentityInfo contains information about an identity.  Identities are distinct from users.  An authentication server of
@@ -28,6 +33,12 @@ type UserIdentityInfo interface {
 	GetExtra() map[string]string
 }
 
+// UserIdentityGroupsInfo is implemented by identities whose provider asserts the groups the user is a member of.
+type UserIdentityGroupsInfo interface {
+	// GetProviderGroups returns the names of the groups the provider asserts the user is a member of
+	GetProviderGroups() []string
+}
+
 // UserIdentityMapper maps UserIdentities into user.Info objects to allow different user abstractions within auth code.
 type UserIdentityMapper interface {
 	// UserFor takes an identity, ignores the passed identity.Provider, forces the provider value to some other value and then creates the mapping.
@@ -53,6 +64,7 @@ type DefaultUserIdentityInfo struct {
 	ProviderName     string
 	ProviderUserName string
 	Extra            map[string]string
+	ProviderGroups   []string
 }
 
 // NewDefaultUserIdentityInfo returns a DefaultUserIdentityInfo with a non-nil Extra component
@@ -79,3 +91,33 @@ func (i *DefaultUserIdentityInfo) GetProviderUserName() string {
 func (i *DefaultUserIdentityInfo) GetExtra() map[string]string {
 	return i.Extra
 }
+
+func (i *DefaultUserIdentityInfo) GetProviderGroups() []string {
+	return i.ProviderGroups
+}
+
+// ScopedUserInfo is implemented by users authenticated with a token restricted to scopes.
+// Users without scopes are not restricted.
+type ScopedUserInfo interface {
+	user.Info
+	// GetScopes returns the scopes the actions of the user are restricted to
+	GetScopes() []string
+}
+
+// DefaultScopedUserInfo is a user.DefaultInfo restricted to scopes
+type DefaultScopedUserInfo struct {
+	user.DefaultInfo
+	Scopes []string
+}
+
+func (i *DefaultScopedUserInfo) GetScopes() []string {
+	return i.Scopes
+}
+
+// ScopesFor returns the scopes restricting the actions of u, or nil if they are not restricted
+func ScopesFor(u user.Info) []string {
+	if scoped, ok := u.(ScopedUserInfo); ok {
+		return scoped.GetScopes()
+	}
+	return nil
+}
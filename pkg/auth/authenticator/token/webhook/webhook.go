@@ -0,0 +1,144 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/auth/user"
+
+	"github.com/openshift/origin/pkg/auth/authenticator"
+)
+
+// requestTimeout bounds how long a request can wait for the token review service
+const requestTimeout = 10 * time.Second
+
+// TokenReview is posted to the token review service, which fills in its status. It uses the serialization of the
+// Kubernetes authentication.k8s.io/v1beta1 TokenReview, so services written for Kubernetes can review tokens too.
+type TokenReview struct {
+	unversioned.TypeMeta `json:",inline"`
+
+	// Spec holds the token to review
+	Spec TokenReviewSpec `json:"spec"`
+	// Status is filled in by the token review service
+	Status TokenReviewStatus `json:"status,omitempty"`
+}
+
+// TokenReviewSpec is a description of the token authentication request
+type TokenReviewSpec struct {
+	// Token is the bearer token to review
+	Token string `json:"token,omitempty"`
+}
+
+// TokenReviewStatus is the result of the token authentication request
+type TokenReviewStatus struct {
+	// Authenticated indicates that the token was associated with a known user
+	Authenticated bool `json:"authenticated,omitempty"`
+	// User is the user associated with the token
+	User UserInfo `json:"user,omitempty"`
+	// Error indicates that the token couldn't be checked
+	Error string `json:"error,omitempty"`
+}
+
+// UserInfo holds the information about the user associated with a token
+type UserInfo struct {
+	// Username is the name of the user
+	Username string `json:"username,omitempty"`
+	// UID is a unique value that identifies the user across time
+	UID string `json:"uid,omitempty"`
+	// Groups are the names of the groups the user is a part of
+	Groups []string `json:"groups,omitempty"`
+}
+
+// Authenticator authenticates tokens by posting them to a token review service
+type Authenticator struct {
+	url    string
+	client *http.Client
+}
+
+// NewAuthenticator returns an authenticator posting token reviews to url using transport
+func NewAuthenticator(url string, transport http.RoundTripper) *Authenticator {
+	return &Authenticator{
+		url:    url,
+		client: &http.Client{Transport: transport, Timeout: requestTimeout},
+	}
+}
+
+func (a *Authenticator) AuthenticateToken(value string) (user.Info, bool, error) {
+	if len(value) == 0 {
+		return nil, false, nil
+	}
+
+	status, err := a.review(value)
+	if err != nil {
+		return nil, false, err
+	}
+	if !status.Authenticated {
+		return nil, false, nil
+	}
+	if len(status.User.Username) == 0 {
+		return nil, false, fmt.Errorf("token review by %s authenticated a user without a name", a.url)
+	}
+
+	return &user.DefaultInfo{
+		Name:   status.User.Username,
+		UID:    status.User.UID,
+		Groups: status.User.Groups,
+	}, true, nil
+}
+
+// review posts a token review for token and returns its status
+func (a *Authenticator) review(token string) (*TokenReviewStatus, error) {
+	review := &TokenReview{
+		TypeMeta: unversioned.TypeMeta{APIVersion: "authentication.k8s.io/v1beta1", Kind: "TokenReview"},
+		Spec:     TokenReviewSpec{Token: token},
+	}
+	body, err := json.Marshal(review)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.Post(a.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("token review by %s failed with status %d", a.url, resp.StatusCode)
+	}
+
+	result := &TokenReview{}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return nil, fmt.Errorf("unable to decode token review from %s: %v", a.url, err)
+	}
+	if len(result.Status.Error) > 0 {
+		return nil, fmt.Errorf("token review by %s failed: %s", a.url, result.Status.Error)
+	}
+	return &result.Status, nil
+}
+
+// ignoreErrorsAuthenticator treats tokens that could not be reviewed as unauthenticated instead of failing the request
+type ignoreErrorsAuthenticator struct {
+	authenticator authenticator.Token
+}
+
+// IgnoreErrors returns an authenticator that treats tokens a fails to review as unauthenticated. It has to wrap any
+// cache of a, so failed reviews are not cached as unauthenticated tokens.
+func IgnoreErrors(a authenticator.Token) authenticator.Token {
+	return &ignoreErrorsAuthenticator{authenticator: a}
+}
+
+func (a *ignoreErrorsAuthenticator) AuthenticateToken(value string) (user.Info, bool, error) {
+	u, ok, err := a.authenticator.AuthenticateToken(value)
+	if err != nil {
+		glog.V(4).Infof("Ignoring failed token review: %v", err)
+		return nil, false, nil
+	}
+	return u, ok, nil
+}
@@ -0,0 +1,130 @@
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/auth/user"
+
+	"github.com/openshift/origin/pkg/auth/authenticator"
+	"github.com/openshift/origin/pkg/auth/authenticator/token/cache"
+)
+
+func TestAuthenticateToken(t *testing.T) {
+	testCases := map[string]struct {
+		Token        string
+		StatusCode   int
+		Response     string
+		IgnoreErrors bool
+
+		ExpectedUser user.Info
+		ExpectedOK   bool
+		ExpectError  bool
+	}{
+		"authenticated": {
+			Token:        "good",
+			StatusCode:   http.StatusOK,
+			Response:     `{"status":{"authenticated":true,"user":{"username":"bob","uid":"1","groups":["dev"]}}}`,
+			ExpectedUser: &user.DefaultInfo{Name: "bob", UID: "1", Groups: []string{"dev"}},
+			ExpectedOK:   true,
+		},
+		"unauthenticated": {
+			Token:      "bad",
+			StatusCode: http.StatusOK,
+			Response:   `{"status":{"authenticated":false}}`,
+		},
+		"without username": {
+			Token:       "good",
+			StatusCode:  http.StatusOK,
+			Response:    `{"status":{"authenticated":true}}`,
+			ExpectError: true,
+		},
+		"review error": {
+			Token:       "good",
+			StatusCode:  http.StatusOK,
+			Response:    `{"status":{"error":"backend unavailable"}}`,
+			ExpectError: true,
+		},
+		"server error": {
+			Token:       "good",
+			StatusCode:  http.StatusInternalServerError,
+			ExpectError: true,
+		},
+		"server error ignored": {
+			Token:        "good",
+			StatusCode:   http.StatusInternalServerError,
+			IgnoreErrors: true,
+		},
+		"invalid response": {
+			Token:       "good",
+			StatusCode:  http.StatusOK,
+			Response:    `not json`,
+			ExpectError: true,
+		},
+	}
+
+	for k, tc := range testCases {
+		var reviewed *TokenReview
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			reviewed = &TokenReview{}
+			if err := json.NewDecoder(req.Body).Decode(reviewed); err != nil {
+				t.Errorf("%s: unexpected error decoding review: %v", k, err)
+			}
+			w.WriteHeader(tc.StatusCode)
+			w.Write([]byte(tc.Response))
+		}))
+
+		var authenticator authenticator.Token = NewAuthenticator(server.URL, http.DefaultTransport)
+		if tc.IgnoreErrors {
+			authenticator = IgnoreErrors(authenticator)
+		}
+		u, ok, err := authenticator.AuthenticateToken(tc.Token)
+		server.Close()
+
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", k)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+		}
+		if ok != tc.ExpectedOK {
+			t.Errorf("%s: expected ok %v, got %v", k, tc.ExpectedOK, ok)
+		}
+		if !reflect.DeepEqual(u, tc.ExpectedUser) {
+			t.Errorf("%s: expected user %#v, got %#v", k, tc.ExpectedUser, u)
+		}
+		if reviewed == nil || reviewed.Kind != "TokenReview" || reviewed.Spec.Token != tc.Token {
+			t.Errorf("%s: unexpected token review %#v", k, reviewed)
+		}
+	}
+}
+
+func TestIgnoredErrorsAreNotCached(t *testing.T) {
+	available := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !available {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"status":{"authenticated":true,"user":{"username":"bob"}}}`))
+	}))
+	defer server.Close()
+
+	cached, err := cache.NewAuthenticator(NewAuthenticator(server.URL, http.DefaultTransport), time.Minute, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	authenticator := IgnoreErrors(cached)
+
+	if _, ok, err := authenticator.AuthenticateToken("good"); ok || err != nil {
+		t.Errorf("expected the failed review to be ignored, got ok=%v err=%v", ok, err)
+	}
+	available = true
+	if u, ok, err := authenticator.AuthenticateToken("good"); !ok || err != nil || u.GetName() != "bob" {
+		t.Errorf("expected the token to be reviewed again, got user=%v ok=%v err=%v", u, ok, err)
+	}
+}
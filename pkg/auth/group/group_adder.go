@@ -3,6 +3,7 @@ package group
 import (
 	"net/http"
 
+	"github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/auth/authenticator"
 	"k8s.io/kubernetes/pkg/auth/user"
 )
@@ -18,11 +19,16 @@ func (g *GroupAdder) AuthenticateRequest(req *http.Request) (user.Info, bool, er
 	if err != nil || !ok {
 		return nil, ok, err
 	}
-	return &user.DefaultInfo{
+	info := user.DefaultInfo{
 		Name:   u.GetName(),
 		UID:    u.GetUID(),
 		Groups: append(u.GetGroups(), g.Groups...),
-	}, true, nil
+	}
+	// Keep the restrictions of scoped users
+	if scopes := api.ScopesFor(u); scopes != nil {
+		return &api.DefaultScopedUserInfo{DefaultInfo: info, Scopes: scopes}, true, nil
+	}
+	return &info, true, nil
 }
 
 func NewGroupAdder(auth authenticator.Request, groups []string) *GroupAdder {
@@ -1,6 +1,7 @@
 package external
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -71,6 +72,11 @@ func (h *Handler) AuthenticationRedirect(w http.ResponseWriter, req *http.Reques
 		return err
 	}
 
+	if verifier := h.codeVerifier(state); len(verifier) > 0 {
+		authReq.CustomParameters["code_challenge"] = CodeChallengeS256(verifier)
+		authReq.CustomParameters["code_challenge_method"] = "S256"
+	}
+
 	oauthURL := authReq.GetAuthorizeUrlWithParams(state)
 	glog.V(4).Infof("redirect to %v", oauthURL)
 
@@ -108,6 +114,9 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 
 	// Exchange code for a token
 	accessReq := h.client.NewAccessRequest(osincli.AUTHORIZATION_CODE, authData)
+	if verifier := h.codeVerifier(authData.State); len(verifier) > 0 {
+		accessReq.CustomParameters["code_verifier"] = verifier
+	}
 	accessData, err := accessReq.GetToken()
 	if err != nil {
 		glog.V(4).Infof("Error getting access token: %v", err)
@@ -146,6 +155,21 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
+// codeVerifier returns the PKCE code verifier of the flow round-tripping state, if the provider uses PKCE
+func (h *Handler) codeVerifier(state string) string {
+	if provider, ok := h.provider.(CodeVerifierProvider); ok {
+		return provider.CodeVerifier(state)
+	}
+	return ""
+}
+
+// CodeChallengeS256 returns the S256 code challenge of verifier
+// See https://tools.ietf.org/html/rfc7636#section-4.2
+func CodeChallengeS256(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
 func (h *Handler) handleError(err error, w http.ResponseWriter, req *http.Request) {
 	handled, err := h.errorHandler.AuthenticationError(err, w, req)
 	if handled {
@@ -4,9 +4,11 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/RangelReale/osincli"
+	authapi "github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/auth/oauth/handlers"
 	"github.com/openshift/origin/pkg/auth/server/csrf"
 	"k8s.io/kubernetes/pkg/auth/user"
@@ -153,3 +155,75 @@ func TestRedirectingStateError(t *testing.T) {
 		t.Errorf("Expected original error back, got %#v", err)
 	}
 }
+
+type pkceProvider struct {
+	tokenURL string
+}
+
+func (p *pkceProvider) NewConfig() (*osincli.ClientConfig, error) {
+	return &osincli.ClientConfig{
+		ClientId:     "client",
+		ClientSecret: "secret",
+		AuthorizeUrl: "https://example.com/authorize",
+		TokenUrl:     p.tokenURL,
+	}, nil
+}
+
+func (p *pkceProvider) GetTransport() (http.RoundTripper, error) {
+	return nil, nil
+}
+
+func (p *pkceProvider) AddCustomParameters(*osincli.AuthorizeRequest) {}
+
+func (p *pkceProvider) GetUserIdentity(*osincli.AccessData) (authapi.UserIdentityInfo, bool, error) {
+	return nil, false, nil
+}
+
+func (p *pkceProvider) CodeVerifier(state string) string {
+	return "verifier-" + state
+}
+
+func TestHandlerPKCE(t *testing.T) {
+	verifiers := make(chan string, 1)
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		verifiers <- req.FormValue("code_verifier")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"token","token_type":"Bearer"}`))
+	}))
+	defer tokenServer.Close()
+
+	state := CSRFRedirectingState(&csrf.FakeCSRF{Token: "xyz"})
+	handler, err := NewExternalOAuthRedirector(&pkceProvider{tokenURL: tokenServer.URL}, state, "http://www.example.com/callback", state, state, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	req, _ := http.NewRequest("GET", "http://www.example.com/authorize", nil)
+	recorder := httptest.NewRecorder()
+	if err := handler.AuthenticationRedirect(recorder, req); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	location, err := url.Parse(recorder.Header().Get("Location"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	query := location.Query()
+	generatedState := query.Get("state")
+	if expected := CodeChallengeS256("verifier-" + generatedState); query.Get("code_challenge") != expected {
+		t.Errorf("Expected code challenge %s, got %s", expected, query.Get("code_challenge"))
+	}
+	if query.Get("code_challenge_method") != "S256" {
+		t.Errorf("Expected S256 code challenge method, got %s", query.Get("code_challenge_method"))
+	}
+
+	callback, _ := http.NewRequest("GET", "http://www.example.com/callback?"+url.Values{"code": {"code"}, "state": {generatedState}}.Encode(), nil)
+	handler.ServeHTTP(httptest.NewRecorder(), callback)
+	select {
+	case verifier := <-verifiers:
+		if verifier != "verifier-"+generatedState {
+			t.Errorf("Expected code verifier %s, got %s", "verifier-"+generatedState, verifier)
+		}
+	default:
+		t.Errorf("Expected the code to be exchanged for a token")
+	}
+}
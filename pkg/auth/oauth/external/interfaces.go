@@ -21,6 +21,14 @@ type Provider interface {
 	GetUserIdentity(*osincli.AccessData) (authapi.UserIdentityInfo, bool, error)
 }
 
+// CodeVerifierProvider is implemented by providers protecting the authorization code flow with Proof Key for Code Exchange.
+// See https://tools.ietf.org/html/rfc7636
+type CodeVerifierProvider interface {
+	// CodeVerifier returns the code verifier of the flow round-tripping state, or "" if the flow is not protected.
+	// The code verifier must be derived from the state, since it is not stored between the authorize and token requests.
+	CodeVerifier(state string) string
+}
+
 // State handles generating and verifying the state parameter round-tripped to an external OAuth flow.
 // Examples: CSRF protection, post authentication redirection
 type State interface {
@@ -1,6 +1,8 @@
 package openid
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -24,6 +26,10 @@ const (
 	PreferredUsernameClaim = "preferred_username"
 	EmailClaim             = "email"
 	NameClaim              = "name"
+
+	// DiscoveryPath is the path of the discovery document of an issuer
+	// http://openid.net/specs/openid-connect-discovery-1_0.html#ProviderConfigurationRequest
+	DiscoveryPath = "/.well-known/openid-configuration"
 )
 
 type TokenValidator func(map[string]interface{}) error
@@ -36,6 +42,9 @@ type Config struct {
 
 	ExtraAuthorizeParameters map[string]string
 
+	// Issuer is the optional URL of the provider. If set, the URLs left empty are read from its discovery document
+	Issuer string
+
 	AuthorizeURL string
 	TokenURL     string
 	UserInfoURL  string
@@ -44,10 +53,25 @@ type Config struct {
 	PreferredUsernameClaims []string
 	EmailClaims             []string
 	NameClaims              []string
+	GroupClaims             []string
+
+	// UsePKCE protects the authorization code flow with Proof Key for Code Exchange (https://tools.ietf.org/html/rfc7636).
+	// It is enabled when the discovery document of the issuer advertises the S256 code challenge method
+	UsePKCE bool
 
 	IDTokenValidator TokenValidator
 }
 
+// discoveryDocument holds the provider metadata read from the discovery document of an issuer
+// http://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata
+type discoveryDocument struct {
+	Issuer                        string   `json:"issuer"`
+	AuthorizationEndpoint         string   `json:"authorization_endpoint"`
+	TokenEndpoint                 string   `json:"token_endpoint"`
+	UserInfoEndpoint              string   `json:"userinfo_endpoint"`
+	CodeChallengeMethodsSupported []string `json:"code_challenge_methods_supported"`
+}
+
 type provider struct {
 	providerName string
 	transport    http.RoundTripper
@@ -56,13 +80,10 @@ type provider struct {
 
 // NewProvider returns an implementation of an OpenID Connect Authorization Code Flow
 // See http://openid.net/specs/openid-connect-core-1_0.html#CodeFlowAuth
+// If an issuer is configured, its discovery document is fetched to fill in the URLs left empty
 // ID Token decryption is not supported
 // UserInfo decryption is not supported
 func NewProvider(providerName string, transport http.RoundTripper, config Config) (external.Provider, error) {
-	// TODO: Add support for discovery documents
-	// see http://openid.net/specs/openid-connect-discovery-1_0.html#ProviderConfig
-	// e.g. https://accounts.google.com/.well-known/openid-configuration
-
 	// Validate client id/secret
 	if len(config.ClientID) == 0 {
 		return nil, errors.New("ClientID is required")
@@ -71,6 +92,14 @@ func NewProvider(providerName string, transport http.RoundTripper, config Config
 		return nil, errors.New("ClientSecret is required")
 	}
 
+	// Read the URLs left empty from the discovery document of the issuer
+	// e.g. https://accounts.google.com/.well-known/openid-configuration
+	if len(config.Issuer) > 0 {
+		if err := discover(&config, transport); err != nil {
+			return nil, err
+		}
+	}
+
 	// Validate url presence
 	if len(config.AuthorizeURL) == 0 {
 		return nil, errors.New("Authorize URL is required")
@@ -132,6 +161,18 @@ func (p provider) AddCustomParameters(req *osincli.AuthorizeRequest) {
 	}
 }
 
+// CodeVerifier implements external/interfaces/CodeVerifierProvider.CodeVerifier
+// The code verifier is the HMAC of the state keyed by the client secret, so it can be derived again when the code is exchanged
+// without being stored, and cannot be derived by anyone intercepting the state or the code.
+func (p provider) CodeVerifier(state string) string {
+	if !p.UsePKCE {
+		return ""
+	}
+	mac := hmac.New(sha256.New, []byte(p.ClientSecret))
+	mac.Write([]byte(state))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
 // GetUserIdentity implements external/interfaces/Provider.GetUserIdentity
 func (p provider) GetUserIdentity(data *osincli.AccessData) (authapi.UserIdentityInfo, bool, error) {
 	// Token response MUST include id_token
@@ -211,6 +252,14 @@ func (p provider) GetUserIdentity(data *osincli.AccessData) (authapi.UserIdentit
 		identity.Extra[authapi.IdentityDisplayNameKey] = name
 	}
 
+	if len(p.GroupClaims) != 0 {
+		groups, err := getClaimValues(claims, p.GroupClaims)
+		if err != nil {
+			return nil, false, err
+		}
+		identity.ProviderGroups = groups
+	}
+
 	glog.V(4).Infof("identity=%v", identity)
 
 	return identity, true, nil
@@ -233,6 +282,81 @@ func getClaimValue(data map[string]interface{}, claims []string) (string, error)
 	return "", errors.New("No value found")
 }
 
+// getClaimValues returns the values of the first of the given claims present in data.
+// Claims may be a single string or a list of strings.
+func getClaimValues(data map[string]interface{}, claims []string) ([]string, error) {
+	for _, claim := range claims {
+		value, ok := data[claim]
+		if !ok {
+			continue
+		}
+		switch value := value.(type) {
+		case string:
+			return []string{value}, nil
+		case []interface{}:
+			values := []string{}
+			for _, item := range value {
+				stringItem, ok := item.(string)
+				if !ok {
+					return nil, fmt.Errorf("Claim %s was not a string or a list of strings", claim)
+				}
+				values = append(values, stringItem)
+			}
+			return values, nil
+		default:
+			return nil, fmt.Errorf("Claim %s was not a string or a list of strings", claim)
+		}
+	}
+	return []string{}, nil
+}
+
+// discover fetches the discovery document of the issuer of config and uses it to fill in the URLs left empty
+// http://openid.net/specs/openid-connect-discovery-1_0.html#ProviderConfig
+func discover(config *Config, transport http.RoundTripper) error {
+	if u, err := url.Parse(config.Issuer); err != nil {
+		return errors.New("Issuer URL is invalid")
+	} else if u.Scheme != "https" {
+		return errors.New("Issuer URL must use https scheme")
+	}
+
+	client := &http.Client{Transport: transport}
+	resp, err := client.Get(strings.TrimSuffix(config.Issuer, "/") + DiscoveryPath)
+	if err != nil {
+		return fmt.Errorf("Error fetching the discovery document of %s: %v", config.Issuer, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Non-200 response fetching the discovery document of %s: %d", config.Issuer, resp.StatusCode)
+	}
+
+	doc := discoveryDocument{}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return fmt.Errorf("Error decoding the discovery document of %s: %v", config.Issuer, err)
+	}
+
+	// The issuer value returned MUST be identical to the Issuer URL that was used as the prefix to /.well-known/openid-configuration
+	// http://openid.net/specs/openid-connect-discovery-1_0.html#ProviderConfigurationValidation
+	if doc.Issuer != config.Issuer {
+		return fmt.Errorf("Discovery document issuer (%s) did not match the configured issuer (%s)", doc.Issuer, config.Issuer)
+	}
+
+	if len(config.AuthorizeURL) == 0 {
+		config.AuthorizeURL = doc.AuthorizationEndpoint
+	}
+	if len(config.TokenURL) == 0 {
+		config.TokenURL = doc.TokenEndpoint
+	}
+	if len(config.UserInfoURL) == 0 {
+		config.UserInfoURL = doc.UserInfoEndpoint
+	}
+	if sets.NewString(doc.CodeChallengeMethodsSupported...).Has("S256") {
+		config.UsePKCE = true
+	}
+
+	return nil
+}
+
 // fetch and decode JSON from the given UserInfo URL
 func fetchUserInfo(url, accessToken string, transport http.RoundTripper) (map[string]interface{}, error) {
 	req, _ := http.NewRequest("GET", url, nil)
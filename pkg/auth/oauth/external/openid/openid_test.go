@@ -1,8 +1,19 @@
 package openid
 
 import (
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/RangelReale/osincli"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/auth/oauth/external"
 )
 
@@ -21,3 +32,151 @@ func TestOpenID(t *testing.T) {
 	_ = external.Provider(p)
 
 }
+
+func TestDiscovery(t *testing.T) {
+	var issuer string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != DiscoveryPath {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{
+			"issuer": %q,
+			"authorization_endpoint": "https://example.com/authorize",
+			"token_endpoint": "https://example.com/token",
+			"userinfo_endpoint": "https://example.com/userinfo",
+			"code_challenge_methods_supported": ["plain", "S256"]
+		}`, issuer)
+	}))
+	defer server.Close()
+	transport := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+
+	testcases := map[string]struct {
+		Issuer       string
+		Config       Config
+		ExpectedErr  bool
+		ExpectedURLs []string
+	}{
+		"discovered urls": {
+			Issuer:       server.URL,
+			Config:       Config{Issuer: server.URL},
+			ExpectedURLs: []string{"https://example.com/authorize", "https://example.com/token", "https://example.com/userinfo"},
+		},
+		"configured urls take precedence": {
+			Issuer:       server.URL,
+			Config:       Config{Issuer: server.URL, TokenURL: "https://foo/token"},
+			ExpectedURLs: []string{"https://example.com/authorize", "https://foo/token", "https://example.com/userinfo"},
+		},
+		"mismatched issuer": {
+			Issuer:      "https://other",
+			Config:      Config{Issuer: server.URL},
+			ExpectedErr: true,
+		},
+		"insecure issuer": {
+			Config:      Config{Issuer: "http://example.com"},
+			ExpectedErr: true,
+		},
+	}
+
+	for k, tc := range testcases {
+		issuer = tc.Issuer
+		config := tc.Config
+		config.ClientID = "foo"
+		config.ClientSecret = "secret"
+		config.Scopes = []string{"openid"}
+		config.IDClaims = []string{"sub"}
+
+		p, err := NewProvider("openid", transport, config)
+		if tc.ExpectedErr != (err != nil) {
+			t.Errorf("%s: expected error=%v, got %v", k, tc.ExpectedErr, err)
+			continue
+		}
+		if tc.ExpectedErr {
+			continue
+		}
+		discovered := p.(provider)
+		if urls := []string{discovered.AuthorizeURL, discovered.TokenURL, discovered.UserInfoURL}; !reflect.DeepEqual(urls, tc.ExpectedURLs) {
+			t.Errorf("%s: expected urls %v, got %v", k, tc.ExpectedURLs, urls)
+		}
+		if !discovered.UsePKCE {
+			t.Errorf("%s: expected PKCE to be enabled", k)
+		}
+	}
+}
+
+func TestCodeVerifier(t *testing.T) {
+	p := provider{Config: Config{ClientSecret: "secret"}}
+	if verifier := p.CodeVerifier("state"); len(verifier) != 0 {
+		t.Errorf("expected no code verifier without PKCE, got %q", verifier)
+	}
+
+	p.UsePKCE = true
+	verifier := p.CodeVerifier("state")
+	// The code verifier MUST have a minimum length of 43 characters and a maximum length of 128 characters
+	// https://tools.ietf.org/html/rfc7636#section-4.1
+	if len(verifier) < 43 || len(verifier) > 128 {
+		t.Errorf("unexpected length of code verifier %q", verifier)
+	}
+	if p.CodeVerifier("state") != verifier {
+		t.Errorf("expected the code verifier of a state to be stable")
+	}
+	if p.CodeVerifier("other") == verifier {
+		t.Errorf("expected different states to have different code verifiers")
+	}
+}
+
+func TestGetUserIdentityGroups(t *testing.T) {
+	testcases := map[string]struct {
+		Claims         map[string]interface{}
+		GroupClaims    []string
+		ExpectedErr    bool
+		ExpectedGroups []string
+	}{
+		"no group claims configured": {
+			Claims: map[string]interface{}{"groups": []string{"admins"}},
+		},
+		"list of groups": {
+			Claims:         map[string]interface{}{"groups": []string{"admins", "devs"}},
+			GroupClaims:    []string{"groups"},
+			ExpectedGroups: []string{"admins", "devs"},
+		},
+		"single group": {
+			Claims:         map[string]interface{}{"role": "admins"},
+			GroupClaims:    []string{"groups", "role"},
+			ExpectedGroups: []string{"admins"},
+		},
+		"missing claim": {
+			Claims:         map[string]interface{}{},
+			GroupClaims:    []string{"groups"},
+			ExpectedGroups: []string{},
+		},
+		"invalid claim": {
+			Claims:      map[string]interface{}{"groups": 1},
+			GroupClaims: []string{"groups"},
+			ExpectedErr: true,
+		},
+	}
+
+	for k, tc := range testcases {
+		tc.Claims["sub"] = "bob"
+		payload, _ := json.Marshal(tc.Claims)
+		idToken := "header." + strings.TrimRight(base64.StdEncoding.EncodeToString(payload), "=") + ".signature"
+
+		p := provider{providerName: "openid", Config: Config{IDClaims: []string{"sub"}, GroupClaims: tc.GroupClaims}}
+		identity, ok, err := p.GetUserIdentity(&osincli.AccessData{ResponseData: osincli.ResponseData{"id_token": idToken}})
+		if tc.ExpectedErr != (err != nil) {
+			t.Errorf("%s: expected error=%v, got %v", k, tc.ExpectedErr, err)
+			continue
+		}
+		if tc.ExpectedErr {
+			continue
+		}
+		if !ok || identity.GetProviderUserName() != "bob" {
+			t.Errorf("%s: unexpected identity %#v", k, identity)
+			continue
+		}
+		if groups := identity.(authapi.UserIdentityGroupsInfo).GetProviderGroups(); !reflect.DeepEqual(groups, tc.ExpectedGroups) {
+			t.Errorf("%s: expected groups %v, got %v", k, tc.ExpectedGroups, groups)
+		}
+	}
+}
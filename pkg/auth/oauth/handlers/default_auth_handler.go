@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"sort"
 	"strings"
 
 	kerrors "k8s.io/kubernetes/pkg/util/errors"
@@ -148,8 +149,15 @@ func (authHandler *unionAuthenticationHandler) AuthenticationNeeded(apiClient au
 
 	// Delegate to provider selection
 	if authHandler.selectionHandler != nil {
-		providers := []ProviderInfo{}
+		// Offer the providers sorted by name, so the selection page is stable
+		names := []string{}
 		for name := range authHandler.redirectors {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		providers := []ProviderInfo{}
+		for _, name := range names {
 			u := *req.URL
 			q := u.Query()
 			q.Set(useRedirectParam, name)
@@ -221,6 +221,75 @@ func TestWithRedirect(t *testing.T) {
 	}
 }
 
+type mockRedirector struct {
+	name string
+}
+
+func (r *mockRedirector) AuthenticationRedirect(w http.ResponseWriter, req *http.Request) error {
+	http.Redirect(w, req, "https://"+r.name+".example.com", http.StatusFound)
+	return nil
+}
+
+type mockSelectionHandler struct {
+	providers []ProviderInfo
+}
+
+func (h *mockSelectionHandler) SelectAuthentication(providers []ProviderInfo, w http.ResponseWriter, req *http.Request) (*ProviderInfo, bool, error) {
+	h.providers = providers
+	w.WriteHeader(http.StatusOK)
+	return nil, true, nil
+}
+
+func TestWithMultipleRedirectors(t *testing.T) {
+	redirectors := map[string]AuthenticationRedirector{
+		"ldap":   &mockRedirector{name: "ldap"},
+		"github": &mockRedirector{name: "github"},
+		"google": &mockRedirector{name: "google"},
+	}
+	selectionHandler := &mockSelectionHandler{}
+	authHandler := NewUnionAuthenticationHandler(nil, redirectors, nil, selectionHandler)
+	client := &testClient{&oauthapi.OAuthClient{}}
+
+	// Without a hint, the providers are offered for selection in a stable order
+	req, _ := http.NewRequest("GET", "http://example.org/authorize?client_id=foo", nil)
+	responseRecorder := httptest.NewRecorder()
+	handled, err := authHandler.AuthenticationNeeded(client, responseRecorder, req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !handled {
+		t.Error("Expected handling.")
+	}
+	expectedProviders := []ProviderInfo{
+		{Name: "github", URL: "http://example.org/authorize?client_id=foo&idp=github"},
+		{Name: "google", URL: "http://example.org/authorize?client_id=foo&idp=google"},
+		{Name: "ldap", URL: "http://example.org/authorize?client_id=foo&idp=ldap"},
+	}
+	if !reflect.DeepEqual(selectionHandler.providers, expectedProviders) {
+		t.Errorf("Expected %#v, got %#v", expectedProviders, selectionHandler.providers)
+	}
+
+	// The idp parameter selects a provider directly
+	req, _ = http.NewRequest("GET", "http://example.org/authorize?client_id=foo&idp=ldap", nil)
+	responseRecorder = httptest.NewRecorder()
+	handled, err = authHandler.AuthenticationNeeded(client, responseRecorder, req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !handled {
+		t.Error("Expected handling.")
+	}
+	if location := responseRecorder.Header().Get("Location"); location != "https://ldap.example.com" {
+		t.Errorf("Expected redirect to the ldap provider, got %q", location)
+	}
+
+	// Unknown providers are rejected
+	req, _ = http.NewRequest("GET", "http://example.org/authorize?client_id=foo&idp=unknown", nil)
+	if _, err := authHandler.AuthenticationNeeded(client, httptest.NewRecorder(), req); err == nil {
+		t.Error("Expected an error for an unknown provider")
+	}
+}
+
 type badTestClient struct {
 	client *oauthapi.OAuthClient
 }
@@ -122,3 +122,23 @@ func (g *redirectGrant) GrantNeeded(user user.Info, grant *api.Grant, w http.Res
 	http.Redirect(w, req, redirectURL.String(), http.StatusFound)
 	return false, true, nil
 }
+
+type clientFilteredGrant struct {
+	filter    func(clientID string) bool
+	matched   GrantHandler
+	unmatched GrantHandler
+}
+
+// NewClientFilteredGrant returns a grant handler that delegates to matched when filter returns true for the ID
+// of the requesting client, and to unmatched otherwise
+func NewClientFilteredGrant(filter func(clientID string) bool, matched, unmatched GrantHandler) GrantHandler {
+	return &clientFilteredGrant{filter: filter, matched: matched, unmatched: unmatched}
+}
+
+// GrantNeeded implements the GrantHandler interface
+func (g *clientFilteredGrant) GrantNeeded(user user.Info, grant *api.Grant, w http.ResponseWriter, req *http.Request) (bool, bool, error) {
+	if g.filter(grant.Client.GetId()) {
+		return g.matched.GrantNeeded(user, grant, w, req)
+	}
+	return g.unmatched.GrantNeeded(user, grant, w, req)
+}
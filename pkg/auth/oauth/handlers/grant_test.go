@@ -1,8 +1,12 @@
 package handlers
 
 import (
+	"net/http/httptest"
 	"testing"
 
+	"github.com/RangelReale/osin"
+
+	"github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/oauth/server/osinserver"
 )
 
@@ -21,3 +25,18 @@ func TestAutoGrant(t *testing.T) {
 func TestRedirectGrant(t *testing.T) {
 	_ = NewRedirectGrant("/")
 }
+
+func TestClientFilteredGrant(t *testing.T) {
+	handler := NewClientFilteredGrant(func(clientID string) bool { return clientID == "filtered" }, NewEmptyGrant(), NewAutoGrant())
+
+	for clientID, expected := range map[string]bool{"filtered": false, "other": true} {
+		grant := &api.Grant{Client: &osin.DefaultClient{Id: clientID}}
+		authorized, _, err := handler.GrantNeeded(nil, grant, httptest.NewRecorder(), nil)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", clientID, err)
+		}
+		if authorized != expected {
+			t.Errorf("%s: expected authorized=%v, got %v", clientID, expected, authorized)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/RangelReale/osin"
+	"github.com/golang/glog"
+
+	"github.com/openshift/origin/pkg/authorization/authorizer/scope"
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+)
+
+// ScopeAuthorizer implements osinserver.AuthorizeHandler to reject requests for scopes that are invalid or that the
+// client is not allowed to request
+type ScopeAuthorizer struct{}
+
+// NewScopeAuthorizer returns a new ScopeAuthorizer
+func NewScopeAuthorizer() *ScopeAuthorizer {
+	return &ScopeAuthorizer{}
+}
+
+// HandleAuthorize implements osinserver.AuthorizeHandler. Requests without scopes are given the user:full scope.
+// If the requested scopes are allowed, false is returned and the next handler is called.
+// Otherwise the user agent is redirected to the client with an invalid_scope error.
+func (h *ScopeAuthorizer) HandleAuthorize(ar *osin.AuthorizeRequest, w http.ResponseWriter) (bool, error) {
+	if len(ar.Scope) == 0 {
+		ar.Scope = scope.UserFull
+	}
+	scopes := strings.Split(ar.Scope, " ")
+
+	err := scope.ValidateScopes(scopes)
+	if err == nil {
+		if client, ok := ar.Client.GetUserData().(*oauthapi.OAuthClient); ok {
+			err = scope.ValidateScopeRestrictions(client, scopes...)
+		}
+	}
+	if err == nil {
+		return false, nil
+	}
+
+	glog.V(4).Infof("OAuth scope error for client %s: %v", ar.Client.GetId(), err)
+	redirectURI, parseErr := url.Parse(ar.RedirectUri)
+	if parseErr != nil {
+		return false, parseErr
+	}
+	params := url.Values{}
+	params.Set("error", osin.E_INVALID_SCOPE)
+	params.Set("error_description", err.Error())
+	if len(ar.State) > 0 {
+		params.Set("state", ar.State)
+	}
+	// Implicit grant responses are returned in the fragment
+	if ar.Type == osin.TOKEN {
+		redirectURI.Fragment = ""
+		http.Redirect(w, ar.HttpRequest, redirectURI.String()+"#"+params.Encode(), http.StatusFound)
+		return true, nil
+	}
+	query := redirectURI.Query()
+	for key, values := range params {
+		query[key] = values
+	}
+	redirectURI.RawQuery = query.Encode()
+	http.Redirect(w, ar.HttpRequest, redirectURI.String(), http.StatusFound)
+	return true, nil
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/RangelReale/osin"
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+	"github.com/openshift/origin/pkg/oauth/server/osinserver"
+)
+
+func TestScopeAuthorizer(t *testing.T) {
+	_ = osinserver.AuthorizeHandler(&ScopeAuthorizer{})
+
+	client := &oauthapi.OAuthClient{
+		ObjectMeta:        kapi.ObjectMeta{Name: "client"},
+		ScopeRestrictions: []oauthapi.ScopeRestriction{{ExactValues: []string{"user:info"}}},
+	}
+
+	testCases := map[string]struct {
+		Scope string
+		Type  osin.AuthorizeRequestType
+
+		ExpectedScope    string
+		ExpectHandled    bool
+		ExpectedLocation string
+	}{
+		"allowed scope": {
+			Scope:         "user:info",
+			Type:          osin.CODE,
+			ExpectedScope: "user:info",
+		},
+		"defaulted scope": {
+			Scope:            "",
+			Type:             osin.CODE,
+			ExpectedScope:    "user:full",
+			ExpectHandled:    true,
+			ExpectedLocation: "https://example.com/cb?error=invalid_scope&error_description=%22user%3Afull%22+is+not+allowed+for+client+client&foo=bar&state=xyz",
+		},
+		"invalid scope in implicit grant": {
+			Scope:            "unknown",
+			Type:             osin.TOKEN,
+			ExpectedScope:    "unknown",
+			ExpectHandled:    true,
+			ExpectedLocation: "https://example.com/cb?foo=bar#error=invalid_scope&error_description=%22unknown%22+is+not+a+valid+scope&state=xyz",
+		},
+	}
+
+	for k, tc := range testCases {
+		req, _ := http.NewRequest("GET", "https://server/authorize", nil)
+		ar := &osin.AuthorizeRequest{
+			Type:        tc.Type,
+			Client:      &osin.DefaultClient{Id: "client", UserData: client},
+			Scope:       tc.Scope,
+			RedirectUri: "https://example.com/cb?foo=bar",
+			State:       "xyz",
+			HttpRequest: req,
+		}
+		w := httptest.NewRecorder()
+
+		handled, err := NewScopeAuthorizer().HandleAuthorize(ar, w)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+		if handled != tc.ExpectHandled {
+			t.Errorf("%s: expected handled %v, got %v", k, tc.ExpectHandled, handled)
+		}
+		if ar.Scope != tc.ExpectedScope {
+			t.Errorf("%s: expected scope %q, got %q", k, tc.ExpectedScope, ar.Scope)
+		}
+		if !tc.ExpectHandled {
+			continue
+		}
+		location, _ := url.QueryUnescape(w.Header().Get("Location"))
+		expected, _ := url.QueryUnescape(tc.ExpectedLocation)
+		if location != expected {
+			t.Errorf("%s: expected redirect to %s, got %s", k, expected, location)
+		}
+	}
+}
@@ -191,7 +191,7 @@ func TestRegistryAndServer(t *testing.T) {
 		if testCase.ClientAuth == nil {
 			grant.Err = apierrs.NewNotFound(oapi.Resource("OAuthClientAuthorization"), "test:test")
 		}
-		storage := registrystorage.New(access, authorize, client, NewUserConversion())
+		storage := registrystorage.New(access, authorize, client, NewUserConversion(), 0, nil)
 		config := osinserver.NewDefaultServerConfig()
 		server := osinserver.New(
 			config,
@@ -249,7 +249,7 @@ func TestRegistryAndServer(t *testing.T) {
 func TestAuthenticateTokenNotFound(t *testing.T) {
 	tokenRegistry := &test.AccessTokenRegistry{Err: apierrs.NewNotFound(oapi.Resource("OAuthAccessToken"), "token")}
 	userRegistry := usertest.NewUserRegistry()
-	tokenAuthenticator := NewTokenAuthenticator(tokenRegistry, userRegistry, identitymapper.NoopGroupMapper{})
+	tokenAuthenticator := NewTokenAuthenticator(tokenRegistry, userRegistry, identitymapper.NoopGroupMapper{}, &test.ClientRegistry{}, 0)
 
 	userInfo, found, err := tokenAuthenticator.AuthenticateToken("token")
 	if found {
@@ -268,7 +268,7 @@ func TestAuthenticateTokenNotFound(t *testing.T) {
 func TestAuthenticateTokenOtherGetError(t *testing.T) {
 	tokenRegistry := &test.AccessTokenRegistry{Err: errors.New("get error")}
 	userRegistry := usertest.NewUserRegistry()
-	tokenAuthenticator := NewTokenAuthenticator(tokenRegistry, userRegistry, identitymapper.NoopGroupMapper{})
+	tokenAuthenticator := NewTokenAuthenticator(tokenRegistry, userRegistry, identitymapper.NoopGroupMapper{}, &test.ClientRegistry{}, 0)
 
 	userInfo, found, err := tokenAuthenticator.AuthenticateToken("token")
 	if found {
@@ -293,7 +293,7 @@ func TestAuthenticateTokenExpired(t *testing.T) {
 		},
 	}
 	userRegistry := usertest.NewUserRegistry()
-	tokenAuthenticator := NewTokenAuthenticator(tokenRegistry, userRegistry, identitymapper.NoopGroupMapper{})
+	tokenAuthenticator := NewTokenAuthenticator(tokenRegistry, userRegistry, identitymapper.NoopGroupMapper{}, &test.ClientRegistry{}, 0)
 
 	userInfo, found, err := tokenAuthenticator.AuthenticateToken("token")
 	if found {
@@ -319,7 +319,7 @@ func TestAuthenticateTokenValidated(t *testing.T) {
 	userRegistry := usertest.NewUserRegistry()
 	userRegistry.Get["foo"] = &userapi.User{ObjectMeta: kapi.ObjectMeta{UID: "bar"}}
 
-	tokenAuthenticator := NewTokenAuthenticator(tokenRegistry, userRegistry, identitymapper.NoopGroupMapper{})
+	tokenAuthenticator := NewTokenAuthenticator(tokenRegistry, userRegistry, identitymapper.NoopGroupMapper{}, &test.ClientRegistry{}, 0)
 
 	userInfo, found, err := tokenAuthenticator.AuthenticateToken("token")
 	if !found {
@@ -332,3 +332,139 @@ func TestAuthenticateTokenValidated(t *testing.T) {
 		t.Error("Did not get a user!")
 	}
 }
+
+func TestAuthenticateTokenTimedOut(t *testing.T) {
+	tokenRegistry := &test.AccessTokenRegistry{
+		Err: nil,
+		AccessToken: &oapi.OAuthAccessToken{
+			ObjectMeta:               kapi.ObjectMeta{CreationTimestamp: unversioned.Time{Time: time.Now().Add(-1 * time.Hour)}},
+			ExpiresIn:                24 * 60 * 60, // 1 day
+			InactivityTimeoutSeconds: 600,          // 10 minutes
+		},
+	}
+	userRegistry := usertest.NewUserRegistry()
+	tokenAuthenticator := NewTokenAuthenticator(tokenRegistry, userRegistry, identitymapper.NoopGroupMapper{}, &test.ClientRegistry{}, 600)
+
+	userInfo, found, err := tokenAuthenticator.AuthenticateToken("token")
+	if found {
+		t.Error("Found token, but it should be missing!")
+	}
+	if err != ErrTimedOut {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if userInfo != nil {
+		t.Errorf("Unexpected user: %v", userInfo)
+	}
+}
+
+func TestAuthenticateTokenExtendsInactivityTimeout(t *testing.T) {
+	clientTimeout := int32(1200)
+	testCases := map[string]struct {
+		InactivityTimeoutSeconds int32
+		Client                   *oapi.OAuthClient
+
+		ExpectedInactivityTimeoutSeconds int32
+	}{
+		"extends with the default timeout": {
+			InactivityTimeoutSeconds:         1900,
+			Client:                           &oapi.OAuthClient{},
+			ExpectedInactivityTimeoutSeconds: 1800 + 600,
+		},
+		"extends with the timeout of the client": {
+			InactivityTimeoutSeconds:         2000,
+			Client:                           &oapi.OAuthClient{AccessTokenInactivityTimeoutSeconds: &clientTimeout},
+			ExpectedInactivityTimeoutSeconds: 1800 + 1200,
+		},
+		"does not update recently extended tokens": {
+			InactivityTimeoutSeconds: 1800 + 590,
+			Client:                   &oapi.OAuthClient{},
+		},
+	}
+
+	for k, tc := range testCases {
+		tokenRegistry := &test.AccessTokenRegistry{
+			AccessToken: &oapi.OAuthAccessToken{
+				ObjectMeta:               kapi.ObjectMeta{CreationTimestamp: unversioned.Time{Time: time.Now().Add(-30 * time.Minute)}},
+				ExpiresIn:                24 * 60 * 60, // 1 day
+				InactivityTimeoutSeconds: tc.InactivityTimeoutSeconds,
+				UserName:                 "foo",
+				UserUID:                  "bar",
+			},
+		}
+		userRegistry := usertest.NewUserRegistry()
+		userRegistry.Get["foo"] = &userapi.User{ObjectMeta: kapi.ObjectMeta{UID: "bar"}}
+		tokenAuthenticator := NewTokenAuthenticator(tokenRegistry, userRegistry, identitymapper.NoopGroupMapper{}, &test.ClientRegistry{Client: tc.Client}, 600)
+
+		if _, found, err := tokenAuthenticator.AuthenticateToken("token"); !found || err != nil {
+			t.Errorf("%s: expected the token to be found, got %v", k, err)
+			continue
+		}
+
+		switch {
+		case tc.ExpectedInactivityTimeoutSeconds == 0 && tokenRegistry.UpdatedAccessToken != nil:
+			t.Errorf("%s: unexpected update of the token: %#v", k, tokenRegistry.UpdatedAccessToken)
+		case tc.ExpectedInactivityTimeoutSeconds != 0 && tokenRegistry.UpdatedAccessToken == nil:
+			t.Errorf("%s: expected the token to be updated", k)
+		case tc.ExpectedInactivityTimeoutSeconds != 0 && tokenRegistry.UpdatedAccessToken.InactivityTimeoutSeconds != tc.ExpectedInactivityTimeoutSeconds:
+			t.Errorf("%s: expected an inactivity timeout of %d, got %d", k, tc.ExpectedInactivityTimeoutSeconds, tokenRegistry.UpdatedAccessToken.InactivityTimeoutSeconds)
+		}
+	}
+}
+
+func TestAuthenticateTokenCachesClientTimeout(t *testing.T) {
+	clientTimeout := int32(1200)
+	token := &oapi.OAuthAccessToken{
+		ObjectMeta:               kapi.ObjectMeta{CreationTimestamp: unversioned.Time{Time: time.Now().Add(-30 * time.Minute)}},
+		ExpiresIn:                24 * 60 * 60, // 1 day
+		InactivityTimeoutSeconds: 1900,
+		UserName:                 "foo",
+		UserUID:                  "bar",
+	}
+	tokenRegistry := &test.AccessTokenRegistry{AccessToken: token}
+	clientRegistry := &test.ClientRegistry{Client: &oapi.OAuthClient{AccessTokenInactivityTimeoutSeconds: &clientTimeout}}
+	userRegistry := usertest.NewUserRegistry()
+	userRegistry.Get["foo"] = &userapi.User{ObjectMeta: kapi.ObjectMeta{UID: "bar"}}
+	tokenAuthenticator := NewTokenAuthenticator(tokenRegistry, userRegistry, identitymapper.NoopGroupMapper{}, clientRegistry, 600)
+
+	if _, found, err := tokenAuthenticator.AuthenticateToken("token"); !found || err != nil {
+		t.Fatalf("expected the token to be found, got %v", err)
+	}
+
+	// the client is not read again while its timeout is cached
+	clientRegistry.Err = errors.New("unexpected client get")
+	tokenRegistry.UpdatedAccessToken = nil
+	if _, found, err := tokenAuthenticator.AuthenticateToken("token"); !found || err != nil {
+		t.Fatalf("expected the token to be found, got %v", err)
+	}
+	if tokenRegistry.UpdatedAccessToken == nil || tokenRegistry.UpdatedAccessToken.InactivityTimeoutSeconds != 1800+1200 {
+		t.Errorf("expected the token to be extended with the cached timeout of the client, got %#v", tokenRegistry.UpdatedAccessToken)
+	}
+
+	// the cached timeout expires
+	tokenAuthenticator.now = func() time.Time { return time.Now().Add(clientTimeoutCacheTTL) }
+	tokenRegistry.UpdatedAccessToken = nil
+	if _, found, err := tokenAuthenticator.AuthenticateToken("token"); !found || err != nil {
+		t.Fatalf("expected the token to be found, got %v", err)
+	}
+	if tokenRegistry.UpdatedAccessToken == nil || tokenRegistry.UpdatedAccessToken.InactivityTimeoutSeconds != 1800+60+600 {
+		t.Errorf("expected the token to be extended with the default timeout, got %#v", tokenRegistry.UpdatedAccessToken)
+	}
+}
+
+func TestAuthenticateTokenNoExpiry(t *testing.T) {
+	tokenRegistry := &test.AccessTokenRegistry{
+		AccessToken: &oapi.OAuthAccessToken{
+			ObjectMeta: kapi.ObjectMeta{CreationTimestamp: unversioned.Time{Time: time.Now().Add(-365 * 24 * time.Hour)}},
+			ExpiresIn:  oapi.AccessTokenNoExpiry,
+			UserName:   "foo",
+			UserUID:    "bar",
+		},
+	}
+	userRegistry := usertest.NewUserRegistry()
+	userRegistry.Get["foo"] = &userapi.User{ObjectMeta: kapi.ObjectMeta{UID: "bar"}}
+	tokenAuthenticator := NewTokenAuthenticator(tokenRegistry, userRegistry, identitymapper.NoopGroupMapper{}, &test.ClientRegistry{}, 0)
+
+	if _, found, err := tokenAuthenticator.AuthenticateToken("token"); !found || err != nil {
+		t.Errorf("expected the token to be found, got %v", err)
+	}
+}
@@ -5,8 +5,14 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/golang/glog"
+	lru "github.com/hashicorp/golang-lru"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/auth/userregistry/identitymapper"
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
 	"github.com/openshift/origin/pkg/oauth/registry/oauthaccesstoken"
+	"github.com/openshift/origin/pkg/oauth/registry/oauthclient"
 	"github.com/openshift/origin/pkg/user/registry/user"
 	"k8s.io/kubernetes/pkg/api"
 	kuser "k8s.io/kubernetes/pkg/auth/user"
@@ -16,15 +22,43 @@ type TokenAuthenticator struct {
 	tokens      oauthaccesstoken.Registry
 	users       user.Registry
 	groupMapper identitymapper.UserToGroupMapper
+	clients     oauthclient.Registry
+
+	// inactivityTimeoutSeconds is the inactivity timeout of the tokens of clients that do not override it
+	inactivityTimeoutSeconds int32
+
+	// clientTimeouts caches the inactivity timeout overrides of clients, so using a token does not read its client
+	clientTimeouts *lru.Cache
+	now            func() time.Time
 }
 
-var ErrExpired = errors.New("Token is expired")
+type clientTimeoutRecord struct {
+	created time.Time
+	timeout *int32
+}
+
+const (
+	clientTimeoutCacheSize = 1024
+	clientTimeoutCacheTTL  = 1 * time.Minute
+)
+
+var (
+	ErrExpired  = errors.New("Token is expired")
+	ErrTimedOut = errors.New("Token timed out from inactivity")
+)
 
-func NewTokenAuthenticator(tokens oauthaccesstoken.Registry, users user.Registry, groupMapper identitymapper.UserToGroupMapper) *TokenAuthenticator {
+func NewTokenAuthenticator(tokens oauthaccesstoken.Registry, users user.Registry, groupMapper identitymapper.UserToGroupMapper, clients oauthclient.Registry, inactivityTimeoutSeconds int32) *TokenAuthenticator {
+	clientTimeouts, _ := lru.New(clientTimeoutCacheSize)
 	return &TokenAuthenticator{
 		tokens:      tokens,
 		users:       users,
 		groupMapper: groupMapper,
+		clients:     clients,
+
+		inactivityTimeoutSeconds: inactivityTimeoutSeconds,
+
+		clientTimeouts: clientTimeouts,
+		now:            time.Now,
 	}
 }
 
@@ -35,9 +69,16 @@ func (a *TokenAuthenticator) AuthenticateToken(value string) (kuser.Info, bool,
 	if err != nil {
 		return nil, false, err
 	}
-	if token.CreationTimestamp.Time.Add(time.Duration(token.ExpiresIn) * time.Second).Before(time.Now()) {
+	now := a.now()
+	if token.ExpiresIn != oauthapi.AccessTokenNoExpiry && token.CreationTimestamp.Time.Add(time.Duration(token.ExpiresIn)*time.Second).Before(now) {
 		return nil, false, ErrExpired
 	}
+	if token.InactivityTimeoutSeconds > 0 {
+		if token.CreationTimestamp.Time.Add(time.Duration(token.InactivityTimeoutSeconds) * time.Second).Before(now) {
+			return nil, false, ErrTimedOut
+		}
+		a.extendInactivityTimeout(ctx, token, now)
+	}
 
 	u, err := a.users.GetUser(ctx, token.UserName)
 	if err != nil {
@@ -57,9 +98,60 @@ func (a *TokenAuthenticator) AuthenticateToken(value string) (kuser.Info, bool,
 	}
 	groupNames = append(groupNames, u.Groups...)
 
-	return &kuser.DefaultInfo{
+	info := kuser.DefaultInfo{
 		Name:   u.Name,
 		UID:    string(u.UID),
 		Groups: groupNames,
-	}, true, nil
+	}
+	// the authorizer restricts the user to the scopes of the token
+	if len(token.Scopes) > 0 {
+		return &authapi.DefaultScopedUserInfo{DefaultInfo: info, Scopes: token.Scopes}, true, nil
+	}
+	return &info, true, nil
+}
+
+// extendInactivityTimeout pushes back the inactivity timeout of a token that was just used. To limit the writes,
+// the token is only updated once it has been idle for a tenth of its timeout. Failures are logged but do not fail
+// the authentication, the token will be extended on its next use.
+func (a *TokenAuthenticator) extendInactivityTimeout(ctx api.Context, token *oauthapi.OAuthAccessToken, now time.Time) {
+	timeout := a.inactivityTimeoutSeconds
+	if clientTimeout, err := a.clientInactivityTimeout(ctx, token.ClientName, now); err != nil {
+		glog.V(4).Infof("Unable to get the client of token %s, using the default inactivity timeout: %v", token.Name, err)
+	} else if clientTimeout != nil {
+		timeout = *clientTimeout
+	}
+	if timeout <= 0 {
+		return
+	}
+
+	elapsed := int32(now.Sub(token.CreationTimestamp.Time) / time.Second)
+	extended := elapsed + timeout
+	if extended-token.InactivityTimeoutSeconds < timeout/10 {
+		return
+	}
+
+	updated := *token
+	updated.InactivityTimeoutSeconds = extended
+	if _, err := a.tokens.UpdateAccessToken(ctx, &updated); err != nil {
+		glog.V(4).Infof("Unable to extend the inactivity timeout of token %s: %v", token.Name, err)
+	}
+}
+
+// clientInactivityTimeout returns the inactivity timeout override of the named client, or nil if it does not override it.
+// Lookups are cached for clientTimeoutCacheTTL, failed lookups are not cached.
+func (a *TokenAuthenticator) clientInactivityTimeout(ctx api.Context, name string, now time.Time) (*int32, error) {
+	if value, hit := a.clientTimeouts.Get(name); hit {
+		record := value.(*clientTimeoutRecord)
+		if record.created.Add(clientTimeoutCacheTTL).After(now) {
+			return record.timeout, nil
+		}
+		a.clientTimeouts.Remove(name)
+	}
+
+	client, err := a.clients.GetClient(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	a.clientTimeouts.Add(name, &clientTimeoutRecord{created: now, timeout: client.AccessTokenInactivityTimeoutSeconds})
+	return client.AccessTokenInactivityTimeoutSeconds, nil
 }
@@ -0,0 +1,155 @@
+package tokenrevocation
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	"github.com/openshift/origin/pkg/auth/authenticator"
+	"github.com/openshift/origin/pkg/auth/server/login"
+	"github.com/openshift/origin/pkg/oauth/registry/oauthaccesstoken"
+	"github.com/openshift/origin/pkg/oauth/registry/oauthauthorizetoken"
+	"github.com/openshift/origin/pkg/oauth/registry/oauthclientauthorization"
+)
+
+// RevokeTokensEndpoint revokes every access token, authorize token and client authorization of the user making the request
+const RevokeTokensEndpoint = "/token/revoke"
+
+// SessionInvalidator clears the login session of a browser, if it has one
+type SessionInvalidator interface {
+	InvalidateAuthentication(w http.ResponseWriter, req *http.Request) error
+}
+
+// RevokeTokensResponse is returned on a successful revocation
+type RevokeTokensResponse struct {
+	// AccessTokens is the number of access tokens that were revoked
+	AccessTokens int `json:"accessTokens"`
+	// AuthorizeTokens is the number of authorize tokens that were revoked
+	AuthorizeTokens int `json:"authorizeTokens"`
+	// ClientAuthorizations is the number of client authorizations that were revoked
+	ClientAuthorizations int `json:"clientAuthorizations"`
+}
+
+type endpoints struct {
+	auth                 authenticator.Request
+	accessTokens         oauthaccesstoken.Registry
+	authorizeTokens      oauthauthorizetoken.Registry
+	clientAuthorizations oauthclientauthorization.Registry
+	session              SessionInvalidator
+}
+
+// NewEndpoints returns the token revocation endpoints. The auth authenticator identifies the user whose tokens
+// are revoked, session may be nil when the server does not keep login sessions. Login sessions are stored in
+// cookies, so only the session of the browser making the request can be invalidated.
+func NewEndpoints(auth authenticator.Request, accessTokens oauthaccesstoken.Registry, authorizeTokens oauthauthorizetoken.Registry, clientAuthorizations oauthclientauthorization.Registry, session SessionInvalidator) *endpoints {
+	return &endpoints{
+		auth:                 auth,
+		accessTokens:         accessTokens,
+		authorizeTokens:      authorizeTokens,
+		clientAuthorizations: clientAuthorizations,
+		session:              session,
+	}
+}
+
+// Install registers the token revocation endpoints into a mux. It is expected that the
+// provided prefix will serve all operations
+func (e *endpoints) Install(mux login.Mux, paths ...string) {
+	for _, prefix := range paths {
+		mux.HandleFunc(path.Join(prefix, RevokeTokensEndpoint), e.revokeTokens)
+	}
+}
+
+func (e *endpoints) revokeTokens(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "POST" {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	user, ok, err := e.auth.AuthenticateRequest(req)
+	if err != nil {
+		glog.V(4).Infof("Unable to authenticate token revocation request: %v", err)
+	}
+	if !ok || err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	// a token restricted to scopes cannot be used to end every session of its user
+	if len(authapi.ScopesFor(user)) > 0 {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	response, err := RevokeUserTokens(user.GetName(), e.accessTokens, e.authorizeTokens, e.clientAuthorizations)
+	if err != nil {
+		glog.Errorf("Unable to revoke the tokens of %s: %v", user.GetName(), err)
+		http.Error(w, "Unable to revoke tokens", http.StatusInternalServerError)
+		return
+	}
+
+	if e.session != nil {
+		if err := e.session.InvalidateAuthentication(w, req); err != nil {
+			glog.V(4).Infof("Unable to invalidate the session of %s: %v", user.GetName(), err)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		glog.V(4).Infof("Unable to write token revocation response: %v", err)
+	}
+}
+
+// RevokeUserTokens deletes every access token, authorize token and client authorization of the named user, so
+// clients must ask the user for their grants again. All objects are attempted even if some of them cannot be
+// deleted, and the number of revoked objects is returned along with any error.
+func RevokeUserTokens(userName string, accessTokens oauthaccesstoken.Registry, authorizeTokens oauthauthorizetoken.Registry, clientAuthorizations oauthclientauthorization.Registry) (*RevokeTokensResponse, error) {
+	ctx := kapi.NewContext()
+	options := &kapi.ListOptions{FieldSelector: fields.OneTermEqualSelector("userName", userName)}
+	response := &RevokeTokensResponse{}
+	errs := []error{}
+
+	accessTokenList, err := accessTokens.ListAccessTokens(ctx, options)
+	if err != nil {
+		return response, err
+	}
+	for _, token := range accessTokenList.Items {
+		if err := accessTokens.DeleteAccessToken(ctx, token.Name); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		response.AccessTokens++
+	}
+
+	authorizeTokenList, err := authorizeTokens.ListAuthorizeTokens(ctx, options)
+	if err != nil {
+		return response, err
+	}
+	for _, token := range authorizeTokenList.Items {
+		if err := authorizeTokens.DeleteAuthorizeToken(ctx, token.Name); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		response.AuthorizeTokens++
+	}
+
+	clientAuthorizationList, err := clientAuthorizations.ListClientAuthorizations(ctx, options)
+	if err != nil {
+		return response, err
+	}
+	for _, authorization := range clientAuthorizationList.Items {
+		if err := clientAuthorizations.DeleteClientAuthorization(ctx, authorization.Name); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		response.ClientAuthorizations++
+	}
+
+	return response, utilerrors.NewAggregate(errs)
+}
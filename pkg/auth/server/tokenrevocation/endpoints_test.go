@@ -0,0 +1,113 @@
+package tokenrevocation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/auth/user"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	"github.com/openshift/origin/pkg/auth/authenticator"
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+	"github.com/openshift/origin/pkg/oauth/registry/test"
+)
+
+type testSession struct {
+	invalidated bool
+}
+
+func (s *testSession) InvalidateAuthentication(w http.ResponseWriter, req *http.Request) error {
+	s.invalidated = true
+	return nil
+}
+
+func TestRevokeTokens(t *testing.T) {
+	testCases := map[string]struct {
+		Method string
+		User   user.Info
+
+		ExpectedCode     int
+		ExpectedRevoked  bool
+		ExpectedResponse RevokeTokensResponse
+	}{
+		"get": {
+			Method:       "GET",
+			User:         &user.DefaultInfo{Name: "bob"},
+			ExpectedCode: http.StatusMethodNotAllowed,
+		},
+		"unauthenticated": {
+			Method:       "POST",
+			ExpectedCode: http.StatusUnauthorized,
+		},
+		"scoped": {
+			Method:       "POST",
+			User:         &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{"user:info"}},
+			ExpectedCode: http.StatusForbidden,
+		},
+		"authenticated": {
+			Method:           "POST",
+			User:             &user.DefaultInfo{Name: "bob"},
+			ExpectedCode:     http.StatusOK,
+			ExpectedRevoked:  true,
+			ExpectedResponse: RevokeTokensResponse{AccessTokens: 1, AuthorizeTokens: 1, ClientAuthorizations: 1},
+		},
+	}
+
+	for k, tc := range testCases {
+		accessTokens := &test.AccessTokenRegistry{
+			AccessTokens: &oauthapi.OAuthAccessTokenList{Items: []oauthapi.OAuthAccessToken{{ObjectMeta: kapi.ObjectMeta{Name: "access"}, UserName: "bob"}}},
+		}
+		authorizeTokens := &test.AuthorizeTokenRegistry{
+			AuthorizeTokens: &oauthapi.OAuthAuthorizeTokenList{Items: []oauthapi.OAuthAuthorizeToken{{ObjectMeta: kapi.ObjectMeta{Name: "authorize"}, UserName: "bob"}}},
+		}
+		clientAuthorizations := &test.ClientAuthorizationRegistry{
+			ClientAuthorizations: &oauthapi.OAuthClientAuthorizationList{Items: []oauthapi.OAuthClientAuthorization{{ObjectMeta: kapi.ObjectMeta{Name: "bob:client"}, UserName: "bob"}}},
+		}
+		session := &testSession{}
+		auth := authenticator.RequestFunc(func(req *http.Request) (user.Info, bool, error) {
+			return tc.User, tc.User != nil, nil
+		})
+
+		mux := http.NewServeMux()
+		NewEndpoints(auth, accessTokens, authorizeTokens, clientAuthorizations, session).Install(mux, "/oauth")
+		server := httptest.NewServer(mux)
+
+		req, _ := http.NewRequest(tc.Method, server.URL+"/oauth"+RevokeTokensEndpoint, nil)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			server.Close()
+			continue
+		}
+
+		if resp.StatusCode != tc.ExpectedCode {
+			t.Errorf("%s: expected code %d, got %d", k, tc.ExpectedCode, resp.StatusCode)
+		}
+		if tc.ExpectedRevoked {
+			response := RevokeTokensResponse{}
+			if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+				t.Errorf("%s: unexpected error: %v", k, err)
+			}
+			if response != tc.ExpectedResponse {
+				t.Errorf("%s: expected response %#v, got %#v", k, tc.ExpectedResponse, response)
+			}
+			if accessTokens.DeletedAccessTokenName != "access" || authorizeTokens.DeletedAuthorizeTokenName != "authorize" {
+				t.Errorf("%s: expected tokens to be deleted, got %q and %q", k, accessTokens.DeletedAccessTokenName, authorizeTokens.DeletedAuthorizeTokenName)
+			}
+			if clientAuthorizations.DeletedClientAuthorizationName != "bob:client" {
+				t.Errorf("%s: expected the client authorization to be deleted, got %q", k, clientAuthorizations.DeletedClientAuthorizationName)
+			}
+			if !session.invalidated {
+				t.Errorf("%s: expected the session to be invalidated", k)
+			}
+		} else if len(accessTokens.DeletedAccessTokenName) > 0 || len(authorizeTokens.DeletedAuthorizeTokenName) > 0 || len(clientAuthorizations.DeletedClientAuthorizationName) > 0 {
+			t.Errorf("%s: expected no tokens to be deleted", k)
+		}
+
+		resp.Body.Close()
+		server.Close()
+	}
+}
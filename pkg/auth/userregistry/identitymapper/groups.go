@@ -0,0 +1,126 @@
+package identitymapper
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrs "k8s.io/kubernetes/pkg/api/errors"
+	kuser "k8s.io/kubernetes/pkg/auth/user"
+	kerrors "k8s.io/kubernetes/pkg/util/errors"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	userapi "github.com/openshift/origin/pkg/user/api"
+	"github.com/openshift/origin/pkg/user/api/validation"
+	groupregistry "github.com/openshift/origin/pkg/user/registry/group"
+)
+
+// GroupIdentityProviderAnnotation is set on the groups created from the groups asserted by an identity provider.
+// Its value is the name of the provider, whose logins then manage the membership of the group.
+const GroupIdentityProviderAnnotation = "openshift.io/identity-provider"
+
+var _ = authapi.UserIdentityMapper(&groupSyncingIdentityMapper{})
+
+// groupSyncingIdentityMapper implements api.UserIdentityMapper
+// It maps identities with its delegate, then syncs the group memberships of the user with the groups asserted by the identity
+type groupSyncingIdentityMapper struct {
+	delegate authapi.UserIdentityMapper
+	groups   groupregistry.Registry
+}
+
+// NewGroupSyncingIdentityMapper returns a UserIdentityMapper that maps identities to users with delegate, then:
+// 1. Adds the user to the groups asserted by the identity, creating them if needed
+// 2. Removes the user from the other groups created for the provider of the identity
+// Groups that were not created for the provider of the identity are never modified
+func NewGroupSyncingIdentityMapper(delegate authapi.UserIdentityMapper, groups groupregistry.Registry) authapi.UserIdentityMapper {
+	return &groupSyncingIdentityMapper{delegate: delegate, groups: groups}
+}
+
+// UserFor returns info about the user for whom identity info have been provided
+func (m *groupSyncingIdentityMapper) UserFor(info authapi.UserIdentityInfo) (kuser.Info, error) {
+	user, err := m.delegate.UserFor(info)
+	if err != nil {
+		return nil, err
+	}
+
+	asserted := sets.NewString()
+	if groupsInfo, ok := info.(authapi.UserIdentityGroupsInfo); ok {
+		for _, name := range groupsInfo.GetProviderGroups() {
+			if ok, reason := validation.ValidateGroupName(name, false); !ok {
+				glog.V(4).Infof("Ignoring group %q asserted by identity provider %s: %s", name, info.GetProviderName(), reason)
+				continue
+			}
+			asserted.Insert(name)
+		}
+	}
+
+	// Retrying up to three times lets us handle races with the concurrent logins of other members of the groups
+	for i := 0; i < 3; i++ {
+		err = m.syncGroups(kapi.NewContext(), info.GetProviderName(), user.GetName(), asserted)
+		if !kerrs.IsConflict(err) && !kerrs.IsAlreadyExists(err) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to update the groups of user %s: %v", user.GetName(), err)
+	}
+
+	return user, nil
+}
+
+// syncGroups makes username a member of exactly the asserted groups among the groups managed by providerName
+// Conflicts are returned immediately so the sync can be retried with fresh groups
+func (m *groupSyncingIdentityMapper) syncGroups(ctx kapi.Context, providerName, username string, asserted sets.String) error {
+	groups, err := m.groups.ListGroups(ctx, &kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	errs := []error{}
+	existing := sets.NewString()
+	for i := range groups.Items {
+		group := groups.Items[i]
+		existing.Insert(group.Name)
+
+		if group.Annotations[GroupIdentityProviderAnnotation] != providerName {
+			if asserted.Has(group.Name) {
+				glog.V(4).Infof("Not adding user %s to group %s, which is not managed by identity provider %s", username, group.Name, providerName)
+			}
+			continue
+		}
+
+		users := sets.NewString(group.Users...)
+		switch {
+		case asserted.Has(group.Name) && !users.Has(username):
+			group.Users = append(group.Users, username)
+		case !asserted.Has(group.Name) && users.Has(username):
+			users.Delete(username)
+			group.Users = users.List()
+		default:
+			continue
+		}
+		if _, err := m.groups.UpdateGroup(ctx, &group); kerrs.IsConflict(err) {
+			return err
+		} else if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, name := range asserted.Difference(existing).List() {
+		group := &userapi.Group{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:        name,
+				Annotations: map[string]string{GroupIdentityProviderAnnotation: providerName},
+			},
+			Users: []string{username},
+		}
+		if _, err := m.groups.CreateGroup(ctx, group); kerrs.IsAlreadyExists(err) {
+			return err
+		} else if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
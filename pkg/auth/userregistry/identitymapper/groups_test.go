@@ -0,0 +1,127 @@
+package identitymapper
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrs "k8s.io/kubernetes/pkg/api/errors"
+	kuser "k8s.io/kubernetes/pkg/auth/user"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	"github.com/openshift/origin/pkg/user/api"
+	"github.com/openshift/origin/pkg/user/registry/test"
+)
+
+type fixedIdentityMapper struct {
+	user kuser.Info
+}
+
+func (m fixedIdentityMapper) UserFor(identityInfo authapi.UserIdentityInfo) (kuser.Info, error) {
+	return m.user, nil
+}
+
+func makeGroup(name, providerName string, users ...string) *api.Group {
+	group := &api.Group{
+		ObjectMeta: kapi.ObjectMeta{Name: name},
+		Users:      users,
+	}
+	if len(providerName) > 0 {
+		group.Annotations = map[string]string{GroupIdentityProviderAnnotation: providerName}
+	}
+	return group
+}
+
+func TestGroupSyncingIdentityMapper(t *testing.T) {
+	testcases := map[string]struct {
+		ProviderGroups []string
+		ExistingGroups []*api.Group
+
+		ExpectedGroups map[string][]string
+	}{
+		"creates asserted groups": {
+			ProviderGroups: []string{"admins", "devs"},
+			ExpectedGroups: map[string][]string{"admins": {"bob"}, "devs": {"bob"}},
+		},
+		"adds to existing groups of the provider": {
+			ProviderGroups: []string{"devs"},
+			ExistingGroups: []*api.Group{makeGroup("devs", "idp", "alice")},
+			ExpectedGroups: map[string][]string{"devs": {"alice", "bob"}},
+		},
+		"removes from groups no longer asserted": {
+			ProviderGroups: []string{},
+			ExistingGroups: []*api.Group{makeGroup("devs", "idp", "alice", "bob")},
+			ExpectedGroups: map[string][]string{"devs": {"alice"}},
+		},
+		"ignores groups of other providers and unmanaged groups": {
+			ProviderGroups: []string{"devs", "ops"},
+			ExistingGroups: []*api.Group{
+				makeGroup("devs", "other", "alice"),
+				makeGroup("ops", "", "alice"),
+				makeGroup("admins", "other", "bob"),
+			},
+			ExpectedGroups: map[string][]string{"devs": {"alice"}, "ops": {"alice"}, "admins": {"bob"}},
+		},
+		"ignores invalid group names": {
+			ProviderGroups: []string{"devs", "idp:devs", ".."},
+			ExpectedGroups: map[string][]string{"devs": {"bob"}},
+		},
+	}
+
+	for k, tc := range testcases {
+		groupRegistry := test.NewGroupRegistry()
+		existing := map[string]bool{}
+		for _, group := range tc.ExistingGroups {
+			groupRegistry.Get[group.Name] = group
+			existing[group.Name] = true
+		}
+
+		mapper := NewGroupSyncingIdentityMapper(fixedIdentityMapper{&kuser.DefaultInfo{Name: "bob"}}, groupRegistry)
+
+		identity := authapi.NewDefaultUserIdentityInfo("idp", "bob")
+		identity.ProviderGroups = tc.ProviderGroups
+		user, err := mapper.UserFor(identity)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+		if user.GetName() != "bob" {
+			t.Errorf("%s: expected user bob, got %s", k, user.GetName())
+		}
+
+		groups := map[string][]string{}
+		for name, group := range groupRegistry.Get {
+			groups[name] = group.Users
+			if !existing[name] && group.Annotations[GroupIdentityProviderAnnotation] != "idp" {
+				t.Errorf("%s: expected created group %s to be annotated with its provider", k, name)
+			}
+		}
+		if !reflect.DeepEqual(groups, tc.ExpectedGroups) {
+			t.Errorf("%s: expected groups %v, got %v", k, tc.ExpectedGroups, groups)
+		}
+	}
+}
+
+func TestGroupSyncingIdentityMapperRetriesConflicts(t *testing.T) {
+	groupRegistry := test.NewGroupRegistry()
+	groupRegistry.Get["devs"] = makeGroup("devs", "idp", "alice")
+	groupRegistry.UpdateErr["devs"] = kerrs.NewConflict(api.Resource("group"), "devs", nil)
+
+	mapper := NewGroupSyncingIdentityMapper(fixedIdentityMapper{&kuser.DefaultInfo{Name: "bob"}}, groupRegistry)
+
+	identity := authapi.NewDefaultUserIdentityInfo("idp", "bob")
+	identity.ProviderGroups = []string{"devs"}
+	if _, err := mapper.UserFor(identity); err == nil {
+		t.Fatalf("expected an error")
+	}
+
+	updates := 0
+	for _, action := range *groupRegistry.Actions {
+		if action.Name == "UpdateGroup" {
+			updates++
+		}
+	}
+	if updates != 3 {
+		t.Errorf("expected 3 attempts to update the group, got %d", updates)
+	}
+}
@@ -54,6 +54,7 @@ func ToRole(in *ClusterRole) *Role {
 	ret := &Role{}
 	ret.ObjectMeta = in.ObjectMeta
 	ret.Rules = in.Rules
+	ret.AggregationRule = in.AggregationRule
 
 	return ret
 }
@@ -106,6 +107,7 @@ func ToClusterRole(in *Role) *ClusterRole {
 	ret := &ClusterRole{}
 	ret.ObjectMeta = in.ObjectMeta
 	ret.Rules = in.Rules
+	ret.AggregationRule = in.AggregationRule
 
 	return ret
 }
@@ -74,9 +74,9 @@ var (
 	GroupsToResources = map[string][]string{
 		BuildGroupName:       {"builds", "buildconfigs", "buildlogs", "buildconfigs/instantiate", "buildconfigs/instantiatebinary", "builds/log", "builds/clone", "buildconfigs/webhooks"},
 		ImageGroupName:       {"imagestreams", "imagestreammappings", "imagestreamtags", "imagestreamimages", "imagestreamimports"},
-		DeploymentGroupName:  {"deployments", "deploymentconfigs", "generatedeploymentconfigs", "deploymentconfigrollbacks", "deploymentconfigs/log", "deploymentconfigs/scale"},
+		DeploymentGroupName:  {"deployments", "deploymentconfigs", "generatedeploymentconfigs", "deploymentconfigrollbacks", "deploymentconfigs/instantiate", "deploymentconfigs/log", "deploymentconfigs/scale"},
 		SDNGroupName:         {"clusternetworks", "hostsubnets", "netnamespaces"},
-		TemplateGroupName:    {"templates", "templateconfigs", "processedtemplates"},
+		TemplateGroupName:    {"templates", "templateconfigs", "processedtemplates", "templateinstances"},
 		UserGroupName:        {"identities", "users", "useridentitymappings", "groups"},
 		OAuthGroupName:       {"oauthauthorizetokens", "oauthaccesstokens", "oauthclients", "oauthclientauthorizations"},
 		PolicyOwnerGroupName: {"policies", "policybindings"},
@@ -86,7 +86,7 @@ var (
 		PermissionGrantingGroupName: {"roles", "rolebindings", "resourceaccessreviews" /* cluster scoped*/, "subjectaccessreviews" /* cluster scoped*/, "localresourceaccessreviews", "localsubjectaccessreviews"},
 		OpenshiftExposedGroupName:   {BuildGroupName, ImageGroupName, DeploymentGroupName, TemplateGroupName, "routes"},
 		OpenshiftAllGroupName: {OpenshiftExposedGroupName, UserGroupName, OAuthGroupName, PolicyOwnerGroupName, SDNGroupName, PermissionGrantingGroupName, OpenshiftStatusGroupName, "projects",
-			"clusterroles", "clusterrolebindings", "clusterpolicies", "clusterpolicybindings", "images" /* cluster scoped*/, "projectrequests", "builds/details", "imagestreams/secrets"},
+			"clusterroles", "clusterrolebindings", "clusterpolicies", "clusterpolicybindings", "images" /* cluster scoped*/, "projectrequests", "builds/details", "imagestreams/secrets", "templaterepositories" /* sources retrieved by the master */},
 		OpenshiftStatusGroupName: {"imagestreams/status", "routes/status"},
 
 		QuotaGroupName:         {"limitranges", "resourcequotas", "resourcequotausages"},
@@ -146,6 +146,10 @@ type Role struct {
 
 	// Rules holds all the PolicyRules for this Role
 	Rules []PolicyRule
+
+	// AggregationRule is only set on cluster roles.  It is carried by roles because cluster roles are stored
+	// and authorized as roles.
+	AggregationRule *AggregationRule
 }
 
 // RoleBinding references a Role, but not contain it.  It can reference any Role in the same namespace or in the global namespace.
@@ -203,6 +207,22 @@ type ResourceAccessReviewResponse struct {
 	Users sets.String
 	// Groups is the list of groups who can perform the action
 	Groups sets.String
+	// Grants is the list of role bindings through which the users and groups can perform the action
+	Grants []ResourceAccessGrant
+}
+
+// ResourceAccessGrant describes a role binding that allows subjects to perform an action
+type ResourceAccessGrant struct {
+	// Namespace is the namespace of the role binding, empty for cluster role bindings
+	Namespace string
+	// RoleBinding is the name of the role binding
+	RoleBinding string
+	// RoleRef references the role whose rules allow the action
+	RoleRef kapi.ObjectReference
+	// Users is the list of users bound to the role
+	Users []string
+	// Groups is the list of groups bound to the role
+	Groups []string
 }
 
 // ResourceAccessReview is a means to request a list of which users and groups are authorized to perform the
@@ -274,6 +294,10 @@ type AuthorizationAttributes struct {
 	ResourceName string
 	// Content is the actual content of the request for create and update
 	Content kruntime.Object
+	// IsNonResourceURL is true if this is a request for a non-resource URL (outside of the resource hierarchy)
+	IsNonResourceURL bool
+	// Path is the path of a non resource URL
+	Path string
 }
 
 // PolicyList is a collection of Policies
@@ -324,6 +348,20 @@ type ClusterRole struct {
 
 	// Rules holds all the PolicyRules for this ClusterRole
 	Rules []PolicyRule
+
+	// AggregationRule is an optional field that describes how to build the Rules for this ClusterRole.
+	// If AggregationRule is set, then the Rules are controller managed and direct changes to Rules will be
+	// stomped by the controller.  Setting or changing it requires holding the aggregated rules, or the escalate
+	// verb on clusterroles.
+	AggregationRule *AggregationRule
+}
+
+// AggregationRule describes how to locate ClusterRoles to aggregate into the ClusterRole
+type AggregationRule struct {
+	// ClusterRoleSelectors holds a list of selectors which will be used to find ClusterRoles and create the rules.
+	// If any of the selectors match, then the ClusterRole's permissions will be added
+	// Only matchLabels are supported.
+	ClusterRoleSelectors []unversioned.LabelSelector
 }
 
 // ClusterRoleBinding references a ClusterRole, but not contain it.  It can reference any ClusterRole in the same namespace or in the global namespace.
@@ -5,6 +5,15 @@ package v1
 // by hack/update-generated-swagger-descriptions.sh and should be run after a full build of OpenShift.
 // ==== DO NOT EDIT THIS FILE MANUALLY ====
 
+var map_AggregationRule = map[string]string{
+	"":                     "AggregationRule describes how to locate ClusterRoles to aggregate into the ClusterRole",
+	"clusterRoleSelectors": "ClusterRoleSelectors holds a list of selectors which will be used to find ClusterRoles and create the rules. If any of the selectors match, then the ClusterRole's permissions will be added Only matchLabels are supported.",
+}
+
+func (AggregationRule) SwaggerDoc() map[string]string {
+	return map_AggregationRule
+}
+
 var map_AuthorizationAttributes = map[string]string{
 	"":                   "AuthorizationAttributes describes a request to the API server",
 	"namespace":          "Namespace is the namespace of the action being requested.  Currently, there is no distinction between no namespace and all namespaces",
@@ -14,6 +23,8 @@ var map_AuthorizationAttributes = map[string]string{
 	"resource":           "Resource is one of the existing resource types",
 	"resourceName":       "ResourceName is the name of the resource being requested for a \"get\" or deleted for a \"delete\"",
 	"content":            "Content is the actual content of the request for create and update",
+	"isNonResourceURL":   "IsNonResourceURL is true if this is a request for a non-resource URL (outside of the resource hierarchy)",
+	"path":               "Path is the path of a non resource URL",
 }
 
 func (AuthorizationAttributes) SwaggerDoc() map[string]string {
@@ -64,9 +75,10 @@ func (ClusterPolicyList) SwaggerDoc() map[string]string {
 }
 
 var map_ClusterRole = map[string]string{
-	"":         "ClusterRole is a logical grouping of PolicyRules that can be referenced as a unit by ClusterRoleBindings.",
-	"metadata": "Standard object's metadata.",
-	"rules":    "Rules holds all the PolicyRules for this ClusterRole",
+	"":                "ClusterRole is a logical grouping of PolicyRules that can be referenced as a unit by ClusterRoleBindings.",
+	"metadata":        "Standard object's metadata.",
+	"rules":           "Rules holds all the PolicyRules for this ClusterRole",
+	"aggregationRule": "AggregationRule is an optional field that describes how to build the Rules for this ClusterRole. If AggregationRule is set, then the Rules are controller managed and direct changes to Rules will be stomped by the controller.  Setting or changing it requires holding the aggregated rules, or the escalate verb on clusterroles.",
 }
 
 func (ClusterRole) SwaggerDoc() map[string]string {
@@ -237,11 +249,25 @@ func (ResourceAccessReview) SwaggerDoc() map[string]string {
 	return map_ResourceAccessReview
 }
 
+var map_ResourceAccessGrant = map[string]string{
+	"":            "ResourceAccessGrant describes a role binding that allows subjects to perform an action",
+	"namespace":   "Namespace is the namespace of the role binding, empty for cluster role bindings",
+	"roleBinding": "RoleBinding is the name of the role binding",
+	"roleRef":     "RoleRef references the role whose rules allow the action",
+	"users":       "Users is the list of users bound to the role",
+	"groups":      "Groups is the list of groups bound to the role",
+}
+
+func (ResourceAccessGrant) SwaggerDoc() map[string]string {
+	return map_ResourceAccessGrant
+}
+
 var map_ResourceAccessReviewResponse = map[string]string{
 	"":          "ResourceAccessReviewResponse describes who can perform the action",
 	"namespace": "Namespace is the namespace used for the access review",
 	"users":     "UsersSlice is the list of users who can perform the action",
 	"groups":    "GroupsSlice is the list of groups who can perform the action",
+	"grants":    "Grants is the list of role bindings through which the users and groups can perform the action",
 }
 
 func (ResourceAccessReviewResponse) SwaggerDoc() map[string]string {
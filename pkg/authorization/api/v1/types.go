@@ -126,6 +126,22 @@ type ResourceAccessReviewResponse struct {
 	UsersSlice []string `json:"users"`
 	// GroupsSlice is the list of groups who can perform the action
 	GroupsSlice []string `json:"groups"`
+	// Grants is the list of role bindings through which the users and groups can perform the action
+	Grants []ResourceAccessGrant `json:"grants,omitempty"`
+}
+
+// ResourceAccessGrant describes a role binding that allows subjects to perform an action
+type ResourceAccessGrant struct {
+	// Namespace is the namespace of the role binding, empty for cluster role bindings
+	Namespace string `json:"namespace,omitempty"`
+	// RoleBinding is the name of the role binding
+	RoleBinding string `json:"roleBinding"`
+	// RoleRef references the role whose rules allow the action
+	RoleRef kapi.ObjectReference `json:"roleRef"`
+	// Users is the list of users bound to the role
+	Users []string `json:"users"`
+	// Groups is the list of groups bound to the role
+	Groups []string `json:"groups"`
 }
 
 // ResourceAccessReview is a means to request a list of which users and groups are authorized to perform the
@@ -199,6 +215,10 @@ type AuthorizationAttributes struct {
 	ResourceName string `json:"resourceName"`
 	// Content is the actual content of the request for create and update
 	Content kruntime.RawExtension `json:"content,omitempty"`
+	// IsNonResourceURL is true if this is a request for a non-resource URL (outside of the resource hierarchy)
+	IsNonResourceURL bool `json:"isNonResourceURL"`
+	// Path is the path of a non resource URL
+	Path string `json:"path"`
 }
 
 // PolicyList is a collection of Policies
@@ -249,6 +269,20 @@ type ClusterRole struct {
 
 	// Rules holds all the PolicyRules for this ClusterRole
 	Rules []PolicyRule `json:"rules"`
+
+	// AggregationRule is an optional field that describes how to build the Rules for this ClusterRole.
+	// If AggregationRule is set, then the Rules are controller managed and direct changes to Rules will be
+	// stomped by the controller.  Setting or changing it requires holding the aggregated rules, or the escalate
+	// verb on clusterroles.
+	AggregationRule *AggregationRule `json:"aggregationRule,omitempty"`
+}
+
+// AggregationRule describes how to locate ClusterRoles to aggregate into the ClusterRole
+type AggregationRule struct {
+	// ClusterRoleSelectors holds a list of selectors which will be used to find ClusterRoles and create the rules.
+	// If any of the selectors match, then the ClusterRole's permissions will be added
+	// Only matchLabels are supported.
+	ClusterRoleSelectors []unversioned.LabelSelector `json:"clusterRoleSelectors"`
 }
 
 // ClusterRoleBinding references a ClusterRole, but not contain it.  It can reference any ClusterRole in the same namespace or in the global namespace.
@@ -119,6 +119,22 @@ type ResourceAccessReviewResponse struct {
 	UsersSlice []string `json:"users"`
 	// Groups is the list of groups who can perform the action
 	GroupsSlice []string `json:"groups"`
+	// Grants is the list of role bindings through which the users and groups can perform the action
+	Grants []ResourceAccessGrant `json:"grants,omitempty"`
+}
+
+// ResourceAccessGrant describes a role binding that allows subjects to perform an action
+type ResourceAccessGrant struct {
+	// Namespace is the namespace of the role binding, empty for cluster role bindings
+	Namespace string `json:"namespace,omitempty"`
+	// RoleBinding is the name of the role binding
+	RoleBinding string `json:"roleBinding"`
+	// RoleRef references the role whose rules allow the action
+	RoleRef kapi.ObjectReference `json:"roleRef"`
+	// Users is the list of users bound to the role
+	Users []string `json:"users"`
+	// Groups is the list of groups bound to the role
+	Groups []string `json:"groups"`
 }
 
 // ResourceAccessReview is a means to request a list of which users and groups are authorized to perform the
@@ -191,6 +207,10 @@ type AuthorizationAttributes struct {
 	ResourceName string `json:"resourceName"`
 	// Content is the actual content of the request for create and update
 	Content kruntime.RawExtension `json:"content,omitempty"`
+	// IsNonResourceURL is true if this is a request for a non-resource URL (outside of the resource hierarchy)
+	IsNonResourceURL bool `json:"isNonResourceURL"`
+	// Path is the path of a non resource URL
+	Path string `json:"path"`
 }
 
 // PolicyList is a collection of Policies
@@ -14,51 +14,35 @@ import (
 )
 
 func ValidateSubjectAccessReview(review *authorizationapi.SubjectAccessReview) field.ErrorList {
-	allErrs := field.ErrorList{}
-
-	if len(review.Action.Verb) == 0 {
-		allErrs = append(allErrs, field.Required(field.NewPath("verb"), ""))
-	}
-	if len(review.Action.Resource) == 0 {
-		allErrs = append(allErrs, field.Required(field.NewPath("resource"), ""))
-	}
-
-	return allErrs
+	return validateAuthorizationAttributes(review.Action)
 }
 
 func ValidateResourceAccessReview(review *authorizationapi.ResourceAccessReview) field.ErrorList {
-	allErrs := field.ErrorList{}
-
-	if len(review.Action.Verb) == 0 {
-		allErrs = append(allErrs, field.Required(field.NewPath("verb"), ""))
-	}
-	if len(review.Action.Resource) == 0 {
-		allErrs = append(allErrs, field.Required(field.NewPath("resource"), ""))
-	}
-
-	return allErrs
+	return validateAuthorizationAttributes(review.Action)
 }
 
 func ValidateLocalSubjectAccessReview(review *authorizationapi.LocalSubjectAccessReview) field.ErrorList {
-	allErrs := field.ErrorList{}
-
-	if len(review.Action.Verb) == 0 {
-		allErrs = append(allErrs, field.Required(field.NewPath("verb"), ""))
-	}
-	if len(review.Action.Resource) == 0 {
-		allErrs = append(allErrs, field.Required(field.NewPath("resource"), ""))
-	}
-
-	return allErrs
+	return validateAuthorizationAttributes(review.Action)
 }
 
 func ValidateLocalResourceAccessReview(review *authorizationapi.LocalResourceAccessReview) field.ErrorList {
+	return validateAuthorizationAttributes(review.Action)
+}
+
+func validateAuthorizationAttributes(attributes authorizationapi.AuthorizationAttributes) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	if len(review.Action.Verb) == 0 {
+	if len(attributes.Verb) == 0 {
 		allErrs = append(allErrs, field.Required(field.NewPath("verb"), ""))
 	}
-	if len(review.Action.Resource) == 0 {
+	if attributes.IsNonResourceURL {
+		if len(attributes.Path) == 0 {
+			allErrs = append(allErrs, field.Required(field.NewPath("path"), "required for non-resource URLs"))
+		}
+		if len(attributes.Resource) != 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("resource"), attributes.Resource, "must be empty for non-resource URLs"))
+		}
+	} else if len(attributes.Resource) == 0 {
 		allErrs = append(allErrs, field.Required(field.NewPath("resource"), ""))
 	}
 
@@ -212,7 +196,42 @@ func ValidateRole(role *authorizationapi.Role, isNamespaced bool) field.ErrorLis
 }
 
 func validateRole(role *authorizationapi.Role, isNamespaced bool, fldPath *field.Path) field.ErrorList {
-	return validation.ValidateObjectMeta(&role.ObjectMeta, isNamespaced, oapi.MinimalNameRequirements, fldPath.Child("metadata"))
+	allErrs := validation.ValidateObjectMeta(&role.ObjectMeta, isNamespaced, oapi.MinimalNameRequirements, fldPath.Child("metadata"))
+
+	if role.AggregationRule != nil {
+		aggregationPath := fldPath.Child("aggregationRule")
+		if isNamespaced {
+			allErrs = append(allErrs, field.Forbidden(aggregationPath, "only cluster roles can aggregate rules"))
+		} else {
+			allErrs = append(allErrs, validateAggregationRule(role.AggregationRule, aggregationPath)...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateAggregationRule(rule *authorizationapi.AggregationRule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	selectorsPath := fldPath.Child("clusterRoleSelectors")
+	if len(rule.ClusterRoleSelectors) == 0 {
+		allErrs = append(allErrs, field.Required(selectorsPath, ""))
+	}
+	for i := range rule.ClusterRoleSelectors {
+		selector := &rule.ClusterRoleSelectors[i]
+		selectorPath := selectorsPath.Index(i)
+		// only exact labels are supported, so the roles granting their rules to others are clearly marked
+		if len(selector.MatchExpressions) > 0 {
+			allErrs = append(allErrs, field.Forbidden(selectorPath.Child("matchExpressions"), "only matchLabels are supported"))
+		}
+		// an empty selector would aggregate every cluster role, including the aggregating one
+		if len(selector.MatchLabels) == 0 {
+			allErrs = append(allErrs, field.Required(selectorPath.Child("matchLabels"), "must select cluster roles by label"))
+		}
+		allErrs = append(allErrs, validation.ValidateLabels(selector.MatchLabels, selectorPath.Child("matchLabels"))...)
+	}
+
+	return allErrs
 }
 
 func ValidateRoleUpdate(role *authorizationapi.Role, oldRole *authorizationapi.Role, isNamespaced bool) field.ErrorList {
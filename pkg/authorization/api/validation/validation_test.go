@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
@@ -388,6 +389,79 @@ func TestValidateRole(t *testing.T) {
 	}
 }
 
+func TestValidateClusterRoleAggregationRule(t *testing.T) {
+	aggregationRule := &authorizationapi.AggregationRule{
+		ClusterRoleSelectors: []unversioned.LabelSelector{{MatchLabels: map[string]string{"aggregate-to-view": "true"}}},
+	}
+	errs := ValidateClusterRole(
+		&authorizationapi.ClusterRole{
+			ObjectMeta:      kapi.ObjectMeta{Name: "view"},
+			AggregationRule: aggregationRule,
+		},
+	)
+	if len(errs) != 0 {
+		t.Errorf("expected success: %v", errs)
+	}
+
+	errs = ValidateRole(
+		&authorizationapi.Role{
+			ObjectMeta:      kapi.ObjectMeta{Namespace: kapi.NamespaceDefault, Name: "view"},
+			AggregationRule: aggregationRule,
+		},
+		true,
+	)
+	if len(errs) != 1 || errs[0].Type != field.ErrorTypeForbidden || errs[0].Field != "aggregationRule" {
+		t.Errorf("expected a forbidden aggregation rule, got %v", errs)
+	}
+
+	errorCases := map[string]struct {
+		A authorizationapi.AggregationRule
+		T field.ErrorType
+		F string
+	}{
+		"no selectors": {
+			A: authorizationapi.AggregationRule{},
+			T: field.ErrorTypeRequired,
+			F: "aggregationRule.clusterRoleSelectors",
+		},
+		"empty selector": {
+			A: authorizationapi.AggregationRule{ClusterRoleSelectors: []unversioned.LabelSelector{{}}},
+			T: field.ErrorTypeRequired,
+			F: "aggregationRule.clusterRoleSelectors[0].matchLabels",
+		},
+		"match expressions": {
+			A: authorizationapi.AggregationRule{ClusterRoleSelectors: []unversioned.LabelSelector{{
+				MatchLabels:      map[string]string{"aggregate-to-view": "true"},
+				MatchExpressions: []unversioned.LabelSelectorRequirement{{Key: "aggregate-to-edit", Operator: unversioned.LabelSelectorOpExists}},
+			}}},
+			T: field.ErrorTypeForbidden,
+			F: "aggregationRule.clusterRoleSelectors[0].matchExpressions",
+		},
+		"invalid label": {
+			A: authorizationapi.AggregationRule{ClusterRoleSelectors: []unversioned.LabelSelector{{
+				MatchLabels: map[string]string{"aggregate-to-view": "not a label value"},
+			}}},
+			T: field.ErrorTypeInvalid,
+			F: "aggregationRule.clusterRoleSelectors[0].matchLabels",
+		},
+	}
+	for k, v := range errorCases {
+		errs := ValidateClusterRole(&authorizationapi.ClusterRole{ObjectMeta: kapi.ObjectMeta{Name: "view"}, AggregationRule: &v.A})
+		if len(errs) == 0 {
+			t.Errorf("expected failure %s for %v", k, v.A)
+			continue
+		}
+		for i := range errs {
+			if errs[i].Type != v.T {
+				t.Errorf("%s: expected errors to have type %s: %v", k, v.T, errs[i])
+			}
+			if errs[i].Field != v.F {
+				t.Errorf("%s: expected errors to have field %s: %v", k, v.F, errs[i])
+			}
+		}
+	}
+}
+
 func TestValidateClusterPolicyBinding(t *testing.T) {
 	errorCases := map[string]struct {
 		A authorizationapi.PolicyBinding
@@ -6,6 +6,7 @@ import (
 	kerrors "k8s.io/kubernetes/pkg/util/errors"
 	"k8s.io/kubernetes/pkg/util/sets"
 
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
 	"github.com/openshift/origin/pkg/authorization/rulevalidation"
 )
 
@@ -64,41 +65,52 @@ func (a *openshiftAuthorizer) Authorize(ctx kapi.Context, passedAttributes Autho
 // This is done because policy rules are purely additive and policy determinations
 // can be made on the basis of those rules that are found.
 func (a *openshiftAuthorizer) GetAllowedSubjects(ctx kapi.Context, attributes AuthorizationAttributes) (sets.String, sets.String, error) {
+	grants, err := a.GetAllowedSubjectGrants(ctx, attributes)
+
+	users := sets.String{}
+	groups := sets.String{}
+	for _, grant := range grants {
+		users.Insert(grant.Users...)
+		groups.Insert(grant.Groups...)
+	}
+
+	return users, groups, err
+}
+
+// GetAllowedSubjectGrants returns the cluster role bindings and the role bindings of the namespace it knows allow
+// their subjects to perform the action.  Like for GetAllowedSubjects, the list may be incomplete if we got an error.
+func (a *openshiftAuthorizer) GetAllowedSubjectGrants(ctx kapi.Context, attributes AuthorizationAttributes) ([]authorizationapi.ResourceAccessGrant, error) {
 	errs := []error{}
 
 	masterContext := kapi.WithNamespace(ctx, kapi.NamespaceNone)
-	globalUsers, globalGroups, err := a.getAllowedSubjectsFromNamespaceBindings(masterContext, attributes)
-	if err != nil {
-		errs = append(errs, err)
-	}
-	localUsers, localGroups, err := a.getAllowedSubjectsFromNamespaceBindings(ctx, attributes)
+	globalGrants, err := a.getGrantsFromNamespaceBindings(masterContext, attributes)
 	if err != nil {
 		errs = append(errs, err)
 	}
+	grants := globalGrants
 
-	users := sets.String{}
-	users.Insert(globalUsers.List()...)
-	users.Insert(localUsers.List()...)
-
-	groups := sets.String{}
-	groups.Insert(globalGroups.List()...)
-	groups.Insert(localGroups.List()...)
+	if namespace := kapi.NamespaceValue(ctx); len(namespace) != 0 {
+		localGrants, err := a.getGrantsFromNamespaceBindings(ctx, attributes)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		grants = append(grants, localGrants...)
+	}
 
-	return users, groups, kerrors.NewAggregate(errs)
+	return grants, kerrors.NewAggregate(errs)
 }
 
-func (a *openshiftAuthorizer) getAllowedSubjectsFromNamespaceBindings(ctx kapi.Context, passedAttributes AuthorizationAttributes) (sets.String, sets.String, error) {
+func (a *openshiftAuthorizer) getGrantsFromNamespaceBindings(ctx kapi.Context, passedAttributes AuthorizationAttributes) ([]authorizationapi.ResourceAccessGrant, error) {
 	attributes := coerceToDefaultAuthorizationAttributes(passedAttributes)
 
 	errs := []error{}
 
 	roleBindings, err := a.ruleResolver.GetRoleBindings(ctx)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	users := sets.String{}
-	groups := sets.String{}
+	grants := []authorizationapi.ResourceAccessGrant{}
 	for _, roleBinding := range roleBindings {
 		role, err := a.ruleResolver.GetRole(roleBinding)
 		if err != nil {
@@ -117,13 +129,19 @@ func (a *openshiftAuthorizer) getAllowedSubjectsFromNamespaceBindings(ctx kapi.C
 			}
 
 			if matches {
-				users.Insert(roleBinding.Users().List()...)
-				groups.Insert(roleBinding.Groups().List()...)
+				grants = append(grants, authorizationapi.ResourceAccessGrant{
+					Namespace:   roleBinding.Namespace(),
+					RoleBinding: roleBinding.Name(),
+					RoleRef:     roleBinding.RoleRef(),
+					Users:       roleBinding.Users().List(),
+					Groups:      roleBinding.Groups().List(),
+				})
+				break
 			}
 		}
 	}
 
-	return users, groups, kerrors.NewAggregate(errs)
+	return grants, kerrors.NewAggregate(errs)
 }
 
 // authorizeWithNamespaceRules returns isAllowed, reason, and error.  If an error is returned, isAllowed and reason are still valid.  This seems strange
@@ -6,6 +6,8 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/auth/user"
 	"k8s.io/kubernetes/pkg/util/sets"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
 )
 
 type Authorizer interface {
@@ -13,6 +15,11 @@ type Authorizer interface {
 	GetAllowedSubjects(ctx kapi.Context, attributes AuthorizationAttributes) (sets.String, sets.String, error)
 }
 
+// GrantLister is implemented by authorizers that can report which role bindings allow their subjects to perform an action
+type GrantLister interface {
+	GetAllowedSubjectGrants(ctx kapi.Context, attributes AuthorizationAttributes) ([]authorizationapi.ResourceAccessGrant, error)
+}
+
 type AuthorizationAttributeBuilder interface {
 	GetAttributes(request *http.Request) (AuthorizationAttributes, error)
 }
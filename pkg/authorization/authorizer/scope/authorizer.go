@@ -0,0 +1,90 @@
+package scope
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/authorization/authorizer"
+	"github.com/openshift/origin/pkg/authorization/rulevalidation"
+)
+
+type scopeAuthorizer struct {
+	delegate            authorizer.Authorizer
+	clusterPolicyGetter rulevalidation.ClusterPolicyGetter
+}
+
+// NewAuthorizer returns an authorizer denying the actions of scoped users that their scopes do not cover. The other
+// actions are authorized by delegate, scopes can only restrict the permissions of users.
+func NewAuthorizer(delegate authorizer.Authorizer, clusterPolicyGetter rulevalidation.ClusterPolicyGetter) authorizer.Authorizer {
+	return &scopeAuthorizer{delegate: delegate, clusterPolicyGetter: clusterPolicyGetter}
+}
+
+func (a *scopeAuthorizer) Authorize(ctx kapi.Context, attributes authorizer.AuthorizationAttributes) (bool, string, error) {
+	user, exists := kapi.UserFrom(ctx)
+	if !exists {
+		return a.delegate.Authorize(ctx, attributes)
+	}
+	scopes := authapi.ScopesFor(user)
+	if IsUnrestricted(scopes) {
+		return a.delegate.Authorize(ctx, attributes)
+	}
+
+	namespace := kapi.NamespaceValue(ctx)
+	rules, escalating, err := ScopesToRules(ctx, scopes, namespace, a.clusterPolicyGetter)
+
+	// rules can be found in spite of errors, just like the rules of the role bindings of users
+	allowedByScopes := false
+	if escalating || attributes.IsNonResourceURL() || !IsEscalatingResource(attributes.GetResource()) {
+		for _, rule := range rules {
+			matches, matchErr := coerceAttributes(attributes).RuleMatches(rule)
+			if matchErr != nil {
+				return false, "", matchErr
+			}
+			if matches {
+				allowedByScopes = true
+				break
+			}
+		}
+	}
+	if !allowedByScopes {
+		if err != nil {
+			return false, "", err
+		}
+		return false, fmt.Sprintf("scopes %v prevent this action", scopes), nil
+	}
+
+	return a.delegate.Authorize(ctx, attributes)
+}
+
+// GetAllowedSubjects returns the subjects allowed by the delegate, scopes do not apply to them.
+func (a *scopeAuthorizer) GetAllowedSubjects(ctx kapi.Context, attributes authorizer.AuthorizationAttributes) (sets.String, sets.String, error) {
+	return a.delegate.GetAllowedSubjects(ctx, attributes)
+}
+
+// GetAllowedSubjectGrants returns the grants listed by the delegate, scopes do not apply to them.
+func (a *scopeAuthorizer) GetAllowedSubjectGrants(ctx kapi.Context, attributes authorizer.AuthorizationAttributes) ([]authorizationapi.ResourceAccessGrant, error) {
+	grantLister, ok := a.delegate.(authorizer.GrantLister)
+	if !ok {
+		return nil, fmt.Errorf("the authorizer cannot list the role bindings granting access")
+	}
+	return grantLister.GetAllowedSubjectGrants(ctx, attributes)
+}
+
+func coerceAttributes(attributes authorizer.AuthorizationAttributes) *authorizer.DefaultAuthorizationAttributes {
+	if defaultAttributes, ok := attributes.(*authorizer.DefaultAuthorizationAttributes); ok {
+		return defaultAttributes
+	}
+	return &authorizer.DefaultAuthorizationAttributes{
+		APIGroup:          attributes.GetAPIGroup(),
+		Verb:              attributes.GetVerb(),
+		RequestAttributes: attributes.GetRequestAttributes(),
+		Resource:          attributes.GetResource(),
+		ResourceName:      attributes.GetResourceName(),
+		NonResourceURL:    attributes.IsNonResourceURL(),
+		URL:               attributes.GetURL(),
+	}
+}
@@ -0,0 +1,115 @@
+package scope
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	"github.com/openshift/origin/pkg/authorization/authorizer"
+)
+
+type allowAllAuthorizer struct {
+	called bool
+}
+
+func (a *allowAllAuthorizer) Authorize(ctx kapi.Context, attributes authorizer.AuthorizationAttributes) (bool, string, error) {
+	a.called = true
+	return true, "", nil
+}
+
+func (a *allowAllAuthorizer) GetAllowedSubjects(ctx kapi.Context, attributes authorizer.AuthorizationAttributes) (sets.String, sets.String, error) {
+	return sets.NewString(), sets.NewString(), nil
+}
+
+func TestAuthorize(t *testing.T) {
+	testCases := map[string]struct {
+		User       user.Info
+		Namespace  string
+		Attributes *authorizer.DefaultAuthorizationAttributes
+
+		ExpectDelegated bool
+	}{
+		"unscoped user": {
+			User:            &user.DefaultInfo{Name: "bob"},
+			Namespace:       "ns",
+			Attributes:      &authorizer.DefaultAuthorizationAttributes{Verb: "delete", Resource: "pods"},
+			ExpectDelegated: true,
+		},
+		"user full": {
+			User:            &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{UserFull}},
+			Namespace:       "ns",
+			Attributes:      &authorizer.DefaultAuthorizationAttributes{Verb: "get", Resource: "secrets"},
+			ExpectDelegated: true,
+		},
+		"user info": {
+			User:            &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{UserInfo}},
+			Attributes:      &authorizer.DefaultAuthorizationAttributes{Verb: "get", Resource: "users", ResourceName: "~"},
+			ExpectDelegated: true,
+		},
+		"user info on other users": {
+			User:       &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{UserInfo}},
+			Attributes: &authorizer.DefaultAuthorizationAttributes{Verb: "get", Resource: "users", ResourceName: "alice"},
+		},
+		"discovery": {
+			User:            &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{UserInfo}},
+			Attributes:      &authorizer.DefaultAuthorizationAttributes{Verb: "get", NonResourceURL: true, URL: "/oapi"},
+			ExpectDelegated: true,
+		},
+		"role in namespace": {
+			User:            &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{"role:view:ns"}},
+			Namespace:       "ns",
+			Attributes:      &authorizer.DefaultAuthorizationAttributes{Verb: "list", Resource: "pods"},
+			ExpectDelegated: true,
+		},
+		"role in other namespace": {
+			User:       &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{"role:view:ns"}},
+			Namespace:  "other",
+			Attributes: &authorizer.DefaultAuthorizationAttributes{Verb: "list", Resource: "pods"},
+		},
+		"verb outside of role": {
+			User:       &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{"role:view:ns"}},
+			Namespace:  "ns",
+			Attributes: &authorizer.DefaultAuthorizationAttributes{Verb: "delete", Resource: "pods"},
+		},
+		"escalating resource": {
+			User:       &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{"role:view:ns"}},
+			Namespace:  "ns",
+			Attributes: &authorizer.DefaultAuthorizationAttributes{Verb: "get", Resource: "secrets"},
+		},
+		"escalating resource with escalating role": {
+			User:            &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{"role:view:ns:!"}},
+			Namespace:       "ns",
+			Attributes:      &authorizer.DefaultAuthorizationAttributes{Verb: "get", Resource: "secrets"},
+			ExpectDelegated: true,
+		},
+		"permission granting resource": {
+			User:       &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{"role:admin:ns"}},
+			Namespace:  "ns",
+			Attributes: &authorizer.DefaultAuthorizationAttributes{Verb: "create", Resource: "rolebindings"},
+		},
+		"permission granting resource with escalating role": {
+			User:            &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: []string{"role:admin:ns:!"}},
+			Namespace:       "ns",
+			Attributes:      &authorizer.DefaultAuthorizationAttributes{Verb: "create", Resource: "rolebindings"},
+			ExpectDelegated: true,
+		},
+	}
+
+	for k, tc := range testCases {
+		delegate := &allowAllAuthorizer{}
+		a := NewAuthorizer(delegate, newTestClusterPolicyGetter())
+		ctx := kapi.WithUser(kapi.WithNamespace(kapi.NewContext(), tc.Namespace), tc.User)
+
+		allowed, _, err := a.Authorize(ctx, tc.Attributes)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+		if allowed != tc.ExpectDelegated || delegate.called != tc.ExpectDelegated {
+			t.Errorf("%s: expected delegation %v, got allowed=%v called=%v", k, tc.ExpectDelegated, allowed, delegate.called)
+		}
+	}
+}
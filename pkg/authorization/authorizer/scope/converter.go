@@ -0,0 +1,168 @@
+package scope
+
+import (
+	"fmt"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/util/errors"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/authorization/rulevalidation"
+)
+
+const (
+	// UserIndicator is the prefix of the scopes granting access to information about the user
+	UserIndicator = "user:"
+	// ClusterRoleIndicator is the prefix of the scopes granting the permissions of a cluster role
+	ClusterRoleIndicator = "role:"
+
+	// UserInfo grants read access to the user (~), which includes the names of its groups
+	UserInfo = UserIndicator + "info"
+	// UserAccessCheck grants access to subject access reviews about the user
+	UserAccessCheck = UserIndicator + "check-access"
+	// UserListProject grants access to the list of projects of the user
+	UserListProject = UserIndicator + "list-projects"
+	// UserFull grants all the permissions of the user, it is the scope of tokens requested without scopes
+	UserFull = UserIndicator + "full"
+
+	// ClusterRoleEscalation is the suffix of the role scopes granting access to escalating resources
+	ClusterRoleEscalation = "!"
+	// AllNamespaces can be used as namespace of role scopes to grant the permissions of the role in all namespaces
+	AllNamespaces = "*"
+)
+
+// discoveryRule lets scoped tokens discover the API, as every user can
+var discoveryRule = authorizationapi.PolicyRule{
+	Verbs:           sets.NewString("get"),
+	NonResourceURLs: sets.NewString("/version", "/api", "/api/*", "/apis", "/apis/*", "/oapi", "/oapi/*", "/osapi", "/osapi/"),
+}
+
+// userScopeRules are the rules granted by user scopes
+var userScopeRules = map[string][]authorizationapi.PolicyRule{
+	UserInfo: {
+		{Verbs: sets.NewString("get"), Resources: sets.NewString("users"), ResourceNames: sets.NewString("~")},
+	},
+	UserAccessCheck: {
+		{Verbs: sets.NewString("create"), Resources: sets.NewString("subjectaccessreviews", "localsubjectaccessreviews"), AttributeRestrictions: &authorizationapi.IsPersonalSubjectAccessReview{}},
+	},
+	UserListProject: {
+		{Verbs: sets.NewString("list", "watch"), Resources: sets.NewString("projects")},
+	},
+	UserFull: {
+		{Verbs: sets.NewString(authorizationapi.VerbAll), Resources: sets.NewString(authorizationapi.ResourceAll), NonResourceURLs: sets.NewString(authorizationapi.NonResourceAll)},
+	},
+}
+
+// escalatingResources are the resources that can be used to gain more permissions than those of the role that
+// grants access to them, either by reading credentials or by granting permissions. Role scopes only grant access
+// to them when they explicitly allow escalation.
+var escalatingResources = sets.NewString(
+	"secrets", "imagestreams/secrets", "oauthaccesstokens", "oauthauthorizetokens",
+	"roles", "rolebindings", "clusterroles", "clusterrolebindings", "policies", "policybindings", "clusterpolicies", "clusterpolicybindings",
+)
+
+// clusterRoleScope is a parsed role:<cluster role name>:<namespace>[:!] scope
+type clusterRoleScope struct {
+	RoleName   string
+	Namespace  string
+	Escalating bool
+}
+
+// parseClusterRoleScope parses a role scope, returning an error if scope is not a valid role scope
+func parseClusterRoleScope(scope string) (clusterRoleScope, error) {
+	if !strings.HasPrefix(scope, ClusterRoleIndicator) {
+		return clusterRoleScope{}, fmt.Errorf("%q is not a role scope", scope)
+	}
+	parts := strings.Split(strings.TrimPrefix(scope, ClusterRoleIndicator), ":")
+	switch {
+	case len(parts) == 2:
+	case len(parts) == 3 && parts[2] == ClusterRoleEscalation:
+	default:
+		return clusterRoleScope{}, fmt.Errorf("%q must be in the format %s<cluster role name>:<namespace or %s>[:%s]", scope, ClusterRoleIndicator, AllNamespaces, ClusterRoleEscalation)
+	}
+	if len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return clusterRoleScope{}, fmt.Errorf("%q must be in the format %s<cluster role name>:<namespace or %s>[:%s]", scope, ClusterRoleIndicator, AllNamespaces, ClusterRoleEscalation)
+	}
+	return clusterRoleScope{RoleName: parts[0], Namespace: parts[1], Escalating: len(parts) == 3}, nil
+}
+
+// appliesTo returns true if the scope grants permissions in namespace. Cluster level requests have an empty namespace,
+// only role scopes for all namespaces apply to them.
+func (s clusterRoleScope) appliesTo(namespace string) bool {
+	return s.Namespace == AllNamespaces || s.Namespace == namespace
+}
+
+// ValidateScopes returns an error for each invalid scope
+func ValidateScopes(scopes []string) error {
+	errs := []error{}
+	for _, scope := range scopes {
+		if _, ok := userScopeRules[scope]; ok {
+			continue
+		}
+		if _, err := parseClusterRoleScope(scope); err == nil {
+			continue
+		}
+		errs = append(errs, fmt.Errorf("%q is not a valid scope", scope))
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// IsUnrestricted returns true if scopes do not restrict the permissions of the user
+func IsUnrestricted(scopes []string) bool {
+	return len(scopes) == 0 || sets.NewString(scopes...).Has(UserFull)
+}
+
+// ScopesToRules returns the rules granted by scopes in namespace, and whether they grant access to escalating
+// resources. Errors are returned for invalid scopes and missing roles, the rules of the other scopes are still returned.
+func ScopesToRules(ctx kapi.Context, scopes []string, namespace string, clusterPolicyGetter rulevalidation.ClusterPolicyGetter) ([]authorizationapi.PolicyRule, bool, error) {
+	rules := []authorizationapi.PolicyRule{discoveryRule}
+	escalating := false
+	errs := []error{}
+
+	for _, scope := range scopes {
+		if userRules, ok := userScopeRules[scope]; ok {
+			rules = append(rules, userRules...)
+			if scope == UserFull {
+				escalating = true
+			}
+			continue
+		}
+
+		roleScope, err := parseClusterRoleScope(scope)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%q is not a valid scope", scope))
+			continue
+		}
+		if !roleScope.appliesTo(namespace) {
+			continue
+		}
+		roleRules, err := clusterRoleRules(ctx, roleScope.RoleName, clusterPolicyGetter)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		rules = append(rules, roleRules...)
+		escalating = escalating || roleScope.Escalating
+	}
+
+	return rules, escalating, kerrors.NewAggregate(errs)
+}
+
+// IsEscalatingResource returns true if access to resource can be used to gain more permissions
+func IsEscalatingResource(resource string) bool {
+	return escalatingResources.Has(strings.ToLower(resource))
+}
+
+func clusterRoleRules(ctx kapi.Context, roleName string, clusterPolicyGetter rulevalidation.ClusterPolicyGetter) ([]authorizationapi.PolicyRule, error) {
+	policy, err := clusterPolicyGetter.GetClusterPolicy(kapi.WithNamespace(ctx, kapi.NamespaceNone), authorizationapi.PolicyName)
+	if err != nil {
+		return nil, err
+	}
+	role, ok := policy.Roles[roleName]
+	if !ok {
+		return nil, fmt.Errorf("cluster role %q does not exist", roleName)
+	}
+	return role.Rules, nil
+}
@@ -0,0 +1,125 @@
+package scope
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+)
+
+type testClusterPolicyGetter struct {
+	policy *authorizationapi.ClusterPolicy
+}
+
+func (g *testClusterPolicyGetter) GetClusterPolicy(ctx kapi.Context, id string) (*authorizationapi.ClusterPolicy, error) {
+	return g.policy, nil
+}
+
+func newTestClusterPolicyGetter() *testClusterPolicyGetter {
+	return &testClusterPolicyGetter{
+		policy: &authorizationapi.ClusterPolicy{
+			ObjectMeta: kapi.ObjectMeta{Name: authorizationapi.PolicyName},
+			Roles: map[string]*authorizationapi.ClusterRole{
+				"view": {
+					ObjectMeta: kapi.ObjectMeta{Name: "view"},
+					Rules: []authorizationapi.PolicyRule{
+						{Verbs: sets.NewString("get", "list", "watch"), Resources: sets.NewString("pods", "secrets")},
+					},
+				},
+				"admin": {
+					ObjectMeta: kapi.ObjectMeta{Name: "admin"},
+					Rules: []authorizationapi.PolicyRule{
+						{Verbs: sets.NewString("create", "update"), Resources: sets.NewString("pods", "roles", "rolebindings")},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestValidateScopes(t *testing.T) {
+	testCases := map[string]struct {
+		Scopes      []string
+		ExpectError bool
+	}{
+		"user scopes":             {Scopes: []string{UserInfo, UserAccessCheck, UserListProject, UserFull}},
+		"role scopes":             {Scopes: []string{"role:view:ns", "role:view:*", "role:admin:ns:!"}},
+		"unknown user scope":      {Scopes: []string{"user:unknown"}, ExpectError: true},
+		"unknown scope":           {Scopes: []string{"unknown"}, ExpectError: true},
+		"role scope without ns":   {Scopes: []string{"role:view"}, ExpectError: true},
+		"role scope with suffix":  {Scopes: []string{"role:view:ns:?"}, ExpectError: true},
+		"role scope without name": {Scopes: []string{"role::ns"}, ExpectError: true},
+	}
+
+	for k, tc := range testCases {
+		err := ValidateScopes(tc.Scopes)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", k)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+		}
+	}
+}
+
+func TestScopesToRules(t *testing.T) {
+	testCases := map[string]struct {
+		Scopes    []string
+		Namespace string
+
+		ExpectedRules      int
+		ExpectedEscalating bool
+		ExpectError        bool
+	}{
+		"user info": {
+			Scopes:        []string{UserInfo},
+			Namespace:     "ns",
+			ExpectedRules: 2,
+		},
+		"role in namespace": {
+			Scopes:        []string{"role:view:ns"},
+			Namespace:     "ns",
+			ExpectedRules: 2,
+		},
+		"role in other namespace": {
+			Scopes:        []string{"role:view:other"},
+			Namespace:     "ns",
+			ExpectedRules: 1,
+		},
+		"role in all namespaces at the cluster level": {
+			Scopes:        []string{"role:view:*"},
+			Namespace:     "",
+			ExpectedRules: 2,
+		},
+		"escalating role": {
+			Scopes:             []string{"role:view:ns:!"},
+			Namespace:          "ns",
+			ExpectedRules:      2,
+			ExpectedEscalating: true,
+		},
+		"missing role": {
+			Scopes:        []string{UserInfo, "role:missing:ns"},
+			Namespace:     "ns",
+			ExpectedRules: 2,
+			ExpectError:   true,
+		},
+	}
+
+	for k, tc := range testCases {
+		rules, escalating, err := ScopesToRules(kapi.NewContext(), tc.Scopes, tc.Namespace, newTestClusterPolicyGetter())
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", k)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+		}
+		if len(rules) != tc.ExpectedRules {
+			t.Errorf("%s: expected %d rules, got %#v", k, tc.ExpectedRules, rules)
+		}
+		if escalating != tc.ExpectedEscalating {
+			t.Errorf("%s: expected escalating %v, got %v", k, tc.ExpectedEscalating, escalating)
+		}
+	}
+}
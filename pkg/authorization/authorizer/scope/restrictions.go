@@ -0,0 +1,54 @@
+package scope
+
+import (
+	"fmt"
+
+	kerrors "k8s.io/kubernetes/pkg/util/errors"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+)
+
+// ValidateScopeRestrictions returns an error for each scope that client is not allowed to request
+func ValidateScopeRestrictions(client *oauthapi.OAuthClient, scopes ...string) error {
+	if len(client.ScopeRestrictions) == 0 {
+		return nil
+	}
+
+	errs := []error{}
+	for _, scope := range scopes {
+		if !allowedByRestrictions(client.ScopeRestrictions, scope) {
+			errs = append(errs, fmt.Errorf("%q is not allowed for client %s", scope, client.Name))
+		}
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+func allowedByRestrictions(restrictions []oauthapi.ScopeRestriction, scope string) bool {
+	for _, restriction := range restrictions {
+		if sets.NewString(restriction.ExactValues...).Has(scope) {
+			return true
+		}
+		if restriction.ClusterRole != nil && allowedByClusterRoleRestriction(restriction.ClusterRole, scope) {
+			return true
+		}
+	}
+	return false
+}
+
+func allowedByClusterRoleRestriction(restriction *oauthapi.ClusterRoleScopeRestriction, scope string) bool {
+	roleScope, err := parseClusterRoleScope(scope)
+	if err != nil {
+		return false
+	}
+
+	roleNames := sets.NewString(restriction.RoleNames...)
+	if !roleNames.Has("*") && !roleNames.Has(roleScope.RoleName) {
+		return false
+	}
+	namespaces := sets.NewString(restriction.Namespaces...)
+	if !namespaces.Has("*") && !namespaces.Has(roleScope.Namespace) {
+		return false
+	}
+	return restriction.AllowEscalation || !roleScope.Escalating
+}
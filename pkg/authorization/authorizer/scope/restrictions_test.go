@@ -0,0 +1,73 @@
+package scope
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+)
+
+func TestValidateScopeRestrictions(t *testing.T) {
+	client := &oauthapi.OAuthClient{
+		ObjectMeta: kapi.ObjectMeta{Name: "client"},
+		ScopeRestrictions: []oauthapi.ScopeRestriction{
+			{ExactValues: []string{UserInfo}},
+			{ClusterRole: &oauthapi.ClusterRoleScopeRestriction{RoleNames: []string{"view"}, Namespaces: []string{"ns"}}},
+			{ClusterRole: &oauthapi.ClusterRoleScopeRestriction{RoleNames: []string{"*"}, Namespaces: []string{"escalating"}, AllowEscalation: true}},
+		},
+	}
+
+	testCases := map[string]struct {
+		Client      *oauthapi.OAuthClient
+		Scopes      []string
+		ExpectError bool
+	}{
+		"unrestricted client": {
+			Client: &oauthapi.OAuthClient{ObjectMeta: kapi.ObjectMeta{Name: "client"}},
+			Scopes: []string{UserFull},
+		},
+		"literal": {
+			Client: client,
+			Scopes: []string{UserInfo},
+		},
+		"role": {
+			Client: client,
+			Scopes: []string{UserInfo, "role:view:ns"},
+		},
+		"any escalating role": {
+			Client: client,
+			Scopes: []string{"role:admin:escalating:!"},
+		},
+		"missing literal": {
+			Client:      client,
+			Scopes:      []string{UserInfo, UserFull},
+			ExpectError: true,
+		},
+		"other role": {
+			Client:      client,
+			Scopes:      []string{"role:admin:ns"},
+			ExpectError: true,
+		},
+		"other namespace": {
+			Client:      client,
+			Scopes:      []string{"role:view:*"},
+			ExpectError: true,
+		},
+		"escalation not allowed": {
+			Client:      client,
+			Scopes:      []string{"role:view:ns:!"},
+			ExpectError: true,
+		},
+	}
+
+	for k, tc := range testCases {
+		err := ValidateScopeRestrictions(tc.Client, tc.Scopes...)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", k)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+		}
+	}
+}
@@ -58,4 +58,22 @@ func (test *subjectsTest) test(t *testing.T) {
 	matchStringSlice(test.expectedUsers.List(), actualUsers.List(), "users", t)
 	matchStringSlice(test.expectedGroups.List(), actualGroups.List(), "groups", t)
 	matchError(test.expectedError, actualError, "error", t)
+
+	grants, actualError := authorizer.(GrantLister).GetAllowedSubjectGrants(test.context, *test.attributes)
+	grantUsers := sets.String{}
+	grantGroups := sets.String{}
+	for _, grant := range grants {
+		if len(grant.Namespace) != 0 && grant.Namespace != kapi.NamespaceValue(test.context) {
+			t.Errorf("unexpected grant from namespace %q: %#v", grant.Namespace, grant)
+		}
+		if len(grant.RoleBinding) == 0 || len(grant.RoleRef.Name) == 0 {
+			t.Errorf("expected the binding and role of the grant: %#v", grant)
+		}
+		grantUsers.Insert(grant.Users...)
+		grantGroups.Insert(grant.Groups...)
+	}
+
+	matchStringSlice(test.expectedUsers.List(), grantUsers.List(), "grant users", t)
+	matchStringSlice(test.expectedGroups.List(), grantGroups.List(), "grant groups", t)
+	matchError(test.expectedError, actualError, "grant error", t)
 }
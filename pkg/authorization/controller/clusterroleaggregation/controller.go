@@ -0,0 +1,123 @@
+package clusterroleaggregation
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/controller/framework"
+	"k8s.io/kubernetes/pkg/runtime"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
+	"k8s.io/kubernetes/pkg/watch"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/authorization/rulevalidation"
+	osclient "github.com/openshift/origin/pkg/client"
+)
+
+// ClusterRoleAggregationController sets the rules of every cluster role with an aggregation rule to the rules
+// of the cluster roles matched by its selectors.  The cluster roles are held by the cluster policy, so the
+// controller watches it and updates the aggregating roles whenever a cluster role changes.
+type ClusterRoleAggregationController struct {
+	client osclient.Interface
+
+	policyController *framework.Controller
+	stopChan         chan struct{}
+}
+
+// NewClusterRoleAggregationController returns a controller aggregating cluster roles with client.
+func NewClusterRoleAggregationController(client osclient.Interface, resync time.Duration) *ClusterRoleAggregationController {
+	c := &ClusterRoleAggregationController{
+		client: client,
+	}
+
+	_, c.policyController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+				return c.client.ClusterPolicies().List(options)
+			},
+			WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+				return c.client.ClusterPolicies().Watch(options)
+			},
+		},
+		&authorizationapi.ClusterPolicy{},
+		resync,
+		framework.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				c.handleClusterPolicy(obj.(*authorizationapi.ClusterPolicy))
+			},
+			UpdateFunc: func(_, obj interface{}) {
+				c.handleClusterPolicy(obj.(*authorizationapi.ClusterPolicy))
+			},
+		},
+	)
+
+	return c
+}
+
+// Run starts the controller and returns immediately.
+func (c *ClusterRoleAggregationController) Run() {
+	if c.stopChan == nil {
+		c.stopChan = make(chan struct{})
+		go c.policyController.Run(c.stopChan)
+	}
+}
+
+// Stop gracefully shuts down the controller.
+func (c *ClusterRoleAggregationController) Stop() {
+	if c.stopChan != nil {
+		close(c.stopChan)
+		c.stopChan = nil
+	}
+}
+
+func (c *ClusterRoleAggregationController) handleClusterPolicy(policy *authorizationapi.ClusterPolicy) {
+	if err := c.syncClusterPolicy(policy); err != nil {
+		utilruntime.HandleError(err)
+	}
+}
+
+// syncClusterPolicy updates the aggregating roles of policy whose rules differ from the aggregated rules. Updating
+// a role changes the cluster policy, so roles aggregating other aggregating roles converge on the following syncs.
+func (c *ClusterRoleAggregationController) syncClusterPolicy(policy *authorizationapi.ClusterPolicy) error {
+	names := []string{}
+	for name, role := range policy.Roles {
+		if role.AggregationRule != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	errs := []error{}
+	for _, name := range names {
+		role := policy.Roles[name]
+		rules, err := rulevalidation.AggregatedRules(role, policy.Roles)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to aggregate the rules of clusterrole/%s: %v", name, err))
+			continue
+		}
+		if kapi.Semantic.DeepEqual(rules, role.Rules) {
+			continue
+		}
+
+		obj, err := kapi.Scheme.Copy(role)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		updated := obj.(*authorizationapi.ClusterRole)
+		updated.Rules = rules
+		if _, err := c.client.ClusterRoles().Update(updated); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		glog.V(4).Infof("Updated the aggregated rules of clusterrole/%s", name)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,109 @@
+package clusterroleaggregation
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/client/testclient"
+)
+
+func newRole(name string, labels map[string]string, rules ...authorizationapi.PolicyRule) *authorizationapi.ClusterRole {
+	return &authorizationapi.ClusterRole{
+		ObjectMeta: kapi.ObjectMeta{Name: name, Labels: labels},
+		Rules:      rules,
+	}
+}
+
+func newAggregatingRole(name string, label string, rules ...authorizationapi.PolicyRule) *authorizationapi.ClusterRole {
+	role := newRole(name, map[string]string{label: "true"}, rules...)
+	role.AggregationRule = &authorizationapi.AggregationRule{
+		ClusterRoleSelectors: []unversioned.LabelSelector{{MatchLabels: map[string]string{label: "true"}}},
+	}
+	return role
+}
+
+func newRule(verb, resource string) authorizationapi.PolicyRule {
+	return authorizationapi.PolicyRule{Verbs: sets.NewString(verb), Resources: sets.NewString(resource)}
+}
+
+func newPolicy(roles ...*authorizationapi.ClusterRole) *authorizationapi.ClusterPolicy {
+	policy := &authorizationapi.ClusterPolicy{
+		ObjectMeta: kapi.ObjectMeta{Name: authorizationapi.PolicyName},
+		Roles:      map[string]*authorizationapi.ClusterRole{},
+	}
+	for _, role := range roles {
+		policy.Roles[role.Name] = role
+	}
+	return policy
+}
+
+func TestSyncClusterPolicy(t *testing.T) {
+	testCases := map[string]struct {
+		policy *authorizationapi.ClusterPolicy
+
+		expectedUpdates map[string][]authorizationapi.PolicyRule
+	}{
+		"out of sync": {
+			policy: newPolicy(
+				newAggregatingRole("view", "aggregate-to-view", newRule("get", "pods")),
+				newRole("extension", map[string]string{"aggregate-to-view": "true"}, newRule("get", "widgets")),
+				newRole("bootstrap", map[string]string{"aggregate-to-view": "true"}, newRule("get", "pods")),
+			),
+			expectedUpdates: map[string][]authorizationapi.PolicyRule{
+				"view": {newRule("get", "pods"), newRule("get", "widgets")},
+			},
+		},
+		"in sync": {
+			policy: newPolicy(
+				newAggregatingRole("view", "aggregate-to-view", newRule("get", "pods")),
+				newRole("bootstrap", map[string]string{"aggregate-to-view": "true"}, newRule("get", "pods")),
+			),
+			expectedUpdates: map[string][]authorizationapi.PolicyRule{},
+		},
+		"contributor removed": {
+			policy: newPolicy(
+				newAggregatingRole("edit", "aggregate-to-edit", newRule("get", "pods"), newRule("update", "widgets")),
+				newRole("bootstrap", map[string]string{"aggregate-to-edit": "true"}, newRule("get", "pods")),
+				newRole("admin", nil, newRule("update", "widgets")),
+			),
+			expectedUpdates: map[string][]authorizationapi.PolicyRule{
+				"edit": {newRule("get", "pods")},
+			},
+		},
+	}
+
+	for k, tc := range testCases {
+		client := &testclient.Fake{}
+		client.AddReactor("update", "clusterroles", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			return true, action.(ktestclient.UpdateAction).GetObject(), nil
+		})
+		controller := &ClusterRoleAggregationController{client: client}
+		if err := controller.syncClusterPolicy(tc.policy); err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+
+		updates := map[string][]authorizationapi.PolicyRule{}
+		for _, action := range client.Actions() {
+			if !action.Matches("update", "clusterroles") {
+				t.Errorf("%s: unexpected action: %#v", k, action)
+				continue
+			}
+			role := action.(ktestclient.UpdateAction).GetObject().(*authorizationapi.ClusterRole)
+			updates[role.Name] = role.Rules
+			if role.AggregationRule == nil {
+				t.Errorf("%s: expected the aggregation rule of %s to be kept", k, role.Name)
+			}
+		}
+		if !reflect.DeepEqual(tc.expectedUpdates, updates) {
+			t.Errorf("%s: expected updates %#v, got %#v", k, tc.expectedUpdates, updates)
+		}
+	}
+}
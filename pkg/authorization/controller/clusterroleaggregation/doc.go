@@ -0,0 +1,4 @@
+// Package clusterroleaggregation contains the controller which keeps the rules
+// of the cluster roles with an aggregation rule in sync with the rules of the
+// cluster roles their selectors match.
+package clusterroleaggregation
@@ -48,5 +48,11 @@ func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 	}
 	clusterRAR.Action.Namespace = kapi.NamespaceValue(ctx)
 
-	return r.clusterRARRegistry.CreateResourceAccessReview(kapi.WithNamespace(ctx, ""), clusterRAR)
+	response, err := r.clusterRARRegistry.CreateResourceAccessReview(kapi.WithNamespace(ctx, ""), clusterRAR)
+	if err != nil {
+		return nil, err
+	}
+	// the users of a namespace may not see the cluster role bindings
+	response.Grants = resourceaccessreview.NamespaceGrants(response.Grants, clusterRAR.Action.Namespace)
+	return response, nil
 }
@@ -122,6 +122,48 @@ func TestNoErrors(t *testing.T) {
 	test.runTest(t)
 }
 
+// testGrantAuthorizer lists grants from cluster role bindings and role bindings
+type testGrantAuthorizer struct {
+	*testAuthorizer
+	grants []authorizationapi.ResourceAccessGrant
+}
+
+func (a *testGrantAuthorizer) GetAllowedSubjectGrants(ctx kapi.Context, attributes authorizer.AuthorizationAttributes) ([]authorizationapi.ResourceAccessGrant, error) {
+	return a.grants, nil
+}
+
+func TestClusterGrantsHidden(t *testing.T) {
+	localGrant := authorizationapi.ResourceAccessGrant{Namespace: "unittest", RoleBinding: "admins", RoleRef: kapi.ObjectReference{Name: "admin"}, Users: []string{"one"}}
+	authorizer := &testGrantAuthorizer{
+		testAuthorizer: &testAuthorizer{},
+		grants: []authorizationapi.ResourceAccessGrant{
+			{RoleBinding: "cluster-admins", RoleRef: kapi.ObjectReference{Name: "cluster-admin"}, Groups: []string{"system:cluster-admins"}},
+			localGrant,
+		},
+	}
+	reviewRequest := &authorizationapi.LocalResourceAccessReview{
+		Action: authorizationapi.AuthorizationAttributes{
+			Namespace: "unittest",
+			Verb:      "delete",
+			Resource:  "deploymentConfig",
+		},
+	}
+	storage := NewREST(resourceaccessreview.NewRegistry(resourceaccessreview.NewREST(authorizer)))
+
+	obj, err := storage.Create(kapi.WithNamespace(kapi.NewContext(), "unittest"), reviewRequest)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	response := obj.(*authorizationapi.ResourceAccessReviewResponse)
+	// the subjects of cluster role bindings are still reported, the bindings are not
+	if !response.Users.Equal(sets.NewString("one")) || !response.Groups.Equal(sets.NewString("system:cluster-admins")) {
+		t.Errorf("unexpected subjects: %v %v", response.Users, response.Groups)
+	}
+	if !reflect.DeepEqual(response.Grants, []authorizationapi.ResourceAccessGrant{localGrant}) {
+		t.Errorf("expected only the role binding of the namespace, got %#v", response.Grants)
+	}
+}
+
 func (r *resourceAccessTest) runTest(t *testing.T) {
 	storage := NewREST(resourceaccessreview.NewRegistry(resourceaccessreview.NewREST(r.authorizer)))
 
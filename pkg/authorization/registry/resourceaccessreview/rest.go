@@ -7,6 +7,7 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	kapierrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
 
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
 	authorizationvalidation "github.com/openshift/origin/pkg/authorization/api/validation"
@@ -51,17 +52,47 @@ func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 
 	requestContext := kapi.WithNamespace(ctx, resourceAccessReview.Action.Namespace)
 	attributes := authorizer.ToDefaultAuthorizationAttributes(resourceAccessReview.Action)
-	users, groups, _ := r.authorizer.GetAllowedSubjects(requestContext, attributes)
 
 	response := &authorizationapi.ResourceAccessReviewResponse{
 		Namespace: resourceAccessReview.Action.Namespace,
-		Users:     users,
-		Groups:    groups,
+	}
+	// the subjects and their grants are best effort, errors are ignored
+	if grantLister, ok := r.authorizer.(authorizer.GrantLister); ok {
+		response.Grants, _ = grantLister.GetAllowedSubjectGrants(requestContext, attributes)
+		response.Users, response.Groups = subjectsFromGrants(response.Grants)
+	} else {
+		response.Users, response.Groups, _ = r.authorizer.GetAllowedSubjects(requestContext, attributes)
+	}
+	// reviews of a namespace made by its users do not disclose the cluster role bindings
+	if namespace := kapi.NamespaceValue(ctx); len(namespace) > 0 {
+		response.Grants = NamespaceGrants(response.Grants, namespace)
 	}
 
 	return response, nil
 }
 
+// subjectsFromGrants returns the users and groups bound by the grants
+func subjectsFromGrants(grants []authorizationapi.ResourceAccessGrant) (sets.String, sets.String) {
+	users := sets.String{}
+	groups := sets.String{}
+	for _, grant := range grants {
+		users.Insert(grant.Users...)
+		groups.Insert(grant.Groups...)
+	}
+	return users, groups
+}
+
+// NamespaceGrants returns the grants of the role bindings in namespace, leaving out the cluster role bindings
+func NamespaceGrants(grants []authorizationapi.ResourceAccessGrant, namespace string) []authorizationapi.ResourceAccessGrant {
+	var namespaceGrants []authorizationapi.ResourceAccessGrant
+	for _, grant := range grants {
+		if grant.Namespace == namespace {
+			namespaceGrants = append(namespaceGrants, grant)
+		}
+	}
+	return namespaceGrants
+}
+
 // isAllowed checks to see if the current user has rights to issue a LocalSubjectAccessReview on the namespace they're attempting to access
 func (r *REST) isAllowed(ctx kapi.Context, rar *authorizationapi.ResourceAccessReview) error {
 	localRARAttributes := authorizer.DefaultAuthorizationAttributes{
@@ -21,6 +21,7 @@ type resourceAccessTest struct {
 type testAuthorizer struct {
 	users            sets.String
 	groups           sets.String
+	grants           []authorizationapi.ResourceAccessGrant
 	err              string
 	deniedNamespaces sets.String
 
@@ -52,6 +53,27 @@ func (a *testAuthorizer) GetAllowedSubjects(ctx kapi.Context, passedAttributes a
 	return a.users, a.groups, errors.New(a.err)
 }
 
+// testGrantAuthorizer lists the grants of the test authorizer, from which the subjects are derived
+type testGrantAuthorizer struct {
+	*testAuthorizer
+}
+
+func (a testGrantAuthorizer) GetAllowedSubjects(ctx kapi.Context, passedAttributes authorizer.AuthorizationAttributes) (sets.String, sets.String, error) {
+	return nil, nil, errors.New("subjects must be derived from the grants")
+}
+func (a testGrantAuthorizer) GetAllowedSubjectGrants(ctx kapi.Context, passedAttributes authorizer.AuthorizationAttributes) ([]authorizationapi.ResourceAccessGrant, error) {
+	attributes, ok := passedAttributes.(authorizer.DefaultAuthorizationAttributes)
+	if !ok {
+		return nil, errors.New("unexpected type for test")
+	}
+
+	a.actualAttributes = attributes
+	if len(a.err) == 0 {
+		return a.grants, nil
+	}
+	return a.grants, errors.New(a.err)
+}
+
 func TestDeniedNamespace(t *testing.T) {
 	test := &resourceAccessTest{
 		authorizer: &testAuthorizer{
@@ -106,13 +128,58 @@ func TestNoErrors(t *testing.T) {
 	test.runTest(t)
 }
 
+func TestGrants(t *testing.T) {
+	test := &resourceAccessTest{
+		authorizer: &testAuthorizer{
+			users:  sets.NewString("one", "two"),
+			groups: sets.NewString("three"),
+			grants: []authorizationapi.ResourceAccessGrant{
+				{RoleBinding: "cluster-readers", RoleRef: kapi.ObjectReference{Name: "cluster-reader"}, Groups: []string{"three"}},
+				{Namespace: "foo", RoleBinding: "admins", RoleRef: kapi.ObjectReference{Name: "admin"}, Users: []string{"one", "two"}},
+			},
+		},
+		reviewRequest: &authorizationapi.ResourceAccessReview{
+			Action: authorizationapi.AuthorizationAttributes{
+				Namespace:    "foo",
+				Verb:         "get",
+				Resource:     "pods/log",
+				ResourceName: "bar",
+			},
+		},
+	}
+
+	test.runTest(t)
+}
+
+func TestNonResourceURL(t *testing.T) {
+	test := &resourceAccessTest{
+		authorizer: &testAuthorizer{
+			users:  sets.NewString("one"),
+			groups: sets.NewString("two"),
+		},
+		reviewRequest: &authorizationapi.ResourceAccessReview{
+			Action: authorizationapi.AuthorizationAttributes{
+				Verb:             "get",
+				IsNonResourceURL: true,
+				Path:             "/healthz",
+			},
+		},
+	}
+
+	test.runTest(t)
+}
+
 func (r *resourceAccessTest) runTest(t *testing.T) {
 	storage := REST{r.authorizer}
+	if len(r.authorizer.grants) > 0 {
+		storage = REST{testGrantAuthorizer{r.authorizer}}
+	}
 
 	expectedResponse := &authorizationapi.ResourceAccessReviewResponse{
 		Namespace: r.reviewRequest.Action.Namespace,
 		Users:     r.authorizer.users,
 		Groups:    r.authorizer.groups,
+		Grants:    r.authorizer.grants,
 	}
 
 	expectedAttributes := authorizer.ToDefaultAuthorizationAttributes(r.reviewRequest.Action)
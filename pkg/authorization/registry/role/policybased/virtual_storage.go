@@ -9,6 +9,7 @@ import (
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
 
 	oapi "github.com/openshift/origin/pkg/api"
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
@@ -119,6 +120,9 @@ func (m *VirtualStorage) createRole(ctx kapi.Context, obj runtime.Object, allowE
 		if err := rulevalidation.ConfirmNoEscalation(ctx, m.RuleResolver, authorizationinterfaces.NewLocalRoleAdapter(role)); err != nil {
 			return nil, err
 		}
+		if err := m.confirmNoAggregationEscalation(ctx, role, nil); err != nil {
+			return nil, err
+		}
 	}
 
 	policy, err := m.EnsurePolicy(ctx)
@@ -166,6 +170,9 @@ func (m *VirtualStorage) updateRole(ctx kapi.Context, obj runtime.Object, allowE
 		if err := rulevalidation.ConfirmNoEscalation(ctx, m.RuleResolver, authorizationinterfaces.NewLocalRoleAdapter(role)); err != nil {
 			return nil, false, err
 		}
+		if err := m.confirmNoAggregationEscalation(ctx, role, old.(*authorizationapi.Role)); err != nil {
+			return nil, false, err
+		}
 	}
 
 	policy, err := m.PolicyStorage.GetPolicy(ctx, authorizationapi.PolicyName)
@@ -190,6 +197,42 @@ func (m *VirtualStorage) updateRole(ctx kapi.Context, obj runtime.Object, allowE
 	return role, false, nil
 }
 
+// escalateClusterRolesRule allows setting an aggregation rule on a cluster role without holding the aggregated rules
+var escalateClusterRolesRule = authorizationapi.PolicyRule{Verbs: sets.NewString("escalate"), Resources: sets.NewString("clusterroles")}
+
+// confirmNoAggregationEscalation checks that a user setting or changing the aggregation rule of a role holds the
+// rules it aggregates, since the aggregation controller will grant them to the role.  Users allowed to escalate
+// cluster roles may aggregate any rule.
+func (m *VirtualStorage) confirmNoAggregationEscalation(ctx kapi.Context, role, oldRole *authorizationapi.Role) error {
+	if role.AggregationRule == nil {
+		return nil
+	}
+	if oldRole != nil && kapi.Semantic.DeepEqual(role.AggregationRule, oldRole.AggregationRule) {
+		return nil
+	}
+
+	escalateRole := &authorizationapi.Role{ObjectMeta: role.ObjectMeta, Rules: []authorizationapi.PolicyRule{escalateClusterRolesRule}}
+	if err := rulevalidation.ConfirmNoEscalation(ctx, m.RuleResolver, authorizationinterfaces.NewLocalRoleAdapter(escalateRole)); err == nil {
+		return nil
+	}
+
+	roles := map[string]*authorizationapi.Role{}
+	policy, err := m.PolicyStorage.GetPolicy(ctx, authorizationapi.PolicyName)
+	if err != nil && !kapierrors.IsNotFound(err) {
+		return err
+	}
+	if policy != nil {
+		roles = policy.Roles
+	}
+	rules, err := rulevalidation.AggregatedRules(authorizationapi.ToClusterRole(role), authorizationapi.ToClusterRoleMap(roles))
+	if err != nil {
+		return kapierrors.NewBadRequest(err.Error())
+	}
+
+	aggregatedRole := &authorizationapi.Role{ObjectMeta: role.ObjectMeta, Rules: rules}
+	return rulevalidation.ConfirmNoEscalation(ctx, m.RuleResolver, authorizationinterfaces.NewLocalRoleAdapter(aggregatedRole))
+}
+
 // EnsurePolicy returns the policy object for the specified namespace.  If one does not exist, it is created for you.  Permission to
 // create, update, or delete roles in a namespace implies the ability to create a Policy object itself.
 func (m *VirtualStorage) EnsurePolicy(ctx kapi.Context) (*authorizationapi.Policy, error) {
@@ -13,6 +13,7 @@ import (
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
 	_ "github.com/openshift/origin/pkg/authorization/api/install"
 	clusterpolicyregistry "github.com/openshift/origin/pkg/authorization/registry/clusterpolicy"
+	clusterpolicybindingregistry "github.com/openshift/origin/pkg/authorization/registry/clusterpolicybinding"
 	roleregistry "github.com/openshift/origin/pkg/authorization/registry/role"
 	"github.com/openshift/origin/pkg/authorization/registry/test"
 	"github.com/openshift/origin/pkg/authorization/rulevalidation"
@@ -180,3 +181,139 @@ func TestDeleteValid(t *testing.T) {
 		t.Fatalf("Got back non-status result: %v", r)
 	}
 }
+
+func makeAggregationTestStorage() *VirtualStorage {
+	clusterPolicyRegistry := test.NewClusterPolicyRegistry([]authorizationapi.ClusterPolicy{
+		{
+			ObjectMeta: kapi.ObjectMeta{Name: authorizationapi.PolicyName, ResourceVersion: "1"},
+			Roles: map[string]*authorizationapi.ClusterRole{
+				"cluster-admin": {
+					ObjectMeta: kapi.ObjectMeta{Name: "cluster-admin"},
+					Rules:      []authorizationapi.PolicyRule{{Verbs: sets.NewString("*"), Resources: sets.NewString("*")}},
+				},
+				"escalator": {
+					ObjectMeta: kapi.ObjectMeta{Name: "escalator"},
+					Rules:      []authorizationapi.PolicyRule{{Verbs: sets.NewString("escalate"), Resources: sets.NewString("clusterroles")}},
+				},
+				"pod-reader": {
+					ObjectMeta: kapi.ObjectMeta{Name: "pod-reader", Labels: map[string]string{"aggregate-to-pods": "true"}},
+					Rules:      []authorizationapi.PolicyRule{{Verbs: sets.NewString("get"), Resources: sets.NewString("pods")}},
+				},
+				"secret-reader": {
+					ObjectMeta: kapi.ObjectMeta{Name: "secret-reader", Labels: map[string]string{"aggregate-to-secrets": "true"}},
+					Rules:      []authorizationapi.PolicyRule{{Verbs: sets.NewString("get"), Resources: sets.NewString("secrets")}},
+				},
+				"secrets": {
+					ObjectMeta:      kapi.ObjectMeta{Name: "secrets", ResourceVersion: "1"},
+					AggregationRule: newAggregationRule("aggregate-to-secrets"),
+				},
+			},
+		},
+	}, nil)
+	clusterBindingRegistry := test.NewClusterPolicyBindingRegistry([]authorizationapi.ClusterPolicyBinding{
+		{
+			ObjectMeta: kapi.ObjectMeta{Name: authorizationapi.ClusterPolicyBindingName},
+			RoleBindings: map[string]*authorizationapi.ClusterRoleBinding{
+				"cluster-admins": {
+					ObjectMeta: kapi.ObjectMeta{Name: "cluster-admins"},
+					RoleRef:    kapi.ObjectReference{Name: "cluster-admin"},
+					Subjects:   []kapi.ObjectReference{{Kind: authorizationapi.SystemUserKind, Name: "system:admin"}},
+				},
+				"escalators": {
+					ObjectMeta: kapi.ObjectMeta{Name: "escalators"},
+					RoleRef:    kapi.ObjectReference{Name: "escalator"},
+					Subjects:   []kapi.ObjectReference{{Kind: authorizationapi.UserKind, Name: "escalator"}},
+				},
+				"pod-readers": {
+					ObjectMeta: kapi.ObjectMeta{Name: "pod-readers"},
+					RoleRef:    kapi.ObjectReference{Name: "pod-reader"},
+					Subjects:   []kapi.ObjectReference{{Kind: authorizationapi.UserKind, Name: "bob"}},
+				},
+			},
+		},
+	}, nil)
+	policyRegistry := clusterpolicyregistry.NewSimulatedRegistry(clusterPolicyRegistry)
+	bindingRegistry := clusterpolicybindingregistry.NewSimulatedRegistry(clusterBindingRegistry)
+
+	return &VirtualStorage{
+		PolicyStorage:  policyRegistry,
+		RuleResolver:   rulevalidation.NewDefaultRuleResolver(policyRegistry, bindingRegistry, clusterPolicyRegistry, clusterBindingRegistry),
+		CreateStrategy: roleregistry.ClusterStrategy,
+		UpdateStrategy: roleregistry.ClusterStrategy,
+	}
+}
+
+func newAggregationRule(label string) *authorizationapi.AggregationRule {
+	return &authorizationapi.AggregationRule{
+		ClusterRoleSelectors: []unversioned.LabelSelector{{MatchLabels: map[string]string{label: "true"}}},
+	}
+}
+
+func TestCreateAggregatingRoleEscalation(t *testing.T) {
+	testCases := map[string]struct {
+		user  string
+		label string
+
+		expectedForbidden bool
+	}{
+		"aggregates held rules": {
+			user:  "bob",
+			label: "aggregate-to-pods",
+		},
+		"aggregates extra rules": {
+			user:              "bob",
+			label:             "aggregate-to-secrets",
+			expectedForbidden: true,
+		},
+		"cluster admin": {
+			user:  "system:admin",
+			label: "aggregate-to-secrets",
+		},
+		"escalate permission": {
+			user:  "escalator",
+			label: "aggregate-to-secrets",
+		},
+	}
+
+	for k, tc := range testCases {
+		storage := makeAggregationTestStorage()
+		ctx := kapi.WithUser(kapi.NewContext(), &user.DefaultInfo{Name: tc.user})
+		_, err := storage.Create(ctx, &authorizationapi.Role{
+			ObjectMeta:      kapi.ObjectMeta{Name: "aggregating"},
+			AggregationRule: newAggregationRule(tc.label),
+		})
+		if tc.expectedForbidden && !kapierrors.IsUnauthorized(err) {
+			t.Errorf("%s: expected an escalation error, got %v", k, err)
+		}
+		if !tc.expectedForbidden && err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+		}
+	}
+}
+
+func TestUpdateAggregatingRoleEscalation(t *testing.T) {
+	storage := makeAggregationTestStorage()
+	ctx := kapi.WithUser(kapi.NewContext(), &user.DefaultInfo{Name: "bob"})
+	update := func(labels map[string]string, aggregationLabel string) error {
+		old, err := storage.Get(ctx, "secrets")
+		if err != nil {
+			return err
+		}
+		_, _, err = storage.Update(ctx, &authorizationapi.Role{
+			ObjectMeta:      kapi.ObjectMeta{Name: "secrets", Labels: labels, ResourceVersion: old.(*authorizationapi.Role).ResourceVersion},
+			AggregationRule: newAggregationRule(aggregationLabel),
+		})
+		return err
+	}
+
+	// the aggregation rule is unchanged, the aggregated rules are not checked again
+	if err := update(map[string]string{"updated": "true"}, "aggregate-to-secrets"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := update(nil, "aggregate-to-pods"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := update(nil, "aggregate-to-secrets"); !kapierrors.IsUnauthorized(err) {
+		t.Errorf("expected an escalation error, got %v", err)
+	}
+}
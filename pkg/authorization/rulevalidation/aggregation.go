@@ -0,0 +1,60 @@
+package rulevalidation
+
+import (
+	"sort"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/labels"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+)
+
+// AggregatedRules returns the rules of the roles matched by any of the selectors of the aggregation rule of role, in
+// the order of the names of the roles and without duplicates.  A role never aggregates its own rules.
+func AggregatedRules(role *authorizationapi.ClusterRole, roles map[string]*authorizationapi.ClusterRole) ([]authorizationapi.PolicyRule, error) {
+	selectors := []labels.Selector{}
+	for i := range role.AggregationRule.ClusterRoleSelectors {
+		selector, err := unversioned.LabelSelectorAsSelector(&role.AggregationRule.ClusterRoleSelectors[i])
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, selector)
+	}
+
+	names := []string{}
+	for name := range roles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	rules := []authorizationapi.PolicyRule{}
+	for _, name := range names {
+		if name == role.Name {
+			continue
+		}
+		candidate := roles[name]
+		for _, selector := range selectors {
+			if !selector.Matches(labels.Set(candidate.Labels)) {
+				continue
+			}
+			for _, rule := range candidate.Rules {
+				if !hasRule(rules, rule) {
+					rules = append(rules, rule)
+				}
+			}
+			break
+		}
+	}
+
+	return rules, nil
+}
+
+func hasRule(rules []authorizationapi.PolicyRule, rule authorizationapi.PolicyRule) bool {
+	for _, existing := range rules {
+		if kapi.Semantic.DeepEqual(existing, rule) {
+			return true
+		}
+	}
+	return false
+}
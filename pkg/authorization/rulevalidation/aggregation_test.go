@@ -0,0 +1,48 @@
+package rulevalidation
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+)
+
+func newAggregationTestRole(name string, labels map[string]string, rules ...authorizationapi.PolicyRule) *authorizationapi.ClusterRole {
+	return &authorizationapi.ClusterRole{
+		ObjectMeta: kapi.ObjectMeta{Name: name, Labels: labels},
+		Rules:      rules,
+	}
+}
+
+func newAggregationTestRule(verb, resource string) authorizationapi.PolicyRule {
+	return authorizationapi.PolicyRule{Verbs: sets.NewString(verb), Resources: sets.NewString(resource)}
+}
+
+func TestAggregatedRules(t *testing.T) {
+	aggregating := newAggregationTestRole("view", map[string]string{"aggregate-to-view": "true"}, newAggregationTestRule("get", "stale"))
+	aggregating.AggregationRule = &authorizationapi.AggregationRule{
+		ClusterRoleSelectors: []unversioned.LabelSelector{{MatchLabels: map[string]string{"aggregate-to-view": "true"}}},
+	}
+	roles := map[string]*authorizationapi.ClusterRole{}
+	for _, role := range []*authorizationapi.ClusterRole{
+		aggregating,
+		newAggregationTestRole("b-extension", map[string]string{"aggregate-to-view": "true"}, newAggregationTestRule("get", "widgets"), newAggregationTestRule("get", "pods")),
+		newAggregationTestRole("a-bootstrap", map[string]string{"aggregate-to-view": "true"}, newAggregationTestRule("get", "pods")),
+		newAggregationTestRole("unlabeled", nil, newAggregationTestRule("get", "secrets")),
+		newAggregationTestRole("other", map[string]string{"aggregate-to-view": "false"}, newAggregationTestRule("get", "nodes")),
+	} {
+		roles[role.Name] = role
+	}
+
+	rules, err := AggregatedRules(aggregating, roles)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []authorizationapi.PolicyRule{newAggregationTestRule("get", "pods"), newAggregationTestRule("get", "widgets")}
+	if !kapi.Semantic.DeepEqual(expected, rules) {
+		t.Errorf("expected %#v, got %#v", expected, rules)
+	}
+}
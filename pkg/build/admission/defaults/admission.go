@@ -71,10 +71,63 @@ func (a *buildDefaults) Admit(attributes admission.Attributes) error {
 	glog.V(4).Infof("Handling build %s/%s", build.Namespace, build.Name)
 
 	a.applyBuildDefaults(build)
+	if err := a.applyPodDefaults(attributes, build); err != nil {
+		return err
+	}
 
 	return buildadmission.SetBuild(attributes, build, version)
 }
 
+// applyPodDefaults applies the default node selector and compute resources to
+// a build and its pod when the build does not specify them.
+func (a *buildDefaults) applyPodDefaults(attributes admission.Attributes, build *buildapi.Build) error {
+	pod, err := buildadmission.GetPod(attributes)
+	if err != nil {
+		return err
+	}
+
+	if len(build.Spec.NodeSelector) == 0 && len(a.defaultsConfig.NodeSelector) != 0 {
+		glog.V(5).Infof("Setting default node selector of build %s/%s to %v", build.Namespace, build.Name, a.defaultsConfig.NodeSelector)
+		build.Spec.NodeSelector = map[string]string{}
+		for k, v := range a.defaultsConfig.NodeSelector {
+			build.Spec.NodeSelector[k] = v
+		}
+		pod.Spec.NodeSelector = build.Spec.NodeSelector
+	}
+
+	resources := &build.Spec.Resources
+	for name, value := range a.defaultsConfig.Resources.Limits {
+		if _, ok := resources.Limits[name]; ok {
+			continue
+		}
+		if request, ok := resources.Requests[name]; ok && value.Cmp(request) < 0 {
+			continue
+		}
+		glog.V(5).Infof("Setting default %s limit of build %s/%s to %s", name, build.Namespace, build.Name, value.String())
+		if resources.Limits == nil {
+			resources.Limits = kapi.ResourceList{}
+		}
+		resources.Limits[name] = value
+	}
+	for name, value := range a.defaultsConfig.Resources.Requests {
+		if _, ok := resources.Requests[name]; ok {
+			continue
+		}
+		if limit, ok := resources.Limits[name]; ok && limit.Cmp(value) < 0 {
+			continue
+		}
+		glog.V(5).Infof("Setting default %s request of build %s/%s to %s", name, build.Namespace, build.Name, value.String())
+		if resources.Requests == nil {
+			resources.Requests = kapi.ResourceList{}
+		}
+		resources.Requests[name] = value
+	}
+	if len(pod.Spec.Containers) > 0 {
+		pod.Spec.Containers[0].Resources = build.Spec.Resources
+	}
+	return nil
+}
+
 func (a *buildDefaults) applyBuildDefaults(build *buildapi.Build) {
 	// Apply default env
 	buildEnv := getBuildEnv(build)
@@ -1,9 +1,11 @@
 package defaults
 
 import (
+	"reflect"
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
 
 	buildadmission "github.com/openshift/origin/pkg/build/admission"
 	defaultsapi "github.com/openshift/origin/pkg/build/admission/defaults/api"
@@ -85,3 +87,67 @@ func TestEnvDefaults(t *testing.T) {
 		t.Errorf("VAR2 not found")
 	}
 }
+
+func TestNodeSelectorAndResourcesDefaults(t *testing.T) {
+	defaultsConfig := &defaultsapi.BuildDefaultsConfig{
+		NodeSelector: map[string]string{"region": "builds"},
+		Resources: kapi.ResourceRequirements{
+			Limits: kapi.ResourceList{
+				kapi.ResourceCPU:    resource.MustParse("1"),
+				kapi.ResourceMemory: resource.MustParse("1Gi"),
+			},
+			Requests: kapi.ResourceList{
+				kapi.ResourceMemory: resource.MustParse("512Mi"),
+			},
+		},
+	}
+
+	admitter := NewBuildDefaults(defaultsConfig)
+	build := u.Build().WithDockerStrategy().AsBuild()
+	build.Spec.Resources.Limits = kapi.ResourceList{kapi.ResourceMemory: resource.MustParse("256Mi")}
+	pod := u.Pod().WithBuild(t, build, "v1")
+	err := admitter.Admit(pod.ToAttributes())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	build, _, err = buildadmission.GetBuild(pod.ToAttributes())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(build.Spec.NodeSelector, defaultsConfig.NodeSelector) {
+		t.Errorf("unexpected build node selector %v", build.Spec.NodeSelector)
+	}
+	if !reflect.DeepEqual(pod.Spec.NodeSelector, defaultsConfig.NodeSelector) {
+		t.Errorf("unexpected pod node selector %v", pod.Spec.NodeSelector)
+	}
+	resources := pod.Spec.Containers[0].Resources
+	if cpu := resources.Limits[kapi.ResourceCPU]; cpu.String() != "1" {
+		t.Errorf("expected the default cpu limit, got %s", cpu.String())
+	}
+	// the memory limit of the build is kept, the default request would exceed it
+	if memory := resources.Limits[kapi.ResourceMemory]; memory.String() != "256Mi" {
+		t.Errorf("expected the memory limit of the build, got %s", memory.String())
+	}
+	if _, ok := resources.Requests[kapi.ResourceMemory]; ok {
+		t.Errorf("unexpected memory request %v", resources.Requests)
+	}
+	if !reflect.DeepEqual(build.Spec.Resources, resources) {
+		t.Errorf("expected the resources of the build %v to match the pod %v", build.Spec.Resources, resources)
+	}
+
+	// the node selector of the build is kept
+	build = u.Build().WithDockerStrategy().AsBuild()
+	build.Spec.NodeSelector = map[string]string{"region": "other"}
+	pod = u.Pod().WithBuild(t, build, "v1")
+	if err := admitter.Admit(pod.ToAttributes()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	build, _, err = buildadmission.GetBuild(pod.ToAttributes())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if build.Spec.NodeSelector["region"] != "other" {
+		t.Errorf("unexpected build node selector %v", build.Spec.NodeSelector)
+	}
+}
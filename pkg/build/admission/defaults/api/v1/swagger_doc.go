@@ -10,6 +10,8 @@ var map_BuildDefaultsConfig = map[string]string{
 	"gitHTTPProxy":  "GitHTTPProxy is the location of the HTTPProxy for Git source",
 	"gitHTTPSProxy": "GitHTTPSProxy is the location of the HTTPSProxy for Git source",
 	"env":           "Env is a set of default environment variables that will be applied to the build if the specified variables do not exist on the build",
+	"nodeSelector":  "NodeSelector is a selector which must be true for the build pod to fit on a node, applied to the builds that do not specify one",
+	"resources":     "Resources are the default compute resource requirements of the build pods, applied to the builds that do not specify them",
 }
 
 func (BuildDefaultsConfig) SwaggerDoc() map[string]string {
@@ -18,4 +18,12 @@ type BuildDefaultsConfig struct {
 	// Env is a set of default environment variables that will be applied to the
 	// build if the specified variables do not exist on the build
 	Env []kapi.EnvVar `json:"env,omitempty",description:"default environment variable values to add to builds"`
+
+	// NodeSelector is a selector which must be true for the build pod to fit on a node,
+	// applied to the builds that do not specify one
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Resources are the default compute resource requirements of the build pods,
+	// applied to the builds that do not specify them
+	Resources kapi.ResourceRequirements `json:"resources,omitempty"`
 }
@@ -1,6 +1,7 @@
 package validation
 
 import (
+	kvalidation "k8s.io/kubernetes/pkg/api/validation"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	"github.com/openshift/origin/pkg/build/admission/defaults/api"
@@ -13,6 +14,8 @@ func ValidateBuildDefaultsConfig(config *api.BuildDefaultsConfig) field.ErrorLis
 	allErrs = append(allErrs, validateURL(config.GitHTTPProxy, field.NewPath("gitHTTPProxy"))...)
 	allErrs = append(allErrs, validateURL(config.GitHTTPSProxy, field.NewPath("gitHTTPSProxy"))...)
 	allErrs = append(allErrs, buildvalidation.ValidateStrategyEnv(config.Env, field.NewPath("env"))...)
+	allErrs = append(allErrs, kvalidation.ValidateLabels(config.NodeSelector, field.NewPath("nodeSelector"))...)
+	allErrs = append(allErrs, kvalidation.ValidateResourceRequirements(&config.Resources, field.NewPath("resources"))...)
 	return allErrs
 }
 
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	defaultsapi "github.com/openshift/origin/pkg/build/admission/defaults/api"
@@ -97,6 +98,27 @@ func TestValidateBuildDefaultsConfig(t *testing.T) {
 			errField:    "env[0].valueFrom",
 			errType:     field.ErrorTypeInvalid,
 		},
+		// 6: invalid node selector
+		{
+			config: &defaultsapi.BuildDefaultsConfig{
+				NodeSelector: map[string]string{"invalid key": "value"},
+			},
+			errExpected: true,
+			errField:    "nodeSelector",
+			errType:     field.ErrorTypeInvalid,
+		},
+		// 7: cpu request greater than the limit
+		{
+			config: &defaultsapi.BuildDefaultsConfig{
+				Resources: kapi.ResourceRequirements{
+					Limits:   kapi.ResourceList{kapi.ResourceCPU: resource.MustParse("100m")},
+					Requests: kapi.ResourceList{kapi.ResourceCPU: resource.MustParse("200m")},
+				},
+			},
+			errExpected: true,
+			errField:    "resources.limits[cpu]",
+			errType:     field.ErrorTypeInvalid,
+		},
 	}
 
 	for i, tc := range tests {
@@ -8,6 +8,7 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	clientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util/sets"
 
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
@@ -107,7 +108,10 @@ func (a *buildByStrategy) checkBuildAuthorization(build *buildapi.Build, attr ad
 		User:   attr.GetUserInfo().GetName(),
 		Groups: sets.NewString(attr.GetUserInfo().GetGroups()...),
 	}
-	return a.checkAccess(strategy, subjectAccessReview, attr)
+	if err := a.checkAccess(strategy, subjectAccessReview, attr); err != nil {
+		return err
+	}
+	return a.checkDockerSocketAccess(strategy, build, resourceName(build.ObjectMeta), attr)
 }
 
 func (a *buildByStrategy) checkBuildConfigAuthorization(buildConfig *buildapi.BuildConfig, attr admission.Attributes) error {
@@ -123,7 +127,10 @@ func (a *buildByStrategy) checkBuildConfigAuthorization(buildConfig *buildapi.Bu
 		User:   attr.GetUserInfo().GetName(),
 		Groups: sets.NewString(attr.GetUserInfo().GetGroups()...),
 	}
-	return a.checkAccess(strategy, subjectAccessReview, attr)
+	if err := a.checkAccess(strategy, subjectAccessReview, attr); err != nil {
+		return err
+	}
+	return a.checkDockerSocketAccess(strategy, buildConfig, resourceName(buildConfig.ObjectMeta), attr)
 }
 
 func (a *buildByStrategy) checkBuildRequestAuthorization(req *buildapi.BuildRequest, attr admission.Attributes) error {
@@ -156,6 +163,35 @@ func (a *buildByStrategy) checkAccess(strategy buildapi.BuildStrategy, subjectAc
 	return nil
 }
 
+// checkDockerSocketAccess verifies that the user is allowed to create docker
+// builds when a custom build asks for the docker socket to be exposed, since
+// access to the socket grants at least the same privileges.
+func (a *buildByStrategy) checkDockerSocketAccess(strategy buildapi.BuildStrategy, content runtime.Object, name string, attr admission.Attributes) error {
+	if strategy.CustomStrategy == nil || !strategy.CustomStrategy.ExposeDockerSocket {
+		return nil
+	}
+	resource := buildapi.Resource(authorizationapi.DockerBuildResource)
+	subjectAccessReview := &authorizationapi.LocalSubjectAccessReview{
+		Action: authorizationapi.AuthorizationAttributes{
+			Verb:         "create",
+			Group:        resource.Group,
+			Resource:     resource.Resource,
+			Content:      content,
+			ResourceName: name,
+		},
+		User:   attr.GetUserInfo().GetName(),
+		Groups: sets.NewString(attr.GetUserInfo().GetGroups()...),
+	}
+	resp, err := a.client.LocalSubjectAccessReviews(attr.GetNamespace()).Create(subjectAccessReview)
+	if err != nil {
+		return err
+	}
+	if !resp.Allowed {
+		return admission.NewForbidden(attr, fmt.Errorf("build strategy %s is not allowed to expose the docker socket", buildapi.StrategyType(strategy)))
+	}
+	return nil
+}
+
 func notAllowed(strategy buildapi.BuildStrategy, attr admission.Attributes) error {
 	return admission.NewForbidden(attr, fmt.Errorf("build strategy %s is not allowed", buildapi.StrategyType(strategy)))
 }
@@ -236,3 +236,59 @@ func testBuildRequest(name string) runtime.Object {
 		},
 	}
 }
+
+func TestCustomBuildDockerSocketAdmission(t *testing.T) {
+	tests := []struct {
+		name         string
+		object       runtime.Object
+		resource     unversioned.GroupResource
+		dockerAccess bool
+		expectAccept bool
+	}{
+		{
+			name:         "custom build without the docker socket",
+			object:       testBuild(buildapi.BuildStrategy{CustomStrategy: &buildapi.CustomBuildStrategy{}}),
+			resource:     buildsResource,
+			expectAccept: true,
+		},
+		{
+			name:         "custom build exposing the docker socket with docker access",
+			object:       testBuild(buildapi.BuildStrategy{CustomStrategy: &buildapi.CustomBuildStrategy{ExposeDockerSocket: true}}),
+			resource:     buildsResource,
+			dockerAccess: true,
+			expectAccept: true,
+		},
+		{
+			name:     "custom build exposing the docker socket without docker access",
+			object:   testBuild(buildapi.BuildStrategy{CustomStrategy: &buildapi.CustomBuildStrategy{ExposeDockerSocket: true}}),
+			resource: buildsResource,
+		},
+		{
+			name:     "custom build config exposing the docker socket without docker access",
+			object:   testBuildConfig(buildapi.BuildStrategy{CustomStrategy: &buildapi.CustomBuildStrategy{ExposeDockerSocket: true}}),
+			resource: buildConfigsResource,
+		},
+	}
+
+	for _, test := range tests {
+		allowed := map[string]bool{
+			authorizationapi.CustomBuildResource: true,
+			authorizationapi.DockerBuildResource: test.dockerAccess,
+		}
+		fake := &testclient.Fake{}
+		fake.AddReactor("create", "localsubjectaccessreviews", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			review := action.(ktestclient.CreateAction).GetObject().(*authorizationapi.LocalSubjectAccessReview)
+			return true, reviewResponse(allowed[review.Action.Resource], ""), nil
+		})
+		c := NewBuildByStrategy()
+		c.(oadmission.WantsOpenshiftClient).SetOpenshiftClient(fake)
+		attrs := admission.NewAttributesRecord(test.object, buildapi.Kind("Build"), "default", "name", test.resource, "", admission.Create, fakeUser())
+		err := c.Admit(attrs)
+		if test.expectAccept && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if !test.expectAccept && !apierrors.IsForbidden(err) {
+			t.Errorf("%s: expected a forbidden error, got %v", test.name, err)
+		}
+	}
+}
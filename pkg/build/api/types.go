@@ -30,6 +30,9 @@ const (
 	// DropCapabilities is an environment variable that contains a list of capabilities to drop when
 	// executing a Source build
 	DropCapabilities = "DROP_CAPS"
+	// EnvValueFromPrefix is the prefix of the environment variables of a build pod holding the
+	// values of the strategy environment variables resolved from a Secret or a ConfigMap
+	EnvValueFromPrefix = "OPENSHIFT_BUILD_ENV_"
 )
 
 // Build encapsulates the inputs needed to produce a new deployable image, as well as
@@ -68,6 +71,10 @@ type BuildSpec struct {
 	// Compute resource requirements to execute the build
 	Resources kapi.ResourceRequirements
 
+	// NodeSelector is a selector which must be true for the build pod to fit on a node.
+	// If empty, the default build node selector of the cluster, if any, is used.
+	NodeSelector map[string]string
+
 	// PostCommit is a build hook executed after the build output image is
 	// committed, before it is pushed to a registry.
 	PostCommit BuildPostCommitSpec
@@ -177,6 +184,10 @@ const (
 	// StatusReasonExceededRetryTimeout is an error condition when the build has
 	// not completed and retrying the build times out.
 	StatusReasonExceededRetryTimeout = "ExceededRetryTimeout"
+
+	// StatusReasonCancelledBuild describes a build which was cancelled before
+	// its completion.
+	StatusReasonCancelledBuild = "CancelledBuild"
 )
 
 // BuildSource is the input used for the build.
@@ -312,6 +323,19 @@ type GitBuildSource struct {
 
 	// HTTPSProxy is a proxy used to reach the git repository over https
 	HTTPSProxy *string
+
+	// NoProxy is the list of domains for which the proxy should not be used
+	NoProxy *string
+
+	// SkipSubmodules disables the initialization and update of the submodules
+	// of the repository, which are otherwise checked out recursively.
+	SkipSubmodules bool
+
+	// SparseCheckoutPaths is the list of paths of the repository to check out,
+	// relative to its root. When set, the rest of the repository is not checked
+	// out. The context dir is checked out as well when it is not under one of
+	// these paths.
+	SparseCheckoutPaths []string
 }
 
 // SourceControlUser defines the identity of a user of source control
@@ -355,12 +379,13 @@ type CustomBuildStrategy struct {
 	// registries
 	PullSecret *kapi.LocalObjectReference
 
-	// Env contains additional environment variables you want to pass into a builder container
+	// Env contains additional environment variables you want to pass into a builder container.
+	// The value of a variable can come from a key of a Secret or a ConfigMap with valueFrom.
 	Env []kapi.EnvVar
 
 	// ExposeDockerSocket will allow running Docker commands (and build Docker images) from
-	// inside the Docker container.
-	// TODO: Allow admins to enforce 'false' for this option
+	// inside the Docker container. Exposing the socket requires permission to create
+	// docker builds.
 	ExposeDockerSocket bool
 
 	// ForcePull describes if the controller should configure the build pod to always pull the images
@@ -390,7 +415,8 @@ type DockerBuildStrategy struct {
 	// --no-cache=true flag
 	NoCache bool
 
-	// Env contains additional environment variables you want to pass into a builder container
+	// Env contains additional environment variables you want to pass into a builder container.
+	// The value of a variable can come from a key of a Secret or a ConfigMap with valueFrom.
 	Env []kapi.EnvVar
 
 	// ForcePull describes if the builder should pull the images from registry prior to building.
@@ -412,7 +438,8 @@ type SourceBuildStrategy struct {
 	// registries
 	PullSecret *kapi.LocalObjectReference
 
-	// Env contains additional environment variables you want to pass into a builder container
+	// Env contains additional environment variables you want to pass into a builder container.
+	// The value of a variable can come from a key of a Secret or a ConfigMap with valueFrom.
 	Env []kapi.EnvVar
 
 	// Scripts is the location of Source scripts
@@ -423,6 +450,13 @@ type SourceBuildStrategy struct {
 
 	// ForcePull describes if the builder should pull the images from registry prior to building.
 	ForcePull bool
+
+	// ArtifactCache is a reference to a PersistentVolumeClaim mounted into the
+	// build pod to keep the artifacts of the builds of the BuildConfig, such as
+	// the dependencies downloaded by Maven or npm, across builds. The artifacts
+	// saved by the save-artifacts script of the last build are restored into
+	// /tmp/artifacts before the assemble script is run.
+	ArtifactCache *kapi.LocalObjectReference
 }
 
 // A BuildPostCommitSpec holds a build post commit hook specification. The hook
@@ -539,6 +573,12 @@ const (
 	// BuildConfigPausedAnnotation is an annotation that marks a BuildConfig as paused.
 	// New Builds cannot be instantiated from a paused BuildConfig.
 	BuildConfigPausedAnnotation = "openshift.io/build-config.paused"
+	// BuildRunPolicyLabel is the key of a Build label whose value is the RunPolicy of the
+	// BuildConfig the Build was created from.
+	BuildRunPolicyLabel = "openshift.io/build.start-policy"
+	// BuildAcceptedAnnotation is an annotation updated on a queued Build when the Build
+	// preceding it completes, so it is reconsidered for execution.
+	BuildAcceptedAnnotation = "build.openshift.io/accepted"
 )
 
 // BuildConfig is a template which can be used to create new builds.
@@ -561,8 +601,41 @@ type BuildConfigSpec struct {
 
 	// BuildSpec is the desired build specification
 	BuildSpec
+
+	// SuccessfulBuildsHistoryLimit is the number of old successful builds of the
+	// BuildConfig to retain. Older completed builds are deleted when a build of the
+	// BuildConfig completes. If not set, all the successful builds are retained.
+	SuccessfulBuildsHistoryLimit *int
+
+	// FailedBuildsHistoryLimit is the number of old failed, errored or cancelled builds
+	// of the BuildConfig to retain. Older ones are deleted when a build of the
+	// BuildConfig completes. If not set, all the failed builds are retained.
+	FailedBuildsHistoryLimit *int
+
+	// RunPolicy describes how the new builds created from this BuildConfig are
+	// scheduled for execution. Defaults to Serial.
+	RunPolicy BuildRunPolicy
 }
 
+// BuildRunPolicy defines the behaviour of how the new builds are executed
+// from the existing build configuration.
+type BuildRunPolicy string
+
+const (
+	// BuildRunPolicyParallel schedules new builds immediately after they are
+	// created. Builds will be executed in parallel.
+	BuildRunPolicyParallel BuildRunPolicy = "Parallel"
+
+	// BuildRunPolicySerial schedules new builds to execute in a sequence as
+	// they are created. Every build gets queued up and will execute when the
+	// previous build completes.
+	BuildRunPolicySerial BuildRunPolicy = "Serial"
+
+	// BuildRunPolicySerialLatestOnly schedules only the latest build to execute,
+	// cancelling all the previously queued builds.
+	BuildRunPolicySerialLatestOnly BuildRunPolicy = "SerialLatestOnly"
+)
+
 // BuildConfigStatus contains current state of the build config object.
 type BuildConfigStatus struct {
 	// LastVersion is used to inform about number of last triggered build.
@@ -573,6 +646,16 @@ type BuildConfigStatus struct {
 type WebHookTrigger struct {
 	// Secret used to validate requests.
 	Secret string
+
+	// RefFilter is a regular expression which the branch or tag name of a pushed ref
+	// must fully match for the event to trigger a build. When set, it replaces
+	// matching the pushed ref against the ref of the build source.
+	RefFilter string
+
+	// PathFilter is a glob pattern which at least one file changed by a push must
+	// match for the event to trigger a build. A pattern matching a directory matches
+	// every file beneath it. Only applies to events that list the changed files.
+	PathFilter string
 }
 
 // ImageChangeTrigger allows builds to be triggered when an ImageStream changes
@@ -581,10 +664,12 @@ type ImageChangeTrigger struct {
 	// used image ID for build
 	LastTriggeredImageID string
 
-	// From is a reference to an ImageStreamTag that will trigger a build when updated
-	// It is optional. If no From is specified, the From image from the build strategy
+	// From is a reference to an ImageStreamTag that will trigger a build when updated, or
+	// to a BuildConfig whose output ImageStreamTag will trigger a build when updated, to chain
+	// builds. It is optional. If no From is specified, the From image from the build strategy
 	// will be used. Only one ImageChangeTrigger with an empty From reference is allowed in
-	// a build configuration.
+	// a build configuration. The tag of an ImageStreamTag reference may be a glob pattern
+	// such as "release-*", in which case an update of any matching tag triggers a build.
 	From *kapi.ObjectReference
 }
 
@@ -599,6 +684,12 @@ type BuildTriggerPolicy struct {
 	// GenericWebHook contains the parameters for a Generic webhook type of trigger
 	GenericWebHook *WebHookTrigger
 
+	// GitLabWebHook contains the parameters for a GitLab webhook type of trigger
+	GitLabWebHook *WebHookTrigger
+
+	// BitbucketWebHook contains the parameters for a Bitbucket webhook type of trigger
+	BitbucketWebHook *WebHookTrigger
+
 	// ImageChange contains parameters for an ImageChange type of trigger
 	ImageChange *ImageChangeTrigger
 }
@@ -610,6 +701,8 @@ type BuildTriggerType string
 var KnownTriggerTypes = sets.NewString(
 	string(GitHubWebHookBuildTriggerType),
 	string(GenericWebHookBuildTriggerType),
+	string(GitLabWebHookBuildTriggerType),
+	string(BitbucketWebHookBuildTriggerType),
 	string(ImageChangeBuildTriggerType),
 	string(ConfigChangeBuildTriggerType),
 )
@@ -625,6 +718,14 @@ const (
 	GenericWebHookBuildTriggerType           BuildTriggerType = "Generic"
 	GenericWebHookBuildTriggerTypeDeprecated BuildTriggerType = "generic"
 
+	// GitLabWebHookBuildTriggerType represents a trigger that launches builds on
+	// GitLab webhook invocations
+	GitLabWebHookBuildTriggerType BuildTriggerType = "GitLab"
+
+	// BitbucketWebHookBuildTriggerType represents a trigger that launches builds on
+	// Bitbucket webhook invocations
+	BitbucketWebHookBuildTriggerType BuildTriggerType = "Bitbucket"
+
 	// ImageChangeBuildTriggerType represents a trigger that launches builds on
 	// availability of a new version of an image
 	ImageChangeBuildTriggerType           BuildTriggerType = "ImageChange"
@@ -774,8 +875,31 @@ type BuildLogOptions struct {
 
 	// Version of the build for which to view logs.
 	Version *int64
+
+	// OffsetBytes, if set, is the number of bytes at the start of the build log to skip.
+	// It allows an interrupted log stream to be resumed. Only one of offsetBytes or
+	// offsetLines may be specified.
+	OffsetBytes *int64
+	// OffsetLines, if set, is the number of lines at the start of the build log to skip.
+	// It allows an interrupted log stream to be resumed. Only one of offsetBytes or
+	// offsetLines may be specified.
+	OffsetLines *int64
+	// Format of the returned build log, either "text" (the default) or "json". The json
+	// format returns one JSON object per line, annotated with the build step that
+	// produced it.
+	Format BuildLogFormat
 }
 
+// BuildLogFormat is the format in which a build log is returned
+type BuildLogFormat string
+
+const (
+	// BuildLogFormatText returns the build log as plain text
+	BuildLogFormatText BuildLogFormat = "text"
+	// BuildLogFormatJSON returns the build log as one JSON object per line
+	BuildLogFormatJSON BuildLogFormat = "json"
+)
+
 // SecretSpec specifies a secret to be included in a build pod and its corresponding mount point
 type SecretSpec struct {
 	// SecretSource is a reference to the secret
@@ -240,6 +240,11 @@ func addConversionFuncs(scheme *runtime.Scheme) {
 				obj.ImageChange = &ImageChangeTrigger{}
 			}
 		},
+		func(obj *BuildConfigSpec) {
+			if len(obj.RunPolicy) == 0 {
+				obj.RunPolicy = BuildRunPolicySerial
+			}
+		},
 	)
 	if err != nil {
 		panic(err)
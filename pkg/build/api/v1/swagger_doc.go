@@ -63,8 +63,11 @@ func (BuildConfigList) SwaggerDoc() map[string]string {
 }
 
 var map_BuildConfigSpec = map[string]string{
-	"":         "BuildConfigSpec describes when and how builds are created",
-	"triggers": "Triggers determine how new Builds can be launched from a BuildConfig. If no triggers are defined, a new build can only occur as a result of an explicit client build creation.",
+	"":                             "BuildConfigSpec describes when and how builds are created",
+	"triggers":                     "Triggers determine how new Builds can be launched from a BuildConfig. If no triggers are defined, a new build can only occur as a result of an explicit client build creation.",
+	"successfulBuildsHistoryLimit": "SuccessfulBuildsHistoryLimit is the number of old successful builds of the BuildConfig to retain. Older completed builds are deleted when a build of the BuildConfig completes. If not set, all the successful builds are retained.",
+	"failedBuildsHistoryLimit":     "FailedBuildsHistoryLimit is the number of old failed, errored or cancelled builds of the BuildConfig to retain. Older ones are deleted when a build of the BuildConfig completes. If not set, all the failed builds are retained.",
+	"runPolicy":                    "RunPolicy describes how the new builds created from this BuildConfig are scheduled for execution. Defaults to Serial.",
 }
 
 func (BuildConfigSpec) SwaggerDoc() map[string]string {
@@ -110,6 +113,9 @@ var map_BuildLogOptions = map[string]string{
 	"limitBytes":   "If set, the number of bytes to read from the server before terminating the log output. This may not display a complete final line of logging, and may return slightly more or slightly less than the specified limit.",
 	"nowait":       "NoWait if true causes the call to return immediately even if the build is not available yet. Otherwise the server will wait until the build has started.",
 	"version":      "Version of the build for which to view logs.",
+	"offsetBytes":  "OffsetBytes, if set, is the number of bytes at the start of the build log to skip. It allows an interrupted log stream to be resumed. Only one of offsetBytes or offsetLines may be specified.",
+	"offsetLines":  "OffsetLines, if set, is the number of lines at the start of the build log to skip. It allows an interrupted log stream to be resumed. Only one of offsetBytes or offsetLines may be specified.",
+	"format":       "Format of the returned build log, either \"text\" (the default) or \"json\". The json format returns one JSON object per line, annotated with the build step that produced it.",
 }
 
 func (BuildLogOptions) SwaggerDoc() map[string]string {
@@ -176,6 +182,7 @@ var map_BuildSpec = map[string]string{
 	"strategy":                  "Strategy defines how to perform a build.",
 	"output":                    "Output describes the Docker image the Strategy should produce.",
 	"resources":                 "Compute resource requirements to execute the build",
+	"nodeSelector":              "NodeSelector is a selector which must be true for the build pod to fit on a node. If empty, the default build node selector of the cluster, if any, is used.",
 	"postCommit":                "PostCommit is a build hook executed after the build output image is committed, before it is pushed to a registry.",
 	"completionDeadlineSeconds": "Optional duration in seconds, counted from the time when a build pod gets scheduled in the system, that the build may be active on a node before the system actively tries to terminate the build; value must be positive integer",
 }
@@ -218,6 +225,8 @@ var map_BuildTriggerPolicy = map[string]string{
 	"type":        "Type is the type of build trigger",
 	"github":      "GitHubWebHook contains the parameters for a GitHub webhook type of trigger",
 	"generic":     "GenericWebHook contains the parameters for a Generic webhook type of trigger",
+	"gitlab":      "GitLabWebHook contains the parameters for a GitLab webhook type of trigger",
+	"bitbucket":   "BitbucketWebHook contains the parameters for a Bitbucket webhook type of trigger",
 	"imageChange": "ImageChange contains parameters for an ImageChange type of trigger",
 }
 
@@ -229,8 +238,8 @@ var map_CustomBuildStrategy = map[string]string{
 	"":                   "CustomBuildStrategy defines input parameters specific to Custom build.",
 	"from":               "From is reference to an DockerImage, ImageStreamTag, or ImageStreamImage from which the docker image should be pulled",
 	"pullSecret":         "PullSecret is the name of a Secret that would be used for setting up the authentication for pulling the Docker images from the private Docker registries",
-	"env":                "Env contains additional environment variables you want to pass into a builder container",
-	"exposeDockerSocket": "ExposeDockerSocket will allow running Docker commands (and build Docker images) from inside the Docker container.",
+	"env":                "Env contains additional environment variables you want to pass into a builder container. The value of a variable can come from a key of a Secret or a ConfigMap with valueFrom.",
+	"exposeDockerSocket": "ExposeDockerSocket will allow running Docker commands (and build Docker images) from inside the Docker container. Exposing the socket requires permission to create docker builds.",
 	"forcePull":          "ForcePull describes if the controller should configure the build pod to always pull the images for the builder or only pull if it is not present locally",
 	"secrets":            "Secrets is a list of additional secrets that will be included in the build pod",
 	"buildAPIVersion":    "BuildAPIVersion is the requested API version for the Build object serialized and passed to the custom builder",
@@ -245,7 +254,7 @@ var map_DockerBuildStrategy = map[string]string{
 	"from":           "From is reference to an DockerImage, ImageStreamTag, or ImageStreamImage from which the docker image should be pulled the resulting image will be used in the FROM line of the Dockerfile for this build.",
 	"pullSecret":     "PullSecret is the name of a Secret that would be used for setting up the authentication for pulling the Docker images from the private Docker registries",
 	"noCache":        "NoCache if set to true indicates that the docker build must be executed with the --no-cache=true flag",
-	"env":            "Env contains additional environment variables you want to pass into a builder container",
+	"env":            "Env contains additional environment variables you want to pass into a builder container. The value of a variable can come from a key of a Secret or a ConfigMap with valueFrom.",
 	"forcePull":      "ForcePull describes if the builder should pull the images from registry prior to building.",
 	"dockerfilePath": "DockerfilePath is the path of the Dockerfile that will be used to build the Docker image, relative to the root of the context (contextDir).",
 }
@@ -265,11 +274,14 @@ func (GenericWebHookEvent) SwaggerDoc() map[string]string {
 }
 
 var map_GitBuildSource = map[string]string{
-	"":           "GitBuildSource defines the parameters of a Git SCM",
-	"uri":        "URI points to the source that will be built. The structure of the source will depend on the type of build to run",
-	"ref":        "Ref is the branch/tag/ref to build.",
-	"httpProxy":  "HTTPProxy is a proxy used to reach the git repository over http",
-	"httpsProxy": "HTTPSProxy is a proxy used to reach the git repository over https",
+	"":                    "GitBuildSource defines the parameters of a Git SCM",
+	"uri":                 "URI points to the source that will be built. The structure of the source will depend on the type of build to run",
+	"ref":                 "Ref is the branch/tag/ref to build.",
+	"httpProxy":           "HTTPProxy is a proxy used to reach the git repository over http",
+	"httpsProxy":          "HTTPSProxy is a proxy used to reach the git repository over https",
+	"noProxy":             "NoProxy is the list of domains for which the proxy should not be used",
+	"skipSubmodules":      "SkipSubmodules disables the initialization and update of the submodules of the repository, which are otherwise checked out recursively.",
+	"sparseCheckoutPaths": "SparseCheckoutPaths is the list of paths of the repository to check out, relative to its root. When set, the rest of the repository is not checked out. The context dir is checked out as well when it is not under one of these paths.",
 }
 
 func (GitBuildSource) SwaggerDoc() map[string]string {
@@ -299,7 +311,7 @@ func (GitSourceRevision) SwaggerDoc() map[string]string {
 var map_ImageChangeTrigger = map[string]string{
 	"": "ImageChangeTrigger allows builds to be triggered when an ImageStream changes",
 	"lastTriggeredImageID": "LastTriggeredImageID is used internally by the ImageChangeController to save last used image ID for build",
-	"from":                 "From is a reference to an ImageStreamTag that will trigger a build when updated It is optional. If no From is specified, the From image from the build strategy will be used. Only one ImageChangeTrigger with an empty From reference is allowed in a build configuration.",
+	"from":                 "From is a reference to an ImageStreamTag that will trigger a build when updated, or to a BuildConfig whose output ImageStreamTag will trigger a build when updated, to chain builds. It is optional. If no From is specified, the From image from the build strategy will be used. Only one ImageChangeTrigger with an empty From reference is allowed in a build configuration. The tag of an ImageStreamTag reference may be a glob pattern such as \"release-*\", in which case an update of any matching tag triggers a build.",
 }
 
 func (ImageChangeTrigger) SwaggerDoc() map[string]string {
@@ -348,13 +360,14 @@ func (SecretSpec) SwaggerDoc() map[string]string {
 }
 
 var map_SourceBuildStrategy = map[string]string{
-	"":            "SourceBuildStrategy defines input parameters specific to an Source build.",
-	"from":        "From is reference to an DockerImage, ImageStreamTag, or ImageStreamImage from which the docker image should be pulled",
-	"pullSecret":  "PullSecret is the name of a Secret that would be used for setting up the authentication for pulling the Docker images from the private Docker registries",
-	"env":         "Env contains additional environment variables you want to pass into a builder container",
-	"scripts":     "Scripts is the location of Source scripts",
-	"incremental": "Incremental flag forces the Source build to do incremental builds if true.",
-	"forcePull":   "ForcePull describes if the builder should pull the images from registry prior to building.",
+	"":              "SourceBuildStrategy defines input parameters specific to an Source build.",
+	"from":          "From is reference to an DockerImage, ImageStreamTag, or ImageStreamImage from which the docker image should be pulled",
+	"pullSecret":    "PullSecret is the name of a Secret that would be used for setting up the authentication for pulling the Docker images from the private Docker registries",
+	"env":           "Env contains additional environment variables you want to pass into a builder container. The value of a variable can come from a key of a Secret or a ConfigMap with valueFrom.",
+	"scripts":       "Scripts is the location of Source scripts",
+	"incremental":   "Incremental flag forces the Source build to do incremental builds if true.",
+	"forcePull":     "ForcePull describes if the builder should pull the images from registry prior to building.",
+	"artifactCache": "ArtifactCache is a reference to a PersistentVolumeClaim mounted into the build pod to keep the artifacts of the builds of the BuildConfig, such as the dependencies downloaded by Maven or npm, across builds. The artifacts saved by the save-artifacts script of the last build are restored into /tmp/artifacts before the assemble script is run.",
 }
 
 func (SourceBuildStrategy) SwaggerDoc() map[string]string {
@@ -382,8 +395,10 @@ func (SourceRevision) SwaggerDoc() map[string]string {
 }
 
 var map_WebHookTrigger = map[string]string{
-	"":       "WebHookTrigger is a trigger that gets invoked using a webhook type of post",
-	"secret": "Secret used to validate requests.",
+	"":           "WebHookTrigger is a trigger that gets invoked using a webhook type of post",
+	"secret":     "Secret used to validate requests.",
+	"refFilter":  "RefFilter is a regular expression which the branch or tag name of a pushed ref must fully match for the event to trigger a build. When set, it replaces matching the pushed ref against the ref of the build source.",
+	"pathFilter": "PathFilter is a glob pattern which at least one file changed by a push must match for the event to trigger a build. A pattern matching a directory matches every file beneath it. Only applies to events that list the changed files.",
 }
 
 func (WebHookTrigger) SwaggerDoc() map[string]string {
@@ -43,6 +43,10 @@ type BuildSpec struct {
 	// Compute resource requirements to execute the build
 	Resources kapi.ResourceRequirements `json:"resources,omitempty"`
 
+	// NodeSelector is a selector which must be true for the build pod to fit on a node.
+	// If empty, the default build node selector of the cluster, if any, is used.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
 	// PostCommit is a build hook executed after the build output image is
 	// committed, before it is pushed to a registry.
 	PostCommit BuildPostCommitSpec `json:"postCommit,omitempty"`
@@ -269,6 +273,19 @@ type GitBuildSource struct {
 
 	// HTTPSProxy is a proxy used to reach the git repository over https
 	HTTPSProxy *string `json:"httpsProxy,omitempty"`
+
+	// NoProxy is the list of domains for which the proxy should not be used
+	NoProxy *string `json:"noProxy,omitempty"`
+
+	// SkipSubmodules disables the initialization and update of the submodules
+	// of the repository, which are otherwise checked out recursively.
+	SkipSubmodules bool `json:"skipSubmodules,omitempty"`
+
+	// SparseCheckoutPaths is the list of paths of the repository to check out,
+	// relative to its root. When set, the rest of the repository is not checked
+	// out. The context dir is checked out as well when it is not under one of
+	// these paths.
+	SparseCheckoutPaths []string `json:"sparseCheckoutPaths,omitempty"`
 }
 
 // SourceControlUser defines the identity of a user of source control
@@ -323,8 +340,8 @@ type CustomBuildStrategy struct {
 	Env []kapi.EnvVar `json:"env,omitempty"`
 
 	// ExposeDockerSocket will allow running Docker commands (and build Docker images) from
-	// inside the Docker container.
-	// TODO: Allow admins to enforce 'false' for this option
+	// inside the Docker container. Exposing the socket requires permission to create
+	// docker builds.
 	ExposeDockerSocket bool `json:"exposeDockerSocket,omitempty"`
 
 	// ForcePull describes if the controller should configure the build pod to always pull the images
@@ -387,6 +404,13 @@ type SourceBuildStrategy struct {
 
 	// ForcePull describes if the builder should pull the images from registry prior to building.
 	ForcePull bool `json:"forcePull,omitempty"`
+
+	// ArtifactCache is a reference to a PersistentVolumeClaim mounted into the
+	// build pod to keep the artifacts of the builds of the BuildConfig, such as
+	// the dependencies downloaded by Maven or npm, across builds. The artifacts
+	// saved by the save-artifacts script of the last build are restored into
+	// /tmp/artifacts before the assemble script is run.
+	ArtifactCache *kapi.LocalObjectReference `json:"artifactCache,omitempty"`
 }
 
 // A BuildPostCommitSpec holds a build post commit hook specification. The hook
@@ -511,8 +535,41 @@ type BuildConfigSpec struct {
 	Triggers []BuildTriggerPolicy `json:"triggers"`
 
 	BuildSpec `json:",inline"`
+
+	// SuccessfulBuildsHistoryLimit is the number of old successful builds of the
+	// BuildConfig to retain. Older completed builds are deleted when a build of the
+	// BuildConfig completes. If not set, all the successful builds are retained.
+	SuccessfulBuildsHistoryLimit *int `json:"successfulBuildsHistoryLimit,omitempty"`
+
+	// FailedBuildsHistoryLimit is the number of old failed, errored or cancelled builds
+	// of the BuildConfig to retain. Older ones are deleted when a build of the
+	// BuildConfig completes. If not set, all the failed builds are retained.
+	FailedBuildsHistoryLimit *int `json:"failedBuildsHistoryLimit,omitempty"`
+
+	// RunPolicy describes how the new builds created from this BuildConfig are
+	// scheduled for execution. Defaults to Serial.
+	RunPolicy BuildRunPolicy `json:"runPolicy,omitempty"`
 }
 
+// BuildRunPolicy defines the behaviour of how the new builds are executed
+// from the existing build configuration.
+type BuildRunPolicy string
+
+const (
+	// BuildRunPolicyParallel schedules new builds immediately after they are
+	// created. Builds will be executed in parallel.
+	BuildRunPolicyParallel BuildRunPolicy = "Parallel"
+
+	// BuildRunPolicySerial schedules new builds to execute in a sequence as
+	// they are created. Every build gets queued up and will execute when the
+	// previous build completes.
+	BuildRunPolicySerial BuildRunPolicy = "Serial"
+
+	// BuildRunPolicySerialLatestOnly schedules only the latest build to execute,
+	// cancelling all the previously queued builds.
+	BuildRunPolicySerialLatestOnly BuildRunPolicy = "SerialLatestOnly"
+)
+
 // BuildConfigStatus contains current state of the build config object.
 type BuildConfigStatus struct {
 	// LastVersion is used to inform about number of last triggered build.
@@ -523,6 +580,16 @@ type BuildConfigStatus struct {
 type WebHookTrigger struct {
 	// Secret used to validate requests.
 	Secret string `json:"secret,omitempty"`
+
+	// RefFilter is a regular expression which the branch or tag name of a pushed ref
+	// must fully match for the event to trigger a build. When set, it replaces
+	// matching the pushed ref against the ref of the build source.
+	RefFilter string `json:"refFilter,omitempty"`
+
+	// PathFilter is a glob pattern which at least one file changed by a push must
+	// match for the event to trigger a build. A pattern matching a directory matches
+	// every file beneath it. Only applies to events that list the changed files.
+	PathFilter string `json:"pathFilter,omitempty"`
 }
 
 // ImageChangeTrigger allows builds to be triggered when an ImageStream changes
@@ -531,10 +598,12 @@ type ImageChangeTrigger struct {
 	// used image ID for build
 	LastTriggeredImageID string `json:"lastTriggeredImageID,omitempty"`
 
-	// From is a reference to an ImageStreamTag that will trigger a build when updated
-	// It is optional. If no From is specified, the From image from the build strategy
+	// From is a reference to an ImageStreamTag that will trigger a build when updated, or
+	// to a BuildConfig whose output ImageStreamTag will trigger a build when updated, to chain
+	// builds. It is optional. If no From is specified, the From image from the build strategy
 	// will be used. Only one ImageChangeTrigger with an empty From reference is allowed in
-	// a build configuration.
+	// a build configuration. The tag of an ImageStreamTag reference may be a glob pattern
+	// such as "release-*", in which case an update of any matching tag triggers a build.
 	From *kapi.ObjectReference `json:"from,omitempty"`
 }
 
@@ -549,6 +618,12 @@ type BuildTriggerPolicy struct {
 	// GenericWebHook contains the parameters for a Generic webhook type of trigger
 	GenericWebHook *WebHookTrigger `json:"generic,omitempty"`
 
+	// GitLabWebHook contains the parameters for a GitLab webhook type of trigger
+	GitLabWebHook *WebHookTrigger `json:"gitlab,omitempty"`
+
+	// BitbucketWebHook contains the parameters for a Bitbucket webhook type of trigger
+	BitbucketWebHook *WebHookTrigger `json:"bitbucket,omitempty"`
+
 	// ImageChange contains parameters for an ImageChange type of trigger
 	ImageChange *ImageChangeTrigger `json:"imageChange,omitempty"`
 }
@@ -565,6 +640,14 @@ const (
 	// generic webhook invocations
 	GenericWebHookBuildTriggerType BuildTriggerType = "generic"
 
+	// GitLabWebHookBuildTriggerType represents a trigger that launches builds on
+	// GitLab webhook invocations
+	GitLabWebHookBuildTriggerType BuildTriggerType = "gitlab"
+
+	// BitbucketWebHookBuildTriggerType represents a trigger that launches builds on
+	// Bitbucket webhook invocations
+	BitbucketWebHookBuildTriggerType BuildTriggerType = "bitbucket"
+
 	// ImageChangeBuildTriggerType represents a trigger that launches builds on
 	// availability of a new version of an image
 	ImageChangeBuildTriggerType BuildTriggerType = "imageChange"
@@ -697,8 +780,31 @@ type BuildLogOptions struct {
 
 	// Version of the build for which to view logs.
 	Version *int64 `json:"version,omitempty"`
+
+	// OffsetBytes, if set, is the number of bytes at the start of the build log to skip.
+	// It allows an interrupted log stream to be resumed. Only one of offsetBytes or
+	// offsetLines may be specified.
+	OffsetBytes *int64 `json:"offsetBytes,omitempty"`
+	// OffsetLines, if set, is the number of lines at the start of the build log to skip.
+	// It allows an interrupted log stream to be resumed. Only one of offsetBytes or
+	// offsetLines may be specified.
+	OffsetLines *int64 `json:"offsetLines,omitempty"`
+	// Format of the returned build log, either "text" (the default) or "json". The json
+	// format returns one JSON object per line, annotated with the build step that
+	// produced it.
+	Format BuildLogFormat `json:"format,omitempty"`
 }
 
+// BuildLogFormat is the format in which a build log is returned
+type BuildLogFormat string
+
+const (
+	// BuildLogFormatText returns the build log as plain text
+	BuildLogFormatText BuildLogFormat = "text"
+	// BuildLogFormatJSON returns the build log as one JSON object per line
+	BuildLogFormatJSON BuildLogFormat = "json"
+)
+
 // SecretSpec specifies a secret to be included in a build pod and its corresponding mount point
 type SecretSpec struct {
 	// SecretSource is a reference to the secret
@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"path"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	kapi "k8s.io/kubernetes/pkg/api"
@@ -45,7 +46,7 @@ func ValidateBuildUpdate(build *buildapi.Build, older *buildapi.Build) field.Err
 // refKey returns a key for the given ObjectReference. If the ObjectReference
 // doesn't include a namespace, the passed in namespace is used for the reference
 func refKey(namespace string, ref *kapi.ObjectReference) string {
-	if ref == nil || ref.Kind != "ImageStreamTag" {
+	if ref == nil || (ref.Kind != "ImageStreamTag" && ref.Kind != "BuildConfig") {
 		return "nil"
 	}
 	ns := ref.Namespace
@@ -74,6 +75,9 @@ func ValidateBuildConfig(config *buildapi.BuildConfig) field.ErrorList {
 		if from == nil {
 			from = buildFrom
 		}
+		if from != nil && from.Kind == "BuildConfig" && from.Name == config.Name && (len(from.Namespace) == 0 || from.Namespace == config.Namespace) {
+			allErrs = append(allErrs, field.Invalid(triggersPath.Index(i).Child("imageChange", "from", "name"), from.Name, "an ImageChange trigger can't reference its own BuildConfig"))
+		}
 		fromKey := refKey(config.Namespace, from)
 		_, exists := fromRefs[fromKey]
 		if exists {
@@ -84,6 +88,21 @@ func ValidateBuildConfig(config *buildapi.BuildConfig) field.ErrorList {
 
 	allErrs = append(allErrs, validateBuildSpec(&config.Spec.BuildSpec, specPath)...)
 
+	if config.Spec.SuccessfulBuildsHistoryLimit != nil {
+		allErrs = append(allErrs, validation.ValidateNonnegativeField(int64(*config.Spec.SuccessfulBuildsHistoryLimit), specPath.Child("successfulBuildsHistoryLimit"))...)
+	}
+	if config.Spec.FailedBuildsHistoryLimit != nil {
+		allErrs = append(allErrs, validation.ValidateNonnegativeField(int64(*config.Spec.FailedBuildsHistoryLimit), specPath.Child("failedBuildsHistoryLimit"))...)
+	}
+
+	switch config.Spec.RunPolicy {
+	case "", buildapi.BuildRunPolicyParallel, buildapi.BuildRunPolicySerial, buildapi.BuildRunPolicySerialLatestOnly:
+	default:
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("runPolicy"), config.Spec.RunPolicy, []string{
+			string(buildapi.BuildRunPolicyParallel), string(buildapi.BuildRunPolicySerial), string(buildapi.BuildRunPolicySerialLatestOnly),
+		}))
+	}
+
 	return allErrs
 }
 
@@ -118,8 +137,9 @@ func validateBuildSpec(spec *buildapi.BuildSpec, fldPath *field.Path) field.Erro
 	allErrs = append(allErrs, validateOutput(&spec.Output, fldPath.Child("output"))...)
 	allErrs = append(allErrs, validateStrategy(&spec.Strategy, fldPath.Child("strategy"))...)
 	allErrs = append(allErrs, validatePostCommit(spec.PostCommit, fldPath.Child("postCommit"))...)
+	allErrs = append(allErrs, validation.ValidateResourceRequirements(&spec.Resources, fldPath.Child("resources"))...)
+	allErrs = append(allErrs, validation.ValidateLabels(spec.NodeSelector, fldPath.Child("nodeSelector"))...)
 
-	// TODO: validate resource requirements (prereq: https://github.com/kubernetes/kubernetes/pull/7059)
 	return allErrs
 }
 
@@ -217,6 +237,14 @@ func validateGitSource(git *buildapi.GitBuildSource, fldPath *field.Path) field.
 	if hasProxy(git) && !isHTTPScheme(git.URI) {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("uri"), git.URI, "only http:// and https:// GIT protocols are allowed with HTTP or HTTPS proxy set"))
 	}
+	for i, p := range git.SparseCheckoutPaths {
+		switch {
+		case len(p) == 0:
+			allErrs = append(allErrs, field.Required(fldPath.Child("sparseCheckoutPaths").Index(i), ""))
+		case path.IsAbs(p) || strings.HasPrefix(path.Clean(p), ".."):
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("sparseCheckoutPaths").Index(i), p, "sparse checkout paths must be relative to the root of the repository"))
+		}
+	}
 	return allErrs
 }
 
@@ -357,6 +385,21 @@ func validateFromImageReference(reference *kapi.ObjectReference, fldPath *field.
 	return allErrs
 }
 
+// validateBuildConfigReference validates the reference of an ImageChange
+// trigger to the BuildConfig whose output it watches.
+func validateBuildConfigReference(reference *kapi.ObjectReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(reference.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), ""))
+	} else if ok, msg := validation.NameIsDNSSubdomain(reference.Name, false); !ok {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), reference.Name, msg))
+	}
+	if len(reference.Namespace) != 0 && !kvalidation.IsDNS1123Subdomain(reference.Namespace) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("namespace"), reference.Namespace, "namespace must be a valid subdomain"))
+	}
+	return allErrs
+}
+
 func validateOutput(output *buildapi.BuildOutput, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -434,6 +477,7 @@ func validateSourceStrategy(strategy *buildapi.SourceBuildStrategy, fldPath *fie
 	allErrs = append(allErrs, validateFromImageReference(&strategy.From, fldPath.Child("from"))...)
 	allErrs = append(allErrs, validateSecretRef(strategy.PullSecret, fldPath.Child("pullSecret"))...)
 	allErrs = append(allErrs, ValidateStrategyEnv(strategy.Env, fldPath.Child("env"))...)
+	allErrs = append(allErrs, validateSecretRef(strategy.ArtifactCache, fldPath.Child("artifactCache"))...)
 	return allErrs
 }
 
@@ -466,6 +510,18 @@ func validateTrigger(trigger *buildapi.BuildTriggerPolicy, buildFrom *kapi.Objec
 		} else {
 			allErrs = append(allErrs, validateWebHook(trigger.GenericWebHook, fldPath.Child("generic"))...)
 		}
+	case buildapi.GitLabWebHookBuildTriggerType:
+		if trigger.GitLabWebHook == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("gitlab"), ""))
+		} else {
+			allErrs = append(allErrs, validateWebHook(trigger.GitLabWebHook, fldPath.Child("gitlab"))...)
+		}
+	case buildapi.BitbucketWebHookBuildTriggerType:
+		if trigger.BitbucketWebHook == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("bitbucket"), ""))
+		} else {
+			allErrs = append(allErrs, validateWebHook(trigger.BitbucketWebHook, fldPath.Child("bitbucket"))...)
+		}
 	case buildapi.ImageChangeBuildTriggerType:
 		if trigger.ImageChange == nil {
 			allErrs = append(allErrs, field.Required(fldPath.Child("imageChange"), ""))
@@ -483,15 +539,23 @@ func validateTrigger(trigger *buildapi.BuildTriggerPolicy, buildFrom *kapi.Objec
 
 			break
 		}
-		if kind := trigger.ImageChange.From.Kind; kind != "ImageStreamTag" {
+		switch kind := trigger.ImageChange.From.Kind; kind {
+		case "ImageStreamTag":
+			allErrs = append(allErrs, validateFromImageReference(trigger.ImageChange.From, fldPath.Child("from"))...)
+			if _, tag, ok := imageapi.SplitImageStreamTag(trigger.ImageChange.From.Name); ok && imageapi.IsTagPattern(tag) {
+				if _, err := path.Match(tag, ""); err != nil {
+					allErrs = append(allErrs, field.Invalid(fldPath.Child("imageChange", "from", "name"), trigger.ImageChange.From.Name, fmt.Sprintf("invalid tag pattern: %v", err)))
+				}
+			}
+		case "BuildConfig":
+			allErrs = append(allErrs, validateBuildConfigReference(trigger.ImageChange.From, fldPath.Child("imageChange", "from"))...)
+		default:
 			invalidKindErr := field.Invalid(
 				fldPath.Child("imageChange").Child("from").Child("kind"),
 				kind,
-				"only an ImageStreamTag type of reference is allowed in an ImageChange trigger.")
+				"only an ImageStreamTag or BuildConfig type of reference is allowed in an ImageChange trigger.")
 			allErrs = append(allErrs, invalidKindErr)
-			break
 		}
-		allErrs = append(allErrs, validateFromImageReference(trigger.ImageChange.From, fldPath.Child("from"))...)
 	case buildapi.ConfigChangeBuildTriggerType:
 		// doesn't require additional validation
 	default:
@@ -505,6 +569,16 @@ func validateWebHook(webHook *buildapi.WebHookTrigger, fldPath *field.Path) fiel
 	if len(webHook.Secret) == 0 {
 		allErrs = append(allErrs, field.Required(fldPath.Child("secret"), ""))
 	}
+	if len(webHook.RefFilter) > 0 {
+		if _, err := regexp.Compile(webHook.RefFilter); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("refFilter"), webHook.RefFilter, err.Error()))
+		}
+	}
+	if len(webHook.PathFilter) > 0 {
+		if _, err := path.Match(webHook.PathFilter, ""); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("pathFilter"), webHook.PathFilter, err.Error()))
+		}
+	}
 	return allErrs
 }
 
@@ -528,6 +602,20 @@ func ValidateBuildLogOptions(opts *buildapi.BuildLogOptions) field.ErrorList {
 	if opts.Version != nil && opts.Previous {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("previous"), opts.Previous, "cannot use previous when a version is specified"))
 	}
+	if opts.OffsetBytes != nil && *opts.OffsetBytes < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("offsetBytes"), *opts.OffsetBytes, "must be greater than or equal to 0"))
+	}
+	if opts.OffsetLines != nil && *opts.OffsetLines < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("offsetLines"), *opts.OffsetLines, "must be greater than or equal to 0"))
+	}
+	if opts.OffsetBytes != nil && opts.OffsetLines != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("offsetLines"), *opts.OffsetLines, "cannot use offsetLines when offsetBytes is specified"))
+	}
+	switch opts.Format {
+	case "", buildapi.BuildLogFormatText, buildapi.BuildLogFormatJSON:
+	default:
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("format"), opts.Format, []string{string(buildapi.BuildLogFormatText), string(buildapi.BuildLogFormatJSON)}))
+	}
 	return allErrs
 }
 
@@ -544,7 +632,35 @@ func ValidateStrategyEnv(vars []kapi.EnvVar, fldPath *field.Path) field.ErrorLis
 			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), ev.Name, cIdentifierErrorMsg))
 		}
 		if ev.ValueFrom != nil {
-			allErrs = append(allErrs, field.Invalid(idxPath.Child("valueFrom"), ev.ValueFrom, "valueFrom is not supported in build strategy environment variables"))
+			allErrs = append(allErrs, validateEnvVarSource(ev, idxPath.Child("valueFrom"))...)
+		}
+	}
+	return allErrs
+}
+
+// validateEnvVarSource ensures the value of a build strategy environment
+// variable comes from a key of a Secret or a ConfigMap.
+func validateEnvVarSource(ev kapi.EnvVar, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	source := ev.ValueFrom
+	switch {
+	case len(ev.Value) != 0:
+		allErrs = append(allErrs, field.Invalid(fldPath, "", "may not be specified when value is not empty"))
+	case source.FieldRef != nil || (source.SecretKeyRef == nil) == (source.ConfigMapKeyRef == nil):
+		allErrs = append(allErrs, field.Invalid(fldPath, source, "only one of secretKeyRef or configMapKeyRef is supported in build strategy environment variables"))
+	case source.SecretKeyRef != nil:
+		if len(source.SecretKeyRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("secretKeyRef", "name"), ""))
+		}
+		if len(source.SecretKeyRef.Key) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("secretKeyRef", "key"), ""))
+		}
+	case source.ConfigMapKeyRef != nil:
+		if len(source.ConfigMapKeyRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("configMapKeyRef", "name"), ""))
+		}
+		if len(source.ConfigMapKeyRef.Key) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("configMapKeyRef", "key"), ""))
 		}
 	}
 	return allErrs
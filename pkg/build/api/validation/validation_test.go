@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
@@ -417,6 +418,39 @@ func TestBuildConfigImageChangeTriggers(t *testing.T) {
 			fromKind:    "ImageStreamTag",
 			expectError: false,
 		},
+		{
+			name: "image change trigger with tag pattern",
+			triggers: []buildapi.BuildTriggerPolicy{
+				{
+					Type: buildapi.ImageChangeBuildTriggerType,
+					ImageChange: &buildapi.ImageChangeTrigger{
+						From: &kapi.ObjectReference{
+							Kind: "ImageStreamTag",
+							Name: "myimage:release-*",
+						},
+					},
+				},
+			},
+			fromKind:    "ImageStreamTag",
+			expectError: false,
+		},
+		{
+			name: "image change trigger with invalid tag pattern",
+			triggers: []buildapi.BuildTriggerPolicy{
+				{
+					Type: buildapi.ImageChangeBuildTriggerType,
+					ImageChange: &buildapi.ImageChangeTrigger{
+						From: &kapi.ObjectReference{
+							Kind: "ImageStreamTag",
+							Name: "myimage:release-[",
+						},
+					},
+				},
+			},
+			fromKind:    "ImageStreamTag",
+			expectError: true,
+			errorType:   field.ErrorTypeInvalid,
+		},
 		{
 			name: "invalid reference kind for trigger",
 			triggers: []buildapi.BuildTriggerPolicy{
@@ -635,6 +669,73 @@ func TestBuildConfigValidationOutputFailure(t *testing.T) {
 	}
 }
 
+func TestBuildConfigValidationHistoryLimits(t *testing.T) {
+	newBuildConfig := func(successful, failed int) *buildapi.BuildConfig {
+		return &buildapi.BuildConfig{
+			ObjectMeta: kapi.ObjectMeta{Name: "config-id", Namespace: "namespace"},
+			Spec: buildapi.BuildConfigSpec{
+				BuildSpec: buildapi.BuildSpec{
+					Source: buildapi.BuildSource{
+						Git: &buildapi.GitBuildSource{
+							URI: "http://github.com/my/repository",
+						},
+					},
+					Strategy: buildapi.BuildStrategy{
+						DockerStrategy: &buildapi.DockerBuildStrategy{},
+					},
+				},
+				SuccessfulBuildsHistoryLimit: &successful,
+				FailedBuildsHistoryLimit:     &failed,
+			},
+		}
+	}
+
+	if errs := ValidateBuildConfig(newBuildConfig(0, 2)); len(errs) != 0 {
+		t.Errorf("Unexpected validation errors %v", errs)
+	}
+
+	errs := ValidateBuildConfig(newBuildConfig(-1, -2))
+	if len(errs) != 2 {
+		t.Fatalf("Expected 2 validation errors, got %v", errs)
+	}
+	for i, fieldName := range []string{"spec.successfulBuildsHistoryLimit", "spec.failedBuildsHistoryLimit"} {
+		if errs[i].Type != field.ErrorTypeInvalid || errs[i].Field != fieldName {
+			t.Errorf("Expected an invalid value error for %s, got %v", fieldName, errs[i])
+		}
+	}
+}
+
+func TestBuildConfigValidationRunPolicy(t *testing.T) {
+	config := &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "config-id", Namespace: "namespace"},
+		Spec: buildapi.BuildConfigSpec{
+			BuildSpec: buildapi.BuildSpec{
+				Source: buildapi.BuildSource{
+					Git: &buildapi.GitBuildSource{
+						URI: "http://github.com/my/repository",
+					},
+				},
+				Strategy: buildapi.BuildStrategy{
+					DockerStrategy: &buildapi.DockerBuildStrategy{},
+				},
+			},
+		},
+	}
+
+	for _, policy := range []buildapi.BuildRunPolicy{buildapi.BuildRunPolicyParallel, buildapi.BuildRunPolicySerial, buildapi.BuildRunPolicySerialLatestOnly} {
+		config.Spec.RunPolicy = policy
+		if errs := ValidateBuildConfig(config); len(errs) != 0 {
+			t.Errorf("%s: unexpected validation errors %v", policy, errs)
+		}
+	}
+
+	config.Spec.RunPolicy = "Random"
+	errs := ValidateBuildConfig(config)
+	if len(errs) != 1 || errs[0].Type != field.ErrorTypeNotSupported || errs[0].Field != "spec.runPolicy" {
+		t.Errorf("Expected an unsupported value error for spec.runPolicy, got %v", errs)
+	}
+}
+
 func TestValidateBuildRequest(t *testing.T) {
 	testCases := map[string]*buildapi.BuildRequest{
 		string(field.ErrorTypeRequired) + "metadata.namespace": {ObjectMeta: kapi.ObjectMeta{Name: "requestName"}},
@@ -967,6 +1068,51 @@ func TestValidateSource(t *testing.T) {
 				},
 			},
 		},
+		// 22
+		{
+			ok: true,
+			source: &buildapi.BuildSource{
+				Git: &buildapi.GitBuildSource{
+					URI:                 "https://example.com/repo.git",
+					SkipSubmodules:      true,
+					SparseCheckoutPaths: []string{"app", "lib/common/"},
+				},
+				ContextDir: "app",
+			},
+		},
+		// 23
+		{
+			t:    field.ErrorTypeInvalid,
+			path: "git.sparseCheckoutPaths[1]",
+			source: &buildapi.BuildSource{
+				Git: &buildapi.GitBuildSource{
+					URI:                 "https://example.com/repo.git",
+					SparseCheckoutPaths: []string{"app", "/lib"},
+				},
+			},
+		},
+		// 24
+		{
+			t:    field.ErrorTypeInvalid,
+			path: "git.sparseCheckoutPaths[0]",
+			source: &buildapi.BuildSource{
+				Git: &buildapi.GitBuildSource{
+					URI:                 "https://example.com/repo.git",
+					SparseCheckoutPaths: []string{"app/../../lib"},
+				},
+			},
+		},
+		// 25
+		{
+			t:    field.ErrorTypeRequired,
+			path: "git.sparseCheckoutPaths[0]",
+			source: &buildapi.BuildSource{
+				Git: &buildapi.GitBuildSource{
+					URI:                 "https://example.com/repo.git",
+					SparseCheckoutPaths: []string{""},
+				},
+			},
+		},
 	}
 	for i, tc := range errorCases {
 		errors := validateSource(tc.source, false, false, nil)
@@ -1432,6 +1578,77 @@ func TestValidateBuildSpec(t *testing.T) {
 				},
 			},
 		},
+		// 18
+		// invalid because the artifact cache has no claim name
+		{
+			string(field.ErrorTypeRequired) + "strategy.sourceStrategy.artifactCache.name",
+			&buildapi.BuildSpec{
+				Source: buildapi.BuildSource{
+					Git: &buildapi.GitBuildSource{
+						URI: "http://github.com/my/repository",
+					},
+				},
+				Strategy: buildapi.BuildStrategy{
+					SourceStrategy: &buildapi.SourceBuildStrategy{
+						From:          kapi.ObjectReference{Kind: "DockerImage", Name: "repository/builder"},
+						ArtifactCache: &kapi.LocalObjectReference{},
+					},
+				},
+				Output: buildapi.BuildOutput{
+					To: &kapi.ObjectReference{
+						Kind: "DockerImage",
+						Name: "repository/data",
+					},
+				},
+			},
+		},
+		// 19
+		// invalid because the node selector has an invalid label key
+		{
+			string(field.ErrorTypeInvalid) + "nodeSelector",
+			&buildapi.BuildSpec{
+				Source: buildapi.BuildSource{
+					Git: &buildapi.GitBuildSource{
+						URI: "http://github.com/my/repository",
+					},
+				},
+				Strategy: buildapi.BuildStrategy{
+					DockerStrategy: &buildapi.DockerBuildStrategy{},
+				},
+				Output: buildapi.BuildOutput{
+					To: &kapi.ObjectReference{
+						Kind: "DockerImage",
+						Name: "repository/data",
+					},
+				},
+				NodeSelector: map[string]string{"invalid key": "value"},
+			},
+		},
+		// 20
+		// invalid because the memory limit is lower than the request
+		{
+			string(field.ErrorTypeInvalid) + "resources.limits[memory]",
+			&buildapi.BuildSpec{
+				Source: buildapi.BuildSource{
+					Git: &buildapi.GitBuildSource{
+						URI: "http://github.com/my/repository",
+					},
+				},
+				Strategy: buildapi.BuildStrategy{
+					DockerStrategy: &buildapi.DockerBuildStrategy{},
+				},
+				Output: buildapi.BuildOutput{
+					To: &kapi.ObjectReference{
+						Kind: "DockerImage",
+						Name: "repository/data",
+					},
+				},
+				Resources: kapi.ResourceRequirements{
+					Limits:   kapi.ResourceList{kapi.ResourceMemory: resource.MustParse("256Mi")},
+					Requests: kapi.ResourceList{kapi.ResourceMemory: resource.MustParse("512Mi")},
+				},
+			},
+		},
 	}
 
 	for count, config := range errorCases {
@@ -1702,6 +1919,58 @@ func TestValidateTrigger(t *testing.T) {
 			},
 			expected: []*field.Error{field.Required(field.NewPath("generic"), "")},
 		},
+		"GitLab trigger with no gitlab webhook": {
+			trigger:  buildapi.BuildTriggerPolicy{Type: buildapi.GitLabWebHookBuildTriggerType},
+			expected: []*field.Error{field.Required(field.NewPath("gitlab"), "")},
+		},
+		"GitLab trigger with no secret": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type:          buildapi.GitLabWebHookBuildTriggerType,
+				GitLabWebHook: &buildapi.WebHookTrigger{},
+			},
+			expected: []*field.Error{field.Required(field.NewPath("gitlab", "secret"), "")},
+		},
+		"Bitbucket trigger with no bitbucket webhook": {
+			trigger:  buildapi.BuildTriggerPolicy{Type: buildapi.BitbucketWebHookBuildTriggerType},
+			expected: []*field.Error{field.Required(field.NewPath("bitbucket"), "")},
+		},
+		"Bitbucket trigger with no secret": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type:             buildapi.BitbucketWebHookBuildTriggerType,
+				BitbucketWebHook: &buildapi.WebHookTrigger{},
+			},
+			expected: []*field.Error{field.Required(field.NewPath("bitbucket", "secret"), "")},
+		},
+		"GitHub trigger with invalid ref filter": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitHubWebHookBuildTriggerType,
+				GitHubWebHook: &buildapi.WebHookTrigger{
+					Secret:    "secret101",
+					RefFilter: "release-(",
+				},
+			},
+			expected: []*field.Error{field.Invalid(field.NewPath("github", "refFilter"), "", "")},
+		},
+		"GitLab trigger with invalid path filter": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitLabWebHookBuildTriggerType,
+				GitLabWebHook: &buildapi.WebHookTrigger{
+					Secret:     "secret101",
+					PathFilter: "services/[api",
+				},
+			},
+			expected: []*field.Error{field.Invalid(field.NewPath("gitlab", "pathFilter"), "", "")},
+		},
+		"valid GitHub trigger with filters": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitHubWebHookBuildTriggerType,
+				GitHubWebHook: &buildapi.WebHookTrigger{
+					Secret:     "secret101",
+					RefFilter:  "release-.*",
+					PathFilter: "services/api",
+				},
+			},
+		},
 		"ImageChange trigger without params": {
 			trigger: buildapi.BuildTriggerPolicy{
 				Type: buildapi.ImageChangeBuildTriggerType,
@@ -1738,6 +2007,32 @@ func TestValidateTrigger(t *testing.T) {
 				ImageChange: &buildapi.ImageChangeTrigger{},
 			},
 		},
+		"valid ImageChange trigger on a BuildConfig": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.ImageChangeBuildTriggerType,
+				ImageChange: &buildapi.ImageChangeTrigger{
+					From: &kapi.ObjectReference{Kind: "BuildConfig", Name: "builder", Namespace: "other"},
+				},
+			},
+		},
+		"ImageChange trigger on a BuildConfig without name": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.ImageChangeBuildTriggerType,
+				ImageChange: &buildapi.ImageChangeTrigger{
+					From: &kapi.ObjectReference{Kind: "BuildConfig"},
+				},
+			},
+			expected: []*field.Error{field.Required(field.NewPath("imageChange", "from", "name"), "")},
+		},
+		"ImageChange trigger on a DockerImage": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.ImageChangeBuildTriggerType,
+				ImageChange: &buildapi.ImageChangeTrigger{
+					From: &kapi.ObjectReference{Kind: "DockerImage", Name: "builder"},
+				},
+			},
+			expected: []*field.Error{field.Invalid(field.NewPath("imageChange", "from", "kind"), "", "")},
+		},
 	}
 	for desc, test := range tests {
 		errors := validateTrigger(&test.trigger, &kapi.ObjectReference{Kind: "ImageStreamTag"}, nil)
@@ -1842,6 +2137,59 @@ func TestValidateStrategyEnvVars(t *testing.T) {
 				},
 			},
 		},
+		// 4: valid env from a secret and a config map
+		{
+			env: []kapi.EnvVar{
+				{
+					Name: "TOKEN",
+					ValueFrom: &kapi.EnvVarSource{
+						SecretKeyRef: &kapi.SecretKeySelector{
+							LocalObjectReference: kapi.LocalObjectReference{Name: "registry"},
+							Key:                  "token",
+						},
+					},
+				},
+				{
+					Name: "MIRROR",
+					ValueFrom: &kapi.EnvVarSource{
+						ConfigMapKeyRef: &kapi.ConfigMapKeySelector{
+							LocalObjectReference: kapi.LocalObjectReference{Name: "settings"},
+							Key:                  "mirror",
+						},
+					},
+				},
+			},
+		},
+		// 5: field references are not supported
+		{
+			env: []kapi.EnvVar{
+				{
+					Name: "NAMESPACE",
+					ValueFrom: &kapi.EnvVarSource{
+						FieldRef: &kapi.ObjectFieldSelector{FieldPath: "metadata.namespace"},
+					},
+				},
+			},
+			errExpected: true,
+			errField:    "env[0].valueFrom",
+			errType:     field.ErrorTypeInvalid,
+		},
+		// 6: missing secret key
+		{
+			env: []kapi.EnvVar{
+				{
+					Name: "TOKEN",
+					ValueFrom: &kapi.EnvVarSource{
+						SecretKeyRef: &kapi.SecretKeySelector{
+							LocalObjectReference: kapi.LocalObjectReference{Name: "registry"},
+						},
+					},
+				},
+			},
+			errExpected: true,
+			errField:    "env[0].valueFrom.secretKeyRef.key",
+			errType:     field.ErrorTypeRequired,
+		},
 	}
 
 	for i, tc := range tests {
@@ -1925,3 +2273,74 @@ func TestValidatePostCommit(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateBuildConfigTriggerOnBuildConfig(t *testing.T) {
+	config := &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "runtime", Namespace: "ns"},
+		Spec: buildapi.BuildConfigSpec{
+			BuildSpec: buildapi.BuildSpec{
+				Source: buildapi.BuildSource{
+					Git: &buildapi.GitBuildSource{URI: "http://github.com/my/repository"},
+				},
+				Strategy: buildapi.BuildStrategy{
+					SourceStrategy: &buildapi.SourceBuildStrategy{
+						From: kapi.ObjectReference{Kind: "DockerImage", Name: "builder"},
+					},
+				},
+			},
+			Triggers: []buildapi.BuildTriggerPolicy{
+				{
+					Type: buildapi.ImageChangeBuildTriggerType,
+					ImageChange: &buildapi.ImageChangeTrigger{
+						From: &kapi.ObjectReference{Kind: "BuildConfig", Name: "builder"},
+					},
+				},
+			},
+		},
+	}
+	if errs := ValidateBuildConfig(config); len(errs) != 0 {
+		t.Errorf("Unexpected validation errors: %v", errs)
+	}
+
+	config.Spec.Triggers = append(config.Spec.Triggers, buildapi.BuildTriggerPolicy{
+		Type: buildapi.ImageChangeBuildTriggerType,
+		ImageChange: &buildapi.ImageChangeTrigger{
+			From: &kapi.ObjectReference{Kind: "BuildConfig", Name: "builder", Namespace: "ns"},
+		},
+	})
+	if errs := ValidateBuildConfig(config); len(errs) != 1 || !strings.Contains(errs[0].Error(), "multiple ImageChange triggers") {
+		t.Errorf("Expected a duplicate trigger error, got %v", errs)
+	}
+
+	config.Spec.Triggers[1].ImageChange.From.Name = "runtime"
+	if errs := ValidateBuildConfig(config); len(errs) != 1 || errs[0].Field != "spec.triggers[1].imageChange.from.name" {
+		t.Errorf("Expected a self reference error, got %v", errs)
+	}
+}
+
+func TestValidateBuildLogOptions(t *testing.T) {
+	negative, offset := int64(-1), int64(10)
+	tests := []struct {
+		opts  buildapi.BuildLogOptions
+		field string
+	}{
+		{opts: buildapi.BuildLogOptions{OffsetBytes: &offset, Format: buildapi.BuildLogFormatJSON}},
+		{opts: buildapi.BuildLogOptions{OffsetLines: &offset, Format: buildapi.BuildLogFormatText}},
+		{opts: buildapi.BuildLogOptions{OffsetBytes: &negative}, field: "offsetBytes"},
+		{opts: buildapi.BuildLogOptions{OffsetLines: &negative}, field: "offsetLines"},
+		{opts: buildapi.BuildLogOptions{OffsetBytes: &offset, OffsetLines: &offset}, field: "offsetLines"},
+		{opts: buildapi.BuildLogOptions{Format: "yaml"}, field: "format"},
+	}
+	for i, test := range tests {
+		errs := ValidateBuildLogOptions(&test.opts)
+		if len(test.field) == 0 {
+			if len(errs) != 0 {
+				t.Errorf("%d: unexpected validation errors: %v", i, errs)
+			}
+			continue
+		}
+		if len(errs) != 1 || errs[0].Field != test.field {
+			t.Errorf("%d: expected a single error for %s, got %v", i, test.field, errs)
+		}
+	}
+}
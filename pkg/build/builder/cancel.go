@@ -0,0 +1,101 @@
+package builder
+
+import (
+	"errors"
+	"sync"
+
+	docker "github.com/fsouza/go-dockerclient"
+	"github.com/golang/glog"
+)
+
+// buildStep describes the step of a build being executed when it is cancelled.
+type buildStep string
+
+const (
+	stepStarting    buildStep = "starting the build"
+	stepFetchSource buildStep = "fetching the source"
+	stepBuildImage  buildStep = "building the image"
+	stepPostCommit  buildStep = "running the post commit hook"
+	stepPushImage   buildStep = "pushing the image"
+)
+
+// ErrBuildCancelled is returned by a builder asked to start a new step after
+// its build was cancelled.
+var ErrBuildCancelled = errors.New("the build was cancelled")
+
+// cancellation tracks the step being executed by a build and the temporary
+// resources it created, so that a cancelled build can be aborted cleanly.
+type cancellation struct {
+	lock      sync.Mutex
+	step      buildStep
+	cleanups  []func()
+	cancelled bool
+}
+
+// buildCancellation tracks the build run by this builder process.
+var buildCancellation = &cancellation{step: stepStarting}
+
+// enter records that the build is starting step. It returns ErrBuildCancelled
+// if the build was cancelled meanwhile.
+func (c *cancellation) enter(step buildStep) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.cancelled {
+		return ErrBuildCancelled
+	}
+	c.step = step
+	return nil
+}
+
+// onCancel registers fn to release a temporary resource of the build if it is
+// cancelled. It is called right away if the build was already cancelled.
+func (c *cancellation) onCancel(fn func()) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.cancelled {
+		fn()
+		return
+	}
+	c.cleanups = append(c.cleanups, fn)
+}
+
+// cancel marks the build as cancelled, releases its temporary resources in
+// the reverse order of their creation and returns the step it was
+// executing.
+func (c *cancellation) cancel() buildStep {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.cancelled {
+		c.cancelled = true
+		for i := len(c.cleanups) - 1; i >= 0; i-- {
+			c.cleanups[i]()
+		}
+		c.cleanups = nil
+	}
+	return c.step
+}
+
+// CancelBuild aborts the build run by this builder process, removing the
+// temporary images and containers it created, and returns a description of
+// the step the build was executing.
+func CancelBuild() string {
+	return string(buildCancellation.cancel())
+}
+
+// removeImageOnCancel removes the image name if the build is cancelled.
+func removeImageOnCancel(client DockerClient, name string) {
+	buildCancellation.onCancel(func() {
+		if err := removeImage(client, name); err != nil {
+			glog.V(2).Infof("Failed to remove the image %s of the cancelled build: %v", name, err)
+		}
+	})
+}
+
+// removeContainerOnCancel removes the container name if the build is cancelled.
+func removeContainerOnCancel(client DockerClient, name string) {
+	buildCancellation.onCancel(func() {
+		if err := client.RemoveContainer(docker.RemoveContainerOptions{ID: name, Force: true}); err != nil {
+			glog.V(2).Infof("Failed to remove the container %s of the cancelled build: %v", name, err)
+		}
+	})
+}
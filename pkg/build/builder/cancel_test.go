@@ -0,0 +1,62 @@
+package builder
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCancellation(t *testing.T) {
+	c := &cancellation{step: stepStarting}
+	cleaned := []string{}
+
+	if err := c.enter(stepBuildImage); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	c.onCancel(func() { cleaned = append(cleaned, "build-tag") })
+	if err := c.enter(stepPostCommit); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	c.onCancel(func() { cleaned = append(cleaned, "post-commit") })
+
+	if step := c.cancel(); step != stepPostCommit {
+		t.Errorf("expected the build to be cancelled while %s, got %s", stepPostCommit, step)
+	}
+	if expected := []string{"post-commit", "build-tag"}; !reflect.DeepEqual(cleaned, expected) {
+		t.Errorf("expected cleanups %v, got %v", expected, cleaned)
+	}
+
+	if err := c.enter(stepPushImage); err != ErrBuildCancelled {
+		t.Errorf("expected %v entering a step of a cancelled build, got %v", ErrBuildCancelled, err)
+	}
+	c.onCancel(func() { cleaned = append(cleaned, "push-tag") })
+	if expected := []string{"post-commit", "build-tag", "push-tag"}; !reflect.DeepEqual(cleaned, expected) {
+		t.Errorf("expected a cleanup registered after cancellation to run right away, got %v", cleaned)
+	}
+
+	if step := c.cancel(); step != stepPostCommit || len(cleaned) != 3 {
+		t.Errorf("expected cancelling twice to be a no-op, got step %s and cleanups %v", step, cleaned)
+	}
+}
+
+func TestRemoveImageOnCancel(t *testing.T) {
+	defer func(c *cancellation) { buildCancellation = c }(buildCancellation)
+	buildCancellation = &cancellation{step: stepStarting}
+
+	removed := []string{}
+	client := &FakeDocker{
+		removeImageFunc: func(name string) error {
+			removed = append(removed, name)
+			return nil
+		},
+	}
+	removeImageOnCancel(client, "build-tag")
+	if len(removed) != 0 {
+		t.Fatalf("expected no image to be removed before the build is cancelled, got %v", removed)
+	}
+	if step := CancelBuild(); step != string(stepStarting) {
+		t.Errorf("expected the build to be cancelled while %s, got %s", stepStarting, step)
+	}
+	if expected := []string{"build-tag"}; !reflect.DeepEqual(removed, expected) {
+		t.Errorf("expected images %v to be removed, got %v", expected, removed)
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
+	"syscall"
 
 	docker "github.com/fsouza/go-dockerclient"
 	"github.com/golang/glog"
@@ -48,6 +50,7 @@ func newBuilderConfigFromEnvironment() (*builderConfig, error) {
 	if err = runtime.DecodeInto(kapi.Codecs.UniversalDecoder(), []byte(buildStr), cfg.build); err != nil {
 		return nil, fmt.Errorf("unable to parse build: %v", err)
 	}
+	resolveValueFromEnv(cfg.build)
 
 	masterVersion := os.Getenv(api.OriginVersion)
 	thisVersion := version.Get().String()
@@ -81,6 +84,26 @@ func newBuilderConfigFromEnvironment() (*builderConfig, error) {
 	return cfg, nil
 }
 
+// resolveValueFromEnv sets the values of the strategy environment variables of
+// build coming from a Secret or a ConfigMap, as resolved in the environment of
+// the build pod.
+func resolveValueFromEnv(build *api.Build) {
+	var env []kapi.EnvVar
+	switch {
+	case build.Spec.Strategy.SourceStrategy != nil:
+		env = build.Spec.Strategy.SourceStrategy.Env
+	case build.Spec.Strategy.DockerStrategy != nil:
+		env = build.Spec.Strategy.DockerStrategy.Env
+	}
+	for i := range env {
+		if env[i].ValueFrom == nil {
+			continue
+		}
+		env[i].Value = os.Getenv(api.EnvValueFromPrefix + env[i].Name)
+		env[i].ValueFrom = nil
+	}
+}
+
 func (c *builderConfig) setupGitEnvironment() ([]string, error) {
 
 	gitSource := c.build.Spec.Source.Git
@@ -126,6 +149,10 @@ func (c *builderConfig) setupGitEnvironment() ([]string, error) {
 		gitEnv = append(gitEnv, fmt.Sprintf("HTTPS_PROXY=%s", *gitSource.HTTPSProxy))
 		gitEnv = append(gitEnv, fmt.Sprintf("https_proxy=%s", *gitSource.HTTPSProxy))
 	}
+	if gitSource.NoProxy != nil && len(*gitSource.NoProxy) > 0 {
+		gitEnv = append(gitEnv, fmt.Sprintf("NO_PROXY=%s", *gitSource.NoProxy))
+		gitEnv = append(gitEnv, fmt.Sprintf("no_proxy=%s", *gitSource.NoProxy))
+	}
 	return bld.MergeEnv(os.Environ(), gitEnv), nil
 }
 
@@ -194,11 +221,26 @@ func (s2iBuilder) Build(dockerClient bld.DockerClient, sock string, buildsClient
 	return bld.NewS2IBuilder(dockerClient, sock, buildsClient, build, gitClient, cgLimits).Build()
 }
 
+// handleTermination aborts the build when the build pod is terminated, which
+// happens when the build is cancelled.
+func handleTermination() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+	go func() {
+		<-signals
+		step := bld.CancelBuild()
+		glog.Infof("Build cancelled while %s, aborting", step)
+		glog.Flush()
+		os.Exit(1)
+	}()
+}
+
 func runBuild(builder builder) {
 	cfg, err := newBuilderConfigFromEnvironment()
 	if err != nil {
 		glog.Fatalf("Cannot setup builder configuration: %v", err)
 	}
+	handleTermination()
 	err = cfg.execute(builder)
 	if err != nil {
 		glog.Fatalf("Error: %v", err)
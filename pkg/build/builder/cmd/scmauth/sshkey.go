@@ -2,16 +2,21 @@ package scmauth
 
 import (
 	"io/ioutil"
+	"os"
 	"path/filepath"
 )
 
-const SSHPrivateKeyMethodName = "ssh-privatekey"
+const (
+	SSHPrivateKeyMethodName = "ssh-privatekey"
+	SSHKnownHostsName       = "known_hosts"
+)
 
 // SSHPrivateKey implements SCMAuth interface for using SSH private keys.
 type SSHPrivateKey struct{}
 
 // Setup creates a wrapper script for SSH command to be able to use the provided
-// SSH key while accessing private repository.
+// SSH key while accessing private repository. When the secret also contains a
+// known_hosts file, the host keys of the server are verified against it.
 func (_ SSHPrivateKey) Setup(baseDir string, context SCMAuthContext) error {
 	script, err := ioutil.TempFile("", "gitssh")
 	if err != nil {
@@ -21,9 +26,14 @@ func (_ SSHPrivateKey) Setup(baseDir string, context SCMAuthContext) error {
 	if err := script.Chmod(0711); err != nil {
 		return err
 	}
+	hostKeyOptions := " -o StrictHostKeyChecking=false"
+	knownHosts := filepath.Join(baseDir, SSHKnownHostsName)
+	if _, err := os.Stat(knownHosts); err == nil {
+		hostKeyOptions = " -o UserKnownHostsFile=" + knownHosts + " -o StrictHostKeyChecking=yes"
+	}
 	if _, err := script.WriteString("#!/bin/sh\nssh -i " +
 		filepath.Join(baseDir, SSHPrivateKeyMethodName) +
-		" -o StrictHostKeyChecking=false \"$@\"\n"); err != nil {
+		hostKeyOptions + " \"$@\"\n"); err != nil {
 		return err
 	}
 	// set environment variable to tell git to use the SSH wrapper
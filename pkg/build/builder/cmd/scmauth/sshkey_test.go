@@ -1,7 +1,10 @@
 package scmauth
 
 import (
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -30,3 +33,43 @@ func TestSSHPrivateKeySetup(t *testing.T) {
 		t.Errorf("GIT_SSH is not set")
 	}
 }
+
+func TestSSHPrivateKeySetupKnownHosts(t *testing.T) {
+	tests := map[string]struct {
+		files    []string
+		expected string
+	}{
+		"without known hosts": {
+			files:    []string{"ssh-privatekey"},
+			expected: "-o StrictHostKeyChecking=false",
+		},
+		"with known hosts": {
+			files:    []string{"ssh-privatekey", "known_hosts"},
+			expected: "-o StrictHostKeyChecking=yes",
+		},
+	}
+	for name, test := range tests {
+		context := NewDefaultSCMContext()
+		secretDir := secretDir(t, test.files...)
+		defer os.RemoveAll(secretDir)
+
+		if err := (&SSHPrivateKey{}).Setup(secretDir, context); err != nil {
+			t.Errorf("%s: unexpected error %v", name, err)
+			continue
+		}
+		gitSSH, _ := context.Get("GIT_SSH")
+		script, err := ioutil.ReadFile(gitSSH)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", name, err)
+			continue
+		}
+		os.Remove(gitSSH)
+		if !strings.Contains(string(script), test.expected) {
+			t.Errorf("%s: expected the script to contain %q, got %q", name, test.expected, string(script))
+		}
+		knownHosts := "-o UserKnownHostsFile=" + filepath.Join(secretDir, "known_hosts")
+		if strings.Contains(string(script), knownHosts) != (len(test.files) > 1) {
+			t.Errorf("%s: unexpected known hosts file in the script %q", name, string(script))
+		}
+	}
+}
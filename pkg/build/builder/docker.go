@@ -64,6 +64,9 @@ func (d *DockerBuilder) Build() error {
 	if err != nil {
 		return err
 	}
+	if err := buildCancellation.enter(stepFetchSource); err != nil {
+		return err
+	}
 	sourceInfo, err := fetchSource(d.dockerClient, buildDir, d.build, d.urlTimeout, os.Stdin, d.gitClient)
 	if err != nil {
 		return err
@@ -86,11 +89,20 @@ func (d *DockerBuilder) Build() error {
 
 	buildTag := randomBuildTag(d.build.Namespace, d.build.Name)
 
+	if err := buildCancellation.enter(stepBuildImage); err != nil {
+		return err
+	}
+	removeImageOnCancel(d.dockerClient, buildTag)
+	glog.Infof("Starting Docker build from %s/%s BuildConfig ...", d.build.Namespace, d.build.Name)
 	if err := d.dockerBuild(buildDir, buildTag, d.build.Spec.Source.Secrets); err != nil {
 		return err
 	}
 
 	cname := containerName("docker", d.build.Name, d.build.Namespace, "post-commit")
+	if err := buildCancellation.enter(stepPostCommit); err != nil {
+		return err
+	}
+	removeContainerOnCancel(d.dockerClient, cname)
 	if err := execPostCommitHook(d.dockerClient, d.build.Spec.PostCommit, buildTag, cname); err != nil {
 		return err
 	}
@@ -99,6 +111,7 @@ func (d *DockerBuilder) Build() error {
 		if err := tagImage(d.dockerClient, buildTag, pushTag); err != nil {
 			return err
 		}
+		removeImageOnCancel(d.dockerClient, pushTag)
 	}
 
 	if err := removeImage(d.dockerClient, buildTag); err != nil {
@@ -107,6 +120,9 @@ func (d *DockerBuilder) Build() error {
 
 	defer glog.Flush()
 	if push {
+		if err := buildCancellation.enter(stepPushImage); err != nil {
+			return err
+		}
 		// Get the Docker push authentication
 		pushAuthConfig, authPresent := dockercfg.NewHelper().GetDockerAuth(
 			pushTag,
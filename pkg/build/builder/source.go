@@ -50,7 +50,7 @@ func fetchSource(dockerClient DockerClient, dir string, build *api.Build, urlTim
 	}
 
 	// may retrieve source from Git
-	hasGitSource, err := extractGitSource(gitClient, build.Spec.Source.Git, build.Spec.Revision, dir, urlTimeout)
+	hasGitSource, err := extractGitSource(gitClient, build.Spec.Source.Git, build.Spec.Revision, build.Spec.Source.ContextDir, dir, urlTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -189,7 +189,7 @@ func extractInputBinary(in io.Reader, source *api.BinaryBuildSource, dir string)
 	return nil
 }
 
-func extractGitSource(gitClient GitClient, gitSource *api.GitBuildSource, revision *api.SourceRevision, dir string, timeout time.Duration) (bool, error) {
+func extractGitSource(gitClient GitClient, gitSource *api.GitBuildSource, revision *api.SourceRevision, contextDir, dir string, timeout time.Duration) (bool, error) {
 	if gitSource == nil {
 		return false, nil
 	}
@@ -204,18 +204,41 @@ func extractGitSource(gitClient GitClient, gitSource *api.GitBuildSource, revisi
 	// check if we specify a commit, ref, or branch to check out
 	usingRef := len(gitSource.Ref) != 0 || (revision != nil && revision.Git != nil && len(revision.Git.Commit) != 0)
 
+	// a sparse checkout is configured between the clone and the checkout
+	sparsePaths := sparseCheckoutPaths(gitSource.SparseCheckoutPaths, contextDir)
+	sparse := len(sparsePaths) > 0
+
 	// Recursive clone if we're not going to checkout a ref and submodule update later
 	glog.V(2).Infof("Cloning source from %s", gitSource.URI)
 
 	// Only use the quiet flag if Verbosity is not 5 or greater
 	quiet := !bool(glog.V(5))
-	if err := gitClient.CloneWithOptions(dir, gitSource.URI, git.CloneOptions{Recursive: !usingRef, Quiet: quiet}); err != nil {
+	opts := git.CloneOptions{
+		Recursive:  !usingRef && !sparse && !gitSource.SkipSubmodules,
+		Quiet:      quiet,
+		NoCheckout: sparse,
+	}
+	if sparse {
+		// only download the contents of the files actually checked out
+		opts.Filter = "blob:none"
+	}
+	if err := gitClient.CloneWithOptions(dir, gitSource.URI, opts); err != nil {
 		return true, err
 	}
 
+	if sparse {
+		glog.V(2).Infof("Checking out the paths %s of the source", strings.Join(sparsePaths, ", "))
+		if err := configureSparseCheckout(gitClient, dir, sparsePaths); err != nil {
+			return true, err
+		}
+	}
+
 	// if we specify a commit, ref, or branch to checkout, do so, and update submodules
-	if usingRef {
+	if usingRef || sparse {
 		commit := gitSource.Ref
+		if len(commit) == 0 {
+			commit = "HEAD"
+		}
 
 		if revision != nil && revision.Git != nil && revision.Git.Commit != "" {
 			commit = revision.Git.Commit
@@ -225,6 +248,10 @@ func extractGitSource(gitClient GitClient, gitSource *api.GitBuildSource, revisi
 			return true, err
 		}
 
+		if gitSource.SkipSubmodules {
+			return true, nil
+		}
+
 		// Recursively update --init
 		if err := gitClient.SubmoduleUpdate(dir, true, true); err != nil {
 			return true, err
@@ -233,6 +260,48 @@ func extractGitSource(gitClient GitClient, gitSource *api.GitBuildSource, revisi
 	return true, nil
 }
 
+// sparseCheckoutPaths returns the paths of the repository to check out, the
+// context dir included, or nil if the whole repository is checked out.
+func sparseCheckoutPaths(paths []string, contextDir string) []string {
+	if len(paths) == 0 {
+		return nil
+	}
+	result := []string{}
+	covered := len(contextDir) == 0
+	for _, p := range paths {
+		p = strings.Trim(filepath.ToSlash(filepath.Clean(p)), "/")
+		if len(p) == 0 || p == "." {
+			// the whole repository is checked out
+			return nil
+		}
+		if contextDir == p || strings.HasPrefix(contextDir, p+"/") {
+			covered = true
+		}
+		result = append(result, p)
+	}
+	if !covered {
+		result = append(result, contextDir)
+	}
+	return result
+}
+
+// configureSparseCheckout enables the sparse checkout of paths in the
+// repository cloned in dir.
+func configureSparseCheckout(gitClient GitClient, dir string, paths []string) error {
+	if err := gitClient.AddLocalConfig(dir, "core.sparseCheckout", "true"); err != nil {
+		return err
+	}
+	infoDir := filepath.Join(dir, ".git", "info")
+	if err := os.MkdirAll(infoDir, 0755); err != nil {
+		return err
+	}
+	content := ""
+	for _, p := range paths {
+		content += "/" + p + "\n"
+	}
+	return ioutil.WriteFile(filepath.Join(infoDir, "sparse-checkout"), []byte(content), 0644)
+}
+
 func copyImageSource(dockerClient DockerClient, containerID, sourceDir, destDir string, tarHelper tar.Tar) error {
 	// Setup destination directory
 	fi, err := os.Stat(destDir)
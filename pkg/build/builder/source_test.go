@@ -2,12 +2,17 @@ package builder
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/generate/git"
 )
 
@@ -41,3 +46,102 @@ func TestCheckRemoteGit(t *testing.T) {
 		t.Errorf("unexpected error %q", err)
 	}
 }
+
+// fakeGitClient records the git commands run by the builder.
+type fakeGitClient struct {
+	commands []string
+}
+
+func (g *fakeGitClient) CloneWithOptions(dir string, url string, opts git.CloneOptions) error {
+	g.commands = append(g.commands, fmt.Sprintf("clone recursive=%t no-checkout=%t filter=%s", opts.Recursive, opts.NoCheckout, opts.Filter))
+	return os.MkdirAll(filepath.Join(dir, ".git"), 0755)
+}
+
+func (g *fakeGitClient) Checkout(dir string, ref string) error {
+	g.commands = append(g.commands, "checkout "+ref)
+	return nil
+}
+
+func (g *fakeGitClient) SubmoduleUpdate(dir string, init, recursive bool) error {
+	g.commands = append(g.commands, "submodule update")
+	return nil
+}
+
+func (g *fakeGitClient) AddLocalConfig(dir, name, value string) error {
+	g.commands = append(g.commands, fmt.Sprintf("config %s=%s", name, value))
+	return nil
+}
+
+func (g *fakeGitClient) ListRemote(url string, args ...string) (string, string, error) {
+	return "", "", nil
+}
+
+func (g *fakeGitClient) GetInfo(location string) (*git.SourceInfo, []error) {
+	return nil, nil
+}
+
+func TestExtractGitSource(t *testing.T) {
+	tests := map[string]struct {
+		source     api.GitBuildSource
+		contextDir string
+		commands   []string
+		sparse     string
+	}{
+		"default": {
+			commands: []string{"clone recursive=true no-checkout=false filter="},
+		},
+		"ref": {
+			source:   api.GitBuildSource{Ref: "v1"},
+			commands: []string{"clone recursive=false no-checkout=false filter=", "checkout v1", "submodule update"},
+		},
+		"skip submodules": {
+			source:   api.GitBuildSource{SkipSubmodules: true},
+			commands: []string{"clone recursive=false no-checkout=false filter="},
+		},
+		"skip submodules with ref": {
+			source:   api.GitBuildSource{Ref: "v1", SkipSubmodules: true},
+			commands: []string{"clone recursive=false no-checkout=false filter=", "checkout v1"},
+		},
+		"sparse checkout": {
+			source:     api.GitBuildSource{SparseCheckoutPaths: []string{"app/", "lib"}},
+			contextDir: "app/web",
+			commands:   []string{"clone recursive=false no-checkout=true filter=blob:none", "config core.sparseCheckout=true", "checkout HEAD", "submodule update"},
+			sparse:     "/app\n/lib\n",
+		},
+		"sparse checkout outside of the context dir": {
+			source:     api.GitBuildSource{Ref: "v1", SparseCheckoutPaths: []string{"lib"}, SkipSubmodules: true},
+			contextDir: "app",
+			commands:   []string{"clone recursive=false no-checkout=true filter=blob:none", "config core.sparseCheckout=true", "checkout v1"},
+			sparse:     "/lib\n/app\n",
+		},
+		"sparse checkout of the whole repository": {
+			source:   api.GitBuildSource{SparseCheckoutPaths: []string{"."}},
+			commands: []string{"clone recursive=true no-checkout=false filter="},
+		},
+	}
+	for name, test := range tests {
+		dir, err := ioutil.TempDir("", "extractgitsource")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer os.RemoveAll(dir)
+
+		gitClient := &fakeGitClient{}
+		source := test.source
+		source.URI = "https://github.com/openshift/ruby-hello-world"
+		if _, err := extractGitSource(gitClient, &source, nil, test.contextDir, dir, time.Second); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !reflect.DeepEqual(gitClient.commands, test.commands) {
+			t.Errorf("%s: expected commands %v, got %v", name, test.commands, gitClient.commands)
+		}
+		data, err := ioutil.ReadFile(filepath.Join(dir, ".git", "info", "sparse-checkout"))
+		if err != nil && !os.IsNotExist(err) {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+		if string(data) != test.sparse {
+			t.Errorf("%s: expected sparse checkout %q, got %q", name, test.sparse, string(data))
+		}
+	}
+}
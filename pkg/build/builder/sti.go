@@ -19,13 +19,20 @@ import (
 	"github.com/openshift/source-to-image/pkg/api/validation"
 	s2ibuild "github.com/openshift/source-to-image/pkg/build"
 	s2i "github.com/openshift/source-to-image/pkg/build/strategies"
+	s2idocker "github.com/openshift/source-to-image/pkg/docker"
+	"github.com/openshift/source-to-image/pkg/tar"
 
 	"github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/build/builder/cmd/dockercfg"
 	"github.com/openshift/origin/pkg/build/controller/strategy"
+	buildutil "github.com/openshift/origin/pkg/build/util"
 	"github.com/openshift/origin/pkg/client"
 )
 
+// artifactsDestinationDir is the directory of the builder image where S2I
+// uploads the artifacts of the previous build for the assemble script.
+const artifactsDestinationDir = "/tmp/artifacts"
+
 // builderFactory is the internal interface to decouple S2I-specific code from Origin builder code
 type builderFactory interface {
 	// Create S2I Builder based on S2I configuration
@@ -158,6 +165,17 @@ func (s *S2IBuilder) Build() error {
 		})
 	}
 
+	cacheDir := artifactCacheDir(s.build)
+	if len(cacheDir) > 0 {
+		if files, err := ioutil.ReadDir(cacheDir); err == nil && len(files) > 0 {
+			glog.V(3).Infof("Restoring the artifacts of the previous build from %q", cacheDir)
+			injections = append(injections, s2iapi.InjectPath{
+				SourcePath:     cacheDir,
+				DestinationDir: artifactsDestinationDir,
+			})
+		}
+	}
+
 	buildTag := randomBuildTag(s.build.Namespace, s.build.Name)
 
 	config := &s2iapi.Config{
@@ -225,21 +243,38 @@ func (s *S2IBuilder) Build() error {
 		return err
 	}
 
-	glog.V(4).Infof("Starting S2I build from %s/%s BuildConfig ...", s.build.Namespace, s.build.Name)
+	// the source is fetched by the S2I builder as part of the build of the image
+	if err := buildCancellation.enter(stepBuildImage); err != nil {
+		return err
+	}
+	removeImageOnCancel(s.dockerClient, buildTag)
+	glog.Infof("Starting S2I build from %s/%s BuildConfig ...", s.build.Namespace, s.build.Name)
 
 	if _, err = builder.Build(config); err != nil {
 		return err
 	}
 
 	cname := containerName("s2i", s.build.Name, s.build.Namespace, "post-commit")
+	if err := buildCancellation.enter(stepPostCommit); err != nil {
+		return err
+	}
+	removeContainerOnCancel(s.dockerClient, cname)
 	if err := execPostCommitHook(s.dockerClient, s.build.Spec.PostCommit, buildTag, cname); err != nil {
 		return err
 	}
 
+	if len(cacheDir) > 0 {
+		glog.V(3).Infof("Saving the artifacts of the build to %q", cacheDir)
+		if err := saveArtifacts(config, cacheDir); err != nil {
+			glog.Warningf("Failed to save the artifacts of the build: %v", err)
+		}
+	}
+
 	if push {
 		if err := tagImage(s.dockerClient, buildTag, pushTag); err != nil {
 			return err
 		}
+		removeImageOnCancel(s.dockerClient, pushTag)
 	}
 
 	if err := removeImage(s.dockerClient, buildTag); err != nil {
@@ -248,6 +283,9 @@ func (s *S2IBuilder) Build() error {
 
 	defer glog.Flush()
 	if push {
+		if err := buildCancellation.enter(stepPushImage); err != nil {
+			return err
+		}
 		// Get the Docker push authentication
 		pushAuthConfig, authPresent := dockercfg.NewHelper().GetDockerAuth(
 			pushTag,
@@ -279,6 +317,60 @@ func (s *S2IBuilder) Build() error {
 	return nil
 }
 
+// artifactCacheDir returns the directory of the artifact cache of the
+// BuildConfig of build, or an empty string if the build has no artifact cache.
+func artifactCacheDir(build *api.Build) string {
+	if build.Spec.Strategy.SourceStrategy == nil || build.Spec.Strategy.SourceStrategy.ArtifactCache == nil {
+		return ""
+	}
+	name := buildutil.ConfigNameForBuild(build)
+	if len(name) == 0 {
+		glog.V(2).Infof("The artifact cache is ignored, the build %s/%s has no BuildConfig", build.Namespace, build.Name)
+		return ""
+	}
+	return filepath.Join(strategy.ArtifactCacheMountPath, name)
+}
+
+// saveArtifacts runs the save-artifacts script of the image built with config
+// and replaces the content of dir with the artifacts it streams.
+func saveArtifacts(config *s2iapi.Config, dir string) error {
+	docker, err := s2idocker.New(config.DockerConfig, config.PullAuthentication)
+	if err != nil {
+		return err
+	}
+	user := config.AssembleUser
+	if len(user) == 0 {
+		if user, err = docker.GetImageUser(config.Tag); err != nil {
+			return err
+		}
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	outReader, outWriter := io.Pipe()
+	defer outReader.Close()
+	defer outWriter.Close()
+	opts := s2idocker.RunContainerOptions{
+		Image:       config.Tag,
+		User:        user,
+		ScriptsURL:  config.ScriptsURL,
+		Destination: config.Destination,
+		Command:     s2iapi.SaveArtifacts,
+		Stdout:      outWriter,
+		OnStart: func(string) error {
+			return tar.New().ExtractTarStream(dir, outReader)
+		},
+		NetworkMode:  string(config.DockerNetworkMode),
+		CGroupLimits: config.CGroupLimits,
+		CapDrop:      config.DropCapabilities,
+	}
+	return docker.RunContainer(opts)
+}
+
 type downloader struct {
 	s       *S2IBuilder
 	in      io.Reader
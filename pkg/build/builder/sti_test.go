@@ -123,3 +123,37 @@ func TestGetStrategyError(t *testing.T) {
 		t.Errorf("s2iBuilder.Build() = %v; want %v", err, expErr)
 	}
 }
+
+func TestArtifactCacheDir(t *testing.T) {
+	cache := &kapi.LocalObjectReference{Name: "cache"}
+	tests := map[string]struct {
+		labels   map[string]string
+		cache    *kapi.LocalObjectReference
+		expected string
+	}{
+		"no cache": {
+			labels: map[string]string{api.BuildConfigLabel: "app"},
+		},
+		"build of a BuildConfig": {
+			labels:   map[string]string{api.BuildConfigLabel: "app"},
+			cache:    cache,
+			expected: "/var/run/openshift.io/artifacts/app",
+		},
+		"build without BuildConfig": {
+			cache: cache,
+		},
+	}
+	for name, test := range tests {
+		build := &api.Build{
+			ObjectMeta: kapi.ObjectMeta{Name: "app-1", Labels: test.labels},
+			Spec: api.BuildSpec{
+				Strategy: api.BuildStrategy{
+					SourceStrategy: &api.SourceBuildStrategy{ArtifactCache: test.cache},
+				},
+			},
+		}
+		if dir := artifactCacheDir(build); dir != test.expected {
+			t.Errorf("%s: expected %q, got %q", name, test.expected, dir)
+		}
+	}
+}
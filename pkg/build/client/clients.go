@@ -1,6 +1,8 @@
 package client
 
 import (
+	kapi "k8s.io/kubernetes/pkg/api"
+
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	osclient "github.com/openshift/origin/pkg/client"
 )
@@ -41,6 +43,16 @@ type BuildUpdater interface {
 	Update(namespace string, build *buildapi.Build) error
 }
 
+// BuildDeleter provides methods for deleting existing Builds.
+type BuildDeleter interface {
+	Delete(build *buildapi.Build) error
+}
+
+// BuildLister provides methods for listing the Builds.
+type BuildLister interface {
+	List(namespace string, opts kapi.ListOptions) (*buildapi.BuildList, error)
+}
+
 // OSClientBuildClient deletes build create and update operations to the OpenShift client interface
 type OSClientBuildClient struct {
 	Client osclient.Interface
@@ -57,6 +69,16 @@ func (c OSClientBuildClient) Update(namespace string, build *buildapi.Build) err
 	return e
 }
 
+// List lists the builds using the OpenShift client.
+func (c OSClientBuildClient) List(namespace string, opts kapi.ListOptions) (*buildapi.BuildList, error) {
+	return c.Client.Builds(namespace).List(opts)
+}
+
+// Delete deletes builds using the OpenShift client.
+func (c OSClientBuildClient) Delete(build *buildapi.Build) error {
+	return c.Client.Builds(build.Namespace).Delete(build.Name)
+}
+
 // BuildCloner provides methods for cloning builds
 type BuildCloner interface {
 	Clone(namespace string, request *buildapi.BuildRequest) (*buildapi.Build, error)
@@ -14,6 +14,7 @@ import (
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	buildclient "github.com/openshift/origin/pkg/build/client"
 	strategy "github.com/openshift/origin/pkg/build/controller/strategy"
+	"github.com/openshift/origin/pkg/build/prune"
 	buildutil "github.com/openshift/origin/pkg/build/util"
 	imageapi "github.com/openshift/origin/pkg/image/api"
 )
@@ -21,6 +22,7 @@ import (
 // BuildController watches build resources and manages their state
 type BuildController struct {
 	BuildUpdater      buildclient.BuildUpdater
+	BuildLister       buildclient.BuildLister
 	PodManager        podManager
 	BuildStrategy     BuildStrategy
 	ImageStreamClient imageStreamClient
@@ -63,9 +65,12 @@ func (bc *BuildController) CancelBuild(build *buildapi.Build) error {
 		}
 	}
 
+	// record the phase the build was cancelled in, the build pod logs the step
+	// it aborted
+	phase := build.Status.Phase
 	build.Status.Phase = buildapi.BuildPhaseCancelled
-	build.Status.Reason = ""
-	build.Status.Message = ""
+	build.Status.Reason = buildapi.StatusReasonCancelledBuild
+	build.Status.Message = fmt.Sprintf("The build was cancelled in the %s phase.", phase)
 	now := unversioned.Now()
 	build.Status.CompletionTimestamp = &now
 	if err := bc.BuildUpdater.Update(build.Namespace, build); err != nil {
@@ -73,9 +78,31 @@ func (bc *BuildController) CancelBuild(build *buildapi.Build) error {
 	}
 
 	glog.V(4).Infof("Build %s/%s was successfully cancelled.", build.Namespace, build.Name)
+	bc.runNextBuild(build)
 	return nil
 }
 
+// runNextBuild starts the build queued behind a serial build which was cancelled.
+func (bc *BuildController) runNextBuild(build *buildapi.Build) {
+	if bc.BuildLister == nil || runPolicyForBuild(build) == buildapi.BuildRunPolicyParallel {
+		return
+	}
+	list, err := bc.BuildLister.List(build.Namespace, kapi.ListOptions{LabelSelector: buildutil.BuildConfigSelector(buildutil.ConfigNameForBuild(build))})
+	if err != nil {
+		glog.V(2).Infof("Failed to list the builds queued after build %s/%s: %v", build.Namespace, build.Name, err)
+		return
+	}
+	builds := []*buildapi.Build{}
+	for i := range list.Items {
+		builds = append(builds, &list.Items[i])
+	}
+	if next := nextQueuedBuild(build, builds); next != nil {
+		if err := acceptBuild(bc.BuildUpdater, next); err != nil {
+			glog.V(2).Infof("Failed to run build %s/%s queued after build %s: %v", next.Namespace, next.Name, build.Name, err)
+		}
+	}
+}
+
 // HandleBuild deletes pods for cancelled builds and takes new builds and puts
 // them in the pending state after creating a corresponding pod
 func (bc *BuildController) HandleBuild(build *buildapi.Build) error {
@@ -94,6 +121,18 @@ func (bc *BuildController) HandleBuild(build *buildapi.Build) error {
 		return nil
 	}
 
+	// Queue the build until the run policy of its build config allows it to run
+	if !build.Status.Cancelled {
+		runnable, err := bc.isRunnable(build)
+		if err != nil {
+			return fmt.Errorf("unable to determine whether build %s/%s can run: %v", build.Namespace, build.Name, err)
+		}
+		if !runnable {
+			glog.V(4).Infof("Build %s/%s is waiting for the previous builds of its build config to complete", build.Namespace, build.Name)
+			return nil
+		}
+	}
+
 	if err := bc.nextBuildPhase(build); err != nil {
 		return err
 	}
@@ -232,9 +271,11 @@ func (bc *BuildController) resolveOutputDockerImageReference(build *buildapi.Bui
 
 // BuildPodController watches pods running builds and manages the build state
 type BuildPodController struct {
-	BuildStore   cache.Store
-	BuildUpdater buildclient.BuildUpdater
-	PodManager   podManager
+	BuildStore        cache.Store
+	BuildUpdater      buildclient.BuildUpdater
+	BuildDeleter      buildclient.BuildDeleter
+	BuildConfigGetter buildclient.BuildConfigGetter
+	PodManager        podManager
 }
 
 // HandlePod updates the state of the build based on the pod state
@@ -293,10 +334,65 @@ func (bc *BuildPodController) HandlePod(pod *kapi.Pod) error {
 			return fmt.Errorf("failed to update build %s/%s: %v", build.Namespace, build.Name, err)
 		}
 		glog.V(4).Infof("Build %s/%s status was updated %s -> %s", build.Namespace, build.Name, build.Status.Phase, nextStatus)
+		if buildutil.IsBuildComplete(build) {
+			bc.runNextBuild(build)
+			if err := bc.pruneBuilds(build); err != nil {
+				glog.V(2).Infof("Failed to prune the builds of the build config of build %s/%s: %v", build.Namespace, build.Name, err)
+			}
+		}
 	}
 	return nil
 }
 
+// runNextBuild starts the build queued behind a serial build which completed.
+func (bc *BuildPodController) runNextBuild(build *buildapi.Build) {
+	builds := []*buildapi.Build{}
+	for _, obj := range bc.BuildStore.List() {
+		builds = append(builds, obj.(*buildapi.Build))
+	}
+	if next := nextQueuedBuild(build, builds); next != nil {
+		if err := acceptBuild(bc.BuildUpdater, next); err != nil {
+			glog.V(2).Infof("Failed to run build %s/%s queued after build %s: %v", next.Namespace, next.Name, build.Name, err)
+		}
+	}
+}
+
+// pruneBuilds deletes the completed builds of the BuildConfig of build beyond
+// the history limits of the BuildConfig. The pods of the deleted builds are
+// removed by the BuildDeleteController.
+func (bc *BuildPodController) pruneBuilds(build *buildapi.Build) error {
+	if bc.BuildDeleter == nil || bc.BuildConfigGetter == nil || build.Status.Config == nil {
+		return nil
+	}
+	config, err := bc.BuildConfigGetter.Get(build.Namespace, build.Status.Config.Name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if config.Spec.SuccessfulBuildsHistoryLimit == nil && config.Spec.FailedBuildsHistoryLimit == nil {
+		return nil
+	}
+
+	builds := []*buildapi.Build{}
+	for _, obj := range bc.BuildStore.List() {
+		b := obj.(*buildapi.Build)
+		if b.Namespace == config.Namespace && b.Status.Config != nil && b.Status.Config.Name == config.Name {
+			builds = append(builds, b)
+		}
+	}
+	// only the history limits of the build config apply, the other builds are kept
+	pruner := prune.NewPruneTasker([]*buildapi.BuildConfig{config}, builds, 0, false, -1, -1, func(b *buildapi.Build) error {
+		glog.V(4).Infof("Pruning build %s/%s beyond the history limits of build config %s", b.Namespace, b.Name, config.Name)
+		if err := bc.BuildDeleter.Delete(b); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	})
+	return pruner.PruneTask()
+}
+
 // isBuildCancellable checks for build status and returns true if the condition is checked.
 func isBuildCancellable(build *buildapi.Build) bool {
 	return build.Status.Phase == buildapi.BuildPhaseNew || build.Status.Phase == buildapi.BuildPhasePending || build.Status.Phase == buildapi.BuildPhaseRunning
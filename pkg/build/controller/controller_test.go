@@ -2,12 +2,16 @@ package controller
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
+	"sort"
 	"testing"
+	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/cache"
 	"k8s.io/kubernetes/pkg/client/record"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
@@ -545,6 +549,68 @@ func TestHandlePod(t *testing.T) {
 	}
 }
 
+type fakeBuildConfigGetter struct {
+	config *buildapi.BuildConfig
+}
+
+func (g *fakeBuildConfigGetter) Get(namespace, name string) (*buildapi.BuildConfig, error) {
+	return g.config, nil
+}
+
+type fakeBuildDeleter struct {
+	deleted []string
+}
+
+func (d *fakeBuildDeleter) Delete(build *buildapi.Build) error {
+	d.deleted = append(d.deleted, build.Name)
+	return nil
+}
+
+func TestHandlePodPrunesBuilds(t *testing.T) {
+	limit := 1
+	config := &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "data", Namespace: "namespace"},
+		Spec:       buildapi.BuildConfigSpec{SuccessfulBuildsHistoryLimit: &limit},
+	}
+	now := unversioned.Now()
+	newBuild := func(name string, phase buildapi.BuildPhase, age time.Duration) *buildapi.Build {
+		build := mockBuild(phase, buildapi.BuildOutput{})
+		build.Name = name
+		build.CreationTimestamp = unversioned.NewTime(now.Add(-age))
+		build.Status.Config = &kapi.ObjectReference{Name: config.Name, Namespace: config.Namespace}
+		return build
+	}
+
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	store.Add(newBuild("data-build", buildapi.BuildPhaseRunning, time.Minute))
+	store.Add(newBuild("data-1", buildapi.BuildPhaseComplete, time.Hour))
+	store.Add(newBuild("data-2", buildapi.BuildPhaseComplete, 2*time.Hour))
+	store.Add(newBuild("data-3", buildapi.BuildPhaseFailed, 3*time.Hour))
+	other := newBuild("other-1", buildapi.BuildPhaseComplete, 4*time.Hour)
+	other.Status.Config.Name = "other"
+	store.Add(other)
+
+	deleter := &fakeBuildDeleter{}
+	ctrl := &BuildPodController{
+		BuildStore:        store,
+		BuildUpdater:      &okBuildUpdater{},
+		BuildDeleter:      deleter,
+		BuildConfigGetter: &fakeBuildConfigGetter{config: config},
+		PodManager:        &okPodManager{},
+	}
+	pod := mockPod(kapi.PodSucceeded, 0)
+	pod.Namespace = "namespace"
+
+	if err := ctrl.HandlePod(pod); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// the failed builds are kept, the build config does not limit them
+	sort.Strings(deleter.deleted)
+	if expected := []string{"data-1", "data-2"}; !reflect.DeepEqual(deleter.deleted, expected) {
+		t.Errorf("expected pruned builds %v, got %v", expected, deleter.deleted)
+	}
+}
+
 func TestCancelBuild(t *testing.T) {
 	type handleCancelBuildTest struct {
 		inStatus            buildapi.BuildPhase
@@ -672,6 +738,15 @@ func TestCancelBuild(t *testing.T) {
 			t.Errorf("(%d) Expected build completion timestamp %v to be equal to or later than %v!", i, build.Status.CompletionTimestamp, tc.completionTimestamp)
 		}
 
+		if tc.inStatus != tc.outStatus && tc.outStatus == buildapi.BuildPhaseCancelled {
+			if build.Status.Reason != buildapi.StatusReasonCancelledBuild {
+				t.Errorf("(%d) Expected reason %s, got %s", i, buildapi.StatusReasonCancelledBuild, build.Status.Reason)
+			}
+			if expected := fmt.Sprintf("The build was cancelled in the %s phase.", tc.inStatus); build.Status.Message != expected {
+				t.Errorf("(%d) Expected message %q, got %q", i, expected, build.Status.Message)
+			}
+		}
+
 		if build.Status.Phase != tc.outStatus {
 			t.Errorf("(%d) Expected %s, got %s!", i, tc.outStatus, build.Status.Phase)
 		}
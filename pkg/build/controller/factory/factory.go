@@ -61,6 +61,7 @@ type BuildControllerFactory struct {
 	OSClient            osclient.Interface
 	KubeClient          kclient.Interface
 	BuildUpdater        buildclient.BuildUpdater
+	BuildLister         buildclient.BuildLister
 	DockerBuildStrategy *strategy.DockerBuildStrategy
 	SourceBuildStrategy *strategy.SourceBuildStrategy
 	CustomBuildStrategy *strategy.CustomBuildStrategy
@@ -79,6 +80,7 @@ func (factory *BuildControllerFactory) Create() controller.RunnableController {
 	client := ControllerClient{factory.KubeClient, factory.OSClient}
 	buildController := &buildcontroller.BuildController{
 		BuildUpdater:      factory.BuildUpdater,
+		BuildLister:       factory.BuildLister,
 		ImageStreamClient: client,
 		PodManager:        client,
 		BuildStrategy: &typeBasedFactoryStrategy{
@@ -152,6 +154,10 @@ type BuildPodControllerFactory struct {
 	OSClient     osclient.Interface
 	KubeClient   kclient.Interface
 	BuildUpdater buildclient.BuildUpdater
+	// BuildDeleter and BuildConfigGetter are used to prune the builds of a BuildConfig
+	// beyond its history limits.
+	BuildDeleter      buildclient.BuildDeleter
+	BuildConfigGetter buildclient.BuildConfigGetter
 	// Stop may be set to allow controllers created by this factory to be terminated.
 	Stop <-chan struct{}
 
@@ -190,9 +196,11 @@ func (factory *BuildPodControllerFactory) Create() controller.RunnableController
 
 	client := ControllerClient{factory.KubeClient, factory.OSClient}
 	buildPodController := &buildcontroller.BuildPodController{
-		BuildStore:   factory.buildStore,
-		BuildUpdater: factory.BuildUpdater,
-		PodManager:   client,
+		BuildStore:        factory.buildStore,
+		BuildUpdater:      factory.BuildUpdater,
+		BuildDeleter:      factory.BuildDeleter,
+		BuildConfigGetter: factory.BuildConfigGetter,
+		PodManager:        client,
 	}
 
 	return &controller.RetryController{
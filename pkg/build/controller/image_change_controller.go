@@ -42,6 +42,21 @@ func getImageStreamNameFromReference(ref *kapi.ObjectReference) string {
 	return strings.Split(name, "@")[0]
 }
 
+// buildConfigOutput returns the ImageStreamTag the BuildConfig referenced by
+// an ImageChange trigger of config pushes to, or nil if it is unknown.
+func (c *ImageChangeController) buildConfigOutput(config *buildapi.BuildConfig, ref *kapi.ObjectReference) *kapi.ObjectReference {
+	namespace := ref.Namespace
+	if len(namespace) == 0 {
+		namespace = config.Namespace
+	}
+	obj, exists, err := c.BuildConfigStore.GetByKey(namespace + "/" + ref.Name)
+	if err != nil || !exists {
+		glog.V(4).Infof("Unable to find BuildConfig %s/%s watched by build config %s/%s: %v", namespace, ref.Name, config.Namespace, config.Name, err)
+		return nil
+	}
+	return buildutil.GetOutputImageStreamTagReference(obj.(*buildapi.BuildConfig))
+}
+
 // HandleImageRepo processes the next ImageStream event.
 func (c *ImageChangeController) HandleImageRepo(repo *imageapi.ImageStream) error {
 	glog.V(4).Infof("Build image change controller detected ImageStream change %s", repo.Status.DockerImageRepository)
@@ -75,16 +90,21 @@ func (c *ImageChangeController) HandleImageRepo(repo *imageapi.ImageStream) erro
 				from = buildutil.GetInputReference(config.Spec.Strategy)
 			}
 
-			if from == nil || from.Kind != "ImageStreamTag" {
+			// a trigger on another BuildConfig watches the tag it pushes to
+			watched := from
+			if from != nil && from.Kind == "BuildConfig" {
+				watched = c.buildConfigOutput(config, from)
+			}
+			if watched == nil || watched.Kind != "ImageStreamTag" {
 				continue
 			}
-			fromStreamName, tag, ok := imageapi.SplitImageStreamTag(from.Name)
+			fromStreamName, tag, ok := imageapi.SplitImageStreamTag(watched.Name)
 			if !ok {
-				glog.Errorf("Invalid image stream tag: %s in build config %s/%s", from.Name, config.Name, config.Namespace)
+				glog.Errorf("Invalid image stream tag: %s in build config %s/%s", watched.Name, config.Name, config.Namespace)
 				continue
 			}
 
-			fromNamespace := from.Namespace
+			fromNamespace := watched.Namespace
 			if len(fromNamespace) == 0 {
 				fromNamespace = config.Namespace
 			}
@@ -96,18 +116,19 @@ func (c *ImageChangeController) HandleImageRepo(repo *imageapi.ImageStream) erro
 				continue
 			}
 
-			// This split is safe because ImageStreamTag names always have the form
-			// name:tag.
-			latest := imageapi.LatestTaggedImage(repo, tag)
+			// The tag may be a pattern, in which case the most recently updated
+			// matching tag is used.
+			pattern := tag
+			tag, latest := imageapi.LatestMatchingTaggedImage(repo, pattern)
 			if latest == nil {
-				glog.V(4).Infof("unable to find tagged image: no image recorded for %s/%s:%s", repo.Namespace, repo.Name, tag)
+				glog.V(4).Infof("unable to find tagged image: no image recorded for %s/%s:%s", repo.Namespace, repo.Name, pattern)
 				continue
 			}
 			glog.V(4).Infof("Found ImageStream %s/%s with tag %s", repo.Namespace, repo.Name, tag)
 
 			// (must be different) to trigger a build
 			last := trigger.ImageChange.LastTriggeredImageID
-			next := latest.DockerImageReference
+			next := imageapi.ResolveReferenceForTagEvent(repo, tag, latest)
 
 			if len(last) == 0 || (len(next) > 0 && next != last) {
 				triggeredImage = next
@@ -8,6 +8,7 @@ import (
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/cache"
 	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
@@ -138,6 +139,43 @@ func TestNewImageDifferentTagUpdate2(t *testing.T) {
 	}
 }
 
+func TestNewImageMatchingTagPattern(t *testing.T) {
+	// the buildconfig references a tag pattern, a build is triggered by any matching tag.
+	buildcfg := mockBuildConfig("registry.com/namespace/imagename", "registry.com/namespace/imagename", "testImageStream", "release-*")
+	imageStream := mockImageStream("testImageStream", "registry.com/namespace/imagename", map[string]string{"release-1.0": "newImageID123"})
+	image := mockImage("testImage@id", "registry.com/namespace/imagename:newImageID123")
+	controller := mockImageChangeController(buildcfg, imageStream, image)
+	bcInstantiator := controller.BuildConfigInstantiator.(*buildConfigInstantiator)
+
+	err := controller.HandleImageRepo(imageStream)
+	if err != nil {
+		t.Fatalf("Unexpected error %v from HandleImageRepo", err)
+	}
+	if len(bcInstantiator.name) == 0 {
+		t.Fatal("Expected build generation when a tag matching the pattern was updated!")
+	}
+	if actual, expected := bcInstantiator.newBuild.Spec.Strategy.DockerStrategy.From.Name, "registry.com/namespace/imagename:newImageID123"; actual != expected {
+		t.Errorf("Image substitutions not properly setup for new build. Expected %s, got %s |", expected, actual)
+	}
+}
+
+func TestNewImageNotMatchingTagPattern(t *testing.T) {
+	// the buildconfig references a tag pattern that no updated tag matches.
+	buildcfg := mockBuildConfig("registry.com/namespace/imagename", "registry.com/namespace/imagename", "testImageStream", "release-*")
+	imageStream := mockImageStream("testImageStream", "registry.com/namespace/imagename", map[string]string{"otherTag": "newImageID123"})
+	image := mockImage("testImage@id", "registry.com/namespace/imagename@id")
+	controller := mockImageChangeController(buildcfg, imageStream, image)
+	bcInstantiator := controller.BuildConfigInstantiator.(*buildConfigInstantiator)
+
+	err := controller.HandleImageRepo(imageStream)
+	if err != nil {
+		t.Errorf("Unexpected error %v from HandleImageRepo", err)
+	}
+	if len(bcInstantiator.name) != 0 {
+		t.Error("New build generated when no tag matching the pattern was updated!")
+	}
+}
+
 func TestNewDifferentImageUpdate(t *testing.T) {
 	// this buildconfig references a different image than the one that will be updated
 	buildcfg := mockBuildConfig("registry.com/namespace/imagename1", "registry.com/namespace/imagename1", "testImageRepo1", "testTag1")
@@ -289,6 +327,55 @@ func TestNewImageIDNoDockerRepo(t *testing.T) {
 	}
 }
 
+func TestNewImageIDFromBuildConfigOutput(t *testing.T) {
+	// the build config is triggered by the output of another build config
+	builder := &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "builder", Namespace: "ns"},
+		Spec: buildapi.BuildConfigSpec{
+			BuildSpec: buildapi.BuildSpec{
+				Output: buildapi.BuildOutput{
+					To: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "testImageStream:testTag"},
+				},
+			},
+		},
+	}
+	buildcfg := mockBuildConfig("registry.com/namespace/imagename", "registry.com/namespace/imagename", "otherImageStream", "latest")
+	buildcfg.Namespace = "ns"
+	buildcfg.Spec.Triggers[0].ImageChange.From = &kapi.ObjectReference{Kind: "BuildConfig", Name: "builder"}
+	imageStream := mockImageStream("testImageStream", "registry.com/namespace/imagename", map[string]string{"testTag": "newImageID123"})
+	imageStream.Namespace = "ns"
+	image := mockImage("testImage@id", "registry.com/namespace/imagename:newImageID123")
+	controller := mockImageChangeController(buildcfg, imageStream, image)
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	store.Add(builder)
+	store.Add(buildcfg)
+	controller.BuildConfigStore = store
+	bcInstantiator := controller.BuildConfigInstantiator.(*buildConfigInstantiator)
+	bcUpdater := bcInstantiator.buildConfigUpdater
+
+	if err := controller.HandleImageRepo(imageStream); err != nil {
+		t.Fatalf("Unexpected error %v from HandleImageRepo", err)
+	}
+	if bcInstantiator.name != "testBuildCfg" {
+		t.Fatalf("Expected a build of testBuildCfg, got %q", bcInstantiator.name)
+	}
+	if bcUpdater.buildcfg == nil {
+		t.Fatalf("Expected buildConfig update when new image was created!")
+	}
+	if actual, expected := bcUpdater.buildcfg.Spec.Triggers[0].ImageChange.LastTriggeredImageID, "registry.com/namespace/imagename:newImageID123"; actual != expected {
+		t.Errorf("Expected last triggered image %q, got %q", expected, actual)
+	}
+
+	// the output of the other build config does not trigger it again
+	bcInstantiator.name = ""
+	if err := controller.HandleImageRepo(imageStream); err != nil {
+		t.Fatalf("Unexpected error %v from HandleImageRepo", err)
+	}
+	if len(bcInstantiator.name) != 0 {
+		t.Errorf("Unexpected build of %q for an image already built", bcInstantiator.name)
+	}
+}
+
 type mockBuildConfigUpdater struct {
 	updateCount int
 	buildcfg    *buildapi.BuildConfig
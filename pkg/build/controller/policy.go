@@ -0,0 +1,106 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildclient "github.com/openshift/origin/pkg/build/client"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// runPolicyForBuild returns the run policy of the BuildConfig the build was
+// created from. Builds created without a BuildConfig, or before run policies
+// existed, are run in parallel.
+func runPolicyForBuild(build *buildapi.Build) buildapi.BuildRunPolicy {
+	if policy, ok := build.Labels[buildapi.BuildRunPolicyLabel]; ok && len(policy) > 0 {
+		return buildapi.BuildRunPolicy(policy)
+	}
+	return buildapi.BuildRunPolicyParallel
+}
+
+// isRunnable determines whether a new build can start according to the run
+// policy of its BuildConfig. A serial build waits for the running builds and
+// the builds queued before it to complete. With the SerialLatestOnly policy the
+// builds queued before it are cancelled instead.
+func (bc *BuildController) isRunnable(build *buildapi.Build) (bool, error) {
+	policy := runPolicyForBuild(build)
+	configName := buildutil.ConfigNameForBuild(build)
+	if policy == buildapi.BuildRunPolicyParallel || len(configName) == 0 || bc.BuildLister == nil {
+		return true, nil
+	}
+
+	list, err := bc.BuildLister.List(build.Namespace, kapi.ListOptions{LabelSelector: buildutil.BuildConfigSelector(configName)})
+	if err != nil {
+		return false, err
+	}
+	version := buildutil.VersionForBuild(build)
+	runnable := true
+	for i := range list.Items {
+		b := &list.Items[i]
+		if b.Name == build.Name {
+			continue
+		}
+		switch b.Status.Phase {
+		case buildapi.BuildPhasePending, buildapi.BuildPhaseRunning:
+			runnable = false
+		case buildapi.BuildPhaseNew:
+			if b.Status.Cancelled || buildutil.VersionForBuild(b) > version {
+				continue
+			}
+			if policy == buildapi.BuildRunPolicySerialLatestOnly {
+				glog.V(4).Infof("Cancelling build %s/%s superseded by build %s", b.Namespace, b.Name, build.Name)
+				b.Status.Cancelled = true
+				if err := bc.BuildUpdater.Update(b.Namespace, b); err != nil {
+					return false, err
+				}
+				continue
+			}
+			runnable = false
+		}
+	}
+	return runnable, nil
+}
+
+// nextQueuedBuild returns the oldest build of the BuildConfig of a serial build
+// which is still waiting to run, or nil if there is none.
+func nextQueuedBuild(build *buildapi.Build, builds []*buildapi.Build) *buildapi.Build {
+	configName := buildutil.ConfigNameForBuild(build)
+	if runPolicyForBuild(build) == buildapi.BuildRunPolicyParallel || len(configName) == 0 {
+		return nil
+	}
+	var next *buildapi.Build
+	for _, b := range builds {
+		if b.Namespace != build.Namespace || buildutil.ConfigNameForBuild(b) != configName {
+			continue
+		}
+		if b.Status.Phase != buildapi.BuildPhaseNew || b.Status.Cancelled {
+			continue
+		}
+		if next == nil || buildutil.VersionForBuild(b) < buildutil.VersionForBuild(next) {
+			next = b
+		}
+	}
+	return next
+}
+
+// acceptBuild marks a queued build so the BuildController reconsiders it without
+// waiting for the next resync of the builds. The build usually comes from a
+// cache, so a copy of it is updated.
+func acceptBuild(updater buildclient.BuildUpdater, build *buildapi.Build) error {
+	copy, err := kapi.Scheme.Copy(build)
+	if err != nil {
+		return fmt.Errorf("unable to copy build: %v", err)
+	}
+	build = copy.(*buildapi.Build)
+	if build.Annotations == nil {
+		build.Annotations = make(map[string]string)
+	}
+	build.Annotations[buildapi.BuildAcceptedAnnotation] = unversioned.Now().UTC().Format(time.RFC3339Nano)
+	return updater.Update(build.Namespace, build)
+}
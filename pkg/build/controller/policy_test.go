@@ -0,0 +1,175 @@
+package controller
+
+import (
+	"strconv"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/cache"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+type fakeBuildLister struct {
+	builds []buildapi.Build
+}
+
+func (f *fakeBuildLister) List(namespace string, opts kapi.ListOptions) (*buildapi.BuildList, error) {
+	return &buildapi.BuildList{Items: f.builds}, nil
+}
+
+type recordingBuildUpdater struct {
+	updated []*buildapi.Build
+}
+
+func (r *recordingBuildUpdater) Update(namespace string, build *buildapi.Build) error {
+	r.updated = append(r.updated, build)
+	return nil
+}
+
+func policyBuild(version int, phase buildapi.BuildPhase, policy buildapi.BuildRunPolicy) *buildapi.Build {
+	build := mockBuild(phase, buildapi.BuildOutput{})
+	build.Name = "data-" + strconv.Itoa(version)
+	build.Labels = map[string]string{
+		buildapi.BuildConfigLabel:    "data",
+		buildapi.BuildRunPolicyLabel: string(policy),
+	}
+	build.Annotations = map[string]string{buildapi.BuildNumberAnnotation: strconv.Itoa(version)}
+	return build
+}
+
+func TestIsRunnable(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    buildapi.BuildRunPolicy
+		others    []*buildapi.Build
+		runnable  bool
+		cancelled []string
+	}{
+		{
+			name:     "parallel build runs next to a running build",
+			policy:   buildapi.BuildRunPolicyParallel,
+			others:   []*buildapi.Build{policyBuild(1, buildapi.BuildPhaseRunning, buildapi.BuildRunPolicyParallel)},
+			runnable: true,
+		},
+		{
+			name:     "serial build runs after the previous build completed",
+			policy:   buildapi.BuildRunPolicySerial,
+			others:   []*buildapi.Build{policyBuild(1, buildapi.BuildPhaseComplete, buildapi.BuildRunPolicySerial)},
+			runnable: true,
+		},
+		{
+			name:   "serial build waits for a running build",
+			policy: buildapi.BuildRunPolicySerial,
+			others: []*buildapi.Build{policyBuild(1, buildapi.BuildPhaseRunning, buildapi.BuildRunPolicySerial)},
+		},
+		{
+			name:   "serial build waits for the builds queued before it",
+			policy: buildapi.BuildRunPolicySerial,
+			others: []*buildapi.Build{policyBuild(1, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerial)},
+		},
+		{
+			name:     "serial build runs before the builds queued after it",
+			policy:   buildapi.BuildRunPolicySerial,
+			others:   []*buildapi.Build{policyBuild(3, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerial)},
+			runnable: true,
+		},
+		{
+			name:   "latest only build cancels the builds queued before it",
+			policy: buildapi.BuildRunPolicySerialLatestOnly,
+			others: []*buildapi.Build{
+				policyBuild(0, buildapi.BuildPhaseRunning, buildapi.BuildRunPolicySerialLatestOnly),
+				policyBuild(1, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerialLatestOnly),
+			},
+			cancelled: []string{"data-1"},
+		},
+		{
+			name:   "latest only build runs once the queued builds are cancelled",
+			policy: buildapi.BuildRunPolicySerialLatestOnly,
+			others: []*buildapi.Build{
+				policyBuild(0, buildapi.BuildPhaseComplete, buildapi.BuildRunPolicySerialLatestOnly),
+				policyBuild(1, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerialLatestOnly),
+			},
+			runnable:  true,
+			cancelled: []string{"data-1"},
+		},
+	}
+
+	for _, test := range tests {
+		build := policyBuild(2, buildapi.BuildPhaseNew, test.policy)
+		lister := &fakeBuildLister{builds: []buildapi.Build{*build}}
+		for _, b := range test.others {
+			lister.builds = append(lister.builds, *b)
+		}
+		updater := &recordingBuildUpdater{}
+		ctrl := mockBuildController()
+		ctrl.BuildLister = lister
+		ctrl.BuildUpdater = updater
+
+		runnable, err := ctrl.isRunnable(build)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if runnable != test.runnable {
+			t.Errorf("%s: expected runnable %t, got %t", test.name, test.runnable, runnable)
+		}
+		if len(updater.updated) != len(test.cancelled) {
+			t.Errorf("%s: expected builds %v to be cancelled, got %d updates", test.name, test.cancelled, len(updater.updated))
+			continue
+		}
+		for i, name := range test.cancelled {
+			if b := updater.updated[i]; b.Name != name || !b.Status.Cancelled {
+				t.Errorf("%s: expected build %s to be cancelled, got %s cancelled=%t", test.name, name, b.Name, b.Status.Cancelled)
+			}
+		}
+	}
+}
+
+func TestHandleBuildQueuesSerialBuild(t *testing.T) {
+	build := policyBuild(2, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerial)
+	ctrl := mockBuildController()
+	ctrl.BuildLister = &fakeBuildLister{builds: []buildapi.Build{
+		*policyBuild(1, buildapi.BuildPhaseRunning, buildapi.BuildRunPolicySerial),
+		*build,
+	}}
+
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if build.Status.Phase != buildapi.BuildPhaseNew {
+		t.Errorf("expected the build to stay queued in phase %s, got %s", buildapi.BuildPhaseNew, build.Status.Phase)
+	}
+}
+
+func TestHandlePodRunsNextBuild(t *testing.T) {
+	running := policyBuild(1, buildapi.BuildPhaseRunning, buildapi.BuildRunPolicySerial)
+	running.Name = "data-build"
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	store.Add(running)
+	queued := policyBuild(2, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerial)
+	store.Add(policyBuild(3, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerial))
+	store.Add(queued)
+
+	updater := &recordingBuildUpdater{}
+	ctrl := &BuildPodController{
+		BuildStore:   store,
+		BuildUpdater: updater,
+		PodManager:   &okPodManager{},
+	}
+	pod := mockPod(kapi.PodSucceeded, 0)
+	pod.Namespace = "namespace"
+
+	if err := ctrl.HandlePod(pod); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(updater.updated) != 2 {
+		t.Fatalf("expected the completed build and the next build to be updated, got %d updates", len(updater.updated))
+	}
+	if next := updater.updated[1]; next.Name != "data-2" || len(next.Annotations[buildapi.BuildAcceptedAnnotation]) == 0 {
+		t.Errorf("expected build data-2 to be accepted, got %s with annotations %v", next.Name, next.Annotations)
+	}
+	if _, ok := queued.Annotations[buildapi.BuildAcceptedAnnotation]; ok {
+		t.Errorf("expected the cached build to be left untouched, got annotations %v", queued.Annotations)
+	}
+}
@@ -100,6 +100,7 @@ func (bs *CustomBuildStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.Pod,
 		pod.Spec.Containers[0].ImagePullPolicy = kapi.PullAlways
 	}
 	pod.Spec.Containers[0].Resources = build.Spec.Resources
+	pod.Spec.NodeSelector = build.Spec.NodeSelector
 	if build.Spec.Source.Binary != nil {
 		pod.Spec.Containers[0].Stdin = true
 		pod.Spec.Containers[0].StdinOnce = true
@@ -41,6 +41,7 @@ func (bs *DockerBuildStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.Pod,
 
 	if len(strategy.Env) > 0 {
 		mergeTrustedEnvWithoutDuplicates(strategy.Env, &containerEnv)
+		addValueFromEnvVars(strategy.Env, &containerEnv)
 	}
 
 	pod := &kapi.Pod{
@@ -68,6 +69,7 @@ func (bs *DockerBuildStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.Pod,
 	}
 	pod.Spec.Containers[0].ImagePullPolicy = kapi.PullIfNotPresent
 	pod.Spec.Containers[0].Resources = build.Spec.Resources
+	pod.Spec.NodeSelector = build.Spec.NodeSelector
 
 	if build.Spec.CompletionDeadlineSeconds != nil {
 		pod.Spec.ActiveDeadlineSeconds = build.Spec.CompletionDeadlineSeconds
@@ -203,3 +203,27 @@ func mockSTIBuild() *buildapi.Build {
 		},
 	}
 }
+
+func TestSTICreateBuildPodArtifactCache(t *testing.T) {
+	strategy := &SourceBuildStrategy{
+		Image:            "sti-test-image",
+		Codec:            kapi.Codecs.LegacyCodec(buildapi.SchemeGroupVersion),
+		AdmissionControl: &FakeAdmissionControl{admit: true},
+	}
+
+	build := mockSTIBuild()
+	build.Spec.Strategy.SourceStrategy.ArtifactCache = &kapi.LocalObjectReference{Name: "maven-cache"}
+	actual, err := strategy.CreateBuildPod(build)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	mounts := actual.Spec.Containers[0].VolumeMounts
+	if len(mounts) != 5 || mounts[4].MountPath != ArtifactCacheMountPath {
+		t.Fatalf("Expected the artifact cache to be mounted in %s, got %#v", ArtifactCacheMountPath, mounts)
+	}
+	volume := actual.Spec.Volumes[len(actual.Spec.Volumes)-1]
+	if volume.Name != mounts[4].Name || volume.PersistentVolumeClaim == nil || volume.PersistentVolumeClaim.ClaimName != "maven-cache" {
+		t.Errorf("Expected a volume of the maven-cache claim, got %#v", volume)
+	}
+}
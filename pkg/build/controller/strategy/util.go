@@ -23,6 +23,7 @@ const (
 	SecretBuildSourceBaseMountPath = "/var/run/secrets/openshift.io/build"
 	SourceImagePullSecretMountPath = "/var/run/secrets/openshift.io/source-image"
 	sourceSecretMountPath          = "/var/run/secrets/openshift.io/source"
+	ArtifactCacheMountPath         = "/var/run/openshift.io/artifacts"
 )
 
 var whitelistEnvVarNames = []string{"BUILD_LOGLEVEL"}
@@ -142,6 +143,30 @@ func setupSecrets(pod *kapi.Pod, secrets []buildapi.SecretBuildSource) {
 	}
 }
 
+// setupArtifactCache mounts the persistent volume claim holding the artifacts
+// of the builds of a BuildConfig into the builder container.
+func setupArtifactCache(pod *kapi.Pod, artifactCache *kapi.LocalObjectReference) {
+	if artifactCache == nil {
+		return
+	}
+	volumeName := namer.GetName(artifactCache.Name, "artifacts", kvalidation.DNS1123SubdomainMaxLength)
+	volume := kapi.Volume{
+		Name: volumeName,
+		VolumeSource: kapi.VolumeSource{
+			PersistentVolumeClaim: &kapi.PersistentVolumeClaimVolumeSource{
+				ClaimName: artifactCache.Name,
+			},
+		},
+	}
+	volumeMount := kapi.VolumeMount{
+		Name:      volumeName,
+		MountPath: ArtifactCacheMountPath,
+	}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, volume)
+	pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, volumeMount)
+	glog.V(3).Infof("%s will be used as the artifact cache in %s", artifactCache.Name, ArtifactCacheMountPath)
+}
+
 // addSourceEnvVars adds environment variables related to the source code
 // repository to builder container
 func addSourceEnvVars(source buildapi.BuildSource, output *[]kapi.EnvVar) {
@@ -192,6 +217,19 @@ func addOutputEnvVars(buildOutput *kapi.ObjectReference, output *[]kapi.EnvVar)
 	return nil
 }
 
+// addValueFromEnvVars adds the strategy environment variables whose values
+// come from a Secret or a ConfigMap to the builder container, prefixed to not
+// override the environment of the builder. The builder resolves the strategy
+// environment variables with these values.
+func addValueFromEnvVars(source []kapi.EnvVar, output *[]kapi.EnvVar) {
+	for _, env := range source {
+		if env.ValueFrom == nil {
+			continue
+		}
+		*output = append(*output, kapi.EnvVar{Name: buildapi.EnvValueFromPrefix + env.Name, ValueFrom: env.ValueFrom})
+	}
+}
+
 // setupAdditionalSecrets creates secret volume mounts in the given pod for the given list of secrets
 func setupAdditionalSecrets(pod *kapi.Pod, secrets []buildapi.SecretSpec) {
 	for _, secretSpec := range secrets {
@@ -95,3 +95,28 @@ func TestTrustedMergeEnvWithoutDuplicates(t *testing.T) {
 		t.Errorf("Expected output env 'foo' to have value 'loglevel', got %+v", output[0])
 	}
 }
+
+func TestAddValueFromEnvVars(t *testing.T) {
+	source := &kapi.EnvVarSource{
+		SecretKeyRef: &kapi.SecretKeySelector{
+			LocalObjectReference: kapi.LocalObjectReference{Name: "registry"},
+			Key:                  "token",
+		},
+	}
+	input := []kapi.EnvVar{
+		{Name: "foo", Value: "bar"},
+		{Name: "TOKEN", ValueFrom: source},
+	}
+	output := []kapi.EnvVar{
+		{Name: "BUILD", Value: "build"},
+	}
+
+	addValueFromEnvVars(input, &output)
+
+	if len(output) != 2 {
+		t.Fatalf("Expected output to contain the env from the secret, got %+v", output)
+	}
+	if output[1].Name != "OPENSHIFT_BUILD_ENV_TOKEN" || output[1].ValueFrom != source {
+		t.Errorf("Expected output env 'OPENSHIFT_BUILD_ENV_TOKEN' from the secret, got %+v", output[1])
+	}
+}
@@ -415,6 +415,9 @@ func (g *BuildGenerator) generateBuildFromConfig(ctx kapi.Context, bc *buildapi.
 	}
 	build.Labels[buildapi.BuildConfigLabelDeprecated] = bcCopy.Name
 	build.Labels[buildapi.BuildConfigLabel] = bcCopy.Name
+	if len(bcCopy.Spec.RunPolicy) > 0 {
+		build.Labels[buildapi.BuildRunPolicyLabel] = string(bcCopy.Spec.RunPolicy)
+	}
 
 	builderSecrets, err := g.FetchServiceAccountSecrets(bc.Namespace, serviceAccount)
 	if err != nil {
@@ -539,6 +542,9 @@ func (g *BuildGenerator) resolveImageStreamReference(ctx kapi.Context, from kapi
 		glog.V(4).Infof("Resolved ImageStreamReference %s to image %s with reference %s in namespace %s", from.Name, image.Name, image.DockerImageReference, namespace)
 		return image.DockerImageReference, nil
 	case "ImageStreamTag":
+		if name, tag, ok := imageapi.SplitImageStreamTag(from.Name); ok && imageapi.IsTagPattern(tag) {
+			return g.resolveImageStreamTagPattern(ctx, name, tag, namespace)
+		}
 		imageStreamTag, err := g.Client.GetImageStreamTag(kapi.WithNamespace(ctx, namespace), from.Name)
 		if err != nil {
 			glog.V(2).Infof("Error resolving ImageStreamTag reference %s in namespace %s: %v", from.Name, namespace, err)
@@ -552,11 +558,40 @@ func (g *BuildGenerator) resolveImageStreamReference(ctx kapi.Context, from kapi
 		return image.DockerImageReference, nil
 	case "DockerImage":
 		return from.Name, nil
+	case "BuildConfig":
+		// ImageChange triggers may watch the output of another BuildConfig
+		config, err := g.Client.GetBuildConfig(kapi.WithNamespace(ctx, namespace), from.Name)
+		if err != nil {
+			glog.V(2).Infof("Error resolving BuildConfig reference %s in namespace %s: %v", from.Name, namespace, err)
+			return "", err
+		}
+		output := buildutil.GetOutputImageStreamTagReference(config)
+		if output == nil {
+			return "", fmt.Errorf("BuildConfig %s/%s does not push its output to an ImageStreamTag", namespace, from.Name)
+		}
+		return g.resolveImageStreamReference(ctx, *output, namespace)
 	default:
 		return "", fmt.Errorf("Unknown From Kind %s", from.Kind)
 	}
 }
 
+// resolveImageStreamTagPattern resolves an ImageStreamTag reference whose tag is a
+// pattern to the image of the most recently updated matching tag of the stream.
+func (g *BuildGenerator) resolveImageStreamTagPattern(ctx kapi.Context, name, pattern, namespace string) (string, error) {
+	stream, err := g.Client.GetImageStream(kapi.WithNamespace(ctx, namespace), name)
+	if err != nil {
+		glog.V(2).Infof("Error resolving ImageStream %s in namespace %s: %v", name, namespace, err)
+		return "", err
+	}
+	tag, latest := imageapi.LatestMatchingTaggedImage(stream, pattern)
+	if latest == nil {
+		return "", fmt.Errorf("no image recorded for a tag of %s/%s matching %q", namespace, name, pattern)
+	}
+	ref := imageapi.ResolveReferenceForTagEvent(stream, tag, latest)
+	glog.V(4).Infof("Resolved ImageStreamTag pattern %s:%s to tag %s with reference %s in namespace %s", name, pattern, tag, ref, namespace)
+	return ref, nil
+}
+
 // resolveImageStreamDockerRepository looks up the ImageStream[Tag/Image] and converts it to a
 // the docker repository reference with no tag information
 func (g *BuildGenerator) resolveImageStreamDockerRepository(ctx kapi.Context, from kapi.ObjectReference, defaultNamespace string) (string, error) {
@@ -1224,6 +1224,21 @@ func TestResolveImageStreamRef(t *testing.T) {
 			// the @id is applied as a :tag when resolving the repository.
 			expectedDockerRef: latestDockerReference,
 		},
+		{
+			streamRef: kapi.ObjectReference{
+				Kind: "ImageStreamTag",
+				Name: imageRepoName + ":te*",
+			},
+			expectedSuccess:   true,
+			expectedDockerRef: dockerReference,
+		},
+		{
+			streamRef: kapi.ObjectReference{
+				Kind: "ImageStreamTag",
+				Name: imageRepoName + ":release-*",
+			},
+			expectedSuccess: false,
+		},
 	}
 	for i, test := range tests {
 		ref, error := generator.resolveImageStreamReference(kapi.NewDefaultContext(), test.streamRef, "")
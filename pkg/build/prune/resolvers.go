@@ -80,7 +80,9 @@ type perBuildConfigResolver struct {
 	keepFailed   int
 }
 
-// NewPerBuildConfigResolver returns a Resolver that selects Builds to prune per BuildConfig
+// NewPerBuildConfigResolver returns a Resolver that selects Builds to prune per BuildConfig.
+// The history limits set on a BuildConfig take precedence over keepComplete and keepFailed,
+// a negative value keeps all the builds.
 func NewPerBuildConfigResolver(dataSet DataSet, keepComplete int, keepFailed int) Resolver {
 	return &perBuildConfigResolver{
 		dataSet:      dataSet,
@@ -116,11 +118,19 @@ func (o *perBuildConfigResolver) Resolve() ([]*buildapi.Build, error) {
 		sort.Sort(sort.Reverse(buildapi.BuildPtrSliceByCreationTimestamp(completeBuilds)))
 		sort.Sort(sort.Reverse(buildapi.BuildPtrSliceByCreationTimestamp(failedBuilds)))
 
-		if o.keepComplete >= 0 && o.keepComplete < len(completeBuilds) {
-			prunableBuilds = append(prunableBuilds, completeBuilds[o.keepComplete:]...)
+		keepComplete, keepFailed := o.keepComplete, o.keepFailed
+		if limit := buildConfig.Spec.SuccessfulBuildsHistoryLimit; limit != nil {
+			keepComplete = *limit
 		}
-		if o.keepFailed >= 0 && o.keepFailed < len(failedBuilds) {
-			prunableBuilds = append(prunableBuilds, failedBuilds[o.keepFailed:]...)
+		if limit := buildConfig.Spec.FailedBuildsHistoryLimit; limit != nil {
+			keepFailed = *limit
+		}
+
+		if keepComplete >= 0 && keepComplete < len(completeBuilds) {
+			prunableBuilds = append(prunableBuilds, completeBuilds[keepComplete:]...)
+		}
+		if keepFailed >= 0 && keepFailed < len(failedBuilds) {
+			prunableBuilds = append(prunableBuilds, failedBuilds[keepFailed:]...)
 		}
 	}
 	return prunableBuilds, nil
@@ -188,3 +188,39 @@ func TestPerBuildConfigResolver(t *testing.T) {
 		}
 	}
 }
+
+func TestPerBuildConfigResolverHistoryLimits(t *testing.T) {
+	successfulLimit, failedLimit := 1, 0
+	limited := mockBuildConfig("a", "limited")
+	limited.Spec.SuccessfulBuildsHistoryLimit = &successfulLimit
+	limited.Spec.FailedBuildsHistoryLimit = &failedLimit
+	unlimited := mockBuildConfig("a", "unlimited")
+	buildConfigs := []*buildapi.BuildConfig{limited, unlimited}
+
+	now := unversioned.Now()
+	builds := []*buildapi.Build{}
+	for _, buildConfig := range buildConfigs {
+		for i := 0; i < 3; i++ {
+			created := unversioned.NewTime(now.Time.Add(-1 * time.Duration(i) * time.Hour))
+			builds = append(builds,
+				withCreated(withStatus(mockBuild(buildConfig.Namespace, fmt.Sprintf("%s-complete-%d", buildConfig.Name, i), buildConfig), buildapi.BuildPhaseComplete), created),
+				withCreated(withStatus(mockBuild(buildConfig.Namespace, fmt.Sprintf("%s-failed-%d", buildConfig.Name, i), buildConfig), buildapi.BuildPhaseFailed), created),
+			)
+		}
+	}
+
+	// the limits of the build config take precedence, the others keep all
+	resolver := NewPerBuildConfigResolver(NewDataSet(buildConfigs, builds), -1, -1)
+	results, err := resolver.Resolve()
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	foundNames := sets.String{}
+	for _, result := range results {
+		foundNames.Insert(result.Name)
+	}
+	expectedNames := sets.NewString("limited-complete-1", "limited-complete-2", "limited-failed-0", "limited-failed-1", "limited-failed-2")
+	if !foundNames.Equal(expectedNames) {
+		t.Errorf("expected %v, got %v", expectedNames.List(), foundNames.List())
+	}
+}
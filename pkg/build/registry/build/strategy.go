@@ -14,6 +14,8 @@ import (
 
 	"github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/build/api/validation"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+	imageapi "github.com/openshift/origin/pkg/image/api"
 )
 
 // strategy implements behavior for Build objects
@@ -63,11 +65,21 @@ func (strategy) PrepareForCreate(obj runtime.Object) {
 	if len(build.Status.Phase) == 0 {
 		build.Status.Phase = api.BuildPhaseNew
 	}
+	expandImageReferences(build)
 }
 
 // PrepareForUpdate clears fields that are not allowed to be set by end users on update.
 func (strategy) PrepareForUpdate(obj, old runtime.Object) {
-	_ = obj.(*api.Build)
+	build := obj.(*api.Build)
+	expandImageReferences(build)
+}
+
+// expandImageReferences moves the namespace of image stream references in the form
+// <namespace>/<name> to the namespace of the reference.
+func expandImageReferences(build *api.Build) {
+	for _, ref := range buildutil.GetImageReferences(&build.Spec) {
+		imageapi.ExpandImageStreamReferenceNamespace(ref)
+	}
 }
 
 // Canonicalize normalizes the object after validation.
@@ -12,6 +12,8 @@ import (
 
 	"github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/build/api/validation"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+	imageapi "github.com/openshift/origin/pkg/image/api"
 )
 
 // strategy implements behavior for BuildConfig objects
@@ -40,6 +42,7 @@ func (strategy) AllowUnconditionalUpdate() bool {
 func (strategy) PrepareForCreate(obj runtime.Object) {
 	bc := obj.(*api.BuildConfig)
 	dropUnknownTriggers(bc)
+	expandImageReferences(bc)
 }
 
 // Canonicalize normalizes the object after validation.
@@ -50,6 +53,7 @@ func (strategy) Canonicalize(obj runtime.Object) {
 func (strategy) PrepareForUpdate(obj, old runtime.Object) {
 	bc := obj.(*api.BuildConfig)
 	dropUnknownTriggers(bc)
+	expandImageReferences(bc)
 }
 
 // Validate validates a new policy.
@@ -92,3 +96,16 @@ func dropUnknownTriggers(bc *api.BuildConfig) {
 	}
 	bc.Spec.Triggers = triggers
 }
+
+// expandImageReferences moves the namespace of image stream references in the form
+// <namespace>/<name> to the namespace of the reference.
+func expandImageReferences(bc *api.BuildConfig) {
+	for _, ref := range buildutil.GetImageReferences(&bc.Spec.BuildSpec) {
+		imageapi.ExpandImageStreamReferenceNamespace(ref)
+	}
+	for i := range bc.Spec.Triggers {
+		if trigger := bc.Spec.Triggers[i].ImageChange; trigger != nil && trigger.From != nil {
+			imageapi.ExpandImageStreamReferenceNamespace(trigger.From)
+		}
+	}
+}
@@ -118,6 +118,11 @@ func (r *REST) Get(ctx kapi.Context, name string, opts runtime.Object) (runtime.
 	// The container should be the default build container, so setting it to blank
 	buildPodName := buildutil.GetBuildPodName(build)
 	logOpts := api.BuildToPodLogOptions(buildLogOpts)
+	if buildLogOpts.OffsetBytes != nil && logOpts.LimitBytes != nil {
+		// the limit applies to the log returned after the offset
+		limit := *logOpts.LimitBytes + *buildLogOpts.OffsetBytes
+		logOpts.LimitBytes = &limit
+	}
 	location, transport, err := pod.LogLocation(r.PodGetter, r.ConnectionInfo, ctx, buildPodName, logOpts)
 	if err != nil {
 		if errors.IsNotFound(err) {
@@ -125,13 +130,28 @@ func (r *REST) Get(ctx kapi.Context, name string, opts runtime.Object) (runtime.
 		}
 		return nil, errors.NewBadRequest(err.Error())
 	}
-	return &genericrest.LocationStreamer{
+	streamer := &genericrest.LocationStreamer{
 		Location:        location,
 		Transport:       transport,
 		ContentType:     "text/plain",
 		Flush:           buildLogOpts.Follow,
 		ResponseChecker: genericrest.NewGenericHttpResponseChecker(kapi.Resource("pod"), buildPodName),
-	}, nil
+	}
+	if buildLogOpts.OffsetBytes == nil && buildLogOpts.OffsetLines == nil && buildLogOpts.Format != api.BuildLogFormatJSON {
+		return streamer, nil
+	}
+	s := &logStreamer{
+		LocationStreamer: streamer,
+		format:           buildLogOpts.Format,
+		timestamps:       buildLogOpts.Timestamps,
+	}
+	if buildLogOpts.OffsetBytes != nil {
+		s.offsetBytes = *buildLogOpts.OffsetBytes
+	}
+	if buildLogOpts.OffsetLines != nil {
+		s.offsetLines = *buildLogOpts.OffsetLines
+	}
+	return s, nil
 }
 
 // NewGetOptions returns a new options object for build logs
@@ -0,0 +1,125 @@
+package buildlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	genericrest "k8s.io/kubernetes/pkg/registry/generic/rest"
+
+	"github.com/openshift/origin/pkg/build/api"
+)
+
+// buildStepMarkers identify the log lines written by the builder when it
+// starts each step of a build.
+var buildStepMarkers = []struct {
+	step   string
+	marker *regexp.Regexp
+}{
+	{step: "clone", marker: regexp.MustCompile(`Downloading ".*" \.\.\.|Receiving source from STDIN`)},
+	{step: "assemble", marker: regexp.MustCompile(`Starting (S2I|Docker) build`)},
+	{step: "post-commit", marker: regexp.MustCompile(`Running post commit hook`)},
+	{step: "push", marker: regexp.MustCompile(`Pushing (image )?\S+ (image )?\.\.\.`)},
+}
+
+// logEntry is a single line of a build log in the json format.
+type logEntry struct {
+	Timestamp string `json:"timestamp,omitempty"`
+	Step      string `json:"step,omitempty"`
+	Message   string `json:"message"`
+}
+
+// logStreamer streams the log of a build pod, skipping the requested offset
+// at the start of the log and optionally converting it to json.
+type logStreamer struct {
+	*genericrest.LocationStreamer
+
+	offsetBytes int64
+	offsetLines int64
+	format      api.BuildLogFormat
+	timestamps  bool
+}
+
+// InputStream returns the pod log stream transformed according to the options
+// of the streamer.
+func (s *logStreamer) InputStream(apiVersion, acceptHeader string) (io.ReadCloser, bool, string, error) {
+	stream, flush, contentType, err := s.LocationStreamer.InputStream(apiVersion, acceptHeader)
+	if err != nil || stream == nil {
+		return stream, flush, contentType, err
+	}
+	if s.format == api.BuildLogFormatJSON {
+		contentType = "application/json"
+	}
+	r, w := io.Pipe()
+	go func() {
+		w.CloseWithError(s.transform(bufio.NewReader(stream), w))
+	}()
+	return &logReadCloser{PipeReader: r, stream: stream}, flush, contentType, nil
+}
+
+// transform copies the log from in to out once the offset has been skipped.
+func (s *logStreamer) transform(in *bufio.Reader, out io.Writer) error {
+	if s.offsetBytes > 0 {
+		if _, err := io.CopyN(ioutil.Discard, in, s.offsetBytes); err != nil {
+			return ignoreEOF(err)
+		}
+	}
+	for i := int64(0); i < s.offsetLines; i++ {
+		if _, err := in.ReadString('\n'); err != nil {
+			return ignoreEOF(err)
+		}
+	}
+	if s.format != api.BuildLogFormatJSON {
+		_, err := io.Copy(out, in)
+		return err
+	}
+
+	encoder := json.NewEncoder(out)
+	step := ""
+	for {
+		line, err := in.ReadString('\n')
+		if len(line) > 0 {
+			entry := logEntry{Message: strings.TrimRight(line, "\r\n")}
+			if s.timestamps {
+				if parts := strings.SplitN(entry.Message, " ", 2); len(parts) == 2 {
+					entry.Timestamp, entry.Message = parts[0], parts[1]
+				}
+			}
+			for _, m := range buildStepMarkers {
+				if m.marker.MatchString(entry.Message) {
+					step = m.step
+					break
+				}
+			}
+			entry.Step = step
+			if err := encoder.Encode(&entry); err != nil {
+				return err
+			}
+		}
+		if err != nil {
+			return ignoreEOF(err)
+		}
+	}
+}
+
+func ignoreEOF(err error) error {
+	if err == io.EOF {
+		return nil
+	}
+	return err
+}
+
+// logReadCloser closes the underlying pod log stream along with the pipe so
+// the transforming goroutine exits when the client goes away.
+type logReadCloser struct {
+	*io.PipeReader
+	stream io.ReadCloser
+}
+
+func (r *logReadCloser) Close() error {
+	r.PipeReader.Close()
+	return r.stream.Close()
+}
@@ -0,0 +1,91 @@
+package buildlog
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/openshift/origin/pkg/build/api"
+)
+
+const testBuildLog = `I0512 10:00:00.000000       1 source.go:197] Downloading "https://github.com/openshift/ruby-hello-world" ...
+Cloning into '/tmp/build'...
+I0512 10:00:05.000000       1 sti.go:246] Starting S2I build from test/ruby-hello-world-1 BuildConfig ...
+---> Installing application source
+I0512 10:00:30.000000       1 sti.go:286] Pushing test/ruby-hello-world:latest image ...
+I0512 10:00:40.000000       1 sti.go:302] Successfully pushed test/ruby-hello-world:latest
+`
+
+func TestLogStreamerTransform(t *testing.T) {
+	tests := []struct {
+		name     string
+		streamer logStreamer
+		log      string
+		expected string
+	}{
+		{
+			name:     "no options",
+			log:      "line 1\nline 2\n",
+			expected: "line 1\nline 2\n",
+		},
+		{
+			name:     "offset bytes",
+			streamer: logStreamer{offsetBytes: 5},
+			log:      "line 1\nline 2\n",
+			expected: "1\nline 2\n",
+		},
+		{
+			name:     "offset lines",
+			streamer: logStreamer{offsetLines: 1},
+			log:      "line 1\nline 2\n",
+			expected: "line 2\n",
+		},
+		{
+			name:     "offset past the end of the log",
+			streamer: logStreamer{offsetLines: 5},
+			log:      "line 1\nline 2\n",
+			expected: "",
+		},
+		{
+			name:     "json with timestamps",
+			streamer: logStreamer{format: api.BuildLogFormatJSON, timestamps: true},
+			log:      "2016-05-12T10:00:00Z line 1\n2016-05-12T10:00:01Z line 2",
+			expected: `{"timestamp":"2016-05-12T10:00:00Z","message":"line 1"}
+{"timestamp":"2016-05-12T10:00:01Z","message":"line 2"}
+`,
+		},
+		{
+			name:     "json with build steps",
+			streamer: logStreamer{format: api.BuildLogFormatJSON, offsetLines: 1},
+			log:      testBuildLog,
+			expected: `{"message":"Cloning into '/tmp/build'..."}
+{"step":"assemble","message":"I0512 10:00:05.000000       1 sti.go:246] Starting S2I build from test/ruby-hello-world-1 BuildConfig ..."}
+{"step":"assemble","message":"---\u003e Installing application source"}
+{"step":"push","message":"I0512 10:00:30.000000       1 sti.go:286] Pushing test/ruby-hello-world:latest image ..."}
+{"step":"push","message":"I0512 10:00:40.000000       1 sti.go:302] Successfully pushed test/ruby-hello-world:latest"}
+`,
+		},
+	}
+	for _, test := range tests {
+		out := &bytes.Buffer{}
+		if err := test.streamer.transform(bufio.NewReader(strings.NewReader(test.log)), out); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if out.String() != test.expected {
+			t.Errorf("%s: expected\n%s\ngot\n%s", test.name, test.expected, out.String())
+		}
+	}
+}
+
+func TestLogStreamerClone(t *testing.T) {
+	out := &bytes.Buffer{}
+	streamer := logStreamer{format: api.BuildLogFormatJSON}
+	if err := streamer.transform(bufio.NewReader(strings.NewReader(testBuildLog)), out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first := strings.SplitN(out.String(), "\n", 2)[0]; !strings.Contains(first, `"step":"clone"`) {
+		t.Errorf("expected the first line to belong to the clone step, got %s", first)
+	}
+}
@@ -45,6 +45,34 @@ func GetInputReference(strategy buildapi.BuildStrategy) *kapi.ObjectReference {
 	}
 }
 
+// GetImageReferences returns the references to the images a build pulls: the
+// image of its strategy and the images its source is copied from.
+func GetImageReferences(spec *buildapi.BuildSpec) []*kapi.ObjectReference {
+	var refs []*kapi.ObjectReference
+	if ref := GetInputReference(spec.Strategy); ref != nil {
+		refs = append(refs, ref)
+	}
+	for i := range spec.Source.Images {
+		refs = append(refs, &spec.Source.Images[i].From)
+	}
+	return refs
+}
+
+// GetOutputImageStreamTagReference returns the ImageStreamTag the builds of
+// the BuildConfig push their image to, with its namespace defaulted to the one
+// of the BuildConfig, or nil if the output is not an ImageStreamTag.
+func GetOutputImageStreamTagReference(config *buildapi.BuildConfig) *kapi.ObjectReference {
+	to := config.Spec.Output.To
+	if to == nil || to.Kind != "ImageStreamTag" {
+		return nil
+	}
+	ref := *to
+	if len(ref.Namespace) == 0 {
+		ref.Namespace = config.Namespace
+	}
+	return &ref
+}
+
 // NameFromImageStream returns a concatenated name representing an ImageStream[Tag/Image]
 // reference.  If the reference does not contain a Namespace, the namespace parameter
 // is used instead.
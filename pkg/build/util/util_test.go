@@ -12,3 +12,22 @@ func TestGetBuildPodName(t *testing.T) {
 		t.Errorf("Expected %s, got %s", expected, actual)
 	}
 }
+
+func TestGetOutputImageStreamTagReference(t *testing.T) {
+	config := &buildapi.BuildConfig{ObjectMeta: kapi.ObjectMeta{Name: "builder", Namespace: "ns"}}
+	if ref := GetOutputImageStreamTagReference(config); ref != nil {
+		t.Errorf("Expected no reference, got %#v", ref)
+	}
+	config.Spec.Output.To = &kapi.ObjectReference{Kind: "DockerImage", Name: "registry/image"}
+	if ref := GetOutputImageStreamTagReference(config); ref != nil {
+		t.Errorf("Expected no reference, got %#v", ref)
+	}
+	config.Spec.Output.To = &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "builder:latest"}
+	expected := kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "ns", Name: "builder:latest"}
+	if ref := GetOutputImageStreamTagReference(config); ref == nil || *ref != expected {
+		t.Errorf("Expected %#v, got %#v", expected, ref)
+	}
+	if len(config.Spec.Output.To.Namespace) != 0 {
+		t.Errorf("The BuildConfig must not be modified")
+	}
+}
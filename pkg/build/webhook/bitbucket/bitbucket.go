@@ -0,0 +1,139 @@
+package bitbucket
+
+import (
+	"crypto/hmac"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/mail"
+
+	"github.com/golang/glog"
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+// WebHook used for processing bitbucket webhook requests.
+type WebHook struct{}
+
+// New returns bitbucket webhook plugin.
+func New() *WebHook {
+	return &WebHook{}
+}
+
+type author struct {
+	Raw string `json:"raw,omitempty"`
+}
+
+type commit struct {
+	Hash    string `json:"hash,omitempty"`
+	Message string `json:"message,omitempty"`
+	Author  author `json:"author,omitempty"`
+}
+
+type reference struct {
+	Type   string `json:"type,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Target commit `json:"target,omitempty"`
+}
+
+type change struct {
+	New *reference `json:"new,omitempty"`
+}
+
+type pushEvent struct {
+	Push struct {
+		Changes []change `json:"changes,omitempty"`
+	} `json:"push,omitempty"`
+}
+
+// Extract services webhooks from bitbucket.org
+func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (revision *api.SourceRevision, proceed bool, err error) {
+	trigger, ok := webhook.FindTriggerPolicy(api.BitbucketWebHookBuildTriggerType, buildCfg)
+	if !ok {
+		err = webhook.ErrHookNotEnabled
+		return
+	}
+	glog.V(4).Infof("Checking if the provided secret for BuildConfig %s/%s matches", buildCfg.Namespace, buildCfg.Name)
+	if !hmac.Equal([]byte(trigger.BitbucketWebHook.Secret), []byte(secret)) {
+		err = webhook.ErrSecretMismatch
+		return
+	}
+	glog.V(4).Infof("Verifying build request for BuildConfig %s/%s", buildCfg.Namespace, buildCfg.Name)
+	if err = verifyRequest(req); err != nil {
+		return
+	}
+	if method := req.Header.Get("X-Event-Key"); method != "repo:push" {
+		err = fmt.Errorf("Unknown X-Event-Key %s", method)
+		return
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return
+	}
+	var event pushEvent
+	if err = json.Unmarshal(body, &event); err != nil {
+		return
+	}
+
+	// a single push may update several branches, build from the one matching
+	// the configuration; a change without a new reference deletes a branch
+	filter := trigger.BitbucketWebHook
+	for _, c := range event.Push.Changes {
+		if c.New == nil {
+			continue
+		}
+		if len(filter.RefFilter) > 0 {
+			if err := webhook.MatchFilters(filter, c.New.Name, nil); err != nil {
+				continue
+			}
+		} else if c.New.Type != "branch" || !webhook.GitRefMatches("refs/heads/"+c.New.Name, buildCfg.Spec.Source.Git.Ref) {
+			continue
+		}
+		proceed = true
+		revision = &api.SourceRevision{
+			Git: &api.GitSourceRevision{
+				Commit:  c.New.Target.Hash,
+				Author:  parseAuthor(c.New.Target.Author.Raw),
+				Message: c.New.Target.Message,
+			},
+		}
+		return
+	}
+	if len(filter.RefFilter) > 0 {
+		err = &webhook.SkippedError{Reason: fmt.Sprintf("no ref in the push event matches the ref filter %q", filter.RefFilter)}
+		return
+	}
+	glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  No branch in the push event matches configuration", buildCfg.Namespace, buildCfg.Name)
+	return
+}
+
+// parseAuthor converts a raw "Name <email>" author string into a user,
+// falling back to the raw value as the name when it cannot be parsed.
+func parseAuthor(raw string) api.SourceControlUser {
+	addr, err := mail.ParseAddress(raw)
+	if err != nil {
+		return api.SourceControlUser{Name: raw}
+	}
+	return api.SourceControlUser{Name: addr.Name, Email: addr.Address}
+}
+
+func verifyRequest(req *http.Request) error {
+	if method := req.Method; method != "POST" {
+		return fmt.Errorf("unsupported HTTP method %s", method)
+	}
+	contentType := req.Header.Get("Content-Type")
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("non-parseable Content-Type %s (%s)", contentType, err)
+	}
+	if mediaType != "application/json" {
+		return fmt.Errorf("unsupported Content-Type %s", contentType)
+	}
+	if len(req.Header.Get("X-Event-Key")) == 0 {
+		return errors.New("missing X-Event-Key")
+	}
+	return nil
+}
@@ -72,6 +72,11 @@ func (c *controller) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 	revision, proceed, err := plugin.Extract(buildCfg, uv.secret, uv.path, req)
+	if skipped, ok := err.(*SkippedError); ok {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s: %v", buildCfg.Namespace, buildCfg.Name, skipped)
+		fmt.Fprintf(w, "No build triggered for BuildConfig %s/%s: %s", buildCfg.Namespace, buildCfg.Name, skipped.Reason)
+		return
+	}
 	if err != nil {
 		glog.V(2).Infof("Failed to extract information from webhook: %v", err)
 		badRequest(w, err.Error())
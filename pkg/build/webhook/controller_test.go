@@ -73,6 +73,12 @@ func (p *pathPlugin) Extract(buildCfg *api.BuildConfig, secret, path string, req
 	return nil, true, nil
 }
 
+type skippedPlugin struct{}
+
+func (*skippedPlugin) Extract(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (*api.SourceRevision, bool, error) {
+	return nil, false, &SkippedError{Reason: "the ref \"other\" does not match the ref filter \"master\""}
+}
+
 type errPlugin struct{}
 
 func (*errPlugin) Extract(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (*api.SourceRevision, bool, error) {
@@ -306,3 +312,23 @@ func TestInvokeWebhookOK(t *testing.T) {
 		t.Fatalf("expected buildconfig names to match '%s', got '%s'", buildConfig.Name, buildRequest)
 	}
 }
+
+func TestInvokeWebhookSkipped(t *testing.T) {
+	server := httptest.NewServer(NewController(&okBuildConfigGetter{}, &errorBuildConfigInstantiator{},
+		map[string]Plugin{
+			"skippedPlugin": &skippedPlugin{},
+		}))
+	defer server.Close()
+
+	resp, err := http.Post(server.URL+"/build100/secret101/skippedPlugin",
+		"application/json", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK ||
+		!strings.Contains(string(body), "does not match the ref filter") {
+		t.Errorf("Wrong response, expecting 200 with the reason, got %s: %s!", resp.Status,
+			string(body))
+	}
+}
@@ -71,6 +71,23 @@ func (p *WebHookPlugin) Extract(buildCfg *api.BuildConfig, secret, path string,
 			return nil, true, nil
 		}
 
+		filter := trigger.GenericWebHook
+		if len(filter.RefFilter) > 0 {
+			refs := data.Git.Refs
+			if refs == nil {
+				refs = []api.GitRefInfo{{GitBuildSource: data.Git.GitBuildSource, GitSourceRevision: data.Git.GitSourceRevision}}
+			}
+			for _, ref := range refs {
+				if webhook.MatchFilters(filter, ref.Ref, nil) == nil {
+					revision = &api.SourceRevision{
+						Git: &ref.GitSourceRevision,
+					}
+					return revision, true, nil
+				}
+			}
+			return nil, false, &webhook.SkippedError{Reason: fmt.Sprintf("no supplied ref matches the ref filter %q", filter.RefFilter)}
+		}
+
 		if data.Git.Refs != nil {
 			for _, ref := range data.Git.Refs {
 				if webhook.GitRefMatches(ref.Ref, git.Ref) {
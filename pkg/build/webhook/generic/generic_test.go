@@ -436,3 +436,49 @@ func TestExtractWithUnmarshalError(t *testing.T) {
 		t.Error("Expected the 'revision' return value to be nil")
 	}
 }
+
+func TestExtractWithRefFilter(t *testing.T) {
+	tests := []struct {
+		filter  string
+		proceed bool
+	}{
+		{filter: "ma.*", proceed: true},
+		{filter: "release-.*", proceed: false},
+	}
+	for _, test := range tests {
+		req := GivenRequestWithRefsPayload(t)
+		buildConfig := &api.BuildConfig{
+			Spec: api.BuildConfigSpec{
+				Triggers: []api.BuildTriggerPolicy{
+					{
+						Type: api.GenericWebHookBuildTriggerType,
+						GenericWebHook: &api.WebHookTrigger{
+							Secret:    "secret100",
+							RefFilter: test.filter,
+						},
+					},
+				},
+				BuildSpec: api.BuildSpec{
+					Source: api.BuildSource{
+						Git: &api.GitBuildSource{
+							Ref: "other",
+						},
+					},
+					Strategy: mockBuildStrategy,
+				},
+			},
+		}
+		plugin := New()
+		revision, proceed, err := plugin.Extract(buildConfig, "secret100", "", req)
+
+		if proceed != test.proceed {
+			t.Errorf("%s: expected 'proceed' return value to be %t", test.filter, test.proceed)
+		}
+		if test.proceed && (err != nil || revision == nil) {
+			t.Errorf("%s: expected a revision without error, got %v: %v", test.filter, revision, err)
+		}
+		if _, ok := err.(*webhook.SkippedError); !test.proceed && !ok {
+			t.Errorf("%s: expected a SkippedError, got %v", test.filter, err)
+		}
+	}
+}
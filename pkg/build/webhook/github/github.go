@@ -27,12 +27,16 @@ type commit struct {
 	Author    api.SourceControlUser `json:"author,omitempty"`
 	Committer api.SourceControlUser `json:"committer,omitempty"`
 	Message   string                `json:"message,omitempty"`
+	Added     []string              `json:"added,omitempty"`
+	Modified  []string              `json:"modified,omitempty"`
+	Removed   []string              `json:"removed,omitempty"`
 }
 
 type pushEvent struct {
-	Ref        string `json:"ref,omitempty"`
-	After      string `json:"after,omitempty"`
-	HeadCommit commit `json:"head_commit,omitempty"`
+	Ref        string   `json:"ref,omitempty"`
+	After      string   `json:"after,omitempty"`
+	HeadCommit commit   `json:"head_commit,omitempty"`
+	Commits    []commit `json:"commits,omitempty"`
 }
 
 // Extract services webhooks from github.com
@@ -68,7 +72,10 @@ func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *h
 	if err = json.Unmarshal(body, &event); err != nil {
 		return
 	}
-	proceed = webhook.GitRefMatches(event.Ref, buildCfg.Spec.Source.Git.Ref)
+	if err = webhook.MatchFilters(trigger.GitHubWebHook, event.Ref, changedFiles(event.Commits)); err != nil {
+		return
+	}
+	proceed = len(trigger.GitHubWebHook.RefFilter) > 0 || webhook.GitRefMatches(event.Ref, buildCfg.Spec.Source.Git.Ref)
 	if !proceed {
 		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Branch reference from '%s' does not match configuration", buildCfg.Namespace, buildCfg, event)
 	}
@@ -85,6 +92,17 @@ func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *h
 	return
 }
 
+// changedFiles returns the files added, modified or removed by the commits of a push.
+func changedFiles(commits []commit) []string {
+	files := []string{}
+	for _, c := range commits {
+		files = append(files, c.Added...)
+		files = append(files, c.Modified...)
+		files = append(files, c.Removed...)
+	}
+	return files
+}
+
 func verifyRequest(req *http.Request) error {
 	if method := req.Method; method != "POST" {
 		return fmt.Errorf("unsupported HTTP method %s", method)
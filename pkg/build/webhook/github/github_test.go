@@ -332,3 +332,41 @@ func TestExtractSkipsBuildForUnmatchedBranches(t *testing.T) {
 		t.Errorf("Expecting to not continue from this event because the branch is not for this buildConfig '%s'", context.buildCfg.Spec.Source.Git.Ref)
 	}
 }
+
+func TestExtractProvidesValidBuildForMatchingFilters(t *testing.T) {
+	//setup
+	context := setup(t, "pushevent-not-master-branch.json", "push")
+	context.buildCfg.Spec.Triggers[0].GitHubWebHook.RefFilter = "my_.*"
+	context.buildCfg.Spec.Triggers[0].GitHubWebHook.PathFilter = "LICENSE"
+
+	//execute
+	revision, proceed, err := context.plugin.Extract(context.buildCfg, "secret101", context.path, context.req)
+
+	//validation
+	if err != nil {
+		t.Errorf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+	if revision == nil {
+		t.Error("Expecting the revision to not be nil")
+	}
+}
+
+func TestExtractSkipsBuildForUnmatchedPathFilter(t *testing.T) {
+	//setup
+	context := setup(t, "pushevent.json", "push")
+	context.buildCfg.Spec.Triggers[0].GitHubWebHook.PathFilter = "services/api"
+
+	//execute
+	_, proceed, err := context.plugin.Extract(context.buildCfg, "secret101", context.path, context.req)
+
+	//validation
+	if _, ok := err.(*webhook.SkippedError); !ok {
+		t.Errorf("Expecting a SkippedError, got %v", err)
+	}
+	if proceed {
+		t.Errorf("Expecting to not continue from this event because no changed file matches '%s'", context.buildCfg.Spec.Triggers[0].GitHubWebHook.PathFilter)
+	}
+}
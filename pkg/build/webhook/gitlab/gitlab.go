@@ -0,0 +1,140 @@
+package gitlab
+
+import (
+	"crypto/hmac"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	"github.com/golang/glog"
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+// WebHook used for processing gitlab webhook requests.
+type WebHook struct{}
+
+// New returns gitlab webhook plugin.
+func New() *WebHook {
+	return &WebHook{}
+}
+
+type commit struct {
+	ID       string                `json:"id,omitempty"`
+	Author   api.SourceControlUser `json:"author,omitempty"`
+	Message  string                `json:"message,omitempty"`
+	Added    []string              `json:"added,omitempty"`
+	Modified []string              `json:"modified,omitempty"`
+	Removed  []string              `json:"removed,omitempty"`
+}
+
+type pushEvent struct {
+	Ref         string   `json:"ref,omitempty"`
+	After       string   `json:"after,omitempty"`
+	CheckoutSHA string   `json:"checkout_sha,omitempty"`
+	UserName    string   `json:"user_name,omitempty"`
+	UserEmail   string   `json:"user_email,omitempty"`
+	Commits     []commit `json:"commits,omitempty"`
+}
+
+// Extract services webhooks from gitlab.com
+func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (revision *api.SourceRevision, proceed bool, err error) {
+	trigger, ok := webhook.FindTriggerPolicy(api.GitLabWebHookBuildTriggerType, buildCfg)
+	if !ok {
+		err = webhook.ErrHookNotEnabled
+		return
+	}
+	glog.V(4).Infof("Checking if the provided secret for BuildConfig %s/%s matches", buildCfg.Namespace, buildCfg.Name)
+	if !hmac.Equal([]byte(trigger.GitLabWebHook.Secret), []byte(secret)) {
+		err = webhook.ErrSecretMismatch
+		return
+	}
+	glog.V(4).Infof("Verifying build request for BuildConfig %s/%s", buildCfg.Namespace, buildCfg.Name)
+	if err = verifyRequest(req); err != nil {
+		return
+	}
+	if method := req.Header.Get("X-Gitlab-Event"); method != "Push Hook" {
+		err = fmt.Errorf("Unknown X-Gitlab-Event %s", method)
+		return
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return
+	}
+	var event pushEvent
+	if err = json.Unmarshal(body, &event); err != nil {
+		return
+	}
+	// a push deleting a branch carries no checkout_sha and has nothing to build
+	if len(event.CheckoutSHA) == 0 {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Push event for '%s' does not contain a commit", buildCfg.Namespace, buildCfg.Name, event.Ref)
+		return
+	}
+	if err = webhook.MatchFilters(trigger.GitLabWebHook, event.Ref, changedFiles(event.Commits)); err != nil {
+		return
+	}
+	proceed = len(trigger.GitLabWebHook.RefFilter) > 0 || webhook.GitRefMatches(event.Ref, buildCfg.Spec.Source.Git.Ref)
+	if !proceed {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Branch reference from '%s' does not match configuration", buildCfg.Namespace, buildCfg.Name, event.Ref)
+	}
+
+	head := commit{
+		ID: event.CheckoutSHA,
+		Author: api.SourceControlUser{
+			Name:  event.UserName,
+			Email: event.UserEmail,
+		},
+	}
+	for _, c := range event.Commits {
+		if c.ID == event.CheckoutSHA {
+			head = c
+			break
+		}
+	}
+
+	revision = &api.SourceRevision{
+		Git: &api.GitSourceRevision{
+			Commit: head.ID,
+			Author: head.Author,
+			Committer: api.SourceControlUser{
+				Name:  event.UserName,
+				Email: event.UserEmail,
+			},
+			Message: head.Message,
+		},
+	}
+
+	return
+}
+
+// changedFiles returns the files added, modified or removed by the commits of a push.
+func changedFiles(commits []commit) []string {
+	files := []string{}
+	for _, c := range commits {
+		files = append(files, c.Added...)
+		files = append(files, c.Modified...)
+		files = append(files, c.Removed...)
+	}
+	return files
+}
+
+func verifyRequest(req *http.Request) error {
+	if method := req.Method; method != "POST" {
+		return fmt.Errorf("unsupported HTTP method %s", method)
+	}
+	contentType := req.Header.Get("Content-Type")
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("non-parseable Content-Type %s (%s)", contentType, err)
+	}
+	if mediaType != "application/json" {
+		return fmt.Errorf("unsupported Content-Type %s", contentType)
+	}
+	if len(req.Header.Get("X-Gitlab-Event")) == 0 {
+		return errors.New("missing X-Gitlab-Event")
+	}
+	return nil
+}
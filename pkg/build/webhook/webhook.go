@@ -2,6 +2,8 @@ package webhook
 
 import (
 	"fmt"
+	"path"
+	"regexp"
 	"strings"
 
 	"github.com/openshift/origin/pkg/build/api"
@@ -12,6 +14,16 @@ var (
 	ErrHookNotEnabled = fmt.Errorf("the specified hook is not enabled")
 )
 
+// SkippedError is returned by a Plugin when a valid event does not match the
+// filters of its webhook trigger and must not trigger a build.
+type SkippedError struct {
+	Reason string
+}
+
+func (e *SkippedError) Error() string {
+	return e.Reason
+}
+
 // GitRefMatches determines if the ref from a webhook event matches a build configuration
 func GitRefMatches(eventRef, configRef string) bool {
 	const RefPrefix = "refs/heads/"
@@ -32,3 +44,45 @@ func FindTriggerPolicy(triggerType api.BuildTriggerType, config *api.BuildConfig
 	}
 	return nil, false
 }
+
+// MatchFilters checks the ref and the changed files of a webhook event against the
+// filters of the trigger and returns a SkippedError when the event does not match.
+// A nil list of changed files means the event does not carry them, and the path
+// filter is not evaluated.
+func MatchFilters(trigger *api.WebHookTrigger, eventRef string, changedFiles []string) error {
+	if len(trigger.RefFilter) > 0 {
+		re, err := regexp.Compile("^(?:" + trigger.RefFilter + ")$")
+		if err != nil {
+			return fmt.Errorf("invalid ref filter %q: %v", trigger.RefFilter, err)
+		}
+		name := strings.TrimPrefix(strings.TrimPrefix(eventRef, "refs/heads/"), "refs/tags/")
+		if !re.MatchString(name) {
+			return &SkippedError{Reason: fmt.Sprintf("the ref %q does not match the ref filter %q", name, trigger.RefFilter)}
+		}
+	}
+	if len(trigger.PathFilter) > 0 && changedFiles != nil {
+		for _, file := range changedFiles {
+			matches, err := pathMatches(trigger.PathFilter, file)
+			if err != nil {
+				return fmt.Errorf("invalid path filter %q: %v", trigger.PathFilter, err)
+			}
+			if matches {
+				return nil
+			}
+		}
+		return &SkippedError{Reason: fmt.Sprintf("none of the changed files match the path filter %q", trigger.PathFilter)}
+	}
+	return nil
+}
+
+// pathMatches returns true if the pattern matches the file or any of the
+// directories containing it.
+func pathMatches(pattern, file string) (bool, error) {
+	for file = path.Clean(file); file != "." && file != "/"; file = path.Dir(file) {
+		matches, err := path.Match(pattern, file)
+		if err != nil || matches {
+			return matches, err
+		}
+	}
+	return false, nil
+}
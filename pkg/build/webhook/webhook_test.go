@@ -0,0 +1,71 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/openshift/origin/pkg/build/api"
+)
+
+func TestMatchFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		trigger api.WebHookTrigger
+		ref     string
+		files   []string
+		skipped bool
+	}{
+		{
+			name: "no filters",
+			ref:  "refs/heads/master",
+		},
+		{
+			name:    "ref filter matches branch",
+			trigger: api.WebHookTrigger{RefFilter: "release-.*"},
+			ref:     "refs/heads/release-1.2",
+		},
+		{
+			name:    "ref filter matches tag",
+			trigger: api.WebHookTrigger{RefFilter: `v\d+\.\d+`},
+			ref:     "refs/tags/v1.2",
+		},
+		{
+			name:    "ref filter must match the whole name",
+			trigger: api.WebHookTrigger{RefFilter: "release"},
+			ref:     "refs/heads/release-1.2",
+			skipped: true,
+		},
+		{
+			name:    "path filter matches file",
+			trigger: api.WebHookTrigger{PathFilter: "docs/*.md"},
+			ref:     "refs/heads/master",
+			files:   []string{"src/main.go", "docs/README.md"},
+		},
+		{
+			name:    "path filter matches directory",
+			trigger: api.WebHookTrigger{PathFilter: "services/api"},
+			ref:     "refs/heads/master",
+			files:   []string{"services/api/pkg/server.go"},
+		},
+		{
+			name:    "path filter matches no file",
+			trigger: api.WebHookTrigger{PathFilter: "services/api"},
+			ref:     "refs/heads/master",
+			files:   []string{"services/web/index.html"},
+			skipped: true,
+		},
+		{
+			name:    "path filter ignored without changed files",
+			trigger: api.WebHookTrigger{PathFilter: "services/api"},
+			ref:     "refs/heads/master",
+		},
+	}
+	for _, test := range tests {
+		err := MatchFilters(&test.trigger, test.ref, test.files)
+		if _, skipped := err.(*SkippedError); skipped != test.skipped {
+			t.Errorf("%s: expected skipped=%t, got %v", test.name, test.skipped, err)
+		}
+		if err != nil && !test.skipped {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+	}
+}
@@ -46,7 +46,10 @@ type Interface interface {
 	LocalSubjectAccessReviewsNamespacer
 	TemplatesNamespacer
 	TemplateConfigsNamespacer
+	TemplateInstancesNamespacer
+	TemplateRepositoriesNamespacer
 	OAuthAccessTokensInterface
+	OAuthAuthorizeTokensInterface
 	PoliciesNamespacer
 	PolicyBindingsNamespacer
 	RolesNamespacer
@@ -172,6 +175,16 @@ func (c *Client) Templates(namespace string) TemplateInterface {
 	return newTemplates(c, namespace)
 }
 
+// TemplateInstances provides a REST client for TemplateInstances
+func (c *Client) TemplateInstances(namespace string) TemplateInstanceInterface {
+	return newTemplateInstances(c, namespace)
+}
+
+// TemplateRepositories provides a REST client for TemplateRepositories
+func (c *Client) TemplateRepositories(namespace string) TemplateRepositoryInterface {
+	return newTemplateRepositories(c, namespace)
+}
+
 // Policies provides a REST client for Policies
 func (c *Client) Policies(namespace string) PolicyInterface {
 	return newPolicies(c, namespace)
@@ -227,6 +240,11 @@ func (c *Client) OAuthAccessTokens() OAuthAccessTokenInterface {
 	return newOAuthAccessTokens(c)
 }
 
+// OAuthAuthorizeTokens provides a REST client for OAuthAuthorizeTokens
+func (c *Client) OAuthAuthorizeTokens() OAuthAuthorizeTokenInterface {
+	return newOAuthAuthorizeTokens(c)
+}
+
 func (c *Client) ClusterPolicies() ClusterPolicyInterface {
 	return newClusterPolicies(c)
 }
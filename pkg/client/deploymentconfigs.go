@@ -25,6 +25,7 @@ type DeploymentConfigInterface interface {
 	Watch(opts kapi.ListOptions) (watch.Interface, error)
 	Generate(name string) (*deployapi.DeploymentConfig, error)
 	Rollback(config *deployapi.DeploymentConfigRollback) (*deployapi.DeploymentConfig, error)
+	Instantiate(request *deployapi.DeploymentRequest) (*deployapi.DeploymentConfig, error)
 	GetScale(name string) (*extensions.Scale, error)
 	UpdateScale(scale *extensions.Scale) (*extensions.Scale, error)
 }
@@ -109,6 +110,14 @@ func (c *deploymentConfigs) Rollback(config *deployapi.DeploymentConfigRollback)
 	return
 }
 
+// Instantiate starts a new deployment of a deploymentConfig, returning the
+// updated deploymentConfig.
+func (c *deploymentConfigs) Instantiate(request *deployapi.DeploymentRequest) (result *deployapi.DeploymentConfig, err error) {
+	result = &deployapi.DeploymentConfig{}
+	err = c.r.Post().Namespace(c.ns).Resource("deploymentConfigs").Name(request.Name).SubResource("instantiate").Body(request).Do().Into(result)
+	return
+}
+
 // Get returns information about a particular deploymentConfig
 func (c *deploymentConfigs) GetScale(name string) (result *extensions.Scale, err error) {
 	result = &extensions.Scale{}
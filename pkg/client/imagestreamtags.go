@@ -14,6 +14,7 @@ type ImageStreamTagsNamespacer interface {
 // ImageStreamTagInterface exposes methods on ImageStreamTag resources.
 type ImageStreamTagInterface interface {
 	Get(name, tag string) (*api.ImageStreamTag, error)
+	Create(tag *api.ImageStreamTag) (*api.ImageStreamTag, error)
 	Update(tag *api.ImageStreamTag) (*api.ImageStreamTag, error)
 	Delete(name, tag string) error
 }
@@ -39,6 +40,13 @@ func (c *imageStreamTags) Get(name, tag string) (result *api.ImageStreamTag, err
 	return
 }
 
+// Create creates an image stream tag, failing if the tag already exists.
+func (c *imageStreamTags) Create(tag *api.ImageStreamTag) (result *api.ImageStreamTag, err error) {
+	result = &api.ImageStreamTag{}
+	err = c.r.Post().Namespace(c.ns).Resource("imageStreamTags").Body(tag).Do().Into(result)
+	return
+}
+
 // Update updates an image stream tag (creating it if it does not exist).
 func (c *imageStreamTags) Update(tag *api.ImageStreamTag) (result *api.ImageStreamTag, err error) {
 	result = &api.ImageStreamTag{}
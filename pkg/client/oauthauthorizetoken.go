@@ -0,0 +1,56 @@
+package client
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+)
+
+// OAuthAuthorizeTokensInterface has methods to work with OAuthAuthorizeTokens resources
+type OAuthAuthorizeTokensInterface interface {
+	OAuthAuthorizeTokens() OAuthAuthorizeTokenInterface
+}
+
+// OAuthAuthorizeTokenInterface exposes methods on OAuthAuthorizeTokens resources.
+type OAuthAuthorizeTokenInterface interface {
+	List(opts kapi.ListOptions) (*oauthapi.OAuthAuthorizeTokenList, error)
+	Delete(name string) error
+	DeleteCollection(opts kapi.ListOptions) error
+}
+
+type oauthAuthorizeTokenInterface struct {
+	r *Client
+}
+
+func newOAuthAuthorizeTokens(c *Client) *oauthAuthorizeTokenInterface {
+	return &oauthAuthorizeTokenInterface{
+		r: c,
+	}
+}
+
+// List returns a list of OAuthAuthorizeTokens that match the label and field selectors.
+func (c *oauthAuthorizeTokenInterface) List(opts kapi.ListOptions) (result *oauthapi.OAuthAuthorizeTokenList, err error) {
+	result = &oauthapi.OAuthAuthorizeTokenList{}
+	err = c.r.Get().
+		Resource("oAuthAuthorizeTokens").
+		VersionedParams(&opts, kapi.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete removes the OAuthAuthorizeToken on server
+func (c *oauthAuthorizeTokenInterface) Delete(name string) (err error) {
+	err = c.r.Delete().Resource("oAuthAuthorizeTokens").Name(name).Do().Error()
+	return
+}
+
+// DeleteCollection removes every OAuthAuthorizeToken that matches the label and field selectors.
+func (c *oauthAuthorizeTokenInterface) DeleteCollection(opts kapi.ListOptions) (err error) {
+	err = c.r.Delete().
+		Resource("oAuthAuthorizeTokens").
+		VersionedParams(&opts, kapi.ParameterCodec).
+		Do().
+		Error()
+	return
+}
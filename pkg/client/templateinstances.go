@@ -0,0 +1,88 @@
+package client
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/watch"
+
+	templateapi "github.com/openshift/origin/pkg/template/api"
+)
+
+// TemplateInstancesNamespacer has methods to work with TemplateInstance resources in a namespace
+type TemplateInstancesNamespacer interface {
+	TemplateInstances(namespace string) TemplateInstanceInterface
+}
+
+// TemplateInstanceInterface exposes methods on TemplateInstance resources.
+type TemplateInstanceInterface interface {
+	List(opts kapi.ListOptions) (*templateapi.TemplateInstanceList, error)
+	Get(name string) (*templateapi.TemplateInstance, error)
+	Create(instance *templateapi.TemplateInstance) (*templateapi.TemplateInstance, error)
+	Update(instance *templateapi.TemplateInstance) (*templateapi.TemplateInstance, error)
+	Delete(name string, options *kapi.DeleteOptions) error
+	Watch(opts kapi.ListOptions) (watch.Interface, error)
+}
+
+// templateInstances implements TemplateInstancesNamespacer interface
+type templateInstances struct {
+	r  *Client
+	ns string
+}
+
+// newTemplateInstances returns a templateInstances
+func newTemplateInstances(c *Client, namespace string) *templateInstances {
+	return &templateInstances{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+// List returns a list of template instances that match the label and field selectors.
+func (c *templateInstances) List(opts kapi.ListOptions) (result *templateapi.TemplateInstanceList, err error) {
+	result = &templateapi.TemplateInstanceList{}
+	err = c.r.Get().
+		Namespace(c.ns).
+		Resource("templateInstances").
+		VersionedParams(&opts, kapi.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// Get returns information about a particular template instance and error if one occurs.
+func (c *templateInstances) Get(name string) (result *templateapi.TemplateInstance, err error) {
+	result = &templateapi.TemplateInstance{}
+	err = c.r.Get().Namespace(c.ns).Resource("templateInstances").Name(name).Do().Into(result)
+	return
+}
+
+// Create creates new template instance. Returns the server's representation of the template instance and error if one occurs.
+func (c *templateInstances) Create(instance *templateapi.TemplateInstance) (result *templateapi.TemplateInstance, err error) {
+	result = &templateapi.TemplateInstance{}
+	err = c.r.Post().Namespace(c.ns).Resource("templateInstances").Body(instance).Do().Into(result)
+	return
+}
+
+// Update updates the template instance on server. Returns the server's representation of the template instance and error if one occurs.
+func (c *templateInstances) Update(instance *templateapi.TemplateInstance) (result *templateapi.TemplateInstance, err error) {
+	result = &templateapi.TemplateInstance{}
+	err = c.r.Put().Namespace(c.ns).Resource("templateInstances").Name(instance.Name).Body(instance).Do().Into(result)
+	return
+}
+
+// Delete deletes a template instance, returns error if one occurs. Without
+// options the server keeps the template instance until the objects it refers
+// to have been deleted.
+func (c *templateInstances) Delete(name string, options *kapi.DeleteOptions) (err error) {
+	err = c.r.Delete().Namespace(c.ns).Resource("templateInstances").Name(name).Body(options).Do().Error()
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested template instances
+func (c *templateInstances) Watch(opts kapi.ListOptions) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Namespace(c.ns).
+		Resource("templateInstances").
+		VersionedParams(&opts, kapi.ParameterCodec).
+		Watch()
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/watch"
+
+	templateapi "github.com/openshift/origin/pkg/template/api"
+)
+
+// TemplateRepositoriesNamespacer has methods to work with TemplateRepository resources in a namespace
+type TemplateRepositoriesNamespacer interface {
+	TemplateRepositories(namespace string) TemplateRepositoryInterface
+}
+
+// TemplateRepositoryInterface exposes methods on TemplateRepository resources.
+type TemplateRepositoryInterface interface {
+	List(opts kapi.ListOptions) (*templateapi.TemplateRepositoryList, error)
+	Get(name string) (*templateapi.TemplateRepository, error)
+	Create(repository *templateapi.TemplateRepository) (*templateapi.TemplateRepository, error)
+	Update(repository *templateapi.TemplateRepository) (*templateapi.TemplateRepository, error)
+	Delete(name string) error
+	Watch(opts kapi.ListOptions) (watch.Interface, error)
+}
+
+// templateRepositories implements TemplateRepositoriesNamespacer interface
+type templateRepositories struct {
+	r  *Client
+	ns string
+}
+
+// newTemplateRepositories returns a templateRepositories
+func newTemplateRepositories(c *Client, namespace string) *templateRepositories {
+	return &templateRepositories{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+// List returns a list of template repositories that match the label and field selectors.
+func (c *templateRepositories) List(opts kapi.ListOptions) (result *templateapi.TemplateRepositoryList, err error) {
+	result = &templateapi.TemplateRepositoryList{}
+	err = c.r.Get().
+		Namespace(c.ns).
+		Resource("templateRepositories").
+		VersionedParams(&opts, kapi.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// Get returns information about a particular template repository and error if one occurs.
+func (c *templateRepositories) Get(name string) (result *templateapi.TemplateRepository, err error) {
+	result = &templateapi.TemplateRepository{}
+	err = c.r.Get().Namespace(c.ns).Resource("templateRepositories").Name(name).Do().Into(result)
+	return
+}
+
+// Create creates new template repository. Returns the server's representation of the template repository and error if one occurs.
+func (c *templateRepositories) Create(repository *templateapi.TemplateRepository) (result *templateapi.TemplateRepository, err error) {
+	result = &templateapi.TemplateRepository{}
+	err = c.r.Post().Namespace(c.ns).Resource("templateRepositories").Body(repository).Do().Into(result)
+	return
+}
+
+// Update updates the template repository on server. Returns the server's representation of the template repository and error if one occurs.
+func (c *templateRepositories) Update(repository *templateapi.TemplateRepository) (result *templateapi.TemplateRepository, err error) {
+	result = &templateapi.TemplateRepository{}
+	err = c.r.Put().Namespace(c.ns).Resource("templateRepositories").Name(repository.Name).Body(repository).Do().Into(result)
+	return
+}
+
+// Delete deletes a template repository, returns error if one occurs. The
+// synchronized templates are kept.
+func (c *templateRepositories) Delete(name string) (err error) {
+	err = c.r.Delete().Namespace(c.ns).Resource("templateRepositories").Name(name).Do().Error()
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested template repositories
+func (c *templateRepositories) Watch(opts kapi.ListOptions) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Namespace(c.ns).
+		Resource("templateRepositories").
+		VersionedParams(&opts, kapi.ParameterCodec).
+		Watch()
+}
@@ -201,6 +201,16 @@ func (c *Fake) Templates(namespace string) client.TemplateInterface {
 	return &FakeTemplates{Fake: c, Namespace: namespace}
 }
 
+// TemplateInstances provides a fake REST client for TemplateInstances
+func (c *Fake) TemplateInstances(namespace string) client.TemplateInstanceInterface {
+	return &FakeTemplateInstances{Fake: c, Namespace: namespace}
+}
+
+// TemplateRepositories provides a fake REST client for TemplateRepositories
+func (c *Fake) TemplateRepositories(namespace string) client.TemplateRepositoryInterface {
+	return &FakeTemplateRepositories{Fake: c, Namespace: namespace}
+}
+
 // TemplateConfigs provides a fake REST client for TemplateConfigs
 func (c *Fake) TemplateConfigs(namespace string) client.TemplateConfigInterface {
 	return &FakeTemplateConfigs{Fake: c, Namespace: namespace}
@@ -281,9 +291,14 @@ func (c *Fake) OAuthAccessTokens() client.OAuthAccessTokenInterface {
 	return &FakeOAuthAccessTokens{Fake: c}
 }
 
+// OAuthAuthorizeTokens provides a fake REST client for OAuthAuthorizeTokens
+func (c *Fake) OAuthAuthorizeTokens() client.OAuthAuthorizeTokenInterface {
+	return &FakeOAuthAuthorizeTokens{Fake: c}
+}
+
 // LocalSubjectAccessReviews provides a fake REST client for SubjectAccessReviews
 func (c *Fake) LocalSubjectAccessReviews(namespace string) client.LocalSubjectAccessReviewInterface {
-	return &FakeLocalSubjectAccessReviews{Fake: c}
+	return &FakeLocalSubjectAccessReviews{Fake: c, Namespace: namespace}
 }
 
 // SubjectAccessReviews provides a fake REST client for ClusterSubjectAccessReviews
@@ -80,6 +80,17 @@ func (c *FakeDeploymentConfigs) Rollback(inObj *deployapi.DeploymentConfigRollba
 	return obj.(*deployapi.DeploymentConfig), err
 }
 
+func (c *FakeDeploymentConfigs) Instantiate(request *deployapi.DeploymentRequest) (*deployapi.DeploymentConfig, error) {
+	action := ktestclient.NewCreateAction("deploymentconfigs", c.Namespace, request)
+	action.Subresource = "instantiate"
+	obj, err := c.Fake.Invokes(action, &deployapi.DeploymentConfig{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*deployapi.DeploymentConfig), err
+}
+
 func (c *FakeDeploymentConfigs) GetScale(name string) (*extensions.Scale, error) {
 	obj, err := c.Fake.Invokes(ktestclient.NewGetAction("deploymentconfigs/scale", c.Namespace, name), &extensions.Scale{})
 	if obj == nil {
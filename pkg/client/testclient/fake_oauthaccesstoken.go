@@ -1,6 +1,7 @@
 package testclient
 
 import (
+	kapi "k8s.io/kubernetes/pkg/api"
 	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
 
 	oauthapi "github.com/openshift/origin/pkg/oauth/api"
@@ -12,7 +13,21 @@ type FakeOAuthAccessTokens struct {
 	Fake *Fake
 }
 
+func (c *FakeOAuthAccessTokens) List(opts kapi.ListOptions) (*oauthapi.OAuthAccessTokenList, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewRootListAction("oauthaccesstokens", opts), &oauthapi.OAuthAccessTokenList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*oauthapi.OAuthAccessTokenList), err
+}
+
 func (c *FakeOAuthAccessTokens) Delete(name string) error {
 	_, err := c.Fake.Invokes(ktestclient.NewRootDeleteAction("oauthaccesstokens", name), &oauthapi.OAuthAccessToken{})
 	return err
 }
+
+func (c *FakeOAuthAccessTokens) DeleteCollection(opts kapi.ListOptions) error {
+	_, err := c.Fake.Invokes(ktestclient.NewRootDeleteCollectionAction("oauthaccesstokens", opts), &oauthapi.OAuthAccessTokenList{})
+	return err
+}
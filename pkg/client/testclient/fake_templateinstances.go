@@ -0,0 +1,61 @@
+package testclient
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/watch"
+
+	templateapi "github.com/openshift/origin/pkg/template/api"
+)
+
+// FakeTemplateInstances implements TemplateInstanceInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeTemplateInstances struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeTemplateInstances) Get(name string) (*templateapi.TemplateInstance, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewGetAction("templateinstances", c.Namespace, name), &templateapi.TemplateInstance{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*templateapi.TemplateInstance), err
+}
+
+func (c *FakeTemplateInstances) List(opts kapi.ListOptions) (*templateapi.TemplateInstanceList, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewListAction("templateinstances", c.Namespace, opts), &templateapi.TemplateInstanceList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*templateapi.TemplateInstanceList), err
+}
+
+func (c *FakeTemplateInstances) Create(inObj *templateapi.TemplateInstance) (*templateapi.TemplateInstance, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewCreateAction("templateinstances", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*templateapi.TemplateInstance), err
+}
+
+func (c *FakeTemplateInstances) Update(inObj *templateapi.TemplateInstance) (*templateapi.TemplateInstance, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewUpdateAction("templateinstances", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*templateapi.TemplateInstance), err
+}
+
+func (c *FakeTemplateInstances) Delete(name string, options *kapi.DeleteOptions) error {
+	_, err := c.Fake.Invokes(ktestclient.NewDeleteAction("templateinstances", c.Namespace, name), &templateapi.TemplateInstance{})
+	return err
+}
+
+func (c *FakeTemplateInstances) Watch(opts kapi.ListOptions) (watch.Interface, error) {
+	return c.Fake.InvokesWatch(ktestclient.NewWatchAction("templateinstances", c.Namespace, opts))
+}
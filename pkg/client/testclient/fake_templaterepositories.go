@@ -0,0 +1,61 @@
+package testclient
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/watch"
+
+	templateapi "github.com/openshift/origin/pkg/template/api"
+)
+
+// FakeTemplateRepositories implements TemplateRepositoryInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeTemplateRepositories struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeTemplateRepositories) Get(name string) (*templateapi.TemplateRepository, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewGetAction("templaterepositories", c.Namespace, name), &templateapi.TemplateRepository{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*templateapi.TemplateRepository), err
+}
+
+func (c *FakeTemplateRepositories) List(opts kapi.ListOptions) (*templateapi.TemplateRepositoryList, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewListAction("templaterepositories", c.Namespace, opts), &templateapi.TemplateRepositoryList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*templateapi.TemplateRepositoryList), err
+}
+
+func (c *FakeTemplateRepositories) Create(inObj *templateapi.TemplateRepository) (*templateapi.TemplateRepository, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewCreateAction("templaterepositories", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*templateapi.TemplateRepository), err
+}
+
+func (c *FakeTemplateRepositories) Update(inObj *templateapi.TemplateRepository) (*templateapi.TemplateRepository, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewUpdateAction("templaterepositories", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*templateapi.TemplateRepository), err
+}
+
+func (c *FakeTemplateRepositories) Delete(name string) error {
+	_, err := c.Fake.Invokes(ktestclient.NewDeleteAction("templaterepositories", c.Namespace, name), &templateapi.TemplateRepository{})
+	return err
+}
+
+func (c *FakeTemplateRepositories) Watch(opts kapi.ListOptions) (watch.Interface, error) {
+	return c.Fake.InvokesWatch(ktestclient.NewWatchAction("templaterepositories", c.Namespace, opts))
+}
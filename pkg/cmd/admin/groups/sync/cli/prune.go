@@ -141,7 +141,7 @@ func (o *PruneOptions) Complete(whitelistFile, blacklistFile, configFile string,
 		return err
 	}
 
-	o.Config, err = decodeSyncConfigFromFile(configFile)
+	o.Config, err = DecodeSyncConfigFromFile(configFile)
 	if err != nil {
 		return err
 	}
@@ -170,6 +170,11 @@ func (o *PruneOptions) Validate() error {
 // Run creates the GroupSyncer specified and runs it to sync groups
 // the arguments are only here because its the only way to get the printer we need
 func (o *PruneOptions) Run(cmd *cobra.Command, f *clientcmd.Factory) error {
+	return o.Prune()
+}
+
+// Prune creates the GroupPruner specified and runs it to prune groups
+func (o *PruneOptions) Prune() error {
 	bindPassword, err := api.ResolveStringValue(o.Config.BindPassword)
 	if err != nil {
 		return err
@@ -191,7 +196,7 @@ func (o *PruneOptions) Run(cmd *cobra.Command, f *clientcmd.Factory) error {
 		DryRun:      !o.Confirm,
 
 		Out: o.Out,
-		Err: os.Stderr,
+		Err: o.Stderr,
 	}
 
 	listerMapper, err := getOpenShiftGroupListerMapper(clientConfig.Host(), o)
@@ -209,7 +214,6 @@ func (o *PruneOptions) Run(cmd *cobra.Command, f *clientcmd.Factory) error {
 	// Now we run the pruner and report any errors
 	pruneErrors := pruner.Prune()
 	return kerrs.NewAggregate(pruneErrors)
-
 }
 
 func buildPruneBuilder(clientConfig ldapclient.Config, pruneConfig *api.LDAPSyncConfig) (PruneBuilder, error) {
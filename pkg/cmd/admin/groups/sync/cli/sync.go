@@ -29,6 +29,7 @@ import (
 	"github.com/openshift/origin/pkg/cmd/server/api/validation"
 	ocmdutil "github.com/openshift/origin/pkg/cmd/util"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	userapi "github.com/openshift/origin/pkg/user/api"
 )
 
 const (
@@ -214,7 +215,7 @@ func (o *SyncOptions) Complete(typeArg, whitelistFile, blacklistFile, configFile
 		}
 	}
 
-	o.Config, err = decodeSyncConfigFromFile(configFile)
+	o.Config, err = DecodeSyncConfigFromFile(configFile)
 	if err != nil {
 		return err
 	}
@@ -257,7 +258,8 @@ func buildNameList(args []string, file string) ([]string, error) {
 	return list, nil
 }
 
-func decodeSyncConfigFromFile(configFile string) (*api.LDAPSyncConfig, error) {
+// DecodeSyncConfigFromFile reads the LDAPSyncConfig in configFile
+func DecodeSyncConfigFromFile(configFile string) (*api.LDAPSyncConfig, error) {
 	var config api.LDAPSyncConfig
 	yamlConfig, err := ioutil.ReadFile(configFile)
 	if err != nil {
@@ -337,20 +339,49 @@ func (o *SyncOptions) Validate() error {
 // Run creates the GroupSyncer specified and runs it to sync groups
 // the arguments are only here because its the only way to get the printer we need
 func (o *SyncOptions) Run(cmd *cobra.Command, f *clientcmd.Factory) error {
-	bindPassword, err := api.ResolveStringValue(o.Config.BindPassword)
+	openshiftGroups, syncErr := o.Sync()
+	if o.Confirm {
+		return syncErr
+	}
+	// errors syncing individual groups are reported after the groups that could be synced
+	if _, isAggregate := syncErr.(kerrs.Aggregate); syncErr != nil && !isAggregate {
+		return syncErr
+	}
+
+	list := &kapi.List{}
+	for _, item := range openshiftGroups {
+		list.Items = append(list.Items, item)
+	}
+	var err error
+	list.Items, err = ocmdutil.ConvertItemsForDisplayFromDefaultCommand(cmd, list.Items)
 	if err != nil {
 		return err
 	}
+
+	if err := f.Factory.PrintObject(cmd, list, o.Out); err != nil {
+		return err
+	}
+
+	return syncErr
+}
+
+// Sync creates the GroupSyncer specified and runs it to sync groups. Errors syncing individual groups
+// are returned as an aggregate along with the groups that were synced.
+func (o *SyncOptions) Sync() ([]*userapi.Group, error) {
+	bindPassword, err := api.ResolveStringValue(o.Config.BindPassword)
+	if err != nil {
+		return nil, err
+	}
 	clientConfig, err := ldaputil.NewLDAPClientConfig(o.Config.URL, o.Config.BindDN, bindPassword, o.Config.CA, o.Config.Insecure)
 	if err != nil {
-		return fmt.Errorf("could not determine LDAP client configuration: %v", err)
+		return nil, fmt.Errorf("could not determine LDAP client configuration: %v", err)
 	}
 
 	errorHandler := o.CreateErrorHandler()
 
 	syncBuilder, err := buildSyncBuilder(clientConfig, o.Config, errorHandler)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// populate schema-independent syncer fields
@@ -360,7 +391,7 @@ func (o *SyncOptions) Run(cmd *cobra.Command, f *clientcmd.Factory) error {
 		DryRun:      !o.Confirm,
 
 		Out: o.Out,
-		Err: os.Stderr,
+		Err: o.Stderr,
 	}
 
 	switch o.Source {
@@ -369,7 +400,7 @@ func (o *SyncOptions) Run(cmd *cobra.Command, f *clientcmd.Factory) error {
 		// pinned by the existing mapping.
 		listerMapper, err := getOpenShiftGroupListerMapper(clientConfig.Host(), o)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		syncer.GroupLister = listerMapper
 		syncer.GroupNameMapper = listerMapper
@@ -377,47 +408,30 @@ func (o *SyncOptions) Run(cmd *cobra.Command, f *clientcmd.Factory) error {
 	case GroupSyncSourceLDAP:
 		syncer.GroupLister, err = getLDAPGroupLister(syncBuilder, o)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		syncer.GroupNameMapper, err = getGroupNameMapper(syncBuilder, o)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 	default:
-		return fmt.Errorf("invalid group source: %v", o.Source)
+		return nil, fmt.Errorf("invalid group source: %v", o.Source)
 	}
 
 	syncer.GroupMemberExtractor, err = syncBuilder.GetGroupMemberExtractor()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	syncer.UserNameMapper, err = syncBuilder.GetUserNameMapper()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Now we run the Syncer and report any errors
 	openshiftGroups, syncErrors := syncer.Sync()
-	if o.Confirm {
-		return kerrs.NewAggregate(syncErrors)
-	}
-
-	list := &kapi.List{}
-	for _, item := range openshiftGroups {
-		list.Items = append(list.Items, item)
-	}
-	list.Items, err = ocmdutil.ConvertItemsForDisplayFromDefaultCommand(cmd, list.Items)
-	if err != nil {
-		return err
-	}
-
-	if err := f.Factory.PrintObject(cmd, list, o.Out); err != nil {
-		return err
-	}
-
-	return kerrs.NewAggregate(syncErrors)
+	return openshiftGroups, kerrs.NewAggregate(syncErrors)
 }
 
 func buildSyncBuilder(clientConfig ldapclient.Config, syncConfig *api.LDAPSyncConfig, errorHandler syncerror.Handler) (SyncBuilder, error) {
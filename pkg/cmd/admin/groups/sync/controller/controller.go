@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/golang/glog"
+
+	kerrs "k8s.io/kubernetes/pkg/util/errors"
+	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
+	utilwait "k8s.io/kubernetes/pkg/util/wait"
+
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/admin/groups/sync/cli"
+	"github.com/openshift/origin/pkg/cmd/server/api"
+)
+
+// LDAPGroupSyncController periodically syncs OpenShift groups with the records of an LDAP server, as
+// `oadm groups sync --confirm` does, and optionally prunes the groups whose LDAP group no longer exists.
+// The outcome of the last sync of each group is recorded in the annotations of the group.
+type LDAPGroupSyncController struct {
+	interval time.Duration
+	syncer   *cli.SyncOptions
+	pruner   *cli.PruneOptions
+
+	stopChan chan struct{}
+}
+
+// NewLDAPGroupSyncController returns a controller syncing all the groups of the LDAP server described by
+// config every interval, and pruning groups after every sync if prune is true. It returns an error if
+// config is not valid.
+func NewLDAPGroupSyncController(config *api.LDAPSyncConfig, groups client.GroupInterface, prune bool, interval time.Duration) (*LDAPGroupSyncController, error) {
+	c := &LDAPGroupSyncController{
+		interval: interval,
+		syncer: &cli.SyncOptions{
+			Source:         cli.GroupSyncSourceLDAP,
+			Config:         config,
+			Whitelist:      []string{},
+			Confirm:        true,
+			GroupInterface: groups,
+			Out:            ioutil.Discard,
+			Stderr:         ioutil.Discard,
+		},
+	}
+	if prune {
+		c.pruner = &cli.PruneOptions{
+			Config:         config,
+			Whitelist:      []string{},
+			Confirm:        true,
+			GroupInterface: groups,
+			Out:            ioutil.Discard,
+			Stderr:         ioutil.Discard,
+		}
+	}
+
+	// the pruner uses the same config
+	if err := c.syncer.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Run starts the controller and returns immediately.
+func (c *LDAPGroupSyncController) Run() {
+	if c.stopChan == nil {
+		c.stopChan = make(chan struct{})
+		go utilwait.Until(func() {
+			if err := c.RunOnce(); err != nil {
+				utilruntime.HandleError(err)
+			}
+		}, c.interval, c.stopChan)
+	}
+}
+
+// Stop gracefully shuts down the controller.
+func (c *LDAPGroupSyncController) Stop() {
+	if c.stopChan != nil {
+		close(c.stopChan)
+		c.stopChan = nil
+	}
+}
+
+// RunOnce syncs and prunes groups once, and returns the errors encountered.
+func (c *LDAPGroupSyncController) RunOnce() error {
+	errs := []error{}
+
+	groups, err := c.syncer.Sync()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("unable to sync groups with %s: %v", c.syncer.Config.URL, err))
+	}
+	glog.V(4).Infof("Synced %d groups with %s", len(groups), c.syncer.Config.URL)
+
+	if c.pruner != nil {
+		if err := c.pruner.Prune(); err != nil {
+			errs = append(errs, fmt.Errorf("unable to prune groups synced with %s: %v", c.pruner.Config.URL, err))
+		}
+	}
+
+	return kerrs.NewAggregate(errs)
+}
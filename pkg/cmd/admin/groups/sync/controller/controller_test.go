@@ -0,0 +1,58 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	"github.com/openshift/origin/pkg/cmd/server/api"
+)
+
+func testSyncConfig(url string) *api.LDAPSyncConfig {
+	return &api.LDAPSyncConfig{
+		URL: url,
+		ActiveDirectoryConfig: &api.ActiveDirectoryConfig{
+			AllUsersQuery: api.LDAPQuery{
+				BaseDN: "ou=users,dc=example,dc=com",
+				Scope:  "sub",
+				Filter: "(objectClass=inetOrgPerson)",
+			},
+			UserNameAttributes:        []string{"mail"},
+			GroupMembershipAttributes: []string{"memberOf"},
+		},
+	}
+}
+
+func TestNewLDAPGroupSyncControllerValidation(t *testing.T) {
+	groups := testclient.NewSimpleFake().Groups()
+
+	if _, err := NewLDAPGroupSyncController(testSyncConfig(""), groups, true, time.Minute); err == nil {
+		t.Errorf("expected a config without a URL to be rejected")
+	}
+	invalid := testSyncConfig("ldap://127.0.0.1:389")
+	invalid.ActiveDirectoryConfig = nil
+	if _, err := NewLDAPGroupSyncController(invalid, groups, false, time.Minute); err == nil {
+		t.Errorf("expected a config without a schema to be rejected")
+	}
+	if _, err := NewLDAPGroupSyncController(testSyncConfig("ldap://127.0.0.1:389"), groups, true, time.Minute); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestLDAPGroupSyncControllerRunOnce(t *testing.T) {
+	// nothing listens on port 1, the sync and the prune fail to reach the server
+	c, err := NewLDAPGroupSyncController(testSyncConfig("ldap://127.0.0.1:1"), testclient.NewSimpleFake().Groups(), true, time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = c.RunOnce()
+	if err == nil {
+		t.Fatalf("expected an error reaching the LDAP server")
+	}
+	for _, expected := range []string{"unable to sync groups with ldap://127.0.0.1:1", "unable to prune groups synced with ldap://127.0.0.1:1"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected %q in the error, got %v", expected, err)
+		}
+	}
+}
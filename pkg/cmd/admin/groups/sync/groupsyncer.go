@@ -69,6 +69,7 @@ func (s *LDAPGroupSyncer) Sync() ([]*userapi.Group, []error) {
 		if err != nil {
 			fmt.Fprintf(s.Err, "Error determining LDAP group membership for %q: %v.\n", ldapGroupUID, err)
 			errors = append(errors, err)
+			s.recordSyncError(ldapGroupUID, err)
 			continue
 		}
 
@@ -77,6 +78,7 @@ func (s *LDAPGroupSyncer) Sync() ([]*userapi.Group, []error) {
 		if err != nil {
 			fmt.Fprintf(s.Err, "Error determining usernames for LDAP group %q: %v.\n", ldapGroupUID, err)
 			errors = append(errors, err)
+			s.recordSyncError(ldapGroupUID, err)
 			continue
 		}
 		glog.V(1).Infof("Has OpenShift users %v", usernames)
@@ -95,6 +97,7 @@ func (s *LDAPGroupSyncer) Sync() ([]*userapi.Group, []error) {
 			if err := s.updateOpenShiftGroup(openshiftGroup); err != nil {
 				fmt.Fprintf(s.Err, "Error updating OpenShift group %q for LDAP group %q: %v.\n", openshiftGroup.Name, ldapGroupUID, err)
 				errors = append(errors, err)
+				s.recordSyncError(ldapGroupUID, err)
 				continue
 			}
 		}
@@ -173,10 +176,35 @@ func (s *LDAPGroupSyncer) makeOpenShiftGroup(ldapGroupUID string, usernames []st
 	// overwrite Group Users data
 	group.Users = usernames
 	group.Annotations[ldaputil.LDAPSyncTimeAnnotation] = ISO8601(time.Now())
+	delete(group.Annotations, ldaputil.LDAPSyncErrorAnnotation)
 
 	return group, nil
 }
 
+// recordSyncError records the error of a failed sync in the OpenShift Group previously synced with the LDAP group, if any
+func (s *LDAPGroupSyncer) recordSyncError(ldapGroupUID string, syncErr error) {
+	if s.DryRun {
+		return
+	}
+	groupName, err := s.GroupNameMapper.GroupNameFor(ldapGroupUID)
+	if err != nil {
+		return
+	}
+	group, err := s.GroupClient.Get(groupName)
+	if err != nil {
+		return
+	}
+	// only record errors on groups that were synced with this LDAP group
+	if group.Annotations[ldaputil.LDAPURLAnnotation] != s.Host || group.Annotations[ldaputil.LDAPUIDAnnotation] != ldapGroupUID {
+		return
+	}
+
+	group.Annotations[ldaputil.LDAPSyncErrorAnnotation] = syncErr.Error()
+	if _, err := s.GroupClient.Update(group); err != nil {
+		glog.V(2).Infof("Unable to record the sync error of OpenShift group %q: %v", group.Name, err)
+	}
+}
+
 // ISO8601 returns an ISO 6801 formatted string from a time.
 func ISO8601(t time.Time) string {
 	var tz string
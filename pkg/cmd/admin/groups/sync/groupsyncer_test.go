@@ -230,6 +230,38 @@ func TestMissingLDAPGroupUIDMapping(t *testing.T) {
 	checkClientForGroups(tc, newDefaultOpenShiftGroups(testGroupSyncer.Host), t)
 }
 
+func TestSyncErrorRecorded(t *testing.T) {
+	testGroupSyncer, tc := newTestSyncer()
+	host := testGroupSyncer.Host
+	existingGroup := newDefaultOpenShiftGroups(host)[0]
+	tc.PrependReactor("get", "groups", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		if action.(ktestclient.GetAction).GetName() != existingGroup.Name {
+			return false, nil, nil
+		}
+		t, _ := kapi.Scheme.DeepCopy(existingGroup)
+		return true, t.(*userapi.Group), nil
+	})
+	delete(testGroupSyncer.GroupMemberExtractor.(*TestGroupMemberExtractor).MemberMapping, Group1UID)
+
+	_, errs := testGroupSyncer.Sync()
+	if len(errs) != 1 {
+		t.Fatalf("unexpected sync errors: %v", errs)
+	}
+
+	expectedGroup := newDefaultOpenShiftGroups(host)[0]
+	expectedGroup.Annotations[ldaputil.LDAPSyncErrorAnnotation] = errs[0].Error()
+	checkClientForGroups(tc, []*userapi.Group{expectedGroup}, t)
+
+	// a successful sync clears the error
+	testGroupSyncer.GroupMemberExtractor.(*TestGroupMemberExtractor).MemberMapping[Group1UID] = Group1Members
+	existingGroup = expectedGroup
+	tc.ClearActions()
+	if _, errs := testGroupSyncer.Sync(); len(errs) != 0 {
+		t.Fatalf("unexpected sync errors: %v", errs)
+	}
+	checkClientForGroups(tc, newDefaultOpenShiftGroups(host), t)
+}
+
 func checkClientForGroups(tc *testclient.Fake, expectedGroups []*userapi.Group, t *testing.T) {
 	actualGroups := extractActualGroups(tc)
 
@@ -188,16 +188,31 @@ func (o *ReconcileClusterRolesOptions) ChangedClusterRoles() ([]*authorizationap
 		}
 
 		// Copy any existing labels/annotations, so the displayed update is correct
-		// This assumes bootstrap roles will not set any labels/annotations
+		// This assumes bootstrap roles will only set the labels selecting them for aggregation and no annotations
 		// These aren't actually used during update; the latest labels/annotations are pulled from the existing object again
-		expectedClusterRole.Labels = actualClusterRole.Labels
+		expectedClusterRole.Labels = withLabels(actualClusterRole.Labels, expectedClusterRole.Labels)
 		expectedClusterRole.Annotations = actualClusterRole.Annotations
+		changed := !kapi.Semantic.DeepEqual(expectedClusterRole.Labels, actualClusterRole.Labels)
+
+		if o.Union && expectedClusterRole.AggregationRule == nil {
+			expectedClusterRole.AggregationRule = actualClusterRole.AggregationRule
+		}
+		if !kapi.Semantic.DeepEqual(expectedClusterRole.AggregationRule, actualClusterRole.AggregationRule) {
+			changed = true
+		} else if expectedClusterRole.AggregationRule != nil {
+			// the rules of aggregating roles are kept in sync by the cluster role aggregation controller
+			expectedClusterRole.Rules = actualClusterRole.Rules
+		}
 
 		if !kapi.Semantic.DeepEqual(expectedClusterRole.Rules, actualClusterRole.Rules) {
 			if o.Union {
 				_, missingRules := rulevalidation.Covers(expectedClusterRole.Rules, actualClusterRole.Rules)
 				expectedClusterRole.Rules = append(expectedClusterRole.Rules, missingRules...)
 			}
+			changed = true
+		}
+
+		if changed {
 			changedRoles = append(changedRoles, expectedClusterRole)
 		}
 	}
@@ -229,6 +244,8 @@ func (o *ReconcileClusterRolesOptions) ReplaceChangedRoles(changedRoles []*autho
 		}
 
 		role.Rules = changedRoles[i].Rules
+		role.AggregationRule = changedRoles[i].AggregationRule
+		role.Labels = withLabels(role.Labels, changedRoles[i].Labels)
 		updatedRole, err := o.RoleClient.Update(role)
 		if err != nil {
 			return err
@@ -239,3 +256,19 @@ func (o *ReconcileClusterRolesOptions) ReplaceChangedRoles(changedRoles []*autho
 
 	return nil
 }
+
+// withLabels returns labels with the required labels added to them
+func withLabels(labels, required map[string]string) map[string]string {
+	if len(required) == 0 {
+		return labels
+	}
+
+	merged := map[string]string{}
+	for key, value := range labels {
+		merged[key] = value
+	}
+	for key, value := range required {
+		merged[key] = value
+	}
+	return merged
+}
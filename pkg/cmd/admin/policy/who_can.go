@@ -18,13 +18,27 @@ import (
 
 const WhoCanRecommendedName = "who-can"
 
+const (
+	whoCanExample = `  # List who can get pods in the current project
+  $ %[1]s get pods
+
+  # List who can get the logs of the pod named "frontend-1"
+  $ %[1]s get pods frontend-1 --subresource=log
+
+  # List who can get the /healthz non-resource URL
+  $ %[1]s get /healthz`
+)
+
 type whoCanOptions struct {
 	allNamespaces    bool
 	bindingNamespace string
 	client           *client.Client
 
-	verb     string
-	resource string
+	verb           string
+	resource       string
+	resourceName   string
+	subresource    string
+	nonResourceURL string
 }
 
 // NewCmdWhoCan implements the OpenShift cli who-can command
@@ -32,9 +46,14 @@ func NewCmdWhoCan(name, fullName string, f *clientcmd.Factory, out io.Writer) *c
 	options := &whoCanOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "who-can VERB RESOURCE",
+		Use:   "who-can VERB (RESOURCE [NAME] | NONRESOURCEURL)",
 		Short: "List who can perform the specified action on a resource",
-		Long:  "List who can perform the specified action on a resource",
+		Long: `List who can perform the specified action on a resource
+
+The resource can be narrowed to a single object by giving its name, and to one of its subresources with
+--subresource.  Arguments starting with a slash are non-resource URLs like /healthz.  The role bindings
+granting the action are listed along with the users and groups they are bound to.`,
+		Example: fmt.Sprintf(whoCanExample, fullName),
 		Run: func(cmd *cobra.Command, args []string) {
 			if err := options.complete(args); err != nil {
 				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, err.Error()))
@@ -53,29 +72,48 @@ func NewCmdWhoCan(name, fullName string, f *clientcmd.Factory, out io.Writer) *c
 	}
 
 	cmd.Flags().BoolVar(&options.allNamespaces, "all-namespaces", options.allNamespaces, "If present, list who can perform the specified action in all namespaces.")
+	cmd.Flags().StringVar(&options.subresource, "subresource", options.subresource, "The subresource of the resource, like log for pods.")
 
 	return cmd
 }
 
 func (o *whoCanOptions) complete(args []string) error {
-	if len(args) != 2 {
-		return errors.New("you must specify two arguments: verb and resource")
+	if len(args) < 2 || len(args) > 3 {
+		return errors.New("you must specify a verb and either a resource, optionally followed by a name, or a non-resource URL")
 	}
 
 	o.verb = args[0]
+	if strings.HasPrefix(args[1], "/") {
+		if len(args) != 2 || len(o.subresource) > 0 {
+			return errors.New("a non-resource URL cannot have a name or a subresource")
+		}
+		o.nonResourceURL = args[1]
+		return nil
+	}
+
 	o.resource = args[1]
+	if len(o.subresource) > 0 {
+		o.resource = o.resource + "/" + o.subresource
+	}
+	if len(args) == 3 {
+		o.resourceName = args[2]
+	}
 	return nil
 }
 
 func (o *whoCanOptions) run() error {
 	authorizationAttributes := authorizationapi.AuthorizationAttributes{
-		Resource: o.resource,
-		Verb:     o.verb,
+		Resource:         o.resource,
+		ResourceName:     o.resourceName,
+		Verb:             o.verb,
+		IsNonResourceURL: len(o.nonResourceURL) > 0,
+		Path:             o.nonResourceURL,
 	}
 
 	resourceAccessReviewResponse := &authorizationapi.ResourceAccessReviewResponse{}
 	var err error
-	if o.allNamespaces {
+	// non-resource URLs are only granted by cluster role bindings, they are not reviewed in a namespace
+	if o.allNamespaces || authorizationAttributes.IsNonResourceURL {
 		resourceAccessReviewResponse, err = o.client.ResourceAccessReviews().Create(&authorizationapi.ResourceAccessReview{Action: authorizationAttributes})
 	} else {
 		resourceAccessReviewResponse, err = o.client.LocalResourceAccessReviews(o.bindingNamespace).Create(&authorizationapi.LocalResourceAccessReview{Action: authorizationAttributes})
@@ -91,7 +129,14 @@ func (o *whoCanOptions) run() error {
 		fmt.Printf("Namespace: %s\n", resourceAccessReviewResponse.Namespace)
 	}
 	fmt.Printf("Verb:      %s\n", o.verb)
-	fmt.Printf("Resource:  %s\n\n", o.resource)
+	if authorizationAttributes.IsNonResourceURL {
+		fmt.Printf("URL:       %s\n\n", o.nonResourceURL)
+	} else if len(o.resourceName) > 0 {
+		fmt.Printf("Resource:  %s\n", o.resource)
+		fmt.Printf("Name:      %s\n\n", o.resourceName)
+	} else {
+		fmt.Printf("Resource:  %s\n\n", o.resource)
+	}
 	if len(resourceAccessReviewResponse.Users) == 0 {
 		fmt.Printf("Users:  none\n\n")
 	} else {
@@ -104,5 +149,38 @@ func (o *whoCanOptions) run() error {
 		fmt.Printf("Groups: %s\n\n", strings.Join(resourceAccessReviewResponse.Groups.List(), "\n        "))
 	}
 
+	if len(resourceAccessReviewResponse.Grants) > 0 {
+		fmt.Printf("Granted by:\n")
+		for _, grant := range resourceAccessReviewResponse.Grants {
+			fmt.Printf("  %s\n", describeGrant(grant))
+		}
+		fmt.Printf("\n")
+	}
+
 	return nil
 }
+
+// describeGrant returns a one line description of the role binding of a grant and of its subjects
+func describeGrant(grant authorizationapi.ResourceAccessGrant) string {
+	binding := "clusterrolebinding/" + grant.RoleBinding
+	if len(grant.Namespace) > 0 {
+		binding = "rolebinding/" + grant.RoleBinding + " in " + grant.Namespace
+	}
+	role := "clusterrole/" + grant.RoleRef.Name
+	if len(grant.RoleRef.Namespace) > 0 {
+		role = "role/" + grant.RoleRef.Name
+	}
+
+	subjects := []string{}
+	for _, user := range grant.Users {
+		subjects = append(subjects, "user "+user)
+	}
+	for _, group := range grant.Groups {
+		subjects = append(subjects, "group "+group)
+	}
+	if len(subjects) == 0 {
+		subjects = append(subjects, "no subjects")
+	}
+
+	return fmt.Sprintf("%s (%s): %s", binding, role, strings.Join(subjects, ", "))
+}
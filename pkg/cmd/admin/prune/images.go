@@ -12,6 +12,7 @@ import (
 	"text/tabwriter"
 	"time"
 
+	"github.com/docker/docker/pkg/units"
 	"github.com/spf13/cobra"
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/client/restclient"
@@ -34,7 +35,8 @@ const (
 	imagesLongDesc = `Prune images no longer needed due to age and/or status
 
 By default, the prune operation performs a dry run making no changes to internal registry. A
---confirm flag is needed for changes to be effective.
+--confirm flag is needed for changes to be effective. The amount of registry storage that is, or
+would be, reclaimed by deleting layer blobs is reported at the end.
 
 Only a user with a cluster role %s or higher who is logged-in will be able to actually delete the
 images.`
@@ -59,6 +61,9 @@ type PruneImagesOptions struct {
 
 	CABundle            string
 	RegistryUrlOverride string
+
+	// layerSizes is the size of each layer blob, used to report the space reclaimed by pruning.
+	layerSizes map[string]int64
 }
 
 // NewCmdPruneImages implements the OpenShift cli prune images command
@@ -119,6 +124,8 @@ func (o *PruneImagesOptions) Complete(f *clientcmd.Factory, args []string, out i
 		return err
 	}
 
+	o.layerSizes = layerSizes(allImages)
+
 	allStreams, err := osClient.ImageStreams(kapi.NamespaceAll).List(kapi.ListOptions{})
 	if err != nil {
 		return err
@@ -196,7 +203,7 @@ func (o *PruneImagesOptions) RunPruneImages() error {
 	imagePruner := &describingImagePruner{w: w}
 	imageStreamPruner := &describingImageStreamPruner{w: w}
 	layerPruner := &describingLayerPruner{w: w}
-	blobPruner := &describingBlobPruner{w: w}
+	blobPruner := &describingBlobPruner{w: w, sizes: o.layerSizes}
 	manifestPruner := &describingManifestPruner{w: w}
 
 	if o.Confirm {
@@ -209,7 +216,42 @@ func (o *PruneImagesOptions) RunPruneImages() error {
 		fmt.Fprintln(os.Stderr, "Dry run enabled - no modifications will be made. Add --confirm to remove images")
 	}
 
-	return o.Pruner.Prune(imagePruner, imageStreamPruner, layerPruner, blobPruner, manifestPruner)
+	err := o.Pruner.Prune(imagePruner, imageStreamPruner, layerPruner, blobPruner, manifestPruner)
+	if blobPruner.count > 0 {
+		if o.Confirm {
+			fmt.Fprintf(w, "\nDeleted %d blobs, freeing %s of registry storage\n", blobPruner.count, units.HumanSize(float64(blobPruner.size)))
+		} else {
+			fmt.Fprintf(w, "\nWould delete %d blobs, freeing %s of registry storage\n", blobPruner.count, units.HumanSize(float64(blobPruner.size)))
+		}
+	}
+	return err
+}
+
+// layerSizes returns the size of each layer blob referenced by the images, as recorded
+// in the image metadata.
+func layerSizes(images *imageapi.ImageList) map[string]int64 {
+	sizes := make(map[string]int64)
+	for _, image := range images.Items {
+		for _, layer := range image.DockerImageLayers {
+			if layer.Size > 0 {
+				sizes[layer.Name] = layer.Size
+			}
+		}
+	}
+	return sizes
+}
+
+// imageSize returns the size of the image recorded in its metadata, or the sum of the sizes
+// of its layers when the metadata does not record it. Zero is returned when the size is unknown.
+func imageSize(image *imageapi.Image) int64 {
+	if image.DockerImageMetadata.Size > 0 {
+		return image.DockerImageMetadata.Size
+	}
+	size := int64(0)
+	for _, layer := range image.DockerImageLayers {
+		size += layer.Size
+	}
+	return size
 }
 
 // describingImageStreamPruner prints information about each image stream update.
@@ -257,10 +299,14 @@ func (p *describingImagePruner) PruneImage(image *imageapi.Image) error {
 	if !p.headerPrinted {
 		p.headerPrinted = true
 		fmt.Fprintln(p.w, "\nDeleting images from server ...")
-		fmt.Fprintln(p.w, "IMAGE")
+		fmt.Fprintln(p.w, "IMAGE\tSIZE")
 	}
 
-	fmt.Fprintf(p.w, "%s\n", image.Name)
+	humanSize := "unknown"
+	if size := imageSize(image); size > 0 {
+		humanSize = units.HumanSize(float64(size))
+	}
+	fmt.Fprintf(p.w, "%s\t%s\n", image.Name, humanSize)
 
 	if p.delegate == nil {
 		return nil
@@ -307,11 +353,16 @@ func (p *describingLayerPruner) PruneLayer(registryClient *http.Client, registry
 }
 
 // describingBlobPruner prints information about each blob being deleted. If a
-// delegate exists, its PruneBlob function is invoked prior to returning.
+// delegate exists, its PruneBlob function is invoked prior to returning. The
+// number and total size of the deleted blobs are accumulated in count and size.
 type describingBlobPruner struct {
 	w             io.Writer
 	delegate      prune.BlobPruner
 	headerPrinted bool
+
+	sizes map[string]int64
+	count int
+	size  int64
 }
 
 var _ prune.BlobPruner = &describingBlobPruner{}
@@ -320,21 +371,26 @@ func (p *describingBlobPruner) PruneBlob(registryClient *http.Client, registryUR
 	if !p.headerPrinted {
 		p.headerPrinted = true
 		fmt.Fprintln(p.w, "\nDeleting registry layer blobs ...")
-		fmt.Fprintln(p.w, "BLOB")
+		fmt.Fprintln(p.w, "BLOB\tSIZE")
 	}
 
-	fmt.Fprintf(p.w, "%s\n", layer)
-
-	if p.delegate == nil {
-		return nil
+	size, ok := p.sizes[layer]
+	humanSize := "unknown"
+	if ok {
+		humanSize = units.HumanSize(float64(size))
 	}
+	fmt.Fprintf(p.w, "%s\t%s\n", layer, humanSize)
 
-	err := p.delegate.PruneBlob(registryClient, registryURL, layer)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "error deleting blob %s from the registry: %v\n", layer, err)
+	if p.delegate != nil {
+		if err := p.delegate.PruneBlob(registryClient, registryURL, layer); err != nil {
+			fmt.Fprintf(os.Stderr, "error deleting blob %s from the registry: %v\n", layer, err)
+			return err
+		}
 	}
 
-	return err
+	p.count++
+	p.size += size
+	return nil
 }
 
 // describingManifestPruner prints information about each repo manifest being
@@ -0,0 +1,147 @@
+package prune
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	"github.com/openshift/origin/pkg/image/prune"
+)
+
+func TestImageSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		image    imageapi.Image
+		expected int64
+	}{
+		{
+			name:     "size from metadata",
+			image:    imageapi.Image{DockerImageMetadata: imageapi.DockerImage{Size: 300}, DockerImageLayers: []imageapi.ImageLayer{{Name: "a", Size: 100}}},
+			expected: 300,
+		},
+		{
+			name:     "size from layers",
+			image:    imageapi.Image{DockerImageLayers: []imageapi.ImageLayer{{Name: "a", Size: 100}, {Name: "b", Size: 50}}},
+			expected: 150,
+		},
+		{
+			name:     "unknown size",
+			image:    imageapi.Image{},
+			expected: 0,
+		},
+	}
+
+	for _, test := range tests {
+		if size := imageSize(&test.image); size != test.expected {
+			t.Errorf("%s: expected size %d, got %d", test.name, test.expected, size)
+		}
+	}
+}
+
+type fakeBlobPruner struct {
+	err error
+}
+
+func (p *fakeBlobPruner) PruneBlob(registryClient *http.Client, registryURL, blob string) error {
+	return p.err
+}
+
+func TestDescribingBlobPrunerSize(t *testing.T) {
+	images := &imageapi.ImageList{
+		Items: []imageapi.Image{
+			{DockerImageLayers: []imageapi.ImageLayer{{Name: "layer1", Size: 1000}, {Name: "layer2", Size: 2000}}},
+			{DockerImageLayers: []imageapi.ImageLayer{{Name: "layer2", Size: 2000}, {Name: "layer3"}}},
+		},
+	}
+	out := &bytes.Buffer{}
+	pruner := &describingBlobPruner{w: out, sizes: layerSizes(images)}
+
+	for _, layer := range []string{"layer1", "layer2", "layer3"} {
+		if err := pruner.PruneBlob(nil, "registry", layer); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if pruner.count != 3 || pruner.size != 3000 {
+		t.Errorf("expected 3 blobs of 3000 bytes, got %d blobs of %d bytes", pruner.count, pruner.size)
+	}
+	if !strings.Contains(out.String(), "layer3\tunknown") {
+		t.Errorf("expected the size of layer3 to be unknown, got:\n%s", out.String())
+	}
+
+	// blobs which could not be deleted do not count towards the reclaimed storage
+	pruner.delegate = &fakeBlobPruner{err: errors.New("failed")}
+	if err := pruner.PruneBlob(nil, "registry", "layer1"); err == nil {
+		t.Fatalf("expected an error")
+	}
+	if pruner.count != 3 || pruner.size != 3000 {
+		t.Errorf("expected 3 blobs of 3000 bytes, got %d blobs of %d bytes", pruner.count, pruner.size)
+	}
+}
+
+// fakeImageRegistryPruner prunes the blobs of its layers from its registry.
+type fakeImageRegistryPruner struct {
+	registryURL string
+	layers      []string
+}
+
+func (p *fakeImageRegistryPruner) Prune(imagePruner prune.ImagePruner, streamPruner prune.ImageStreamPruner, layerPruner prune.LayerPruner, blobPruner prune.BlobPruner, manifestPruner prune.ManifestPruner) error {
+	var lastErr error
+	for _, layer := range p.layers {
+		if err := blobPruner.PruneBlob(http.DefaultClient, p.registryURL, layer); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+func TestRunPruneImagesSummary(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != "DELETE" || strings.HasSuffix(req.URL.Path, "/layer2") {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		confirm  bool
+		err      bool
+		expected string
+	}{
+		{
+			name:     "dry run",
+			expected: "Would delete 3 blobs, freeing 3 kB of registry storage",
+		},
+		{
+			name:     "confirm",
+			confirm:  true,
+			err:      true,
+			expected: "Deleted 2 blobs, freeing 1 kB of registry storage",
+		},
+	}
+
+	for _, test := range tests {
+		out := &bytes.Buffer{}
+		o := &PruneImagesOptions{
+			Pruner:     &fakeImageRegistryPruner{registryURL: strings.TrimPrefix(server.URL, "http://"), layers: []string{"layer1", "layer2", "layer3"}},
+			Client:     &testclient.Fake{},
+			Out:        out,
+			Confirm:    test.confirm,
+			layerSizes: map[string]int64{"layer1": 1000, "layer2": 2000},
+		}
+		err := o.RunPruneImages()
+		if (err != nil) != test.err {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if !strings.Contains(out.String(), test.expected) {
+			t.Errorf("%s: expected %q in the output, got:\n%s", test.name, test.expected, out.String())
+		}
+	}
+}
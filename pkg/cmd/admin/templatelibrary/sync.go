@@ -0,0 +1,137 @@
+package templatelibrary
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	kvalidation "k8s.io/kubernetes/pkg/util/validation"
+
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	"github.com/openshift/origin/pkg/template/library"
+)
+
+const (
+	SyncTemplatesRecommendedName = "sync-templates"
+
+	syncTemplatesLong = `
+Synchronize the templates of a template repository into a project
+
+A template repository publishes templates either in a Git repository, where the templates
+belong to the categories named after the directories holding them, or through an HTTP
+index listing the template files and their categories. The synchronized templates are
+labeled with the name of the repository and with their categories, and are created or
+updated in the current project. Templates of the project that are not managed by the
+repository are never changed. With --prune, the templates the repository no longer
+publishes are deleted.
+
+Run the command again, for example periodically, to keep the templates up to date.`
+
+	syncTemplatesExample = `  # Synchronize the templates of a Git repository into the shared openshift project
+  $ %[1]s library --from-git=https://github.com/openshift/library.git -n openshift
+
+  # Synchronize the templates listed in an HTTP index and delete the ones no longer listed
+  $ %[1]s library --from-index=https://example.com/templates/index.yaml --prune -n openshift`
+)
+
+// SyncTemplatesOptions holds the options of the sync-templates command.
+type SyncTemplatesOptions struct {
+	Syncer *library.Syncer
+	Source library.Source
+	Out    io.Writer
+
+	FromGit   string
+	FromIndex string
+	FromDir   string
+}
+
+// NewCmdSyncTemplates synchronizes the templates of a template repository.
+func NewCmdSyncTemplates(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	o := &SyncTemplatesOptions{Out: out, Syncer: &library.Syncer{}}
+
+	cmd := &cobra.Command{
+		Use:     name + " REPOSITORY (--from-git=URL | --from-index=URL | --from-dir=DIR)",
+		Short:   "Synchronize the templates of a template repository into a project",
+		Long:    syncTemplatesLong,
+		Example: fmt.Sprintf(syncTemplatesExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := o.Complete(f, args); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, "%s", err.Error()))
+			}
+			kcmdutil.CheckErr(o.Run())
+		},
+	}
+
+	cmd.Flags().StringVar(&o.FromGit, "from-git", "", "URL of a Git repository holding the templates.")
+	cmd.Flags().StringVar(&o.FromIndex, "from-index", "", "URL of an HTTP index listing the templates.")
+	cmd.Flags().StringVar(&o.FromDir, "from-dir", "", "Local directory holding the templates.")
+	cmd.Flags().BoolVar(&o.Syncer.Prune, "prune", false, "If true, delete the templates of the repository it no longer publishes.")
+
+	return cmd
+}
+
+// Complete sets the repository name and source from the arguments and flags.
+func (o *SyncTemplatesOptions) Complete(f *clientcmd.Factory, args []string) error {
+	if len(args) != 1 {
+		return errors.New("you must specify the name of the template repository")
+	}
+	if !kvalidation.IsValidLabelValue(args[0]) {
+		return fmt.Errorf("the repository name %q must be a valid label value", args[0])
+	}
+	o.Syncer.Repository = args[0]
+
+	sources := []string{}
+	if len(o.FromGit) > 0 {
+		sources = append(sources, "--from-git")
+		o.Source = &library.GitSource{URL: o.FromGit}
+	}
+	if len(o.FromIndex) > 0 {
+		sources = append(sources, "--from-index")
+		o.Source = &library.HTTPIndexSource{URL: o.FromIndex}
+	}
+	if len(o.FromDir) > 0 {
+		sources = append(sources, "--from-dir")
+		o.Source = &library.DirectorySource{Dir: o.FromDir}
+	}
+	if len(sources) != 1 {
+		return errors.New("exactly one of --from-git, --from-index or --from-dir must be specified")
+	}
+
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	client, _, err := f.Clients()
+	if err != nil {
+		return err
+	}
+	o.Syncer.Namespace = namespace
+	o.Syncer.Client = client
+	return nil
+}
+
+// Run synchronizes the templates and reports the changes.
+func (o *SyncTemplatesOptions) Run() error {
+	result, err := o.Syncer.Sync(o.Source)
+	if result != nil {
+		for _, change := range []struct {
+			operation string
+			names     []string
+		}{
+			{"created", result.Created},
+			{"updated", result.Updated},
+			{"deleted", result.Deleted},
+		} {
+			for _, name := range change.names {
+				fmt.Fprintf(o.Out, "template/%s %s\n", name, change.operation)
+			}
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("unable to synchronize the templates of %q: %v", o.Syncer.Repository, strings.TrimSpace(err.Error()))
+	}
+	return nil
+}
@@ -242,21 +242,26 @@ const (
 Create a route that uses reencrypt TLS termination
 
 Specify the service (either just its name or using type/name syntax) that the
-generated route should expose via the --service flag. A destination CA certificate
-is needed for reencrypt routes, specify one with the --dest-ca-cert flag.`
+generated route should expose via the --service flag. You may provide a destination
+CA certificate with the --dest-ca-cert flag; if omitted, the router will use the
+default destination CA, which trusts certificates generated for services by the
+cluster.`
 
 	reencryptRouteExample = `  # Create a route named "my-route" that exposes the frontend service.
   $ %[1]s create route reencrypt my-route --service=frontend --dest-ca-cert cert.cert
 
   # Create a reencrypt route that exposes the frontend service and re-use
   # the service name as the route name.
-  $ %[1]s create route reencrypt --service=frontend --dest-ca-cert cert.cert`
+  $ %[1]s create route reencrypt --service=frontend --dest-ca-cert cert.cert
+
+  # Create a reencrypt route that trusts the router's default destination CA.
+  $ %[1]s create route reencrypt --service=frontend`
 )
 
 // NewCmdCreateReencryptRoute is a macro command to create a reencrypt route.
 func NewCmdCreateReencryptRoute(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "reencrypt [NAME] --dest-ca-cert=FILENAME --service=SERVICE",
+		Use:     "reencrypt [NAME] --service=SERVICE",
 		Short:   "Create a route that uses reencrypt TLS termination",
 		Long:    reencryptRouteLong,
 		Example: fmt.Sprintf(reencryptRouteExample, fullName),
@@ -280,7 +285,6 @@ func NewCmdCreateReencryptRoute(fullName string, f *clientcmd.Factory, out io.Wr
 	cmd.Flags().String("ca-cert", "", "Path to a CA certificate file.")
 	cmd.MarkFlagFilename("ca-cert")
 	cmd.Flags().String("dest-ca-cert", "", "Path to a CA certificate file, used for securing the connection from the router to the destination.")
-	cmd.MarkFlagRequired("dest-ca-cert")
 	cmd.MarkFlagFilename("dest-ca-cert")
 
 	return cmd
@@ -373,7 +377,7 @@ func unsecuredRoute(kc *kclient.Client, namespace, routeName, serviceName, portS
 				Name: routeName,
 			},
 			Spec: api.RouteSpec{
-				To: kapi.ObjectReference{
+				To: api.RouteTargetReference{
 					Name: serviceName,
 				},
 				Port: resolveRoutePort(portString),
@@ -392,7 +396,7 @@ func unsecuredRoute(kc *kclient.Client, namespace, routeName, serviceName, portS
 			Labels: svc.Labels,
 		},
 		Spec: api.RouteSpec{
-			To: kapi.ObjectReference{
+			To: api.RouteTargetReference{
 				Name: serviceName,
 			},
 		},
@@ -66,7 +66,8 @@ never successfully complete - in which case you can use the '--latest' flag to f
 When rolling back to a previous deployment, a new deployment will be created with an identical copy
 of your config at the latest position.
 
-If no options are given, shows information about the latest deployment.`
+If no options are given, shows information about the latest deployment. The 'rollout' command
+offers the same controls together with status, history, undo, pause, and resume subcommands.`
 
 	deployExample = `  # Display the latest deployment for the 'database' deployment config
   $ %[1]s deploy database
@@ -206,6 +207,9 @@ func (o DeployOptions) RunDeploy() error {
 // deploy launches a new deployment unless there's already a deployment
 // process in progress for config.
 func (o DeployOptions) deploy(config *deployapi.DeploymentConfig, out io.Writer) error {
+	if config.Spec.Paused {
+		return fmt.Errorf("cannot deploy a paused deployment config.\nYou can resume it using '%s rollout resume dc/%s'.", o.baseCommandName, config.Name)
+	}
 	deploymentName := deployutil.LatestDeploymentNameForConfig(config)
 	deployment, err := o.kubeClient.ReplicationControllers(config.Namespace).Get(deploymentName)
 	if err == nil {
@@ -94,6 +94,24 @@ func TestCmdDeploy_latestConcurrentRejection(t *testing.T) {
 	}
 }
 
+// TestCmdDeploy_latestPausedRejection ensures that attempts to start a
+// deployment of a paused config are rejected.
+func TestCmdDeploy_latestPausedRejection(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Spec.Paused = true
+	osClient := &tc.Fake{}
+	kubeClient := &ktc.Fake{}
+	o := &DeployOptions{osClient: osClient, kubeClient: kubeClient}
+
+	err := o.deploy(config, ioutil.Discard)
+	if err == nil {
+		t.Fatal("expected an error starting a deployment of a paused config")
+	}
+	if len(osClient.Actions()) != 0 || len(kubeClient.Actions()) != 0 {
+		t.Errorf("expected no client actions, got %v and %v", osClient.Actions(), kubeClient.Actions())
+	}
+}
+
 // TestCmdDeploy_latestLookupError ensures that an error is thrown when
 // existing deployments can't be looked up due to some fatal server error.
 func TestCmdDeploy_latestLookupError(t *testing.T) {
@@ -30,7 +30,10 @@ default configuration will be saved to your home directory under
 
 The information required to login -- like username and password, a session token, or
 the server details -- can be provided through flags. If not provided, the command will
-prompt for user input as needed.`
+prompt for user input as needed.
+
+Identity providers that cannot answer a username and password challenge, like those requiring
+several authentication factors, can be used by logging in with a browser with --web.`
 
 	loginExample = `  # Log in interactively
   $ %[1]s login
@@ -39,7 +42,10 @@ prompt for user input as needed.`
   $ %[1]s login localhost:8443 --certificate-authority=/path/to/cert.crt
 
   # Log in to the given server with the given credentials (will not prompt interactively)
-  $ %[1]s login localhost:8443 --username=myuser --password=mypass`
+  $ %[1]s login localhost:8443 --username=myuser --password=mypass
+
+  # Log in to the given server with a browser
+  $ %[1]s login localhost:8443 --web`
 )
 
 // NewCmdLogin implements the OpenShift cli login command
@@ -87,6 +93,7 @@ func NewCmdLogin(fullName string, f *osclientcmd.Factory, reader io.Reader, out
 	// Login is the only command that can negotiate a session token against the auth server using basic auth
 	cmds.Flags().StringVarP(&options.Username, "username", "u", "", "Username, will prompt if not provided")
 	cmds.Flags().StringVarP(&options.Password, "password", "p", "", "Password, will prompt if not provided")
+	cmds.Flags().BoolVar(&options.WebLogin, "web", false, "Log in with a browser instead of prompting for a username and password")
 
 	return cmds
 }
@@ -171,6 +178,10 @@ func (o LoginOptions) Validate(args []string, serverFlag string) error {
 		return errors.New("--token and --username are mutually exclusive")
 	}
 
+	if o.WebLogin && (len(o.Username) > 0 || len(o.Password) > 0 || len(o.Token) > 0) {
+		return errors.New("--web cannot be used with --username, --password or --token")
+	}
+
 	if o.StartingKubeConfig == nil {
 		return errors.New("Must have a config file already created")
 	}
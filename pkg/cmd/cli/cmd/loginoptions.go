@@ -59,6 +59,9 @@ type LoginOptions struct {
 
 	Token string
 
+	// WebLogin requests a token by logging in with a browser instead of answering authentication challenges
+	WebLogin bool
+
 	PathOptions *kcmdconfig.PathOptions
 }
 
@@ -261,7 +264,12 @@ func (o *LoginOptions) gatherAuthInfo() error {
 	clientConfig.KeyData = []byte{}
 	clientConfig.CertFile = o.CertFile
 	clientConfig.KeyFile = o.KeyFile
-	token, err := tokencmd.RequestToken(o.Config, o.Reader, o.Username, o.Password)
+	var token string
+	if o.WebLogin {
+		token, err = tokencmd.RequestTokenWithBrowser(o.Config, o.Out, true)
+	} else {
+		token, err = tokencmd.RequestToken(o.Config, o.Reader, o.Username, o.Password)
+	}
 	if err != nil {
 		return err
 	}
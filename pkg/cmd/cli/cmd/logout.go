@@ -15,6 +15,7 @@ import (
 	"github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/cmd/cli/config"
 	osclientcmd "github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	"github.com/openshift/origin/pkg/cmd/util/tokencmd"
 )
 
 type LogoutOptions struct {
@@ -22,6 +23,9 @@ type LogoutOptions struct {
 	Config             *restclient.Config
 	Out                io.Writer
 
+	// AllSessions revokes every token of the user instead of only the one in the config file
+	AllSessions bool
+
 	PathOptions *kcmdconfig.PathOptions
 }
 
@@ -32,6 +36,11 @@ Log out of the active session out by clearing saved tokens
 An authentication token is stored in the config file after login - this command will delete
 that token on the server, and then remove the token from the configuration file.
 
+Pass --all-sessions to revoke every token issued to you, and the grants you gave to OAuth clients,
+ending your sessions on other machines and in the web console as well. Use this if you believe one
+of your tokens has been compromised. Browsers where you logged in to the server keep their login
+session until it expires, and can obtain new tokens without asking for your credentials until then.
+
 If you are using an alternative authentication method like Kerberos or client certificates,
 your ticket or client certificate will not be removed from the current system since these
 are typically managed by other programs. Instead, you can delete your config file to remove
@@ -41,7 +50,10 @@ After logging out, if you want to log back into the server use '%[1]s'.`
 
 	logoutExample = `
   # Logout
-  $ %[1]s`
+  $ %[1]s
+
+  # Logout of every session, on this machine and elsewhere
+  $ %[1]s --all-sessions`
 )
 
 // NewCmdLogout implements the OpenShift cli logout command
@@ -71,6 +83,8 @@ func NewCmdLogout(name, fullName, ocLoginFullCommand string, f *osclientcmd.Fact
 		},
 	}
 
+	cmds.Flags().BoolVar(&options.AllSessions, "all-sessions", false, "Revoke all of your tokens and client grants on the server. Browser login sessions are kept until they expire.")
+
 	// TODO: support --all which performs the same logic on all users in your config file.
 
 	return cmds
@@ -122,7 +136,12 @@ func (o LogoutOptions) RunLogout() error {
 		return err
 	}
 
-	if err := client.OAuthAccessTokens().Delete(token); err != nil {
+	var sessions int
+	if o.AllSessions {
+		if sessions, err = tokencmd.RevokeAllTokens(o.Config); err != nil {
+			return err
+		}
+	} else if err := client.OAuthAccessTokens().Delete(token); err != nil {
 		return err
 	}
 
@@ -140,6 +159,10 @@ func (o LogoutOptions) RunLogout() error {
 		return err
 	}
 
+	if o.AllSessions {
+		fmt.Fprintf(o.Out, "Logged %q out of %d session(s) on %q\n", userInfo.Name, sessions, o.Config.Host)
+		return nil
+	}
 	fmt.Fprintf(o.Out, "Logged %q out on %q\n", userInfo.Name, o.Config.Host)
 
 	return nil
@@ -15,6 +15,7 @@ import (
 	"k8s.io/kubernetes/pkg/runtime"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildvalidation "github.com/openshift/origin/pkg/build/api/validation"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 )
@@ -33,7 +34,16 @@ specified via -c. When a build config or deployment config is specified, you can
 the logs for a particular version of it via --version.
 
 If your pod is failing to start, you may need to use the --previous option to see the
-logs of the last attempt.`
+logs of the last attempt.
+
+The logs of a build can be resumed from an offset with --offset-bytes or --offset-lines,
+and returned as one JSON object per line, annotated with the build step that produced it,
+with --format=json.
+
+The logs of a deployment config can include the logs of the deployer pod and the lifecycle
+hook pods of a deployment interleaved in time order with --all-pods, and the logs of the
+pods started by the deployment with --deployment-pods. Each line is prefixed by the name
+of the pod it came from.`
 
 	logsExample = `  # Start streaming the logs of the most recent build of the openldap build config.
   $ %[1]s -f bc/openldap
@@ -46,6 +56,16 @@ logs of the last attempt.`
   # or due to deployment pruning or manual deletion of the deployment.
   $ %[1]s --version=1 dc/mysql
 
+  # Get the logs of the deployer and hook pods of the second deployment of the mysql
+  # deployment config, along with the logs of the pods it started.
+  $ %[1]s --version=2 --all-pods --deployment-pods dc/mysql
+
+  # Resume streaming the logs of a build after the first 100 lines.
+  $ %[1]s -f --offset-lines=100 build/ruby-hello-world-1
+
+  # Return the logs of a build as JSON annotated with build steps.
+  $ %[1]s --format=json build/ruby-hello-world-1
+
   # Return a snapshot of ruby-container logs from pod backend.
   $ %[1]s backend -c ruby-container
 
@@ -80,6 +100,11 @@ func NewCmdLogs(name, parent string, f *clientcmd.Factory, out io.Writer) *cobra
 		kcmdutil.CheckErr(o.RunLog())
 	}
 	cmd.Flags().Int64("version", 0, "View the logs of a particular build or deployment by version if greater than zero")
+	cmd.Flags().Int64("offset-bytes", 0, "Skip the given number of bytes at the start of the logs of a build")
+	cmd.Flags().Int64("offset-lines", 0, "Skip the given number of lines at the start of the logs of a build")
+	cmd.Flags().String("format", "", "The format of the logs of a build. One of: text|json")
+	cmd.Flags().Bool("all-pods", false, "Interleave the logs of the deployer and lifecycle hook pods of a deployment")
+	cmd.Flags().Bool("deployment-pods", false, "Include the logs of the pods started by a deployment; requires --all-pods")
 
 	return cmd
 }
@@ -112,8 +137,22 @@ func (o *OpenShiftLogsOptions) Complete(f *clientcmd.Factory, out io.Writer, cmd
 	}
 
 	version := kcmdutil.GetFlagInt64(cmd, "version")
+	offsetBytes := kcmdutil.GetFlagInt64(cmd, "offset-bytes")
+	offsetLines := kcmdutil.GetFlagInt64(cmd, "offset-lines")
+	format := kcmdutil.GetFlagString(cmd, "format")
+	allPods := kcmdutil.GetFlagBool(cmd, "all-pods")
+	deploymentPods := kcmdutil.GetFlagBool(cmd, "deployment-pods")
 	_, resource := meta.KindToResource(infos[0].Mapping.GroupVersionKind)
 
+	isBuild := resource.GroupResource() == buildapi.Resource("build") || resource.GroupResource() == buildapi.Resource("buildconfig")
+	if !isBuild && (offsetBytes != 0 || offsetLines != 0 || len(format) > 0) {
+		return errors.New("--offset-bytes, --offset-lines and --format may only be used with builds and build configs")
+	}
+	isDeployment := resource.GroupResource() == deployapi.Resource("deploymentconfig")
+	if !isDeployment && (allPods || deploymentPods) {
+		return errors.New("--all-pods and --deployment-pods may only be used with deployment configs")
+	}
+
 	// TODO: podLogOptions should be included in our own logOptions objects.
 	switch resource.GroupResource() {
 	case buildapi.Resource("build"), buildapi.Resource("buildconfig"):
@@ -129,6 +168,13 @@ func (o *OpenShiftLogsOptions) Complete(f *clientcmd.Factory, out io.Writer, cmd
 		if version != 0 {
 			bopts.Version = &version
 		}
+		if cmd.Flags().Lookup("offset-bytes").Changed {
+			bopts.OffsetBytes = &offsetBytes
+		}
+		if cmd.Flags().Lookup("offset-lines").Changed {
+			bopts.OffsetLines = &offsetLines
+		}
+		bopts.Format = buildapi.BuildLogFormat(format)
 		o.Options = bopts
 	case deployapi.Resource("deploymentconfig"):
 		dopts := &deployapi.DeploymentLogOptions{
@@ -139,6 +185,9 @@ func (o *OpenShiftLogsOptions) Complete(f *clientcmd.Factory, out io.Writer, cmd
 			Timestamps:   podLogOptions.Timestamps,
 			TailLines:    podLogOptions.TailLines,
 			LimitBytes:   podLogOptions.LimitBytes,
+
+			AllPods:        allPods,
+			DeploymentPods: deploymentPods,
 		}
 		if version != 0 {
 			dopts.Version = &version
@@ -165,10 +214,19 @@ func (o OpenShiftLogsOptions) Validate() error {
 		if t.Previous && t.Version != nil {
 			return errors.New("cannot use both --previous and --version")
 		}
+		if t.OffsetBytes != nil && t.OffsetLines != nil {
+			return errors.New("cannot use both --offset-bytes and --offset-lines")
+		}
+		if errs := buildvalidation.ValidateBuildLogOptions(t); len(errs) > 0 {
+			return errs.ToAggregate()
+		}
 	case *deployapi.DeploymentLogOptions:
 		if t.Previous && t.Version != nil {
 			return errors.New("cannot use both --previous and --version")
 		}
+		if t.DeploymentPods && !t.AllPods {
+			return errors.New("--deployment-pods requires --all-pods")
+		}
 	default:
 		return errors.New("invalid log options object provided")
 	}
@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -38,6 +39,8 @@ import (
 	newapp "github.com/openshift/origin/pkg/generate/app"
 	newcmd "github.com/openshift/origin/pkg/generate/app/cmd"
 	imageapi "github.com/openshift/origin/pkg/image/api"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+	templatecmd "github.com/openshift/origin/pkg/template/cmd"
 	"github.com/openshift/origin/pkg/util"
 )
 
@@ -95,6 +98,18 @@ You can use '%[1]s status' to check the progress.`
   # Create an application based on a template file, explicitly setting a parameter value
   $ %[1]s new-app --file=./example/myapp/template.json --param=MYSQL_USER=admin
 
+  # Create an application, choosing the builder image and the parameter values interactively
+  $ %[1]s new-app --interactive
+
+  # Create an application based on a remote template, verifying the checksum of its content
+  $ %[1]s new-app -f https://example.com/app-template.yaml --template-sha256=<sha256sum of the file>
+
+  # Create an application and expose it with an edge terminated route
+  $ %[1]s new-app https://github.com/openshift/ruby-hello-world --expose --hostname=www.example.com --tls-termination=edge --cert=www.crt --key=www.key
+
+  # Save the resources that would be created for an application to a file, without creating them
+  $ %[1]s new-app https://github.com/openshift/ruby-hello-world --dry-run -o yaml > ruby-hello-world.yaml
+
   # Search for "mysql" in all image repositories and stored templates
   $ %[1]s new-app --search mysql
 
@@ -152,14 +167,26 @@ func NewCmdNewApplication(fullName string, f *clientcmd.Factory, out io.Writer)
 	cmd.Flags().StringSliceVarP(&config.ImageStreams, "image-stream", "i", config.ImageStreams, "Name of an image stream to use in the app.")
 	cmd.Flags().StringSliceVar(&config.DockerImages, "docker-image", config.DockerImages, "Name of a Docker image to include in the app.")
 	cmd.Flags().StringSliceVar(&config.Templates, "template", config.Templates, "Name of a stored template to use in the app.")
-	cmd.Flags().StringSliceVarP(&config.TemplateFiles, "file", "f", config.TemplateFiles, "Path to a template file to use for the app.")
+	cmd.Flags().StringSliceVarP(&config.TemplateFiles, "file", "f", config.TemplateFiles, "Path or URL of a template file to use for the app, or '-' to read it from stdin.")
 	cmd.MarkFlagFilename("file", "yaml", "yml", "json")
+	cmd.Flags().StringVar(&config.TemplateSHA256, "template-sha256", "", "The hex encoded SHA-256 checksum the content of the template file must match.")
 	cmd.Flags().StringSliceVarP(&config.TemplateParameters, "param", "p", config.TemplateParameters, "Specify a list of key value pairs (e.g., -p FOO=BAR,BAR=FOO) to set/override parameter values in the template.")
 	cmd.Flags().StringSliceVar(&config.Groups, "group", config.Groups, "Indicate components that should be grouped together as <comp1>+<comp2>.")
 	cmd.Flags().StringSliceVarP(&config.Environment, "env", "e", config.Environment, "Specify key value pairs of environment variables to set into each container.")
 	cmd.Flags().StringVar(&config.Name, "name", "", "Set name to use for generated application artifacts")
 	cmd.Flags().StringVar(&config.Strategy, "strategy", "", "Specify the build strategy to use if you don't want to detect (docker|source).")
 	cmd.Flags().StringP("labels", "l", "", "Label to set in all resources for this application.")
+	cmd.Flags().BoolVar(&config.Expose, "expose", false, "If true, create a route for every service of the application.")
+	cmd.Flags().StringVar(&config.Hostname, "hostname", "", "Set a hostname for the route created with --expose.")
+	cmd.Flags().StringVar(&config.TLSTermination, "tls-termination", "", "Secure the routes created with --expose with the given TLS termination (edge|passthrough|reencrypt).")
+	cmd.Flags().StringVar(&config.CertFile, "cert", "", "Path to the certificate file of the secured routes.")
+	cmd.MarkFlagFilename("cert")
+	cmd.Flags().StringVar(&config.KeyFile, "key", "", "Path to the key file of the secured routes.")
+	cmd.MarkFlagFilename("key")
+	cmd.Flags().StringVar(&config.CACertFile, "ca-cert", "", "Path to the CA certificate file of the secured routes.")
+	cmd.MarkFlagFilename("ca-cert")
+	cmd.Flags().StringVar(&config.DestCACertFile, "dest-ca-cert", "", "Path to the CA certificate file used to validate the certificate of the application by reencrypt routes.")
+	cmd.MarkFlagFilename("dest-ca-cert")
 	cmd.Flags().BoolVar(&config.InsecureRegistry, "insecure-registry", false, "If true, indicates that the referenced Docker images are on insecure registries and should bypass certificate checking")
 	cmd.Flags().BoolVarP(&config.AsList, "list", "L", false, "List all local templates and image streams that can be used to create.")
 	cmd.Flags().BoolVarP(&config.AsSearch, "search", "S", false, "Search all templates, image streams, and Docker images that match the arguments provided.")
@@ -167,7 +194,9 @@ func NewCmdNewApplication(fullName string, f *clientcmd.Factory, out io.Writer)
 	cmd.Flags().BoolVar(&config.AllowMissingImageStreamTags, "allow-missing-imagestream-tags", false, "If true, indicates that image stream tags that don't exist should still be used.")
 	cmd.Flags().BoolVar(&config.AllowSecretUse, "grant-install-rights", false, "If true, a component that requires access to your account may use your token to install software into your project. Only grant images you trust the right to run with your token.")
 	cmd.Flags().BoolVar(&config.SkipGeneration, "no-install", false, "Do not attempt to run images that describe themselves as being installable")
-	cmd.Flags().BoolVar(&config.DryRun, "dry-run", false, "If true, do not actually create resources.")
+	cmd.Flags().BoolVar(&config.DryRun, "dry-run", false, "If true, show the resources that would be created without creating them. Combine with -o to print them as a list.")
+	cmd.Flags().Bool("no-prompt", false, "If true, never prompt for the values of required template parameters, even when stdin is a terminal.")
+	cmd.Flags().Bool("interactive", false, "If true, prompt for the inputs, the builder images of the source repositories and the template parameter values, and print the equivalent non-interactive command.")
 
 	// TODO AddPrinterFlags disabled so that it doesn't conflict with our own "template" flag.
 	// Need a better solution.
@@ -189,6 +218,16 @@ func RunNewApplication(fullName string, f *clientcmd.Factory, out io.Writer, c *
 	if err := setupAppConfig(f, out, c, args, config); err != nil {
 		return err
 	}
+	if !kcmdutil.GetFlagBool(c, "no-prompt") {
+		config.ParameterPrompter = templatecmd.NewParameterPrompter(os.Stdin, os.Stderr)
+	}
+	interactive := kcmdutil.GetFlagBool(c, "interactive")
+	if interactive && config.Querying() {
+		return kcmdutil.UsageError(c, "--interactive can't be used with --list or --search")
+	}
+	if interactive && !cmdutil.IsTerminalReader(os.Stdin) {
+		return kcmdutil.UsageError(c, "--interactive requires stdin to be a terminal")
+	}
 
 	if config.Querying() {
 		result, err := config.RunQuery()
@@ -210,6 +249,12 @@ func RunNewApplication(fullName string, f *clientcmd.Factory, out io.Writer, c *
 	if err := setAppConfigLabels(c, config); err != nil {
 		return err
 	}
+	if interactive {
+		if err := config.Interview(&terminalPrompter{in: os.Stdin, out: os.Stderr}); err != nil {
+			return handleRunError(c, err, fullName)
+		}
+		fmt.Fprintf(os.Stderr, "--> Equivalent command: %s new-app %s\n", fullName, newcmd.QuoteArguments(config.Arguments()))
+	}
 	result, err := config.Run()
 	if err := handleRunError(c, err, fullName); err != nil {
 		return err
@@ -245,12 +290,15 @@ func RunNewApplication(fullName string, f *clientcmd.Factory, out io.Writer, c *
 			fmt.Fprintf(out, "--> Creating resources ...\n")
 		}
 	}
+	mapper, _ := f.Object()
 	if config.DryRun {
+		if err := createObjects(f, configcmd.NewPrintNameOrErrorAfterIndent(mapper, shortOutput, "created (dry run)", out, c.Out(), indent), result, true); err != nil {
+			return err
+		}
 		fmt.Fprintf(out, "--> Success (DRY RUN)\n")
 		return nil
 	}
 
-	mapper, _ := f.Object()
 	var afterFn configcmd.AfterFunc
 	switch {
 	// only print success if we don't have installables
@@ -261,13 +309,18 @@ func RunNewApplication(fullName string, f *clientcmd.Factory, out io.Writer, c *
 		afterFn = configcmd.HaltOnError(afterFn)
 	}
 
-	if err := createObjects(f, afterFn, result); err != nil {
+	if err := createObjects(f, afterFn, result, false); err != nil {
 		return err
 	}
 
 	if !shortOutput && !result.GeneratedJobs {
 		fmt.Fprintf(out, "--> Success\n")
 	}
+	for _, message := range result.Messages {
+		for _, line := range strings.Split(strings.TrimRight(message, "\n"), "\n") {
+			fmt.Fprintf(out, "%s%s\n", indent, line)
+		}
+	}
 
 	hasMissingRepo := false
 	installing := []*kapi.Pod{}
@@ -299,6 +352,15 @@ func RunNewApplication(fullName string, f *clientcmd.Factory, out io.Writer, c *
 				hasMissingRepo = true
 				fmt.Fprintf(out, "%sWARNING: No Docker registry has been configured with the server. Automatic builds and deployments may not function.\n", indent)
 			}
+		case *routeapi.Route:
+			if len(t.Spec.Host) == 0 {
+				continue
+			}
+			scheme := "http"
+			if t.Spec.TLS != nil {
+				scheme = "https"
+			}
+			fmt.Fprintf(out, "%sService %q is exposed at %s://%s\n", indent, t.Spec.To.Name, scheme, t.Spec.Host)
 		}
 	}
 
@@ -552,7 +614,9 @@ func retryBuildConfig(info *resource.Info, err error) runtime.Object {
 	return nil
 }
 
-func createObjects(f *clientcmd.Factory, after configcmd.AfterFunc, result *newcmd.AppResult) error {
+// createObjects creates the objects of result, or only reports them to after
+// if dryRun is true.
+func createObjects(f *clientcmd.Factory, after configcmd.AfterFunc, result *newcmd.AppResult, dryRun bool) error {
 	mapper, typer := f.Factory.Object()
 	bulk := configcmd.Bulk{
 		Mapper:            mapper,
@@ -563,6 +627,8 @@ func createObjects(f *clientcmd.Factory, after configcmd.AfterFunc, result *newc
 		// Retry is used to support previous versions of the API server that will
 		// consider the presence of an unknown trigger type to be an error.
 		Retry: retryBuildConfig,
+
+		DryRun: dryRun,
 	}
 	if errs := bulk.Create(result.List, result.Namespace); len(errs) != 0 {
 		return cmdutil.ErrExit
@@ -840,3 +906,31 @@ func (r *configSecretRetriever) CACert() (string, error) {
 	}
 	return "", nil
 }
+
+// terminalPrompter asks the questions of the interactive mode of new-app on a
+// terminal.
+type terminalPrompter struct {
+	in  io.Reader
+	out io.Writer
+}
+
+func (p *terminalPrompter) PromptForString(question string, secret bool) string {
+	if secret {
+		return cmdutil.PromptForPasswordString(p.in, p.out, "%s: ", question)
+	}
+	return cmdutil.PromptForString(p.in, p.out, "%s: ", question)
+}
+
+func (p *terminalPrompter) PromptForChoice(question string, options []string) int {
+	fmt.Fprintf(p.out, "%s:\n", question)
+	for i, option := range options {
+		fmt.Fprintf(p.out, "  %d) %s\n", i+1, option)
+	}
+	for {
+		answer := cmdutil.PromptForStringWithDefault(p.in, p.out, "1", "Choice [1]: ")
+		if choice, err := strconv.Atoi(answer); err == nil && choice >= 1 && choice <= len(options) {
+			return choice - 1
+		}
+		fmt.Fprintf(p.out, "Please enter a number between 1 and %d.\n", len(options))
+	}
+}
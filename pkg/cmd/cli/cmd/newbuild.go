@@ -123,7 +123,7 @@ func NewCmdNewBuild(fullName string, f *clientcmd.Factory, in io.Reader, out io.
 	cmd.Flags().BoolVar(&config.AllowMissingImages, "allow-missing-images", false, "If true, indicates that referenced Docker images that cannot be found locally or in a registry should still be used.")
 	cmd.Flags().BoolVar(&config.AllowMissingImageStreamTags, "allow-missing-imagestream-tags", false, "If true, indicates that image stream tags that don't exist should still be used.")
 	cmd.Flags().StringVar(&config.ContextDir, "context-dir", "", "Context directory to be used for the build.")
-	cmd.Flags().BoolVar(&config.DryRun, "dry-run", false, "If true, do not actually create resources.")
+	cmd.Flags().BoolVar(&config.DryRun, "dry-run", false, "If true, show the resources that would be created without creating them. Combine with -o to print them as a list.")
 	cmd.Flags().BoolVar(&config.NoOutput, "no-output", false, "If true, the build output will not be pushed anywhere.")
 	cmd.Flags().StringVar(&config.SourceImage, "source-image", "", "Specify an image to use as source for the build.  You must also specify --source-image-path.")
 	cmd.Flags().StringVar(&config.SourceImagePath, "source-image-path", "", "Specify the file or directory to copy from the source image and its destination in the build directory. Format: [source]:[destination-dir].")
@@ -186,13 +186,16 @@ func RunNewBuild(fullName string, f *clientcmd.Factory, out io.Writer, in io.Rea
 			fmt.Fprintf(out, "--> Creating resources ...\n")
 		}
 	}
+	mapper, _ := f.Object()
 	if config.DryRun {
+		if err := createObjects(f, configcmd.NewPrintNameOrErrorAfterIndent(mapper, shortOutput, "created (dry run)", out, c.Out(), indent), result, true); err != nil {
+			return err
+		}
 		fmt.Fprintf(out, "--> Success (DRY RUN)\n")
 		return nil
 	}
 
-	mapper, _ := f.Object()
-	if err := createObjects(f, configcmd.NewPrintNameOrErrorAfterIndent(mapper, shortOutput, "created", out, c.Out(), indent), result); err != nil {
+	if err := createObjects(f, configcmd.NewPrintNameOrErrorAfterIndent(mapper, shortOutput, "created", out, c.Out(), indent), result, false); err != nil {
 		return err
 	}
 
@@ -208,6 +211,9 @@ func RunNewBuild(fullName string, f *clientcmd.Factory, out io.Writer, in io.Rea
 				fmt.Fprintf(out, "%sBuild configuration %q created and build triggered.\n", indent, t.Name)
 				fmt.Fprintf(out, "%sRun '%s logs -f bc/%s' to stream the build progress.\n", indent, fullName, t.Name)
 			}
+			if t.Spec.Source.Binary != nil {
+				fmt.Fprintf(out, "%sRun '%s start-build %s --from-dir=<directory>' or '--from-archive=<archive>' to build local content.\n", indent, fullName, t.Name)
+			}
 		}
 	}
 
@@ -1,9 +1,12 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
+	"os"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -15,11 +18,14 @@ import (
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	"k8s.io/kubernetes/pkg/kubectl/resource"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
 
+	osclient "github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/cmd/cli/describe"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 	"github.com/openshift/origin/pkg/template"
 	templateapi "github.com/openshift/origin/pkg/template/api"
+	templatecmd "github.com/openshift/origin/pkg/template/cmd"
 )
 
 const (
@@ -30,8 +36,10 @@ Templates allow parameterization of resources prior to being sent to the server
 update. Templates have "parameters", which may either be generated on creation or set by the user,
 as well as metadata describing the template.
 
-The output of the process command is always a list of one or more resources. You may pipe the
-output to the create command over STDIN (using the '-f -' option) or redirect it to a file.`
+The output of the process command is a list of one or more resources. You may pipe the
+output to the create command over STDIN (using the '-f -' option) or redirect it to a file.
+Use --split to print every resource as a separate document instead, for tools that don't
+understand lists.`
 
 	processExample = `  # Convert template.json file into resource list and pass to create
   $ %[1]s process -f template.json | %[1]s create -f -
@@ -45,6 +53,27 @@ output to the create command over STDIN (using the '-f -' option) or redirect it
   # Convert stored template into resource list by setting/overriding parameter values
   $ %[1]s process foo PARM1=VALUE1 PARM2=VALUE2
 
+  # Convert stored template into resource list reading parameter values from a file
+  $ %[1]s process foo --param-file=params.env
+
+  # Read the parameters declaring fromEnv from the environment when they are not set
+  $ %[1]s process foo --param-from-env
+
+  # Show the parameter values used to process a stored template
+  $ %[1]s process foo PARM1=VALUE1 --report
+
+  # Check that the objects produced by a template are valid
+  $ %[1]s process -f template.json --validate
+
+  # Show the fields changed by processing a template
+  $ %[1]s process -f template.json --diff
+
+  # Print the objects of a template as a multi-document YAML stream
+  $ %[1]s process -f template.json -o yaml --split
+
+  # Show the changes upgrading the objects created from a previous version of a template
+  $ %[1]s process -f template-v2.json --upgrade-from=template-v1.json --param-file=params.env
+
   # Convert template stored in different namespace into a resource list
   $ %[1]s process openshift//foo
 
@@ -70,10 +99,20 @@ func NewCmdProcess(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.
 	cmd.Flags().StringP("filename", "f", "", "Filename or URL to file to read a template")
 	cmd.MarkFlagFilename("filename", "yaml", "yml", "json")
 	cmd.Flags().StringSliceP("value", "v", nil, "Specify a list of key-value pairs (eg. -v FOO=BAR,BAR=FOO) to set/override parameter values")
+	cmd.Flags().StringSlice("param-file", nil, "File containing KEY=VALUE lines or a JSON/YAML map of parameter values. Values set with --value or as arguments take precedence.")
+	cmd.MarkFlagFilename("param-file")
+	cmd.Flags().Bool("param-from-env", false, "If true, parameters declaring fromEnv that have no value are read from the named environment variable")
+	cmd.Flags().Bool("strict", false, "If true, fail when a value is supplied for a parameter the template does not declare")
+	cmd.Flags().Bool("no-prompt", false, "If true, never prompt for the values of required parameters, even when stdin is a terminal")
 	cmd.Flags().BoolP("parameters", "", false, "Do not process but only print available parameters")
+	cmd.Flags().Bool("validate", false, "Validate the objects produced by processing the template and fail if any of them is invalid")
+	cmd.Flags().Bool("diff", false, "Print the fields of every object changed by processing the template instead of the resulting objects")
+	cmd.Flags().String("upgrade-from", "", "File holding the previously instantiated version of the template. Print the changes upgrading its objects to this version instead of the resulting objects")
+	cmd.Flags().Bool("report", false, "Print a JSON report of the parameter values used to process the template instead of the resulting objects")
 	cmd.Flags().StringP("labels", "l", "", "Label to set in all resources for this template")
 
 	cmd.Flags().StringP("output", "o", "json", "Output format. One of: describe|json|yaml|name|template|templatefile.")
+	cmd.Flags().Bool("split", false, "If true print every processed object as a separate document instead of a List. YAML documents are separated by '---'.")
 	cmd.Flags().Bool("raw", false, "If true output the processed template instead of the template's objects. Implied by -o describe")
 	cmd.Flags().String("output-version", "", "Output the formatted object with the given version (default api-version).")
 	cmd.Flags().StringP("template", "t", "", "Template string or path to template file to use when -o=template or -o=templatefile.  The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]")
@@ -102,13 +141,24 @@ func RunProcess(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, args []
 	}
 
 	if kcmdutil.GetFlagBool(cmd, "parameters") {
-		for _, flag := range []string{"value", "labels", "output", "output-version", "raw", "template"} {
+		for _, flag := range []string{"value", "param-file", "param-from-env", "upgrade-from", "labels", "output", "output-version", "raw", "split", "template"} {
 			if f := cmd.Flags().Lookup(flag); f != nil && f.Changed {
 				return kcmdutil.UsageError(cmd, "The --parameters flag does not process the template, can't be used with --%v", flag)
 			}
 		}
 	}
 
+	// Parameter files have the lowest precedence, the values passed through
+	// --value and as arguments are applied after them.
+	fileValues := []string{}
+	for _, paramFile := range kcmdutil.GetFlagStringSlice(cmd, "param-file") {
+		values, err := readParameterFile(paramFile)
+		if err != nil {
+			return err
+		}
+		fileValues = append(fileValues, values...)
+	}
+
 	namespace, explicit, err := f.DefaultNamespace()
 	if err != nil {
 		return err
@@ -123,6 +173,7 @@ func RunProcess(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, args []
 
 	var (
 		objects []runtime.Object
+		reports []template.ParameterReport
 		infos   []*resource.Info
 	)
 
@@ -171,6 +222,21 @@ func RunProcess(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, args []
 
 	outputFormat := kcmdutil.GetFlagString(cmd, "output")
 
+	var previous *templateapi.Template
+	if upgradeFrom := kcmdutil.GetFlagString(cmd, "upgrade-from"); len(upgradeFrom) > 0 {
+		previous, err = readTemplateFile(f, namespace, explicit, upgradeFrom)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Prompt for the missing required parameters unless the template is read
+	// from stdin or prompting is disabled
+	var prompter *templatecmd.ParameterPrompter
+	if filename != "-" && !kcmdutil.GetFlagBool(cmd, "no-prompt") {
+		prompter = templatecmd.NewParameterPrompter(os.Stdin, os.Stderr)
+	}
+
 	for i := range infos {
 		obj, ok := infos[i].Object.(*templateapi.Template)
 		if !ok {
@@ -211,12 +277,33 @@ func RunProcess(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, args []
 		}
 
 		// Override the values for the current template parameters
-		// when user specify the --value
+		// when user specify the --param-file or --value
+		values := append([]string{}, fileValues...)
 		if cmd.Flag("value").Changed {
-			values := kcmdutil.GetFlagStringSlice(cmd, "value")
-			injectUserVars(values, out, obj)
+			values = append(values, kcmdutil.GetFlagStringSlice(cmd, "value")...)
+		}
+		values = append(values, valueArgs...)
+		supplied, err := injectUserVars(values, out, obj, kcmdutil.GetFlagBool(cmd, "strict"))
+		if err != nil {
+			return fmt.Errorf("unable to set the parameters of the template %q: %v", obj.Name, err)
+		}
+		// Environment variables only fill the parameters that opted in and
+		// are still unset, so they never override explicit values
+		if kcmdutil.GetFlagBool(cmd, "param-from-env") {
+			supplied.Insert(template.SetParameterValuesFromEnv(obj, os.LookupEnv).List()...)
+		}
+		if prompter != nil {
+			supplied.Insert(prompter.PromptForRequiredParameters(obj).List()...)
+		}
+
+		var previousResult *templateapi.Template
+		if previous != nil {
+			previousResult, err = processPreviousVersion(client.TemplateConfigs(namespace), previous, obj, supplied, cmd.Out())
+			if err != nil {
+				fmt.Fprintf(cmd.Out(), "error processing the previous version of the template %q: %v\n", obj.Name, err)
+				continue
+			}
 		}
-		injectUserVars(valueArgs, out, obj)
 
 		resultObj, err := client.TemplateConfigs(namespace).Create(obj)
 		if err != nil {
@@ -236,54 +323,213 @@ func RunProcess(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, args []
 			}
 			continue
 		}
+		if kcmdutil.GetFlagBool(cmd, "validate") {
+			if errs := template.ValidateObjects(resultObj); len(errs) > 0 {
+				return fmt.Errorf("the template %q produced invalid objects: %v", obj.Name, errs.ToAggregate())
+			}
+		}
+		if kcmdutil.GetFlagBool(cmd, "diff") {
+			if err := printTemplateDiff(obj, resultObj, client, out); err != nil {
+				fmt.Fprintf(cmd.Out(), "error computing the changes made to %q: %v\n", obj.Name, err)
+			}
+			continue
+		}
+		if kcmdutil.GetFlagBool(cmd, "report") {
+			reports = append(reports, template.ReportParameters(obj, resultObj, supplied)...)
+			continue
+		}
+		if previousResult != nil {
+			plan, err := template.PlanUpgrade(previousResult, resultObj)
+			if err != nil {
+				fmt.Fprintf(cmd.Out(), "error planning the upgrade of %q: %v\n", obj.Name, err)
+				continue
+			}
+			data, err := json.MarshalIndent(plan, "", "    ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(out, string(data))
+			continue
+		}
 		objects = append(objects, resultObj.Objects...)
 	}
 
 	// Do not print the processed templates when asked to only show parameters or
 	// describe.
-	if kcmdutil.GetFlagBool(cmd, "parameters") || outputFormat == "describe" {
+	if kcmdutil.GetFlagBool(cmd, "parameters") || kcmdutil.GetFlagBool(cmd, "diff") || outputFormat == "describe" {
+		return nil
+	}
+	if kcmdutil.GetFlagBool(cmd, "report") {
+		data, err := json.MarshalIndent(reports, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(data))
 		return nil
 	}
 
-	p, _, err := kubectl.GetPrinter(outputFormat, "")
+	gv := mapping.GroupVersionKind.GroupVersion()
+	version, err := kcmdutil.OutputVersion(cmd, &gv)
 	if err != nil {
 		return err
 	}
-	gv := mapping.GroupVersionKind.GroupVersion()
-	version, err := kcmdutil.OutputVersion(cmd, &gv)
+	// use generic output, wrapped in a List unless separate documents are requested
+	split := kcmdutil.GetFlagBool(cmd, "split") || kcmdutil.GetFlagBool(cmd, "raw")
+	p, err := templatecmd.NewObjectsPrinter(outputFormat, kcmdutil.GetFlagString(cmd, "template"), split, version)
 	if err != nil {
 		return err
 	}
-	p = kubectl.NewVersionedPrinter(p, kapi.Scheme, version)
+	return p.PrintObjects(objects, out)
+}
 
-	// use generic output
-	if kcmdutil.GetFlagBool(cmd, "raw") {
-		for i := range objects {
-			p.PrintObj(objects[i], out)
+// printTemplateDiff prints the fields of the template objects changed by
+// processing. The template is processed again locally with the parameter
+// values and labels the server used, so that every processed object can be
+// compared with the template object it was produced from.
+func printTemplateDiff(original, processed *templateapi.Template, client osclient.TemplatesNamespacer, out io.Writer) error {
+	original.Parameters = processed.Parameters
+	original.ObjectLabels = processed.ObjectLabels
+	processor := template.NewProcessor(nil)
+	processor.Templates = template.TemplateGetterFunc(func(namespace, name string) (*templateapi.Template, error) {
+		return client.Templates(namespace).Get(name)
+	})
+	result, errs := processor.ProcessDiff(original)
+	if len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+	for _, diff := range result.Diffs {
+		fmt.Fprintf(out, "%s (object %d):\n", diff.Object, diff.Index)
+		for _, change := range diff.Changes {
+			fmt.Fprintf(out, "  %s: %s -> %s\n", change.Path, formatDiffValue(change.Original), formatDiffValue(change.Processed))
 		}
-		return nil
 	}
+	return nil
+}
 
-	return p.PrintObj(&kapi.List{
-		ListMeta: unversioned.ListMeta{},
-		Items:    objects,
-	}, out)
+func formatDiffValue(value interface{}) string {
+	if value == nil {
+		return "<none>"
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
 }
 
-// injectUserVars injects user specified variables into the Template
-func injectUserVars(values []string, out io.Writer, t *templateapi.Template) {
+// readTemplateFile reads the single Template stored in the given file.
+func readTemplateFile(f *clientcmd.Factory, namespace string, explicit bool, filename string) (*templateapi.Template, error) {
+	mapper, typer := f.Object()
+	infos, err := resource.NewBuilder(mapper, typer, resource.ClientMapperFunc(f.ClientForMapping), kapi.Codecs.UniversalDecoder()).
+		NamespaceParam(namespace).RequireNamespace().
+		FilenameParam(explicit, filename).
+		Do().
+		Infos()
+	if err != nil {
+		return nil, err
+	}
+	if len(infos) != 1 {
+		return nil, fmt.Errorf("%s must hold exactly one template", filename)
+	}
+	t, ok := infos[0].Object.(*templateapi.Template)
+	if !ok {
+		return nil, fmt.Errorf("%s does not hold a template but %s", filename, reflect.TypeOf(infos[0].Object))
+	}
+	return t, nil
+}
+
+// processPreviousVersion processes a copy of the previous version of a
+// Template with the parameter values supplied for the next version. The
+// parameters of next that were generated by the previous version are set to
+// the generated values, so that only the changes made to the Template itself
+// show up when comparing the two versions.
+func processPreviousVersion(client osclient.TemplateConfigInterface, previous, next *templateapi.Template, supplied sets.String, errOut io.Writer) (*templateapi.Template, error) {
+	copied, err := kapi.Scheme.DeepCopy(previous)
+	if err != nil {
+		return nil, err
+	}
+	t := copied.(*templateapi.Template)
+	t.Namespace = next.Namespace
+	for name, value := range next.ObjectLabels {
+		if t.ObjectLabels == nil {
+			t.ObjectLabels = map[string]string{}
+		}
+		t.ObjectLabels[name] = value
+	}
+	for _, name := range supplied.List() {
+		if param := template.GetParameterByName(t, name); param != nil {
+			param.Value = template.GetParameterByName(next, name).Value
+			param.Generate = ""
+		}
+	}
+
+	processed, err := client.Create(t)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, param := range processed.Parameters {
+		declared := template.GetParameterByName(t, param.Name)
+		target := template.GetParameterByName(next, param.Name)
+		if declared == nil || target == nil || supplied.Has(param.Name) || len(declared.Value) > 0 || len(declared.Generate) == 0 {
+			continue
+		}
+		if param.Sensitive && param.Value == templateapi.RedactedParameterValue {
+			fmt.Fprintf(errOut, "warning: the generated value of the sensitive parameter %s cannot be preserved, supply it to keep it unchanged\n", param.Name)
+			continue
+		}
+		target.Value = param.Value
+		target.Generate = ""
+	}
+	return processed, nil
+}
+
+// readParameterFile reads the parameter values stored in the given file and
+// returns them as a sorted list of KEY=VALUE pairs.
+func readParameterFile(filename string) ([]string, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read parameter file %q: %v", filename, err)
+	}
+	defer file.Close()
+	params, err := template.ReadParameterFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read parameter file %q: %v", filename, err)
+	}
+	values := make([]string, 0, len(params))
+	for name, value := range params {
+		values = append(values, fmt.Sprintf("%s=%s", name, value))
+	}
+	sort.Strings(values)
+	return values, nil
+}
+
+// injectUserVars injects user specified variables into the Template and
+// returns the names of the parameters that were set. Unless strict is set,
+// values for parameters the Template does not declare are reported to out and
+// ignored.
+func injectUserVars(values []string, out io.Writer, t *templateapi.Template, strict bool) (sets.String, error) {
+	params := make(map[string]string, len(values))
 	for _, keypair := range values {
 		p := strings.SplitN(keypair, "=", 2)
 		if len(p) != 2 {
 			fmt.Fprintf(out, "invalid parameter assignment in %q: %q\n", t.Name, keypair)
 			continue
 		}
-		if v := template.GetParameterByName(t, p[0]); v != nil {
-			v.Value = p[1]
-			v.Generate = ""
-			template.AddParameter(t, *v)
+		params[p[0]] = p[1]
+	}
+	processor := template.NewProcessor(nil)
+	processor.Strict = strict
+	if errs := processor.SetParameterValues(t, params); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+	injected := sets.NewString()
+	for _, name := range sets.StringKeySet(params).List() {
+		if template.GetParameterByName(t, name) != nil {
+			injected.Insert(name)
 		} else {
-			fmt.Fprintf(out, "unknown parameter name %q\n", p[0])
+			fmt.Fprintf(out, "unknown parameter name %q\n", name)
 		}
 	}
+	return injected, nil
 }
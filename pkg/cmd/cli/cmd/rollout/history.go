@@ -0,0 +1,139 @@
+package rollout
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/kubectl"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+const (
+	historyLong = `
+View the deployment history of a deployment config
+
+Lists every deployment of the config that still exists along with its status and what caused
+it. Pass --revision to view the pod template that was rolled out by a specific deployment.`
+
+	historyExample = `  # View the deployments of the 'frontend' deployment config
+  $ %[1]s history dc/frontend
+
+  # View the pod template rolled out by the third deployment
+  $ %[1]s history dc/frontend --revision=3`
+)
+
+// HistoryOptions holds the options for the rollout history command.
+type HistoryOptions struct {
+	RolloutOptions
+
+	Revision int
+}
+
+// NewCmdHistory implements the rollout history command.
+func NewCmdHistory(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &HistoryOptions{}
+	cmd := &cobra.Command{
+		Use:     "history DEPLOYMENTCONFIG [--revision=N]",
+		Short:   "View the deployment history of a deployment config",
+		Long:    historyLong,
+		Example: fmt.Sprintf(historyExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.Complete(f, args, out); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, "%s", err.Error()))
+			}
+			kcmdutil.CheckErr(options.Validate())
+			kcmdutil.CheckErr(options.Run())
+		},
+	}
+	cmd.Flags().IntVar(&options.Revision, "revision", options.Revision, "Show the pod template of the deployment with this version.")
+	return cmd
+}
+
+// Validate ensures that the options can be used to show the history.
+func (o *HistoryOptions) Validate() error {
+	if o.Revision < 0 {
+		return fmt.Errorf("--revision must be a positive number")
+	}
+	return o.RolloutOptions.Validate()
+}
+
+// Run prints the deployments of the deployment config.
+func (o *HistoryOptions) Run() error {
+	deployments, err := o.KubeClient.ReplicationControllers(o.Namespace).List(kapi.ListOptions{LabelSelector: deployutil.ConfigSelector(o.Name)})
+	if err != nil {
+		return err
+	}
+	if len(deployments.Items) == 0 {
+		fmt.Fprintf(o.Out, "No deployments found for deploymentconfig %q\n", o.Name)
+		return nil
+	}
+	sort.Sort(deployutil.ByLatestVersionAsc(deployments.Items))
+
+	if o.Revision > 0 {
+		for i := range deployments.Items {
+			deployment := &deployments.Items[i]
+			if deployutil.DeploymentVersionFor(deployment) != o.Revision {
+				continue
+			}
+			description, err := kubectl.DescribePodTemplate(deployment.Spec.Template)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(o.Out, "deploymentconfig %q, revision %d\n%s", o.Name, o.Revision, description)
+			return nil
+		}
+		return fmt.Errorf("unable to find deployment #%d of deploymentconfig %q", o.Revision, o.Name)
+	}
+
+	w := tabwriter.NewWriter(o.Out, 10, 4, 3, ' ', 0)
+	defer w.Flush()
+	fmt.Fprintln(w, "REVISION\tSTATUS\tCAUSE")
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		fmt.Fprintf(w, "%d\t%s\t%s\n", deployutil.DeploymentVersionFor(deployment), deployutil.DeploymentStatusFor(deployment), deploymentCause(deployment))
+	}
+	return nil
+}
+
+// deploymentCause returns the causes recorded in the config a deployment was
+// made from.
+func deploymentCause(deployment *kapi.ReplicationController) string {
+	config, err := deployutil.DecodeDeploymentConfig(deployment, kapi.Codecs.UniversalDecoder())
+	if err != nil || config.Status.Details == nil {
+		return "<unknown>"
+	}
+	if len(config.Status.Details.Message) > 0 {
+		return config.Status.Details.Message
+	}
+	causes := []string{}
+	for _, cause := range config.Status.Details.Causes {
+		switch cause.Type {
+		case deployapi.DeploymentTriggerOnImageChange:
+			if cause.ImageTrigger != nil {
+				causes = append(causes, fmt.Sprintf("image change (%s)", cause.ImageTrigger.From.Name))
+				continue
+			}
+			causes = append(causes, "image change")
+		case deployapi.DeploymentTriggerOnConfigChange:
+			causes = append(causes, "config change")
+		case deployapi.DeploymentTriggerManual:
+			causes = append(causes, "manual change")
+		default:
+			causes = append(causes, string(cause.Type))
+		}
+	}
+	if len(causes) == 0 {
+		return "<unknown>"
+	}
+	return strings.Join(causes, ", ")
+}
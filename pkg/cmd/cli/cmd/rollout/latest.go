@@ -0,0 +1,59 @@
+package rollout
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+)
+
+const (
+	latestLong = `
+Start a new deployment of a deployment config
+
+A new deployment is started from the current template of the deployment config, even if the
+config is not triggered by config changes. Only one deployment of a config may be in progress
+at a time, and paused deployment configs must be resumed first.`
+
+	latestExample = `  # Start a new deployment of the 'database' deployment config
+  $ %[1]s latest dc/database`
+)
+
+// LatestOptions holds the options for the rollout latest command.
+type LatestOptions struct {
+	RolloutOptions
+}
+
+// NewCmdLatest implements the rollout latest command.
+func NewCmdLatest(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &LatestOptions{}
+	cmd := &cobra.Command{
+		Use:     "latest DEPLOYMENTCONFIG",
+		Short:   "Start a new deployment of a deployment config",
+		Long:    latestLong,
+		Example: fmt.Sprintf(latestExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.Complete(f, args, out); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, "%s", err.Error()))
+			}
+			kcmdutil.CheckErr(options.Validate())
+			kcmdutil.CheckErr(options.Run())
+		},
+	}
+	return cmd
+}
+
+// Run requests a new deployment of the deployment config.
+func (o *LatestOptions) Run() error {
+	config, err := o.OSClient.DeploymentConfigs(o.Namespace).Instantiate(&deployapi.DeploymentRequest{Name: o.Name})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "Started deployment #%d\n", config.Status.LatestVersion)
+	return nil
+}
@@ -0,0 +1,101 @@
+package rollout
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const (
+	pauseLong = `
+Pause a deployment config
+
+While a deployment config is paused, changes to its template or to the images it is
+triggered by do not result in new deployments. This allows several changes to be made to
+the config and rolled out together once it is resumed. A new deployment can still be started
+manually after the config is resumed.`
+
+	pauseExample = `  # Pause the 'frontend' deployment config
+  $ %[1]s pause dc/frontend`
+
+	resumeLong = `
+Resume a paused deployment config
+
+Once resumed, the triggers of the deployment config start new deployments again. Changes made
+to the config while it was paused are rolled out by its config change trigger.`
+
+	resumeExample = `  # Resume the 'frontend' deployment config
+  $ %[1]s resume dc/frontend`
+)
+
+// PauseOptions holds the options for the rollout pause and resume commands.
+type PauseOptions struct {
+	RolloutOptions
+
+	Paused bool
+}
+
+// NewCmdPause implements the rollout pause command.
+func NewCmdPause(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &PauseOptions{Paused: true}
+	cmd := &cobra.Command{
+		Use:     "pause DEPLOYMENTCONFIG",
+		Short:   "Pause a deployment config",
+		Long:    pauseLong,
+		Example: fmt.Sprintf(pauseExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.Complete(f, args, out); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, "%s", err.Error()))
+			}
+			kcmdutil.CheckErr(options.Validate())
+			kcmdutil.CheckErr(options.Run())
+		},
+	}
+	return cmd
+}
+
+// NewCmdResume implements the rollout resume command.
+func NewCmdResume(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &PauseOptions{Paused: false}
+	cmd := &cobra.Command{
+		Use:     "resume DEPLOYMENTCONFIG",
+		Short:   "Resume a paused deployment config",
+		Long:    resumeLong,
+		Example: fmt.Sprintf(resumeExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.Complete(f, args, out); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, "%s", err.Error()))
+			}
+			kcmdutil.CheckErr(options.Validate())
+			kcmdutil.CheckErr(options.Run())
+		},
+	}
+	return cmd
+}
+
+// Run pauses or resumes the deployment config.
+func (o *PauseOptions) Run() error {
+	config, err := o.OSClient.DeploymentConfigs(o.Namespace).Get(o.Name)
+	if err != nil {
+		return err
+	}
+	state := "resumed"
+	if o.Paused {
+		state = "paused"
+	}
+	if config.Spec.Paused == o.Paused {
+		fmt.Fprintf(o.Out, "deploymentconfig %q is already %s\n", config.Name, state)
+		return nil
+	}
+	config.Spec.Paused = o.Paused
+	if _, err := o.OSClient.DeploymentConfigs(o.Namespace).Update(config); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "deploymentconfig %q %s\n", config.Name, state)
+	return nil
+}
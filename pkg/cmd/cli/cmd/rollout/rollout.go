@@ -0,0 +1,127 @@
+package rollout
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/templates"
+	cmdutil "github.com/openshift/origin/pkg/cmd/util"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const (
+	rolloutLong = `
+Manage the rollout of a deployment config
+
+These commands help you start new deployments of a deployment config, watch their progress,
+inspect the deployments made so far and roll back to a previous one. A paused deployment config
+is not deployed by its config change or image change triggers until it is resumed.`
+)
+
+// NewCmdRollout exposes commands for managing the rollout of deployment configs.
+func NewCmdRollout(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	rollout := &cobra.Command{
+		Use:   "rollout COMMAND",
+		Short: "Manage the rollout of a deployment config",
+		Long:  rolloutLong,
+		Run:   cmdutil.DefaultSubCommandRun(out),
+	}
+
+	name := fmt.Sprintf("%s rollout", fullName)
+
+	groups := templates.CommandGroups{
+		{
+			Message: "Start and observe deployments:",
+			Commands: []*cobra.Command{
+				NewCmdLatest(name, f, out),
+				NewCmdStatus(name, f, out),
+				NewCmdHistory(name, f, out),
+				NewCmdUndo(name, f, out),
+			},
+		},
+		{
+			Message: "Control triggered deployments:",
+			Commands: []*cobra.Command{
+				NewCmdPause(name, f, out),
+				NewCmdResume(name, f, out),
+			},
+		},
+	}
+	groups.Add(rollout)
+	templates.ActsAsRootCommand(rollout, []string{"options"}, groups...)
+	return rollout
+}
+
+// RolloutOptions holds the options shared by all the rollout commands.
+type RolloutOptions struct {
+	Out io.Writer
+
+	Namespace string
+	Name      string
+
+	OSClient   client.Interface
+	KubeClient kclient.Interface
+}
+
+// Complete resolves the deployment config named in args and the clients
+// needed to act on it.
+func (o *RolloutOptions) Complete(f *clientcmd.Factory, args []string, out io.Writer) error {
+	if len(args) != 1 {
+		return errors.New("a single deployment config name is required")
+	}
+	name, err := deploymentConfigName(args[0])
+	if err != nil {
+		return err
+	}
+	o.Name = name
+
+	o.Namespace, _, err = f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	o.OSClient, o.KubeClient, err = f.Clients()
+	if err != nil {
+		return err
+	}
+	o.Out = out
+	return nil
+}
+
+// Validate ensures that the options can be used to act on a deployment config.
+func (o *RolloutOptions) Validate() error {
+	if len(o.Name) == 0 {
+		return errors.New("a deployment config name is required")
+	}
+	if o.Out == nil {
+		return errors.New("out must not be nil")
+	}
+	if o.OSClient == nil {
+		return errors.New("an OpenShift client is required")
+	}
+	if o.KubeClient == nil {
+		return errors.New("a Kubernetes client is required")
+	}
+	return nil
+}
+
+// deploymentConfigName accepts a deployment config either by name or as
+// dc/NAME and returns its name.
+func deploymentConfigName(arg string) (string, error) {
+	parts := strings.SplitN(arg, "/", 2)
+	if len(parts) == 1 {
+		return arg, nil
+	}
+	switch parts[0] {
+	case "dc", "deploymentconfig", "deploymentconfigs":
+		return parts[1], nil
+	default:
+		return "", fmt.Errorf("%s is not a deployment config", arg)
+	}
+}
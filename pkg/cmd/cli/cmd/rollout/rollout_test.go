@@ -0,0 +1,229 @@
+package rollout
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	ktc "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	tc "github.com/openshift/origin/pkg/client/testclient"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+
+	// install all APIs
+	_ "github.com/openshift/origin/pkg/api/install"
+	_ "k8s.io/kubernetes/pkg/api/install"
+)
+
+func deploymentFor(config *deployapi.DeploymentConfig, status deployapi.DeploymentStatus) *kapi.ReplicationController {
+	d, err := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+	if err != nil {
+		panic(err)
+	}
+	d.Annotations[deployapi.DeploymentStatusAnnotation] = string(status)
+	return d
+}
+
+func TestDeploymentConfigName(t *testing.T) {
+	tests := map[string]struct {
+		arg         string
+		expected    string
+		expectedErr bool
+	}{
+		"plain name":     {arg: "frontend", expected: "frontend"},
+		"short resource": {arg: "dc/frontend", expected: "frontend"},
+		"long resource":  {arg: "deploymentconfigs/frontend", expected: "frontend"},
+		"other resource": {arg: "rc/frontend-1", expectedErr: true},
+	}
+	for name, test := range tests {
+		got, err := deploymentConfigName(test.arg)
+		if test.expectedErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if got != test.expected {
+			t.Errorf("%s: expected %q, got %q", name, test.expected, got)
+		}
+	}
+}
+
+// TestLatest ensures that rollout latest requests a new deployment through
+// the instantiate subresource.
+func TestLatest(t *testing.T) {
+	var request *deployapi.DeploymentRequest
+	osClient := &tc.Fake{}
+	osClient.AddReactor("create", "deploymentconfigs", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+		if action.GetSubresource() != "instantiate" {
+			t.Fatalf("unexpected subresource %q", action.GetSubresource())
+		}
+		request = action.(ktc.CreateAction).GetObject().(*deployapi.DeploymentRequest)
+		return true, deploytest.OkDeploymentConfig(2), nil
+	})
+
+	out := &bytes.Buffer{}
+	o := &LatestOptions{RolloutOptions{Out: out, Namespace: "test", Name: "config", OSClient: osClient}}
+	if err := o.Run(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if request == nil || request.Name != "config" {
+		t.Fatalf("expected a deployment request for config, got %#v", request)
+	}
+	if !strings.Contains(out.String(), "Started deployment #2") {
+		t.Errorf("unexpected output: %s", out.String())
+	}
+}
+
+// TestPause ensures that pause and resume toggle the paused field and only
+// update the config when it changes.
+func TestPause(t *testing.T) {
+	tests := []struct {
+		name      string
+		paused    bool
+		pause     bool
+		expectErr bool
+		updated   bool
+	}{
+		{name: "pause", paused: false, pause: true, updated: true},
+		{name: "pause paused", paused: true, pause: true, updated: false},
+		{name: "resume", paused: true, pause: false, updated: true},
+		{name: "resume resumed", paused: false, pause: false, updated: false},
+	}
+
+	for _, test := range tests {
+		config := deploytest.OkDeploymentConfig(1)
+		config.Spec.Paused = test.paused
+		var updatedConfig *deployapi.DeploymentConfig
+
+		osClient := &tc.Fake{}
+		osClient.AddReactor("get", "deploymentconfigs", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+			return true, config, nil
+		})
+		osClient.AddReactor("update", "deploymentconfigs", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+			updatedConfig = action.(ktc.UpdateAction).GetObject().(*deployapi.DeploymentConfig)
+			return true, updatedConfig, nil
+		})
+
+		o := &PauseOptions{RolloutOptions: RolloutOptions{Out: &bytes.Buffer{}, Namespace: "test", Name: config.Name, OSClient: osClient}, Paused: test.pause}
+		if err := o.Run(); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !test.updated {
+			if updatedConfig != nil {
+				t.Errorf("%s: expected no update", test.name)
+			}
+			continue
+		}
+		if updatedConfig == nil {
+			t.Errorf("%s: expected an update", test.name)
+			continue
+		}
+		if e, a := test.pause, updatedConfig.Spec.Paused; e != a {
+			t.Errorf("%s: expected paused %t, got %t", test.name, e, a)
+		}
+	}
+}
+
+// TestStatus ensures that rollout status reports the latest deployment and
+// fails when it failed.
+func TestStatus(t *testing.T) {
+	tests := []struct {
+		status    deployapi.DeploymentStatus
+		expectErr bool
+	}{
+		{status: deployapi.DeploymentStatusComplete},
+		{status: deployapi.DeploymentStatusFailed, expectErr: true},
+	}
+
+	for _, test := range tests {
+		config := deploytest.OkDeploymentConfig(1)
+		osClient := tc.NewSimpleFake(config)
+		kubeClient := ktc.NewSimpleFake(deploymentFor(config, test.status))
+
+		o := &StatusOptions{RolloutOptions: RolloutOptions{Out: &bytes.Buffer{}, Namespace: config.Namespace, Name: config.Name, OSClient: osClient, KubeClient: kubeClient}, Watch: true}
+		err := o.Run()
+		if test.expectErr && err == nil {
+			t.Errorf("%s: expected an error", test.status)
+		}
+		if !test.expectErr && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.status, err)
+		}
+	}
+}
+
+// TestHistory ensures that rollout history lists every deployment in order.
+func TestHistory(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	first := deploymentFor(config, deployapi.DeploymentStatusComplete)
+	config.Status.LatestVersion = 2
+	second := deploymentFor(config, deployapi.DeploymentStatusRunning)
+	kubeClient := ktc.NewSimpleFake(&kapi.ReplicationControllerList{Items: []kapi.ReplicationController{*second, *first}})
+
+	out := &bytes.Buffer{}
+	o := &HistoryOptions{RolloutOptions: RolloutOptions{Out: out, Namespace: config.Namespace, Name: config.Name, KubeClient: kubeClient}}
+	if err := o.Run(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected a header and two deployments, got:\n%s", out.String())
+	}
+	if !strings.HasPrefix(lines[1], "1 ") || !strings.Contains(lines[1], "Complete") {
+		t.Errorf("unexpected first deployment: %s", lines[1])
+	}
+	if !strings.HasPrefix(lines[2], "2 ") || !strings.Contains(lines[2], "Running") {
+		t.Errorf("unexpected second deployment: %s", lines[2])
+	}
+}
+
+// TestUndo ensures that rollout undo rolls back to the last completed
+// deployment older than the latest one.
+func TestUndo(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	first := deploymentFor(config, deployapi.DeploymentStatusComplete)
+	config.Status.LatestVersion = 2
+	second := deploymentFor(config, deployapi.DeploymentStatusFailed)
+	kubeClient := ktc.NewSimpleFake(&kapi.ReplicationControllerList{Items: []kapi.ReplicationController{*first, *second}})
+
+	var rollback *deployapi.DeploymentConfigRollback
+	var updatedConfig *deployapi.DeploymentConfig
+	osClient := &tc.Fake{}
+	osClient.AddReactor("get", "deploymentconfigs", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+		return true, config, nil
+	})
+	osClient.AddReactor("create", "deploymentconfigrollbacks", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+		rollback = action.(ktc.CreateAction).GetObject().(*deployapi.DeploymentConfigRollback)
+		return true, config, nil
+	})
+	osClient.AddReactor("update", "deploymentconfigs", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+		updatedConfig = action.(ktc.UpdateAction).GetObject().(*deployapi.DeploymentConfig)
+		return true, updatedConfig, nil
+	})
+
+	o := &UndoOptions{RolloutOptions: RolloutOptions{Out: &bytes.Buffer{}, Namespace: config.Namespace, Name: config.Name, OSClient: osClient, KubeClient: kubeClient}}
+	if err := o.Run(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rollback == nil {
+		t.Fatalf("expected a rollback")
+	}
+	if e, a := first.Name, rollback.Spec.From.Name; e != a {
+		t.Errorf("expected rollback to %s, got %s", e, a)
+	}
+	if !rollback.Spec.IncludeTemplate {
+		t.Errorf("expected the template to be rolled back")
+	}
+	if updatedConfig == nil {
+		t.Errorf("expected the rolled back config to be saved")
+	}
+}
@@ -0,0 +1,127 @@
+package rollout
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/util/wait"
+
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+const (
+	statusLong = `
+Watch the status of the latest deployment of a deployment config
+
+The command waits until the latest deployment completes or fails, printing its status as it
+changes. It exits with an error if the deployment fails or is cancelled.`
+
+	statusExample = `  # Watch the latest deployment of the 'frontend' deployment config
+  $ %[1]s status dc/frontend`
+)
+
+// StatusOptions holds the options for the rollout status command.
+type StatusOptions struct {
+	RolloutOptions
+
+	Watch    bool
+	Interval time.Duration
+}
+
+// NewCmdStatus implements the rollout status command.
+func NewCmdStatus(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &StatusOptions{
+		Watch:    true,
+		Interval: time.Second,
+	}
+	cmd := &cobra.Command{
+		Use:     "status DEPLOYMENTCONFIG [--watch=false]",
+		Short:   "Watch the latest deployment of a deployment config",
+		Long:    statusLong,
+		Example: fmt.Sprintf(statusExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.Complete(f, args, out); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, "%s", err.Error()))
+			}
+			kcmdutil.CheckErr(options.Validate())
+			kcmdutil.CheckErr(options.Run())
+		},
+	}
+	cmd.Flags().BoolVarP(&options.Watch, "watch", "w", options.Watch, "Wait for the latest deployment to complete or fail.")
+	return cmd
+}
+
+// Run prints the status of the latest deployment, waiting for it to finish
+// if Watch is set.
+func (o *StatusOptions) Run() error {
+	config, err := o.OSClient.DeploymentConfigs(o.Namespace).Get(o.Name)
+	if err != nil {
+		return err
+	}
+	if config.Status.LatestVersion == 0 {
+		fmt.Fprintf(o.Out, "deploymentconfig %q has not been deployed yet\n", config.Name)
+		return nil
+	}
+	if config.Spec.Paused {
+		fmt.Fprintf(o.Out, "deploymentconfig %q is paused\n", config.Name)
+	}
+
+	deploymentName := deployutil.LatestDeploymentNameForConfig(config)
+	var lastStatus deployapi.DeploymentStatus
+	var finalStatus deployapi.DeploymentStatus
+	condition := func() (bool, error) {
+		deployment, err := o.KubeClient.ReplicationControllers(o.Namespace).Get(deploymentName)
+		if err != nil {
+			if kerrors.IsNotFound(err) && o.Watch {
+				return false, nil
+			}
+			return false, err
+		}
+		status := deployutil.DeploymentStatusFor(deployment)
+		if status != lastStatus {
+			fmt.Fprintf(o.Out, "#%d is %s\n", config.Status.LatestVersion, describeStatus(status, deployment.Spec.Replicas))
+			lastStatus = status
+		}
+		switch status {
+		case deployapi.DeploymentStatusComplete, deployapi.DeploymentStatusFailed:
+			finalStatus = status
+			return true, nil
+		}
+		return !o.Watch, nil
+	}
+	done, err := condition()
+	if err != nil {
+		return err
+	}
+	if !done {
+		if err := wait.PollInfinite(o.Interval, condition); err != nil {
+			return err
+		}
+	}
+	if finalStatus == deployapi.DeploymentStatusFailed {
+		return fmt.Errorf("deployment #%d of %q failed", config.Status.LatestVersion, config.Name)
+	}
+	return nil
+}
+
+// describeStatus returns a short human readable description of a deployment
+// status.
+func describeStatus(status deployapi.DeploymentStatus, replicas int) string {
+	switch status {
+	case deployapi.DeploymentStatusComplete:
+		return fmt.Sprintf("complete (%d replicas)", replicas)
+	case deployapi.DeploymentStatusFailed:
+		return "failed"
+	case deployapi.DeploymentStatusRunning:
+		return "running"
+	default:
+		return "pending"
+	}
+}
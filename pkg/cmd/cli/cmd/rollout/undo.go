@@ -0,0 +1,139 @@
+package rollout
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+const (
+	undoLong = `
+Roll back a deployment config to a previous deployment
+
+The pod template of the previous successful deployment, or of the deployment selected with
+--to-revision, is copied back into the deployment config and rolled out as a new deployment.
+Image change triggers are disabled by the rollback so that the rolled back config is not
+immediately replaced by a newer image; re-enable them once the problem is fixed.`
+
+	undoExample = `  # Roll back to the previous successful deployment
+  $ %[1]s undo dc/frontend
+
+  # Roll back to the third deployment
+  $ %[1]s undo dc/frontend --to-revision=3`
+)
+
+// UndoOptions holds the options for the rollout undo command.
+type UndoOptions struct {
+	RolloutOptions
+
+	ToRevision int
+}
+
+// NewCmdUndo implements the rollout undo command.
+func NewCmdUndo(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &UndoOptions{}
+	cmd := &cobra.Command{
+		Use:     "undo DEPLOYMENTCONFIG [--to-revision=N]",
+		Short:   "Roll back a deployment config to a previous deployment",
+		Long:    undoLong,
+		Example: fmt.Sprintf(undoExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.Complete(f, args, out); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, "%s", err.Error()))
+			}
+			kcmdutil.CheckErr(options.Validate())
+			kcmdutil.CheckErr(options.Run())
+		},
+	}
+	cmd.Flags().IntVar(&options.ToRevision, "to-revision", options.ToRevision, "The version of the deployment to roll back to. Defaults to the last successful deployment.")
+	return cmd
+}
+
+// Validate ensures that the options can be used to roll back.
+func (o *UndoOptions) Validate() error {
+	if o.ToRevision < 0 {
+		return fmt.Errorf("--to-revision must be a positive number")
+	}
+	return o.RolloutOptions.Validate()
+}
+
+// Run rolls the deployment config back to the target deployment.
+func (o *UndoOptions) Run() error {
+	config, err := o.OSClient.DeploymentConfigs(o.Namespace).Get(o.Name)
+	if err != nil {
+		return err
+	}
+	target, err := o.findTargetDeployment(config)
+	if err != nil {
+		return err
+	}
+
+	rollback := &deployapi.DeploymentConfigRollback{
+		Spec: deployapi.DeploymentConfigRollbackSpec{
+			From: kapi.ObjectReference{
+				Name: target.Name,
+			},
+			IncludeTemplate: true,
+		},
+	}
+	newConfig, err := o.OSClient.DeploymentConfigs(o.Namespace).Rollback(rollback)
+	if err != nil {
+		return err
+	}
+	rolledback, err := o.OSClient.DeploymentConfigs(o.Namespace).Update(newConfig)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "#%d rolled back to %s\n", rolledback.Status.LatestVersion, target.Name)
+	disabled := []string{}
+	for _, trigger := range rolledback.Spec.Triggers {
+		if trigger.Type == deployapi.DeploymentTriggerOnImageChange && !trigger.ImageChangeParams.Automatic {
+			disabled = append(disabled, trigger.ImageChangeParams.From.Name)
+		}
+	}
+	if len(disabled) > 0 {
+		reenable := fmt.Sprintf("oc set triggers dc/%s --auto -n %s", rolledback.Name, o.Namespace)
+		fmt.Fprintf(o.Out, "Warning: the following images triggers were disabled: %s\n  You can re-enable them with: %s\n", strings.Join(disabled, ","), reenable)
+	}
+	return nil
+}
+
+// findTargetDeployment returns the deployment of config with version
+// ToRevision, or the last completed deployment older than the latest one if
+// no revision was requested.
+func (o *UndoOptions) findTargetDeployment(config *deployapi.DeploymentConfig) (*kapi.ReplicationController, error) {
+	deployments, err := o.KubeClient.ReplicationControllers(config.Namespace).List(kapi.ListOptions{LabelSelector: deployutil.ConfigSelector(config.Name)})
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(deployutil.ByLatestVersionDesc(deployments.Items))
+
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		version := deployutil.DeploymentVersionFor(deployment)
+		if o.ToRevision > 0 {
+			if version == o.ToRevision {
+				return deployment, nil
+			}
+			continue
+		}
+		if version < config.Status.LatestVersion && deployutil.DeploymentStatusFor(deployment) == deployapi.DeploymentStatusComplete {
+			return deployment, nil
+		}
+	}
+	if o.ToRevision > 0 {
+		return nil, fmt.Errorf("unable to find deployment #%d of deploymentconfig %q", o.ToRevision, config.Name)
+	}
+	return nil, fmt.Errorf("no previous successful deployment of deploymentconfig %q to roll back to", config.Name)
+}
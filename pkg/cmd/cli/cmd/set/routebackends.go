@@ -0,0 +1,280 @@
+package set
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/meta"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	cmdutil "github.com/openshift/origin/pkg/cmd/util"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+const (
+	routeBackendsLong = `
+Set and adjust the backends of a route
+
+Routes may send traffic to more than one service. Each service is given a weight between 0
+and 256, and receives a share of the requests to the route proportional to its weight. A
+weight of 0 stops new requests from being sent to a service while keeping it on the route.
+Shifting the weights gradually from one service to another allows a new version of an
+application to be rolled out to a growing share of its users (blue-green deployments).
+
+The first service given becomes the primary backend of the route, the remaining services are
+set as its alternate backends. Without any services, the current backends of the route and
+their share of the traffic are printed.
+
+Weights are currently only honored by the HAProxy router, other routers send all the
+traffic of a route to its primary backend.`
+
+	routeBackendsExample = `  # Print the backends of a route
+  $ %[1]s route-backends web
+
+  # Send 80%% of the traffic of a route to the service blue and 20%% to the service green
+  $ %[1]s route-backends web blue=80 green=20
+
+  # Send all the traffic of a route to the service green while keeping the service blue
+  $ %[1]s route-backends web green=100 blue=0`
+)
+
+type BackendsOptions struct {
+	Out io.Writer
+	Err io.Writer
+
+	Builder *resource.Builder
+	Infos   []*resource.Info
+
+	Encoder runtime.Encoder
+
+	ShortOutput bool
+	Mapper      meta.RESTMapper
+
+	PrintTable  bool
+	PrintObject func(runtime.Object) error
+
+	Backends []routeapi.RouteTargetReference
+}
+
+// NewCmdRouteBackends implements the set route-backends command
+func NewCmdRouteBackends(fullName string, f *clientcmd.Factory, out, errOut io.Writer) *cobra.Command {
+	options := &BackendsOptions{
+		Out: out,
+		Err: errOut,
+	}
+	cmd := &cobra.Command{
+		Use:     "route-backends ROUTENAME [SERVICE=WEIGHT ...]",
+		Short:   "Update the backends of a route",
+		Long:    routeBackendsLong,
+		Example: fmt.Sprintf(routeBackendsExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(options.Complete(f, cmd, args))
+			kcmdutil.CheckErr(options.Validate())
+			if err := options.Run(); err != nil {
+				// TODO: move met to kcmdutil
+				if err == cmdutil.ErrExit {
+					os.Exit(1)
+				}
+				kcmdutil.CheckErr(err)
+			}
+		},
+	}
+
+	kcmdutil.AddPrinterFlags(cmd)
+
+	return cmd
+}
+
+func (o *BackendsOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []string) error {
+	if len(args) < 1 {
+		return kcmdutil.UsageError(cmd, "a route must be specified as <name> or routes/<name>")
+	}
+
+	backends, err := parseRouteBackends(args[1:])
+	if err != nil {
+		return kcmdutil.UsageError(cmd, "%v", err)
+	}
+	o.Backends = backends
+	o.PrintTable = len(backends) == 0
+
+	cmdNamespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	mapper, typer := f.Object()
+	o.Builder = resource.NewBuilder(mapper, typer, resource.ClientMapperFunc(f.ClientForMapping), kapi.Codecs.UniversalDecoder()).
+		ContinueOnError().
+		NamespaceParam(cmdNamespace).DefaultNamespace().
+		ResourceNames("routes", args[0]).
+		Flatten()
+
+	output := kcmdutil.GetFlagString(cmd, "output")
+	if len(output) != 0 {
+		o.PrintObject = func(obj runtime.Object) error { return f.PrintObject(cmd, obj, o.Out) }
+	}
+
+	o.Encoder = f.JSONEncoder()
+	o.ShortOutput = kcmdutil.GetFlagString(cmd, "output") == "name"
+	o.Mapper = mapper
+
+	return nil
+}
+
+func (o *BackendsOptions) Validate() error {
+	total := int32(0)
+	for _, backend := range o.Backends {
+		total += *backend.Weight
+	}
+	if len(o.Backends) > 0 && total == 0 {
+		return fmt.Errorf("at least one service must have a weight greater than zero")
+	}
+	return nil
+}
+
+func (o *BackendsOptions) Run() error {
+	infos := o.Infos
+	singular := len(o.Infos) <= 1
+	if o.Builder != nil {
+		loaded, err := o.Builder.Do().IntoSingular(&singular).Infos()
+		if err != nil {
+			return err
+		}
+		infos = loaded
+	}
+
+	if o.PrintTable && o.PrintObject == nil {
+		return o.printBackends(infos)
+	}
+
+	patches := CalculatePatches(infos, o.Encoder, func(info *resource.Info) (bool, error) {
+		route, ok := info.Object.(*routeapi.Route)
+		if !ok {
+			return false, nil
+		}
+		if len(o.Backends) > 0 {
+			route.Spec.To = o.Backends[0]
+			route.Spec.AlternateBackends = o.Backends[1:]
+		}
+		return true, nil
+	})
+	if singular && len(patches) == 0 {
+		return fmt.Errorf("%s/%s is not a route", infos[0].Mapping.Resource, infos[0].Name)
+	}
+
+	if o.PrintObject != nil {
+		var infos []*resource.Info
+		for _, patch := range patches {
+			info := patch.Info
+			if patch.Err != nil {
+				fmt.Fprintf(o.Err, "error: %s/%s %v\n", info.Mapping.Resource, info.Name, patch.Err)
+				continue
+			}
+			infos = append(infos, info)
+		}
+		if len(infos) == 0 {
+			return cmdutil.ErrExit
+		}
+		object, err := resource.AsVersionedObject(infos, !singular, "", nil)
+		if err != nil {
+			return err
+		}
+		return o.PrintObject(object)
+	}
+
+	failed := false
+	for _, patch := range patches {
+		info := patch.Info
+		if patch.Err != nil {
+			failed = true
+			fmt.Fprintf(o.Err, "error: %s/%s %v\n", info.Mapping.Resource, info.Name, patch.Err)
+			continue
+		}
+
+		if string(patch.Patch) == "{}" || len(patch.Patch) == 0 {
+			fmt.Fprintf(o.Err, "info: %s %q was not changed\n", info.Mapping.Resource, info.Name)
+			continue
+		}
+
+		glog.V(4).Infof("Calculated patch %s", patch.Patch)
+
+		obj, err := resource.NewHelper(info.Client, info.Mapping).Patch(info.Namespace, info.Name, kapi.StrategicMergePatchType, patch.Patch)
+		if err != nil {
+			fmt.Fprintf(o.Err, "error: %v\n", err)
+			failed = true
+			continue
+		}
+
+		info.Refresh(obj, true)
+		kcmdutil.PrintSuccess(o.Mapper, o.ShortOutput, o.Out, info.Mapping.Resource, info.Name, "updated")
+	}
+	if failed {
+		return cmdutil.ErrExit
+	}
+	return nil
+}
+
+// printBackends displays a tabular output of the backends of each route.
+func (o *BackendsOptions) printBackends(infos []*resource.Info) error {
+	w := tabwriter.NewWriter(o.Out, 0, 2, 2, ' ', 0)
+	defer w.Flush()
+	fmt.Fprintf(w, "NAME\tKIND\tTO\tWEIGHT\tSHARE\n")
+	for _, info := range infos {
+		route, ok := info.Object.(*routeapi.Route)
+		if !ok {
+			fmt.Fprintf(w, "%s/%s\t%s\t%s\t%s\t%s\n", info.Mapping.Resource, info.Name, "<error>", "", "", "")
+			continue
+		}
+		backends := routeapi.RouteBackends(route)
+		total := int32(0)
+		for i := range backends {
+			total += routeapi.BackendWeight(&backends[i])
+		}
+		for i := range backends {
+			weight := routeapi.BackendWeight(&backends[i])
+			share := int32(0)
+			if total > 0 {
+				share = weight * 100 / total
+			}
+			fmt.Fprintf(w, "%s/%s\t%s\t%s\t%d\t%d%%\n", info.Mapping.Resource, info.Name, backends[i].Kind, backends[i].Name, weight, share)
+		}
+	}
+	return nil
+}
+
+// parseRouteBackends parses a list of SERVICE=WEIGHT arguments into route
+// backends, preserving their order.
+func parseRouteBackends(args []string) ([]routeapi.RouteTargetReference, error) {
+	backends := []routeapi.RouteTargetReference{}
+	names := sets.NewString()
+	for _, arg := range args {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 || len(parts[0]) == 0 {
+			return nil, fmt.Errorf("backends must be specified as SERVICE=WEIGHT, got %q", arg)
+		}
+		name := parts[0]
+		if names.Has(name) {
+			return nil, fmt.Errorf("the service %q was specified more than once", name)
+		}
+		names.Insert(name)
+		weight, err := strconv.ParseInt(parts[1], 10, 32)
+		if err != nil || weight < 0 || weight > 256 {
+			return nil, fmt.Errorf("the weight of the service %q must be a number between 0 and 256, got %q", name, parts[1])
+		}
+		w := int32(weight)
+		backends = append(backends, routeapi.RouteTargetReference{Kind: "Service", Name: name, Weight: &w})
+	}
+	return backends, nil
+}
@@ -42,6 +42,13 @@ func NewCmdSet(fullName string, f *clientcmd.Factory, in io.Reader, out, errout
 			Message: "Manage application flows:",
 			Commands: []*cobra.Command{
 				NewCmdTriggers(name, f, out, errout),
+				NewCmdBuildHook(name, f, out, errout),
+			},
+		},
+		{
+			Message: "Control load balancing:",
+			Commands: []*cobra.Command{
+				NewCmdRouteBackends(name, f, out, errout),
 			},
 		},
 	}
@@ -41,11 +41,13 @@ Start a build
 This command starts a new build for the provided build config or copies an existing build using
 --from-build=<name>. Pass the --follow flag to see output from the build.
 
-In addition, you can pass a file, directory, or source code repository with the --from-file,
---from-dir, or --from-repo flags directly to the build. The contents will be streamed to the build
-and override the current build source settings. When using --from-repo, the --commit flag can be
-used to control which branch, tag, or commit is sent to the server. If you pass --from-file, the
-file is placed in the root of an empty directory with the same filename. Note that builds
+In addition, you can pass a file, directory, archive, or source code repository with the
+--from-file, --from-dir, --from-archive, or --from-repo flags directly to the build. The contents
+will be streamed to the build and override the current build source settings. When using
+--from-repo, the --commit flag can be used to control which branch, tag, or commit is sent to the
+server. If you pass --from-file, the file is placed in the root of an empty directory with the same
+filename. An archive passed with --from-archive must be a zip, tar, or gzipped tar file and is
+extracted in the root of the build source, use '-' to read it from stdin. Note that builds
 triggered from binary input will not preserve the source on the server, so rebuilds triggered by
 base image changes will use the source specified on the build config.
 `
@@ -59,6 +61,9 @@ base image changes will use the source specified on the build config.
   # Use the contents of a directory as build input
   $ %[1]s start-build hello-world --from-dir=src/
 
+  # Use the contents of a gzipped tar archive as build input
+  $ %[1]s start-build hello-world --from-archive=hello-world.tar.gz
+
   # Send the contents of a Git repository to the server from tag 'v2'
   $ %[1]s start-build hello-world --from-repo=../hello-world --commit=v2
 
@@ -98,9 +103,10 @@ func NewCmdStartBuild(fullName string, f *clientcmd.Factory, in io.Reader, out i
 	cmd.Flags().String("from-file", "", "A file to use as the binary input for the build; example a pom.xml or Dockerfile. Will be the only file in the build source.")
 	cmd.Flags().String("from-dir", "", "A directory to archive and use as the binary input for a build.")
 	cmd.Flags().String("from-repo", "", "The path to a local source code repository to use as the binary input for a build.")
+	cmd.Flags().String("from-archive", "", "A zip, tar, or gzipped tar archive to use as the binary input for a build, or '-' to read it from stdin.")
 	cmd.Flags().String("commit", "", "Specify the source code commit identifier the build should use; requires a build based on a Git repository")
 
-	cmd.Flags().Var(&webhooks, "list-webhooks", "List the webhooks for the specified build config or build; accepts 'all', 'generic', or 'github'")
+	cmd.Flags().Var(&webhooks, "list-webhooks", "List the webhooks for the specified build config or build; accepts 'all', 'generic', 'github', 'gitlab', or 'bitbucket'")
 	cmd.Flags().String("from-webhook", "", "Specify a webhook URL for an existing build config to trigger")
 
 	cmd.Flags().String("git-post-receive", "", "The contents of the post-receive hook to trigger a build")
@@ -120,11 +126,12 @@ func RunStartBuild(f *clientcmd.Factory, in io.Reader, out io.Writer, cmd *cobra
 	fromFile := kcmdutil.GetFlagString(cmd, "from-file")
 	fromDir := kcmdutil.GetFlagString(cmd, "from-dir")
 	fromRepo := kcmdutil.GetFlagString(cmd, "from-repo")
+	fromArchive := kcmdutil.GetFlagString(cmd, "from-archive")
 	buildLogLevel := kcmdutil.GetFlagString(cmd, "build-loglevel")
 
 	switch {
 	case len(webhook) > 0:
-		if len(args) > 0 || len(buildName) > 0 || len(fromFile) > 0 || len(fromDir) > 0 || len(fromRepo) > 0 {
+		if len(args) > 0 || len(buildName) > 0 || len(fromFile) > 0 || len(fromDir) > 0 || len(fromRepo) > 0 || len(fromArchive) > 0 {
 			return kcmdutil.UsageError(cmd, "The '--from-webhook' flag is incompatible with arguments and all '--from-*' flags")
 		}
 		path := kcmdutil.GetFlagString(cmd, "git-repository")
@@ -200,7 +207,7 @@ func RunStartBuild(f *clientcmd.Factory, in io.Reader, out io.Writer, cmd *cobra
 
 	var newBuild *buildapi.Build
 	switch {
-	case len(args) > 0 && (len(fromFile) > 0 || len(fromDir) > 0 || len(fromRepo) > 0):
+	case len(args) > 0 && (len(fromFile) > 0 || len(fromDir) > 0 || len(fromRepo) > 0 || len(fromArchive) > 0):
 		request := &buildapi.BinaryBuildRequestOptions{
 			ObjectMeta: kapi.ObjectMeta{
 				Name:      name,
@@ -211,7 +218,7 @@ func RunStartBuild(f *clientcmd.Factory, in io.Reader, out io.Writer, cmd *cobra
 		if len(env) > 0 {
 			fmt.Fprintf(cmd.Out(), "WARNING: Specifying environment variables with binary builds is not supported.\n")
 		}
-		if newBuild, err = streamPathToBuild(git, in, cmd.Out(), client.BuildConfigs(namespace), fromDir, fromFile, fromRepo, request); err != nil {
+		if newBuild, err = streamPathToBuild(git, in, cmd.Out(), client.BuildConfigs(namespace), fromDir, fromFile, fromRepo, fromArchive, request); err != nil {
 			return err
 		}
 	case resource == "builds":
@@ -292,18 +299,22 @@ func RunStartBuild(f *clientcmd.Factory, in io.Reader, out io.Writer, cmd *cobra
 
 // RunListBuildWebHooks prints the webhooks for the provided build config.
 func RunListBuildWebHooks(f *clientcmd.Factory, out, errOut io.Writer, name, resource, webhookFilter string) error {
-	generic, github := false, false
+	generic, github, gitlab, bitbucket := false, false, false, false
 	prefix := false
 	switch webhookFilter {
 	case "all":
-		generic, github = true, true
+		generic, github, gitlab, bitbucket = true, true, true, true
 		prefix = true
 	case "generic":
 		generic = true
 	case "github":
 		github = true
+	case "gitlab":
+		gitlab = true
+	case "bitbucket":
+		bitbucket = true
 	default:
-		return fmt.Errorf("--list-webhooks must be 'all', 'generic', or 'github'")
+		return fmt.Errorf("--list-webhooks must be 'all', 'generic', 'github', 'gitlab', or 'bitbucket'")
 	}
 	client, _, err := f.Clients()
 	if err != nil {
@@ -350,6 +361,14 @@ func RunListBuildWebHooks(f *clientcmd.Factory, out, errOut io.Writer, name, res
 			if prefix {
 				hookType = "github "
 			}
+		case t.GitLabWebHook != nil && gitlab:
+			if prefix {
+				hookType = "gitlab "
+			}
+		case t.BitbucketWebHook != nil && bitbucket:
+			if prefix {
+				hookType = "bitbucket "
+			}
 		default:
 			continue
 		}
@@ -365,9 +384,9 @@ func RunListBuildWebHooks(f *clientcmd.Factory, out, errOut io.Writer, name, res
 	return nil
 }
 
-func streamPathToBuild(git git.Repository, in io.Reader, out io.Writer, client osclient.BuildConfigInterface, fromDir, fromFile, fromRepo string, options *buildapi.BinaryBuildRequestOptions) (*buildapi.Build, error) {
+func streamPathToBuild(git git.Repository, in io.Reader, out io.Writer, client osclient.BuildConfigInterface, fromDir, fromFile, fromRepo, fromArchive string, options *buildapi.BinaryBuildRequestOptions) (*buildapi.Build, error) {
 	count := 0
-	asDir, asFile, asRepo := len(fromDir) > 0, len(fromFile) > 0, len(fromRepo) > 0
+	asDir, asFile, asRepo, asArchive := len(fromDir) > 0, len(fromFile) > 0, len(fromRepo) > 0, len(fromArchive) > 0
 	if asDir {
 		count++
 	}
@@ -377,8 +396,11 @@ func streamPathToBuild(git git.Repository, in io.Reader, out io.Writer, client o
 	if asRepo {
 		count++
 	}
+	if asArchive {
+		count++
+	}
 	if count > 1 {
-		return nil, fmt.Errorf("only one of --from-file, --from-repo, or --from-dir may be specified")
+		return nil, fmt.Errorf("only one of --from-file, --from-repo, --from-dir, or --from-archive may be specified")
 	}
 
 	var r io.Reader
@@ -394,6 +416,23 @@ func streamPathToBuild(git git.Repository, in io.Reader, out io.Writer, client o
 		}
 		fmt.Fprintf(out, "Uploading archive file from STDIN as binary input for the build ...\n")
 
+	case asArchive:
+		source, name := in, "STDIN"
+		if fromArchive != "-" {
+			f, err := os.Open(fromArchive)
+			if err != nil {
+				return nil, err
+			}
+			defer f.Close()
+			source, name = f, fmt.Sprintf("%q", filepath.Clean(fromArchive))
+		}
+		br := bufio.NewReaderSize(source, 4096)
+		r = br
+		if !isArchive(br) {
+			return nil, fmt.Errorf("the file %s is not a zip, tar, or gzipped tar archive", name)
+		}
+		fmt.Fprintf(out, "Uploading archive file %s as binary input for the build ...\n", name)
+
 	default:
 		var fromPath string
 		switch {
@@ -488,10 +527,8 @@ func streamPathToBuild(git git.Repository, in io.Reader, out io.Writer, client o
 }
 
 func isArchive(r *bufio.Reader) bool {
-	data, err := r.Peek(280)
-	if err != nil {
-		return false
-	}
+	// archives smaller than the peeked size are still recognized by their prefix
+	data, _ := r.Peek(280)
 	for _, b := range [][]byte{
 		{0x50, 0x4B, 0x03, 0x04}, // zip
 		{0x1F, 0x9D},             // tar.z
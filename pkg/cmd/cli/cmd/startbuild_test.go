@@ -2,9 +2,11 @@ package cmd
 
 import (
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -16,6 +18,7 @@ import (
 	clientcmdapi "k8s.io/kubernetes/pkg/client/unversioned/clientcmd/api"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
+	osclient "github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 )
 
@@ -119,3 +122,87 @@ func TestStartBuildHookPostReceive(t *testing.T) {
 		t.Fatalf("unexpected ref: %#v", event.Git.Refs[0])
 	}
 }
+
+type binaryBuildConfigs struct {
+	osclient.BuildConfigInterface
+	body []byte
+}
+
+func (c *binaryBuildConfigs) InstantiateBinary(request *buildapi.BinaryBuildRequestOptions, r io.Reader) (*buildapi.Build, error) {
+	body, err := ioutil.ReadAll(r)
+	c.body = body
+	return &buildapi.Build{}, err
+}
+
+func TestStreamArchiveToBuild(t *testing.T) {
+	archive := &bytes.Buffer{}
+	w := gzip.NewWriter(archive)
+	w.Write([]byte("content"))
+	w.Close()
+
+	dir, err := ioutil.TempDir("", "start-build")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	archiveFile := dir + "/source.tar.gz"
+	if err := ioutil.WriteFile(archiveFile, archive.Bytes(), 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	textFile := dir + "/source.txt"
+	if err := ioutil.WriteFile(textFile, []byte("content"), 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := map[string]struct {
+		fromArchive string
+		fromDir     string
+		in          []byte
+		output      string
+		err         string
+	}{
+		"archive file": {
+			fromArchive: archiveFile,
+			output:      fmt.Sprintf("Uploading archive file %q", archiveFile),
+		},
+		"archive from stdin": {
+			fromArchive: "-",
+			in:          archive.Bytes(),
+			output:      "Uploading archive file STDIN",
+		},
+		"not an archive": {
+			fromArchive: textFile,
+			err:         "is not a zip, tar, or gzipped tar archive",
+		},
+		"missing archive": {
+			fromArchive: dir + "/missing.tar",
+			err:         "no such file or directory",
+		},
+		"archive and directory": {
+			fromArchive: archiveFile,
+			fromDir:     dir,
+			err:         "only one of",
+		},
+	}
+	for name, test := range tests {
+		client := &binaryBuildConfigs{}
+		out := &bytes.Buffer{}
+		_, err := streamPathToBuild(nil, bytes.NewReader(test.in), out, client, test.fromDir, "", "", test.fromArchive, &buildapi.BinaryBuildRequestOptions{})
+		if len(test.err) > 0 {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("%s: expected error %q, got %v", name, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !strings.Contains(out.String(), test.output) {
+			t.Errorf("%s: expected output %q, got %q", name, test.output, out.String())
+		}
+		if !bytes.Equal(client.body, archive.Bytes()) {
+			t.Errorf("%s: the archive was not uploaded unchanged", name)
+		}
+	}
+}
@@ -26,11 +26,13 @@ type TagOptions struct {
 	out      io.Writer
 	osClient client.Interface
 
-	deleteTag   bool
-	aliasTag    bool
-	scheduleTag bool
-	insecureTag bool
-	namespace   string
+	deleteTag       bool
+	aliasTag        bool
+	scheduleTag     bool
+	insecureTag     bool
+	referenceTag    bool
+	referencePolicy string
+	namespace       string
 
 	ref            imageapi.DockerImageReference
 	sourceKind     string
@@ -52,6 +54,14 @@ certificate, or is only served over HTTP. Pass --scheduled to have the server
 regularly check the tag for updates and import the latest version (which can
 then trigger builds and deployments). Note that --scheduled is only allowed for
 Docker images.
+
+Pass --reference to have the destination tag point directly at the source Docker
+image instead of importing its metadata. Clients pulling the tag will then pull
+from the source location.
+
+Pass --reference-policy=local to have builds and deployments triggered by the
+destination tag pull the image through the integrated registry instead of the
+source registry.
 `
 
 	tagExample = `  # Tag the current image for the image stream 'openshift/ruby' and tag '2.0' into the image stream 'yourproject/ruby with tag 'tip'.
@@ -63,6 +73,9 @@ Docker images.
   # Tag an external Docker image.
   $ %[1]s tag --source=docker openshift/origin:latest yourproject/ruby:tip
 
+  # Tag an external Docker image and have the destination tag reference it without importing.
+  $ %[1]s tag --reference docker.io/openshift/origin:latest yourproject/origin:latest
+
   # Remove the specified spec tag from an image stream.
   $ %[1]s tag openshift/origin:latest -d`
 )
@@ -88,6 +101,8 @@ func NewCmdTag(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Comm
 	cmd.Flags().BoolVar(&opts.aliasTag, "alias", false, "Should the destination tag be updated whenever the source tag changes. Defaults to false.")
 	cmd.Flags().BoolVar(&opts.scheduleTag, "scheduled", false, "Set a Docker image to be periodically imported from a remote repository.")
 	cmd.Flags().BoolVar(&opts.insecureTag, "insecure", false, "Set to true if importing the specified Docker image requires HTTP or has a self-signed certificate.")
+	cmd.Flags().StringVar(&opts.referencePolicy, "reference-policy", opts.referencePolicy, "How builds and deployments pull the tagged image: 'source' uses the original location and 'local' pulls through the integrated registry. Defaults to 'source'.")
+	cmd.Flags().BoolVar(&opts.referenceTag, "reference", false, "Should the destination tag point to the source image rather than importing it. Only allowed for Docker images.")
 
 	return cmd
 }
@@ -278,7 +293,7 @@ func (o TagOptions) Validate() error {
 		if len(o.ref.String()) > 0 {
 			return errors.New("cannot specify a source when deleting")
 		}
-		if o.scheduleTag || o.insecureTag {
+		if o.scheduleTag || o.insecureTag || o.referenceTag || len(o.referencePolicy) > 0 {
 			return errors.New("cannot set flags for importing images when deleting a tag")
 		}
 	} else {
@@ -303,6 +318,19 @@ func (o TagOptions) Validate() error {
 	if o.aliasTag && (o.scheduleTag || o.insecureTag) {
 		return errors.New("cannot set a Docker image tag as an alias and also set import flags")
 	}
+	switch strings.ToLower(o.referencePolicy) {
+	case "", "source", "local":
+	default:
+		return fmt.Errorf("invalid --reference-policy %q; valid values are 'source' and 'local'", o.referencePolicy)
+	}
+	if o.referenceTag {
+		if o.sourceKind != "DockerImage" {
+			return errors.New("only Docker images can be referenced by a tag")
+		}
+		if o.aliasTag || o.scheduleTag {
+			return errors.New("--reference may not be specified with --alias or --scheduled")
+		}
+	}
 
 	return nil
 }
@@ -359,6 +387,13 @@ func (o TagOptions) RunTag() error {
 
 				targetRef.ImportPolicy.Insecure = o.insecureTag
 				targetRef.ImportPolicy.Scheduled = o.scheduleTag
+				targetRef.Reference = o.referenceTag
+				switch strings.ToLower(o.referencePolicy) {
+				case "source":
+					targetRef.ReferencePolicy.Type = imageapi.SourceTagReferencePolicy
+				case "local":
+					targetRef.ReferencePolicy.Type = imageapi.LocalTagReferencePolicy
+				}
 				targetRef.From = &kapi.ObjectReference{
 					Kind: o.sourceKind,
 				}
@@ -132,3 +132,66 @@ func TestRunTag_Delete(t *testing.T) {
 		}
 	}
 }
+
+func TestTagValidate_ReferencePolicy(t *testing.T) {
+	tests := map[string]bool{
+		"":       false,
+		"source": false,
+		"Local":  false,
+		"remote": true,
+	}
+
+	for policy, expectErr := range tests {
+		opts := TagOptions{
+			out:             os.Stdout,
+			osClient:        testclient.NewSimpleFake(),
+			referencePolicy: policy,
+			sourceKind:      "DockerImage",
+			ref:             imageapi.DockerImageReference{Registry: "docker.io", Namespace: "openshift", Name: "origin", Tag: "latest"},
+			destNamespace:   []string{"yourproject"},
+			destNameAndTag:  []string{"origin:latest"},
+		}
+		err := opts.Validate()
+		if expectErr != (err != nil) {
+			t.Errorf("%q: unexpected error: %v", policy, err)
+		}
+	}
+}
+
+func TestTagValidate_Reference(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      TagOptions
+		expectErr bool
+	}{
+		{
+			name: "docker image",
+			opts: TagOptions{sourceKind: "DockerImage"},
+		},
+		{
+			name:      "image stream tag",
+			opts:      TagOptions{sourceKind: "ImageStreamTag"},
+			expectErr: true,
+		},
+		{
+			name:      "scheduled",
+			opts:      TagOptions{sourceKind: "DockerImage", scheduleTag: true},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		opts := test.opts
+		opts.out = os.Stdout
+		opts.osClient = testclient.NewSimpleFake()
+		opts.referenceTag = true
+		opts.ref = imageapi.DockerImageReference{Registry: "docker.io", Namespace: "openshift", Name: "origin", Tag: "latest"}
+		opts.destNamespace = []string{"yourproject"}
+		opts.destNameAndTag = []string{"origin:latest"}
+
+		err := opts.Validate()
+		if test.expectErr != (err != nil) {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+	}
+}
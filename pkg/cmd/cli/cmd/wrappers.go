@@ -8,14 +8,19 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	kapi "k8s.io/kubernetes/pkg/api"
 	kcmd "k8s.io/kubernetes/pkg/kubectl/cmd"
 	"k8s.io/kubernetes/pkg/kubectl/cmd/config"
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/runtime"
 	kvalidation "k8s.io/kubernetes/pkg/util/validation"
 
 	cmdconfig "github.com/openshift/origin/pkg/cmd/cli/config"
 	"github.com/openshift/origin/pkg/cmd/cli/describe"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+	"github.com/openshift/origin/pkg/template/library"
 )
 
 func tab(original string) string {
@@ -47,7 +52,10 @@ If you want an even more detailed view, use '%[1]s describe'.`
   $ %[1]s get -o json pod redis-pod
 
   # Return only the status value of the specified pod.
-  $ %[1]s get -o template pod redis-pod --template={{.currentState.status}}`
+  $ %[1]s get -o template pod redis-pod --template={{.currentState.status}}
+
+  # List the templates related to databases.
+  $ %[1]s get templates --search=database`
 )
 
 // NewCmdGet is a wrapper for the Kubernetes cli get command
@@ -56,9 +64,52 @@ func NewCmdGet(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Comm
 	cmd.Long = fmt.Sprintf(getLong, fullName)
 	cmd.Example = fmt.Sprintf(getExample, fullName)
 	cmd.SuggestFor = []string{"list"}
+	cmd.Flags().String("search", "", "Only list the templates whose name, description, tags or categories contain this term.")
+
+	run := cmd.Run
+	cmd.Run = func(c *cobra.Command, args []string) {
+		if term := kcmdutil.GetFlagString(c, "search"); len(term) > 0 {
+			if kcmdutil.GetFlagBool(c, "all-namespaces") {
+				kcmdutil.CheckErr(kcmdutil.UsageError(c, "--search cannot be combined with --all-namespaces"))
+			}
+			names, err := searchTemplates(f, args, term)
+			kcmdutil.CheckErr(err)
+			if len(names) == 0 {
+				return
+			}
+			args = append([]string{"templates"}, names...)
+		}
+		run(c, args)
+	}
 	return cmd
 }
 
+// searchTemplates returns the names of the templates of the current project
+// matching term. The only argument allowed with a search is the templates
+// resource.
+func searchTemplates(f *clientcmd.Factory, args []string, term string) ([]string, error) {
+	if len(args) != 1 || (args[0] != "template" && args[0] != "templates") {
+		return nil, fmt.Errorf("--search can only be used to list templates")
+	}
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return nil, err
+	}
+	client, _, err := f.Clients()
+	if err != nil {
+		return nil, err
+	}
+	templates, err := client.Templates(namespace).List(kapi.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, template := range library.Search(templates.Items, strings.Fields(term)...) {
+		names = append(names, template.Name)
+	}
+	return names, nil
+}
+
 const (
 	replaceLong = `Replace a resource by filename or stdin
 
@@ -124,7 +175,10 @@ will be lost along with the rest of the resource.`
   $ %[1]s delete pod 1234-56-7890-234234-456456
 
   # Delete all pods
-  $ %[1]s delete pods --all`
+  $ %[1]s delete pods --all
+
+  # Delete a template instance and all the objects created by that instantiation
+  $ %[1]s delete templateinstance ruby-helloworld-sample-x7k2p`
 )
 
 // NewCmdDelete is a wrapper for the Kubernetes cli delete command
@@ -284,7 +338,10 @@ const (
 
 Looks up a deployment config or replication controller by name and creates an autoscaler that uses
 this deployment config or replication controller as a reference. An autoscaler can automatically
-increase or decrease number of pods deployed within the system as needed.`
+increase or decrease number of pods deployed within the system as needed.
+
+Replication controllers created by a deployment config are scaled by the deployment config, so
+autoscale the deployment config instead.`
 
 	autoScaleExample = `  # Auto scale a deployment config "foo", with the number of pods between 2 to 10, target CPU utilization at a default value that server applies:
   $ %[1]s autoscale dc/foo --min=2 --max=10
@@ -299,9 +356,58 @@ func NewCmdAutoscale(fullName string, f *clientcmd.Factory, out io.Writer) *cobr
 	cmd.Short = "Autoscale a deployment config or replication controller"
 	cmd.Long = autoScaleLong
 	cmd.Example = fmt.Sprintf(autoScaleExample, fullName)
+	cmd.ValidArgs = []string{"deploymentconfig", "replicationcontroller"}
+
+	run := cmd.Run
+	cmd.Run = func(c *cobra.Command, args []string) {
+		kcmdutil.CheckErr(validateAutoscaleTargets(f, c, fullName, args))
+		run(c, args)
+	}
 	return cmd
 }
 
+// validateAutoscaleTargets rejects replication controllers which belong to a
+// deployment config. Their replica count is managed by the deployment config,
+// so an autoscaler targeting them would compete with the deployment process.
+func validateAutoscaleTargets(f *clientcmd.Factory, c *cobra.Command, fullName string, args []string) error {
+	namespace, enforceNamespace, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	mapper, typer := f.Object()
+	infos, err := resource.NewBuilder(mapper, typer, resource.ClientMapperFunc(f.ClientForMapping), kapi.Codecs.UniversalDecoder()).
+		ContinueOnError().
+		NamespaceParam(namespace).DefaultNamespace().
+		FilenameParam(enforceNamespace, kcmdutil.GetFlagStringSlice(c, "filename")...).
+		ResourceTypeOrNameArgs(false, args...).
+		Flatten().
+		Do().
+		Infos()
+	if err != nil {
+		return err
+	}
+	for _, info := range infos {
+		if err := autoscaleTargetAllowed(info.Object, fullName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// autoscaleTargetAllowed returns an error if obj is a deployment of a
+// deployment config.
+func autoscaleTargetAllowed(obj runtime.Object, fullName string) error {
+	rc, ok := obj.(*kapi.ReplicationController)
+	if !ok {
+		return nil
+	}
+	config := deployutil.DeploymentConfigNameFor(rc)
+	if len(config) == 0 {
+		return nil
+	}
+	return fmt.Errorf("replication controller %q is managed by deployment config %q and cannot be autoscaled directly.\nYou can autoscale the deployment config with: %s autoscale dc/%s", rc.Name, config, fullName, config)
+}
+
 const (
 	runLong = `Create and run a particular image, possibly replicated
 
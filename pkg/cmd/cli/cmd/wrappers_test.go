@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+)
+
+func TestAutoscaleTargetAllowed(t *testing.T) {
+	tests := map[string]struct {
+		obj runtime.Object
+		err bool
+	}{
+		"deployment config": {
+			obj: &deployapi.DeploymentConfig{ObjectMeta: kapi.ObjectMeta{Name: "frontend"}},
+		},
+		"replication controller": {
+			obj: &kapi.ReplicationController{ObjectMeta: kapi.ObjectMeta{Name: "frontend"}},
+		},
+		"deployment": {
+			obj: &kapi.ReplicationController{ObjectMeta: kapi.ObjectMeta{
+				Name:        "frontend-1",
+				Annotations: map[string]string{deployapi.DeploymentConfigAnnotation: "frontend"},
+			}},
+			err: true,
+		},
+	}
+	for name, test := range tests {
+		err := autoscaleTargetAllowed(test.obj, "oc")
+		if test.err && err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+		if !test.err && err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+	}
+}
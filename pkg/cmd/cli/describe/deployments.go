@@ -133,6 +133,12 @@ func (d *DeploymentConfigDescriber) Describe(namespace, name string) (string, er
 		} else {
 			formatString(out, "Latest Version", strconv.Itoa(deploymentConfig.Status.LatestVersion))
 		}
+		if deploymentConfig.Spec.Paused {
+			formatString(out, "Paused", "yes (new deployments are deferred until the config is resumed)")
+		}
+		if deploymentConfig.Spec.ProgressDeadlineSeconds != nil {
+			formatString(out, "Progress Deadline", fmt.Sprintf("%ds", *deploymentConfig.Spec.ProgressDeadlineSeconds))
+		}
 
 		printTriggers(deploymentConfig.Spec.Triggers, out)
 
@@ -142,6 +148,9 @@ func (d *DeploymentConfigDescriber) Describe(namespace, name string) (string, er
 		if deploymentConfig.Status.Details != nil && len(deploymentConfig.Status.Details.Message) > 0 {
 			fmt.Fprintf(out, "Warning:\t%s\n", deploymentConfig.Status.Details.Message)
 		}
+		if len(deploymentConfig.Status.Conditions) > 0 {
+			printDeploymentConfigConditions(deploymentConfig.Status.Conditions, out)
+		}
 		deploymentName := deployutil.LatestDeploymentNameForConfig(deploymentConfig)
 		deployment, err := d.client.getDeployment(namespace, deploymentName)
 		if err != nil {
@@ -174,6 +183,15 @@ func (d *DeploymentConfigDescriber) Describe(namespace, name string) (string, er
 	})
 }
 
+func printDeploymentConfigConditions(conditions []deployapi.DeploymentCondition, w *tabwriter.Writer) {
+	fmt.Fprint(w, "Conditions:\n")
+	fmt.Fprint(w, "  Type\tStatus\tReason\tMessage\n")
+	fmt.Fprint(w, "  ----\t------\t------\t-------\n")
+	for _, c := range conditions {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", c.Type, c.Status, c.Reason, c.Message)
+	}
+}
+
 func printStrategy(strategy deployapi.DeploymentStrategy, w *tabwriter.Writer) {
 	switch strategy.Type {
 	case deployapi.DeploymentStrategyTypeRecreate:
@@ -202,6 +220,25 @@ func printStrategy(strategy deployapi.DeploymentStrategy, w *tabwriter.Writer) {
 				printHook("Post-deployment", post, w)
 			}
 		}
+	case deployapi.DeploymentStrategyTypeCanary:
+		if strategy.CanaryParams != nil {
+			fmt.Fprintf(w, "\t  Canary Size:\t%s\n", strategy.CanaryParams.Size.String())
+			if strategy.CanaryParams.VerificationSeconds != nil {
+				fmt.Fprintf(w, "\t  Verification Window:\t%ds\n", *strategy.CanaryParams.VerificationSeconds)
+			}
+			pre := strategy.CanaryParams.Pre
+			verify := strategy.CanaryParams.Verify
+			post := strategy.CanaryParams.Post
+			if pre != nil {
+				printHook("Pre-deployment", pre, w)
+			}
+			if verify != nil {
+				printHook("Verification", verify, w)
+			}
+			if post != nil {
+				printHook("Post-deployment", post, w)
+			}
+		}
 	case deployapi.DeploymentStrategyTypeCustom:
 		fmt.Fprintf(w, "\t  Image:\t%s\n", strategy.CustomParams.Image)
 
@@ -219,6 +256,9 @@ func printHook(prefix string, hook *deployapi.LifecycleHook, w io.Writer) {
 	if hook.ExecNewPod != nil {
 		fmt.Fprintf(w, "\t  %s hook (pod type, failure policy: %s):\n", prefix, hook.FailurePolicy)
 		fmt.Fprintf(w, "\t    Container:\t%s\n", hook.ExecNewPod.ContainerName)
+		if len(hook.ExecNewPod.Image) > 0 {
+			fmt.Fprintf(w, "\t    Image:\t%s\n", hook.ExecNewPod.Image)
+		}
 		fmt.Fprintf(w, "\t    Command:\t%v\n", strings.Join(hook.ExecNewPod.Command, " "))
 		fmt.Fprintf(w, "\t    Env:\t%s\n", formatLabels(convertEnv(hook.ExecNewPod.Env)))
 	}
@@ -235,6 +275,10 @@ func printTriggers(triggers []deployapi.DeploymentTriggerPolicy, w *tabwriter.Wr
 	for _, t := range triggers {
 		switch t.Type {
 		case deployapi.DeploymentTriggerOnConfigChange:
+			if t.ConfigChangeParams != nil && t.ConfigChangeParams.DebounceSeconds > 0 {
+				labels = append(labels, fmt.Sprintf("Config(debounce=%ds)", t.ConfigChangeParams.DebounceSeconds))
+				continue
+			}
 			labels = append(labels, "Config")
 		case deployapi.DeploymentTriggerOnImageChange:
 			if len(t.ImageChangeParams.From.Name) > 0 {
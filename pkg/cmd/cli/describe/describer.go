@@ -47,6 +47,8 @@ func describerMap(c *client.Client, kclient kclient.Interface, host string) map[
 		routeapi.Kind("Route"):                        &RouteDescriber{c, kclient},
 		projectapi.Kind("Project"):                    &ProjectDescriber{c, kclient},
 		templateapi.Kind("Template"):                  &TemplateDescriber{c, meta.NewAccessor(), kapi.Scheme, nil},
+		templateapi.Kind("TemplateInstance"):          &TemplateInstanceDescriber{c},
+		templateapi.Kind("TemplateRepository"):        &TemplateRepositoryDescriber{c},
 		authorizationapi.Kind("Policy"):               &PolicyDescriber{c},
 		authorizationapi.Kind("PolicyBinding"):        &PolicyBindingDescriber{c},
 		authorizationapi.Kind("RoleBinding"):          &RoleBindingDescriber{c},
@@ -271,6 +273,10 @@ func describeBuildSpec(p buildapi.BuildSpec, out *tabwriter.Writer) {
 		}
 	}
 
+	if len(p.NodeSelector) > 0 {
+		formatString(out, "Node Selector", formatLabels(p.NodeSelector))
+	}
+
 	if p.CompletionDeadlineSeconds != nil {
 		formatString(out, "Fail Build After", time.Duration(*p.CompletionDeadlineSeconds)*time.Second)
 	}
@@ -315,6 +321,9 @@ func describeSourceStrategy(s *buildapi.SourceBuildStrategy, out *tabwriter.Writ
 	if s.Incremental {
 		formatString(out, "Incremental Build", "yes")
 	}
+	if s.ArtifactCache != nil {
+		formatString(out, "Artifact Cache Claim", s.ArtifactCache.Name)
+	}
 	if s.ForcePull {
 		formatString(out, "Force Pull", "yes")
 	}
@@ -380,7 +389,7 @@ func describeBuildTriggers(triggers []buildapi.BuildTriggerPolicy, w *tabwriter.
 
 	for _, t := range triggers {
 		switch t.Type {
-		case buildapi.GitHubWebHookBuildTriggerType, buildapi.GenericWebHookBuildTriggerType:
+		case buildapi.GitHubWebHookBuildTriggerType, buildapi.GenericWebHookBuildTriggerType, buildapi.GitLabWebHookBuildTriggerType, buildapi.BitbucketWebHookBuildTriggerType:
 			continue
 		case buildapi.ConfigChangeBuildTriggerType:
 			labels = append(labels, "Config")
@@ -423,6 +432,15 @@ func (d *BuildConfigDescriber) Describe(namespace, name string) (string, error)
 		}
 		describeBuildSpec(buildConfig.Spec.BuildSpec, out)
 		d.DescribeTriggers(buildConfig, out)
+		if limit := buildConfig.Spec.SuccessfulBuildsHistoryLimit; limit != nil {
+			formatString(out, "Successful Builds History Limit", strconv.Itoa(*limit))
+		}
+		if limit := buildConfig.Spec.FailedBuildsHistoryLimit; limit != nil {
+			formatString(out, "Failed Builds History Limit", strconv.Itoa(*limit))
+		}
+		if len(buildConfig.Spec.RunPolicy) > 0 {
+			formatString(out, "Run Policy", buildConfig.Spec.RunPolicy)
+		}
 		if len(buildList.Items) == 0 {
 			return nil
 		}
@@ -668,11 +686,21 @@ func (d *RouteDescriber) Describe(namespace, name string) (string, error) {
 		formatString(out, "Insecure Policy", insecurePolicy)
 
 		formatString(out, "Service", route.Spec.To.Name)
+		if len(route.Spec.AlternateBackends) > 0 {
+			formatString(out, "Backends", strings.Join(routeBackendShares(route), ", "))
+		}
 		if route.Spec.Port != nil {
 			formatString(out, "Endpoint Port", route.Spec.Port.TargetPort.String())
 		} else {
 			formatString(out, "Endpoint Port", "<all endpoint ports>")
 		}
+		if check := route.Spec.HealthCheck; check != nil && len(check.Path) > 0 {
+			interval := check.IntervalSeconds
+			if interval == 0 {
+				interval = 5
+			}
+			formatString(out, "Health Check", fmt.Sprintf("GET %s every %ds", check.Path, interval))
+		}
 
 		ends := "<none>"
 		if endsErr != nil {
@@ -844,6 +872,9 @@ func (d *TemplateDescriber) DescribeParameters(params []templateapi.Parameter, o
 			formatString(out, indent+"Description", p.Description)
 		}
 		formatString(out, indent+"Required", p.Required)
+		if p.Sensitive {
+			formatString(out, indent+"Sensitive", p.Sensitive)
+		}
 		if len(p.Generate) == 0 {
 			formatString(out, indent+"Value", p.Value)
 			continue
@@ -910,6 +941,12 @@ func (d *TemplateDescriber) DescribeTemplate(template *templateapi.Template) (st
 		d.DescribeParameters(template.Parameters, out)
 		out.Write([]byte("\n"))
 		formatString(out, "Object Labels", formatLabels(template.ObjectLabels))
+		if len(template.ObjectAnnotations) > 0 {
+			formatString(out, "Object Annotations", formatLabels(template.ObjectAnnotations))
+		}
+		if len(template.Message) > 0 {
+			formatString(out, "Message", template.Message)
+		}
 		out.Write([]byte("\n"))
 		out.Flush()
 		d.describeObjects(template.Objects, out)
@@ -917,6 +954,67 @@ func (d *TemplateDescriber) DescribeTemplate(template *templateapi.Template) (st
 	})
 }
 
+// TemplateInstanceDescriber generates information about a template instance
+type TemplateInstanceDescriber struct {
+	client.Interface
+}
+
+// Describe returns the description of a template instance
+func (d *TemplateInstanceDescriber) Describe(namespace, name string) (string, error) {
+	instance, err := d.TemplateInstances(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	return tabbedString(func(out *tabwriter.Writer) error {
+		formatMeta(out, instance.ObjectMeta)
+		formatString(out, "Template", instance.Template)
+		if instance.DeletionTimestamp != nil {
+			formatString(out, "Deleting", fmt.Sprintf("since %s", instance.DeletionTimestamp))
+		}
+		out.Write([]byte("\n"))
+		out.Flush()
+		formatString(out, "Objects", " ")
+		indent := "    "
+		for _, ref := range instance.Objects {
+			fmt.Fprintf(out, "%s%s\t%s\n", indent, ref.Kind, ref.Name)
+		}
+		return nil
+	})
+}
+
+// TemplateRepositoryDescriber generates information about a template repository
+type TemplateRepositoryDescriber struct {
+	client.Interface
+}
+
+// Describe returns the description of a template repository
+func (d *TemplateRepositoryDescriber) Describe(namespace, name string) (string, error) {
+	repository, err := d.TemplateRepositories(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	return tabbedString(func(out *tabwriter.Writer) error {
+		formatMeta(out, repository.ObjectMeta)
+		if len(repository.Spec.Git) > 0 {
+			formatString(out, "Git Repository", repository.Spec.Git)
+		}
+		if len(repository.Spec.Index) > 0 {
+			formatString(out, "Index", repository.Spec.Index)
+		}
+		formatString(out, "Prune", repository.Spec.Prune)
+		if repository.Status.LastSyncTime != nil {
+			formatString(out, "Last Sync", repository.Status.LastSyncTime)
+		}
+		if len(repository.Status.Message) > 0 {
+			formatString(out, "Message", repository.Status.Message)
+		}
+		formatString(out, "Templates", strings.Join(repository.Status.Templates, ", "))
+		return nil
+	})
+}
+
 // IdentityDescriber generates information about a user
 type IdentityDescriber struct {
 	client.Interface
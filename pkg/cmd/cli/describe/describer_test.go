@@ -52,6 +52,7 @@ var DescriberCoverageExceptions = []reflect.Type{
 	reflect.TypeOf(&deployapi.DeploymentConfigRollback{}),             // normal users don't ever look at these
 	reflect.TypeOf(&deployapi.DeploymentLog{}),                        // normal users don't ever look at these
 	reflect.TypeOf(&deployapi.DeploymentLogOptions{}),                 // normal users don't ever look at these
+	reflect.TypeOf(&deployapi.DeploymentRequest{}),                    // normal users don't ever look at these
 	reflect.TypeOf(&imageapi.DockerImage{}),                           // not a top level resource
 	reflect.TypeOf(&imageapi.ImageStreamImport{}),                     // normal users don't ever look at these
 	reflect.TypeOf(&oauthapi.OAuthAccessToken{}),                      // normal users don't ever look at these
@@ -219,6 +220,17 @@ func TestDeploymentConfigDescriber(t *testing.T) {
 		},
 	}
 	describe()
+
+	deadline := int64(600)
+	config.Spec.ProgressDeadlineSeconds = &deadline
+	config.Status.Conditions = []deployapi.DeploymentCondition{
+		*deployutil.NewDeploymentCondition(deployapi.DeploymentProgressing, kapi.ConditionFalse, deployapi.TimedOutReason, `Replication controller "config-1" has timed out progressing.`),
+	}
+	if output, err := d.Describe("test", "deployment"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if !strings.Contains(output, "Progress Deadline:\t600s") || !strings.Contains(output, deployapi.TimedOutReason) {
+		t.Errorf("expected the progress deadline and conditions in the output:\n%s", output)
+	}
 }
 
 func TestDescribeBuildDuration(t *testing.T) {
@@ -146,6 +146,10 @@ func webhookURL(c *buildapi.BuildConfig, cli client.BuildConfigsNamespacer) map[
 			whTrigger = trigger.GitHubWebHook.Secret
 		case buildapi.GenericWebHookBuildTriggerType:
 			whTrigger = trigger.GenericWebHook.Secret
+		case buildapi.GitLabWebHookBuildTriggerType:
+			whTrigger = trigger.GitLabWebHook.Secret
+		case buildapi.BitbucketWebHookBuildTriggerType:
+			whTrigger = trigger.BitbucketWebHook.Secret
 		}
 		if len(whTrigger) == 0 {
 			continue
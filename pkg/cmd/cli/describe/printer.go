@@ -28,21 +28,23 @@ import (
 )
 
 var (
-	buildColumns            = []string{"NAME", "TYPE", "FROM", "STATUS", "STARTED", "DURATION"}
-	buildConfigColumns      = []string{"NAME", "TYPE", "FROM", "LATEST"}
-	imageColumns            = []string{"NAME", "DOCKER REF"}
-	imageStreamTagColumns   = []string{"NAME", "DOCKER REF", "UPDATED", "IMAGENAME"}
-	imageStreamImageColumns = []string{"NAME", "DOCKER REF", "UPDATED", "IMAGENAME"}
-	imageStreamColumns      = []string{"NAME", "DOCKER REPO", "TAGS", "UPDATED"}
-	projectColumns          = []string{"NAME", "DISPLAY NAME", "STATUS"}
-	routeColumns            = []string{"NAME", "HOST/PORT", "PATH", "SERVICE", "TERMINATION", "LABELS"}
-	deploymentColumns       = []string{"NAME", "STATUS", "CAUSE"}
-	deploymentConfigColumns = []string{"NAME", "REVISION", "REPLICAS", "TRIGGERED BY"}
-	templateColumns         = []string{"NAME", "DESCRIPTION", "PARAMETERS", "OBJECTS"}
-	policyColumns           = []string{"NAME", "ROLES", "LAST MODIFIED"}
-	policyBindingColumns    = []string{"NAME", "ROLE BINDINGS", "LAST MODIFIED"}
-	roleBindingColumns      = []string{"NAME", "ROLE", "USERS", "GROUPS", "SERVICE ACCOUNTS", "SUBJECTS"}
-	roleColumns             = []string{"NAME"}
+	buildColumns              = []string{"NAME", "TYPE", "FROM", "STATUS", "STARTED", "DURATION"}
+	buildConfigColumns        = []string{"NAME", "TYPE", "FROM", "LATEST"}
+	imageColumns              = []string{"NAME", "DOCKER REF"}
+	imageStreamTagColumns     = []string{"NAME", "DOCKER REF", "UPDATED", "IMAGENAME"}
+	imageStreamImageColumns   = []string{"NAME", "DOCKER REF", "UPDATED", "IMAGENAME"}
+	imageStreamColumns        = []string{"NAME", "DOCKER REPO", "TAGS", "UPDATED"}
+	projectColumns            = []string{"NAME", "DISPLAY NAME", "STATUS"}
+	routeColumns              = []string{"NAME", "HOST/PORT", "PATH", "SERVICE", "TERMINATION", "LABELS"}
+	deploymentColumns         = []string{"NAME", "STATUS", "CAUSE"}
+	deploymentConfigColumns   = []string{"NAME", "REVISION", "REPLICAS", "TRIGGERED BY"}
+	templateColumns           = []string{"NAME", "DESCRIPTION", "PARAMETERS", "OBJECTS"}
+	templateInstanceColumns   = []string{"NAME", "TEMPLATE", "OBJECTS", "AGE"}
+	templateRepositoryColumns = []string{"NAME", "SOURCE", "TEMPLATES", "LAST SYNC"}
+	policyColumns             = []string{"NAME", "ROLES", "LAST MODIFIED"}
+	policyBindingColumns      = []string{"NAME", "ROLE BINDINGS", "LAST MODIFIED"}
+	roleBindingColumns        = []string{"NAME", "ROLE", "USERS", "GROUPS", "SERVICE ACCOUNTS", "SUBJECTS"}
+	roleColumns               = []string{"NAME"}
 
 	oauthClientColumns              = []string{"NAME", "SECRET", "WWW-CHALLENGE", "REDIRECT URIS"}
 	oauthClientAuthorizationColumns = []string{"NAME", "USER NAME", "CLIENT NAME", "SCOPES"}
@@ -85,6 +87,10 @@ func NewHumanReadablePrinter(noHeaders, withNamespace, wide bool, showAll bool,
 	p.Handler(deploymentConfigColumns, printDeploymentConfigList)
 	p.Handler(templateColumns, printTemplate)
 	p.Handler(templateColumns, printTemplateList)
+	p.Handler(templateInstanceColumns, printTemplateInstance)
+	p.Handler(templateInstanceColumns, printTemplateInstanceList)
+	p.Handler(templateRepositoryColumns, printTemplateRepository)
+	p.Handler(templateRepositoryColumns, printTemplateRepositoryList)
 
 	p.Handler(policyColumns, printPolicy)
 	p.Handler(policyColumns, printPolicyList)
@@ -200,6 +206,52 @@ func printTemplateList(list *templateapi.TemplateList, w io.Writer, opts kctl.Pr
 	return nil
 }
 
+func printTemplateInstance(instance *templateapi.TemplateInstance, w io.Writer, opts kctl.PrintOptions) error {
+	if opts.WithNamespace {
+		if _, err := fmt.Fprintf(w, "%s\t", instance.Namespace); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", instance.Name, instance.Template, len(instance.Objects), formatRelativeTime(instance.CreationTimestamp.Time))
+	return err
+}
+
+func printTemplateInstanceList(list *templateapi.TemplateInstanceList, w io.Writer, opts kctl.PrintOptions) error {
+	for _, instance := range list.Items {
+		if err := printTemplateInstance(&instance, w, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printTemplateRepository(repository *templateapi.TemplateRepository, w io.Writer, opts kctl.PrintOptions) error {
+	if opts.WithNamespace {
+		if _, err := fmt.Fprintf(w, "%s\t", repository.Namespace); err != nil {
+			return err
+		}
+	}
+	source := repository.Spec.Git
+	if len(source) == 0 {
+		source = repository.Spec.Index
+	}
+	lastSync := "<none>"
+	if repository.Status.LastSyncTime != nil {
+		lastSync = formatRelativeTime(repository.Status.LastSyncTime.Time)
+	}
+	_, err := fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", repository.Name, source, len(repository.Status.Templates), lastSync)
+	return err
+}
+
+func printTemplateRepositoryList(list *templateapi.TemplateRepositoryList, w io.Writer, opts kctl.PrintOptions) error {
+	for _, repository := range list.Items {
+		if err := printTemplateRepository(&repository, w, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func printBuild(build *buildapi.Build, w io.Writer, opts kctl.PrintOptions) error {
 	if opts.WithNamespace {
 		if _, err := fmt.Fprintf(w, "%s\t", build.Namespace); err != nil {
@@ -484,6 +536,9 @@ func printRoute(route *routeapi.Route, w io.Writer, opts kctl.PrintOptions) erro
 		policy = ""
 	}
 	svc := route.Spec.To.Name
+	if len(route.Spec.AlternateBackends) > 0 {
+		svc = strings.Join(routeBackendShares(route), ",")
+	}
 	if route.Spec.Port != nil {
 		svc = fmt.Sprintf("%s:%s", svc, route.Spec.Port.TargetPort.String())
 	}
@@ -492,6 +547,25 @@ func printRoute(route *routeapi.Route, w io.Writer, opts kctl.PrintOptions) erro
 	return err
 }
 
+// routeBackendShares returns the services of route along with the
+// percentage of the requests each of them receives.
+func routeBackendShares(route *routeapi.Route) []string {
+	backends := routeapi.RouteBackends(route)
+	total := int32(0)
+	for i := range backends {
+		total += routeapi.BackendWeight(&backends[i])
+	}
+	shares := []string{}
+	for i := range backends {
+		share := int32(0)
+		if total > 0 {
+			share = routeapi.BackendWeight(&backends[i]) * 100 / total
+		}
+		shares = append(shares, fmt.Sprintf("%s(%d%%)", backends[i].Name, share))
+	}
+	return shares
+}
+
 func printRouteList(routeList *routeapi.RouteList, w io.Writer, opts kctl.PrintOptions) error {
 	for _, route := range routeList.Items {
 		if err := printRoute(&route, w, opts); err != nil {
@@ -725,7 +799,10 @@ func printOAuthClientAuthorizationList(list *oauthapi.OAuthClientAuthorizationLi
 
 func printOAuthAccessToken(token *oauthapi.OAuthAccessToken, w io.Writer, opts kctl.PrintOptions) error {
 	created := token.CreationTimestamp
-	expires := created.Add(time.Duration(token.ExpiresIn) * time.Second)
+	expires := "<never>"
+	if token.ExpiresIn != oauthapi.AccessTokenNoExpiry {
+		expires = created.Add(time.Duration(token.ExpiresIn) * time.Second).String()
+	}
 	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", token.Name, token.UserName, token.ClientName, created, expires, token.RedirectURI, strings.Join(token.Scopes, ","))
 	return err
 }
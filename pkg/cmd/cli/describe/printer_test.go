@@ -19,6 +19,7 @@ import (
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	imageapi "github.com/openshift/origin/pkg/image/api"
 	projectapi "github.com/openshift/origin/pkg/project/api"
+	routeapi "github.com/openshift/origin/pkg/route/api"
 )
 
 // PrinterCoverageExceptions is the list of API types that do NOT have corresponding printers
@@ -43,6 +44,7 @@ var PrinterCoverageExceptions = []reflect.Type{
 	reflect.TypeOf(&buildapi.BinaryBuildRequestOptions{}),
 	reflect.TypeOf(&buildapi.BuildRequest{}),
 	reflect.TypeOf(&buildapi.BuildLogOptions{}),
+	reflect.TypeOf(&deployapi.DeploymentRequest{}),
 }
 
 // MissingPrinterCoverageExceptions is the list of types that were missing printer methods when I started
@@ -234,3 +236,25 @@ func mockStreams() []*imageapi.ImageStream {
 		},
 	}
 }
+
+func TestPrintRouteBackends(t *testing.T) {
+	weight := func(w int32) *int32 { return &w }
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Name: "frontend"},
+		Spec: routeapi.RouteSpec{
+			Host: "www.example.com",
+			To:   routeapi.RouteTargetReference{Kind: "Service", Name: "blue", Weight: weight(60)},
+			AlternateBackends: []routeapi.RouteTargetReference{
+				{Kind: "Service", Name: "green", Weight: weight(20)},
+				{Kind: "Service", Name: "red", Weight: weight(20)},
+			},
+		},
+	}
+	out := &bytes.Buffer{}
+	if err := printRoute(route, out, kctl.PrintOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out.String(), "blue(60%),green(20%),red(20%)") {
+		t.Errorf("expected the share of each backend to be printed, got: %s", out.String())
+	}
+}
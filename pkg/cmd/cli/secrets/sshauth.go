@@ -32,6 +32,9 @@ provide this information by creating a 'sshauth' secret and attaching it to your
   // If your SSH authentication method requires also CA certificate, add it by using:
   $ %[1]s SECRET --ssh-privatekey=FILENAME --ca-cert=FILENAME
 
+  // If the host keys of the SCM server must be verified, add the known hosts by using:
+  $ %[1]s SECRET --ssh-privatekey=FILENAME --known-hosts=FILENAME
+
   // If you do already have a .gitconfig file needed for authentication, you can create a gitconfig secret by using:
   $ %[2]s SECRET path/to/.gitconfig`
 )
@@ -40,6 +43,7 @@ provide this information by creating a 'sshauth' secret and attaching it to your
 type CreateSSHAuthSecretOptions struct {
 	SecretName      string
 	PrivateKeyPath  string
+	KnownHostsPath  string
 	CertificatePath string
 	GitConfigPath   string
 
@@ -57,7 +61,7 @@ func NewCmdCreateSSHAuthSecret(name, fullName string, f *kcmdutil.Factory, out i
 	}
 
 	cmd := &cobra.Command{
-		Use:     fmt.Sprintf("%s SECRET --ssh-privatekey=FILENAME [--ca-cert=FILENAME] [--gitconfig=FILENAME]", name),
+		Use:     fmt.Sprintf("%s SECRET --ssh-privatekey=FILENAME [--known-hosts=FILENAME] [--ca-cert=FILENAME] [--gitconfig=FILENAME]", name),
 		Short:   "Create a new secret for SSH authentication",
 		Long:    createSSHAuthSecretLong,
 		Example: fmt.Sprintf(createSSHAuthSecretExample, fullName, newSecretFullName, ocEditFullName),
@@ -86,6 +90,8 @@ func NewCmdCreateSSHAuthSecret(name, fullName string, f *kcmdutil.Factory, out i
 
 	cmd.Flags().StringVar(&o.PrivateKeyPath, "ssh-privatekey", "", "Path to a SSH private key file")
 	cmd.MarkFlagFilename("ssh-privatekey")
+	cmd.Flags().StringVar(&o.KnownHostsPath, "known-hosts", "", "Path to a SSH known hosts file used to verify the host keys of the SCM server")
+	cmd.MarkFlagFilename("known-hosts")
 	cmd.Flags().StringVar(&o.CertificatePath, "ca-cert", "", "Path to a certificate file")
 	cmd.MarkFlagFilename("ca-cert")
 	cmd.Flags().StringVar(&o.GitConfigPath, "gitconfig", "", "Path to a .gitconfig file")
@@ -127,6 +133,14 @@ func (o *CreateSSHAuthSecretOptions) NewSSHAuthSecret() (*api.Secret, error) {
 		secret.Data[SourcePrivateKey] = privateKeyContent
 	}
 
+	if len(o.KnownHostsPath) != 0 {
+		knownHostsContent, err := ioutil.ReadFile(o.KnownHostsPath)
+		if err != nil {
+			return nil, err
+		}
+		secret.Data[SourceKnownHosts] = knownHostsContent
+	}
+
 	if len(o.CertificatePath) != 0 {
 		caContent, err := ioutil.ReadFile(o.CertificatePath)
 		if err != nil {
@@ -1,6 +1,7 @@
 package secrets
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -54,3 +55,21 @@ func TestValidateSSHAuth(t *testing.T) {
 		}
 	}
 }
+
+func TestNewSSHAuthSecret(t *testing.T) {
+	options := CreateSSHAuthSecretOptions{
+		SecretName:     "testSecret",
+		PrivateKeyPath: "./bsFixtures/valid/ssh-privatekey",
+		KnownHostsPath: "./bsFixtures/valid/known_hosts",
+	}
+	secret, err := options.NewSSHAuthSecret()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := secret.Data[SourcePrivateKey]; !ok {
+		t.Errorf("expected the secret to contain %s", SourcePrivateKey)
+	}
+	if !strings.HasPrefix(string(secret.Data[SourceKnownHosts]), "github.com ssh-rsa ") {
+		t.Errorf("expected the secret to contain the known hosts, got %q", string(secret.Data[SourceKnownHosts]))
+	}
+}
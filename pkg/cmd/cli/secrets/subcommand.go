@@ -21,6 +21,8 @@ const (
 	SourceCertificate = scmauth.CACertName
 	// SourcePrivateKey is the key of the required SSH private key for SSH authentication subcommand
 	SourcePrivateKey = scmauth.SSHPrivateKeyMethodName
+	// SourceKnownHosts is the key of the optional SSH known hosts for SSH authentication subcommand
+	SourceKnownHosts = scmauth.SSHKnownHostsName
 	// SourceGitconfig is the key of the optional gitconfig content for both basic and SSH authentication subcommands
 	SourceGitConfig = scmauth.GitConfigName
 )
@@ -0,0 +1,115 @@
+package templatetest
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	templatetesting "github.com/openshift/origin/pkg/template/testing"
+)
+
+const (
+	templateTestLong = `
+Test templates against fixtures
+
+A fixture references a template file and lists test cases. Every test case processes
+the template locally with a set of parameter values and checks the resulting objects
+against expectations written as JSONPath templates. Generated parameter values are
+produced from a fixed seed, so that the results are reproducible.
+
+A fixture is written in YAML or JSON:
+
+  template: guestbook.json
+  cases:
+  - name: custom admin
+    parameters:
+      ADMIN_USERNAME: admin
+    expectations:
+    - object: ReplicationController/guestbook
+      path: "{.spec.template.spec.containers[0].env[?(@.name==\"ADMIN_USERNAME\")].value}"
+      value: admin
+    - path: "{.items[*].kind}"
+      value: Route Service Service Service Pod ReplicationController ReplicationController
+  - name: unknown parameter
+    parameters:
+      UNKNOWN: value
+    expectError: true`
+
+	templateTestExample = `  # Run the test cases of a fixture
+  $ %[1]s guestbook-test.yaml
+
+  # Run the test cases of several fixtures
+  $ %[1]s test/*.yaml`
+)
+
+// TemplateTestRecommendedCommandName is the recommended command name
+const TemplateTestRecommendedCommandName = "template-test"
+
+// TemplateTestOptions contains all the options needed for template-test
+type TemplateTestOptions struct {
+	fixtures []string
+
+	out io.Writer
+}
+
+// NewCmdTemplateTest implements the OpenShift experimental template-test command
+func NewCmdTemplateTest(name, fullName string, out io.Writer) *cobra.Command {
+	options := &TemplateTestOptions{}
+	cmd := &cobra.Command{
+		Use:     name + " FIXTURE...",
+		Short:   "Test templates against fixtures",
+		Long:    templateTestLong,
+		Example: fmt.Sprintf(templateTestExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(options.Complete(cmd, args, out))
+
+			cmdutil.CheckErr(options.RunTemplateTest())
+		},
+	}
+	return cmd
+}
+
+// Complete completes the required options for template-test
+func (o *TemplateTestOptions) Complete(cmd *cobra.Command, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return cmdutil.UsageError(cmd, "Must pass at least one fixture file.")
+	}
+	o.fixtures = args
+	o.out = out
+	return nil
+}
+
+// RunTemplateTest runs the test cases of every fixture, printing their
+// outcome, and fails if any of them fails.
+func (o *TemplateTestOptions) RunTemplateTest() error {
+	total, failed := 0, 0
+	for _, filename := range o.fixtures {
+		fixture, err := templatetesting.LoadFixture(filename)
+		if err != nil {
+			return err
+		}
+		template, err := templatetesting.LoadTemplate(fixture.Template)
+		if err != nil {
+			return err
+		}
+		for _, c := range fixture.Cases {
+			total++
+			errs := c.Run(nil, template)
+			if len(errs) == 0 {
+				fmt.Fprintf(o.out, "PASS %s: %s\n", filename, c.Name)
+				continue
+			}
+			failed++
+			fmt.Fprintf(o.out, "FAIL %s: %s\n", filename, c.Name)
+			for _, err := range errs {
+				fmt.Fprintf(o.out, "  %v\n", err)
+			}
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d test cases failed", failed, total)
+	}
+	return nil
+}
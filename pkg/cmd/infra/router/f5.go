@@ -177,6 +177,7 @@ func (o *F5RouterOptions) Run() error {
 
 	statusPlugin := controller.NewStatusAdmitter(f5Plugin, oc, o.RouterName)
 	plugin := controller.NewUniqueHost(statusPlugin, o.RouteSelectionFunc(), statusPlugin)
+	plugin.AllowPathSharing = o.RouterSelection.AllowPathSharing
 
 	factory := o.RouterSelection.NewFactory(oc, kc)
 	controller := factory.Create(plugin)
@@ -43,6 +43,8 @@ type RouterSelection struct {
 	ProjectLabels        labels.Selector
 
 	IncludeUDP bool
+
+	AllowPathSharing bool
 }
 
 // Bind sets the appropriate labels
@@ -55,6 +57,7 @@ func (o *RouterSelection) Bind(flag *pflag.FlagSet) {
 	flag.StringVar(&o.ProjectLabelSelector, "project-labels", cmdutil.Env("PROJECT_LABELS", ""), "A label selector to apply to projects to watch; if '*' watches all projects the client can access")
 	flag.StringVar(&o.NamespaceLabelSelector, "namespace-labels", cmdutil.Env("NAMESPACE_LABELS", ""), "A label selector to apply to namespaces to watch")
 	flag.BoolVar(&o.IncludeUDP, "include-udp-endpoints", false, "If true, UDP endpoints will be considered as candidates for routing")
+	flag.BoolVar(&o.AllowPathSharing, "allow-path-sharing", cmdutil.Env("ROUTER_ALLOW_PATH_SHARING", "true") == "true", "If true, routes in the namespace owning a host may share it with different paths; otherwise only the oldest route for a host is served")
 }
 
 // RouteSelectionFunc returns a func that identifies the host for a route.
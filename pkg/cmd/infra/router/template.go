@@ -3,10 +3,13 @@ package router
 import (
 	"errors"
 	"fmt"
+	"net"
+	"os"
 	"strconv"
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -16,6 +19,7 @@ import (
 	"github.com/openshift/origin/pkg/cmd/util"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 	"github.com/openshift/origin/pkg/router/controller"
+	"github.com/openshift/origin/pkg/router/metrics"
 	templateplugin "github.com/openshift/origin/pkg/router/template"
 	"github.com/openshift/origin/pkg/util/proc"
 	"github.com/openshift/origin/pkg/version"
@@ -47,14 +51,17 @@ type TemplateRouterOptions struct {
 }
 
 type TemplateRouter struct {
-	RouterName             string
-	WorkingDir             string
-	TemplateFile           string
-	ReloadScript           string
-	ReloadInterval         time.Duration
-	DefaultCertificate     string
-	DefaultCertificatePath string
-	RouterService          *ktypes.NamespacedName
+	RouterName               string
+	WorkingDir               string
+	TemplateFile             string
+	ReloadScript             string
+	ReloadInterval           time.Duration
+	MinReloadInterval        time.Duration
+	HAProxySocket            string
+	DefaultCertificate       string
+	DefaultCertificatePath   string
+	DefaultDestinationCAPath string
+	RouterService            *ktypes.NamespacedName
 }
 
 // reloadInterval returns how often to run the router reloads. The interval
@@ -69,14 +76,30 @@ func reloadInterval() time.Duration {
 	return value
 }
 
+// minReloadInterval returns the minimum time between two router reloads. The
+// value is based on an environment variable, reloads are only limited by the
+// reload interval by default.
+func minReloadInterval() time.Duration {
+	interval := util.Env("MIN_RELOAD_INTERVAL", "0s")
+	value, err := time.ParseDuration(interval)
+	if err != nil {
+		glog.Warningf("Invalid MIN_RELOAD_INTERVAL %q, reloads are only limited by the reload interval ...", interval)
+		value = 0
+	}
+	return value
+}
+
 func (o *TemplateRouter) Bind(flag *pflag.FlagSet) {
 	flag.StringVar(&o.RouterName, "name", util.Env("ROUTER_SERVICE_NAME", "public"), "The name the router will identify itself with in the route status")
 	flag.StringVar(&o.WorkingDir, "working-dir", "/var/lib/containers/router", "The working directory for the router plugin")
 	flag.StringVar(&o.DefaultCertificate, "default-certificate", util.Env("DEFAULT_CERTIFICATE", ""), "The contents of a default certificate to use for routes that don't expose a TLS server cert; in PEM format")
 	flag.StringVar(&o.DefaultCertificatePath, "default-certificate-path", util.Env("DEFAULT_CERTIFICATE_PATH", ""), "A path to default certificate to use for routes that don't expose a TLS server cert; in PEM format")
+	flag.StringVar(&o.DefaultDestinationCAPath, "default-destination-ca-path", util.Env("DEFAULT_DESTINATION_CA_PATH", "/var/run/secrets/kubernetes.io/serviceaccount/service-ca.crt"), "A path to a PEM file containing the default CA bundle to use with re-encrypt routes. This CA should sign for certificates in the Kubernetes DNS space (service.namespace.svc).")
 	flag.StringVar(&o.TemplateFile, "template", util.Env("TEMPLATE_FILE", ""), "The path to the template file to use")
 	flag.StringVar(&o.ReloadScript, "reload", util.Env("RELOAD_SCRIPT", ""), "The path to the reload script to use")
 	flag.DurationVar(&o.ReloadInterval, "interval", reloadInterval(), "Controls how often router reloads are invoked. Mutiple router reload requests are coalesced for the duration of this interval since the last reload time.")
+	flag.DurationVar(&o.MinReloadInterval, "min-reload-interval", minReloadInterval(), "The minimum time between two reloads of the router. Changes which can be applied through --haproxy-socket are not delayed.")
+	flag.StringVar(&o.HAProxySocket, "haproxy-socket", util.Env("ROUTER_HAPROXY_SOCKET", ""), "The path of the stats socket of HAProxy, which must have the admin level. If set, changes to the weight of endpoints and removed endpoints are applied through the socket instead of reloading the router, and the HAProxy statistics are included in the metrics.")
 }
 
 type RouterStats struct {
@@ -85,12 +108,19 @@ type RouterStats struct {
 	StatsUsername   string
 
 	StatsPort int
+
+	MetricsAddress  string
+	MetricsCertFile string
+	MetricsKeyFile  string
 }
 
 func (o *RouterStats) Bind(flag *pflag.FlagSet) {
 	flag.StringVar(&o.StatsPortString, "stats-port", util.Env("STATS_PORT", ""), "If the underlying router implementation can provide statistics this is a hint to expose it on this port.")
 	flag.StringVar(&o.StatsPassword, "stats-password", util.Env("STATS_PASSWORD", ""), "If the underlying router implementation can provide statistics this is the requested password for auth.")
 	flag.StringVar(&o.StatsUsername, "stats-user", util.Env("STATS_USERNAME", ""), "If the underlying router implementation can provide statistics this is the requested username for auth.")
+	flag.StringVar(&o.MetricsAddress, "metrics-address", util.Env("ROUTER_METRICS_ADDRESS", ""), "If set, the router serves prometheus metrics on /metrics at this host:port. Clients must authenticate with the stats user and password when both are set; otherwise the host must be a loopback address.")
+	flag.StringVar(&o.MetricsCertFile, "metrics-cert-file", util.Env("ROUTER_METRICS_TLS_CERT_FILE", ""), "If set with --metrics-key-file, the metrics are served over TLS with this certificate; in PEM format")
+	flag.StringVar(&o.MetricsKeyFile, "metrics-key-file", util.Env("ROUTER_METRICS_TLS_KEY_FILE", ""), "The private key of --metrics-cert-file; in PEM format")
 }
 
 // NewCommndTemplateRouter provides CLI handler for the template router backend
@@ -147,6 +177,9 @@ func (o *TemplateRouterOptions) Complete() error {
 	if nsecs := int(o.ReloadInterval.Seconds()); nsecs < 1 {
 		return fmt.Errorf("invalid reload interval: %v - must be a positive duration", nsecs)
 	}
+	if o.MinReloadInterval < 0 {
+		return fmt.Errorf("invalid minimum reload interval: %v - must not be negative", o.MinReloadInterval)
+	}
 
 	return o.RouterSelection.Complete()
 }
@@ -162,23 +195,53 @@ func (o *TemplateRouterOptions) Validate() error {
 	if len(o.ReloadScript) == 0 {
 		return errors.New("reload script must be specified")
 	}
+	if (len(o.MetricsCertFile) == 0) != (len(o.MetricsKeyFile) == 0) {
+		return errors.New("both a metrics certificate and key file must be specified to serve metrics over TLS")
+	}
+	if len(o.MetricsAddress) > 0 && (len(o.StatsUsername) == 0 || len(o.StatsPassword) == 0) && !isLoopbackAddress(o.MetricsAddress) {
+		return fmt.Errorf("metrics address %s must be a loopback address unless a stats user and password are set", o.MetricsAddress)
+	}
 	return nil
 }
 
+// isLoopbackAddress returns true if the host of the host:port address is
+// localhost or a loopback IP. An empty host listens on all interfaces.
+func isLoopbackAddress(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
 // Run launches a template router using the provided options. It never exits.
 func (o *TemplateRouterOptions) Run() error {
+	if len(o.DefaultDestinationCAPath) > 0 {
+		if _, err := os.Stat(o.DefaultDestinationCAPath); err != nil {
+			glog.Warningf("Re-encrypt routes without a destination CA certificate will be rejected, the default destination CA could not be read: %v", err)
+			o.DefaultDestinationCAPath = ""
+		}
+	}
+
 	pluginCfg := templateplugin.TemplatePluginConfig{
-		WorkingDir:             o.WorkingDir,
-		TemplatePath:           o.TemplateFile,
-		ReloadScriptPath:       o.ReloadScript,
-		ReloadInterval:         o.ReloadInterval,
-		DefaultCertificate:     o.DefaultCertificate,
-		DefaultCertificatePath: o.DefaultCertificatePath,
-		StatsPort:              o.StatsPort,
-		StatsUsername:          o.StatsUsername,
-		StatsPassword:          o.StatsPassword,
-		PeerService:            o.RouterService,
-		IncludeUDP:             o.RouterSelection.IncludeUDP,
+		WorkingDir:               o.WorkingDir,
+		TemplatePath:             o.TemplateFile,
+		ReloadScriptPath:         o.ReloadScript,
+		ReloadInterval:           o.ReloadInterval,
+		MinReloadInterval:        o.MinReloadInterval,
+		HAProxySocket:            o.HAProxySocket,
+		DefaultCertificate:       o.DefaultCertificate,
+		DefaultCertificatePath:   o.DefaultCertificatePath,
+		DefaultDestinationCAPath: o.DefaultDestinationCAPath,
+		StatsPort:                o.StatsPort,
+		StatsUsername:            o.StatsUsername,
+		StatsPassword:            o.StatsPassword,
+		PeerService:              o.RouterService,
+		IncludeUDP:               o.RouterSelection.IncludeUDP,
 	}
 
 	templatePlugin, err := templateplugin.NewTemplatePlugin(pluginCfg)
@@ -186,13 +249,32 @@ func (o *TemplateRouterOptions) Run() error {
 		return err
 	}
 
+	if len(o.MetricsAddress) > 0 {
+		templateplugin.RegisterMetrics()
+		if len(o.HAProxySocket) > 0 {
+			prometheus.MustRegister(metrics.NewHAProxyCollector(o.HAProxySocket))
+		}
+		listener := metrics.Listener{
+			Addr:     o.MetricsAddress,
+			Username: o.StatsUsername,
+			Password: o.StatsPassword,
+			CertFile: o.MetricsCertFile,
+			KeyFile:  o.MetricsKeyFile,
+		}
+		if err := listener.Listen(); err != nil {
+			return fmt.Errorf("unable to serve router metrics: %v", err)
+		}
+	}
+
 	oc, kc, err := o.Config.Clients()
 	if err != nil {
 		return err
 	}
 
 	statusPlugin := controller.NewStatusAdmitter(templatePlugin, oc, o.RouterName)
-	plugin := controller.NewUniqueHost(statusPlugin, o.RouteSelectionFunc(), statusPlugin)
+	uniqueHostPlugin := controller.NewUniqueHost(statusPlugin, o.RouteSelectionFunc(), statusPlugin)
+	uniqueHostPlugin.AllowPathSharing = o.RouterSelection.AllowPathSharing
+	plugin := controller.NewDestinationCA(uniqueHostPlugin, len(o.DefaultDestinationCAPath) > 0, statusPlugin)
 
 	factory := o.RouterSelection.NewFactory(oc, kc)
 	controller := factory.Create(plugin)
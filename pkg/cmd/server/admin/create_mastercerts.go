@@ -189,6 +189,7 @@ func (o CreateMasterCertsOptions) CreateMasterCerts() error {
 		func() error { return o.createKubeletClientCerts(&getSignerCertOptions) },
 		func() error { return o.createProxyClientCerts(&getSignerCertOptions) },
 		func() error { return o.createServiceAccountKeys() },
+		func() error { return o.createServiceSigningCA() },
 	)
 	return utilerrors.NewAggregate(errs)
 }
@@ -320,3 +321,23 @@ func (o CreateMasterCertsOptions) createServiceAccountKeys() error {
 	}
 	return nil
 }
+
+func (o CreateMasterCertsOptions) createServiceSigningCA() error {
+	caInfo := DefaultServiceSignerCAInfo(o.CertDir)
+
+	caOptions := CreateSignerCertOptions{
+		CertFile:   caInfo.CertFile,
+		KeyFile:    caInfo.KeyFile,
+		SerialFile: "", // we want the random cert serial for this one
+		Name:       DefaultServiceSignerName(),
+		Overwrite:  o.Overwrite,
+		Output:     o.Output,
+	}
+	if err := caOptions.Validate(nil); err != nil {
+		return err
+	}
+	if _, err := caOptions.CreateSignerCert(); err != nil {
+		return err
+	}
+	return nil
+}
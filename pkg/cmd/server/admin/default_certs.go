@@ -15,6 +15,8 @@ const (
 	CAFilePrefix     = "ca"
 	CABundlePrefix   = "ca-bundle"
 	MasterFilePrefix = "master"
+
+	ServiceSignerCAFilePrefix = "service-signer"
 )
 
 type ClientCertInfo struct {
@@ -28,6 +30,10 @@ func DefaultSignerName() string {
 	return fmt.Sprintf("%s@%d", "openshift-signer", time.Now().Unix())
 }
 
+func DefaultServiceSignerName() string {
+	return fmt.Sprintf("%s@%d", "openshift-service-serving-signer", time.Now().Unix())
+}
+
 func DefaultCABundleFile(certDir string) string {
 	return DefaultCertFilename(certDir, CABundlePrefix)
 }
@@ -180,6 +186,13 @@ func DefaultEtcdServingCertInfo(certDir string) configapi.CertInfo {
 	}
 }
 
+func DefaultServiceSignerCAInfo(certDir string) configapi.CertInfo {
+	return configapi.CertInfo{
+		CertFile: DefaultCertFilename(certDir, ServiceSignerCAFilePrefix),
+		KeyFile:  DefaultKeyFilename(certDir, ServiceSignerCAFilePrefix),
+	}
+}
+
 func DefaultServiceAccountPrivateKeyFile(certDir string) string {
 	return path.Join(certDir, "serviceaccounts.private.key")
 }
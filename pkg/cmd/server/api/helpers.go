@@ -246,6 +246,19 @@ func GetMasterFileReferences(config *MasterConfig) []*string {
 		refs = append(refs, &config.ServiceAccountConfig.PublicKeyFiles[i])
 	}
 
+	if config.ControllerConfig.ServiceServingCert.Signer != nil {
+		refs = append(refs, &config.ControllerConfig.ServiceServingCert.Signer.CertFile)
+		refs = append(refs, &config.ControllerConfig.ServiceServingCert.Signer.KeyFile)
+	}
+	for i := range config.AuthConfig.WebhookTokenAuthenticators {
+		refs = append(refs, &config.AuthConfig.WebhookTokenAuthenticators[i].CA)
+		refs = append(refs, &config.AuthConfig.WebhookTokenAuthenticators[i].ClientCert.CertFile)
+		refs = append(refs, &config.AuthConfig.WebhookTokenAuthenticators[i].ClientCert.KeyFile)
+	}
+	if config.ControllerConfig.LDAPGroupSync != nil {
+		refs = append(refs, &config.ControllerConfig.LDAPGroupSync.SyncConfigFile)
+	}
+
 	refs = append(refs, &config.MasterClients.OpenShiftLoopbackKubeConfig)
 	refs = append(refs, &config.MasterClients.ExternalKubernetesKubeConfig)
 
@@ -165,6 +165,18 @@ func fuzzInternalObject(t *testing.T, forVersion unversioned.GroupVersion, item
 				obj.MCSLabelsPerProject = 5
 			}
 		},
+		func(obj *configapi.WebhookTokenAuthenticator, c fuzz.Continue) {
+			c.FuzzNoCustom(obj)
+			if len(obj.CacheTTL) == 0 {
+				obj.CacheTTL = "2m"
+			}
+			if obj.CacheSize == 0 {
+				obj.CacheSize = 1000
+			}
+			if len(obj.FailurePolicy) == 0 {
+				obj.FailurePolicy = configapi.WebhookFailurePolicyFail
+			}
+		},
 		func(obj *configapi.IdentityProvider, c fuzz.Continue) {
 			c.FuzzNoCustom(obj)
 			if len(obj.MappingMethod) == 0 {
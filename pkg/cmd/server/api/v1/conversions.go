@@ -52,6 +52,22 @@ func addDefaultingFuncs(scheme *runtime.Scheme) {
 				obj.OAuthConfig.MasterCA = &s
 			}
 		},
+		func(obj *WebhookTokenAuthenticator) {
+			if len(obj.CacheTTL) == 0 {
+				obj.CacheTTL = "2m"
+			}
+			if obj.CacheSize == 0 {
+				obj.CacheSize = 1000
+			}
+			if len(obj.FailurePolicy) == 0 {
+				obj.FailurePolicy = WebhookFailurePolicyFail
+			}
+		},
+		func(obj *LDAPGroupSyncControllerConfig) {
+			if obj.SyncIntervalSeconds == 0 {
+				obj.SyncIntervalSeconds = 30 * 60
+			}
+		},
 		func(obj *KubernetesMasterConfig) {
 			if obj.MasterCount == 0 {
 				obj.MasterCount = 1
@@ -245,6 +261,26 @@ func addConversionFuncs(scheme *runtime.Scheme) {
 			out.KeyFile = in.ClientCert.KeyFile
 			return nil
 		},
+		func(in *WebhookTokenAuthenticator, out *internal.WebhookTokenAuthenticator, s conversion.Scope) error {
+			out.URL = in.URL
+			out.CA = in.CA
+			out.ClientCert.CertFile = in.CertFile
+			out.ClientCert.KeyFile = in.KeyFile
+			out.CacheTTL = in.CacheTTL
+			out.CacheSize = in.CacheSize
+			out.FailurePolicy = internal.WebhookFailurePolicy(in.FailurePolicy)
+			return nil
+		},
+		func(in *internal.WebhookTokenAuthenticator, out *WebhookTokenAuthenticator, s conversion.Scope) error {
+			out.URL = in.URL
+			out.CA = in.CA
+			out.CertFile = in.ClientCert.CertFile
+			out.KeyFile = in.ClientCert.KeyFile
+			out.CacheTTL = in.CacheTTL
+			out.CacheSize = in.CacheSize
+			out.FailurePolicy = WebhookFailurePolicy(in.FailurePolicy)
+			return nil
+		},
 		func(in *KubeletConnectionInfo, out *internal.KubeletConnectionInfo, s conversion.Scope) error {
 			out.Port = in.Port
 			out.CA = in.CA
@@ -105,6 +105,16 @@ func (CertInfo) SwaggerDoc() map[string]string {
 	return map_CertInfo
 }
 
+var map_ControllerConfig = map[string]string{
+	"":                   "ControllerConfig holds configuration values for controllers",
+	"serviceServingCert": "ServiceServingCert holds configuration for the service serving cert signer which creates cert/key pairs for pods fulfilling a service to serve with.",
+	"ldapGroupSync":      "LDAPGroupSync holds configuration for the controller periodically syncing OpenShift groups with an LDAP server. If this value is nil, groups are not synced automatically.",
+}
+
+func (ControllerConfig) SwaggerDoc() map[string]string {
+	return map_ControllerConfig
+}
+
 var map_DNSConfig = map[string]string{
 	"":                      "DNSConfig holds the necessary configuration options for DNS",
 	"bindAddress":           "BindAddress is the ip:port to serve DNS on",
@@ -259,6 +269,8 @@ var map_ImagePolicyConfig = map[string]string{
 	"disableScheduledImport":                     "DisableScheduledImport allows scheduled background import of images to be disabled.",
 	"scheduledImageImportMinimumIntervalSeconds": "ScheduledImageImportMinimumIntervalSeconds is the minimum number of seconds that can elapse between when image streams scheduled for background import are checked against the upstream repository. The default value is 15 minutes.",
 	"maxScheduledImageImportsPerMinute":          "MaxScheduledImageImportsPerMinute is the maximum number of scheduled image streams that will be imported in the background per minute. The default value is 60. Set to -1 for unlimited.",
+	"tagUpdateNotificationURL":                   "TagUpdateNotificationURL is the URL of a webhook that is notified whenever a tag of any image stream points to a new image. If empty, no cluster wide notifications are sent.",
+	"allowTagUpdateNotificationAnnotation":       "AllowTagUpdateNotificationAnnotation allows users to set the URL of a webhook notified of the tag updates of an image stream with the openshift.io/image.tagUpdateNotificationURL annotation of the stream.",
 }
 
 func (ImagePolicyConfig) SwaggerDoc() map[string]string {
@@ -317,6 +329,17 @@ func (LDAPAttributeMapping) SwaggerDoc() map[string]string {
 	return map_LDAPAttributeMapping
 }
 
+var map_LDAPGroupSyncControllerConfig = map[string]string{
+	"":                    "LDAPGroupSyncControllerConfig holds configuration for the controller periodically syncing OpenShift groups with an LDAP server.",
+	"syncConfigFile":      "SyncConfigFile is the path to the LDAPSyncConfig describing how groups are synced, as used by `oadm groups sync`",
+	"syncIntervalSeconds": "SyncIntervalSeconds is the number of seconds between two syncs. Defaults to 1800.",
+	"prune":               "Prune deletes the OpenShift groups whose LDAP group no longer exists after every sync",
+}
+
+func (LDAPGroupSyncControllerConfig) SwaggerDoc() map[string]string {
+	return map_LDAPGroupSyncControllerConfig
+}
+
 var map_LDAPPasswordIdentityProvider = map[string]string{
 	"":             "LDAPPasswordIdentityProvider provides identities for users authenticating using LDAP credentials",
 	"url":          "URL is an RFC 2255 URL which specifies the LDAP search parameters to use. The syntax of the URL is\n   ldap://host:port/basedn?attribute?scope?filter",
@@ -371,6 +394,15 @@ func (LocalQuota) SwaggerDoc() map[string]string {
 	return map_LocalQuota
 }
 
+var map_MasterAuthConfig = map[string]string{
+	"":                           "MasterAuthConfig configures authentication options in addition to the standard oauth token and client certificate authenticators",
+	"webhookTokenAuthenticators": "WebhookTokenAuthenticators, if present configures remote token reviewers",
+}
+
+func (MasterAuthConfig) SwaggerDoc() map[string]string {
+	return map_MasterAuthConfig
+}
+
 var map_MasterClients = map[string]string{
 	"": "MasterClients holds references to `.kubeconfig` files that qualify master clients for OpenShift and Kubernetes",
 	"openshiftLoopbackKubeConfig":  "OpenShiftLoopbackKubeConfig is a .kubeconfig filename for system components to loopback to this master",
@@ -390,6 +422,7 @@ var map_MasterConfig = map[string]string{
 	"controllers":            "Controllers is a list of the controllers that should be started. If set to \"none\", no controllers will start automatically. The default value is \"*\" which will start all controllers. When using \"*\", you may exclude controllers by prepending a \"-\" in front of their name. No other values are recognized at this time.",
 	"pauseControllers":       "PauseControllers instructs the master to not automatically start controllers, but instead to wait until a notification to the server is received before launching them.",
 	"controllerLeaseTTL":     "ControllerLeaseTTL enables controller election, instructing the master to attempt to acquire a lease before controllers start and renewing it within a number of seconds defined by this value. Setting this value non-negative forces pauseControllers=true. This value defaults off (0, or omitted) and controller election can be disabled with -1.",
+	"controllerConfig":       "ControllerConfig holds configuration values for controllers",
 	"admissionConfig":        "AdmissionConfig contains admission control plugin configuration.",
 	"disabledFeatures":       "DisabledFeatures is a list of features that should not be started.  We omitempty here because its very unlikely that anyone will want to manually disable features and we don't want to encourage it.",
 	"etcdStorageConfig":      "EtcdStorageConfig contains information about how API resources are stored in Etcd. These values are only relevant when etcd is the backing store for the cluster.",
@@ -401,6 +434,7 @@ var map_MasterConfig = map[string]string{
 	"assetConfig":            "AssetConfig, if present start the asset server in this process",
 	"dnsConfig":              "DNSConfig, if present start the DNS server in this process",
 	"serviceAccountConfig":   "ServiceAccountConfig holds options related to service accounts",
+	"authConfig":             "AuthConfig configures authentication options in addition to the standard oauth token and client certificate authenticators",
 	"masterClients":          "MasterClients holds all the client connection information for controllers and other system components",
 	"imageConfig":            "ImageConfig holds options that describe how to build image names for system components",
 	"imagePolicyConfig":      "ImagePolicyConfig controls limits and behavior for importing images",
@@ -519,6 +553,7 @@ var map_OpenIDClaims = map[string]string{
 	"preferredUsername": "PreferredUsername is the list of claims whose values should be used as the preferred username. If unspecified, the preferred username is determined from the value of the id claim",
 	"name":              "Name is the list of claims whose values should be used as the display name. Optional. If unspecified, no display name is set for the identity",
 	"email":             "Email is the list of claims whose values should be used as the email address. Optional. If unspecified, no email is set for the identity",
+	"groups":            "Groups is the list of claims whose values should be used as the names of the groups the user is a member of. Optional. Groups are created as needed and the membership of the user is updated on every login",
 }
 
 func (OpenIDClaims) SwaggerDoc() map[string]string {
@@ -532,6 +567,7 @@ var map_OpenIDIdentityProvider = map[string]string{
 	"clientSecret":             "ClientSecret is the oauth client secret",
 	"extraScopes":              "ExtraScopes are any scopes to request in addition to the standard \"openid\" scope.",
 	"extraAuthorizeParameters": "ExtraAuthorizeParameters are any custom parameters to add to the authorize request.",
+	"issuer":                   "Issuer is the optional URL of the OpenID provider. If set, the authorize, token and userinfo URLs left empty are read from the discovery document at <issuer>/.well-known/openid-configuration",
 	"urls":   "URLs to use to authenticate",
 	"claims": "Claims mappings",
 }
@@ -660,6 +696,15 @@ func (ServiceAccountConfig) SwaggerDoc() map[string]string {
 	return map_ServiceAccountConfig
 }
 
+var map_ServiceServingCert = map[string]string{
+	"":       "ServiceServingCert holds configuration for the service serving cert signer which creates cert/key pairs for pods fulfilling a service to serve with.",
+	"signer": "Signer holds the signing information used to automatically sign serving certificates. If this value is nil, then certs are not signed automatically.",
+}
+
+func (ServiceServingCert) SwaggerDoc() map[string]string {
+	return map_ServiceServingCert
+}
+
 var map_ServingInfo = map[string]string{
 	"":                  "ServingInfo holds information about serving web pages",
 	"bindAddress":       "BindAddress is the ip:port to serve on",
@@ -726,6 +771,7 @@ var map_TokenConfig = map[string]string{
 	"": "TokenConfig holds the necessary configuration options for authorization and access tokens",
 	"authorizeTokenMaxAgeSeconds": "AuthorizeTokenMaxAgeSeconds defines the maximum age of authorize tokens",
 	"accessTokenMaxAgeSeconds":    "AccessTokenMaxAgeSeconds defines the maximum age of access tokens",
+	"accessTokenInactivityTimeoutSeconds": "AccessTokenInactivityTimeoutSeconds defines how long an access token may go unused before it can no longer be used. Using a token extends its validity, up to its maximum age. 0 means no timeout. OAuth clients can override this value.",
 }
 
 func (TokenConfig) SwaggerDoc() map[string]string {
@@ -770,3 +816,16 @@ var map_VolumeConfig = map[string]string{
 func (VolumeConfig) SwaggerDoc() map[string]string {
 	return map_VolumeConfig
 }
+
+var map_WebhookTokenAuthenticator = map[string]string{
+	"":              "WebhookTokenAuthenticator holds the necessary configuration options for an external token review service",
+	"url":           "URL is the URL token reviews are posted to",
+	"ca":            "CA is a file containing trusted roots for the token review service certificates",
+	"cacheTTL":      "CacheTTL indicates how long an authentication result should be cached. It takes a valid time duration string (e.g. \"5m\"). If empty, you get the default timeout. If zero (e.g. \"0m\"), caching is disabled",
+	"cacheSize":     "CacheSize indicates how many authentication results should be cached. If 0, the default cache size is used.",
+	"failurePolicy": "FailurePolicy determines how requests are handled when the token review service cannot be reached. Valid values are \"Fail\" and \"Ignore\", defaults to \"Fail\".",
+}
+
+func (WebhookTokenAuthenticator) SwaggerDoc() map[string]string {
+	return map_WebhookTokenAuthenticator
+}
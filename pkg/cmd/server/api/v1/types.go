@@ -166,6 +166,8 @@ type MasterConfig struct {
 	// Setting this value non-negative forces pauseControllers=true. This value defaults off (0, or
 	// omitted) and controller election can be disabled with -1.
 	ControllerLeaseTTL int `json:"controllerLeaseTTL"`
+	// ControllerConfig holds configuration values for controllers
+	ControllerConfig ControllerConfig `json:"controllerConfig"`
 
 	// AdmissionConfig contains admission control plugin configuration.
 	AdmissionConfig AdmissionConfig `json:"admissionConfig"`
@@ -199,6 +201,10 @@ type MasterConfig struct {
 	// ServiceAccountConfig holds options related to service accounts
 	ServiceAccountConfig ServiceAccountConfig `json:"serviceAccountConfig"`
 
+	// AuthConfig configures authentication options in addition to the standard
+	// oauth token and client certificate authenticators
+	AuthConfig MasterAuthConfig `json:"authConfig"`
+
 	// MasterClients holds all the client connection information for controllers and other system components
 	MasterClients MasterClients `json:"masterClients"`
 
@@ -221,6 +227,34 @@ type MasterConfig struct {
 	NetworkConfig MasterNetworkConfig `json:"networkConfig"`
 }
 
+// ControllerConfig holds configuration values for controllers
+type ControllerConfig struct {
+	// ServiceServingCert holds configuration for the service serving cert signer which creates cert/key pairs for
+	// pods fulfilling a service to serve with.
+	ServiceServingCert ServiceServingCert `json:"serviceServingCert"`
+	// LDAPGroupSync holds configuration for the controller periodically syncing OpenShift groups with an LDAP server.
+	// If this value is nil, groups are not synced automatically.
+	LDAPGroupSync *LDAPGroupSyncControllerConfig `json:"ldapGroupSync"`
+}
+
+// LDAPGroupSyncControllerConfig holds configuration for the controller periodically syncing OpenShift groups with an LDAP server.
+type LDAPGroupSyncControllerConfig struct {
+	// SyncConfigFile is the path to the LDAPSyncConfig describing how groups are synced, as used by `oadm groups sync`
+	SyncConfigFile string `json:"syncConfigFile"`
+	// SyncIntervalSeconds is the number of seconds between two syncs. Defaults to 1800.
+	SyncIntervalSeconds int `json:"syncIntervalSeconds"`
+	// Prune deletes the OpenShift groups whose LDAP group no longer exists after every sync
+	Prune bool `json:"prune"`
+}
+
+// ServiceServingCert holds configuration for the service serving cert signer which creates cert/key pairs for
+// pods fulfilling a service to serve with.
+type ServiceServingCert struct {
+	// Signer holds the signing information used to automatically sign serving certificates.
+	// If this value is nil, then certs are not signed automatically.
+	Signer *CertInfo `json:"signer"`
+}
+
 // ImagePolicyConfig holds the necessary configuration options for limits and behavior for importing images
 type ImagePolicyConfig struct {
 	// MaxImagesBulkImportedPerRepository controls the number of images that are imported when a user
@@ -235,6 +269,12 @@ type ImagePolicyConfig struct {
 	// MaxScheduledImageImportsPerMinute is the maximum number of scheduled image streams that will be imported in the
 	// background per minute. The default value is 60. Set to -1 for unlimited.
 	MaxScheduledImageImportsPerMinute int `json:"maxScheduledImageImportsPerMinute"`
+	// TagUpdateNotificationURL is the URL of a webhook that is notified whenever a tag of any image stream points to a
+	// new image. If empty, no cluster wide notifications are sent.
+	TagUpdateNotificationURL string `json:"tagUpdateNotificationURL"`
+	// AllowTagUpdateNotificationAnnotation allows users to set the URL of a webhook notified of the tag updates of an
+	// image stream with the openshift.io/image.tagUpdateNotificationURL annotation of the stream.
+	AllowTagUpdateNotificationAnnotation bool `json:"allowTagUpdateNotificationAnnotation"`
 }
 
 //  holds the necessary configuration options for
@@ -585,12 +625,52 @@ type ServiceAccountConfig struct {
 	MasterCA string `json:"masterCA"`
 }
 
+// MasterAuthConfig configures authentication options in addition to the standard
+// oauth token and client certificate authenticators
+type MasterAuthConfig struct {
+	// WebhookTokenAuthenticators, if present configures remote token reviewers
+	WebhookTokenAuthenticators []WebhookTokenAuthenticator `json:"webhookTokenAuthenticators"`
+}
+
+// WebhookFailurePolicy determines how a webhook token authenticator handles failed token reviews
+type WebhookFailurePolicy string
+
+const (
+	// WebhookFailurePolicyFail rejects the request when the token review service cannot be reached
+	WebhookFailurePolicyFail WebhookFailurePolicy = "Fail"
+	// WebhookFailurePolicyIgnore treats the token as unauthenticated when the token review service cannot be reached
+	WebhookFailurePolicyIgnore WebhookFailurePolicy = "Ignore"
+)
+
+// WebhookTokenAuthenticator holds the necessary configuration options for an external token review service
+type WebhookTokenAuthenticator struct {
+	// URL is the URL token reviews are posted to
+	URL string `json:"url"`
+	// CA is a file containing trusted roots for the token review service certificates
+	CA string `json:"ca"`
+	// CertInfo is the TLS client cert information for securing communication to the token review service
+	// this is anonymous so that we can inline it for serialization
+	CertInfo `json:",inline"`
+	// CacheTTL indicates how long an authentication result should be cached.
+	// It takes a valid time duration string (e.g. "5m"). If empty, you get the default timeout. If zero (e.g. "0m"), caching is disabled
+	CacheTTL string `json:"cacheTTL"`
+	// CacheSize indicates how many authentication results should be cached. If 0, the default cache size is used.
+	CacheSize int `json:"cacheSize"`
+	// FailurePolicy determines how requests are handled when the token review service cannot be reached.
+	// Valid values are "Fail" and "Ignore", defaults to "Fail".
+	FailurePolicy WebhookFailurePolicy `json:"failurePolicy"`
+}
+
 // TokenConfig holds the necessary configuration options for authorization and access tokens
 type TokenConfig struct {
 	// AuthorizeTokenMaxAgeSeconds defines the maximum age of authorize tokens
 	AuthorizeTokenMaxAgeSeconds int32 `json:"authorizeTokenMaxAgeSeconds"`
 	// AccessTokenMaxAgeSeconds defines the maximum age of access tokens
 	AccessTokenMaxAgeSeconds int32 `json:"accessTokenMaxAgeSeconds"`
+	// AccessTokenInactivityTimeoutSeconds defines how long an access token may go unused before it can no
+	// longer be used. Using a token extends its validity, up to its maximum age. 0 means no timeout.
+	// OAuth clients can override this value.
+	AccessTokenInactivityTimeoutSeconds int32 `json:"accessTokenInactivityTimeoutSeconds"`
 }
 
 // SessionConfig specifies options for cookie-based sessions. Used by AuthRequestHandlerSession
@@ -801,6 +881,10 @@ type OpenIDIdentityProvider struct {
 	// ExtraAuthorizeParameters are any custom parameters to add to the authorize request.
 	ExtraAuthorizeParameters map[string]string `json:"extraAuthorizeParameters"`
 
+	// Issuer is the optional URL of the OpenID provider. If set, the authorize, token and userinfo
+	// URLs left empty are read from the discovery document at <issuer>/.well-known/openid-configuration
+	Issuer string `json:"issuer"`
+
 	// URLs to use to authenticate
 	URLs OpenIDURLs `json:"urls"`
 
@@ -834,6 +918,9 @@ type OpenIDClaims struct {
 	// Email is the list of claims whose values should be used as the email address. Optional.
 	// If unspecified, no email is set for the identity
 	Email []string `json:"email"`
+	// Groups is the list of claims whose values should be used as the names of the groups the user is a member of. Optional.
+	// Groups are created as needed and the membership of the user is updated on every login
+	Groups []string `json:"groups"`
 }
 
 // GrantConfig holds the necessary configuration options for grant handlers
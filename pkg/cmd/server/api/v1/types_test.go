@@ -102,6 +102,21 @@ assetConfig:
     maxRequestsInFlight: 0
     namedCertificates: null
     requestTimeoutSeconds: 0
+authConfig:
+  webhookTokenAuthenticators:
+  - ca: ""
+    cacheSize: 0
+    cacheTTL: ""
+    certFile: ""
+    failurePolicy: ""
+    keyFile: ""
+    url: ""
+controllerConfig:
+  ldapGroupSync: null
+  serviceServingCert:
+    signer:
+      certFile: ""
+      keyFile: ""
 controllerLeaseTTL: 0
 controllers: ""
 corsAllowedOrigins: null
@@ -142,10 +157,12 @@ imageConfig:
   format: ""
   latest: false
 imagePolicyConfig:
+  allowTagUpdateNotificationAnnotation: false
   disableScheduledImport: false
   maxImagesBulkImportedPerRepository: 0
   maxScheduledImageImportsPerMinute: 0
   scheduledImageImportMinimumIntervalSeconds: 0
+  tagUpdateNotificationURL: ""
 kind: MasterConfig
 kubeletClientInfo:
   ca: ""
@@ -373,6 +390,7 @@ oauthConfig:
       ca: ""
       claims:
         email: null
+        groups: null
         id: null
         name: null
         preferredUsername: null
@@ -380,6 +398,7 @@ oauthConfig:
       clientSecret: ""
       extraAuthorizeParameters: null
       extraScopes: null
+      issuer: ""
       kind: OpenIDIdentityProvider
       urls:
         authorize: ""
@@ -394,6 +413,7 @@ oauthConfig:
       ca: ""
       claims:
         email: null
+        groups: null
         id: null
         name: null
         preferredUsername: null
@@ -405,6 +425,7 @@ oauthConfig:
         value: ""
       extraAuthorizeParameters: null
       extraScopes: null
+      issuer: ""
       kind: OpenIDIdentityProvider
       urls:
         authorize: ""
@@ -422,6 +443,7 @@ oauthConfig:
     login: ""
     providerSelection: ""
   tokenConfig:
+    accessTokenInactivityTimeoutSeconds: 0
     accessTokenMaxAgeSeconds: 0
     authorizeTokenMaxAgeSeconds: 0
 pauseControllers: false
@@ -630,6 +652,14 @@ func TestMasterConfig(t *testing.T) {
 			},
 		},
 		EtcdConfig: &internal.EtcdConfig{},
+		AuthConfig: internal.MasterAuthConfig{
+			WebhookTokenAuthenticators: []internal.WebhookTokenAuthenticator{{}},
+		},
+		ControllerConfig: internal.ControllerConfig{
+			ServiceServingCert: internal.ServiceServingCert{
+				Signer: &internal.CertInfo{},
+			},
+		},
 		OAuthConfig: &internal.OAuthConfig{
 			IdentityProviders: []internal.IdentityProvider{
 				{Provider: &internal.BasicAuthPasswordIdentityProvider{}},
@@ -11,6 +11,17 @@ import (
 	"github.com/openshift/origin/pkg/cmd/server/api"
 )
 
+func ValidateLDAPGroupSyncControllerConfig(config *api.LDAPGroupSyncControllerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidateFile(config.SyncConfigFile, fldPath.Child("syncConfigFile"))...)
+	if config.SyncIntervalSeconds <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncIntervalSeconds"), config.SyncIntervalSeconds, "must be greater than zero"))
+	}
+
+	return allErrs
+}
+
 func ValidateLDAPSyncConfig(config *api.LDAPSyncConfig) ValidationResults {
 	validationResults := ValidationResults{}
 
@@ -170,6 +170,14 @@ func ValidateMasterConfig(config *api.MasterConfig, fldPath *field.Path) Validat
 	}
 
 	validationResults.Append(ValidateServiceAccountConfig(config.ServiceAccountConfig, builtInKubernetes, fldPath.Child("serviceAccountConfig")))
+	validationResults.AddErrors(ValidateMasterAuthConfig(config.AuthConfig, fldPath.Child("authConfig"))...)
+
+	if config.ControllerConfig.ServiceServingCert.Signer != nil {
+		validationResults.AddErrors(ValidateCertInfo(*config.ControllerConfig.ServiceServingCert.Signer, true, fldPath.Child("controllerConfig", "serviceServingCert", "signer"))...)
+	}
+	if config.ControllerConfig.LDAPGroupSync != nil {
+		validationResults.AddErrors(ValidateLDAPGroupSyncControllerConfig(config.ControllerConfig.LDAPGroupSync, fldPath.Child("controllerConfig", "ldapGroupSync"))...)
+	}
 
 	validationResults.Append(ValidateHTTPServingInfo(config.ServingInfo, fldPath.Child("servingInfo")))
 
@@ -248,6 +256,53 @@ func ValidateStorageVersionLevel(level string, knownAPILevels, deadAPILevels []s
 	return allErrs
 }
 
+var validWebhookFailurePolicies = sets.NewString(string(api.WebhookFailurePolicyFail), string(api.WebhookFailurePolicyIgnore))
+
+func ValidateMasterAuthConfig(config api.MasterAuthConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, webhook := range config.WebhookTokenAuthenticators {
+		allErrs = append(allErrs, ValidateWebhookTokenAuthenticator(webhook, fldPath.Child("webhookTokenAuthenticators").Index(i))...)
+	}
+
+	return allErrs
+}
+
+func ValidateWebhookTokenAuthenticator(config api.WebhookTokenAuthenticator, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(config.URL) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), ""))
+	} else {
+		// Bearer tokens are sent to the token review service, don't let them go over an insecure connection
+		_, urlErrs := ValidateSecureURL(config.URL, fldPath.Child("url"))
+		allErrs = append(allErrs, urlErrs...)
+	}
+	if len(config.CA) > 0 {
+		allErrs = append(allErrs, ValidateFile(config.CA, fldPath.Child("ca"))...)
+	}
+	allErrs = append(allErrs, ValidateCertInfo(config.ClientCert, false, fldPath)...)
+
+	cacheTTLPath := fldPath.Child("cacheTTL")
+	if len(config.CacheTTL) == 0 {
+		allErrs = append(allErrs, field.Required(cacheTTLPath, ""))
+	} else if ttl, err := time.ParseDuration(config.CacheTTL); err != nil {
+		allErrs = append(allErrs, field.Invalid(cacheTTLPath, config.CacheTTL, fmt.Sprintf("%v", err)))
+	} else if ttl < 0 {
+		allErrs = append(allErrs, field.Invalid(cacheTTLPath, config.CacheTTL, "cannot be less than zero"))
+	}
+
+	if config.CacheSize <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cacheSize"), config.CacheSize, "must be greater than zero"))
+	}
+
+	if !validWebhookFailurePolicies.Has(string(config.FailurePolicy)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("failurePolicy"), config.FailurePolicy, validWebhookFailurePolicies.List()))
+	}
+
+	return allErrs
+}
+
 func ValidateServiceAccountConfig(config api.ServiceAccountConfig, builtInKubernetes bool, fldPath *field.Path) ValidationResults {
 	validationResults := ValidationResults{}
 
@@ -407,6 +462,10 @@ func ValidateImagePolicyConfig(config api.ImagePolicyConfig, fldPath *field.Path
 	if config.MaxScheduledImageImportsPerMinute == 0 || config.MaxScheduledImageImportsPerMinute < -1 {
 		errs = append(errs, field.Invalid(fldPath.Child("maxScheduledImageImportsPerMinute"), config.MaxScheduledImageImportsPerMinute, "must be a positive integer or -1"))
 	}
+	if len(config.TagUpdateNotificationURL) > 0 {
+		_, urlErrs := ValidateURL(config.TagUpdateNotificationURL, fldPath.Child("tagUpdateNotificationURL"))
+		errs = append(errs, urlErrs...)
+	}
 	return errs
 }
 
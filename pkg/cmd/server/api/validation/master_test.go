@@ -248,3 +248,56 @@ func TestValidateAdmissionPluginConfig(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateWebhookTokenAuthenticator(t *testing.T) {
+	valid := configapi.WebhookTokenAuthenticator{
+		URL:           "https://tokens.example.com/review",
+		CacheTTL:      "2m",
+		CacheSize:     1000,
+		FailurePolicy: configapi.WebhookFailurePolicyFail,
+	}
+
+	tests := map[string]struct {
+		mutate      func(config *configapi.WebhookTokenAuthenticator)
+		expectError bool
+	}{
+		"valid":            {mutate: func(config *configapi.WebhookTokenAuthenticator) {}},
+		"disabled caching": {mutate: func(config *configapi.WebhookTokenAuthenticator) { config.CacheTTL = "0s" }},
+		"missing url": {
+			mutate:      func(config *configapi.WebhookTokenAuthenticator) { config.URL = "" },
+			expectError: true,
+		},
+		"insecure url": {
+			mutate:      func(config *configapi.WebhookTokenAuthenticator) { config.URL = "http://tokens.example.com/review" },
+			expectError: true,
+		},
+		"invalid cache ttl": {
+			mutate:      func(config *configapi.WebhookTokenAuthenticator) { config.CacheTTL = "forever" },
+			expectError: true,
+		},
+		"invalid cache size": {
+			mutate:      func(config *configapi.WebhookTokenAuthenticator) { config.CacheSize = 0 },
+			expectError: true,
+		},
+		"invalid failure policy": {
+			mutate:      func(config *configapi.WebhookTokenAuthenticator) { config.FailurePolicy = "Retry" },
+			expectError: true,
+		},
+		"client cert without key": {
+			mutate:      func(config *configapi.WebhookTokenAuthenticator) { config.ClientCert.CertFile = "/missing/cert" },
+			expectError: true,
+		},
+	}
+
+	for k, tc := range tests {
+		config := valid
+		tc.mutate(&config)
+		errs := ValidateWebhookTokenAuthenticator(config, nil)
+		if len(errs) > 0 && !tc.expectError {
+			t.Errorf("%s: unexpected errors: %v", k, errs)
+		}
+		if len(errs) == 0 && tc.expectError {
+			t.Errorf("%s: expected an error", k)
+		}
+	}
+}
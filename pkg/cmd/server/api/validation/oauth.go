@@ -46,18 +46,16 @@ func ValidateOAuthConfig(config *api.OAuthConfig, fldPath *field.Path) Validatio
 		validationResults.AddErrors(validateSessionConfig(config.SessionConfig, fldPath.Child("sessionConfig"))...)
 	}
 
+	validationResults.AddErrors(validateTokenConfig(config.TokenConfig, fldPath.Child("tokenConfig"))...)
+
 	validationResults.AddErrors(validateGrantConfig(config.GrantConfig, fldPath.Child("grantConfig"))...)
 
 	providerNames := sets.NewString()
-	redirectingIdentityProviders := []string{}
-
 	challengeIssuingIdentityProviders := []string{}
 	challengeRedirectingIdentityProviders := []string{}
 
 	for i, identityProvider := range config.IdentityProviders {
 		if identityProvider.UseAsLogin {
-			redirectingIdentityProviders = append(redirectingIdentityProviders, identityProvider.Name)
-
 			if api.IsPasswordAuthenticator(identityProvider) {
 				if config.SessionConfig == nil {
 					validationResults.AddErrors(field.Invalid(fldPath.Child("sessionConfig"), config, "sessionConfig is required if a password identity provider is used for browser based login"))
@@ -86,9 +84,6 @@ func ValidateOAuthConfig(config *api.OAuthConfig, fldPath *field.Path) Validatio
 		}
 	}
 
-	if len(redirectingIdentityProviders) > 1 {
-		validationResults.AddErrors(field.Invalid(fldPath.Child("identityProviders"), "login", fmt.Sprintf("only one identity provider can support login for a browser, found: %v", strings.Join(redirectingIdentityProviders, ", "))))
-	}
 	if len(challengeRedirectingIdentityProviders) > 1 {
 		validationResults.AddErrors(field.Invalid(fldPath.Child("identityProviders"), "challenge", fmt.Sprintf("only one identity provider can redirect clients requesting an authentication challenge, found: %v", strings.Join(challengeRedirectingIdentityProviders, ", "))))
 	}
@@ -331,22 +326,38 @@ func ValidateOpenIDIdentityProvider(provider *api.OpenIDIdentityProvider, identi
 
 	allErrs = append(allErrs, ValidateOAuthIdentityProvider(provider.ClientID, provider.ClientSecret, identityProvider.UseAsChallenger, fieldPath)...)
 
-	// Communication with the Authorization Endpoint MUST utilize TLS
-	// http://openid.net/specs/openid-connect-core-1_0.html#AuthorizationEndpoint
 	providerPath := fieldPath.Child("provider")
 	urlsPath := providerPath.Child("urls")
-	_, urlErrs := ValidateSecureURL(provider.URLs.Authorize, urlsPath.Child("authorize"))
-	allErrs = append(allErrs, urlErrs...)
+
+	// The issuer URL MUST use the https scheme and contain no query or fragment components
+	// http://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata
+	if len(provider.Issuer) != 0 {
+		issuerURL, urlErrs := ValidateSecureURL(provider.Issuer, providerPath.Child("issuer"))
+		allErrs = append(allErrs, urlErrs...)
+		if len(urlErrs) == 0 && (len(issuerURL.RawQuery) != 0 || len(issuerURL.Fragment) != 0) {
+			allErrs = append(allErrs, field.Invalid(providerPath.Child("issuer"), provider.Issuer, "must not contain a query or fragment"))
+		}
+	}
+
+	// Communication with the Authorization Endpoint MUST utilize TLS
+	// http://openid.net/specs/openid-connect-core-1_0.html#AuthorizationEndpoint
+	// The endpoint may be omitted if it is read from the discovery document of the issuer
+	if len(provider.Issuer) == 0 || len(provider.URLs.Authorize) != 0 {
+		_, urlErrs := ValidateSecureURL(provider.URLs.Authorize, urlsPath.Child("authorize"))
+		allErrs = append(allErrs, urlErrs...)
+	}
 
 	// Communication with the Token Endpoint MUST utilize TLS
 	// http://openid.net/specs/openid-connect-core-1_0.html#TokenEndpoint
-	_, urlErrs = ValidateSecureURL(provider.URLs.Token, urlsPath.Child("token"))
-	allErrs = append(allErrs, urlErrs...)
+	if len(provider.Issuer) == 0 || len(provider.URLs.Token) != 0 {
+		_, urlErrs := ValidateSecureURL(provider.URLs.Token, urlsPath.Child("token"))
+		allErrs = append(allErrs, urlErrs...)
+	}
 
 	if len(provider.URLs.UserInfo) != 0 {
 		// Communication with the UserInfo Endpoint MUST utilize TLS
 		// http://openid.net/specs/openid-connect-core-1_0.html#UserInfo
-		_, urlErrs = ValidateSecureURL(provider.URLs.UserInfo, urlsPath.Child("userInfo"))
+		_, urlErrs := ValidateSecureURL(provider.URLs.UserInfo, urlsPath.Child("userInfo"))
 		allErrs = append(allErrs, urlErrs...)
 	}
 
@@ -372,6 +383,16 @@ func validateGrantConfig(config api.GrantConfig, fldPath *field.Path) field.Erro
 	return allErrs
 }
 
+func validateTokenConfig(config api.TokenConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.AccessTokenInactivityTimeoutSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("accessTokenInactivityTimeoutSeconds"), config.AccessTokenInactivityTimeoutSeconds, "must be greater than or equal to 0"))
+	}
+
+	return allErrs
+}
+
 func validateSessionConfig(config *api.SessionConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
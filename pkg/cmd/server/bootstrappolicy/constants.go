@@ -85,6 +85,18 @@ const (
 	NodeReaderRoleName = "system:node-reader"
 
 	OpenshiftSharedResourceViewRoleName = "shared-resource-viewer"
+
+	// The aggregate-to roles contribute the bootstrap rules of the admin, edit and view roles
+	AggregateToAdminRoleName = "system:openshift:aggregate-to-admin"
+	AggregateToEditRoleName  = "system:openshift:aggregate-to-edit"
+	AggregateToViewRoleName  = "system:openshift:aggregate-to-view"
+)
+
+// Labels selecting the cluster roles whose rules are aggregated into the admin, edit and view roles
+const (
+	AggregateToAdminLabel = "authorization.openshift.io/aggregate-to-admin"
+	AggregateToEditLabel  = "authorization.openshift.io/aggregate-to-edit"
+	AggregateToViewLabel  = "authorization.openshift.io/aggregate-to-view"
 )
 
 // RoleBindings
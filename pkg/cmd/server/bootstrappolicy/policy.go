@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/apis/autoscaling"
 	"k8s.io/kubernetes/pkg/apis/batch"
 	"k8s.io/kubernetes/pkg/apis/extensions"
@@ -452,7 +453,7 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 				},
 				{
 					Verbs:     sets.NewString("list"),
-					Resources: sets.NewString("resourcequotas"),
+					Resources: sets.NewString("limitranges", "resourcequotas"),
 				},
 			},
 		},
@@ -754,6 +755,31 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 		}
 	}
 
+	// the rules of the admin, edit and view roles are aggregated from the cluster roles carrying their label, so that
+	// extensions can contribute rules to them.  Their bootstrap rules are contributed the same way.
+	aggregateTo := map[string]struct{ roleName, label string }{
+		AdminRoleName: {AggregateToAdminRoleName, AggregateToAdminLabel},
+		EditRoleName:  {AggregateToEditRoleName, AggregateToEditLabel},
+		ViewRoleName:  {AggregateToViewRoleName, AggregateToViewLabel},
+	}
+	for i := range roles {
+		aggregate, ok := aggregateTo[roles[i].Name]
+		if !ok {
+			continue
+		}
+
+		roles[i].AggregationRule = &authorizationapi.AggregationRule{
+			ClusterRoleSelectors: []unversioned.LabelSelector{{MatchLabels: map[string]string{aggregate.label: "true"}}},
+		}
+		roles = append(roles, authorizationapi.ClusterRole{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:   aggregate.roleName,
+				Labels: map[string]string{aggregate.label: "true"},
+			},
+			Rules: append([]authorizationapi.PolicyRule{}, roles[i].Rules...),
+		})
+	}
+
 	return roles
 }
 
@@ -77,6 +77,19 @@ func (c *TLSCertificateConfig) writeCertConfig(certFile, keyFile string) error {
 	}
 	return nil
 }
+// GetPEMBytes returns the PEM encoded certificates and key of the config.
+func (c *TLSCertificateConfig) GetPEMBytes() ([]byte, []byte, error) {
+	certBytes, err := encodeCertificates(c.Certs...)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyBytes, err := encodeKey(c.Key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return certBytes, keyBytes, nil
+}
+
 func (c *TLSCARoots) writeCARoots(rootFile string) error {
 	if err := writeCertificates(rootFile, c.Roots...); err != nil {
 		return err
@@ -323,20 +336,29 @@ func GetServerCert(certFile, keyFile string, hostnames sets.String) (*TLSCertifi
 func (ca *CA) MakeServerCert(certFile, keyFile string, hostnames sets.String) (*TLSCertificateConfig, error) {
 	glog.V(4).Infof("Generating server certificate in %s, key in %s", certFile, keyFile)
 
+	server, err := ca.MakeServerCertInMemory(hostnames)
+	if err != nil {
+		return nil, err
+	}
+	if err := server.writeCertConfig(certFile, keyFile); err != nil {
+		return server, err
+	}
+	return server, nil
+}
+
+// MakeServerCertInMemory returns a new server certificate for hostnames signed by
+// the CA, without writing it to disk.
+func (ca *CA) MakeServerCertInMemory(hostnames sets.String) (*TLSCertificateConfig, error) {
 	serverPublicKey, serverPrivateKey, _ := NewKeyPair()
 	serverTemplate, _ := newServerCertificateTemplate(pkix.Name{CommonName: hostnames.List()[0]}, hostnames.List())
 	serverCrt, err := ca.signCertificate(serverTemplate, serverPublicKey)
 	if err != nil {
 		return nil, err
 	}
-	server := &TLSCertificateConfig{
+	return &TLSCertificateConfig{
 		Certs: append([]*x509.Certificate{serverCrt}, ca.Config.Certs...),
 		Key:   serverPrivateKey,
-	}
-	if err := server.writeCertConfig(certFile, keyFile); err != nil {
-		return server, err
-	}
-	return server, nil
+	}, nil
 }
 
 func (ca *CA) EnsureClientCertificate(certFile, keyFile string, u user.Info) (*TLSCertificateConfig, bool, error) {
@@ -34,6 +34,7 @@ import (
 	"github.com/openshift/origin/pkg/auth/authenticator/password/ldappassword"
 	"github.com/openshift/origin/pkg/auth/authenticator/redirector"
 	"github.com/openshift/origin/pkg/auth/authenticator/request/basicauthrequest"
+	"github.com/openshift/origin/pkg/auth/authenticator/request/bearertoken"
 	"github.com/openshift/origin/pkg/auth/authenticator/request/headerrequest"
 	"github.com/openshift/origin/pkg/auth/authenticator/request/unionrequest"
 	"github.com/openshift/origin/pkg/auth/authenticator/request/x509request"
@@ -51,6 +52,7 @@ import (
 	"github.com/openshift/origin/pkg/auth/server/login"
 	"github.com/openshift/origin/pkg/auth/server/selectprovider"
 	"github.com/openshift/origin/pkg/auth/server/tokenrequest"
+	"github.com/openshift/origin/pkg/auth/server/tokenrevocation"
 	"github.com/openshift/origin/pkg/auth/userregistry/identitymapper"
 	configapi "github.com/openshift/origin/pkg/cmd/server/api"
 	cmdutil "github.com/openshift/origin/pkg/cmd/util"
@@ -65,6 +67,7 @@ import (
 	clientauthetcd "github.com/openshift/origin/pkg/oauth/registry/oauthclientauthorization/etcd"
 	"github.com/openshift/origin/pkg/oauth/server/osinserver"
 	"github.com/openshift/origin/pkg/oauth/server/osinserver/registrystorage"
+	saoauth "github.com/openshift/origin/pkg/serviceaccounts/oauthclient"
 )
 
 const (
@@ -75,6 +78,9 @@ const (
 	OpenShiftWebConsoleClientID  = "openshift-web-console"
 	OpenShiftBrowserClientID     = "openshift-browser-client"
 	OpenShiftCLIClientID         = "openshift-challenging-client"
+	// OpenShiftCLIBrowserClientID is the public client used by `oc login --web`, which receives the authorization
+	// code on a loopback address. It has no secret since it is shared by every installed CLI.
+	OpenShiftCLIBrowserClientID = "openshift-cli-client"
 )
 
 // InstallAPI registers endpoints for an OAuth2 server into the provided mux,
@@ -90,6 +96,8 @@ func (c *AuthConfig) InstallAPI(container *restful.Container) ([]string, error)
 	authorizeTokenRegistry := authorizetokenregistry.NewRegistry(authorizeTokenStorage)
 	clientStorage := clientetcd.NewREST(c.EtcdHelper)
 	clientRegistry := clientregistry.NewRegistry(clientStorage)
+	// Service accounts can act as OAuth clients, but cannot be managed as OAuth clients
+	saClientRegistry := saoauth.NewServiceAccountOAuthClientRegistry(clientRegistry, c.KubeClient, c.KubeClient, c.OpenShiftClient)
 	clientAuthStorage := clientauthetcd.NewREST(c.EtcdHelper)
 	clientAuthRegistry := clientauthregistry.NewRegistry(clientAuthStorage)
 
@@ -103,7 +111,7 @@ func (c *AuthConfig) InstallAPI(container *restful.Container) ([]string, error)
 		glog.Fatal(err)
 	}
 
-	storage := registrystorage.New(accessTokenRegistry, authorizeTokenRegistry, clientRegistry, registry.NewUserConversion())
+	storage := registrystorage.New(accessTokenRegistry, authorizeTokenRegistry, saClientRegistry, registry.NewUserConversion(), c.Options.TokenConfig.AccessTokenInactivityTimeoutSeconds, sets.NewString(OpenShiftCLIBrowserClientID))
 	config := osinserver.NewDefaultServerConfig()
 	if c.Options.TokenConfig.AuthorizeTokenMaxAgeSeconds > 0 {
 		config.AuthorizationExpiration = c.Options.TokenConfig.AuthorizeTokenMaxAgeSeconds
@@ -113,12 +121,13 @@ func (c *AuthConfig) InstallAPI(container *restful.Container) ([]string, error)
 	}
 
 	grantChecker := registry.NewClientAuthorizationGrantChecker(clientAuthRegistry)
-	grantHandler := c.getGrantHandler(mux, authRequestHandler, clientRegistry, clientAuthRegistry)
+	grantHandler := c.getGrantHandler(mux, authRequestHandler, saClientRegistry, clientAuthRegistry)
 
 	server := osinserver.New(
 		config,
 		storage,
 		osinserver.AuthorizeHandlers{
+			handlers.NewScopeAuthorizer(),
 			handlers.NewAuthorizeAuthenticator(
 				authRequestHandler,
 				authHandler,
@@ -163,16 +172,46 @@ func (c *AuthConfig) InstallAPI(container *restful.Container) ([]string, error)
 	tokenRequestEndpoints := tokenrequest.NewEndpoints(c.Options.MasterPublicURL, osOAuthClient)
 	tokenRequestEndpoints.Install(mux, OpenShiftOAuthAPIPrefix)
 
+	// Users revoke all of their sessions by presenting one of their tokens
+	revocationAuth := bearertoken.New(registry.NewTokenAuthenticator(accessTokenRegistry, c.UserRegistry, identitymapper.NoopGroupMapper{}, clientRegistry, c.Options.TokenConfig.AccessTokenInactivityTimeoutSeconds), false)
+	var sessionInvalidator tokenrevocation.SessionInvalidator
+	if c.SessionAuth != nil {
+		sessionInvalidator = c.SessionAuth
+	}
+	tokenRevocationEndpoints := tokenrevocation.NewEndpoints(revocationAuth, accessTokenRegistry, authorizeTokenRegistry, clientAuthRegistry, sessionInvalidator)
+	tokenRevocationEndpoints.Install(mux, OpenShiftOAuthAPIPrefix)
+
 	// glog.Infof("oauth server configured as: %#v", server)
 	// glog.Infof("auth handler: %#v", authHandler)
 	// glog.Infof("auth request handler: %#v", authRequestHandler)
 	// glog.Infof("grant checker: %#v", grantChecker)
 	// glog.Infof("grant handler: %#v", grantHandler)
 
-	return []string{
-		fmt.Sprintf("Started OAuth2 API at %%s%s", OpenShiftOAuthAPIPrefix),
-		fmt.Sprintf("Started Login endpoint at %%s%s", OpenShiftLoginPrefix),
-	}, nil
+	messages := []string{fmt.Sprintf("Started OAuth2 API at %%s%s", OpenShiftOAuthAPIPrefix)}
+	loginPaths := loginPaths(c.Options.IdentityProviders)
+	for _, identityProvider := range c.Options.IdentityProviders {
+		for _, loginPath := range loginPaths[identityProvider.Name] {
+			messages = append(messages, fmt.Sprintf("Started Login endpoint for %s at %%s%s", identityProvider.Name, loginPath))
+		}
+	}
+	return messages, nil
+}
+
+// loginPaths returns the paths of the login pages of the password identity providers used for login, by provider
+// name. Every provider gets its own login page, so several of them can be offered for login. The first one also
+// serves its login page at OpenShiftLoginPrefix, where the login page was before.
+func loginPaths(identityProviders []configapi.IdentityProvider) map[string][]string {
+	paths := map[string][]string{}
+	for _, identityProvider := range identityProviders {
+		if !identityProvider.UseAsLogin || !configapi.IsPasswordAuthenticator(identityProvider) {
+			continue
+		}
+		paths[identityProvider.Name] = []string{path.Join(OpenShiftLoginPrefix, identityProvider.Name)}
+		if len(paths) == 1 {
+			paths[identityProvider.Name] = append(paths[identityProvider.Name], OpenShiftLoginPrefix)
+		}
+	}
+	return paths
 }
 
 func (c *AuthConfig) getErrorHandler() (*errorpage.ErrorPage, error) {
@@ -210,6 +249,9 @@ func OpenShiftOAuthTokenURL(masterAddr string) string {
 func OpenShiftOAuthTokenRequestURL(masterAddr string) string {
 	return masterAddr + path.Join(OpenShiftOAuthAPIPrefix, tokenrequest.RequestTokenEndpoint)
 }
+func OpenShiftOAuthTokenRevocationURL(masterAddr string) string {
+	return masterAddr + path.Join(OpenShiftOAuthAPIPrefix, tokenrevocation.RevokeTokensEndpoint)
+}
 
 func ensureOAuthClient(client oauthapi.OAuthClient, clientRegistry clientregistry.Registry, preserveExistingRedirects bool) error {
 	ctx := kapi.NewContext()
@@ -288,6 +330,18 @@ func CreateOrUpdateDefaultOAuthClients(masterPublicAddr string, assetPublicAddre
 		}
 	}
 
+	{
+		cliBrowserClient := oauthapi.OAuthClient{
+			ObjectMeta:            kapi.ObjectMeta{Name: OpenShiftCLIBrowserClientID},
+			RespondWithChallenges: false,
+			// Any port of the loopback addresses is allowed, the CLI listens on an ephemeral port
+			RedirectURIs: []string{"http://127.0.0.1/callback", "http://[::1]/callback"},
+		}
+		if err := ensureOAuthClient(cliBrowserClient, clientRegistry, false); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -318,12 +372,11 @@ func (c *AuthConfig) getGrantHandler(mux cmdutil.Mux, auth authenticator.Request
 		return handlers.NewEmptyGrant()
 
 	case configapi.GrantHandlerAuto:
-		return handlers.NewAutoGrant()
+		// Service accounts are controlled by the users of their namespace, users must always approve them explicitly
+		return handlers.NewClientFilteredGrant(saoauth.IsServiceAccountClient, c.getPromptGrantHandler(mux, auth, clientregistry, authregistry), handlers.NewAutoGrant())
 
 	case configapi.GrantHandlerPrompt:
-		grantServer := grant.NewGrant(c.getCSRF(), auth, grant.DefaultFormRenderer, clientregistry, authregistry)
-		grantServer.Install(mux, OpenShiftApprovePrefix)
-		return handlers.NewRedirectGrant(OpenShiftApprovePrefix)
+		return c.getPromptGrantHandler(mux, auth, clientregistry, authregistry)
 
 	default:
 		glog.Fatalf("No grant handler found that matches %v.  The oauth server cannot start!", c.Options.GrantConfig.Method)
@@ -332,6 +385,13 @@ func (c *AuthConfig) getGrantHandler(mux cmdutil.Mux, auth authenticator.Request
 	return nil
 }
 
+// getPromptGrantHandler installs the grant approval page and returns a grant handler redirecting to it
+func (c *AuthConfig) getPromptGrantHandler(mux cmdutil.Mux, auth authenticator.Request, clientregistry clientregistry.Registry, authregistry clientauthregistry.Registry) handlers.GrantHandler {
+	grantServer := grant.NewGrant(c.getCSRF(), auth, grant.DefaultFormRenderer, clientregistry, authregistry)
+	grantServer.Install(mux, OpenShiftApprovePrefix)
+	return handlers.NewRedirectGrant(OpenShiftApprovePrefix)
+}
+
 // getAuthenticationFinalizer returns an authentication finalizer which is called just prior to writing a response to an authorization request
 func (c *AuthConfig) getAuthenticationFinalizer() osinserver.AuthorizeHandler {
 	if c.SessionAuth != nil {
@@ -352,6 +412,7 @@ func (c *AuthConfig) getAuthenticationHandler(mux cmdutil.Mux, errorHandler hand
 	// TODO: make these ordered once we can have more than one
 	challengers := map[string]handlers.AuthenticationChallenger{}
 	redirectors := map[string]handlers.AuthenticationRedirector{}
+	loginPaths := loginPaths(c.Options.IdentityProviders)
 
 	for _, identityProvider := range c.Options.IdentityProviders {
 		identityMapper, err := identitymapper.NewIdentityUserMapper(c.IdentityRegistry, c.UserRegistry, identitymapper.MappingMethodType(identityProvider.MappingMethod))
@@ -359,6 +420,11 @@ func (c *AuthConfig) getAuthenticationHandler(mux cmdutil.Mux, errorHandler hand
 			return nil, err
 		}
 
+		// Sync the group memberships of users with the groups asserted by the provider
+		if provider, ok := identityProvider.Provider.(*configapi.OpenIDIdentityProvider); ok && len(provider.Claims.Groups) > 0 {
+			identityMapper = identitymapper.NewGroupSyncingIdentityMapper(identityMapper, c.GroupRegistry)
+		}
+
 		// TODO: refactor handler building per type
 		if configapi.IsPasswordAuthenticator(identityProvider) {
 			passwordAuth, err := c.getPasswordAuthenticator(identityProvider)
@@ -376,7 +442,8 @@ func (c *AuthConfig) getAuthenticationHandler(mux cmdutil.Mux, errorHandler hand
 				passwordSuccessHandler := handlers.AuthenticationSuccessHandlers{c.SessionAuth, redirectSuccessHandler{}}
 
 				// Since we're redirecting to a local login page, we don't need to force absolute URL resolution
-				redirectors[identityProvider.Name] = redirector.NewRedirector(nil, OpenShiftLoginPrefix+"?then=${url}")
+				providerLoginPaths := loginPaths[identityProvider.Name]
+				redirectors[identityProvider.Name] = redirector.NewRedirector(nil, providerLoginPaths[0]+"?then=${url}")
 
 				var loginTemplateFile string
 				if c.Options.Templates != nil {
@@ -388,7 +455,7 @@ func (c *AuthConfig) getAuthenticationHandler(mux cmdutil.Mux, errorHandler hand
 				}
 
 				login := login.NewLogin(identityProvider.Name, c.getCSRF(), &callbackPasswordAuthenticator{passwordAuth, passwordSuccessHandler}, loginFormRenderer)
-				login.Install(mux, OpenShiftLoginPrefix)
+				login.Install(mux, providerLoginPaths...)
 			}
 			if identityProvider.UseAsChallenger {
 				// For now, all password challenges share a single basic challenger, since they'll all respond to any basic credentials
@@ -512,6 +579,8 @@ func (c *AuthConfig) getOAuthProvider(identityProvider configapi.IdentityProvide
 
 			ExtraAuthorizeParameters: provider.ExtraAuthorizeParameters,
 
+			Issuer: provider.Issuer,
+
 			AuthorizeURL: provider.URLs.Authorize,
 			TokenURL:     provider.URLs.Token,
 			UserInfoURL:  provider.URLs.UserInfo,
@@ -520,6 +589,7 @@ func (c *AuthConfig) getOAuthProvider(identityProvider configapi.IdentityProvide
 			PreferredUsernameClaims: provider.Claims.PreferredUsername,
 			EmailClaims:             provider.Claims.Email,
 			NameClaims:              provider.Claims.Name,
+			GroupClaims:             provider.Claims.Groups,
 		}
 
 		return openid.NewProvider(identityProvider.Name, transport, config)
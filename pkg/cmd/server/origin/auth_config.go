@@ -8,12 +8,16 @@ import (
 	"github.com/pborman/uuid"
 
 	"k8s.io/kubernetes/pkg/api/unversioned"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/storage"
 
 	"github.com/openshift/origin/pkg/auth/server/session"
+	osclient "github.com/openshift/origin/pkg/client"
 	configapi "github.com/openshift/origin/pkg/cmd/server/api"
 	"github.com/openshift/origin/pkg/cmd/server/api/latest"
 	"github.com/openshift/origin/pkg/cmd/server/etcd"
+	groupregistry "github.com/openshift/origin/pkg/user/registry/group"
+	groupetcd "github.com/openshift/origin/pkg/user/registry/group/etcd"
 	identityregistry "github.com/openshift/origin/pkg/user/registry/identity"
 	identityetcd "github.com/openshift/origin/pkg/user/registry/identity/etcd"
 	userregistry "github.com/openshift/origin/pkg/user/registry/user"
@@ -36,6 +40,11 @@ type AuthConfig struct {
 
 	UserRegistry     userregistry.Registry
 	IdentityRegistry identityregistry.Registry
+	GroupRegistry    groupregistry.Registry
+
+	// KubeClient and OpenShiftClient are used to look up the service accounts acting as OAuth clients
+	KubeClient      *kclient.Client
+	OpenShiftClient *osclient.Client
 
 	SessionAuth *session.Authenticator
 }
@@ -85,10 +94,21 @@ func BuildAuthConfig(options configapi.MasterConfig) (*AuthConfig, error) {
 		assetPublicURLs = []string{options.OAuthConfig.AssetPublicURL, "http://localhost:9000", "https://localhost:9000"}
 	}
 
+	kubeClient, _, err := configapi.GetKubeClient(options.MasterClients.OpenShiftLoopbackKubeConfig)
+	if err != nil {
+		return nil, err
+	}
+	openshiftClient, _, err := configapi.GetOpenShiftClient(options.MasterClients.OpenShiftLoopbackKubeConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	userStorage := useretcd.NewREST(etcdHelper)
 	userRegistry := userregistry.NewRegistry(userStorage)
 	identityStorage := identityetcd.NewREST(etcdHelper)
 	identityRegistry := identityregistry.NewRegistry(identityStorage)
+	groupStorage := groupetcd.NewREST(etcdHelper)
+	groupRegistry := groupregistry.NewRegistry(groupStorage)
 
 	ret := &AuthConfig{
 		Options: *options.OAuthConfig,
@@ -99,6 +119,10 @@ func BuildAuthConfig(options configapi.MasterConfig) (*AuthConfig, error) {
 
 		IdentityRegistry: identityRegistry,
 		UserRegistry:     userRegistry,
+		GroupRegistry:    groupRegistry,
+
+		KubeClient:      kubeClient,
+		OpenShiftClient: openshiftClient,
 
 		SessionAuth: sessionAuth,
 	}
@@ -95,3 +95,19 @@ func TestGetValidSessionSecretsFile(t *testing.T) {
 		t.Errorf("Unexpected %v, got %v", expectedSecrets, readSecrets)
 	}
 }
+
+func TestLoginPaths(t *testing.T) {
+	identityProviders := []api.IdentityProvider{
+		{Name: "github", UseAsLogin: true, Provider: &api.GitHubIdentityProvider{}},
+		{Name: "htpasswd", UseAsLogin: true, Provider: &api.HTPasswdPasswordIdentityProvider{}},
+		{Name: "challenge-only", UseAsChallenger: true, Provider: &api.AllowAllPasswordIdentityProvider{}},
+		{Name: "ldap", UseAsLogin: true, Provider: &api.LDAPPasswordIdentityProvider{}},
+	}
+	expected := map[string][]string{
+		"htpasswd": {"/login/htpasswd", "/login"},
+		"ldap":     {"/login/ldap"},
+	}
+	if paths := loginPaths(identityProviders); !reflect.DeepEqual(paths, expected) {
+		t.Errorf("expected %v, got %v", expected, paths)
+	}
+}
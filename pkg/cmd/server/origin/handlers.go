@@ -16,11 +16,16 @@ import (
 	kapierrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/auth/user"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/serviceaccount"
 	"k8s.io/kubernetes/pkg/util/sets"
 
+	authenticationapi "github.com/openshift/origin/pkg/auth/api"
+	"github.com/openshift/origin/pkg/auth/userregistry/identitymapper"
 	"github.com/openshift/origin/pkg/authorization/authorizer"
 	configapi "github.com/openshift/origin/pkg/cmd/server/api"
+	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
 	"github.com/openshift/origin/pkg/util/httprequest"
 )
 
@@ -96,6 +101,93 @@ func (c *MasterConfig) authorizationFilter(handler http.Handler) http.Handler {
 	})
 }
 
+// impersonationFilter replaces the authenticated user with the user named in the Impersonate-User header, if the
+// authenticated user is allowed to impersonate them. Groups requested with Impersonate-Group headers must be
+// impersonated as well, otherwise the groups the impersonated user is a member of are used. The impersonated user
+// keeps the scopes of the authenticated user, so a token restricted to scopes cannot escape them by impersonating.
+func impersonationFilter(handler http.Handler, a authorizer.Authorizer, groupMapper identitymapper.UserToGroupMapper, contextMapper kapi.RequestContextMapper) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requestedUser := req.Header.Get(authenticationapi.ImpersonateUserHeader)
+		requestedGroups := req.Header[authenticationapi.ImpersonateGroupHeader]
+		if len(requestedUser) == 0 {
+			if len(requestedGroups) > 0 {
+				http.Error(w, fmt.Sprintf("%s requires %s", authenticationapi.ImpersonateGroupHeader, authenticationapi.ImpersonateUserHeader), http.StatusBadRequest)
+				return
+			}
+			handler.ServeHTTP(w, req)
+			return
+		}
+
+		ctx, exists := contextMapper.Get(req)
+		if !exists {
+			forbidden("context not found", nil, w, req)
+			return
+		}
+		oldUser, ok := kapi.UserFrom(ctx)
+		if !ok {
+			forbidden("user not found", nil, w, req)
+			return
+		}
+
+		// users and groups are cluster scoped, service accounts are impersonated in their namespace
+		userNamespace := kapi.NamespaceNone
+		userAttributes := authorizer.DefaultAuthorizationAttributes{Verb: "impersonate", Resource: "users", ResourceName: requestedUser}
+		groups := []string{}
+		if namespace, name, err := serviceaccount.SplitUsername(requestedUser); err == nil {
+			userNamespace = namespace
+			userAttributes = authorizer.DefaultAuthorizationAttributes{Verb: "impersonate", Resource: "serviceaccounts", ResourceName: name}
+			groups = append(groups, serviceaccount.MakeGroupNames(namespace, name)...)
+		}
+		if allowed, reason, err := a.Authorize(kapi.WithNamespace(ctx, userNamespace), userAttributes); err != nil || !allowed {
+			if err != nil {
+				reason = err.Error()
+			}
+			forbidden(reason, userAttributes, w, req)
+			return
+		}
+
+		for _, group := range requestedGroups {
+			groupAttributes := authorizer.DefaultAuthorizationAttributes{Verb: "impersonate", Resource: "groups", ResourceName: group}
+			if allowed, reason, err := a.Authorize(kapi.WithNamespace(ctx, kapi.NamespaceNone), groupAttributes); err != nil || !allowed {
+				if err != nil {
+					reason = err.Error()
+				}
+				forbidden(reason, groupAttributes, w, req)
+				return
+			}
+			groups = append(groups, group)
+		}
+		if len(requestedGroups) == 0 && len(userNamespace) == 0 {
+			memberships, err := groupMapper.GroupsFor(requestedUser)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("Unable to find the groups of %s: %v", requestedUser, err), http.StatusInternalServerError)
+				return
+			}
+			for _, group := range memberships {
+				groups = append(groups, group.Name)
+			}
+		}
+		groups = append(groups, bootstrappolicy.AuthenticatedGroup)
+
+		glog.V(2).Infof("%s is impersonating %s with groups %v", oldUser.GetName(), requestedUser, groups)
+		var newUser user.Info = &user.DefaultInfo{Name: requestedUser, Groups: groups}
+		if scopes := authenticationapi.ScopesFor(oldUser); len(scopes) > 0 {
+			newUser = &authenticationapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: requestedUser, Groups: groups}, Scopes: scopes}
+		}
+		if err := contextMapper.Update(req, kapi.WithUser(ctx, newUser)); err != nil {
+			glog.V(4).Infof("Error setting impersonated context: %v", err)
+			http.Error(w, "Unable to set impersonated request context", http.StatusInternalServerError)
+			return
+		}
+
+		// the headers were handled, they must not be interpreted again by the handlers or proxied backends
+		req.Header.Del(authenticationapi.ImpersonateUserHeader)
+		req.Header.Del(authenticationapi.ImpersonateGroupHeader)
+
+		handler.ServeHTTP(w, req)
+	})
+}
+
 // forbidden renders a simple forbidden error
 func forbidden(reason string, attributes authorizer.AuthorizationAttributes, w http.ResponseWriter, req *http.Request) {
 	kind := ""
@@ -4,10 +4,18 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
 
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authenticationapi "github.com/openshift/origin/pkg/auth/api"
+	"github.com/openshift/origin/pkg/authorization/authorizer"
 	configapi "github.com/openshift/origin/pkg/cmd/server/api"
+	userapi "github.com/openshift/origin/pkg/user/api"
 )
 
 var (
@@ -249,3 +257,168 @@ func TestVersionSkewFilterSkippedOnNonAPIRequest(t *testing.T) {
 		tc.Run(server.URL+"/api/v1", t)
 	}
 }
+
+type impersonateAuthorizer struct {
+	// allowed is the set of namespace/resource/name the user may impersonate
+	allowed sets.String
+}
+
+func (a *impersonateAuthorizer) Authorize(ctx kapi.Context, attributes authorizer.AuthorizationAttributes) (bool, string, error) {
+	namespace, _ := kapi.NamespaceFrom(ctx)
+	if attributes.GetVerb() == "impersonate" && a.allowed.Has(namespace+"/"+attributes.GetResource()+"/"+attributes.GetResourceName()) {
+		return true, "", nil
+	}
+	return false, "denied", nil
+}
+
+func (a *impersonateAuthorizer) GetAllowedSubjects(ctx kapi.Context, attributes authorizer.AuthorizationAttributes) (sets.String, sets.String, error) {
+	return sets.NewString(), sets.NewString(), nil
+}
+
+type impersonateGroupMapper struct{}
+
+func (impersonateGroupMapper) GroupsFor(username string) ([]*userapi.Group, error) {
+	return []*userapi.Group{{ObjectMeta: kapi.ObjectMeta{Name: username + "-group"}}}, nil
+}
+
+func TestImpersonationFilter(t *testing.T) {
+	testCases := []struct {
+		name   string
+		user   string
+		groups []string
+		// scopes restrict the authenticated user
+		scopes []string
+
+		expectedCode   int
+		expectedUser   string
+		expectedGroups []string
+		expectedScopes []string
+	}{
+		{
+			name:           "no impersonation",
+			expectedCode:   http.StatusOK,
+			expectedUser:   "admin",
+			expectedGroups: []string{"admins"},
+		},
+		{
+			name:         "group without user",
+			groups:       []string{"developers"},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:           "allowed user",
+			user:           "bob",
+			expectedCode:   http.StatusOK,
+			expectedUser:   "bob",
+			expectedGroups: []string{"bob-group", "system:authenticated"},
+		},
+		{
+			name:         "forbidden user",
+			user:         "alice",
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:           "allowed user and group",
+			user:           "bob",
+			groups:         []string{"developers"},
+			expectedCode:   http.StatusOK,
+			expectedUser:   "bob",
+			expectedGroups: []string{"developers", "system:authenticated"},
+		},
+		{
+			name:         "forbidden group",
+			user:         "bob",
+			groups:       []string{"admins"},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:           "allowed service account",
+			user:           "system:serviceaccount:myproject:builder",
+			expectedCode:   http.StatusOK,
+			expectedUser:   "system:serviceaccount:myproject:builder",
+			expectedGroups: []string{"system:serviceaccounts", "system:serviceaccounts:myproject", "system:authenticated"},
+		},
+		{
+			name:         "forbidden service account",
+			user:         "system:serviceaccount:otherproject:builder",
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:           "scoped user keeps its scopes",
+			user:           "bob",
+			scopes:         []string{"user:info"},
+			expectedCode:   http.StatusOK,
+			expectedUser:   "bob",
+			expectedGroups: []string{"bob-group", "system:authenticated"},
+			expectedScopes: []string{"user:info"},
+		},
+	}
+
+	contextMapper := kapi.NewRequestContextMapper()
+	a := &impersonateAuthorizer{allowed: sets.NewString("/users/bob", "/groups/developers", "myproject/serviceaccounts/builder")}
+	var actualUser user.Info
+	var actualHeaders http.Header
+	var authenticatedScopes []string
+	handler := impersonationFilter(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx, _ := contextMapper.Get(req)
+		actualUser, _ = kapi.UserFrom(ctx)
+		actualHeaders = req.Header
+	}), a, impersonateGroupMapper{}, contextMapper)
+	// the authenticated user is set before impersonation, as the authentication filter would do
+	handler = http.HandlerFunc(func(inner http.Handler) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			ctx, _ := contextMapper.Get(req)
+			var authenticated user.Info = &user.DefaultInfo{Name: "admin", Groups: []string{"admins"}}
+			if len(authenticatedScopes) > 0 {
+				authenticated = &authenticationapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "admin", Groups: []string{"admins"}}, Scopes: authenticatedScopes}
+			}
+			contextMapper.Update(req, kapi.WithUser(ctx, authenticated))
+			inner.ServeHTTP(w, req)
+		}
+	}(handler))
+	handler, err := kapi.NewRequestContextFilter(contextMapper, handler)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	for _, tc := range testCases {
+		actualUser = nil
+		actualHeaders = nil
+		authenticatedScopes = tc.scopes
+		req, _ := http.NewRequest("GET", server.URL+"/api/v1/namespaces/myproject/pods", nil)
+		if len(tc.user) > 0 {
+			req.Header.Set(authenticationapi.ImpersonateUserHeader, tc.user)
+		}
+		for _, group := range tc.groups {
+			req.Header.Add(authenticationapi.ImpersonateGroupHeader, group)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != tc.expectedCode {
+			t.Errorf("%s: expected code %d, got %d", tc.name, tc.expectedCode, resp.StatusCode)
+			continue
+		}
+		if tc.expectedCode != http.StatusOK {
+			if actualUser != nil {
+				t.Errorf("%s: expected the request to be rejected, got user %v", tc.name, actualUser)
+			}
+			continue
+		}
+		if actualUser == nil || actualUser.GetName() != tc.expectedUser || !reflect.DeepEqual(actualUser.GetGroups(), tc.expectedGroups) {
+			t.Errorf("%s: expected %s with groups %v, got %v", tc.name, tc.expectedUser, tc.expectedGroups, actualUser)
+		}
+		if scopes := authenticationapi.ScopesFor(actualUser); !reflect.DeepEqual(scopes, tc.expectedScopes) {
+			t.Errorf("%s: expected scopes %v, got %v", tc.name, tc.expectedScopes, scopes)
+		}
+		if len(actualHeaders.Get(authenticationapi.ImpersonateUserHeader)) > 0 || len(actualHeaders[authenticationapi.ImpersonateGroupHeader]) > 0 {
+			t.Errorf("%s: expected the impersonation headers to be removed, got %v", tc.name, actualHeaders)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"time"
 
 	newetcdclient "github.com/coreos/etcd/client"
 	etcdclient "github.com/coreos/go-etcd/etcd"
@@ -31,10 +32,13 @@ import (
 	"github.com/openshift/origin/pkg/auth/authenticator/request/paramtoken"
 	"github.com/openshift/origin/pkg/auth/authenticator/request/unionrequest"
 	"github.com/openshift/origin/pkg/auth/authenticator/request/x509request"
+	authncache "github.com/openshift/origin/pkg/auth/authenticator/token/cache"
+	"github.com/openshift/origin/pkg/auth/authenticator/token/webhook"
 	"github.com/openshift/origin/pkg/auth/group"
 	authnregistry "github.com/openshift/origin/pkg/auth/oauth/registry"
 	"github.com/openshift/origin/pkg/auth/userregistry/identitymapper"
 	"github.com/openshift/origin/pkg/authorization/authorizer"
+	"github.com/openshift/origin/pkg/authorization/authorizer/scope"
 	policycache "github.com/openshift/origin/pkg/authorization/cache"
 	policyclient "github.com/openshift/origin/pkg/authorization/client"
 	clusterpolicyregistry "github.com/openshift/origin/pkg/authorization/registry/clusterpolicy"
@@ -51,11 +55,14 @@ import (
 	configapi "github.com/openshift/origin/pkg/cmd/server/api"
 	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
 	"github.com/openshift/origin/pkg/cmd/server/etcd"
+	cmdutil "github.com/openshift/origin/pkg/cmd/util"
 	"github.com/openshift/origin/pkg/cmd/util/plug"
 	"github.com/openshift/origin/pkg/cmd/util/pluginconfig"
 	"github.com/openshift/origin/pkg/cmd/util/variable"
 	accesstokenregistry "github.com/openshift/origin/pkg/oauth/registry/oauthaccesstoken"
 	accesstokenetcd "github.com/openshift/origin/pkg/oauth/registry/oauthaccesstoken/etcd"
+	clientregistry "github.com/openshift/origin/pkg/oauth/registry/oauthclient"
+	clientetcd "github.com/openshift/origin/pkg/oauth/registry/oauthclient/etcd"
 	projectauth "github.com/openshift/origin/pkg/project/auth"
 	projectcache "github.com/openshift/origin/pkg/project/cache"
 	"github.com/openshift/origin/pkg/serviceaccounts"
@@ -175,7 +182,7 @@ func BuildMasterConfig(options configapi.MasterConfig) (*MasterConfig, error) {
 	kubeletClientConfig := configapi.GetKubeletClientConfig(options)
 
 	// in-order list of plug-ins that should intercept admission decisions (origin only intercepts)
-	admissionControlPluginNames := []string{"ProjectRequestLimit", "OriginNamespaceLifecycle", "PodNodeConstraints", "BuildByStrategy", "OriginResourceQuota"}
+	admissionControlPluginNames := []string{"ProjectRequestLimit", "OriginNamespaceLifecycle", "PodNodeConstraints", "BuildByStrategy", "ImageStreamCrossNamespaceAccess", "OriginResourceQuota"}
 	if len(options.AdmissionConfig.PluginOrderOverride) > 0 {
 		admissionControlPluginNames = options.AdmissionConfig.PluginOrderOverride
 	}
@@ -311,7 +318,7 @@ func newAuthenticator(config configapi.MasterConfig, etcdHelper storage.Interfac
 
 	// OAuth token
 	if config.OAuthConfig != nil {
-		tokenAuthenticator := getEtcdTokenAuthenticator(etcdHelper, groupMapper)
+		tokenAuthenticator := getEtcdTokenAuthenticator(etcdHelper, groupMapper, config.OAuthConfig.TokenConfig.AccessTokenInactivityTimeoutSeconds)
 		tokenRequestAuthenticators := []authenticator.Request{
 			bearertoken.New(tokenAuthenticator, true),
 			// Allow token as access_token param for WebSockets
@@ -323,6 +330,15 @@ func newAuthenticator(config configapi.MasterConfig, etcdHelper storage.Interfac
 			group.NewGroupAdder(unionrequest.NewUnionAuthentication(tokenRequestAuthenticators...), []string{bootstrappolicy.AuthenticatedOAuthGroup}))
 	}
 
+	// Tokens reviewed by external services
+	for _, webhookConfig := range config.AuthConfig.WebhookTokenAuthenticators {
+		tokenAuthenticator, err := newWebhookTokenAuthenticator(webhookConfig)
+		if err != nil {
+			glog.Fatalf("Error building the webhook token authenticator for %s: %v", webhookConfig.URL, err)
+		}
+		authenticators = append(authenticators, bearertoken.New(tokenAuthenticator, true))
+	}
+
 	if configapi.UseTLS(config.ServingInfo.ServingInfo) {
 		// build cert authenticator
 		// TODO: add "system:" prefix in authenticator, limit cert to username
@@ -344,6 +360,32 @@ func newAuthenticator(config configapi.MasterConfig, etcdHelper storage.Interfac
 	return ret
 }
 
+// newWebhookTokenAuthenticator returns a token authenticator using the token review service described by config,
+// caching its results
+func newWebhookTokenAuthenticator(config configapi.WebhookTokenAuthenticator) (authenticator.Token, error) {
+	transport, err := cmdutil.TransportFor(config.CA, config.ClientCert.CertFile, config.ClientCert.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	var tokenAuthenticator authenticator.Token = webhook.NewAuthenticator(config.URL, transport)
+
+	ttl, err := time.ParseDuration(config.CacheTTL)
+	if err != nil {
+		return nil, err
+	}
+	if ttl > 0 && config.CacheSize > 0 {
+		tokenAuthenticator, err = authncache.NewAuthenticator(tokenAuthenticator, ttl, config.CacheSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Failures are ignored outside of the cache, so they are not cached
+	if config.FailurePolicy == configapi.WebhookFailurePolicyIgnore {
+		tokenAuthenticator = webhook.IgnoreErrors(tokenAuthenticator)
+	}
+	return tokenAuthenticator, nil
+}
+
 func newProjectAuthorizationCache(authorizer authorizer.Authorizer, kubeClient *kclient.Client, policyClient policyclient.ReadOnlyPolicyClient) *projectauth.AuthorizationCache {
 	return projectauth.NewAuthorizationCache(
 		projectauth.NewAuthorizerReviewer(authorizer),
@@ -371,7 +413,8 @@ func newAuthorizer(policyClient policyclient.ReadOnlyPolicyClient, projectReques
 		rulevalidation.ClusterPolicyGetter(policyClient),
 		rulevalidation.ClusterBindingLister(policyClient),
 	), authorizer.NewForbiddenMessageResolver(projectRequestDenyMessage))
-	return authorizer
+	// the actions of users authenticated with scoped tokens are further restricted to their scopes
+	return scope.NewAuthorizer(authorizer, rulevalidation.ClusterPolicyGetter(policyClient))
 }
 
 func newAuthorizationAttributeBuilder(requestContextMapper kapi.RequestContextMapper) authorizer.AuthorizationAttributeBuilder {
@@ -379,14 +422,17 @@ func newAuthorizationAttributeBuilder(requestContextMapper kapi.RequestContextMa
 	return authorizationAttributeBuilder
 }
 
-func getEtcdTokenAuthenticator(etcdHelper storage.Interface, groupMapper identitymapper.UserToGroupMapper) authenticator.Token {
+func getEtcdTokenAuthenticator(etcdHelper storage.Interface, groupMapper identitymapper.UserToGroupMapper, inactivityTimeoutSeconds int32) authenticator.Token {
 	accessTokenStorage := accesstokenetcd.NewREST(etcdHelper)
 	accessTokenRegistry := accesstokenregistry.NewRegistry(accessTokenStorage)
 
+	clientStorage := clientetcd.NewREST(etcdHelper)
+	clientRegistry := clientregistry.NewRegistry(clientStorage)
+
 	userStorage := useretcd.NewREST(etcdHelper)
 	userRegistry := userregistry.NewRegistry(userStorage)
 
-	return authnregistry.NewTokenAuthenticator(accessTokenRegistry, userRegistry, groupMapper)
+	return authnregistry.NewTokenAuthenticator(accessTokenRegistry, userRegistry, groupMapper, clientRegistry, inactivityTimeoutSeconds)
 }
 
 // KubeClient returns the kubernetes client object
@@ -473,6 +519,33 @@ func (c *MasterConfig) ImageImportControllerClient() *osclient.Client {
 	return c.PrivilegedLoopbackOpenShiftClient
 }
 
+// TagUpdateNotificationControllerClient returns the client used by the tag update notification controller
+func (c *MasterConfig) TagUpdateNotificationControllerClient() *osclient.Client {
+	return c.PrivilegedLoopbackOpenShiftClient
+}
+
+// ClusterRoleAggregationControllerClient returns the client used by the cluster role aggregation controller
+func (c *MasterConfig) ClusterRoleAggregationControllerClient() *osclient.Client {
+	return c.PrivilegedLoopbackOpenShiftClient
+}
+
+// TemplateInstanceControllerClients returns the clients used by the template instance controller, which deletes
+// objects of any kind. The template instance storage only lets users delete TemplateInstances whose objects they
+// may delete themselves.
+func (c *MasterConfig) TemplateInstanceControllerClients() (*osclient.Client, *kclient.Client) {
+	return c.PrivilegedLoopbackOpenShiftClient, c.PrivilegedLoopbackKubernetesClient
+}
+
+// TemplateRepositoryControllerClient returns the client used by the template repository controller
+func (c *MasterConfig) TemplateRepositoryControllerClient() *osclient.Client {
+	return c.PrivilegedLoopbackOpenShiftClient
+}
+
+// LDAPGroupSyncControllerClient returns the client used by the LDAP group sync controller
+func (c *MasterConfig) LDAPGroupSyncControllerClient() *osclient.Client {
+	return c.PrivilegedLoopbackOpenShiftClient
+}
+
 // DeploymentConfigScaleClient returns the client used by the Scale subresource registry
 func (c *MasterConfig) DeploymentConfigScaleClient() *kclient.Client {
 	return c.PrivilegedLoopbackKubernetesClient
@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"net"
 	"path"
+	"strings"
 	"time"
 
 	"github.com/golang/glog"
@@ -23,9 +24,13 @@ import (
 	utilwait "k8s.io/kubernetes/pkg/util/wait"
 	serviceaccountadmission "k8s.io/kubernetes/plugin/pkg/admission/serviceaccount"
 
+	"github.com/openshift/origin/pkg/authorization/controller/clusterroleaggregation"
 	buildclient "github.com/openshift/origin/pkg/build/client"
 	buildcontrollerfactory "github.com/openshift/origin/pkg/build/controller/factory"
 	buildstrategy "github.com/openshift/origin/pkg/build/controller/strategy"
+	ldapsynccli "github.com/openshift/origin/pkg/cmd/admin/groups/sync/cli"
+	ldapsynccontroller "github.com/openshift/origin/pkg/cmd/admin/groups/sync/controller"
+	"github.com/openshift/origin/pkg/cmd/server/crypto"
 	"github.com/openshift/origin/pkg/cmd/server/etcd"
 	cmdutil "github.com/openshift/origin/pkg/cmd/util"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
@@ -36,11 +41,15 @@ import (
 	imagechangecontroller "github.com/openshift/origin/pkg/deploy/controller/imagechange"
 	"github.com/openshift/origin/pkg/dns"
 	imagecontroller "github.com/openshift/origin/pkg/image/controller"
+	"github.com/openshift/origin/pkg/image/controller/notification"
 	projectcontroller "github.com/openshift/origin/pkg/project/controller"
 	securitycontroller "github.com/openshift/origin/pkg/security/controller"
 	"github.com/openshift/origin/pkg/security/mcs"
 	"github.com/openshift/origin/pkg/security/uid"
 	"github.com/openshift/origin/pkg/security/uidallocator"
+	"github.com/openshift/origin/pkg/service/controller/servingcert"
+	"github.com/openshift/origin/pkg/template/controller/templateinstance"
+	"github.com/openshift/origin/pkg/template/controller/templaterepository"
 
 	"github.com/openshift/openshift-sdn/plugins/osdn/factory"
 	configapi "github.com/openshift/origin/pkg/cmd/server/api"
@@ -119,9 +128,21 @@ func (c *MasterConfig) RunServiceAccountTokensController() {
 		}
 	}
 
+	serviceServingCA := []byte{}
+	if signer := c.Options.ControllerConfig.ServiceServingCert.Signer; signer != nil && len(signer.CertFile) > 0 {
+		serviceServingCA, err = ioutil.ReadFile(signer.CertFile)
+		if err != nil {
+			glog.Fatalf("Error reading service serving signer ca file for Service Account Token Manager: %s: %v", signer.CertFile, err)
+		}
+		if _, err := util.CertsFromPEM(serviceServingCA); err != nil {
+			glog.Fatalf("Error parsing service serving signer ca file for Service Account Token Manager: %s: %v", signer.CertFile, err)
+		}
+	}
+
 	options := sacontroller.TokensControllerOptions{
-		TokenGenerator: serviceaccount.JWTTokenGenerator(privateKey),
-		RootCA:         rootCA,
+		TokenGenerator:   serviceaccount.JWTTokenGenerator(privateKey),
+		RootCA:           rootCA,
+		ServiceServingCA: serviceServingCA,
 	}
 
 	sacontroller.NewTokensController(internalclientset.FromUnversionedClient(c.KubeClient()), options).Run()
@@ -222,6 +243,7 @@ func (c *MasterConfig) RunBuildController() {
 		OSClient:     osclient,
 		KubeClient:   kclient,
 		BuildUpdater: buildclient.NewOSClientBuildClient(osclient),
+		BuildLister:  buildclient.NewOSClientBuildClient(osclient),
 		DockerBuildStrategy: &buildstrategy.DockerBuildStrategy{
 			Image: dockerImage,
 			// TODO: this will be set to --storage-version (the internal schema we use)
@@ -249,9 +271,11 @@ func (c *MasterConfig) RunBuildController() {
 func (c *MasterConfig) RunBuildPodController() {
 	osclient, kclient := c.BuildPodControllerClients()
 	factory := buildcontrollerfactory.BuildPodControllerFactory{
-		OSClient:     osclient,
-		KubeClient:   kclient,
-		BuildUpdater: buildclient.NewOSClientBuildClient(osclient),
+		OSClient:          osclient,
+		KubeClient:        kclient,
+		BuildUpdater:      buildclient.NewOSClientBuildClient(osclient),
+		BuildDeleter:      buildclient.NewOSClientBuildClient(osclient),
+		BuildConfigGetter: buildclient.NewOSClientBuildConfigClient(osclient),
 	}
 	controller := factory.Create()
 	controller.Run()
@@ -384,6 +408,35 @@ func (c *MasterConfig) RunImageImportController() {
 	}
 }
 
+// RunTagUpdateNotificationController starts the controller notifying webhooks of the tag updates of
+// image streams.
+func (c *MasterConfig) RunTagUpdateNotificationController() {
+	config := c.Options.ImagePolicyConfig
+	if len(config.TagUpdateNotificationURL) == 0 && !config.AllowTagUpdateNotificationAnnotation {
+		glog.V(3).Infof("Tag update notifications are disabled")
+		return
+	}
+	notification.NewTagUpdateNotificationController(c.TagUpdateNotificationControllerClient(), config.TagUpdateNotificationURL, config.AllowTagUpdateNotificationAnnotation, 10*time.Minute).Run()
+}
+
+// RunLDAPGroupSyncController starts the controller periodically syncing groups with an LDAP server.
+func (c *MasterConfig) RunLDAPGroupSyncController() {
+	config := c.Options.ControllerConfig.LDAPGroupSync
+	if config == nil {
+		glog.V(3).Infof("LDAP group sync is disabled")
+		return
+	}
+	syncConfig, err := ldapsynccli.DecodeSyncConfigFromFile(config.SyncConfigFile)
+	if err != nil {
+		glog.Fatalf("Unable to read the LDAP sync config: %v", err)
+	}
+	controller, err := ldapsynccontroller.NewLDAPGroupSyncController(syncConfig, c.LDAPGroupSyncControllerClient().Groups(), config.Prune, time.Duration(config.SyncIntervalSeconds)*time.Second)
+	if err != nil {
+		glog.Fatalf("Invalid LDAP sync config %s: %v", config.SyncConfigFile, err)
+	}
+	controller.Run()
+}
+
 // RunSecurityAllocationController starts the security allocation controller process.
 func (c *MasterConfig) RunSecurityAllocationController() {
 	alloc := c.Options.ProjectConfig.SecurityAllocator
@@ -435,6 +488,45 @@ func (c *MasterConfig) RunSecurityAllocationController() {
 	controller.Run()
 }
 
+// RunServiceServingCertController starts the service serving cert controller, which signs serving
+// certificates for services that request them.
+func (c *MasterConfig) RunServiceServingCertController() {
+	if c.Options.ControllerConfig.ServiceServingCert.Signer == nil {
+		glog.V(3).Infof("Service serving cert signer is disabled - no serving certificates will be created")
+		return
+	}
+	ca, err := crypto.GetCA(c.Options.ControllerConfig.ServiceServingCert.Signer.CertFile, c.Options.ControllerConfig.ServiceServingCert.Signer.KeyFile, "")
+	if err != nil {
+		glog.Fatalf("Error reading service serving cert signer: %v", err)
+	}
+	// certificates are valid for the names the cluster DNS serves services under
+	dnsConfig, err := dns.NewServerDefaults()
+	if err != nil {
+		glog.Fatalf("Error reading the cluster DNS domain for the service serving cert signer: %v", err)
+	}
+	dnsSuffix := strings.TrimSuffix(dnsConfig.Domain, ".")
+
+	servingcert.NewServiceServingCertController(c.KubeClient(), ca, dnsSuffix, 2*time.Minute).Run()
+}
+
+// RunClusterRoleAggregationController starts the controller keeping the rules of aggregating cluster roles in sync
+// with the cluster roles they select.
+func (c *MasterConfig) RunClusterRoleAggregationController() {
+	clusterroleaggregation.NewClusterRoleAggregationController(c.ClusterRoleAggregationControllerClient(), 10*time.Minute).Run()
+}
+
+// RunTemplateInstanceController starts the controller deleting the objects created by the instantiations of
+// templates whose TemplateInstances are deleted.
+func (c *MasterConfig) RunTemplateInstanceController() {
+	oc, kc := c.TemplateInstanceControllerClients()
+	templateinstance.NewTemplateInstanceController(oc, templateinstance.NewObjectDeleter(oc, kc), 10*time.Minute).Run()
+}
+
+// RunTemplateRepositoryController starts the controller synchronizing the templates of template repositories.
+func (c *MasterConfig) RunTemplateRepositoryController() {
+	templaterepository.NewTemplateRepositoryController(c.TemplateRepositoryControllerClient(), 15*time.Minute).Run()
+}
+
 // RunGroupCache starts the group cache
 func (c *MasterConfig) RunGroupCache() {
 	c.GroupCache.Run()
@@ -22,13 +22,14 @@ var admissionPluginsNotUsedByKube = sets.NewString(
 	"DenyExecOnPrivileged",   // from kube (deprecated, see below), it denies exec to pods that have certain privileges.  This is superseded in origin by SCCExecRestrictions that checks against SCC rules.
 	"DenyEscalatingExec",     // from kube, it denies exec to pods that have certain privileges.  This is superseded in origin by SCCExecRestrictions that checks against SCC rules.
 
-	"BuildByStrategy",          // from origin, only needed for managing builds, not kubernetes resources
-	"BuildDefaults",            // from origin, only needed for managing builds, not kubernetes resources
-	"BuildOverrides",           // from origin, only needed for managing builds, not kubernetes resources
-	"OriginNamespaceLifecycle", // from origin, only needed for rejecting openshift resources, so not needed by kube
-	"ProjectRequestLimit",      // from origin, used for limiting project requests by user (online use case)
-	"RunOnceDuration",          // from origin, used for overriding the ActiveDeadlineSeconds for run-once pods
-	"OriginResourceQuota",      // from origin, used for quota abuse checks of openshift resources
+	"BuildByStrategy",                 // from origin, only needed for managing builds, not kubernetes resources
+	"BuildDefaults",                   // from origin, only needed for managing builds, not kubernetes resources
+	"BuildOverrides",                  // from origin, only needed for managing builds, not kubernetes resources
+	"ImageStreamCrossNamespaceAccess", // from origin, only needed for managing builds and deployment configs, not kubernetes resources
+	"OriginNamespaceLifecycle",        // from origin, only needed for rejecting openshift resources, so not needed by kube
+	"ProjectRequestLimit",             // from origin, used for limiting project requests by user (online use case)
+	"RunOnceDuration",                 // from origin, used for overriding the ActiveDeadlineSeconds for run-once pods
+	"OriginResourceQuota",             // from origin, used for quota abuse checks of openshift resources
 
 	"NamespaceExists",  // superseded by NamespaceLifecycle
 	"InitialResources", // do we want this? https://github.com/kubernetes/kubernetes/blob/master/docs/proposals/initial-resources.md
@@ -261,6 +261,9 @@ func (args MasterArgs) BuildSerializeableMasterConfig() (*configapi.MasterConfig
 
 		config.AssetConfig.ServingInfo.ServerCert = admin.DefaultAssetServingCertInfo(args.ConfigDir.Value())
 
+		signerInfo := admin.DefaultServiceSignerCAInfo(args.ConfigDir.Value())
+		config.ControllerConfig.ServiceServingCert.Signer = &signerInfo
+
 		if oauthConfig != nil {
 			s := admin.DefaultCABundleFile(args.ConfigDir.Value())
 			oauthConfig.MasterCA = &s
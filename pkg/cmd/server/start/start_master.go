@@ -532,6 +532,8 @@ func startControllers(oc *origin.MasterConfig, kc *kubernetes.MasterConfig) erro
 	// used by admission controllers
 	oc.RunServiceAccountPullSecretsControllers()
 	oc.RunSecurityAllocationController()
+	oc.RunServiceServingCertController()
+	oc.RunClusterRoleAggregationController()
 
 	if kc != nil {
 		_, _, rcClient, err := oc.GetServiceAccountClients(bootstrappolicy.InfraReplicationControllerServiceAccountName)
@@ -623,6 +625,10 @@ func startControllers(oc *origin.MasterConfig, kc *kubernetes.MasterConfig) erro
 	oc.RunDeploymentConfigChangeController()
 	oc.RunDeploymentImageChangeTriggerController()
 	oc.RunImageImportController()
+	oc.RunTagUpdateNotificationController()
+	oc.RunLDAPGroupSyncController()
+	oc.RunTemplateInstanceController()
+	oc.RunTemplateRepositoryController()
 	oc.RunOriginNamespaceController()
 	oc.RunSDNController()
 
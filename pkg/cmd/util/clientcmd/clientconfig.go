@@ -1,11 +1,16 @@
 package clientcmd
 
 import (
+	"net/http"
+
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
+	authenticationapi "github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/cmd/cli/config"
+	"k8s.io/kubernetes/pkg/client/restclient"
 	"k8s.io/kubernetes/pkg/client/unversioned/clientcmd"
+	clientcmdapi "k8s.io/kubernetes/pkg/client/unversioned/clientcmd/api"
 )
 
 func DefaultClientConfig(flags *pflag.FlagSet) clientcmd.ClientConfig {
@@ -27,3 +32,71 @@ func DefaultClientConfig(flags *pflag.FlagSet) clientcmd.ClientConfig {
 
 	return clientConfig
 }
+
+// impersonatingClientConfig makes every request as the user and groups requested with --as and --as-group.
+// The server only honors the request if the authenticated user may impersonate them.
+type impersonatingClientConfig struct {
+	nested clientcmd.ClientConfig
+	user   string
+	groups []string
+}
+
+// newImpersonatingClientConfig wraps nested and binds the impersonation flags
+func newImpersonatingClientConfig(nested clientcmd.ClientConfig, flags *pflag.FlagSet) *impersonatingClientConfig {
+	c := &impersonatingClientConfig{nested: nested}
+	flags.StringVar(&c.user, "as", "", "Username to impersonate for the operation.")
+	flags.StringSliceVar(&c.groups, "as-group", []string{}, "Group to impersonate for the operation, this flag can be repeated to specify multiple groups. Requires --as.")
+	return c
+}
+
+// RawConfig calls the nested method
+func (c *impersonatingClientConfig) RawConfig() (clientcmdapi.Config, error) {
+	return c.nested.RawConfig()
+}
+
+// Namespace calls the nested method
+func (c *impersonatingClientConfig) Namespace() (string, bool, error) {
+	return c.nested.Namespace()
+}
+
+// ClientConfig returns the nested config, sending the impersonation headers if requested
+func (c *impersonatingClientConfig) ClientConfig() (*restclient.Config, error) {
+	cfg, err := c.nested.ClientConfig()
+	if err != nil || (len(c.user) == 0 && len(c.groups) == 0) {
+		return cfg, err
+	}
+
+	wrap := cfg.WrapTransport
+	user, groups := c.user, c.groups
+	cfg.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		if wrap != nil {
+			rt = wrap(rt)
+		}
+		return &impersonatingRoundTripper{user: user, groups: groups, delegate: rt}
+	}
+	return cfg, nil
+}
+
+// impersonatingRoundTripper sets the impersonation headers on every request
+type impersonatingRoundTripper struct {
+	user     string
+	groups   []string
+	delegate http.RoundTripper
+}
+
+func (rt *impersonatingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	// requests must not be modified, set the headers on a copy
+	r := new(http.Request)
+	*r = *req
+	r.Header = make(http.Header, len(req.Header)+2)
+	for k, v := range req.Header {
+		r.Header[k] = v
+	}
+	if len(rt.user) > 0 {
+		r.Header.Set(authenticationapi.ImpersonateUserHeader, rt.user)
+	}
+	for _, group := range rt.groups {
+		r.Header.Add(authenticationapi.ImpersonateGroupHeader, group)
+	}
+	return rt.delegate.RoundTrip(r)
+}
@@ -0,0 +1,77 @@
+package clientcmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/pflag"
+
+	"k8s.io/kubernetes/pkg/client/restclient"
+	"k8s.io/kubernetes/pkg/client/unversioned/clientcmd"
+	clientcmdapi "k8s.io/kubernetes/pkg/client/unversioned/clientcmd/api"
+)
+
+func TestImpersonatingClientConfig(t *testing.T) {
+	testCases := map[string]struct {
+		Args []string
+
+		ExpectedUser   string
+		ExpectedGroups []string
+	}{
+		"no impersonation": {},
+		"user": {
+			Args:         []string{"--as=bob"},
+			ExpectedUser: "bob",
+		},
+		"user and groups": {
+			Args:           []string{"--as=bob", "--as-group=developers", "--as-group=testers"},
+			ExpectedUser:   "bob",
+			ExpectedGroups: []string{"developers", "testers"},
+		},
+	}
+
+	for k, tc := range testCases {
+		var actualUser string
+		var actualGroups []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			actualUser = req.Header.Get("Impersonate-User")
+			actualGroups = req.Header["Impersonate-Group"]
+		}))
+
+		config := clientcmdapi.NewConfig()
+		config.Clusters["cluster"] = &clientcmdapi.Cluster{Server: server.URL}
+		config.AuthInfos["user"] = &clientcmdapi.AuthInfo{Token: "mytoken"}
+		config.Contexts["context"] = &clientcmdapi.Context{Cluster: "cluster", AuthInfo: "user"}
+		config.CurrentContext = "context"
+
+		flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		clientConfig := newImpersonatingClientConfig(clientcmd.NewDefaultClientConfig(*config, &clientcmd.ConfigOverrides{}), flags)
+		if err := flags.Parse(tc.Args); err != nil {
+			t.Fatalf("%s: unexpected error: %v", k, err)
+		}
+
+		cfg, err := clientConfig.ClientConfig()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", k, err)
+		}
+		rt, err := restclient.TransportFor(cfg)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", k, err)
+		}
+		resp, err := (&http.Client{Transport: rt}).Get(server.URL)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", k, err)
+		}
+		resp.Body.Close()
+		server.Close()
+
+		if actualUser != tc.ExpectedUser {
+			t.Errorf("%s: expected user %q, got %q", k, tc.ExpectedUser, actualUser)
+		}
+		if !reflect.DeepEqual(actualGroups, tc.ExpectedGroups) {
+			t.Errorf("%s: expected groups %v, got %v", k, tc.ExpectedGroups, actualGroups)
+		}
+	}
+}
@@ -63,6 +63,7 @@ func New(flags *pflag.FlagSet) *Factory {
 	// TODO: there should be two client configs, one for OpenShift, and one for Kubernetes
 	clientConfig := DefaultClientConfig(flags)
 	clientConfig = defaultingClientConfig{clientConfig}
+	clientConfig = newImpersonatingClientConfig(clientConfig, flags)
 	f := NewFactory(clientConfig)
 	f.BindFlags(flags)
 
@@ -0,0 +1,194 @@
+package tokencmd
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+
+	apierrs "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/restclient"
+)
+
+const (
+	// browserClientID is the public client the OAuth server registers for the browser login
+	browserClientID = "openshift-cli-client"
+	// browserCallbackPath is the path the OAuth server redirects the browser to
+	browserCallbackPath = "/callback"
+)
+
+// browserLoginTimeout is how long the user has to log in with the browser
+var browserLoginTimeout = 5 * time.Minute
+
+// browserResult is the outcome of the redirect back from the OAuth server
+type browserResult struct {
+	code string
+	err  error
+}
+
+// RequestTokenWithBrowser obtains an access token with the OAuth authorization code flow. The user logs in with
+// a browser, which lets them use any identity provider, including those requiring several authentication factors.
+// The authorization URL is printed to out, and opened in the default browser if openBrowser is true.
+func RequestTokenWithBrowser(clientCfg *restclient.Config, out io.Writer, openBrowser bool) (string, error) {
+	rt, err := restclient.TransportFor(clientCfg)
+	if err != nil {
+		return "", err
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", fmt.Errorf("unable to listen for the OAuth redirect: %v", err)
+	}
+	defer listener.Close()
+	redirectURI := "http://" + listener.Addr().String() + browserCallbackPath
+
+	state, err := randomState()
+	if err != nil {
+		return "", err
+	}
+	// The code verifier proves to the token endpoint that the code is exchanged by the process which requested it
+	codeVerifier, err := randomState()
+	if err != nil {
+		return "", err
+	}
+
+	results := make(chan browserResult, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc(browserCallbackPath, func(w http.ResponseWriter, req *http.Request) {
+		// Any local process can reach the listener, only the redirect bound to this login completes it
+		if req.URL.Query().Get("state") != state {
+			http.Error(w, "The OAuth redirect has an unexpected state.", http.StatusBadRequest)
+			return
+		}
+		result := callbackResult(req)
+		if result.err != nil {
+			fmt.Fprintf(w, "Login failed: %v\n", result.err)
+		} else {
+			fmt.Fprintln(w, "Login successful, you can close this window and return to the command line.")
+		}
+		select {
+		case results <- result:
+		default:
+		}
+	})
+	go http.Serve(listener, mux)
+
+	authorizeURL := clientCfg.Host + "/oauth/authorize?" + url.Values{
+		"response_type":         {"code"},
+		"client_id":             {browserClientID},
+		"redirect_uri":          {redirectURI},
+		"state":                 {state},
+		"code_challenge":        {codeChallenge(codeVerifier)},
+		"code_challenge_method": {"S256"},
+	}.Encode()
+
+	fmt.Fprintf(out, "Log in with your browser at\n\n    %s\n\n", authorizeURL)
+	if openBrowser {
+		if err := openURL(authorizeURL); err != nil {
+			glog.V(4).Infof("Unable to open a browser: %v", err)
+		}
+	}
+
+	var result browserResult
+	select {
+	case result = <-results:
+	case <-time.After(browserLoginTimeout):
+		return "", fmt.Errorf("timed out waiting for the browser login after %v", browserLoginTimeout)
+	}
+	if result.err != nil {
+		return "", result.err
+	}
+
+	return exchangeCode(rt, clientCfg.Host, result.code, redirectURI, codeVerifier)
+}
+
+// callbackResult returns the authorization code from the OAuth redirect, or the error it reports
+func callbackResult(req *http.Request) browserResult {
+	query := req.URL.Query()
+	if errorCode := query.Get("error"); len(errorCode) > 0 {
+		return browserResult{err: errors.New(errorCode + " " + query.Get("error_description"))}
+	}
+	code := query.Get("code")
+	if len(code) == 0 {
+		return browserResult{err: errors.New("the OAuth redirect has no authorization code")}
+	}
+	return browserResult{code: code}
+}
+
+// exchangeCode exchanges an authorization code for an access token
+func exchangeCode(rt http.RoundTripper, host, code, redirectURI, codeVerifier string) (string, error) {
+	body := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"code_verifier": {codeVerifier},
+	}.Encode()
+	req, err := http.NewRequest("POST", host+"/oauth/token", strings.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	// The client is public, its secret is empty
+	req.SetBasicAuth(browserClientID, "")
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	tokenResponse := struct {
+		AccessToken      string `json:"access_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResponse); err != nil {
+		return "", apierrs.NewInternalError(fmt.Errorf("unable to decode the token response (%d): %v", resp.StatusCode, err))
+	}
+	if len(tokenResponse.Error) > 0 {
+		return "", apierrs.NewUnauthorized(tokenResponse.Error + " " + tokenResponse.ErrorDescription)
+	}
+	if len(tokenResponse.AccessToken) == 0 {
+		return "", apierrs.NewInternalError(fmt.Errorf("unexpected token response: %d", resp.StatusCode))
+	}
+	return tokenResponse.AccessToken, nil
+}
+
+// randomState returns an unguessable value to bind the OAuth redirect to this login
+func randomState() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// codeChallenge returns the S256 code challenge of codeVerifier, as described in RFC 7636 4.2
+func codeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// openURL opens u in the default browser
+func openURL(u string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("open", u).Start()
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", u).Start()
+	default:
+		return exec.Command("xdg-open", u).Start()
+	}
+}
@@ -0,0 +1,150 @@
+package tokencmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/client/restclient"
+)
+
+// browserSimulator follows the authorization URL printed by the CLI, as a browser would after the user logged in
+type browserSimulator struct {
+	t     *testing.T
+	query url.Values
+	// forged sends a redirect with another state before the one of the login
+	forged bool
+
+	// codeChallenge is the code challenge of the authorization URL
+	codeChallenge string
+}
+
+var authorizeURLRegex = regexp.MustCompile(`(http\S+/oauth/authorize\S+)`)
+
+func (b *browserSimulator) Write(p []byte) (int, error) {
+	match := authorizeURLRegex.FindString(string(p))
+	if len(match) == 0 {
+		return len(p), nil
+	}
+	authorizeURL, err := url.Parse(match)
+	if err != nil {
+		b.t.Fatalf("unexpected error parsing %s: %v", match, err)
+	}
+	params := authorizeURL.Query()
+	if params.Get("client_id") != "openshift-cli-client" || params.Get("response_type") != "code" {
+		b.t.Errorf("unexpected authorization URL: %s", match)
+	}
+	if params.Get("code_challenge_method") != "S256" || len(params.Get("code_challenge")) == 0 {
+		b.t.Errorf("expected an S256 code challenge: %s", match)
+	}
+	b.codeChallenge = params.Get("code_challenge")
+
+	redirectURI, err := url.Parse(params.Get("redirect_uri"))
+	if err != nil {
+		b.t.Fatalf("unexpected error parsing %s: %v", params.Get("redirect_uri"), err)
+	}
+	query := url.Values{}
+	for k, v := range b.query {
+		query[k] = v
+	}
+	if len(query.Get("state")) == 0 {
+		query.Set("state", params.Get("state"))
+	}
+	forgedURI := *redirectURI
+	forgedURI.RawQuery = url.Values{"code": {"forgedcode"}, "state": {"forged"}}.Encode()
+	redirectURI.RawQuery = query.Encode()
+	go func() {
+		if b.forged {
+			resp, err := http.Get(forgedURI.String())
+			if err != nil {
+				b.t.Errorf("unexpected error: %v", err)
+				return
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusBadRequest {
+				b.t.Errorf("expected the forged redirect to be rejected, got %d", resp.StatusCode)
+			}
+		}
+		if resp, err := http.Get(redirectURI.String()); err == nil {
+			resp.Body.Close()
+		}
+	}()
+	return len(p), nil
+}
+
+func TestRequestTokenWithBrowser(t *testing.T) {
+	defer func(timeout time.Duration) { browserLoginTimeout = timeout }(browserLoginTimeout)
+	browserLoginTimeout = time.Second
+
+	testCases := map[string]struct {
+		CallbackQuery url.Values
+		Forged        bool
+		TokenResponse string
+
+		ExpectedToken string
+		ExpectError   bool
+	}{
+		"success": {
+			CallbackQuery: url.Values{"code": {"mycode"}},
+			TokenResponse: `{"access_token":"mytoken","token_type":"bearer"}`,
+			ExpectedToken: "mytoken",
+		},
+		"denied": {
+			CallbackQuery: url.Values{"error": {"access_denied"}},
+			ExpectError:   true,
+		},
+		"wrong state": {
+			CallbackQuery: url.Values{"code": {"mycode"}, "state": {"forged"}},
+			ExpectError:   true,
+		},
+		"wrong state before the redirect": {
+			CallbackQuery: url.Values{"code": {"mycode"}},
+			Forged:        true,
+			TokenResponse: `{"access_token":"mytoken","token_type":"bearer"}`,
+			ExpectedToken: "mytoken",
+		},
+		"invalid code": {
+			CallbackQuery: url.Values{"code": {"mycode"}},
+			TokenResponse: `{"error":"invalid_grant"}`,
+			ExpectError:   true,
+		},
+	}
+
+	for k, tc := range testCases {
+		browser := &browserSimulator{t: t, query: tc.CallbackQuery, forged: tc.Forged}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path != "/oauth/token" || req.Method != "POST" {
+				t.Errorf("%s: unexpected request %s %s", k, req.Method, req.URL)
+				return
+			}
+			if clientID, secret, ok := req.BasicAuth(); !ok || clientID != "openshift-cli-client" || secret != "" {
+				t.Errorf("%s: unexpected client credentials %q %q", k, clientID, secret)
+			}
+			req.ParseForm()
+			if req.PostForm.Get("grant_type") != "authorization_code" || req.PostForm.Get("code") != "mycode" {
+				t.Errorf("%s: unexpected token request %v", k, req.PostForm)
+			}
+			if codeChallenge(req.PostForm.Get("code_verifier")) != browser.codeChallenge {
+				t.Errorf("%s: code verifier %q does not match the code challenge %q", k, req.PostForm.Get("code_verifier"), browser.codeChallenge)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(tc.TokenResponse))
+		}))
+
+		token, err := RequestTokenWithBrowser(&restclient.Config{Host: server.URL}, browser, false)
+		server.Close()
+
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", k)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+		}
+		if token != tc.ExpectedToken {
+			t.Errorf("%s: expected token %q, got %q", k, tc.ExpectedToken, token)
+		}
+	}
+}
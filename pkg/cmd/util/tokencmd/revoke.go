@@ -0,0 +1,48 @@
+package tokencmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apierrs "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/restclient"
+)
+
+// RevokeAllTokens revokes every access token, authorize token and client authorization of the user owning the
+// bearer token of clientCfg. It returns the number of access tokens that were revoked.
+func RevokeAllTokens(clientCfg *restclient.Config) (int, error) {
+	rt, err := restclient.TransportFor(clientCfg)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequest("POST", clientCfg.Host+"/oauth/token/revoke", nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized:
+		return 0, apierrs.NewUnauthorized("the token is not valid, unable to revoke the sessions")
+	case http.StatusForbidden:
+		return 0, errors.New("a token restricted to scopes cannot revoke all sessions")
+	default:
+		return 0, apierrs.NewInternalError(fmt.Errorf("unexpected response revoking the sessions: %d", resp.StatusCode))
+	}
+
+	revokeResponse := struct {
+		AccessTokens int `json:"accessTokens"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&revokeResponse); err != nil {
+		return 0, apierrs.NewInternalError(fmt.Errorf("unable to decode the revocation response: %v", err))
+	}
+	return revokeResponse.AccessTokens, nil
+}
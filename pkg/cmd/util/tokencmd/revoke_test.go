@@ -0,0 +1,64 @@
+package tokencmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/client/restclient"
+)
+
+func TestRevokeAllTokens(t *testing.T) {
+	testCases := map[string]struct {
+		Code     int
+		Response string
+
+		ExpectedRevoked int
+		ExpectError     bool
+	}{
+		"success": {
+			Code:            http.StatusOK,
+			Response:        `{"accessTokens":3,"authorizeTokens":1}`,
+			ExpectedRevoked: 3,
+		},
+		"invalid token": {
+			Code:        http.StatusUnauthorized,
+			ExpectError: true,
+		},
+		"scoped token": {
+			Code:        http.StatusForbidden,
+			ExpectError: true,
+		},
+		"server error": {
+			Code:        http.StatusInternalServerError,
+			ExpectError: true,
+		},
+	}
+
+	for k, tc := range testCases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path != "/oauth/token/revoke" || req.Method != "POST" {
+				t.Errorf("%s: unexpected request %s %s", k, req.Method, req.URL)
+				return
+			}
+			if auth := req.Header.Get("Authorization"); auth != "Bearer mytoken" {
+				t.Errorf("%s: unexpected authorization header %q", k, auth)
+			}
+			w.WriteHeader(tc.Code)
+			w.Write([]byte(tc.Response))
+		}))
+
+		revoked, err := RevokeAllTokens(&restclient.Config{Host: server.URL, BearerToken: "mytoken"})
+		server.Close()
+
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", k)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+		}
+		if revoked != tc.ExpectedRevoked {
+			t.Errorf("%s: expected %d revoked tokens, got %d", k, tc.ExpectedRevoked, revoked)
+		}
+	}
+}
@@ -21,6 +21,8 @@ type Bulk struct {
 	RESTClientFactory func(mapping *meta.RESTMapping) (resource.RESTClient, error)
 	After             AfterFunc
 	Retry             func(info *resource.Info, err error) runtime.Object
+	// DryRun, when true, passes the items to After without creating them.
+	DryRun bool
 }
 
 func NewPrintNameOrErrorAfter(mapper meta.RESTMapper, short bool, operation string, out, errs io.Writer) AfterFunc {
@@ -63,7 +65,7 @@ func encodeAndCreate(info *resource.Info, namespace string, obj runtime.Object)
 
 // Create attempts to create each item generically, gathering all errors in the
 // event a failure occurs. The contents of list will be updated to include the
-// version from the server.
+// version from the server, unless DryRun is set.
 func (b *Bulk) Create(list *kapi.List, namespace string) []error {
 	resourceMapper := &resource.Mapper{ObjectTyper: b.Typer, RESTMapper: b.Mapper, ClientMapper: resource.ClientMapperFunc(b.RESTClientFactory)}
 	after := b.After
@@ -81,6 +83,12 @@ func (b *Bulk) Create(list *kapi.List, namespace string) []error {
 			}
 			continue
 		}
+		if b.DryRun {
+			if after(info, nil) {
+				break
+			}
+			continue
+		}
 		obj, err := encodeAndCreate(info, namespace, item)
 		if err != nil && b.Retry != nil {
 			if obj := b.Retry(info, err); obj != nil {
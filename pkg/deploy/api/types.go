@@ -35,6 +35,8 @@ type DeploymentStrategy struct {
 	RecreateParams *RecreateDeploymentStrategyParams
 	// RollingParams are the input to the Rolling deployment strategy.
 	RollingParams *RollingDeploymentStrategyParams
+	// CanaryParams are the input to the Canary deployment strategy.
+	CanaryParams *CanaryDeploymentStrategyParams
 
 	// Resources contains resource requirements to execute the deployment
 	Resources kapi.ResourceRequirements
@@ -54,6 +56,9 @@ const (
 	DeploymentStrategyTypeCustom DeploymentStrategyType = "Custom"
 	// DeploymentStrategyTypeRolling uses the Kubernetes RollingUpdater.
 	DeploymentStrategyTypeRolling DeploymentStrategyType = "Rolling"
+	// DeploymentStrategyTypeCanary rolls out a portion of the new replicas and
+	// verifies them before completing or rolling back the deployment.
+	DeploymentStrategyTypeCanary DeploymentStrategyType = "Canary"
 )
 
 // CustomDeploymentStrategyParams are the input to the Custom deployment strategy.
@@ -118,6 +123,10 @@ type ExecNewPodHook struct {
 	// ContainerName is the name of a container in the deployment pod template
 	// whose Docker image will be used for the hook pod's container.
 	ContainerName string
+	// Image is the Docker image to run in the hook pod's container instead of
+	// the image of the container named ContainerName, whose environment,
+	// resources and volume mounts are still inherited.
+	Image string
 	// Volumes is a list of named volumes from the pod template which should be
 	// copied to the hook pod.
 	Volumes []string
@@ -178,6 +187,33 @@ type RollingDeploymentStrategyParams struct {
 	Post *LifecycleHook
 }
 
+// CanaryDeploymentStrategyParams are the input to the Canary deployment
+// strategy.
+type CanaryDeploymentStrategyParams struct {
+	// TimeoutSeconds is the time to wait for updates before giving up. If the
+	// value is nil, a default will be used.
+	TimeoutSeconds *int64
+	// Size is the number of replicas of the new deployment rolled out as the
+	// canary. Value can be an absolute number (ex: 5) or a percentage of the
+	// desired replicas (ex: 10%). Absolute number is calculated from
+	// percentage by rounding up, and at least one replica is always rolled out.
+	// By default, 10% is used.
+	Size intstr.IntOrString
+	// VerificationSeconds is the time to wait after the canary replicas are
+	// ready before verifying them. If the value is nil, a default will be used.
+	VerificationSeconds *int64
+	// Pre is a lifecycle hook which is executed before the deployment process
+	// begins. All LifecycleHookFailurePolicy values are supported.
+	Pre *LifecycleHook
+	// Verify is a lifecycle hook which is executed once the verification
+	// window has passed. If it fails with the Abort or Retry policy, the
+	// deployment is rolled back to the previous deployment.
+	Verify *LifecycleHook
+	// Post is a lifecycle hook which is executed after the strategy has
+	// finished all deployment logic.
+	Post *LifecycleHook
+}
+
 const (
 	// DefaultRollingTimeoutSeconds is the default TimeoutSeconds for RollingDeploymentStrategyParams.
 	DefaultRollingTimeoutSeconds int64 = 10 * 60
@@ -185,6 +221,10 @@ const (
 	DefaultRollingIntervalSeconds int64 = 1
 	// DefaultRollingUpdatePeriodSeconds is the default PeriodSeconds for RollingDeploymentStrategyParams.
 	DefaultRollingUpdatePeriodSeconds int64 = 1
+	// DefaultCanaryVerificationSeconds is the default VerificationSeconds for CanaryDeploymentStrategyParams.
+	DefaultCanaryVerificationSeconds int64 = 60
+	// DefaultCanarySize is the default Size for CanaryDeploymentStrategyParams.
+	DefaultCanarySize = "10%"
 )
 
 // These constants represent keys used for correlating objects related to deployments.
@@ -236,12 +276,21 @@ const (
 	// DeploymentReplicasAnnotation is for internal use only and is for
 	// detecting external modifications to deployment replica counts.
 	DeploymentReplicasAnnotation = "openshift.io/deployment.replicas"
+	// DeploymentConfigPendingTemplateAnnotation is set on a deployment config whose config
+	// change trigger is waiting out its debounce window. The value is a hash of the pod
+	// template which is waiting to be deployed.
+	DeploymentConfigPendingTemplateAnnotation = "openshift.io/deployment-config.pending-template"
+	// DeploymentConfigPendingSinceAnnotation is the time, in RFC3339 format, at which the
+	// pod template recorded in DeploymentConfigPendingTemplateAnnotation was first seen.
+	DeploymentConfigPendingSinceAnnotation = "openshift.io/deployment-config.pending-since"
 	// PostHookPodSuffix is the suffix added to all pre hook pods
 	PreHookPodSuffix = "hook-pre"
 	// PostHookPodSuffix is the suffix added to all mid hook pods
 	MidHookPodSuffix = "hook-mid"
 	// PostHookPodSuffix is the suffix added to all post hook pods
 	PostHookPodSuffix = "hook-post"
+	// VerifyHookPodSuffix is the suffix added to all canary verification hook pods
+	VerifyHookPodSuffix = "hook-verify"
 )
 
 // These constants represent the various reasons for cancelling a deployment
@@ -251,6 +300,7 @@ const (
 	DeploymentCancelledNewerDeploymentExists  = "cancelled as a newer deployment was found running"
 	DeploymentFailedUnrelatedDeploymentExists = "unrelated pod with the same name as this deployment is already running"
 	DeploymentFailedDeployerPodNoLongerExists = "deployer pod no longer exists"
+	DeploymentCancelledProgressDeadline       = "cancelled as the deployment did not progress within its progress deadline"
 )
 
 // MaxDeploymentDurationSeconds represents the maximum duration that a deployment is allowed to run
@@ -295,6 +345,17 @@ type DeploymentConfigSpec struct {
 	// or failing. Post strategy hooks and After actions can be used to integrate successful deployment with an action.
 	Test bool
 
+	// Paused indicates that the deployment config is paused, so changes to
+	// its template or to the images it is triggered by will not result in new
+	// deployments until it is resumed.
+	Paused bool
+
+	// ProgressDeadlineSeconds is the maximum number of seconds the latest deployment may run
+	// without making progress before it is cancelled and reported as failed in the Progressing
+	// condition. A deployment makes progress when its phase or its replica counts change. If
+	// unset, deployments are not cancelled for lack of progress.
+	ProgressDeadlineSeconds *int64
+
 	// Selector is a label query over pods that should match the Replicas count.
 	Selector map[string]string
 
@@ -311,6 +372,54 @@ type DeploymentConfigStatus struct {
 	// Details are the reasons for the update to this deployment config.
 	// This could be based on a change made by the user or caused by an automatic trigger
 	Details *DeploymentDetails
+	// Conditions represent the latest available observations of the state of the deployment config.
+	Conditions []DeploymentCondition
+}
+
+// DeploymentConditionType is the type of a condition of a deployment config.
+type DeploymentConditionType string
+
+// These are valid conditions of a deployment config.
+const (
+	// DeploymentAvailable means the replication controllers of the deployment config run the
+	// desired number of replicas.
+	DeploymentAvailable DeploymentConditionType = "Available"
+	// DeploymentProgressing means the latest deployment is running or has completed. It is
+	// False when the latest deployment failed, was cancelled or exceeded its progress deadline.
+	DeploymentProgressing DeploymentConditionType = "Progressing"
+	// DeploymentReplicaFailure is added when the replication controller of the latest
+	// deployment could not be created.
+	DeploymentReplicaFailure DeploymentConditionType = "ReplicaFailure"
+)
+
+// These constants are the reasons of the deployment config conditions.
+const (
+	MinimumReplicasAvailable           = "MinimumReplicasAvailable"
+	MinimumReplicasUnavailable         = "MinimumReplicasUnavailable"
+	ReplicationControllerUpdatedReason = "ReplicationControllerUpdated"
+	NewRcAvailableReason               = "NewReplicationControllerAvailable"
+	TimedOutReason                     = "ProgressDeadlineExceeded"
+	CancelledRolloutReason             = "RolloutCancelled"
+	FailedRolloutReason                = "RolloutFailed"
+	PausedConfigReason                 = "DeploymentConfigPaused"
+	FailedRcCreateReason               = "ReplicationControllerCreateError"
+)
+
+// DeploymentCondition describes the state of a deployment config at a certain point.
+type DeploymentCondition struct {
+	// Type of deployment condition.
+	Type DeploymentConditionType
+	// Status of the condition, one of True, False, Unknown.
+	Status kapi.ConditionStatus
+	// LastUpdateTime is the last time this condition was updated. For the Progressing
+	// condition it is the last time the latest deployment was seen making progress.
+	LastUpdateTime unversioned.Time
+	// LastTransitionTime is the last time the condition transitioned from one status to another.
+	LastTransitionTime unversioned.Time
+	// Reason is the reason for the condition's last transition.
+	Reason string
+	// Message is a human readable description of the details of the last transition.
+	Message string
 }
 
 // DeploymentTriggerPolicy describes a policy for a single trigger that results in a new deployment.
@@ -319,6 +428,8 @@ type DeploymentTriggerPolicy struct {
 	Type DeploymentTriggerType
 	// ImageChangeParams represents the parameters for the ImageChange trigger.
 	ImageChangeParams *DeploymentTriggerImageChangeParams
+	// ConfigChangeParams represents the parameters for the ConfigChange trigger.
+	ConfigChangeParams *DeploymentTriggerConfigChangeParams
 }
 
 // DeploymentTriggerType refers to a specific DeploymentTriggerPolicy implementation.
@@ -343,12 +454,21 @@ type DeploymentTriggerImageChangeParams struct {
 	ContainerNames []string
 	// From is a reference to an image stream tag to watch for changes. From.Name is the only
 	// required subfield - if From.Namespace is blank, the namespace of the current deployment
-	// trigger will be used.
+	// trigger will be used. The tag may be a glob pattern such as "release-*", in which case
+	// the image of the most recently updated matching tag is deployed.
 	From kapi.ObjectReference
 	// LastTriggeredImage is the last image to be triggered.
 	LastTriggeredImage string
 }
 
+// DeploymentTriggerConfigChangeParams represents the parameters to the ConfigChange trigger.
+type DeploymentTriggerConfigChangeParams struct {
+	// DebounceSeconds is how long the pod template must remain unchanged before a change to it
+	// results in a new deployment, so that several edits made within this window are rolled out
+	// together. Zero means that every change is deployed immediately.
+	DebounceSeconds int64
+}
+
 // DeploymentDetails captures information about the causes of a deployment.
 type DeploymentDetails struct {
 	// Message is the user specified change message, if this deployment was triggered manually by the user
@@ -402,6 +522,13 @@ type DeploymentConfigRollbackSpec struct {
 	IncludeStrategy bool
 }
 
+// DeploymentRequest is a request to a deployment config for a new deployment.
+type DeploymentRequest struct {
+	unversioned.TypeMeta
+	// Name of the deployment config for requesting a new deployment.
+	Name string
+}
+
 // DeploymentLog represents the logs for a deployment
 type DeploymentLog struct {
 	unversioned.TypeMeta
@@ -445,4 +572,12 @@ type DeploymentLogOptions struct {
 
 	// Version of the deployment for which to view logs.
 	Version *int64
+
+	// AllPods if true returns the logs of the deployer pod and the lifecycle hook pods
+	// of the deployment interleaved in time order, with each line prefixed by the name
+	// of the pod it came from.
+	AllPods bool
+	// DeploymentPods if true also includes the logs of the pods created by the deployment
+	// when AllPods is set.
+	DeploymentPods bool
 }
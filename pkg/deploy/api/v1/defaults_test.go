@@ -231,6 +231,37 @@ func TestDefaults(t *testing.T) {
 				},
 			},
 		},
+		{
+			original: &deployv1.DeploymentConfig{
+				Spec: deployv1.DeploymentConfigSpec{
+					Strategy: deployv1.DeploymentStrategy{
+						Type: deployv1.DeploymentStrategyTypeCanary,
+					},
+					Triggers: []deployv1.DeploymentTriggerPolicy{
+						{
+							Type: deployv1.DeploymentTriggerOnImageChange,
+						},
+					},
+				},
+			},
+			expected: &deployv1.DeploymentConfig{
+				Spec: deployv1.DeploymentConfigSpec{
+					Strategy: deployv1.DeploymentStrategy{
+						Type: deployv1.DeploymentStrategyTypeCanary,
+						CanaryParams: &deployv1.CanaryDeploymentStrategyParams{
+							TimeoutSeconds:      newInt64(deployapi.DefaultRollingTimeoutSeconds),
+							VerificationSeconds: newInt64(deployapi.DefaultCanaryVerificationSeconds),
+							Size:                intstr.FromString(deployapi.DefaultCanarySize),
+						},
+					},
+					Triggers: []deployv1.DeploymentTriggerPolicy{
+						{
+							Type: deployv1.DeploymentTriggerOnImageChange,
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for i, test := range tests {
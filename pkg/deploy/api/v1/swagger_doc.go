@@ -5,6 +5,20 @@ package v1
 // by hack/update-generated-swagger-descriptions.sh and should be run after a full build of OpenShift.
 // ==== DO NOT EDIT THIS FILE MANUALLY ====
 
+var map_CanaryDeploymentStrategyParams = map[string]string{
+	"":                    "CanaryDeploymentStrategyParams are the input to the Canary deployment strategy.",
+	"timeoutSeconds":      "TimeoutSeconds is the time to wait for updates before giving up. If the value is nil, a default will be used.",
+	"size":                "Size is the number of replicas of the new deployment rolled out as the canary. Value can be an absolute number (ex: 5) or a percentage of the desired replicas (ex: 10%). Absolute number is calculated from percentage by rounding up, and at least one replica is always rolled out. By default, 10% is used.",
+	"verificationSeconds": "VerificationSeconds is the time to wait after the canary replicas are ready before verifying them. If the value is nil, a default will be used.",
+	"pre":                 "Pre is a lifecycle hook which is executed before the deployment process begins. All LifecycleHookFailurePolicy values are supported.",
+	"verify":              "Verify is a lifecycle hook which is executed once the verification window has passed. If it fails with the Abort or Retry policy, the deployment is rolled back to the previous deployment.",
+	"post":                "Post is a lifecycle hook which is executed after the strategy has finished all deployment logic. All LifecycleHookFailurePolicy values are supported.",
+}
+
+func (CanaryDeploymentStrategyParams) SwaggerDoc() map[string]string {
+	return map_CanaryDeploymentStrategyParams
+}
+
 var map_CustomDeploymentStrategyParams = map[string]string{
 	"":            "CustomDeploymentStrategyParams are the input to the Custom deployment strategy.",
 	"image":       "Image specifies a Docker image which can carry out a deployment.",
@@ -35,6 +49,20 @@ func (DeploymentCauseImageTrigger) SwaggerDoc() map[string]string {
 	return map_DeploymentCauseImageTrigger
 }
 
+var map_DeploymentCondition = map[string]string{
+	"":                   "DeploymentCondition describes the state of a deployment config at a certain point.",
+	"type":               "Type of deployment condition.",
+	"status":             "Status of the condition, one of True, False, Unknown.",
+	"lastUpdateTime":     "LastUpdateTime is the last time this condition was updated. For the Progressing condition it is the last time the latest deployment was seen making progress.",
+	"lastTransitionTime": "LastTransitionTime is the last time the condition transitioned from one status to another.",
+	"reason":             "Reason is the reason for the condition's last transition.",
+	"message":            "Message is a human readable description of the details of the last transition.",
+}
+
+func (DeploymentCondition) SwaggerDoc() map[string]string {
+	return map_DeploymentCondition
+}
+
 var map_DeploymentConfig = map[string]string{
 	"":         "DeploymentConfig represents a configuration for a single deployment (represented as a ReplicationController). It also contains details about changes which resulted in the current state of the DeploymentConfig. Each change to the DeploymentConfig which should result in a new deployment results in an increment of LatestVersion.",
 	"metadata": "Standard object's metadata.",
@@ -79,13 +107,15 @@ func (DeploymentConfigRollbackSpec) SwaggerDoc() map[string]string {
 }
 
 var map_DeploymentConfigSpec = map[string]string{
-	"":         "DeploymentConfigSpec represents the desired state of the deployment.",
-	"strategy": "Strategy describes how a deployment is executed.",
-	"triggers": "Triggers determine how updates to a DeploymentConfig result in new deployments. If no triggers are defined, a new deployment can only occur as a result of an explicit client update to the DeploymentConfig with a new LatestVersion.",
-	"replicas": "Replicas is the number of desired replicas.",
-	"test":     "Test ensures that this deployment config will have zero replicas except while a deployment is running. This allows the deployment config to be used as a continuous deployment test - triggering on images, running the deployment, and then succeeding or failing. Post strategy hooks and After actions can be used to integrate successful deployment with an action.",
-	"selector": "Selector is a label query over pods that should match the Replicas count.",
-	"template": "Template is the object that describes the pod that will be created if insufficient replicas are detected.",
+	"":                        "DeploymentConfigSpec represents the desired state of the deployment.",
+	"strategy":                "Strategy describes how a deployment is executed.",
+	"triggers":                "Triggers determine how updates to a DeploymentConfig result in new deployments. If no triggers are defined, a new deployment can only occur as a result of an explicit client update to the DeploymentConfig with a new LatestVersion.",
+	"replicas":                "Replicas is the number of desired replicas.",
+	"test":                    "Test ensures that this deployment config will have zero replicas except while a deployment is running. This allows the deployment config to be used as a continuous deployment test - triggering on images, running the deployment, and then succeeding or failing. Post strategy hooks and After actions can be used to integrate successful deployment with an action.",
+	"paused":                  "Paused indicates that the deployment config is paused, so changes to its template or to the images it is triggered by will not result in new deployments until it is resumed.",
+	"progressDeadlineSeconds": "ProgressDeadlineSeconds is the maximum number of seconds the latest deployment may run without making progress before it is cancelled and reported as failed in the Progressing condition. A deployment makes progress when its phase or its replica counts change. If unset, deployments are not cancelled for lack of progress.",
+	"selector":                "Selector is a label query over pods that should match the Replicas count.",
+	"template":                "Template is the object that describes the pod that will be created if insufficient replicas are detected.",
 }
 
 func (DeploymentConfigSpec) SwaggerDoc() map[string]string {
@@ -96,6 +126,7 @@ var map_DeploymentConfigStatus = map[string]string{
 	"":              "DeploymentConfigStatus represents the current deployment state.",
 	"latestVersion": "LatestVersion is used to determine whether the current deployment associated with a DeploymentConfig is out of sync.",
 	"details":       "Details are the reasons for the update to this deployment config. This could be based on a change made by the user or caused by an automatic trigger",
+	"conditions":    "Conditions represent the latest available observations of the state of the deployment config.",
 }
 
 func (DeploymentConfigStatus) SwaggerDoc() map[string]string {
@@ -121,29 +152,41 @@ func (DeploymentLog) SwaggerDoc() map[string]string {
 }
 
 var map_DeploymentLogOptions = map[string]string{
-	"":             "DeploymentLogOptions is the REST options for a deployment log",
-	"container":    "The container for which to stream logs. Defaults to only container if there is one container in the pod.",
-	"follow":       "Follow if true indicates that the build log should be streamed until the build terminates.",
-	"previous":     "Return previous deployment logs. Defaults to false.",
-	"sinceSeconds": "A relative time in seconds before the current time from which to show logs. If this value precedes the time a pod was started, only logs since the pod start will be returned. If this value is in the future, no logs will be returned. Only one of sinceSeconds or sinceTime may be specified.",
-	"sinceTime":    "An RFC3339 timestamp from which to show logs. If this value preceeds the time a pod was started, only logs since the pod start will be returned. If this value is in the future, no logs will be returned. Only one of sinceSeconds or sinceTime may be specified.",
-	"timestamps":   "If true, add an RFC3339 or RFC3339Nano timestamp at the beginning of every line of log output. Defaults to false.",
-	"tailLines":    "If set, the number of lines from the end of the logs to show. If not specified, logs are shown from the creation of the container or sinceSeconds or sinceTime",
-	"limitBytes":   "If set, the number of bytes to read from the server before terminating the log output. This may not display a complete final line of logging, and may return slightly more or slightly less than the specified limit.",
-	"nowait":       "NoWait if true causes the call to return immediately even if the deployment is not available yet. Otherwise the server will wait until the deployment has started.",
-	"version":      "Version of the deployment for which to view logs.",
+	"":               "DeploymentLogOptions is the REST options for a deployment log",
+	"container":      "The container for which to stream logs. Defaults to only container if there is one container in the pod.",
+	"follow":         "Follow if true indicates that the build log should be streamed until the build terminates.",
+	"previous":       "Return previous deployment logs. Defaults to false.",
+	"sinceSeconds":   "A relative time in seconds before the current time from which to show logs. If this value precedes the time a pod was started, only logs since the pod start will be returned. If this value is in the future, no logs will be returned. Only one of sinceSeconds or sinceTime may be specified.",
+	"sinceTime":      "An RFC3339 timestamp from which to show logs. If this value preceeds the time a pod was started, only logs since the pod start will be returned. If this value is in the future, no logs will be returned. Only one of sinceSeconds or sinceTime may be specified.",
+	"timestamps":     "If true, add an RFC3339 or RFC3339Nano timestamp at the beginning of every line of log output. Defaults to false.",
+	"tailLines":      "If set, the number of lines from the end of the logs to show. If not specified, logs are shown from the creation of the container or sinceSeconds or sinceTime",
+	"limitBytes":     "If set, the number of bytes to read from the server before terminating the log output. This may not display a complete final line of logging, and may return slightly more or slightly less than the specified limit.",
+	"nowait":         "NoWait if true causes the call to return immediately even if the deployment is not available yet. Otherwise the server will wait until the deployment has started.",
+	"version":        "Version of the deployment for which to view logs.",
+	"allPods":        "AllPods if true returns the logs of the deployer pod and the lifecycle hook pods of the deployment interleaved in time order, with each line prefixed by the name of the pod it came from.",
+	"deploymentPods": "DeploymentPods if true also includes the logs of the pods created by the deployment when AllPods is set.",
 }
 
 func (DeploymentLogOptions) SwaggerDoc() map[string]string {
 	return map_DeploymentLogOptions
 }
 
+var map_DeploymentRequest = map[string]string{
+	"":     "DeploymentRequest is a request to a deployment config for a new deployment.",
+	"name": "Name of the deployment config for requesting a new deployment.",
+}
+
+func (DeploymentRequest) SwaggerDoc() map[string]string {
+	return map_DeploymentRequest
+}
+
 var map_DeploymentStrategy = map[string]string{
 	"":               "DeploymentStrategy describes how to perform a deployment.",
 	"type":           "Type is the name of a deployment strategy.",
 	"customParams":   "CustomParams are the input to the Custom deployment strategy.",
 	"recreateParams": "RecreateParams are the input to the Recreate deployment strategy.",
 	"rollingParams":  "RollingParams are the input to the Rolling deployment strategy.",
+	"canaryParams":   "CanaryParams are the input to the Canary deployment strategy.",
 	"resources":      "Resources contains resource requirements to execute the deployment and any hooks",
 	"labels":         "Labels is a set of key, value pairs added to custom deployer and lifecycle pre/post hook pods.",
 	"annotations":    "Annotations is a set of key, value pairs added to custom deployer and lifecycle pre/post hook pods.",
@@ -153,11 +196,20 @@ func (DeploymentStrategy) SwaggerDoc() map[string]string {
 	return map_DeploymentStrategy
 }
 
+var map_DeploymentTriggerConfigChangeParams = map[string]string{
+	"":                "DeploymentTriggerConfigChangeParams represents the parameters to the ConfigChange trigger.",
+	"debounceSeconds": "DebounceSeconds is how long the pod template must remain unchanged before a change to it results in a new deployment, so that several edits made within this window are rolled out together. Zero means that every change is deployed immediately.",
+}
+
+func (DeploymentTriggerConfigChangeParams) SwaggerDoc() map[string]string {
+	return map_DeploymentTriggerConfigChangeParams
+}
+
 var map_DeploymentTriggerImageChangeParams = map[string]string{
 	"":                   "DeploymentTriggerImageChangeParams represents the parameters to the ImageChange trigger.",
 	"automatic":          "Automatic means that the detection of a new tag value should result in a new deployment.",
 	"containerNames":     "ContainerNames is used to restrict tag updates to the specified set of container names in a pod.",
-	"from":               "From is a reference to an image stream tag to watch for changes. From.Name is the only required subfield - if From.Namespace is blank, the namespace of the current deployment trigger will be used.",
+	"from":               "From is a reference to an image stream tag to watch for changes. From.Name is the only required subfield - if From.Namespace is blank, the namespace of the current deployment trigger will be used. The tag may be a glob pattern such as \"release-*\", in which case the image of the most recently updated matching tag is deployed.",
 	"lastTriggeredImage": "LastTriggeredImage is the last image to be triggered.",
 }
 
@@ -166,9 +218,10 @@ func (DeploymentTriggerImageChangeParams) SwaggerDoc() map[string]string {
 }
 
 var map_DeploymentTriggerPolicy = map[string]string{
-	"":                  "DeploymentTriggerPolicy describes a policy for a single trigger that results in a new deployment.",
-	"type":              "Type of the trigger",
-	"imageChangeParams": "ImageChangeParams represents the parameters for the ImageChange trigger.",
+	"":                   "DeploymentTriggerPolicy describes a policy for a single trigger that results in a new deployment.",
+	"type":               "Type of the trigger",
+	"imageChangeParams":  "ImageChangeParams represents the parameters for the ImageChange trigger.",
+	"configChangeParams": "ConfigChangeParams represents the parameters for the ConfigChange trigger.",
 }
 
 func (DeploymentTriggerPolicy) SwaggerDoc() map[string]string {
@@ -180,6 +233,7 @@ var map_ExecNewPodHook = map[string]string{
 	"command":       "Command is the action command and its arguments.",
 	"env":           "Env is a set of environment variables to supply to the hook pod's container.",
 	"containerName": "ContainerName is the name of a container in the deployment pod template whose Docker image will be used for the hook pod's container.",
+	"image":         "Image is the Docker image to run in the hook pod's container instead of the image of the container named ContainerName, whose environment, resources and volume mounts are still inherited.",
 	"volumes":       "Volumes is a list of named volumes from the pod template which should be copied to the hook pod. Volumes names not found in pod spec are ignored. An empty list means no volumes will be copied.",
 }
 
@@ -38,12 +38,28 @@ func addDefaultingFuncs(scheme *runtime.Scheme) {
 			if obj.Type == DeploymentStrategyTypeRecreate && obj.RecreateParams == nil {
 				obj.RecreateParams = &RecreateDeploymentStrategyParams{}
 			}
+			if obj.Type == DeploymentStrategyTypeCanary && obj.CanaryParams == nil {
+				obj.CanaryParams = &CanaryDeploymentStrategyParams{}
+			}
 		},
 		func(obj *RecreateDeploymentStrategyParams) {
 			if obj.TimeoutSeconds == nil {
 				obj.TimeoutSeconds = mkintp(deployapi.DefaultRollingTimeoutSeconds)
 			}
 		},
+		func(obj *CanaryDeploymentStrategyParams) {
+			if obj.TimeoutSeconds == nil {
+				obj.TimeoutSeconds = mkintp(deployapi.DefaultRollingTimeoutSeconds)
+			}
+
+			if obj.VerificationSeconds == nil {
+				obj.VerificationSeconds = mkintp(deployapi.DefaultCanaryVerificationSeconds)
+			}
+
+			if obj.Size == (intstr.IntOrString{}) {
+				obj.Size = intstr.FromString(deployapi.DefaultCanarySize)
+			}
+		},
 		func(obj *RollingDeploymentStrategyParams) {
 			if obj.IntervalSeconds == nil {
 				obj.IntervalSeconds = mkintp(deployapi.DefaultRollingIntervalSeconds)
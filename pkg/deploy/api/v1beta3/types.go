@@ -35,6 +35,8 @@ type DeploymentStrategy struct {
 	RecreateParams *RecreateDeploymentStrategyParams `json:"recreateParams,omitempty"`
 	// RollingParams are the input to the Rolling deployment strategy.
 	RollingParams *RollingDeploymentStrategyParams `json:"rollingParams,omitempty"`
+	// CanaryParams are the input to the Canary deployment strategy.
+	CanaryParams *CanaryDeploymentStrategyParams `json:"canaryParams,omitempty"`
 
 	// Compute resource requirements to execute the deployment
 	Resources kapi.ResourceRequirements `json:"resources,omitempty"`
@@ -54,6 +56,9 @@ const (
 	DeploymentStrategyTypeCustom DeploymentStrategyType = "Custom"
 	// DeploymentStrategyTypeRolling uses the Kubernetes RollingUpdater.
 	DeploymentStrategyTypeRolling DeploymentStrategyType = "Rolling"
+	// DeploymentStrategyTypeCanary rolls out a portion of the new replicas and
+	// verifies them before completing or rolling back the deployment.
+	DeploymentStrategyTypeCanary DeploymentStrategyType = "Canary"
 )
 
 // CustomParams are the input to the Custom deployment strategy.
@@ -119,6 +124,10 @@ type ExecNewPodHook struct {
 	// ContainerName is the name of a container in the deployment pod template
 	// whose Docker image will be used for the hook pod's container.
 	ContainerName string `json:"containerName"`
+	// Image is the Docker image to run in the hook pod's container instead of
+	// the image of the container named ContainerName, whose environment,
+	// resources and volume mounts are still inherited.
+	Image string `json:"image,omitempty"`
 	// Volumes is a list of named volumes from the pod template which should be
 	// copied to the hook pod. Volumes names not found in pod spec are ignored.
 	// An empty list means no volumes will be copied.
@@ -185,6 +194,34 @@ type RollingDeploymentStrategyParams struct {
 	Post *LifecycleHook `json:"post,omitempty"`
 }
 
+// CanaryDeploymentStrategyParams are the input to the Canary deployment
+// strategy.
+type CanaryDeploymentStrategyParams struct {
+	// TimeoutSeconds is the time to wait for updates before giving up. If the
+	// value is nil, a default will be used.
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+	// Size is the number of replicas of the new deployment rolled out as the
+	// canary. Value can be an absolute number (ex: 5) or a percentage of the
+	// desired replicas (ex: 10%). Absolute number is calculated from
+	// percentage by rounding up, and at least one replica is always rolled
+	// out. By default, 10% is used.
+	Size intstr.IntOrString `json:"size,omitempty"`
+	// VerificationSeconds is the time to wait after the canary replicas are
+	// ready before verifying them. If the value is nil, a default will be used.
+	VerificationSeconds *int64 `json:"verificationSeconds,omitempty"`
+	// Pre is a lifecycle hook which is executed before the deployment process
+	// begins. All LifecycleHookFailurePolicy values are supported.
+	Pre *LifecycleHook `json:"pre,omitempty"`
+	// Verify is a lifecycle hook which is executed once the verification
+	// window has passed. If it fails with the Abort or Retry policy, the
+	// deployment is rolled back to the previous deployment.
+	Verify *LifecycleHook `json:"verify,omitempty"`
+	// Post is a lifecycle hook which is executed after the strategy has
+	// finished all deployment logic. All LifecycleHookFailurePolicy values are
+	// supported.
+	Post *LifecycleHook `json:"post,omitempty"`
+}
+
 // These constants represent keys used for correlating objects related to deployments.
 const (
 	// DeploymentConfigAnnotation is an annotation name used to correlate a deployment with the
@@ -279,6 +316,17 @@ type DeploymentConfigSpec struct {
 	// or failing. Post strategy hooks and After actions can be used to integrate successful deployment with an action.
 	Test bool `json:"test"`
 
+	// Paused indicates that the deployment config is paused, so changes to
+	// its template or to the images it is triggered by will not result in new
+	// deployments until it is resumed.
+	Paused bool `json:"paused,omitempty"`
+
+	// ProgressDeadlineSeconds is the maximum number of seconds the latest deployment may run
+	// without making progress before it is cancelled and reported as failed in the Progressing
+	// condition. A deployment makes progress when its phase or its replica counts change. If
+	// unset, deployments are not cancelled for lack of progress.
+	ProgressDeadlineSeconds *int64 `json:"progressDeadlineSeconds,omitempty"`
+
 	// Selector is a label query over pods that should match the Replicas count.
 	Selector map[string]string `json:"selector,omitempty"`
 
@@ -294,6 +342,41 @@ type DeploymentConfigStatus struct {
 	// The reasons for the update to this deployment config.
 	// This could be based on a change made by the user or caused by an automatic trigger
 	Details *DeploymentDetails `json:"details,omitempty"`
+	// Conditions represent the latest available observations of the state of the deployment config.
+	Conditions []DeploymentCondition `json:"conditions,omitempty"`
+}
+
+// DeploymentConditionType is the type of a condition of a deployment config.
+type DeploymentConditionType string
+
+// These are valid conditions of a deployment config.
+const (
+	// DeploymentAvailable means the replication controllers of the deployment config run the
+	// desired number of replicas.
+	DeploymentAvailable DeploymentConditionType = "Available"
+	// DeploymentProgressing means the latest deployment is running or has completed. It is
+	// False when the latest deployment failed, was cancelled or exceeded its progress deadline.
+	DeploymentProgressing DeploymentConditionType = "Progressing"
+	// DeploymentReplicaFailure is added when the replication controller of the latest
+	// deployment could not be created.
+	DeploymentReplicaFailure DeploymentConditionType = "ReplicaFailure"
+)
+
+// DeploymentCondition describes the state of a deployment config at a certain point.
+type DeploymentCondition struct {
+	// Type of deployment condition.
+	Type DeploymentConditionType `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status kapi.ConditionStatus `json:"status"`
+	// LastUpdateTime is the last time this condition was updated. For the Progressing
+	// condition it is the last time the latest deployment was seen making progress.
+	LastUpdateTime unversioned.Time `json:"lastUpdateTime,omitempty"`
+	// LastTransitionTime is the last time the condition transitioned from one status to another.
+	LastTransitionTime unversioned.Time `json:"lastTransitionTime,omitempty"`
+	// Reason is the reason for the condition's last transition.
+	Reason string `json:"reason,omitempty"`
+	// Message is a human readable description of the details of the last transition.
+	Message string `json:"message,omitempty"`
 }
 
 // DeploymentTriggerPolicy describes a policy for a single trigger that results in a new deployment.
@@ -301,6 +384,8 @@ type DeploymentTriggerPolicy struct {
 	Type DeploymentTriggerType `json:"type,omitempty"`
 	// ImageChangeParams represents the parameters for the ImageChange trigger.
 	ImageChangeParams *DeploymentTriggerImageChangeParams `json:"imageChangeParams,omitempty"`
+	// ConfigChangeParams represents the parameters for the ConfigChange trigger.
+	ConfigChangeParams *DeploymentTriggerConfigChangeParams `json:"configChangeParams,omitempty"`
 }
 
 // DeploymentTriggerType refers to a specific DeploymentTriggerPolicy implementation.
@@ -323,12 +408,21 @@ type DeploymentTriggerImageChangeParams struct {
 	ContainerNames []string `json:"containerNames,omitempty"`
 	// From is a reference to an image stream tag to watch for changes. From.Name is the only
 	// required subfield - if From.Namespace is blank, the namespace of the current deployment
-	// trigger will be used.
+	// trigger will be used. The tag may be a glob pattern such as "release-*", in which case
+	// the image of the most recently updated matching tag is deployed.
 	From kapi.ObjectReference `json:"from"`
 	// LastTriggeredImage is the last image to be triggered.
 	LastTriggeredImage string `json:"lastTriggeredImage"`
 }
 
+// DeploymentTriggerConfigChangeParams represents the parameters to the ConfigChange trigger.
+type DeploymentTriggerConfigChangeParams struct {
+	// DebounceSeconds is how long the pod template must remain unchanged before a change to it
+	// results in a new deployment, so that several edits made within this window are rolled out
+	// together. Zero means that every change is deployed immediately.
+	DebounceSeconds int64 `json:"debounceSeconds,omitempty"`
+}
+
 // DeploymentDetails captures information about the causes of a deployment.
 type DeploymentDetails struct {
 	// The user specified change message, if this deployment was triggered manually by the user
@@ -381,6 +475,13 @@ type DeploymentConfigRollbackSpec struct {
 	IncludeStrategy bool `json:"includeStrategy"`
 }
 
+// DeploymentRequest is a request to a deployment config for a new deployment.
+type DeploymentRequest struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Name of the deployment config for requesting a new deployment.
+	Name string `json:"name"`
+}
+
 // DeploymentLog represents the logs for a deployment
 type DeploymentLog struct {
 	unversioned.TypeMeta `json:",inline"`
@@ -424,4 +525,12 @@ type DeploymentLogOptions struct {
 
 	// Version of the deployment for which to view logs.
 	Version *int64 `json:"version,omitempty"`
+
+	// AllPods if true returns the logs of the deployer pod and the lifecycle hook pods
+	// of the deployment interleaved in time order, with each line prefixed by the name
+	// of the pod it came from.
+	AllPods bool `json:"allPods,omitempty"`
+	// DeploymentPods if true also includes the logs of the pods created by the deployment
+	// when AllPods is set.
+	DeploymentPods bool `json:"deploymentPods,omitempty"`
 }
@@ -3,6 +3,7 @@ package validation
 import (
 	"errors"
 	"fmt"
+	"path"
 	"regexp"
 	"strconv"
 
@@ -42,6 +43,9 @@ func ValidateDeploymentConfig(config *deployapi.DeploymentConfig) field.ErrorLis
 	if config.Spec.Replicas < 0 {
 		allErrs = append(allErrs, field.Invalid(specPath.Child("replicas"), config.Spec.Replicas, "replicas cannot be negative"))
 	}
+	if config.Spec.ProgressDeadlineSeconds != nil && *config.Spec.ProgressDeadlineSeconds <= 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("progressDeadlineSeconds"), *config.Spec.ProgressDeadlineSeconds, "must be greater than zero"))
+	}
 	if len(config.Spec.Selector) == 0 {
 		allErrs = append(allErrs, field.Invalid(specPath.Child("selector"), config.Spec.Selector, "selector cannot be empty"))
 	}
@@ -79,6 +83,18 @@ func ValidateDeploymentConfigRollback(rollback *deployapi.DeploymentConfigRollba
 	return result
 }
 
+func ValidateDeploymentRequest(req *deployapi.DeploymentRequest) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(req.Name) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("name"), ""))
+	} else if ok, msg := validation.NameIsDNSSubdomain(req.Name, false); !ok {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("name"), req.Name, msg))
+	}
+
+	return allErrs
+}
+
 func validateDeploymentStrategy(strategy *deployapi.DeploymentStrategy, pod *kapi.PodSpec, fldPath *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
 
@@ -97,6 +113,12 @@ func validateDeploymentStrategy(strategy *deployapi.DeploymentStrategy, pod *kap
 		} else {
 			errs = append(errs, validateRollingParams(strategy.RollingParams, pod, fldPath.Child("rollingParams"))...)
 		}
+	case deployapi.DeploymentStrategyTypeCanary:
+		if strategy.CanaryParams == nil {
+			errs = append(errs, field.Required(fldPath.Child("canaryParams"), ""))
+		} else {
+			errs = append(errs, validateCanaryParams(strategy.CanaryParams, pod, fldPath.Child("canaryParams"))...)
+		}
 	case deployapi.DeploymentStrategyTypeCustom:
 		if strategy.CustomParams == nil {
 			errs = append(errs, field.Required(fldPath.Child("customParams"), ""))
@@ -193,6 +215,12 @@ func validateExecNewPod(hook *deployapi.ExecNewPodHook, fldPath *field.Path) fie
 		errs = append(errs, field.Required(fldPath.Child("containerName"), ""))
 	}
 
+	if len(hook.Image) > 0 {
+		if _, err := imageapi.ParseDockerImageReference(hook.Image); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Child("image"), hook.Image, err.Error()))
+		}
+	}
+
 	if len(hook.Env) > 0 {
 		errs = append(errs, validateEnv(hook.Env, fldPath.Child("env"))...)
 	}
@@ -274,6 +302,36 @@ func validateRollingParams(params *deployapi.RollingDeploymentStrategyParams, po
 	return errs
 }
 
+func validateCanaryParams(params *deployapi.CanaryDeploymentStrategyParams, pod *kapi.PodSpec, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if params.TimeoutSeconds != nil && *params.TimeoutSeconds < 1 {
+		errs = append(errs, field.Invalid(fldPath.Child("timeoutSeconds"), *params.TimeoutSeconds, "must be >0"))
+	}
+
+	if params.VerificationSeconds != nil && *params.VerificationSeconds < 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("verificationSeconds"), *params.VerificationSeconds, isNegativeErrorMsg))
+	}
+
+	errs = append(errs, ValidatePositiveIntOrPercent(params.Size, fldPath.Child("size"))...)
+	if getIntOrPercentValue(params.Size) == 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("size"), params.Size, "must be >0"))
+	}
+	errs = append(errs, IsNotMoreThan100Percent(params.Size, fldPath.Child("size"))...)
+
+	if params.Pre != nil {
+		errs = append(errs, validateLifecycleHook(params.Pre, pod, fldPath.Child("pre"))...)
+	}
+	if params.Verify != nil {
+		errs = append(errs, validateLifecycleHook(params.Verify, pod, fldPath.Child("verify"))...)
+	}
+	if params.Post != nil {
+		errs = append(errs, validateLifecycleHook(params.Post, pod, fldPath.Child("post"))...)
+	}
+
+	return errs
+}
+
 func validateTrigger(trigger *deployapi.DeploymentTriggerPolicy, fldPath *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
 
@@ -289,6 +347,14 @@ func validateTrigger(trigger *deployapi.DeploymentTriggerPolicy, fldPath *field.
 		}
 	}
 
+	if trigger.ConfigChangeParams != nil {
+		if trigger.Type != deployapi.DeploymentTriggerOnConfigChange {
+			errs = append(errs, field.Invalid(fldPath.Child("configChangeParams"), trigger.ConfigChangeParams, "may only be set for ConfigChange triggers"))
+		} else if trigger.ConfigChangeParams.DebounceSeconds < 0 {
+			errs = append(errs, field.Invalid(fldPath.Child("configChangeParams", "debounceSeconds"), trigger.ConfigChangeParams.DebounceSeconds, "must be a non-negative number of seconds"))
+		}
+	}
+
 	return errs
 }
 
@@ -318,10 +384,15 @@ func validateImageChangeParams(params *deployapi.DeploymentTriggerImageChangePar
 }
 
 func validateImageStreamTagName(istag string) error {
-	name, _, ok := imageapi.SplitImageStreamTag(istag)
+	name, tag, ok := imageapi.SplitImageStreamTag(istag)
 	if !ok {
 		return fmt.Errorf("invalid ImageStreamTag: %s", istag)
 	}
+	if imageapi.IsTagPattern(tag) {
+		if _, err := path.Match(tag, ""); err != nil {
+			return fmt.Errorf("invalid tag pattern: %v", err)
+		}
+	}
 	ok, reason := imageval.ValidateImageStreamName(name, false)
 	if !ok {
 		return errors.New(reason)
@@ -401,6 +472,9 @@ func ValidateDeploymentLogOptions(opts *deployapi.DeploymentLogOptions) field.Er
 	if opts.Version != nil && opts.Previous {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("previous"), opts.Previous, "cannot use previous when a version is specified"))
 	}
+	if opts.DeploymentPods && !opts.AllPods {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("deploymentPods"), opts.DeploymentPods, "may only be used with allPods"))
+	}
 
 	return allErrs
 }
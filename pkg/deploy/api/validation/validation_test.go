@@ -62,6 +62,25 @@ func rollingConfigMax(maxSurge, maxUnavailable intstr.IntOrString) api.Deploymen
 	}
 }
 
+func canaryConfig(size intstr.IntOrString, verification int) api.DeploymentConfig {
+	return api.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+		Spec: api.DeploymentConfigSpec{
+			Triggers: manualTrigger(),
+			Strategy: api.DeploymentStrategy{
+				Type: api.DeploymentStrategyTypeCanary,
+				CanaryParams: &api.CanaryDeploymentStrategyParams{
+					TimeoutSeconds:      mkint64p(1),
+					VerificationSeconds: mkint64p(verification),
+					Size:                size,
+				},
+			},
+			Template: test.OkPodTemplate(),
+			Selector: test.OkSelector(),
+		},
+	}
+}
+
 func TestValidateDeploymentConfigOK(t *testing.T) {
 	errs := ValidateDeploymentConfig(&api.DeploymentConfig{
 		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
@@ -184,6 +203,31 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			field.ErrorTypeInvalid,
 			"spec.triggers[0].imageChangeParams.from.kind",
 		},
+		"invalid Trigger imageChangeParams.from.name tag pattern": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Triggers: []api.DeploymentTriggerPolicy{
+						{
+							Type: api.DeploymentTriggerOnImageChange,
+							ImageChangeParams: &api.DeploymentTriggerImageChangeParams{
+								From: kapi.ObjectReference{
+									Kind: "ImageStreamTag",
+									Name: "name:release-[",
+								},
+								ContainerNames: []string{"foo"},
+							},
+						},
+					},
+					Selector: test.OkSelector(),
+					Strategy: test.OkStrategy(),
+					Template: test.OkPodTemplate(),
+				},
+			},
+			field.ErrorTypeInvalid,
+			"spec.triggers[0].imageChangeParams.from.name",
+		},
 		"missing Trigger imageChangeParams.containerNames": {
 			api.DeploymentConfig{
 				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
@@ -208,6 +252,55 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			field.ErrorTypeRequired,
 			"spec.triggers[0].imageChangeParams.containerNames",
 		},
+		"negative Trigger configChangeParams.debounceSeconds": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Triggers: []api.DeploymentTriggerPolicy{
+						{
+							Type: api.DeploymentTriggerOnConfigChange,
+							ConfigChangeParams: &api.DeploymentTriggerConfigChangeParams{
+								DebounceSeconds: -1,
+							},
+						},
+					},
+					Selector: test.OkSelector(),
+					Strategy: test.OkStrategy(),
+					Template: test.OkPodTemplate(),
+				},
+			},
+			field.ErrorTypeInvalid,
+			"spec.triggers[0].configChangeParams.debounceSeconds",
+		},
+		"Trigger configChangeParams on an ImageChange trigger": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Triggers: []api.DeploymentTriggerPolicy{
+						{
+							Type: api.DeploymentTriggerOnImageChange,
+							ImageChangeParams: &api.DeploymentTriggerImageChangeParams{
+								From: kapi.ObjectReference{
+									Kind: "ImageStreamTag",
+									Name: "foo:v1",
+								},
+								ContainerNames: []string{"foo"},
+							},
+							ConfigChangeParams: &api.DeploymentTriggerConfigChangeParams{
+								DebounceSeconds: 10,
+							},
+						},
+					},
+					Selector: test.OkSelector(),
+					Strategy: test.OkStrategy(),
+					Template: test.OkPodTemplate(),
+				},
+			},
+			field.ErrorTypeInvalid,
+			"spec.triggers[0].configChangeParams",
+		},
 		"missing strategy.type": {
 			api.DeploymentConfig{
 				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
@@ -371,6 +464,31 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			field.ErrorTypeInvalid,
 			"spec.strategy.recreateParams.pre.execNewPod.volumes[1]",
 		},
+		"invalid spec.strategy.recreateParams.pre.execNewPod.image": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRecreate,
+						RecreateParams: &api.RecreateDeploymentStrategyParams{
+							Pre: &api.LifecycleHook{
+								FailurePolicy: api.LifecycleHookFailurePolicyRetry,
+								ExecNewPod: &api.ExecNewPodHook{
+									ContainerName: "container",
+									Image:         "registry:8080/too/many/segments",
+									Command:       []string{"cmd"},
+								},
+							},
+						},
+					},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			field.ErrorTypeInvalid,
+			"spec.strategy.recreateParams.pre.execNewPod.image",
+		},
 		"missing spec.strategy.recreateParams.mid.execNewPod": {
 			api.DeploymentConfig{
 				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
@@ -593,6 +711,61 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			field.ErrorTypeInvalid,
 			"spec.strategy.rollingParams.maxSurge",
 		},
+		"missing spec.strategy.canaryParams": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Triggers: manualTrigger(),
+					Selector: test.OkSelector(),
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeCanary,
+					},
+					Template: test.OkPodTemplate(),
+				},
+			},
+			field.ErrorTypeRequired,
+			"spec.strategy.canaryParams",
+		},
+		"valid spec.strategy.canaryParams": {
+			canaryConfig(intstr.FromString("20%"), 30),
+			"",
+			"",
+		},
+		"invalid spec.strategy.canaryParams.verificationSeconds": {
+			canaryConfig(intstr.FromInt(1), -1),
+			field.ErrorTypeInvalid,
+			"spec.strategy.canaryParams.verificationSeconds",
+		},
+		"zero spec.strategy.canaryParams.size": {
+			canaryConfig(intstr.FromString("0%"), 0),
+			field.ErrorTypeInvalid,
+			"spec.strategy.canaryParams.size",
+		},
+		"invalid lower bound spec.strategy.canaryParams.size": {
+			canaryConfig(intstr.FromInt(-1), 0),
+			field.ErrorTypeInvalid,
+			"spec.strategy.canaryParams.size",
+		},
+		"invalid upper bound percent spec.strategy.canaryParams.size": {
+			canaryConfig(intstr.FromString("101%"), 0),
+			field.ErrorTypeInvalid,
+			"spec.strategy.canaryParams.size",
+		},
+		"invalid spec.progressDeadlineSeconds": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas:                1,
+					ProgressDeadlineSeconds: mkint64p(0),
+					Selector:                test.OkSelector(),
+					Strategy:                test.OkStrategy(),
+					Template:                test.OkPodTemplate(),
+				},
+			},
+			field.ErrorTypeInvalid,
+			"spec.progressDeadlineSeconds",
+		},
 	}
 
 	for testName, v := range errorCases {
@@ -682,6 +855,38 @@ func TestValidateDeploymentConfigUpdate(t *testing.T) {
 	}
 }
 
+func TestValidateDeploymentRequest(t *testing.T) {
+	tests := map[string]struct {
+		request  *api.DeploymentRequest
+		expected field.ErrorList
+	}{
+		"valid": {
+			request: &api.DeploymentRequest{Name: "config"},
+		},
+		"missing name": {
+			request:  &api.DeploymentRequest{},
+			expected: field.ErrorList{field.Required(field.NewPath("name"), "")},
+		},
+		"invalid name": {
+			request:  &api.DeploymentRequest{Name: "Config!"},
+			expected: field.ErrorList{field.Invalid(field.NewPath("name"), "Config!", "")},
+		},
+	}
+
+	for name, test := range tests {
+		errs := ValidateDeploymentRequest(test.request)
+		if len(errs) != len(test.expected) {
+			t.Errorf("%s: expected %d errors, got %v", name, len(test.expected), errs)
+			continue
+		}
+		for i := range errs {
+			if errs[i].Type != test.expected[i].Type || errs[i].Field != test.expected[i].Field {
+				t.Errorf("%s: expected error %v, got %v", name, test.expected[i], errs[i])
+			}
+		}
+	}
+}
+
 func TestValidateDeploymentConfigRollbackOK(t *testing.T) {
 	rollback := &api.DeploymentConfigRollback{
 		Spec: api.DeploymentConfigRollbackSpec{
@@ -779,6 +984,31 @@ func mkint64p(i int) *int64 {
 	return &v
 }
 
+func TestValidateDeploymentLogOptions(t *testing.T) {
+	version := int64(1)
+	tests := []struct {
+		opts  api.DeploymentLogOptions
+		field string
+	}{
+		{opts: api.DeploymentLogOptions{AllPods: true, Version: &version}},
+		{opts: api.DeploymentLogOptions{AllPods: true, DeploymentPods: true}},
+		{opts: api.DeploymentLogOptions{DeploymentPods: true}, field: "deploymentPods"},
+		{opts: api.DeploymentLogOptions{Previous: true, Version: &version}, field: "previous"},
+	}
+	for i, test := range tests {
+		errs := ValidateDeploymentLogOptions(&test.opts)
+		if len(test.field) == 0 {
+			if len(errs) != 0 {
+				t.Errorf("%d: unexpected validation errors: %v", i, errs)
+			}
+			continue
+		}
+		if len(errs) != 1 || errs[0].Field != test.field {
+			t.Errorf("%d: expected a single error for %s, got %v", i, test.field, errs)
+		}
+	}
+}
+
 func mkintp(i int) *int {
 	return &i
 }
@@ -1,7 +1,10 @@
 package configchange
 
 import (
+	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"time"
 
 	"github.com/golang/glog"
 
@@ -14,7 +17,10 @@ import (
 
 // DeploymentConfigChangeController increments the version of a
 // DeploymentConfig which has a config change trigger when a pod template
-// change is detected.
+// change is detected. When the config change trigger has a debounce window,
+// the template must remain unchanged for that long before it is deployed. The
+// pending template is recorded in annotations on the config, so the window is
+// kept across controller restarts.
 //
 // Use the DeploymentConfigChangeControllerFactory to create this controller.
 type DeploymentConfigChangeController struct {
@@ -22,6 +28,10 @@ type DeploymentConfigChangeController struct {
 	changeStrategy changeStrategy
 	// decodeConfig knows how to decode the deploymentConfig from a deployment's annotations.
 	decodeConfig func(deployment *kapi.ReplicationController) (*deployapi.DeploymentConfig, error)
+	// enqueueAfter requeues config to be handled again after the given delay.
+	enqueueAfter func(config *deployapi.DeploymentConfig, after time.Duration)
+	// now returns the current time.
+	now func() time.Time
 }
 
 // fatalError is an error which can't be retried.
@@ -38,6 +48,11 @@ func (c *DeploymentConfigChangeController) Handle(config *deployapi.DeploymentCo
 		return nil
 	}
 
+	if config.Spec.Paused {
+		glog.V(5).Infof("Ignoring DeploymentConfig %s; the config is paused", deployutil.LabelForDeploymentConfig(config))
+		return nil
+	}
+
 	if config.Status.LatestVersion == 0 {
 		_, _, abort, err := c.generateDeployment(config)
 		if err != nil {
@@ -74,7 +89,32 @@ func (c *DeploymentConfigChangeController) Handle(config *deployapi.DeploymentCo
 	// Detect template diffs, and return early if there aren't any changes.
 	if kapi.Semantic.DeepEqual(config.Spec.Template, deployedConfig.Spec.Template) {
 		glog.V(5).Infof("Ignoring DeploymentConfig change for %s (latestVersion=%d); same as Deployment %s", deployutil.LabelForDeploymentConfig(config), config.Status.LatestVersion, deployutil.LabelForDeployment(deployment))
-		return nil
+		// A pending change may have been reverted before it was deployed.
+		return c.updatePendingChange(config, "", time.Time{})
+	}
+
+	// There was a template diff. If the trigger has a debounce window, wait
+	// until the template has stopped changing for that long.
+	if debounce := changeTriggerDebounce(config); debounce > 0 {
+		now := c.now()
+		hash, err := templateHash(config.Spec.Template)
+		if err != nil {
+			return fatalError(fmt.Sprintf("couldn't hash the template of DeploymentConfig %s: %v", deployutil.LabelForDeploymentConfig(config), err))
+		}
+		since, pending := pendingChangeSince(config, hash)
+		if !pending {
+			glog.V(4).Infof("Deferring deployment of DeploymentConfig %s for %s; waiting for further template changes", deployutil.LabelForDeploymentConfig(config), debounce)
+			if err := c.updatePendingChange(config, hash, now); err != nil {
+				return err
+			}
+			c.enqueueAfter(config, debounce)
+			return nil
+		}
+		if remaining := since.Add(debounce).Sub(now); remaining > 0 {
+			glog.V(5).Infof("Deferring deployment of DeploymentConfig %s for another %s", deployutil.LabelForDeploymentConfig(config), remaining)
+			c.enqueueAfter(config, remaining)
+			return nil
+		}
 	}
 
 	// There was a template diff, so generate a new config version.
@@ -118,6 +158,9 @@ func (c *DeploymentConfigChangeController) generateDeployment(config *deployapi.
 	newConfig.Status.Details = &deployapi.DeploymentDetails{
 		Causes: causes,
 	}
+	// The pending template, if any, is deployed now.
+	delete(newConfig.Annotations, deployapi.DeploymentConfigPendingTemplateAnnotation)
+	delete(newConfig.Annotations, deployapi.DeploymentConfigPendingSinceAnnotation)
 
 	// This update is atomic. If it fails because a newer resource was already persisted, that's
 	// okay - we can just ignore the update for the old resource and any changes to the more
@@ -130,6 +173,74 @@ func (c *DeploymentConfigChangeController) generateDeployment(config *deployapi.
 	return config.Status.LatestVersion, updatedConfig.Status.LatestVersion, false, nil
 }
 
+// updatePendingChange records on config that the template with the given hash
+// has been waiting to be deployed since the given time. An empty hash clears
+// the pending change.
+func (c *DeploymentConfigChangeController) updatePendingChange(config *deployapi.DeploymentConfig, hash string, since time.Time) error {
+	_, hasTemplate := config.Annotations[deployapi.DeploymentConfigPendingTemplateAnnotation]
+	_, hasSince := config.Annotations[deployapi.DeploymentConfigPendingSinceAnnotation]
+	if len(hash) == 0 && !hasTemplate && !hasSince {
+		return nil
+	}
+	obj, err := kapi.Scheme.Copy(config)
+	if err != nil {
+		return err
+	}
+	pending := obj.(*deployapi.DeploymentConfig)
+	if len(hash) == 0 {
+		delete(pending.Annotations, deployapi.DeploymentConfigPendingTemplateAnnotation)
+		delete(pending.Annotations, deployapi.DeploymentConfigPendingSinceAnnotation)
+	} else {
+		if pending.Annotations == nil {
+			pending.Annotations = make(map[string]string)
+		}
+		pending.Annotations[deployapi.DeploymentConfigPendingTemplateAnnotation] = hash
+		pending.Annotations[deployapi.DeploymentConfigPendingSinceAnnotation] = since.UTC().Format(time.RFC3339)
+	}
+	if _, err := c.changeStrategy.updateDeploymentConfig(config.Namespace, pending); err != nil {
+		if kerrors.IsConflict(err) {
+			return fatalError(fmt.Sprintf("DeploymentConfig %s updated since retrieval; aborting trigger: %v", deployutil.LabelForDeploymentConfig(config), err))
+		}
+		return fmt.Errorf("couldn't record the pending change of DeploymentConfig %s: %v", deployutil.LabelForDeploymentConfig(config), err)
+	}
+	return nil
+}
+
+// pendingChangeSince returns the time at which the template with the given
+// hash was recorded as pending on config, and false if it is not pending.
+func pendingChangeSince(config *deployapi.DeploymentConfig, hash string) (time.Time, bool) {
+	if config.Annotations[deployapi.DeploymentConfigPendingTemplateAnnotation] != hash {
+		return time.Time{}, false
+	}
+	since, err := time.Parse(time.RFC3339, config.Annotations[deployapi.DeploymentConfigPendingSinceAnnotation])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return since, true
+}
+
+// templateHash returns a hash of the pod template.
+func templateHash(template *kapi.PodTemplateSpec) (string, error) {
+	data, err := json.Marshal(template)
+	if err != nil {
+		return "", err
+	}
+	hasher := fnv.New32a()
+	hasher.Write(data)
+	return fmt.Sprintf("%x", hasher.Sum32()), nil
+}
+
+// changeTriggerDebounce returns the debounce window of the config change
+// trigger of config.
+func changeTriggerDebounce(config *deployapi.DeploymentConfig) time.Duration {
+	for _, trigger := range config.Spec.Triggers {
+		if trigger.Type == deployapi.DeploymentTriggerOnConfigChange && trigger.ConfigChangeParams != nil {
+			return time.Duration(trigger.ConfigChangeParams.DebounceSeconds) * time.Second
+		}
+	}
+	return 0
+}
+
 // changeStrategy knows how to generate and update DeploymentConfigs.
 type changeStrategy interface {
 	getDeployment(namespace, name string) (*kapi.ReplicationController, error)
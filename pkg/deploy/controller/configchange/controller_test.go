@@ -2,6 +2,7 @@ package configchange
 
 import (
 	"testing"
+	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 
@@ -39,6 +40,35 @@ func TestHandle_newConfigNoTriggers(t *testing.T) {
 	}
 }
 
+// TestHandle_pausedConfig ensures that a change to a paused config with a
+// config change trigger doesn't result in a new config version bump.
+func TestHandle_pausedConfig(t *testing.T) {
+	controller := &DeploymentConfigChangeController{
+		decodeConfig: func(deployment *kapi.ReplicationController) (*deployapi.DeploymentConfig, error) {
+			return deployutil.DecodeDeploymentConfig(deployment, kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+		},
+		changeStrategy: &changeStrategyImpl{
+			generateDeploymentConfigFunc: func(namespace, name string) (*deployapi.DeploymentConfig, error) {
+				t.Fatalf("unexpected generation of deploymentConfig")
+				return nil, nil
+			},
+			updateDeploymentConfigFunc: func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+				t.Fatalf("unexpected update of deploymentConfig")
+				return config, nil
+			},
+		},
+	}
+
+	config := deployapitest.OkDeploymentConfig(0)
+	config.Spec.Triggers = []deployapi.DeploymentTriggerPolicy{deployapitest.OkConfigChangeTrigger()}
+	config.Spec.Paused = true
+	err := controller.Handle(config)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 // TestHandle_newConfigTriggers ensures that the creation of a new config
 // (with version 0) with a config change trigger results in a version bump and
 // cause update for initial deployment.
@@ -171,6 +201,99 @@ func TestHandle_changeWithTemplateDiff(t *testing.T) {
 	}
 }
 
+// TestHandle_debounce ensures that template changes to a config whose change
+// trigger has a debounce window are only deployed once the template has
+// stopped changing for the whole window, and that the pending change is kept
+// on the config rather than in the controller.
+func TestHandle_debounce(t *testing.T) {
+	config := deployapitest.OkDeploymentConfig(1)
+	trigger := deployapitest.OkConfigChangeTrigger()
+	trigger.ConfigChangeParams = &deployapi.DeploymentTriggerConfigChangeParams{DebounceSeconds: 30}
+	config.Spec.Triggers = []deployapi.DeploymentTriggerPolicy{trigger}
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+
+	var updated *deployapi.DeploymentConfig
+	var requeuedAfter time.Duration
+	now := time.Now().Truncate(time.Second)
+	// A new controller handles every step, as if it had been restarted.
+	newController := func() *DeploymentConfigChangeController {
+		return &DeploymentConfigChangeController{
+			decodeConfig: func(deployment *kapi.ReplicationController) (*deployapi.DeploymentConfig, error) {
+				return deployutil.DecodeDeploymentConfig(deployment, kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+			},
+			changeStrategy: &changeStrategyImpl{
+				generateDeploymentConfigFunc: func(namespace, name string) (*deployapi.DeploymentConfig, error) {
+					generated, err := kapi.Scheme.DeepCopy(config)
+					if err != nil {
+						return nil, err
+					}
+					return generated.(*deployapi.DeploymentConfig), nil
+				},
+				updateDeploymentConfigFunc: func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+					updated = config
+					return config, nil
+				},
+				getDeploymentFunc: func(namespace, name string) (*kapi.ReplicationController, error) {
+					return deployment, nil
+				},
+			},
+			enqueueAfter: func(config *deployapi.DeploymentConfig, after time.Duration) {
+				requeuedAfter = after
+			},
+			now: func() time.Time { return now },
+		}
+	}
+
+	handle := func(step string, modify func(*deployapi.DeploymentConfig), expectedRequeue time.Duration, pendingExpected, deployExpected bool) {
+		updated, requeuedAfter = nil, 0
+		changed, err := kapi.Scheme.DeepCopy(config)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", step, err)
+		}
+		config = changed.(*deployapi.DeploymentConfig)
+		modify(config)
+		if err := newController().Handle(config); err != nil {
+			t.Fatalf("%s: unexpected error: %v", step, err)
+		}
+		if e, a := expectedRequeue, requeuedAfter; e != a {
+			t.Errorf("%s: expected a requeue after %s, got %s", step, e, a)
+		}
+		if updated != nil {
+			config = updated
+		}
+		if deployed := updated != nil && updated.Status.LatestVersion == 2; deployed != deployExpected {
+			t.Errorf("%s: expected deployed to be %t, got %t", step, deployExpected, deployed)
+		}
+		_, pending := config.Annotations[deployapi.DeploymentConfigPendingTemplateAnnotation]
+		if pending != pendingExpected {
+			t.Errorf("%s: expected pending to be %t, got annotations %v", step, pendingExpected, config.Annotations)
+		}
+	}
+
+	handle("first change", func(config *deployapi.DeploymentConfig) {
+		config.Spec.Template.Labels["first"] = "value"
+	}, 30*time.Second, true, false)
+
+	handle("change reverted", func(config *deployapi.DeploymentConfig) {
+		delete(config.Spec.Template.Labels, "first")
+	}, 0, false, false)
+
+	handle("change reapplied", func(config *deployapi.DeploymentConfig) {
+		config.Spec.Template.Labels["first"] = "value"
+	}, 30*time.Second, true, false)
+
+	now = now.Add(20 * time.Second)
+	handle("second change", func(config *deployapi.DeploymentConfig) {
+		config.Spec.Template.Labels["second"] = "value"
+	}, 30*time.Second, true, false)
+
+	now = now.Add(20 * time.Second)
+	handle("within window", func(config *deployapi.DeploymentConfig) {}, 10*time.Second, true, false)
+
+	now = now.Add(10 * time.Second)
+	handle("window elapsed", func(config *deployapi.DeploymentConfig) {}, 0, false, true)
+}
+
 func TestHandle_raceWithTheImageController(t *testing.T) {
 	var updated *deployapi.DeploymentConfig
 
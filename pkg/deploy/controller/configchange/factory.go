@@ -60,6 +60,19 @@ func (factory *DeploymentConfigChangeControllerFactory) Create() controller.Runn
 		decodeConfig: func(deployment *kapi.ReplicationController) (*deployapi.DeploymentConfig, error) {
 			return deployutil.DecodeDeploymentConfig(deployment, factory.Codec)
 		},
+		enqueueAfter: func(config *deployapi.DeploymentConfig, after time.Duration) {
+			time.AfterFunc(after, func() {
+				// Requeue the current config, since it may have been changed while
+				// waiting.
+				latest, err := factory.Client.DeploymentConfigs(config.Namespace).Get(config.Name)
+				if err != nil {
+					utilruntime.HandleError(err)
+					return
+				}
+				queue.AddIfNotPresent(latest)
+			})
+		},
+		now: time.Now,
 	}
 
 	return &controller.RetryController{
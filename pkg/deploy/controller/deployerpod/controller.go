@@ -7,6 +7,7 @@ import (
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/record"
 
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
@@ -25,6 +26,8 @@ type DeployerPodController struct {
 	decodeConfig func(deployment *kapi.ReplicationController) (*deployapi.DeploymentConfig, error)
 	// deletePod deletes a pod.
 	deletePod func(namespace, name string) error
+	// recorder is used to record events.
+	recorder record.EventRecorder
 }
 
 // transientError is an error which will be retried indefinitely.
@@ -77,6 +80,8 @@ func (c *DeployerPodController) Handle(pod *kapi.Pod) error {
 
 	currentStatus := deployutil.DeploymentStatusFor(deployment)
 	nextStatus := currentStatus
+	// testConfig is set when a test deployment reaches its outcome.
+	var testConfig *deployapi.DeploymentConfig
 
 	switch pod.Status.Phase {
 	case kapi.PodRunning:
@@ -102,6 +107,7 @@ func (c *DeployerPodController) Handle(pod *kapi.Pod) error {
 		// reset the size of any test container, since we are the ones updating the RC
 		if config, err := c.decodeConfig(deployment); err == nil && config.Spec.Test {
 			deployment.Spec.Replicas = 0
+			testConfig = config
 		}
 	case kapi.PodFailed:
 		nextStatus = deployapi.DeploymentStatusFailed
@@ -109,6 +115,7 @@ func (c *DeployerPodController) Handle(pod *kapi.Pod) error {
 		// reset the size of any test container, since we are the ones updating the RC
 		if config, err := c.decodeConfig(deployment); err == nil && config.Spec.Test {
 			deployment.Spec.Replicas = 0
+			testConfig = config
 		}
 	}
 
@@ -121,6 +128,16 @@ func (c *DeployerPodController) Handle(pod *kapi.Pod) error {
 			return fmt.Errorf("couldn't update Deployment %s to status %s: %v", deployutil.LabelForDeployment(deployment), nextStatus, err)
 		}
 		glog.V(4).Infof("Updated deployment %s status from %s to %s (scale: %d)", deployutil.LabelForDeployment(deployment), currentStatus, nextStatus, deployment.Spec.Replicas)
+
+		// Record the outcome of test deployments on their config, since their
+		// replicas are gone once they finish.
+		if testConfig != nil {
+			if nextStatus == deployapi.DeploymentStatusComplete {
+				c.recorder.Eventf(testConfig, kapi.EventTypeNormal, "TestDeploymentSucceeded", "Test deployment %s succeeded and was scaled down to zero", deployutil.LabelForDeployment(deployment))
+			} else {
+				c.recorder.Eventf(testConfig, kapi.EventTypeWarning, "TestDeploymentFailed", "Test deployment %s failed and was scaled down to zero", deployutil.LabelForDeployment(deployment))
+			}
+		}
 	}
 
 	return nil
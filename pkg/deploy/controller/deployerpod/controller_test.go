@@ -1,10 +1,12 @@
 package deployerpod
 
 import (
+	"strings"
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/record"
 	"k8s.io/kubernetes/pkg/util/sets"
 
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
@@ -167,7 +169,9 @@ func TestHandle_podTerminatedOkTest(t *testing.T) {
 	deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusRunning)
 	var updatedDeployment *kapi.ReplicationController
 
+	recorder := &record.FakeRecorder{}
 	controller := &DeployerPodController{
+		recorder: recorder,
 		decodeConfig: func(deployment *kapi.ReplicationController) (*deployapi.DeploymentConfig, error) {
 			return deployutil.DecodeDeploymentConfig(deployment, kapi.Codecs.UniversalDecoder())
 		},
@@ -198,6 +202,9 @@ func TestHandle_podTerminatedOkTest(t *testing.T) {
 	if e, a := 0, updatedDeployment.Spec.Replicas; e != a {
 		t.Fatalf("expected updated deployment replicas to be %d, got %d", e, a)
 	}
+	if len(recorder.Events) != 1 || !strings.HasPrefix(recorder.Events[0], "Normal TestDeploymentSucceeded ") {
+		t.Fatalf("expected a TestDeploymentSucceeded event, got %v", recorder.Events)
+	}
 }
 
 // TestHandle_podTerminatedFailNoContainerStatus ensures that a failed
@@ -258,7 +265,9 @@ func TestHandle_podTerminatedFailNoContainerStatusTest(t *testing.T) {
 	// this also tests that the error is just logged and not result in a failure
 	deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusRunning)
 
+	recorder := &record.FakeRecorder{}
 	controller := &DeployerPodController{
+		recorder: recorder,
 		decodeConfig: func(deployment *kapi.ReplicationController) (*deployapi.DeploymentConfig, error) {
 			return deployutil.DecodeDeploymentConfig(deployment, kapi.Codecs.UniversalDecoder())
 		},
@@ -292,6 +301,9 @@ func TestHandle_podTerminatedFailNoContainerStatusTest(t *testing.T) {
 	if e, a := 0, updatedDeployment.Spec.Replicas; e != a {
 		t.Fatalf("expected updated deployment replicas to be %d, got %d", e, a)
 	}
+	if len(recorder.Events) != 1 || !strings.HasPrefix(recorder.Events[0], "Warning TestDeploymentFailed ") {
+		t.Fatalf("expected a TestDeploymentFailed event, got %v", recorder.Events)
+	}
 }
 
 // TestHandle_cleanupDesiredReplicasAnnotation ensures that the desired replicas annotation
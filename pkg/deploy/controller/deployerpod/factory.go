@@ -5,6 +5,7 @@ import (
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/client/record"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/runtime"
 	kutil "k8s.io/kubernetes/pkg/util"
@@ -56,6 +57,9 @@ func (factory *DeployerPodControllerFactory) Create() controller.RunnableControl
 	podQueue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
 	cache.NewReflector(podLW, &kapi.Pod{}, podQueue, 2*time.Minute).Run()
 
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(factory.KubeClient.Events(""))
+
 	podController := &DeployerPodController{
 		deploymentClient: &deploymentClientImpl{
 			getDeploymentFunc: func(namespace, name string) (*kapi.ReplicationController, error) {
@@ -87,6 +91,7 @@ func (factory *DeployerPodControllerFactory) Create() controller.RunnableControl
 		deletePod: func(namespace, name string) error {
 			return factory.KubeClient.Pods(namespace).Delete(name, kapi.NewDeleteOptions(0))
 		},
+		recorder: eventBroadcaster.NewRecorder(kapi.EventSource{Component: "deployer"}),
 	}
 
 	return &controller.RetryController{
@@ -3,6 +3,8 @@ package deploymentconfig
 import (
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/golang/glog"
 
@@ -31,6 +33,13 @@ import (
 // If a new version is observed for which no deployment exists, any running
 // deployments will be cancelled. The controller will not attempt to scale
 // running deployments.
+//
+// New versions of a paused config are not deployed until the config is
+// resumed; existing deployments are still reconciled.
+//
+// The controller also maintains the Available, Progressing and ReplicaFailure
+// conditions of the config, and cancels the latest deployment when it makes no
+// progress within the progress deadline of the config.
 type DeploymentConfigController struct {
 	// kubeClient provides acceess to Kube resources.
 	kubeClient kclient.Interface
@@ -79,6 +88,12 @@ func (c *DeploymentConfigController) Handle(config *deployapi.DeploymentConfig)
 	}
 
 	latestIsDeployed, latestDeployment := deployutil.LatestDeploymentInfo(config, existingDeployments)
+	// A paused config keeps its latest version queued until it is resumed, so
+	// neither supersede the existing deployments nor create a new one yet.
+	if !latestIsDeployed && config.Spec.Paused {
+		glog.V(4).Infof("Deferring deployment of version %d for %s; the config is paused", config.Status.LatestVersion, deployutil.LabelForDeploymentConfig(config))
+		return c.updateStatus(config, existingDeployments, nil)
+	}
 	// If the latest deployment doesn't exist yet, cancel any running
 	// deployments to allow them to be superceded by the new config version.
 	awaitingCancellations := false
@@ -115,9 +130,12 @@ func (c *DeploymentConfigController) Handle(config *deployapi.DeploymentConfig)
 		// If the latest deployment is still running, try again later. We don't
 		// want to compete with the deployer.
 		if !deployutil.IsTerminatedDeployment(latestDeployment) {
-			return nil
+			return c.updateStatus(config, existingDeployments, nil)
+		}
+		if err := c.reconcileDeployments(existingDeployments, config); err != nil {
+			return err
 		}
-		return c.reconcileDeployments(existingDeployments, config)
+		return c.updateStatus(config, existingDeployments, nil)
 	}
 	// No deployments are running and the latest deployment doesn't exist, so
 	// create the new deployment.
@@ -133,12 +151,130 @@ func (c *DeploymentConfigController) Handle(config *deployapi.DeploymentConfig)
 			return nil
 		}
 		c.recorder.Eventf(config, kapi.EventTypeWarning, "DeploymentCreationFailed", "Couldn't deploy version %d: %s", config.Status.LatestVersion, err)
+		if statusErr := c.updateStatus(config, existingDeployments, err); statusErr != nil {
+			glog.V(2).Infof("Couldn't update the status of deployment config %s: %v", deployutil.LabelForDeploymentConfig(config), statusErr)
+		}
 		return fmt.Errorf("couldn't create deployment for deployment config %s: %v", deployutil.LabelForDeploymentConfig(config), err)
 	}
 	c.recorder.Eventf(config, kapi.EventTypeNormal, "DeploymentCreated", "Created new deployment %q for version %d", created.Name, config.Status.LatestVersion)
+	existingDeployments.Items = append(existingDeployments.Items, *created)
+	return c.updateStatus(config, existingDeployments, nil)
+}
+
+// updateStatus computes the conditions of the config from its deployments and
+// updates the config if they changed. If the latest deployment has not made
+// progress within the progress deadline of the config, it is cancelled.
+// createErr is the error of a failed attempt to create the latest deployment.
+func (c *DeploymentConfigController) updateStatus(config *deployapi.DeploymentConfig, deployments *kapi.ReplicationControllerList, createErr error) error {
+	status := config.Status
+	status.Conditions = append([]deployapi.DeploymentCondition{}, config.Status.Conditions...)
+
+	// Test configs are scaled to zero once deployed, so they are never available.
+	if config.Spec.Test {
+		deployutil.RemoveDeploymentCondition(&status, deployapi.DeploymentAvailable)
+	} else {
+		available := 0
+		for _, deployment := range deployments.Items {
+			available += deployment.Status.Replicas
+		}
+		if available >= config.Spec.Replicas {
+			deployutil.SetDeploymentCondition(&status, *deployutil.NewDeploymentCondition(deployapi.DeploymentAvailable, kapi.ConditionTrue, deployapi.MinimumReplicasAvailable, "Deployment config has minimum availability."))
+		} else {
+			msg := fmt.Sprintf("%d of %d replicas are available.", available, config.Spec.Replicas)
+			deployutil.SetDeploymentCondition(&status, *deployutil.NewDeploymentCondition(deployapi.DeploymentAvailable, kapi.ConditionFalse, deployapi.MinimumReplicasUnavailable, msg))
+		}
+	}
+
+	latestIsDeployed, latest := deployutil.LatestDeploymentInfo(config, deployments)
+	switch {
+	case latestIsDeployed:
+		deployutil.SetDeploymentCondition(&status, *progressingCondition(latest))
+		progressing := deployutil.GetDeploymentCondition(status, deployapi.DeploymentProgressing)
+		if progressing.Reason == deployapi.ReplicationControllerUpdatedReason && progressDeadlineExceeded(config, progressing) {
+			if err := c.cancelStalledDeployment(config, latest); err != nil {
+				return err
+			}
+			deployutil.SetDeploymentCondition(&status, *progressingCondition(latest))
+		}
+		deployutil.RemoveDeploymentCondition(&status, deployapi.DeploymentReplicaFailure)
+	case config.Spec.Paused:
+		msg := fmt.Sprintf("Version %d will be deployed when the deployment config is resumed.", config.Status.LatestVersion)
+		deployutil.SetDeploymentCondition(&status, *deployutil.NewDeploymentCondition(deployapi.DeploymentProgressing, kapi.ConditionUnknown, deployapi.PausedConfigReason, msg))
+	}
+	if createErr != nil {
+		deployutil.SetDeploymentCondition(&status, *deployutil.NewDeploymentCondition(deployapi.DeploymentReplicaFailure, kapi.ConditionTrue, deployapi.FailedRcCreateReason, createErr.Error()))
+	}
+
+	if kapi.Semantic.DeepEqual(status.Conditions, config.Status.Conditions) {
+		return nil
+	}
+	config.Status.Conditions = status.Conditions
+	_, err := c.osClient.DeploymentConfigs(config.Namespace).Update(config)
+	if !errors.IsConflict(err) {
+		return err
+	}
+	// The config was changed since it was queued, for example by syncing its
+	// replicas with the active deployment, so retry on the current version.
+	current, err := c.osClient.DeploymentConfigs(config.Namespace).Get(config.Name)
+	if err != nil {
+		return err
+	}
+	current.Status.Conditions = status.Conditions
+	_, err = c.osClient.DeploymentConfigs(config.Namespace).Update(current)
+	return err
+}
+
+// cancelStalledDeployment cancels a deployment that has not made progress
+// within the progress deadline of its config.
+func (c *DeploymentConfigController) cancelStalledDeployment(config *deployapi.DeploymentConfig, deployment *kapi.ReplicationController) error {
+	deployment.Annotations[deployapi.DeploymentCancelledAnnotation] = deployapi.DeploymentCancelledAnnotationValue
+	deployment.Annotations[deployapi.DeploymentStatusReasonAnnotation] = deployapi.DeploymentCancelledProgressDeadline
+	if _, err := c.kubeClient.ReplicationControllers(deployment.Namespace).Update(deployment); err != nil {
+		c.recorder.Eventf(config, kapi.EventTypeWarning, "DeploymentCancellationFailed", "Failed to cancel deployment %q which did not progress within %d seconds: %s", deployment.Name, *config.Spec.ProgressDeadlineSeconds, err)
+		return err
+	}
+	c.recorder.Eventf(config, kapi.EventTypeNormal, "DeploymentCancelled", "Cancelled deployment %q which did not progress within %d seconds", deployment.Name, *config.Spec.ProgressDeadlineSeconds)
 	return nil
 }
 
+// progressingCondition returns the Progressing condition for the latest
+// deployment of a config. While the deployment runs, the message records its
+// phase and replica counts so that the update time of the condition changes
+// whenever the deployment makes progress.
+func progressingCondition(deployment *kapi.ReplicationController) *deployapi.DeploymentCondition {
+	phase := deployutil.DeploymentStatusFor(deployment)
+	switch {
+	case phase == deployapi.DeploymentStatusComplete:
+		msg := fmt.Sprintf("Replication controller %q has successfully progressed.", deployment.Name)
+		return deployutil.NewDeploymentCondition(deployapi.DeploymentProgressing, kapi.ConditionTrue, deployapi.NewRcAvailableReason, msg)
+	case deployutil.DeploymentStatusReasonFor(deployment) == deployapi.DeploymentCancelledProgressDeadline:
+		msg := fmt.Sprintf("Replication controller %q has timed out progressing.", deployment.Name)
+		return deployutil.NewDeploymentCondition(deployapi.DeploymentProgressing, kapi.ConditionFalse, deployapi.TimedOutReason, msg)
+	case deployutil.IsDeploymentCancelled(deployment):
+		msg := fmt.Sprintf("Rollout of replication controller %q was cancelled.", deployment.Name)
+		return deployutil.NewDeploymentCondition(deployapi.DeploymentProgressing, kapi.ConditionFalse, deployapi.CancelledRolloutReason, msg)
+	case phase == deployapi.DeploymentStatusFailed:
+		msg := fmt.Sprintf("Replication controller %q has failed progressing.", deployment.Name)
+		if reason := deployutil.DeploymentStatusReasonFor(deployment); len(reason) > 0 {
+			msg = fmt.Sprintf("Replication controller %q has failed progressing: %s.", deployment.Name, reason)
+		}
+		return deployutil.NewDeploymentCondition(deployapi.DeploymentProgressing, kapi.ConditionFalse, deployapi.FailedRolloutReason, msg)
+	default:
+		msg := fmt.Sprintf("Replication controller %q is %s with %d of %d replicas.", deployment.Name, strings.ToLower(string(phase)), deployment.Status.Replicas, deployment.Spec.Replicas)
+		return deployutil.NewDeploymentCondition(deployapi.DeploymentProgressing, kapi.ConditionTrue, deployapi.ReplicationControllerUpdatedReason, msg)
+	}
+}
+
+// progressDeadlineExceeded returns true if the config has a progress deadline
+// and the latest deployment has not made progress within it.
+func progressDeadlineExceeded(config *deployapi.DeploymentConfig, progressing *deployapi.DeploymentCondition) bool {
+	if config.Spec.ProgressDeadlineSeconds == nil {
+		return false
+	}
+	deadline := progressing.LastUpdateTime.Add(time.Duration(*config.Spec.ProgressDeadlineSeconds) * time.Second)
+	return time.Now().After(deadline)
+}
+
 // reconcileDeployments reconciles existing deployment replica counts which
 // could have diverged outside the deployment process (e.g. due to auto or
 // manual scaling, or partial deployments). The active deployment is the last
@@ -1,12 +1,15 @@
 package deploymentconfig
 
 import (
+	"fmt"
 	"sort"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/client/record"
 	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
 	"k8s.io/kubernetes/pkg/runtime"
@@ -65,6 +68,8 @@ func TestHandleScenarios(t *testing.T) {
 		replicas int
 		// test is whether this is a test deployment config
 		test bool
+		// paused is whether the config is paused
+		paused bool
 		// newVersion is the version of the config at the time of the update
 		newVersion int
 		// expectedReplicas is the expected config replica count after the update
@@ -138,6 +143,36 @@ func TestHandleScenarios(t *testing.T) {
 			},
 			errExpected: false,
 		},
+		{
+			name:             "new version while paused",
+			replicas:         1,
+			paused:           true,
+			newVersion:       3,
+			expectedReplicas: 1,
+			before: []deployment{
+				{version: 1, replicas: 1, replicasA: newint(1), status: deployapi.DeploymentStatusComplete, cancelled: false},
+				{version: 2, replicas: 0, replicasA: newint(0), desiredA: newint(1), status: deployapi.DeploymentStatusRunning, cancelled: false},
+			},
+			after: []deployment{
+				{version: 1, replicas: 1, replicasA: newint(1), status: deployapi.DeploymentStatusComplete, cancelled: false},
+				{version: 2, replicas: 0, replicasA: newint(0), desiredA: newint(1), status: deployapi.DeploymentStatusRunning, cancelled: false},
+			},
+			errExpected: false,
+		},
+		{
+			name:             "already deployed while paused",
+			replicas:         2,
+			paused:           true,
+			newVersion:       1,
+			expectedReplicas: 2,
+			before: []deployment{
+				{version: 1, replicas: 1, replicasA: newint(1), status: deployapi.DeploymentStatusComplete, cancelled: false},
+			},
+			after: []deployment{
+				{version: 1, replicas: 2, replicasA: newint(2), status: deployapi.DeploymentStatusComplete, cancelled: false},
+			},
+			errExpected: false,
+		},
 		{
 			name:             "already deployed",
 			replicas:         1,
@@ -660,6 +695,7 @@ func TestHandleScenarios(t *testing.T) {
 			config = deploytest.TestDeploymentConfig(config)
 		}
 		config.Spec.Replicas = test.replicas
+		config.Spec.Paused = test.paused
 		err := controller.Handle(config)
 		if err != nil && !test.errExpected {
 			t.Fatalf("unexpected error: %s", err)
@@ -694,6 +730,231 @@ func TestHandleScenarios(t *testing.T) {
 	}
 }
 
+func TestHandleConditions(t *testing.T) {
+	mkdeployment := func(version int, status deployapi.DeploymentStatus, replicas int) *kapi.ReplicationController {
+		deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(version), kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+		deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(status)
+		deployment.Spec.Replicas = replicas
+		deployment.Status.Replicas = replicas
+		return deployment
+	}
+	stalled := deployapi.DeploymentCondition{
+		Type:           deployapi.DeploymentProgressing,
+		Status:         kapi.ConditionTrue,
+		LastUpdateTime: unversioned.NewTime(time.Now().Add(-time.Hour)),
+		Reason:         deployapi.ReplicationControllerUpdatedReason,
+		Message:        `Replication controller "config-2" is running with 1 of 1 replicas.`,
+	}
+
+	tests := []struct {
+		name       string
+		deadline   *int64
+		paused     bool
+		conditions []deployapi.DeploymentCondition
+		existing   []*kapi.ReplicationController
+		createErr  error
+
+		expected  map[deployapi.DeploymentConditionType]string
+		cancelled bool
+	}{
+		{
+			name:     "complete latest deployment",
+			existing: []*kapi.ReplicationController{mkdeployment(1, deployapi.DeploymentStatusComplete, 1)},
+			expected: map[deployapi.DeploymentConditionType]string{
+				deployapi.DeploymentAvailable:   deployapi.MinimumReplicasAvailable,
+				deployapi.DeploymentProgressing: deployapi.NewRcAvailableReason,
+			},
+		},
+		{
+			name:     "running latest deployment",
+			existing: []*kapi.ReplicationController{mkdeployment(1, deployapi.DeploymentStatusRunning, 0)},
+			expected: map[deployapi.DeploymentConditionType]string{
+				deployapi.DeploymentAvailable:   deployapi.MinimumReplicasUnavailable,
+				deployapi.DeploymentProgressing: deployapi.ReplicationControllerUpdatedReason,
+			},
+		},
+		{
+			name:     "failed latest deployment",
+			existing: []*kapi.ReplicationController{mkdeployment(1, deployapi.DeploymentStatusFailed, 0)},
+			expected: map[deployapi.DeploymentConditionType]string{
+				deployapi.DeploymentAvailable:   deployapi.MinimumReplicasUnavailable,
+				deployapi.DeploymentProgressing: deployapi.FailedRolloutReason,
+			},
+		},
+		{
+			name:       "stalled deployment without a deadline",
+			conditions: []deployapi.DeploymentCondition{stalled},
+			existing: []*kapi.ReplicationController{
+				mkdeployment(1, deployapi.DeploymentStatusComplete, 0),
+				mkdeployment(2, deployapi.DeploymentStatusRunning, 1),
+			},
+			expected: map[deployapi.DeploymentConditionType]string{
+				deployapi.DeploymentAvailable:   deployapi.MinimumReplicasAvailable,
+				deployapi.DeploymentProgressing: deployapi.ReplicationControllerUpdatedReason,
+			},
+		},
+		{
+			name:       "stalled deployment within the deadline",
+			deadline:   mkint64p(7200),
+			conditions: []deployapi.DeploymentCondition{stalled},
+			existing: []*kapi.ReplicationController{
+				mkdeployment(1, deployapi.DeploymentStatusComplete, 0),
+				mkdeployment(2, deployapi.DeploymentStatusRunning, 1),
+			},
+			expected: map[deployapi.DeploymentConditionType]string{
+				deployapi.DeploymentAvailable:   deployapi.MinimumReplicasAvailable,
+				deployapi.DeploymentProgressing: deployapi.ReplicationControllerUpdatedReason,
+			},
+		},
+		{
+			name:       "stalled deployment past the deadline",
+			deadline:   mkint64p(600),
+			conditions: []deployapi.DeploymentCondition{stalled},
+			existing: []*kapi.ReplicationController{
+				mkdeployment(1, deployapi.DeploymentStatusComplete, 0),
+				mkdeployment(2, deployapi.DeploymentStatusRunning, 1),
+			},
+			expected: map[deployapi.DeploymentConditionType]string{
+				deployapi.DeploymentAvailable:   deployapi.MinimumReplicasAvailable,
+				deployapi.DeploymentProgressing: deployapi.TimedOutReason,
+			},
+			cancelled: true,
+		},
+		{
+			name:     "paused config",
+			paused:   true,
+			existing: []*kapi.ReplicationController{mkdeployment(1, deployapi.DeploymentStatusComplete, 1)},
+			expected: map[deployapi.DeploymentConditionType]string{
+				deployapi.DeploymentAvailable:   deployapi.MinimumReplicasAvailable,
+				deployapi.DeploymentProgressing: deployapi.PausedConfigReason,
+			},
+		},
+		{
+			name:      "failure creating the latest deployment",
+			existing:  []*kapi.ReplicationController{mkdeployment(1, deployapi.DeploymentStatusComplete, 1)},
+			createErr: fmt.Errorf("quota exceeded"),
+			expected: map[deployapi.DeploymentConditionType]string{
+				deployapi.DeploymentAvailable:      deployapi.MinimumReplicasAvailable,
+				deployapi.DeploymentReplicaFailure: deployapi.FailedRcCreateReason,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		deployments := map[string]kapi.ReplicationController{}
+		version := 0
+		for _, deployment := range test.existing {
+			deployments[deployment.Name] = *deployment
+			version = deployutil.DeploymentVersionFor(deployment)
+		}
+		if test.paused || test.createErr != nil {
+			version++
+		}
+
+		kc := &ktestclient.Fake{}
+		kc.AddReactor("list", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			list := []kapi.ReplicationController{}
+			for _, deployment := range deployments {
+				list = append(list, deployment)
+			}
+			return true, &kapi.ReplicationControllerList{Items: list}, nil
+		})
+		kc.AddReactor("create", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			return true, nil, test.createErr
+		})
+		kc.AddReactor("update", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			rc := action.(ktestclient.UpdateAction).GetObject().(*kapi.ReplicationController)
+			deployments[rc.Name] = *rc
+			return true, rc, nil
+		})
+		var updated *deployapi.DeploymentConfig
+		oc := &testclient.Fake{}
+		oc.AddReactor("update", "deploymentconfigs", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			updated = action.(ktestclient.UpdateAction).GetObject().(*deployapi.DeploymentConfig)
+			return true, updated, nil
+		})
+
+		controller := &DeploymentConfigController{
+			kubeClient: kc,
+			osClient:   oc,
+			codec:      kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion),
+			recorder:   &record.FakeRecorder{},
+		}
+
+		config := deploytest.OkDeploymentConfig(version)
+		config.Spec.Paused = test.paused
+		config.Spec.ProgressDeadlineSeconds = test.deadline
+		config.Status.Conditions = test.conditions
+		err := controller.Handle(config)
+		if err != nil && test.createErr == nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if updated == nil {
+			t.Errorf("%s: expected the config status to be updated", test.name)
+			continue
+		}
+
+		if e, a := len(test.expected), len(updated.Status.Conditions); e != a {
+			t.Errorf("%s: expected %d conditions, got %#v", test.name, e, updated.Status.Conditions)
+		}
+		for condType, reason := range test.expected {
+			condition := deployutil.GetDeploymentCondition(updated.Status, condType)
+			if condition == nil {
+				t.Errorf("%s: expected a %s condition", test.name, condType)
+				continue
+			}
+			if condition.Reason != reason {
+				t.Errorf("%s: expected %s condition reason %s, got %s", test.name, condType, reason, condition.Reason)
+			}
+		}
+
+		latest := deployments[deployutil.LatestDeploymentNameForConfig(config)]
+		if e, a := test.cancelled, deployutil.IsDeploymentCancelled(&latest); e != a {
+			t.Errorf("%s: expected the latest deployment cancelled to be %t, got %t", test.name, e, a)
+		}
+		if test.cancelled && deployutil.DeploymentStatusReasonFor(&latest) != deployapi.DeploymentCancelledProgressDeadline {
+			t.Errorf("%s: unexpected cancellation reason %q", test.name, deployutil.DeploymentStatusReasonFor(&latest))
+		}
+	}
+}
+
+func TestHandleUnchangedConditions(t *testing.T) {
+	deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(1), kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+	deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusComplete)
+	deployment.Annotations[deployapi.DeploymentReplicasAnnotation] = "1"
+	deployment.Spec.Replicas = 1
+	deployment.Status.Replicas = 1
+
+	kc := &ktestclient.Fake{}
+	kc.AddReactor("list", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &kapi.ReplicationControllerList{Items: []kapi.ReplicationController{*deployment}}, nil
+	})
+	oc := &testclient.Fake{}
+	controller := &DeploymentConfigController{
+		kubeClient: kc,
+		osClient:   oc,
+		codec:      kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion),
+		recorder:   &record.FakeRecorder{},
+	}
+
+	config := deploytest.OkDeploymentConfig(1)
+	config.Status.Conditions = []deployapi.DeploymentCondition{
+		*deployutil.NewDeploymentCondition(deployapi.DeploymentAvailable, kapi.ConditionTrue, deployapi.MinimumReplicasAvailable, "Deployment config has minimum availability."),
+		*deployutil.NewDeploymentCondition(deployapi.DeploymentProgressing, kapi.ConditionTrue, deployapi.NewRcAvailableReason, `Replication controller "config-1" has successfully progressed.`),
+	}
+	if err := controller.Handle(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actions := oc.Actions(); len(actions) != 0 {
+		t.Errorf("expected no config updates, got %v", actions)
+	}
+}
+
+func mkint64p(i int64) *int64 {
+	return &i
+}
+
 func newint(i int) *int {
 	return &i
 }
@@ -35,6 +35,10 @@ func (c *ImageChangeController) Handle(imageRepo *imageapi.ImageStream) error {
 	// Find any configs which should be updated based on the new image state
 	configsToUpdate := map[string]*deployapi.DeploymentConfig{}
 	for _, config := range configs {
+		if config.Spec.Paused {
+			glog.V(4).Infof("Ignoring paused DeploymentConfig %s", deployutil.LabelForDeploymentConfig(config))
+			continue
+		}
 		glog.V(4).Infof("Detecting changed images for DeploymentConfig %s", deployutil.LabelForDeploymentConfig(config))
 
 		for _, trigger := range config.Spec.Triggers {
@@ -55,16 +59,17 @@ func (c *ImageChangeController) Handle(imageRepo *imageapi.ImageStream) error {
 				return fmt.Errorf("invalid ImageStreamTag: %s", params.From.Name)
 			}
 
-			// Find the latest tag event for the trigger tag
-			latestEvent := imageapi.LatestTaggedImage(imageRepo, tag)
+			// Find the latest tag event for the trigger tag, or for the most recently
+			// updated tag matching it if the trigger tag is a pattern
+			latestTag, latestEvent := imageapi.LatestMatchingTaggedImage(imageRepo, tag)
 			if latestEvent == nil {
 				glog.V(5).Infof("Couldn't find latest tag event for tag %s in ImageStream %s", tag, labelForRepo(imageRepo))
 				continue
 			}
 
 			// Ensure a change occurred
-			if len(latestEvent.DockerImageReference) > 0 &&
-				latestEvent.DockerImageReference != params.LastTriggeredImage {
+			latestImage := imageapi.ResolveReferenceForTagEvent(imageRepo, latestTag, latestEvent)
+			if len(latestImage) > 0 && latestImage != params.LastTriggeredImage {
 				// Mark the config for regeneration
 				configsToUpdate[config.Name] = config
 			}
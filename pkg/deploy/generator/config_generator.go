@@ -55,15 +55,18 @@ func (g *DeploymentConfigGenerator) Generate(ctx kapi.Context, name string) (*de
 			continue
 		}
 
-		// Find the latest tag event for the trigger tag
-		latestEvent := imageapi.LatestTaggedImage(imageStream, tag)
+		// Find the latest tag event for the trigger tag, or for the most recently
+		// updated tag matching it if the trigger tag is a pattern
+		pattern := tag
+		tag, latestEvent := imageapi.LatestMatchingTaggedImage(imageStream, pattern)
 		if latestEvent == nil {
 			f := field.NewPath("triggers").Index(i).Child("imageChange", "tag")
-			errs = append(errs, field.Invalid(f, tag, fmt.Sprintf("no image recorded for %s/%s:%s", imageStream.Namespace, imageStream.Name, tag)))
+			errs = append(errs, field.Invalid(f, pattern, fmt.Sprintf("no image recorded for %s/%s:%s", imageStream.Namespace, imageStream.Name, pattern)))
 			continue
 		}
 
 		// Update containers
+		latestImage := imageapi.ResolveReferenceForTagEvent(imageStream, tag, latestEvent)
 		template := config.Spec.Template
 		names := sets.NewString(params.ContainerNames...)
 		containerChanged := false
@@ -72,12 +75,11 @@ func (g *DeploymentConfigGenerator) Generate(ctx kapi.Context, name string) (*de
 			if !names.Has(container.Name) {
 				continue
 			}
-			if len(latestEvent.DockerImageReference) > 0 &&
-				container.Image != latestEvent.DockerImageReference {
+			if len(latestImage) > 0 && container.Image != latestImage {
 				// Update the image
-				container.Image = latestEvent.DockerImageReference
+				container.Image = latestImage
 				// Log the last triggered image ID
-				params.LastTriggeredImage = latestEvent.DockerImageReference
+				params.LastTriggeredImage = latestImage
 				containerChanged = true
 			}
 		}
@@ -159,6 +159,42 @@ func TestGenerate_fromConfigWithUpdatedImageRef(t *testing.T) {
 	}
 }
 
+func TestGenerate_fromConfigWithTagPattern(t *testing.T) {
+	newRepoName := "registry:8080/openshift/test-image@sha256:00000000000000000000000000000002"
+	streamName := "test-image-stream"
+	newImageID := "00000000000000000000000000000002"
+
+	generator := &DeploymentConfigGenerator{
+		Client: Client{
+			DCFn: func(ctx kapi.Context, id string) (*deployapi.DeploymentConfig, error) {
+				config := deploytest.OkDeploymentConfig(1)
+				config.Spec.Triggers[0].ImageChangeParams.From.Name = imageapi.JoinImageStreamTag(streamName, "release-*")
+				return config, nil
+			},
+			ISFn: func(ctx kapi.Context, name string) (*imageapi.ImageStream, error) {
+				return makeStream(streamName, "release-1.0", newRepoName, newImageID), nil
+			},
+		},
+	}
+
+	config, err := generator.Generate(kapi.NewDefaultContext(), "deploy1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if config.Status.LatestVersion != 2 {
+		t.Fatalf("Expected config LatestVersion=2, got %d", config.Status.LatestVersion)
+	}
+
+	if expected, actual := newRepoName, config.Spec.Template.Spec.Containers[0].Image; actual != expected {
+		t.Fatalf("Expected container image %q, got %q", expected, actual)
+	}
+
+	if actual, expected := config.Status.Details.Causes[0].ImageTrigger.From.Name, imageapi.JoinImageStreamTag(streamName, "release-1.0"); actual != expected {
+		t.Fatalf("Expected cause %q, got %q", expected, actual)
+	}
+}
+
 func TestGenerate_reportsInvalidErrorWhenMissingRepo(t *testing.T) {
 	generator := &DeploymentConfigGenerator{
 		Client: Client{
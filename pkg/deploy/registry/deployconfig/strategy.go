@@ -12,6 +12,7 @@ import (
 
 	"github.com/openshift/origin/pkg/deploy/api"
 	"github.com/openshift/origin/pkg/deploy/api/validation"
+	imageapi "github.com/openshift/origin/pkg/image/api"
 )
 
 // strategy implements behavior for DeploymentConfig objects
@@ -39,14 +40,26 @@ func (strategy) AllowUnconditionalUpdate() bool {
 
 // PrepareForCreate clears fields that are not allowed to be set by end users on creation.
 func (strategy) PrepareForCreate(obj runtime.Object) {
-	_ = obj.(*api.DeploymentConfig)
+	dc := obj.(*api.DeploymentConfig)
 	// TODO: need to ensure status.latestVersion is not set out of order
+	expandImageReferences(dc)
 }
 
 // PrepareForUpdate clears fields that are not allowed to be set by end users on update.
 func (strategy) PrepareForUpdate(obj, old runtime.Object) {
-	_ = obj.(*api.DeploymentConfig)
+	dc := obj.(*api.DeploymentConfig)
 	// TODO: need to ensure status.latestVersion is not set out of order
+	expandImageReferences(dc)
+}
+
+// expandImageReferences moves the namespace of image stream references in the form
+// <namespace>/<name> to the namespace of the reference.
+func expandImageReferences(dc *api.DeploymentConfig) {
+	for i := range dc.Spec.Triggers {
+		if params := dc.Spec.Triggers[i].ImageChangeParams; params != nil {
+			imageapi.ExpandImageStreamReferenceNamespace(&params.From)
+		}
+	}
 }
 
 // Canonicalize normalizes the object after validation.
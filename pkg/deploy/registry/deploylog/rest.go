@@ -135,19 +135,26 @@ func (r *REST) Get(ctx kapi.Context, name string, opts runtime.Object) (runtime.
 		if !ok {
 			return nil, errors.NewTimeoutError(fmt.Sprintf("timed out waiting for deployment %s to start after %s", deployutil.LabelForDeployment(target), r.Timeout), 1)
 		}
-		if deployutil.DeploymentStatusFor(latest) == deployapi.DeploymentStatusComplete {
+		if deployutil.DeploymentStatusFor(latest) == deployapi.DeploymentStatusComplete && !deployLogOpts.AllPods {
 			podName, err = r.returnApplicationPodName(target)
 			if err != nil {
 				return nil, err
 			}
 		}
 	case deployapi.DeploymentStatusComplete:
+		if deployLogOpts.AllPods {
+			break
+		}
 		podName, err = r.returnApplicationPodName(target)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	if deployLogOpts.AllPods {
+		return r.aggregateLogs(ctx, target, deployLogOpts)
+	}
+
 	logOpts := deployapi.DeploymentToPodLogOptions(deployLogOpts)
 	location, transport, err := pod.LogLocation(&podGetter{r.PodGetter}, r.ConnectionInfo, ctx, podName, logOpts)
 	if err != nil {
@@ -163,6 +170,57 @@ func (r *REST) Get(ctx kapi.Context, name string, opts runtime.Object) (runtime.
 	}, nil
 }
 
+// aggregateLogs returns a streamer which interleaves the logs of the deployer
+// and lifecycle hook pods of target and, if requested, of the pods created by
+// target.
+func (r *REST) aggregateLogs(ctx kapi.Context, target *kapi.ReplicationController, opts *deployapi.DeploymentLogOptions) (runtime.Object, error) {
+	deployerPods, err := r.PodGetter.Pods(target.Namespace).List(kapi.ListOptions{LabelSelector: deployutil.DeployerPodSelector(target.Name)})
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	pods := deployerPods.Items
+	if opts.DeploymentPods {
+		deploymentPods, err := r.PodGetter.Pods(target.Namespace).List(kapi.ListOptions{LabelSelector: labels.Set(target.Spec.Selector).AsSelector()})
+		if err != nil {
+			return nil, errors.NewInternalError(err)
+		}
+		pods = append(pods, deploymentPods.Items...)
+	}
+	if len(pods) == 0 {
+		return nil, errors.NewBadRequest(fmt.Sprintf("no pods found for deployment %q", target.Name))
+	}
+	sort.Sort(byCreationTimestamp(pods))
+
+	streamer := &aggregateStreamer{follow: opts.Follow, timestamps: opts.Timestamps}
+	for _, p := range pods {
+		for _, container := range p.Spec.Containers {
+			prefix := p.Name
+			if len(p.Spec.Containers) > 1 {
+				prefix = p.Name + "/" + container.Name
+			}
+			// Timestamps are always requested so the logs can be ordered.
+			logOpts := deployapi.DeploymentToPodLogOptions(opts)
+			logOpts.Container = container.Name
+			logOpts.Timestamps = true
+			location, transport, err := pod.LogLocation(&podGetter{r.PodGetter}, r.ConnectionInfo, ctx, p.Name, logOpts)
+			if err != nil {
+				return nil, errors.NewBadRequest(err.Error())
+			}
+			streamer.logs = append(streamer.logs, podLog{
+				LocationStreamer: &genericrest.LocationStreamer{
+					Location:        location,
+					Transport:       transport,
+					ContentType:     "text/plain",
+					Flush:           opts.Follow,
+					ResponseChecker: genericrest.NewGenericHttpResponseChecker(kapi.Resource("pod"), p.Name),
+				},
+				prefix: prefix,
+			})
+		}
+	}
+	return streamer, nil
+}
+
 // podGetter implements the ResourceGetter interface. Used by LogLocation to
 // retrieve the deployer pod
 type podGetter struct {
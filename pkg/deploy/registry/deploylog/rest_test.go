@@ -12,6 +12,7 @@ import (
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
 	kubeletclient "k8s.io/kubernetes/pkg/kubelet/client"
+	"k8s.io/kubernetes/pkg/labels"
 	genericrest "k8s.io/kubernetes/pkg/registry/generic/rest"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/watch"
@@ -273,6 +274,100 @@ func TestRESTGet(t *testing.T) {
 	}
 }
 
+// TestRESTGetAllPods ensures that the logs of all the pods of a deployment
+// are aggregated in the order the pods were created.
+func TestRESTGetAllPods(t *testing.T) {
+	ctx := kapi.NewDefaultContext()
+	rest := mockREST(1, 1, api.DeploymentStatusRunning)
+
+	podFor := func(name string, created int, labels map[string]string, containers ...string) kapi.Pod {
+		pod := kapi.Pod{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:              name,
+				Namespace:         kapi.NamespaceDefault,
+				CreationTimestamp: unversioned.Date(2016, time.February, 1, 1, 0, created, 0, time.UTC),
+				Labels:            labels,
+			},
+			Spec: kapi.PodSpec{NodeName: "some-host"},
+		}
+		for _, container := range containers {
+			pod.Spec.Containers = append(pod.Spec.Containers, kapi.Container{Name: container})
+		}
+		return pod
+	}
+	deployerLabels := map[string]string{api.DeployerPodForDeploymentLabel: "config-1"}
+	pods := map[string]kapi.Pod{}
+	for _, p := range []kapi.Pod{
+		podFor("config-1-deploy", 1, deployerLabels, "deployment"),
+		podFor("config-1-hook-pre", 0, deployerLabels, "lifecycle"),
+		podFor("config-1-abcde", 2, testSelector, "app", "sidecar"),
+	} {
+		pods[p.Name] = p
+	}
+
+	fakePn := ktestclient.NewSimpleFake()
+	fakePn.PrependReactor("list", "pods", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		selector := action.(ktestclient.ListAction).GetListRestrictions().Labels
+		list := &kapi.PodList{}
+		for _, p := range pods {
+			if selector.Matches(labels.Set(p.Labels)) {
+				list.Items = append(list.Items, p)
+			}
+		}
+		return true, list, nil
+	})
+	fakePn.PrependReactor("get", "pods", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		p := pods[action.(ktestclient.GetAction).GetName()]
+		return true, &p, nil
+	})
+	rest.PodGetter = fakePn
+
+	tests := []struct {
+		opts     *api.DeploymentLogOptions
+		expected []string
+	}{
+		{
+			opts: &api.DeploymentLogOptions{AllPods: true},
+			expected: []string{
+				"config-1-hook-pre",
+				"config-1-deploy",
+			},
+		},
+		{
+			opts: &api.DeploymentLogOptions{AllPods: true, DeploymentPods: true},
+			expected: []string{
+				"config-1-hook-pre",
+				"config-1-deploy",
+				"config-1-abcde/app",
+				"config-1-abcde/sidecar",
+			},
+		},
+	}
+
+	for i, test := range tests {
+		got, err := rest.Get(ctx, "config", test.opts)
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		streamer, ok := got.(*aggregateStreamer)
+		if !ok {
+			t.Errorf("%d: expected an aggregate streamer, got %#v", i, got)
+			continue
+		}
+		prefixes := []string{}
+		for _, log := range streamer.logs {
+			prefixes = append(prefixes, log.prefix)
+			if log.Location.Query().Get("timestamps") != "true" {
+				t.Errorf("%d: expected the log of %s to be requested with timestamps: %v", i, log.prefix, log.Location)
+			}
+		}
+		if !reflect.DeepEqual(prefixes, test.expected) {
+			t.Errorf("%d: expected logs %v, got %v", i, test.expected, prefixes)
+		}
+	}
+}
+
 // TODO: These kind of functions seem to be used in lots of places
 // We should move it in a common location
 func intp(num int64) *int64 {
@@ -0,0 +1,216 @@
+package deploylog
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	genericrest "k8s.io/kubernetes/pkg/registry/generic/rest"
+)
+
+// podLog is the log of a single pod container which is part of an aggregated
+// deployment log.
+type podLog struct {
+	*genericrest.LocationStreamer
+
+	// prefix is prepended to every line of the log.
+	prefix string
+}
+
+// aggregateStreamer interleaves the logs of the pods related to a deployment,
+// prefixing every line with the pod it came from. The pod logs must be
+// requested with timestamps so they can be ordered.
+type aggregateStreamer struct {
+	logs []podLog
+
+	// follow interleaves the lines as they are received instead of ordering
+	// them by time.
+	follow bool
+	// timestamps keeps the timestamps of the lines in the aggregated log.
+	timestamps bool
+}
+
+// GetObjectKind implements runtime.Object.
+func (s *aggregateStreamer) GetObjectKind() unversioned.ObjectKind {
+	return unversioned.EmptyObjectKind
+}
+
+// InputStream opens the logs of all the pods and returns a stream of their
+// interleaved lines.
+func (s *aggregateStreamer) InputStream(apiVersion, acceptHeader string) (io.ReadCloser, bool, string, error) {
+	streams := []io.ReadCloser{}
+	lines := []*logLines{}
+	for _, log := range s.logs {
+		stream, _, _, err := log.InputStream(apiVersion, acceptHeader)
+		if err != nil {
+			for _, stream := range streams {
+				stream.Close()
+			}
+			return nil, false, "", err
+		}
+		// Pods which have not been scheduled yet have no log.
+		if stream == nil {
+			continue
+		}
+		streams = append(streams, stream)
+		lines = append(lines, &logLines{prefix: log.prefix, in: bufio.NewReader(stream)})
+	}
+	if len(streams) == 0 {
+		return nil, false, "", nil
+	}
+
+	r, w := io.Pipe()
+	go func() {
+		if s.follow {
+			w.CloseWithError(s.interleave(lines, w))
+		} else {
+			w.CloseWithError(s.merge(lines, w))
+		}
+	}()
+	return &aggregateReadCloser{PipeReader: r, streams: streams}, s.follow, "text/plain", nil
+}
+
+// merge writes the lines of all the logs to out in time order.
+func (s *aggregateStreamer) merge(logs []*logLines, out io.Writer) error {
+	heads := make([]*logLine, len(logs))
+	for i := range logs {
+		line, err := logs[i].next()
+		if err != nil {
+			return err
+		}
+		heads[i] = line
+	}
+	for {
+		first := -1
+		for i, line := range heads {
+			if line != nil && (first == -1 || line.time.Before(heads[first].time)) {
+				first = i
+			}
+		}
+		if first == -1 {
+			return nil
+		}
+		if _, err := io.WriteString(out, s.format(heads[first])); err != nil {
+			return err
+		}
+		line, err := logs[first].next()
+		if err != nil {
+			return err
+		}
+		heads[first] = line
+	}
+}
+
+// interleave writes the lines of all the logs to out as they are read.
+func (s *aggregateStreamer) interleave(logs []*logLines, out io.Writer) error {
+	received := make(chan *logLine)
+	errs := make(chan error, len(logs))
+	stop := make(chan struct{})
+	defer close(stop)
+	wg := &sync.WaitGroup{}
+	for i := range logs {
+		wg.Add(1)
+		go func(log *logLines) {
+			defer wg.Done()
+			for {
+				line, err := log.next()
+				if err != nil || line == nil {
+					errs <- err
+					return
+				}
+				select {
+				case received <- line:
+				case <-stop:
+					return
+				}
+			}
+		}(logs[i])
+	}
+	go func() {
+		wg.Wait()
+		close(received)
+	}()
+
+	for line := range received {
+		if _, err := io.WriteString(out, s.format(line)); err != nil {
+			return err
+		}
+	}
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// format returns line as it appears in the aggregated log.
+func (s *aggregateStreamer) format(line *logLine) string {
+	if s.timestamps && len(line.timestamp) > 0 {
+		return fmt.Sprintf("[%s] %s %s\n", line.prefix, line.timestamp, line.message)
+	}
+	return fmt.Sprintf("[%s] %s\n", line.prefix, line.message)
+}
+
+// logLine is a single line of a pod log.
+type logLine struct {
+	prefix    string
+	timestamp string
+	time      time.Time
+	message   string
+}
+
+// logLines reads the lines of a pod log requested with timestamps.
+type logLines struct {
+	prefix string
+	in     *bufio.Reader
+	// last is the time of the last line read, used for lines which have no
+	// timestamp of their own.
+	last time.Time
+}
+
+// next returns the next line of the log, or nil once the log has been read.
+func (l *logLines) next() (*logLine, error) {
+	text, err := l.in.ReadString('\n')
+	if len(text) == 0 {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	line := &logLine{prefix: l.prefix, time: l.last, message: strings.TrimRight(text, "\r\n")}
+	if parts := strings.SplitN(line.message, " ", 2); len(parts) == 2 {
+		if t, err := time.Parse(time.RFC3339Nano, parts[0]); err == nil {
+			line.timestamp, line.time, line.message = parts[0], t, parts[1]
+			l.last = t
+		}
+	}
+	return line, nil
+}
+
+// aggregateReadCloser closes the underlying pod log streams along with the
+// pipe so the aggregating goroutine exits when the client goes away.
+type aggregateReadCloser struct {
+	*io.PipeReader
+	streams []io.ReadCloser
+}
+
+func (r *aggregateReadCloser) Close() error {
+	r.PipeReader.Close()
+	var err error
+	for _, stream := range r.streams {
+		if closeErr := stream.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}
+	return err
+}
@@ -0,0 +1,93 @@
+package deploylog
+
+import (
+	"bufio"
+	"bytes"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func testLogLines(logs map[string]string) []*logLines {
+	prefixes := []string{}
+	for prefix := range logs {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	lines := []*logLines{}
+	for _, prefix := range prefixes {
+		lines = append(lines, &logLines{prefix: prefix, in: bufio.NewReader(strings.NewReader(logs[prefix]))})
+	}
+	return lines
+}
+
+func TestAggregateStreamerMerge(t *testing.T) {
+	logs := map[string]string{
+		"config-1-deploy":   "2016-05-12T10:00:01Z --> Scaling up config-1\n2016-05-12T10:00:05Z --> Success\n",
+		"config-1-hook-pre": "2016-05-12T10:00:00Z migrating database\ncontinued without timestamp\n2016-05-12T10:00:02Z done",
+	}
+	tests := []struct {
+		name     string
+		streamer aggregateStreamer
+		expected string
+	}{
+		{
+			name: "without timestamps",
+			expected: `[config-1-hook-pre] migrating database
+[config-1-hook-pre] continued without timestamp
+[config-1-deploy] --> Scaling up config-1
+[config-1-hook-pre] done
+[config-1-deploy] --> Success
+`,
+		},
+		{
+			name:     "with timestamps",
+			streamer: aggregateStreamer{timestamps: true},
+			expected: `[config-1-hook-pre] 2016-05-12T10:00:00Z migrating database
+[config-1-hook-pre] continued without timestamp
+[config-1-deploy] 2016-05-12T10:00:01Z --> Scaling up config-1
+[config-1-hook-pre] 2016-05-12T10:00:02Z done
+[config-1-deploy] 2016-05-12T10:00:05Z --> Success
+`,
+		},
+	}
+
+	for _, test := range tests {
+		out := &bytes.Buffer{}
+		if err := test.streamer.merge(testLogLines(logs), out); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if out.String() != test.expected {
+			t.Errorf("%s: expected:\n%s\ngot:\n%s", test.name, test.expected, out.String())
+		}
+	}
+}
+
+func TestAggregateStreamerInterleave(t *testing.T) {
+	logs := map[string]string{
+		"config-1-deploy":   "2016-05-12T10:00:01Z line 1\n2016-05-12T10:00:05Z line 2\n",
+		"config-1-hook-pre": "2016-05-12T10:00:00Z line 1\n",
+	}
+	out := &bytes.Buffer{}
+	streamer := aggregateStreamer{follow: true}
+	if err := streamer.interleave(testLogLines(logs), out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Lines are written as they are read, so only the order of the lines of
+	// each pod is known.
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected three lines, got:\n%s", out.String())
+	}
+	deployer := []string{}
+	for _, line := range lines {
+		if strings.HasPrefix(line, "[config-1-deploy] ") {
+			deployer = append(deployer, line)
+		}
+	}
+	if e, a := []string{"[config-1-deploy] line 1", "[config-1-deploy] line 2"}, deployer; strings.Join(e, "\n") != strings.Join(a, "\n") {
+		t.Errorf("expected deployer lines %v, got %v", e, a)
+	}
+}
@@ -0,0 +1,3 @@
+// Package instantiate contains the REST storage for the instantiate
+// subresource of deployment configs, which starts a new deployment.
+package instantiate
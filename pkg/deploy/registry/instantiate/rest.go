@@ -0,0 +1,79 @@
+package instantiate
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	"github.com/openshift/origin/pkg/deploy/api/validation"
+	"github.com/openshift/origin/pkg/deploy/registry/deployconfig"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+// REST starts new deployments of deployment configs. Only the Create method
+// is implemented.
+type REST struct {
+	registry deployconfig.Registry
+	rn       kclient.ReplicationControllersNamespacer
+}
+
+// NewREST returns a new REST storage for the instantiate subresource.
+func NewREST(registry deployconfig.Registry, rn kclient.ReplicationControllersNamespacer) *REST {
+	return &REST{
+		registry: registry,
+		rn:       rn,
+	}
+}
+
+// New creates an empty DeploymentRequest resource.
+func (r *REST) New() runtime.Object {
+	return &deployapi.DeploymentRequest{}
+}
+
+// Create starts a new deployment of the deployment config named by the
+// request, unless the config is paused or its latest deployment is still in
+// progress.
+func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
+	req, ok := obj.(*deployapi.DeploymentRequest)
+	if !ok {
+		return nil, kerrors.NewBadRequest(fmt.Sprintf("not a deployment request: %#v", obj))
+	}
+	if errs := validation.ValidateDeploymentRequest(req); len(errs) > 0 {
+		return nil, kerrors.NewInvalid(deployapi.Kind("DeploymentRequest"), req.Name, errs)
+	}
+
+	config, err := r.registry.GetDeploymentConfig(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if config.Spec.Paused {
+		return nil, kerrors.NewBadRequest(fmt.Sprintf("cannot deploy a paused deployment config %s/%s", config.Namespace, config.Name))
+	}
+
+	if config.Status.LatestVersion > 0 {
+		deployment, err := r.rn.ReplicationControllers(config.Namespace).Get(deployutil.LatestDeploymentNameForConfig(config))
+		switch {
+		case err == nil:
+			// Reject attempts to start a concurrent deployment.
+			status := deployutil.DeploymentStatusFor(deployment)
+			if status != deployapi.DeploymentStatusComplete && status != deployapi.DeploymentStatusFailed {
+				return nil, kerrors.NewConflict(deployapi.Resource("deploymentconfigs"), config.Name, fmt.Errorf("#%d is already in progress (%s)", config.Status.LatestVersion, status))
+			}
+		case !kerrors.IsNotFound(err):
+			return nil, err
+		}
+	}
+
+	config.Status.LatestVersion++
+	config.Status.Details = &deployapi.DeploymentDetails{
+		Causes: []*deployapi.DeploymentCause{{Type: deployapi.DeploymentTriggerManual}},
+	}
+	if err := r.registry.UpdateDeploymentConfig(ctx, config); err != nil {
+		return nil, err
+	}
+	return r.registry.GetDeploymentConfig(ctx, config.Name)
+}
@@ -0,0 +1,125 @@
+package instantiate
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	_ "github.com/openshift/origin/pkg/deploy/api/install"
+	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	"github.com/openshift/origin/pkg/deploy/registry/deployconfig"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+// fakeRegistry stores a single deployment config.
+type fakeRegistry struct {
+	deployconfig.Registry
+	config *deployapi.DeploymentConfig
+}
+
+func (r *fakeRegistry) GetDeploymentConfig(ctx kapi.Context, name string) (*deployapi.DeploymentConfig, error) {
+	if r.config == nil || r.config.Name != name {
+		return nil, kerrors.NewNotFound(deployapi.Resource("deploymentconfigs"), name)
+	}
+	copied := *r.config
+	return &copied, nil
+}
+
+func (r *fakeRegistry) UpdateDeploymentConfig(ctx kapi.Context, config *deployapi.DeploymentConfig) error {
+	r.config = config
+	return nil
+}
+
+func deploymentWithStatus(config *deployapi.DeploymentConfig, status deployapi.DeploymentStatus) *kapi.ReplicationController {
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+	deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(status)
+	return deployment
+}
+
+func TestCreate(t *testing.T) {
+	tests := map[string]struct {
+		config      *deployapi.DeploymentConfig
+		deployment  *kapi.ReplicationController
+		request     *deployapi.DeploymentRequest
+		expectErr   func(error) bool
+		expectedVer int
+	}{
+		"first deployment": {
+			config:      deploytest.OkDeploymentConfig(0),
+			request:     &deployapi.DeploymentRequest{Name: "config"},
+			expectedVer: 1,
+		},
+		"latest deployment complete": {
+			config:      deploytest.OkDeploymentConfig(1),
+			deployment:  deploymentWithStatus(deploytest.OkDeploymentConfig(1), deployapi.DeploymentStatusComplete),
+			request:     &deployapi.DeploymentRequest{Name: "config"},
+			expectedVer: 2,
+		},
+		"latest deployment failed": {
+			config:      deploytest.OkDeploymentConfig(1),
+			deployment:  deploymentWithStatus(deploytest.OkDeploymentConfig(1), deployapi.DeploymentStatusFailed),
+			request:     &deployapi.DeploymentRequest{Name: "config"},
+			expectedVer: 2,
+		},
+		"latest deployment in progress": {
+			config:     deploytest.OkDeploymentConfig(1),
+			deployment: deploymentWithStatus(deploytest.OkDeploymentConfig(1), deployapi.DeploymentStatusRunning),
+			request:    &deployapi.DeploymentRequest{Name: "config"},
+			expectErr:  kerrors.IsConflict,
+		},
+		"paused": {
+			config: func() *deployapi.DeploymentConfig {
+				config := deploytest.OkDeploymentConfig(1)
+				config.Spec.Paused = true
+				return config
+			}(),
+			request:   &deployapi.DeploymentRequest{Name: "config"},
+			expectErr: kerrors.IsBadRequest,
+		},
+		"missing config": {
+			config:    deploytest.OkDeploymentConfig(1),
+			request:   &deployapi.DeploymentRequest{Name: "other"},
+			expectErr: kerrors.IsNotFound,
+		},
+		"invalid request": {
+			config:    deploytest.OkDeploymentConfig(1),
+			request:   &deployapi.DeploymentRequest{},
+			expectErr: kerrors.IsInvalid,
+		},
+	}
+
+	for name, test := range tests {
+		client := ktestclient.NewSimpleFake()
+		if test.deployment != nil {
+			client = ktestclient.NewSimpleFake(test.deployment)
+		}
+		registry := &fakeRegistry{config: test.config}
+		rest := NewREST(registry, client)
+
+		obj, err := rest.Create(kapi.NewDefaultContext(), test.request)
+		if test.expectErr != nil {
+			if err == nil || !test.expectErr(err) {
+				t.Errorf("%s: unexpected error: %v", name, err)
+			}
+			if registry.config.Status.LatestVersion != test.config.Status.LatestVersion {
+				t.Errorf("%s: unexpected update of the config", name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+
+		config := obj.(*deployapi.DeploymentConfig)
+		if e, a := test.expectedVer, config.Status.LatestVersion; e != a {
+			t.Errorf("%s: expected latest version %d, got %d", name, e, a)
+		}
+		if config.Status.Details == nil || len(config.Status.Details.Causes) != 1 || config.Status.Details.Causes[0].Type != deployapi.DeploymentTriggerManual {
+			t.Errorf("%s: expected a manual cause, got %#v", name, config.Status.Details)
+		}
+	}
+}
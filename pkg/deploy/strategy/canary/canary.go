@@ -0,0 +1,239 @@
+package canary
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/intstr"
+
+	"github.com/openshift/origin/pkg/client"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	strat "github.com/openshift/origin/pkg/deploy/strategy"
+	stratsupport "github.com/openshift/origin/pkg/deploy/strategy/support"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+// CanaryDeploymentStrategy rolls out a portion of the new deployment next to
+// the last deployment, waits for a verification window and then either
+// completes the deployment or rolls it back.
+//
+// The new deployment is first scaled up to the canary size while the last
+// deployment is scaled down by the same amount. Once the canary replicas are
+// ready and the verification window has passed, the Verify hook is executed.
+// If it fails, the new deployment is scaled back down to zero and the last
+// deployment is restored to its former size. Otherwise the new deployment is
+// scaled up to the desired replica count and the last deployment is scaled
+// down to zero.
+type CanaryDeploymentStrategy struct {
+	// getReplicationController knows how to get a replication controller.
+	getReplicationController func(namespace, name string) (*kapi.ReplicationController, error)
+	// getUpdateAcceptor returns an UpdateAcceptor to verify the replicas of
+	// the deployment.
+	getUpdateAcceptor func(timeout time.Duration) strat.UpdateAcceptor
+	// scaler is used to scale replication controllers.
+	scaler kubectl.Scaler
+	// decoder is used to decode DeploymentConfigs contained in deployments.
+	decoder runtime.Decoder
+	// hookExecutor can execute a lifecycle hook.
+	hookExecutor hookExecutor
+	// sleep waits for the verification window to pass.
+	sleep func(time.Duration)
+	// retryTimeout is how long to wait for the replica count update to succeed
+	// before giving up.
+	retryTimeout time.Duration
+	// retryPeriod is how often to try updating the replica count.
+	retryPeriod time.Duration
+}
+
+// AcceptorInterval is how often the UpdateAcceptor should check for
+// readiness.
+const AcceptorInterval = 1 * time.Second
+
+// NewCanaryDeploymentStrategy makes a CanaryDeploymentStrategy backed by a
+// real HookExecutor and client.
+func NewCanaryDeploymentStrategy(client kclient.Interface, tagClient client.ImageStreamTagsNamespacer, decoder runtime.Decoder) *CanaryDeploymentStrategy {
+	scaler, _ := kubectl.ScalerFor(kapi.Kind("ReplicationController"), client)
+	return &CanaryDeploymentStrategy{
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			return client.ReplicationControllers(namespace).Get(name)
+		},
+		getUpdateAcceptor: func(timeout time.Duration) strat.UpdateAcceptor {
+			return stratsupport.NewAcceptNewlyObservedReadyPods(client, timeout, AcceptorInterval)
+		},
+		scaler:       scaler,
+		decoder:      decoder,
+		hookExecutor: stratsupport.NewHookExecutor(client, tagClient, os.Stdout, decoder),
+		sleep:        time.Sleep,
+		retryTimeout: 120 * time.Second,
+		retryPeriod:  1 * time.Second,
+	}
+}
+
+// Deploy rolls out the canary replicas of to, verifies them and then either
+// completes the deployment or rolls back to from.
+func (s *CanaryDeploymentStrategy) Deploy(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int) error {
+	config, err := deployutil.DecodeDeploymentConfig(to, s.decoder)
+	if err != nil {
+		return fmt.Errorf("couldn't decode config from deployment %s: %v", to.Name, err)
+	}
+
+	params := config.Spec.Strategy.CanaryParams
+	if params == nil {
+		return fmt.Errorf("deployment %s has no canary parameters", deployutil.LabelForDeployment(to))
+	}
+	retryParams := kubectl.NewRetryParams(s.retryPeriod, s.retryTimeout)
+	waitParams := kubectl.NewRetryParams(s.retryPeriod, s.retryTimeout)
+	updateAcceptor := s.getUpdateAcceptor(time.Duration(*params.TimeoutSeconds) * time.Second)
+
+	// Execute any pre-hook.
+	if params.Pre != nil {
+		if err := s.hookExecutor.Execute(params.Pre, to, deployapi.PreHookPodSuffix); err != nil {
+			return fmt.Errorf("Pre hook failed: %s", err)
+		}
+		glog.Infof("Pre hook finished")
+	}
+
+	canaryReplicas, err := canarySize(params.Size, desiredReplicas)
+	if err != nil {
+		return fmt.Errorf("couldn't compute the canary size of %s: %v", deployutil.LabelForDeployment(to), err)
+	}
+
+	fromReplicas := 0
+	if from != nil {
+		fromReplicas = from.Spec.Replicas
+	}
+
+	// Roll out the canary replicas and make room for them in the last
+	// deployment.
+	if canaryReplicas > 0 {
+		glog.Infof("Scaling %s to %d canary replicas", deployutil.LabelForDeployment(to), canaryReplicas)
+		updatedTo, err := s.scaleAndWait(to, canaryReplicas, retryParams, waitParams)
+		if err != nil {
+			return fmt.Errorf("couldn't scale %s to %d: %v", deployutil.LabelForDeployment(to), canaryReplicas, err)
+		}
+		to = updatedTo
+		if err := updateAcceptor.Accept(to); err != nil {
+			return s.rollback(from, fromReplicas, to, retryParams, waitParams, fmt.Errorf("update acceptor rejected %s: %v", deployutil.LabelForDeployment(to), err))
+		}
+	}
+	if from != nil && fromReplicas > desiredReplicas-canaryReplicas {
+		glog.Infof("Scaling %s down to %d", deployutil.LabelForDeployment(from), desiredReplicas-canaryReplicas)
+		updatedFrom, err := s.scaleAndWait(from, desiredReplicas-canaryReplicas, retryParams, waitParams)
+		if err != nil {
+			return s.rollback(from, fromReplicas, to, retryParams, waitParams, fmt.Errorf("couldn't scale %s to %d: %v", deployutil.LabelForDeployment(from), desiredReplicas-canaryReplicas, err))
+		}
+		from = updatedFrom
+	}
+
+	// Wait for the verification window to pass and verify the canary.
+	if params.VerificationSeconds != nil && *params.VerificationSeconds > 0 {
+		glog.Infof("Waiting %d seconds before verifying %s", *params.VerificationSeconds, deployutil.LabelForDeployment(to))
+		s.sleep(time.Duration(*params.VerificationSeconds) * time.Second)
+	}
+	if params.Verify != nil {
+		if err := s.hookExecutor.Execute(params.Verify, to, deployapi.VerifyHookPodSuffix); err != nil {
+			return s.rollback(from, fromReplicas, to, retryParams, waitParams, fmt.Errorf("verify hook failed: %s", err))
+		}
+		glog.Infof("Verify hook finished")
+	}
+
+	// Complete the rollout.
+	if to.Spec.Replicas != desiredReplicas {
+		glog.Infof("Scaling %s to %d", deployutil.LabelForDeployment(to), desiredReplicas)
+		updatedTo, err := s.scaleAndWait(to, desiredReplicas, retryParams, waitParams)
+		if err != nil {
+			return fmt.Errorf("couldn't scale %s to %d: %v", deployutil.LabelForDeployment(to), desiredReplicas, err)
+		}
+		to = updatedTo
+		if err := updateAcceptor.Accept(to); err != nil {
+			return fmt.Errorf("update acceptor rejected %s: %v", deployutil.LabelForDeployment(to), err)
+		}
+	}
+	if from != nil && from.Spec.Replicas > 0 {
+		glog.Infof("Scaling %s down to zero", deployutil.LabelForDeployment(from))
+		if _, err := s.scaleAndWait(from, 0, retryParams, waitParams); err != nil {
+			return fmt.Errorf("couldn't scale %s to 0: %v", deployutil.LabelForDeployment(from), err)
+		}
+	}
+
+	// Execute any post-hook.
+	if params.Post != nil {
+		if err := s.hookExecutor.Execute(params.Post, to, deployapi.PostHookPodSuffix); err != nil {
+			return fmt.Errorf("post hook failed: %s", err)
+		}
+		glog.Infof("Post hook finished")
+	}
+
+	glog.Infof("Deployment %s successfully made active", to.Name)
+	return nil
+}
+
+// rollback scales to down to zero and restores from to fromReplicas. The
+// returned error wraps cause and any error encountered while rolling back.
+func (s *CanaryDeploymentStrategy) rollback(from *kapi.ReplicationController, fromReplicas int, to *kapi.ReplicationController, retry, wait *kubectl.RetryParams, cause error) error {
+	glog.Infof("Canary of %s failed, rolling back: %v", deployutil.LabelForDeployment(to), cause)
+	if from != nil && from.Spec.Replicas != fromReplicas {
+		glog.Infof("Scaling %s back to %d", deployutil.LabelForDeployment(from), fromReplicas)
+		if _, err := s.scaleAndWait(from, fromReplicas, retry, wait); err != nil {
+			return fmt.Errorf("%v; couldn't scale %s back to %d: %v", cause, deployutil.LabelForDeployment(from), fromReplicas, err)
+		}
+	}
+	glog.Infof("Scaling %s down to zero", deployutil.LabelForDeployment(to))
+	if _, err := s.scaleAndWait(to, 0, retry, wait); err != nil {
+		return fmt.Errorf("%v; couldn't scale %s to 0: %v", cause, deployutil.LabelForDeployment(to), err)
+	}
+	return fmt.Errorf("%v; rolled back", cause)
+}
+
+func (s *CanaryDeploymentStrategy) scaleAndWait(deployment *kapi.ReplicationController, replicas int, retry *kubectl.RetryParams, wait *kubectl.RetryParams) (*kapi.ReplicationController, error) {
+	if err := s.scaler.Scale(deployment.Namespace, deployment.Name, uint(replicas), &kubectl.ScalePrecondition{Size: -1, ResourceVersion: ""}, retry, wait); err != nil {
+		return nil, err
+	}
+	updatedDeployment, err := s.getReplicationController(deployment.Namespace, deployment.Name)
+	if err != nil {
+		return nil, err
+	}
+	return updatedDeployment, nil
+}
+
+// canarySize returns the number of canary replicas to roll out for a
+// deployment of desiredReplicas. At least one replica is rolled out unless
+// no replicas are desired.
+func canarySize(size intstr.IntOrString, desiredReplicas int) (int, error) {
+	if desiredReplicas == 0 {
+		return 0, nil
+	}
+	replicas, err := intstr.GetValueFromIntOrPercent(&size, desiredReplicas, true)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case replicas < 1:
+		replicas = 1
+	case replicas > desiredReplicas:
+		replicas = desiredReplicas
+	}
+	return replicas, nil
+}
+
+// hookExecutor knows how to execute a deployment lifecycle hook.
+type hookExecutor interface {
+	Execute(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error
+}
+
+// hookExecutorImpl is a pluggable hookExecutor.
+type hookExecutorImpl struct {
+	executeFunc func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error
+}
+
+// Execute executes the provided lifecycle hook
+func (i *hookExecutorImpl) Execute(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+	return i.executeFunc(hook, deployment, label)
+}
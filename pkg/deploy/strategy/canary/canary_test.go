@@ -0,0 +1,244 @@
+package canary
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apimachinery/registered"
+	"k8s.io/kubernetes/pkg/util/intstr"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	scalertest "github.com/openshift/origin/pkg/deploy/scaler/test"
+	"github.com/openshift/origin/pkg/deploy/strategy"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+
+	_ "github.com/openshift/origin/pkg/api/install"
+)
+
+func TestCanary_initialDeployment(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Spec.Strategy = canaryParams(intstr.FromString("20%"), "")
+	to, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(registered.GroupOrDie(kapi.GroupName).GroupVersions[0]))
+	scaler := &scalertest.FakeScaler{}
+	strategy := newTestStrategy(scaler, to)
+
+	if err := strategy.Deploy(nil, to, 10); err != nil {
+		t.Fatalf("unexpected deploy error: %v", err)
+	}
+
+	expected := []scalertest.ScaleEvent{
+		{Name: to.Name, Size: 2},
+		{Name: to.Name, Size: 10},
+	}
+	if !reflect.DeepEqual(expected, scaler.Events) {
+		t.Fatalf("expected scale events %v, got %v", expected, scaler.Events)
+	}
+}
+
+func TestCanary_verifySuccess(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Spec.Strategy = canaryParams(intstr.FromString("25%"), deployapi.LifecycleHookFailurePolicyAbort)
+	from, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(registered.GroupOrDie(kapi.GroupName).GroupVersions[0]))
+	from.Spec.Replicas = 4
+	config.Status.LatestVersion = 2
+	to, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(registered.GroupOrDie(kapi.GroupName).GroupVersions[0]))
+	scaler := &scalertest.FakeScaler{}
+	strategy := newTestStrategy(scaler, from, to)
+
+	var slept time.Duration
+	strategy.sleep = func(d time.Duration) {
+		slept = d
+	}
+	verified := ""
+	strategy.hookExecutor = &hookExecutorImpl{
+		executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+			if deployment.Spec.Replicas != 1 {
+				t.Errorf("expected the hook to run against 1 canary replica, got %d", deployment.Spec.Replicas)
+			}
+			verified = label
+			return nil
+		},
+	}
+
+	if err := strategy.Deploy(from, to, 4); err != nil {
+		t.Fatalf("unexpected deploy error: %v", err)
+	}
+
+	if e, a := deployapi.VerifyHookPodSuffix, verified; e != a {
+		t.Errorf("expected the %s hook to be executed, got %q", e, a)
+	}
+	if e, a := 30*time.Second, slept; e != a {
+		t.Errorf("expected a verification window of %v, got %v", e, a)
+	}
+	expected := []scalertest.ScaleEvent{
+		{Name: to.Name, Size: 1},
+		{Name: from.Name, Size: 3},
+		{Name: to.Name, Size: 4},
+		{Name: from.Name, Size: 0},
+	}
+	if !reflect.DeepEqual(expected, scaler.Events) {
+		t.Fatalf("expected scale events %v, got %v", expected, scaler.Events)
+	}
+}
+
+func TestCanary_verifyFailureRollsBack(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Spec.Strategy = canaryParams(intstr.FromInt(2), deployapi.LifecycleHookFailurePolicyAbort)
+	from, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(registered.GroupOrDie(kapi.GroupName).GroupVersions[0]))
+	from.Spec.Replicas = 5
+	config.Status.LatestVersion = 2
+	to, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(registered.GroupOrDie(kapi.GroupName).GroupVersions[0]))
+	scaler := &scalertest.FakeScaler{}
+	strategy := newTestStrategy(scaler, from, to)
+	strategy.hookExecutor = &hookExecutorImpl{
+		executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+			return fmt.Errorf("hook execution failure")
+		},
+	}
+
+	err := strategy.Deploy(from, to, 5)
+	if err == nil {
+		t.Fatalf("expected a deploy error")
+	}
+	if !strings.Contains(err.Error(), "rolled back") {
+		t.Errorf("expected the deployment to be rolled back, got: %v", err)
+	}
+
+	expected := []scalertest.ScaleEvent{
+		{Name: to.Name, Size: 2},
+		{Name: from.Name, Size: 3},
+		{Name: from.Name, Size: 5},
+		{Name: to.Name, Size: 0},
+	}
+	if !reflect.DeepEqual(expected, scaler.Events) {
+		t.Fatalf("expected scale events %v, got %v", expected, scaler.Events)
+	}
+}
+
+func TestCanary_acceptorFailureRollsBack(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Spec.Strategy = canaryParams(intstr.FromInt(1), "")
+	to, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(registered.GroupOrDie(kapi.GroupName).GroupVersions[0]))
+	scaler := &scalertest.FakeScaler{}
+	canaryStrategy := newTestStrategy(scaler, to)
+	canaryStrategy.getUpdateAcceptor = func(timeout time.Duration) strategy.UpdateAcceptor {
+		return &testAcceptor{
+			acceptFn: func(deployment *kapi.ReplicationController) error {
+				return fmt.Errorf("rejected")
+			},
+		}
+	}
+
+	if err := canaryStrategy.Deploy(nil, to, 3); err == nil {
+		t.Fatalf("expected a deploy error")
+	}
+
+	expected := []scalertest.ScaleEvent{
+		{Name: to.Name, Size: 1},
+		{Name: to.Name, Size: 0},
+	}
+	if !reflect.DeepEqual(expected, scaler.Events) {
+		t.Fatalf("expected scale events %v, got %v", expected, scaler.Events)
+	}
+}
+
+func TestCanarySize(t *testing.T) {
+	tests := []struct {
+		size     intstr.IntOrString
+		desired  int
+		expected int
+	}{
+		{size: intstr.FromString("10%"), desired: 0, expected: 0},
+		{size: intstr.FromString("10%"), desired: 3, expected: 1},
+		{size: intstr.FromString("10%"), desired: 11, expected: 2},
+		{size: intstr.FromString("100%"), desired: 4, expected: 4},
+		{size: intstr.FromInt(0), desired: 4, expected: 1},
+		{size: intstr.FromInt(2), desired: 4, expected: 2},
+		{size: intstr.FromInt(6), desired: 4, expected: 4},
+	}
+
+	for _, test := range tests {
+		got, err := canarySize(test.size, test.desired)
+		if err != nil {
+			t.Errorf("%s of %d: unexpected error: %v", test.size.String(), test.desired, err)
+			continue
+		}
+		if got != test.expected {
+			t.Errorf("%s of %d: expected %d canary replicas, got %d", test.size.String(), test.desired, test.expected, got)
+		}
+	}
+}
+
+// newTestStrategy returns a strategy whose replication controllers reflect
+// the scale events recorded by scaler.
+func newTestStrategy(scaler *scalertest.FakeScaler, deployments ...*kapi.ReplicationController) *CanaryDeploymentStrategy {
+	return &CanaryDeploymentStrategy{
+		decoder:      kapi.Codecs.UniversalDecoder(),
+		retryTimeout: 1 * time.Second,
+		retryPeriod:  1 * time.Millisecond,
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			for _, deployment := range deployments {
+				if deployment.Name != name {
+					continue
+				}
+				copied := *deployment
+				for _, event := range scaler.Events {
+					if event.Name == name {
+						copied.Spec.Replicas = int(event.Size)
+					}
+				}
+				return &copied, nil
+			}
+			return nil, fmt.Errorf("unexpected deployment %s", name)
+		},
+		getUpdateAcceptor: getUpdateAcceptor,
+		hookExecutor: &hookExecutorImpl{
+			executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+				return nil
+			},
+		},
+		sleep:  func(time.Duration) {},
+		scaler: scaler,
+	}
+}
+
+func canaryParams(size intstr.IntOrString, verifyFailurePolicy deployapi.LifecycleHookFailurePolicy) deployapi.DeploymentStrategy {
+	var verify *deployapi.LifecycleHook
+	if len(verifyFailurePolicy) > 0 {
+		verify = &deployapi.LifecycleHook{
+			FailurePolicy: verifyFailurePolicy,
+			ExecNewPod:    &deployapi.ExecNewPodHook{},
+		}
+	}
+	timeout, verification := int64(30), int64(30)
+	return deployapi.DeploymentStrategy{
+		Type: deployapi.DeploymentStrategyTypeCanary,
+		CanaryParams: &deployapi.CanaryDeploymentStrategyParams{
+			TimeoutSeconds:      &timeout,
+			VerificationSeconds: &verification,
+			Size:                size,
+			Verify:              verify,
+		},
+	}
+}
+
+func getUpdateAcceptor(timeout time.Duration) strategy.UpdateAcceptor {
+	return &testAcceptor{
+		acceptFn: func(deployment *kapi.ReplicationController) error {
+			return nil
+		},
+	}
+}
+
+type testAcceptor struct {
+	acceptFn func(*kapi.ReplicationController) error
+}
+
+func (t *testAcceptor) Accept(deployment *kapi.ReplicationController) error {
+	return t.acceptFn(deployment)
+}
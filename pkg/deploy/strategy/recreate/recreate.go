@@ -143,6 +143,15 @@ func (s *RecreateDeploymentStrategy) DeployWithAcceptor(from *kapi.ReplicationCo
 			}
 			to = updatedTo
 		}
+
+		// A test deployment is verified against all of its replicas before
+		// it's scaled back down, so wait for the rest of them to be ready.
+		if config.Spec.Test && desiredReplicas > 1 {
+			glog.Infof("Performing acceptance check of all %d replicas of test deployment %s", desiredReplicas, deployutil.LabelForDeployment(to))
+			if err := updateAcceptor.Accept(to); err != nil {
+				return fmt.Errorf("update acceptor rejected %s: %v", deployutil.LabelForDeployment(to), err)
+			}
+		}
 	}
 
 	// Execute any post-hook.
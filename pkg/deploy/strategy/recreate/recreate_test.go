@@ -287,6 +287,42 @@ func TestRecreate_acceptorSuccess(t *testing.T) {
 	}
 }
 
+func TestRecreate_acceptorTestDeployment(t *testing.T) {
+	var deployment *kapi.ReplicationController
+	scaler := &scalertest.FakeScaler{}
+
+	strategy := &RecreateDeploymentStrategy{
+		decoder:      kapi.Codecs.UniversalDecoder(),
+		retryTimeout: 1 * time.Second,
+		retryPeriod:  1 * time.Millisecond,
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			return deployment, nil
+		},
+		scaler: scaler,
+	}
+
+	acceptorCalls := 0
+	acceptor := &testAcceptor{
+		acceptFn: func(deployment *kapi.ReplicationController) error {
+			acceptorCalls++
+			return nil
+		},
+	}
+
+	config := deploytest.TestDeploymentConfig(deploytest.OkDeploymentConfig(1))
+	deployment, _ = deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(registered.GroupOrDie(kapi.GroupName).GroupVersions[0]))
+	err := strategy.DeployWithAcceptor(nil, deployment, 3, acceptor)
+	if err != nil {
+		t.Fatalf("unexpected deploy error: %#v", err)
+	}
+
+	// The first replica and then all the replicas of a test deployment must
+	// be accepted.
+	if e, a := 2, acceptorCalls; e != a {
+		t.Fatalf("expected %d acceptance checks, got %d", e, a)
+	}
+}
+
 func TestRecreate_acceptorFail(t *testing.T) {
 	var deployment *kapi.ReplicationController
 	scaler := &scalertest.FakeScaler{}
@@ -290,6 +290,13 @@ func makeHookPod(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationCont
 		}
 	}
 
+	// Run the hook image if one is set, the working directory of the base
+	// container only makes sense with its own image.
+	image, workingDir := baseContainer.Image, baseContainer.WorkingDir
+	if len(exec.Image) > 0 {
+		image, workingDir = exec.Image, ""
+	}
+
 	// Transfer image pull secrets from the pod spec.
 	imagePullSecrets := []kapi.LocalObjectReference{}
 	for _, pullSecret := range deployment.Spec.Template.Spec.ImagePullSecrets {
@@ -310,9 +317,9 @@ func makeHookPod(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationCont
 			Containers: []kapi.Container{
 				{
 					Name:         HookContainerName,
-					Image:        baseContainer.Image,
+					Image:        image,
 					Command:      exec.Command,
-					WorkingDir:   baseContainer.WorkingDir,
+					WorkingDir:   workingDir,
 					Env:          mergedEnv,
 					Resources:    resources,
 					VolumeMounts: volumeMounts,
@@ -400,6 +400,44 @@ func TestHookExecutor_makeHookPod(t *testing.T) {
 	}
 }
 
+func TestHookExecutor_makeHookPodImage(t *testing.T) {
+	hook := &deployapi.LifecycleHook{
+		FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort,
+		ExecNewPod: &deployapi.ExecNewPodHook{
+			ContainerName: "container1",
+			Image:         "registry:8080/migrations:latest",
+			Command:       []string{"migrate"},
+		},
+	}
+
+	config := deploytest.OkDeploymentConfig(1)
+	config.Spec.Template.Spec.Containers[0].WorkingDir = "/app"
+	config.Spec.Template.Spec.Containers[0].Env = []kapi.EnvVar{{Name: "DATABASE_URL", Value: "db:5432"}}
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+
+	pod, err := makeHookPod(hook, deployment, &config.Spec.Strategy, "hook")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	container := pod.Spec.Containers[0]
+	if e, a := "registry:8080/migrations:latest", container.Image; e != a {
+		t.Errorf("expected hook image %s, got %s", e, a)
+	}
+	if len(container.WorkingDir) != 0 {
+		t.Errorf("expected the working directory of the base container not to be inherited, got %s", container.WorkingDir)
+	}
+	found := false
+	for _, env := range container.Env {
+		if env.Name == "DATABASE_URL" && env.Value == "db:5432" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected the environment of the base container to be inherited, got %v", container.Env)
+	}
+}
+
 func TestHookExecutor_makeHookPodRestart(t *testing.T) {
 	hook := &deployapi.LifecycleHook{
 		FailurePolicy: deployapi.LifecycleHookFailurePolicyRetry,
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
 
@@ -279,6 +280,61 @@ func IsTerminatedDeployment(deployment *api.ReplicationController) bool {
 	return current == deployapi.DeploymentStatusComplete || current == deployapi.DeploymentStatusFailed
 }
 
+// NewDeploymentCondition creates a new deployment config condition.
+func NewDeploymentCondition(condType deployapi.DeploymentConditionType, status api.ConditionStatus, reason, message string) *deployapi.DeploymentCondition {
+	now := unversioned.Now()
+	return &deployapi.DeploymentCondition{
+		Type:               condType,
+		Status:             status,
+		LastUpdateTime:     now,
+		LastTransitionTime: now,
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+// GetDeploymentCondition returns the condition of the provided type, or nil if
+// the status has no such condition.
+func GetDeploymentCondition(status deployapi.DeploymentConfigStatus, condType deployapi.DeploymentConditionType) *deployapi.DeploymentCondition {
+	for i := range status.Conditions {
+		if status.Conditions[i].Type == condType {
+			return &status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// SetDeploymentCondition adds the condition to the status, replacing any
+// condition of the same type. The transition time of the existing condition is
+// kept if its status is unchanged, and its update time is kept as well if the
+// reason and message are unchanged.
+func SetDeploymentCondition(status *deployapi.DeploymentConfigStatus, condition deployapi.DeploymentCondition) {
+	current := GetDeploymentCondition(*status, condition.Type)
+	if current == nil {
+		status.Conditions = append(status.Conditions, condition)
+		return
+	}
+	if current.Status == condition.Status {
+		condition.LastTransitionTime = current.LastTransitionTime
+		if current.Reason == condition.Reason && current.Message == condition.Message {
+			condition.LastUpdateTime = current.LastUpdateTime
+		}
+	}
+	*current = condition
+}
+
+// RemoveDeploymentCondition removes the condition of the provided type from
+// the status.
+func RemoveDeploymentCondition(status *deployapi.DeploymentConfigStatus, condType deployapi.DeploymentConditionType) {
+	var conditions []deployapi.DeploymentCondition
+	for _, c := range status.Conditions {
+		if c.Type != condType {
+			conditions = append(conditions, c)
+		}
+	}
+	status.Conditions = conditions
+}
+
 // annotationFor returns the annotation with key for obj.
 func annotationFor(obj runtime.Object, key string) string {
 	meta, err := api.ObjectMetaFor(obj)
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/context"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// admitImageStreamTag checks whether tagging a pushed image with tag does not exceed the maximum number of
+// tags per image stream set by limit ranges in the project. Returns ErrAccessDenied error if the limit is
+// exceeded.
+func admitImageStreamTag(ctx context.Context, repo *repository, tag string) error {
+	if len(tag) == 0 {
+		return nil
+	}
+
+	lrs, err := repo.quotaClient.LimitRanges(repo.namespace).List(kapi.ListOptions{})
+	if err != nil {
+		if kerrors.IsForbidden(err) {
+			context.GetLogger(ctx).Warnf("Cannot list limitranges because of outdated cluster roles: %v", err)
+			return nil
+		}
+		context.GetLogger(ctx).Errorf("Failed to list limitranges: %v", err)
+		return err
+	}
+
+	max, limited := maxImageStreamTags(lrs.Items)
+	if !limited {
+		return nil
+	}
+
+	tags := sets.NewString()
+	is, err := repo.getImageStream()
+	switch {
+	case err == nil:
+		for t := range is.Spec.Tags {
+			tags.Insert(t)
+		}
+		for t := range is.Status.Tags {
+			tags.Insert(t)
+		}
+	case kerrors.IsNotFound(err):
+		// the image stream will be auto-provisioned with just the pushed tag
+	default:
+		context.GetLogger(ctx).Errorf("Failed to get image stream %s/%s: %v", repo.namespace, repo.name, err)
+		return err
+	}
+
+	if tags.Has(tag) || int64(tags.Len()) < max {
+		return nil
+	}
+	context.GetLogger(ctx).Errorf("Refusing to add tag %q to image stream %s/%s: %s limited to %d by limit range", tag, repo.namespace, repo.name, imageapi.ResourceImageStreamTags, max)
+	return distribution.ErrAccessDenied
+}
+
+// maxImageStreamTags returns the lowest maximum number of tags per image stream set by the given limit
+// ranges. It returns false if the number of tags is not limited.
+func maxImageStreamTags(lrs []kapi.LimitRange) (int64, bool) {
+	var (
+		max     int64
+		limited bool
+	)
+	for _, lr := range lrs {
+		for _, item := range lr.Spec.Limits {
+			if item.Type != imageapi.LimitTypeImageStream {
+				continue
+			}
+			q, ok := item.Max[imageapi.ResourceImageStreamTags]
+			if !ok {
+				continue
+			}
+			if !limited || q.Value() < max {
+				max = q.Value()
+				limited = true
+			}
+		}
+	}
+	return max, limited
+}
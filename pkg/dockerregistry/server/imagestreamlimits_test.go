@@ -0,0 +1,120 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/context"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/resource"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func TestAdmitImageStreamTag(t *testing.T) {
+	stream := &imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "is"},
+		Spec: imageapi.ImageStreamSpec{
+			Tags: map[string]imageapi.TagReference{"latest": {}},
+		},
+		Status: imageapi.ImageStreamStatus{
+			Tags: map[string]imageapi.TagEventList{"latest": {}, "v1": {}},
+		},
+	}
+	limitRange := func(max string) kapi.LimitRange {
+		return kapi.LimitRange{
+			Spec: kapi.LimitRangeSpec{
+				Limits: []kapi.LimitRangeItem{
+					{
+						Type: imageapi.LimitTypeImageStream,
+						Max:  kapi.ResourceList{imageapi.ResourceImageStreamTags: resource.MustParse(max)},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		limits       []kapi.LimitRange
+		stream       *imageapi.ImageStream
+		tag          string
+		expectDenied bool
+	}{
+		{
+			name:   "no limit",
+			stream: stream,
+			tag:    "v2",
+		},
+		{
+			name:   "below limit",
+			limits: []kapi.LimitRange{limitRange("3")},
+			stream: stream,
+			tag:    "v2",
+		},
+		{
+			name:         "exceeds limit",
+			limits:       []kapi.LimitRange{limitRange("2")},
+			stream:       stream,
+			tag:          "v2",
+			expectDenied: true,
+		},
+		{
+			name:         "exceeds the lowest limit",
+			limits:       []kapi.LimitRange{limitRange("5"), limitRange("2")},
+			stream:       stream,
+			tag:          "v2",
+			expectDenied: true,
+		},
+		{
+			name:   "existing tag at limit",
+			limits: []kapi.LimitRange{limitRange("2")},
+			stream: stream,
+			tag:    "v1",
+		},
+		{
+			name:   "new image stream",
+			limits: []kapi.LimitRange{limitRange("1")},
+			tag:    "latest",
+		},
+		{
+			name:   "push by digest",
+			limits: []kapi.LimitRange{limitRange("0")},
+			stream: stream,
+		},
+	}
+
+	for _, test := range tests {
+		kclient := &ktestclient.Fake{}
+		kclient.AddReactor("list", "limitranges", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			return true, &kapi.LimitRangeList{Items: test.limits}, nil
+		})
+		client := &testclient.Fake{}
+		client.AddReactor("get", "imagestreams", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			if test.stream == nil {
+				return true, nil, kerrors.NewNotFound(imageapi.Resource("imagestreams"), "is")
+			}
+			return true, test.stream, nil
+		})
+		repo := &repository{
+			ctx:            context.Background(),
+			quotaClient:    kclient,
+			registryClient: client,
+			namespace:      "test",
+			name:           "is",
+		}
+
+		err := admitImageStreamTag(repo.ctx, repo, test.tag)
+		switch {
+		case test.expectDenied && err != distribution.ErrAccessDenied:
+			t.Errorf("%s: expected access denied, got %v", test.name, err)
+		case !test.expectDenied && err != nil:
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+	}
+}
@@ -22,6 +22,8 @@ type pullthroughBlobStore struct {
 
 	repo          *repository
 	digestToStore map[string]distribution.BlobStore
+	// mirror, if set, is the local store that blobs served from a remote repository are written to
+	mirror distribution.BlobStore
 }
 
 var _ distribution.BlobStore = &pullthroughBlobStore{}
@@ -120,17 +122,82 @@ func (r *pullthroughBlobStore) ServeBlob(ctx context.Context, w http.ResponseWri
 		context.GetLogger(r.repo.ctx).Errorf("Failure to open remote store %q: %v", dgst.String(), err)
 		return err
 	}
+	defer remoteReader.Close()
 
 	setResponseHeaders(w, desc.Size, desc.MediaType, dgst)
 
+	mirror := r.mirrorBlob(ctx, dgst)
+	var dst io.Writer = w
+	if mirror != nil {
+		dst = io.MultiWriter(w, mirror)
+	}
+
 	context.GetLogger(r.repo.ctx).Infof("Copying %d bytes of type %q for %q", desc.Size, desc.MediaType, dgst.String())
-	if _, err := io.CopyN(w, remoteReader, desc.Size); err != nil {
+	if _, err := io.CopyN(dst, remoteReader, desc.Size); err != nil {
 		context.GetLogger(r.repo.ctx).Errorf("Failed copying content from remote store %q: %v", dgst.String(), err)
+		if mirror != nil {
+			mirror.cancel(ctx)
+		}
 		return err
 	}
+	if mirror != nil {
+		mirror.commit(ctx, desc)
+	}
 	return nil
 }
 
+// mirrorBlob returns a writer that stores the content of the blob in the local store, or nil if
+// mirroring is disabled or the local store cannot accept the blob.
+func (r *pullthroughBlobStore) mirrorBlob(ctx context.Context, dgst digest.Digest) *blobMirror {
+	if r.mirror == nil {
+		return nil
+	}
+	bw, err := r.mirror.Create(ctx)
+	if err != nil {
+		context.GetLogger(r.repo.ctx).Errorf("Unable to mirror blob %q to the local store: %v", dgst.String(), err)
+		return nil
+	}
+	return &blobMirror{bw: bw, dgst: dgst}
+}
+
+// blobMirror writes content served from a remote repository to the local store. Errors writing
+// to the local store never fail the copy to the client, they only cause the mirrored blob to be
+// discarded.
+type blobMirror struct {
+	bw   distribution.BlobWriter
+	dgst digest.Digest
+	err  error
+}
+
+func (m *blobMirror) Write(p []byte) (int, error) {
+	if m.err == nil {
+		_, m.err = m.bw.Write(p)
+	}
+	return len(p), nil
+}
+
+// commit stores the mirrored blob, or discards it if writing to the local store failed.
+func (m *blobMirror) commit(ctx context.Context, desc distribution.Descriptor) {
+	if m.err != nil {
+		context.GetLogger(ctx).Errorf("Failed mirroring blob %q to the local store: %v", m.dgst.String(), m.err)
+		m.cancel(ctx)
+		return
+	}
+	if _, err := m.bw.Commit(ctx, desc); err != nil {
+		context.GetLogger(ctx).Errorf("Failed committing mirrored blob %q to the local store: %v", m.dgst.String(), err)
+		m.cancel(ctx)
+		return
+	}
+	context.GetLogger(ctx).Infof("Mirrored blob %q to the local store", m.dgst.String())
+}
+
+// cancel discards the mirrored blob.
+func (m *blobMirror) cancel(ctx context.Context) {
+	if err := m.bw.Cancel(ctx); err != nil {
+		context.GetLogger(ctx).Errorf("Failed discarding mirrored blob %q: %v", m.dgst.String(), err)
+	}
+}
+
 // findCandidateRepository looks in search for a particular blob, referring to previously cached items
 func (r *pullthroughBlobStore) findCandidateRepository(ctx context.Context, search map[string]*imageapi.DockerImageReference, cachedLayers []string, dgst digest.Digest, retriever importer.RepositoryRetriever) (distribution.Descriptor, error) {
 	// no possible remote locations to search, exit early
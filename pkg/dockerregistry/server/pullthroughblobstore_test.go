@@ -0,0 +1,133 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/context"
+	"github.com/docker/distribution/digest"
+)
+
+type nopSeekCloser struct {
+	*bytes.Reader
+}
+
+func (nopSeekCloser) Close() error { return nil }
+
+// remoteBlobStore serves a single blob.
+type remoteBlobStore struct {
+	distribution.BlobStore
+	content []byte
+}
+
+func (s *remoteBlobStore) Stat(ctx context.Context, dgst digest.Digest) (distribution.Descriptor, error) {
+	return distribution.Descriptor{Digest: dgst, Size: int64(len(s.content)), MediaType: "application/octet-stream"}, nil
+}
+
+func (s *remoteBlobStore) Open(ctx context.Context, dgst digest.Digest) (distribution.ReadSeekCloser, error) {
+	return nopSeekCloser{bytes.NewReader(s.content)}, nil
+}
+
+// localBlobStore records the blobs written to it.
+type localBlobStore struct {
+	distribution.BlobStore
+	writer *localBlobWriter
+}
+
+func (s *localBlobStore) Create(ctx context.Context) (distribution.BlobWriter, error) {
+	return s.writer, nil
+}
+
+type localBlobWriter struct {
+	distribution.BlobWriter
+	writeErr  error
+	content   bytes.Buffer
+	committed *distribution.Descriptor
+	cancelled bool
+}
+
+func (w *localBlobWriter) Write(p []byte) (int, error) {
+	if w.writeErr != nil {
+		return 0, w.writeErr
+	}
+	return w.content.Write(p)
+}
+
+func (w *localBlobWriter) Commit(ctx context.Context, provisional distribution.Descriptor) (distribution.Descriptor, error) {
+	w.committed = &provisional
+	return provisional, nil
+}
+
+func (w *localBlobWriter) Cancel(ctx context.Context) error {
+	w.cancelled = true
+	return nil
+}
+
+func TestPullthroughServeBlobMirror(t *testing.T) {
+	content := []byte("layer content")
+	dgst, err := digest.FromBytes(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		mirror        bool
+		writeErr      error
+		expectCommit  bool
+		expectCancel  bool
+		expectContent string
+	}{
+		{
+			name: "mirroring disabled",
+		},
+		{
+			name:          "mirrored",
+			mirror:        true,
+			expectCommit:  true,
+			expectContent: string(content),
+		},
+		{
+			name:         "local store failure",
+			mirror:       true,
+			writeErr:     errors.New("disk full"),
+			expectCancel: true,
+		},
+	}
+
+	for _, test := range tests {
+		ctx := context.Background()
+		writer := &localBlobWriter{writeErr: test.writeErr}
+		bs := &pullthroughBlobStore{
+			repo:          &repository{ctx: ctx},
+			digestToStore: map[string]distribution.BlobStore{dgst.String(): &remoteBlobStore{content: content}},
+		}
+		if test.mirror {
+			bs.mirror = &localBlobStore{writer: writer}
+		}
+
+		w := httptest.NewRecorder()
+		if err := bs.ServeBlob(ctx, w, nil, dgst); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if w.Body.String() != string(content) {
+			t.Errorf("%s: unexpected response body: %q", test.name, w.Body.String())
+		}
+		if test.expectCommit != (writer.committed != nil) {
+			t.Errorf("%s: unexpected commit: %#v", test.name, writer.committed)
+		}
+		if writer.committed != nil && (writer.committed.Digest != dgst || writer.committed.Size != int64(len(content))) {
+			t.Errorf("%s: unexpected committed descriptor: %#v", test.name, writer.committed)
+		}
+		if test.expectCancel != writer.cancelled {
+			t.Errorf("%s: expected cancel to be %t", test.name, test.expectCancel)
+		}
+		if writer.content.String() != test.expectContent {
+			t.Errorf("%s: unexpected mirrored content: %q", test.name, writer.content.String())
+		}
+	}
+}
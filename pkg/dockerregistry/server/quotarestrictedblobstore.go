@@ -5,7 +5,9 @@
 // Module with quotaRestrictedBlobStore defines a wrapper for upstream blob store that does an image quota
 // check before committing image layer to a registry. Master server contains admission check that will refuse
 // the manifest if the image exceeds whatever quota set. But the check occurs too late (after the layers are
-// written). This addition allows us to refuse the layers and thus keep the storage clean.
+// written). This addition allows us to refuse the layers and thus keep the storage clean. Besides the number
+// of images, the check covers the total size of images stored in the project and, when a push is about to
+// auto-provision a new image stream, the number of image streams.
 //
 // There are few things to keep in mind:
 //
@@ -23,7 +25,7 @@
 //      This leads to a situation where several layers can be written until a big enough layer will be
 //      received that exceeds quota limit.
 //
-//   3. Image stream size quota doesn't accumulate. Iow, its usage is NOT permanently stored in a resource
+//   4. Image stream size quota doesn't accumulate. Iow, its usage is NOT permanently stored in a resource
 //      quota object. It's updated just for a very short period of time between an ImageStreamMapping object
 //      is allowed by admission plugin to be created and subsequent quota refresh triggered by resource quota
 //      controller. Therefore its check will probably not ever trigger unless uploaded layer is really big. We
@@ -58,7 +60,7 @@ var _ distribution.BlobStore = &quotaRestrictedBlobStore{}
 func (bs *quotaRestrictedBlobStore) Put(ctx context.Context, mediaType string, p []byte) (distribution.Descriptor, error) {
 	context.GetLogger(ctx).Debug("(*quotaRestrictedBlobStore).Put: starting")
 
-	if err := admitBlobWrite(ctx, bs.repo); err != nil {
+	if err := admitBlobWrite(ctx, bs.repo, int64(len(p))); err != nil {
 		context.GetLogger(ctx).Error(err.Error())
 		return distribution.Descriptor{}, err
 	}
@@ -111,7 +113,7 @@ type quotaRestrictedBlobWriter struct {
 func (bw *quotaRestrictedBlobWriter) Commit(ctx context.Context, provisional distribution.Descriptor) (canonical distribution.Descriptor, err error) {
 	context.GetLogger(ctx).Debug("(*quotaRestrictedBlobWriter).Commit: starting")
 
-	if err := admitBlobWrite(ctx, bw.repo); err != nil {
+	if err := admitBlobWrite(ctx, bw.repo, provisional.Size); err != nil {
 		context.GetLogger(ctx).Error(err.Error())
 		return distribution.Descriptor{}, err
 	}
@@ -120,9 +122,9 @@ func (bw *quotaRestrictedBlobWriter) Commit(ctx context.Context, provisional dis
 	return can, err
 }
 
-// admitBlobWrite checks whether the blob does not exceed image quota, if set. Returns
+// admitBlobWrite checks whether the blob of the given size does not exceed image quota, if set. Returns
 // ErrAccessDenied error if the quota is exceeded.
-func admitBlobWrite(ctx context.Context, repo *repository) error {
+func admitBlobWrite(ctx context.Context, repo *repository, size int64) error {
 	rqs, err := repo.quotaClient.ResourceQuotas(repo.namespace).List(kapi.ListOptions{})
 	if err != nil {
 		if kerrors.IsForbidden(err) {
@@ -135,7 +137,14 @@ func admitBlobWrite(ctx context.Context, repo *repository) error {
 
 	usage := kapi.ResourceList{
 		// we are about to tag a single image to an image stream
-		imageapi.ResourceImages: *resource.NewQuantity(1, resource.DecimalSI),
+		imageapi.ResourceImages:     *resource.NewQuantity(1, resource.DecimalSI),
+		imageapi.ResourceImagesSize: *resource.NewQuantity(size, resource.BinarySI),
+	}
+	if limitsImageStreams(rqs.Items) {
+		// the image stream will be auto-provisioned when the manifest is pushed
+		if _, err := repo.getImageStream(); kerrors.IsNotFound(err) {
+			usage[imageapi.ResourceImageStreams] = *resource.NewQuantity(1, resource.DecimalSI)
+		}
 	}
 	resources := quota.ResourceNames(usage)
 
@@ -158,3 +167,13 @@ func admitBlobWrite(ctx context.Context, repo *repository) error {
 
 	return nil
 }
+
+// limitsImageStreams returns true if any of the quotas restricts the number of image streams.
+func limitsImageStreams(rqs []kapi.ResourceQuota) bool {
+	for _, rq := range rqs {
+		if _, ok := rq.Spec.Hard[imageapi.ResourceImageStreams]; ok {
+			return true
+		}
+	}
+	return false
+}
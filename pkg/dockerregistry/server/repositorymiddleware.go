@@ -64,13 +64,19 @@ func init() {
 	}
 }
 
+// projectQuotaClient lists the resource quotas and limit ranges that restrict pushes to a project.
+type projectQuotaClient interface {
+	kclient.ResourceQuotasNamespacer
+	kclient.LimitRangesNamespacer
+}
+
 // repository wraps a distribution.Repository and allows manifests to be served from the OpenShift image
 // API.
 type repository struct {
 	distribution.Repository
 
 	ctx            context.Context
-	quotaClient    kclient.ResourceQuotasNamespacer
+	quotaClient    projectQuotaClient
 	registryClient client.Interface
 	registryAddr   string
 	namespace      string
@@ -79,6 +85,10 @@ type repository struct {
 	// if true, the repository will check remote references in the image stream to support pulling "through"
 	// from a remote repository
 	pullthrough bool
+	// if true, blobs served from a remote repository during pullthrough are also written to the local storage,
+	// so that subsequent pulls of the same content do not have to reach the remote repository. Mirrored blobs
+	// are not counted against the image quota of the project, so mirroring is disabled unless configured.
+	mirrorPullthrough bool
 	// cachedLayers remembers a mapping of layer digest to repositories recently seen with that image to avoid
 	// having to check every potential upstream repository when a blob request is made. The cache is useful only
 	// when session affinity is on for the registry, but in practice the first pull will fill the cache.
@@ -88,7 +98,7 @@ type repository struct {
 var _ distribution.ManifestService = &repository{}
 
 // newRepositoryWithClient returns a new repository middleware.
-func newRepositoryWithClient(registryClient client.Interface, quotaClient kclient.ResourceQuotasNamespacer, ctx context.Context, repo distribution.Repository, options map[string]interface{}) (distribution.Repository, error) {
+func newRepositoryWithClient(registryClient client.Interface, quotaClient projectQuotaClient, ctx context.Context, repo distribution.Repository, options map[string]interface{}) (distribution.Repository, error) {
 	registryAddr := os.Getenv("DOCKER_REGISTRY_URL")
 	if len(registryAddr) == 0 {
 		return nil, errors.New("DOCKER_REGISTRY_URL is required")
@@ -100,6 +110,12 @@ func newRepositoryWithClient(registryClient client.Interface, quotaClient kclien
 			pullthrough = b
 		}
 	}
+	mirrorPullthrough := false
+	if value, ok := options["mirrorpullthrough"]; ok {
+		if b, ok := value.(bool); ok {
+			mirrorPullthrough = b
+		}
+	}
 
 	nameParts := strings.SplitN(repo.Name(), "/", 2)
 	if len(nameParts) != 2 {
@@ -109,14 +125,15 @@ func newRepositoryWithClient(registryClient client.Interface, quotaClient kclien
 	return &repository{
 		Repository: repo,
 
-		ctx:            ctx,
-		quotaClient:    quotaClient,
-		registryClient: registryClient,
-		registryAddr:   registryAddr,
-		namespace:      nameParts[0],
-		name:           nameParts[1],
-		pullthrough:    pullthrough,
-		cachedLayers:   cachedLayers,
+		ctx:               ctx,
+		quotaClient:       quotaClient,
+		registryClient:    registryClient,
+		registryAddr:      registryAddr,
+		namespace:         nameParts[0],
+		name:              nameParts[1],
+		pullthrough:       pullthrough,
+		mirrorPullthrough: mirrorPullthrough,
+		cachedLayers:      cachedLayers,
 	}, nil
 }
 
@@ -135,20 +152,26 @@ func (r *repository) Blobs(ctx context.Context) distribution.BlobStore {
 	repo := repository(*r)
 	repo.ctx = ctx
 
+	local := r.Repository.Blobs(ctx)
 	bs := &quotaRestrictedBlobStore{
-		BlobStore: r.Repository.Blobs(ctx),
+		BlobStore: local,
 		repo:      &repo,
 	}
 	if !r.pullthrough {
 		return bs
 	}
 
-	return &pullthroughBlobStore{
+	pbs := &pullthroughBlobStore{
 		BlobStore: bs,
 
 		repo:          &repo,
 		digestToStore: make(map[string]distribution.BlobStore),
 	}
+	// mirrored blobs are already referenced by an image in the image stream, so they bypass the quota check
+	if r.mirrorPullthrough {
+		pbs.mirror = local
+	}
+	return pbs
 }
 
 // Tags lists the tags under the named repository.
@@ -330,6 +353,10 @@ func (r *repository) Put(manifest *schema1.SignedManifest) error {
 		return err
 	}
 
+	if err := admitImageStreamTag(r.ctx, r, manifest.Tag); err != nil {
+		return err
+	}
+
 	// Upload to openshift
 	ism := imageapi.ImageStreamMapping{
 		ObjectMeta: kapi.ObjectMeta{
@@ -512,8 +539,15 @@ func (r *repository) manifestFromImageWithCachedLayers(image *imageapi.Image, ca
 	return manifest, nil
 }
 
-// manifestFromImage converts an Image to a SignedManifest.
+// manifestFromImage converts an Image to a SignedManifest. Images with schema 2 or OCI manifests
+// cannot be converted.
 func (r *repository) manifestFromImage(image *imageapi.Image) (*schema1.SignedManifest, error) {
+	switch image.DockerImageManifestMediaType {
+	case "", imageapi.DockerManifestSchema1MediaType, imageapi.DockerManifestSchema1SignedMediaType:
+	default:
+		return nil, fmt.Errorf("the manifest of image %s has media type %s and cannot be served as a schema 1 manifest", image.Name, image.DockerImageManifestMediaType)
+	}
+
 	dgst, err := digest.ParseDigest(image.Name)
 	if err != nil {
 		return nil, err
@@ -250,6 +250,9 @@ func describeGeneratedTemplate(out io.Writer, ref app.ComponentReference, result
 			fmt.Fprintf(out, "      %s=%s%s\n", name, p.Value, generated)
 		}
 	}
+	if instance := result.ObjectLabels[templateapi.TemplateInstanceLabel]; len(instance) > 0 {
+		fmt.Fprintf(out, "     Delete templateinstance/%s to delete the created objects\n", instance)
+	}
 }
 
 func describeGeneratedJob(out io.Writer, ref app.ComponentReference, pod *kapi.Pod, secret *kapi.Secret, baseNamespace string) {
@@ -0,0 +1,268 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/glog"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/generate/app"
+	"github.com/openshift/origin/pkg/template"
+	templateapi "github.com/openshift/origin/pkg/template/api"
+)
+
+// Prompter asks the user the questions of the interactive mode.
+type Prompter interface {
+	// PromptForString returns the answer to question, empty if the user did
+	// not answer. The answer is not echoed if secret is true.
+	PromptForString(question string, secret bool) string
+	// PromptForChoice returns the index of the option of options chosen by
+	// the user.
+	PromptForChoice(question string, options []string) int
+}
+
+// Interview completes the AppConfig with the answers of the user to the
+// questions of p. The user is asked for the inputs when none is given, for
+// the builder image of every source repository without one, among the images
+// matching the languages detected in the repository, and for the values of
+// the parameters of the templates.
+func (c *AppConfig) Interview(p Prompter) error {
+	if !c.HasArguments() && len(c.SourceRepositories) == 0 {
+		if repo := p.PromptForString("Source code repository (URL or local directory, empty for none)", false); len(repo) > 0 {
+			c.SourceRepositories = append(c.SourceRepositories, repo)
+		} else if component := p.PromptForString("Template, image stream or Docker image to deploy", false); len(component) > 0 {
+			c.Components = append(c.Components, component)
+		} else {
+			return ErrNoInputs
+		}
+	}
+	if err := c.interviewBuilders(p); err != nil {
+		return err
+	}
+	c.interviewParameters(p)
+	return nil
+}
+
+// interviewBuilders asks for the builder image of every source repository
+// when no other input is given. Repositories holding a Dockerfile are built
+// with it and do not need a builder image.
+func (c *AppConfig) interviewBuilders(p Prompter) error {
+	if c.HasArguments() || len(c.SourceRepositories) == 0 || c.Strategy == "docker" || len(c.Dockerfile) > 0 {
+		return nil
+	}
+	c.ensureDockerSearch()
+	repositories, err := c.individualSourceRepositories()
+	if err != nil {
+		return err
+	}
+	if err := c.DetectSource(repositories); err != nil {
+		return err
+	}
+
+	remaining := []string{}
+	for _, repo := range repositories {
+		info := repo.Info()
+		if info == nil || len(info.Types) == 0 || (info.Dockerfile != nil && len(c.Strategy) == 0) {
+			remaining = append(remaining, repo.String())
+			continue
+		}
+		terms := info.Terms()
+		term := terms[0]
+		if len(terms) > 1 {
+			term = terms[p.PromptForChoice(fmt.Sprintf("Languages detected in %s", repo), terms)]
+		}
+		matches := c.builderMatches(term)
+		if len(matches) == 0 {
+			return fmt.Errorf("no builder image was found for the language %q detected in %s", term, repo)
+		}
+		options := []string{}
+		for _, match := range matches {
+			options = append(options, match.Description)
+		}
+		builder := matches[p.PromptForChoice(fmt.Sprintf("Builder images for %s (%s)", repo, term), options)]
+		c.Components = append(c.Components, fmt.Sprintf("%s~%s", builderReference(builder), repo))
+	}
+	c.SourceRepositories = remaining
+	return nil
+}
+
+// builderMatches returns the images matching the language term, the image
+// streams first, without duplicates.
+func (c *AppConfig) builderMatches(term string) app.ComponentMatches {
+	searcher := app.MultiWeightedSearcher{}
+	if c.ImageStreamSearcher != nil {
+		searcher = append(searcher, app.WeightedSearcher{Searcher: c.ImageStreamSearcher, Weight: 0.0})
+	}
+	if c.ImageStreamByAnnotationSearcher != nil {
+		searcher = append(searcher, app.WeightedSearcher{Searcher: c.ImageStreamByAnnotationSearcher, Weight: 1.0})
+	}
+	if c.DockerSearcher != nil {
+		searcher = append(searcher, app.WeightedSearcher{Searcher: c.DockerSearcher, Weight: 2.0})
+	}
+	matches, errs := searcher.Search(false, term)
+	for _, err := range errs {
+		glog.V(2).Infof("Unable to search builder images for %q: %v", term, err)
+	}
+	found := sets.NewString()
+	unique := app.ComponentMatches{}
+	for _, match := range matches {
+		if ref := builderReference(match); !found.Has(ref) {
+			found.Insert(ref)
+			unique = append(unique, match)
+		}
+	}
+	return unique
+}
+
+// builderReference returns the component reference resolving to match.
+func builderReference(match *app.ComponentMatch) string {
+	if match.ImageStream == nil {
+		return match.Name
+	}
+	ref := fmt.Sprintf("%s/%s", match.ImageStream.Namespace, match.ImageStream.Name)
+	if len(match.ImageTag) > 0 {
+		ref += ":" + match.ImageTag
+	}
+	return ref
+}
+
+// interviewParameters asks for the values of the parameters of the templates
+// that are not set yet.
+func (c *AppConfig) interviewParameters(p Prompter) {
+	set := sets.NewString()
+	for _, param := range c.TemplateParameters {
+		set.Insert(strings.SplitN(param, "=", 2)[0])
+	}
+	for _, t := range c.interviewTemplates() {
+		for i := range t.Parameters {
+			param := &t.Parameters[i]
+			if set.Has(param.Name) {
+				continue
+			}
+			question := param.Name
+			if len(param.Description) > 0 {
+				question = fmt.Sprintf("%s (%s)", question, param.Description)
+			}
+			switch {
+			case len(param.Value) > 0 && !template.IsSecretParameter(param):
+				question = fmt.Sprintf("%s [%s]", question, param.Value)
+			case len(param.Generate) > 0:
+				question = fmt.Sprintf("%s [generated]", question)
+			}
+			if value := p.PromptForString(question, template.IsSecretParameter(param)); len(value) > 0 {
+				c.TemplateParameters = append(c.TemplateParameters, fmt.Sprintf("%s=%s", param.Name, value))
+				set.Insert(param.Name)
+			}
+		}
+	}
+}
+
+// interviewTemplates returns the templates given as inputs. Template files
+// read from the standard input are ignored, the standard input can only be
+// read once.
+func (c *AppConfig) interviewTemplates() []*templateapi.Template {
+	templates := []*templateapi.Template{}
+	find := func(searcher app.Searcher, term string) bool {
+		if searcher == nil {
+			return false
+		}
+		matches, _ := searcher.Search(true, term)
+		for _, match := range matches.Exact() {
+			if match.IsTemplate() {
+				templates = append(templates, match.Template)
+				return true
+			}
+		}
+		return false
+	}
+	for _, term := range c.Templates {
+		find(c.TemplateSearcher, term)
+	}
+	for _, term := range c.TemplateFiles {
+		if term != "-" {
+			find(c.TemplateFileSearcher, term)
+		}
+	}
+	for _, term := range c.Components {
+		if strings.Contains(term, "~") || find(c.TemplateSearcher, term) {
+			continue
+		}
+		if app.IsPossibleTemplateFile(term) {
+			find(c.TemplateFileSearcher, term)
+		}
+	}
+	return templates
+}
+
+// Arguments returns the command line arguments of new-app creating the
+// application described by the AppConfig.
+func (c *AppConfig) Arguments() []string {
+	args := append([]string{}, c.Components...)
+	flags := []struct {
+		name   string
+		values []string
+	}{
+		{"code", c.SourceRepositories},
+		{"image-stream", c.ImageStreams},
+		{"docker-image", c.DockerImages},
+		{"template", c.Templates},
+		{"file", c.TemplateFiles},
+		{"template-sha256", []string{c.TemplateSHA256}},
+		{"param", c.TemplateParameters},
+		{"env", c.Environment},
+		{"group", c.Groups},
+		{"name", []string{c.Name}},
+		{"strategy", []string{c.Strategy}},
+		{"context-dir", []string{c.ContextDir}},
+		{"hostname", []string{c.Hostname}},
+		{"tls-termination", []string{c.TLSTermination}},
+		{"cert", []string{c.CertFile}},
+		{"key", []string{c.KeyFile}},
+		{"ca-cert", []string{c.CACertFile}},
+		{"dest-ca-cert", []string{c.DestCACertFile}},
+	}
+	for _, flag := range flags {
+		for _, value := range flag.values {
+			if len(value) > 0 {
+				args = append(args, fmt.Sprintf("--%s=%s", flag.name, value))
+			}
+		}
+	}
+	if len(c.Labels) > 0 {
+		args = append(args, fmt.Sprintf("--labels=%s", labels.SelectorFromSet(c.Labels).String()))
+	}
+	booleans := []struct {
+		name  string
+		value bool
+	}{
+		{"insecure-registry", c.InsecureRegistry},
+		{"allow-missing-images", c.AllowMissingImages},
+		{"allow-missing-imagestream-tags", c.AllowMissingImageStreamTags},
+		{"grant-install-rights", c.AllowSecretUse},
+		{"no-install", c.SkipGeneration},
+		{"as-test", c.AsTestDeployment},
+		{"expose", c.Expose},
+		{"dry-run", c.DryRun},
+	}
+	for _, flag := range booleans {
+		if flag.value {
+			args = append(args, "--"+flag.name)
+		}
+	}
+	return args
+}
+
+// QuoteArguments returns args joined by spaces and quoted for a POSIX shell.
+func QuoteArguments(args []string) string {
+	quoted := []string{}
+	for _, arg := range args {
+		if len(arg) > 0 && strings.Trim(arg, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_=+/.,:@%") == "" {
+			quoted = append(quoted, arg)
+			continue
+		}
+		quoted = append(quoted, "'"+strings.Replace(arg, "'", `'\''`, -1)+"'")
+	}
+	return strings.Join(quoted, " ")
+}
@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/generate/app"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	templateapi "github.com/openshift/origin/pkg/template/api"
+)
+
+type fakePrompter struct {
+	answers   []string
+	choices   []int
+	questions []string
+}
+
+func (p *fakePrompter) PromptForString(question string, secret bool) string {
+	p.questions = append(p.questions, question)
+	if len(p.answers) == 0 {
+		return ""
+	}
+	answer := p.answers[0]
+	p.answers = p.answers[1:]
+	return answer
+}
+
+func (p *fakePrompter) PromptForChoice(question string, options []string) int {
+	p.questions = append(p.questions, question+": "+strings.Join(options, ", "))
+	choice := p.choices[0]
+	p.choices = p.choices[1:]
+	return choice
+}
+
+type fakeDetector struct {
+	info *app.SourceRepositoryInfo
+}
+
+func (d fakeDetector) Detect(dir string, dockerStrategy bool) (*app.SourceRepositoryInfo, error) {
+	return d.info, nil
+}
+
+type fakeSearcher struct {
+	matches app.ComponentMatches
+}
+
+func (s fakeSearcher) Search(precise bool, terms ...string) (app.ComponentMatches, []error) {
+	matches := app.ComponentMatches{}
+	for _, match := range s.matches {
+		for _, term := range terms {
+			if match.Value == term {
+				matches = append(matches, match)
+			}
+		}
+	}
+	return matches, nil
+}
+
+func TestInterviewBuilders(t *testing.T) {
+	dir, err := ioutil.TempDir("", "interview")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	nodejs := &imageapi.ImageStream{ObjectMeta: kapi.ObjectMeta{Namespace: "openshift", Name: "nodejs"}}
+	config := &AppConfig{
+		RefBuilder: &app.ReferenceBuilder{},
+		Detector: fakeDetector{info: &app.SourceRepositoryInfo{
+			Path:  dir,
+			Types: []app.SourceLanguageType{{Platform: "ruby"}, {Platform: "nodejs"}},
+		}},
+		ImageStreamSearcher: fakeSearcher{matches: app.ComponentMatches{
+			{Value: "nodejs", Name: "nodejs", Description: "Image stream nodejs", ImageStream: nodejs, ImageTag: "4"},
+		}},
+		DockerSearcher: fakeSearcher{matches: app.ComponentMatches{
+			{Value: "nodejs", Name: "nodejs", Description: "Docker image nodejs", Score: 0.5},
+		}},
+		Name: "my app",
+	}
+	prompter := &fakePrompter{answers: []string{dir}, choices: []int{1, 1}}
+	if err := config.Interview(prompter); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedQuestions := []string{
+		"Source code repository (URL or local directory, empty for none)",
+		"Languages detected in " + dir + ": ruby, nodejs",
+		"Builder images for " + dir + " (nodejs): Image stream nodejs, Docker image nodejs",
+	}
+	if !reflect.DeepEqual(prompter.questions, expectedQuestions) {
+		t.Errorf("expected questions %v, got %v", expectedQuestions, prompter.questions)
+	}
+	if expected := []string{"nodejs~" + dir}; !reflect.DeepEqual(config.Components, expected) {
+		t.Errorf("expected components %v, got %v", expected, config.Components)
+	}
+	if len(config.SourceRepositories) != 0 {
+		t.Errorf("expected the source repository to be used by a component, got %v", config.SourceRepositories)
+	}
+	if expected := "'nodejs~" + dir + "' '--name=my app'"; QuoteArguments(config.Arguments()) != expected {
+		t.Errorf("expected arguments %s, got %s", expected, QuoteArguments(config.Arguments()))
+	}
+
+	// the image stream is referenced with its namespace and tag
+	config.Components = nil
+	config.SourceRepositories = []string{dir}
+	prompter = &fakePrompter{choices: []int{1, 0}}
+	if err := config.Interview(prompter); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []string{"openshift/nodejs:4~" + dir}; !reflect.DeepEqual(config.Components, expected) {
+		t.Errorf("expected components %v, got %v", expected, config.Components)
+	}
+}
+
+func TestInterviewParameters(t *testing.T) {
+	template := &templateapi.Template{
+		ObjectMeta: kapi.ObjectMeta{Name: "app"},
+		Parameters: []templateapi.Parameter{
+			{Name: "USER", Description: "The user", Value: "admin"},
+			{Name: "PASSWORD", Generate: "expression", From: "[a-z]{8}"},
+			{Name: "SET", Value: "value"},
+			{Name: "SKIPPED"},
+		},
+	}
+	config := &AppConfig{
+		Templates:          []string{"app"},
+		TemplateParameters: []string{"SET=other"},
+		TemplateSearcher: fakeSearcher{matches: app.ComponentMatches{
+			{Value: "app", Name: "app", Template: template},
+		}},
+	}
+	prompter := &fakePrompter{answers: []string{"me", "it's secret"}}
+	if err := config.Interview(prompter); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedQuestions := []string{"USER (The user) [admin]", "PASSWORD [generated]", "SKIPPED"}
+	if !reflect.DeepEqual(prompter.questions, expectedQuestions) {
+		t.Errorf("expected questions %v, got %v", expectedQuestions, prompter.questions)
+	}
+	expected := []string{"SET=other", "USER=me", "PASSWORD=it's secret"}
+	if !reflect.DeepEqual(config.TemplateParameters, expected) {
+		t.Errorf("expected parameters %v, got %v", expected, config.TemplateParameters)
+	}
+	if expected := `--template=app --param=SET=other --param=USER=me '--param=PASSWORD=it'\''s secret'`; QuoteArguments(config.Arguments()) != expected {
+		t.Errorf("expected arguments %s, got %s", expected, QuoteArguments(config.Arguments()))
+	}
+}
+
+func TestInterviewNoInputs(t *testing.T) {
+	config := &AppConfig{}
+	if err := config.Interview(&fakePrompter{}); err != ErrNoInputs {
+		t.Errorf("expected ErrNoInputs, got %v", err)
+	}
+}
@@ -28,9 +28,12 @@ import (
 	"github.com/openshift/origin/pkg/generate/dockerfile"
 	"github.com/openshift/origin/pkg/generate/source"
 	imageapi "github.com/openshift/origin/pkg/image/api"
+	routeapi "github.com/openshift/origin/pkg/route/api"
 	"github.com/openshift/origin/pkg/template"
+	templatecmd "github.com/openshift/origin/pkg/template/cmd"
 	outil "github.com/openshift/origin/pkg/util"
 	dockerfileutil "github.com/openshift/origin/pkg/util/docker/dockerfile"
+	fileutil "github.com/openshift/origin/pkg/util/file"
 )
 
 const (
@@ -55,6 +58,9 @@ type AppConfig struct {
 	DockerImages  []string
 	Templates     []string
 	TemplateFiles []string
+	// TemplateSHA256 is the hex encoded SHA-256 checksum the content of the
+	// template file must match, if set.
+	TemplateSHA256 string
 
 	TemplateParameters []string
 	Groups             []string
@@ -80,6 +86,18 @@ type AppConfig struct {
 	Deploy           bool
 	AsTestDeployment bool
 
+	// Expose creates a route for every generated service, with the host
+	// Hostname if set. TLSTermination, if set, secures the routes with the
+	// certificates and key read from CertFile, KeyFile, CACertFile and
+	// DestCACertFile.
+	Expose         bool
+	Hostname       string
+	TLSTermination string
+	CertFile       string
+	KeyFile        string
+	CACertFile     string
+	DestCACertFile string
+
 	SourceImage     string
 	SourceImagePath string
 
@@ -98,6 +116,10 @@ type AppConfig struct {
 	Out    io.Writer
 	ErrOut io.Writer
 
+	// ParameterPrompter, when set, asks for the values of the required
+	// template parameters that are not set.
+	ParameterPrompter *templatecmd.ParameterPrompter
+
 	KubeClient kclient.Interface
 
 	RefBuilder *app.ReferenceBuilder
@@ -148,6 +170,10 @@ type AppResult struct {
 	HasSource bool
 	Namespace string
 
+	// Messages are the messages of the instantiated templates, to be
+	// displayed once the objects are created.
+	Messages []string
+
 	GeneratedJobs bool
 }
 
@@ -207,6 +233,7 @@ func (c *AppConfig) SetOpenShiftClient(osclient client.Interface, OriginNamespac
 		Mapper:       c.Mapper,
 		ClientMapper: c.ClientMapper,
 		Namespace:    OriginNamespace,
+		SHA256:       c.TemplateSHA256,
 	}
 	// the hierarchy of docker searching is:
 	// 1) if we have an openshift client - query docker registries via openshift,
@@ -387,6 +414,12 @@ func (c *AppConfig) validate() (app.ComponentReferences, app.SourceRepositories,
 		errs = append(errs, fmt.Errorf("specifying binary builds and source repositories at the same time is not allowed"))
 	}
 
+	if len(c.TemplateSHA256) > 0 && len(c.TemplateFiles) != 1 {
+		errs = append(errs, fmt.Errorf("--template-sha256 requires exactly one template file"))
+	}
+
+	errs = append(errs, c.validateRoutes()...)
+
 	env, duplicateEnv, envErrs := cmdutil.ParseEnvironmentArguments(c.Environment)
 	for _, s := range duplicateEnv {
 		glog.V(1).Infof("The environment variable %q was overwritten", s)
@@ -402,6 +435,60 @@ func (c *AppConfig) validate() (app.ComponentReferences, app.SourceRepositories,
 	return refs, repos, env, parms, errors.NewAggregate(errs)
 }
 
+// validateRoutes checks that the route options are only set with Expose and
+// are consistent with the TLS termination.
+func (c *AppConfig) validateRoutes() []error {
+	errs := []error{}
+	if !c.Expose && (len(c.Hostname) > 0 || len(c.TLSTermination) > 0) {
+		errs = append(errs, fmt.Errorf("--hostname and --tls-termination require --expose"))
+	}
+	hasCerts := len(c.CertFile) > 0 || len(c.KeyFile) > 0 || len(c.CACertFile) > 0
+	switch routeapi.TLSTerminationType(c.TLSTermination) {
+	case "":
+		if hasCerts || len(c.DestCACertFile) > 0 {
+			errs = append(errs, fmt.Errorf("--cert, --key, --ca-cert and --dest-ca-cert require --tls-termination"))
+		}
+	case routeapi.TLSTerminationEdge:
+		if len(c.DestCACertFile) > 0 {
+			errs = append(errs, fmt.Errorf("--dest-ca-cert requires --tls-termination=reencrypt"))
+		}
+	case routeapi.TLSTerminationPassthrough:
+		if hasCerts || len(c.DestCACertFile) > 0 {
+			errs = append(errs, fmt.Errorf("passthrough routes can't use --cert, --key, --ca-cert or --dest-ca-cert"))
+		}
+	case routeapi.TLSTerminationReencrypt:
+	default:
+		errs = append(errs, fmt.Errorf("--tls-termination must be one of edge, passthrough or reencrypt"))
+	}
+	return errs
+}
+
+// routeTLSConfig returns the TLS configuration of the routes created with
+// Expose, or nil if the routes are not secured.
+func (c *AppConfig) routeTLSConfig() (*routeapi.TLSConfig, error) {
+	if len(c.TLSTermination) == 0 {
+		return nil, nil
+	}
+	tls := &routeapi.TLSConfig{Termination: routeapi.TLSTerminationType(c.TLSTermination)}
+	files := []struct {
+		name string
+		data *string
+	}{
+		{c.CertFile, &tls.Certificate},
+		{c.KeyFile, &tls.Key},
+		{c.CACertFile, &tls.CACertificate},
+		{c.DestCACertFile, &tls.DestinationCACertificate},
+	}
+	for _, file := range files {
+		data, err := fileutil.LoadData(file.name)
+		if err != nil {
+			return nil, err
+		}
+		*file.data = string(data)
+	}
+	return tls, nil
+}
+
 // componentsForRepos creates components for repositories that have not been previously associated by a builder
 // these components have already gone through source code detection and have a SourceRepositoryInfo attached to them
 func (c *AppConfig) componentsForRepos(repositories app.SourceRepositories) (app.ComponentReferences, error) {
@@ -698,38 +785,51 @@ func (c *AppConfig) buildPipelines(components app.ComponentReferences, environme
 }
 
 // buildTemplates converts a set of resolved, valid references into references to template objects.
-func (c *AppConfig) buildTemplates(components app.ComponentReferences, environment app.Environment) ([]runtime.Object, error) {
+// It also returns the messages of the processed templates.
+func (c *AppConfig) buildTemplates(components app.ComponentReferences, environment app.Environment) ([]runtime.Object, []string, error) {
 	objects := []runtime.Object{}
+	messages := []string{}
 
 	for _, ref := range components {
 		tpl := ref.Input().ResolvedMatch.Template
 
 		glog.V(4).Infof("processing template %s/%s", c.OriginNamespace, tpl.Name)
-		for _, env := range environment.List() {
-			// only set environment values that match what's expected by the template.
-			if v := template.GetParameterByName(tpl, env.Name); v != nil {
-				v.Value = env.Value
-				v.Generate = ""
-				template.AddParameter(tpl, *v)
-			} else {
-				return nil, fmt.Errorf("unexpected parameter name %q", env.Name)
-			}
+		// only set environment values that match what's expected by the template.
+		processor := template.NewProcessor(nil)
+		processor.Strict = true
+		if errs := processor.SetParameterValues(tpl, environment); len(errs) > 0 {
+			return nil, nil, fmt.Errorf("unable to set the parameters of template %s/%s: %v", c.OriginNamespace, tpl.Name, errs.ToAggregate())
 		}
+		if c.ParameterPrompter != nil {
+			c.ParameterPrompter.PromptForRequiredParameters(tpl)
+		}
+		template.AddInstanceLabel(tpl)
 
 		result, err := c.OSClient.TemplateConfigs(c.OriginNamespace).Create(tpl)
 		if err != nil {
-			return nil, fmt.Errorf("error processing template %s/%s: %v", c.OriginNamespace, tpl.Name, err)
+			return nil, nil, fmt.Errorf("error processing template %s/%s: %v", c.OriginNamespace, tpl.Name, err)
 		}
 		errs := runtime.DecodeList(result.Objects, kapi.Codecs.UniversalDecoder())
 		if len(errs) > 0 {
 			err = errors.NewAggregate(errs)
-			return nil, fmt.Errorf("error processing template %s/%s: %v", c.OriginNamespace, tpl.Name, errs)
+			return nil, nil, fmt.Errorf("error processing template %s/%s: %v", c.OriginNamespace, tpl.Name, errs)
+		}
+		// the template instance is created first, so that deleting it removes whatever was created
+		instance, err := template.NewTemplateInstance(result, result.Objects)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error processing template %s/%s: %v", c.OriginNamespace, tpl.Name, err)
+		}
+		if len(instance.Objects) > 0 {
+			objects = append(objects, instance)
 		}
 		objects = append(objects, result.Objects...)
+		if len(result.Message) > 0 {
+			messages = append(messages, result.Message)
+		}
 
 		describeGeneratedTemplate(c.Out, ref, result, c.OriginNamespace)
 	}
-	return objects, nil
+	return objects, messages, nil
 }
 
 // fakeSecretAccessor is used during dry runs of installation
@@ -1072,8 +1172,19 @@ func (c *AppConfig) run(acceptors app.Acceptors) (*AppResult, error) {
 	}
 
 	objects = app.AddServices(objects, false)
+	if c.Expose {
+		tls, err := c.routeTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		count := len(objects)
+		objects = app.AddRoutes(objects, c.Hostname, tls)
+		if len(c.Hostname) > 0 && len(objects)-count > 1 {
+			return nil, fmt.Errorf("--hostname can only be used when a single service is exposed")
+		}
+	}
 
-	templateObjects, err := c.buildTemplates(components.TemplateComponentRefs(), app.Environment(parameters))
+	templateObjects, messages, err := c.buildTemplates(components.TemplateComponentRefs(), app.Environment(parameters))
 	if err != nil {
 		return nil, err
 	}
@@ -1119,6 +1230,7 @@ func (c *AppConfig) run(acceptors app.Acceptors) (*AppResult, error) {
 		Name:      name,
 		HasSource: len(repositories) != 0,
 		Namespace: c.OriginNamespace,
+		Messages:  messages,
 	}, nil
 }
 
@@ -17,6 +17,7 @@ import (
 
 	client "github.com/openshift/origin/pkg/client/testclient"
 	"github.com/openshift/origin/pkg/generate/app"
+	routeapi "github.com/openshift/origin/pkg/route/api"
 	templateapi "github.com/openshift/origin/pkg/template/api"
 
 	_ "github.com/openshift/origin/pkg/api/install"
@@ -114,16 +115,104 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestValidateTemplateSHA256(t *testing.T) {
+	tests := map[string]struct {
+		templateFiles []string
+		valid         bool
+	}{
+		"one file":  {templateFiles: []string{"https://example.com/template.yaml"}, valid: true},
+		"no file":   {},
+		"two files": {templateFiles: []string{"first.yaml", "second.yaml"}},
+	}
+	for n, c := range tests {
+		cfg := AppConfig{TemplateFiles: c.templateFiles, TemplateSHA256: "checksum"}
+		cfg.RefBuilder = &app.ReferenceBuilder{}
+		_, _, _, _, err := cfg.validate()
+		if c.valid && err != nil {
+			t.Errorf("%s: Unexpected error: %v", n, err)
+		}
+		if !c.valid && (err == nil || !strings.Contains(err.Error(), "--template-sha256")) {
+			t.Errorf("%s: Expected a --template-sha256 error, got: %v", n, err)
+		}
+	}
+}
+
+func TestValidateRoutes(t *testing.T) {
+	tests := map[string]struct {
+		cfg AppConfig
+		err string
+	}{
+		"expose":                 {cfg: AppConfig{Expose: true, Hostname: "www.example.com"}},
+		"hostname without route": {cfg: AppConfig{Hostname: "www.example.com"}, err: "require --expose"},
+		"edge":                   {cfg: AppConfig{Expose: true, TLSTermination: "edge", CertFile: "www.crt", KeyFile: "www.key"}},
+		"reencrypt":              {cfg: AppConfig{Expose: true, TLSTermination: "reencrypt", DestCACertFile: "ca.crt"}},
+		"cert without tls":       {cfg: AppConfig{Expose: true, CertFile: "www.crt"}, err: "require --tls-termination"},
+		"edge dest ca":           {cfg: AppConfig{Expose: true, TLSTermination: "edge", DestCACertFile: "ca.crt"}, err: "--dest-ca-cert requires"},
+		"passthrough cert":       {cfg: AppConfig{Expose: true, TLSTermination: "passthrough", KeyFile: "www.key"}, err: "passthrough routes"},
+		"unknown termination":    {cfg: AppConfig{Expose: true, TLSTermination: "none"}, err: "must be one of"},
+	}
+	for n, c := range tests {
+		errs := c.cfg.validateRoutes()
+		if len(c.err) == 0 {
+			if len(errs) > 0 {
+				t.Errorf("%s: unexpected errors: %v", n, errs)
+			}
+			continue
+		}
+		if len(errs) != 1 || !strings.Contains(errs[0].Error(), c.err) {
+			t.Errorf("%s: expected error %q, got %v", n, c.err, errs)
+		}
+	}
+}
+
+func TestRouteTLSConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "routes")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	certFile := filepath.Join(dir, "www.crt")
+	if err := ioutil.WriteFile(certFile, []byte("CERTIFICATE"), 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cfg := AppConfig{Expose: true}
+	if tls, err := cfg.routeTLSConfig(); tls != nil || err != nil {
+		t.Errorf("expected no TLS configuration, got %#v, %v", tls, err)
+	}
+	cfg.TLSTermination, cfg.CertFile = "edge", certFile
+	tls, err := cfg.routeTLSConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := &routeapi.TLSConfig{Termination: routeapi.TLSTerminationEdge, Certificate: "CERTIFICATE"}
+	if !reflect.DeepEqual(tls, expected) {
+		t.Errorf("expected %#v, got %#v", expected, tls)
+	}
+	cfg.KeyFile = filepath.Join(dir, "missing.key")
+	if _, err := cfg.routeTLSConfig(); err == nil {
+		t.Errorf("expected an error for a missing key file")
+	}
+}
+
 func TestBuildTemplates(t *testing.T) {
 	tests := map[string]struct {
 		templateName string
 		namespace    string
 		parms        map[string]string
+		messages     []string
 	}{
 		"simple": {
 			templateName: "first-stored-template",
 			namespace:    "default",
 			parms:        map[string]string{},
+			messages:     []string{},
+		},
+		"with message": {
+			templateName: "second-stored-template",
+			namespace:    "default",
+			parms:        map[string]string{},
+			messages:     []string{"The application is available at http://example.com"},
 		},
 	}
 	for n, c := range tests {
@@ -147,10 +236,13 @@ func TestBuildTemplates(t *testing.T) {
 		if err != nil {
 			t.Errorf("%s: Unexpected error: %v", n, err)
 		}
-		_, err = appCfg.buildTemplates(components, app.Environment(parms))
+		_, messages, err := appCfg.buildTemplates(components, app.Environment(parms))
 		if err != nil {
 			t.Errorf("%s: Unexpected error: %v", n, err)
 		}
+		if !reflect.DeepEqual(messages, c.messages) {
+			t.Errorf("%s: Template messages don't match. Expected: %v, Got: %v", n, c.messages, messages)
+		}
 		for _, component := range components {
 			match := component.Input().ResolvedMatch
 			if !match.IsTemplate() {
@@ -193,6 +285,14 @@ func templateList() *templateapi.TemplateList {
 					Namespace: "default",
 				},
 			},
+			{
+				Objects: []runtime.Object{},
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "second-stored-template",
+					Namespace: "default",
+				},
+				Message: "The application is available at http://example.com",
+			},
 		},
 	}
 }
@@ -362,23 +362,45 @@ func AddServices(objects Objects, firstPortOnly bool) Objects {
 	return append(objects, svcs...)
 }
 
-// AddRoutes sets up routes for the provided objects.
-func AddRoutes(objects Objects) Objects {
+// AddRoutes sets up routes for the provided objects. Every route targets the
+// first TCP port of its service, services without a TCP port are not exposed.
+// The host and TLS configuration, if set, are used by all the routes.
+func AddRoutes(objects Objects, host string, tls *route.TLSConfig) Objects {
 	routes := []runtime.Object{}
 	for _, o := range objects {
 		switch t := o.(type) {
 		case *kapi.Service:
-			routes = append(routes, &route.Route{
+			var port *kapi.ServicePort
+			for i := range t.Spec.Ports {
+				if protocol := t.Spec.Ports[i].Protocol; len(protocol) == 0 || protocol == kapi.ProtocolTCP {
+					port = &t.Spec.Ports[i]
+					break
+				}
+			}
+			if port == nil {
+				continue
+			}
+			r := &route.Route{
 				ObjectMeta: kapi.ObjectMeta{
 					Name:   t.Name,
 					Labels: t.Labels,
 				},
 				Spec: route.RouteSpec{
-					To: kapi.ObjectReference{
+					Host: host,
+					To: route.RouteTargetReference{
+						Kind: "Service",
 						Name: t.Name,
 					},
+					Port: &route.RoutePort{
+						TargetPort: intstr.FromString(port.Name),
+					},
 				},
-			})
+			}
+			if tls != nil {
+				config := *tls
+				r.Spec.TLS = &config
+			}
+			routes = append(routes, r)
 		}
 	}
 	return append(objects, routes...)
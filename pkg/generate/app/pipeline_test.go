@@ -11,6 +11,7 @@ import (
 
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	imageapi "github.com/openshift/origin/pkg/image/api"
+	routeapi "github.com/openshift/origin/pkg/route/api"
 )
 
 type portDesc struct {
@@ -231,3 +232,31 @@ func TestAddServices(t *testing.T) {
 		}
 	}
 }
+
+func TestAddRoutes(t *testing.T) {
+	tls := &routeapi.TLSConfig{Termination: routeapi.TLSTerminationEdge}
+	objects := Objects{
+		expectedService("udp", portDesc{53, "UDP"}),
+		expectedService("web", portDesc{53, "UDP"}, portDesc{8080, "TCP"}, portDesc{8443, "TCP"}),
+	}
+	objects = AddRoutes(objects, "www.example.com", tls)
+	if len(objects) != 3 {
+		t.Fatalf("expected a single route, got %s", objsToString(objects))
+	}
+	route, ok := objects[2].(*routeapi.Route)
+	if !ok {
+		t.Fatalf("expected a route, got %#v", objects[2])
+	}
+	expected := routeapi.RouteSpec{
+		Host: "www.example.com",
+		To:   routeapi.RouteTargetReference{Kind: "Service", Name: "web"},
+		Port: &routeapi.RoutePort{TargetPort: intstr.FromString("8080-TCP")},
+		TLS:  &routeapi.TLSConfig{Termination: routeapi.TLSTerminationEdge},
+	}
+	if route.Name != "web" || !reflect.DeepEqual(route.Spec, expected) {
+		t.Errorf("unexpected route: %#v", route)
+	}
+	if route.Spec.TLS == tls {
+		t.Errorf("the TLS configuration must be copied")
+	}
+}
@@ -1,8 +1,17 @@
 package app
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/golang/glog"
@@ -14,6 +23,7 @@ import (
 	"k8s.io/kubernetes/pkg/util/sets"
 
 	"github.com/openshift/origin/pkg/client"
+	templateprocessor "github.com/openshift/origin/pkg/template"
 	templateapi "github.com/openshift/origin/pkg/template/api"
 )
 
@@ -87,12 +97,23 @@ func IsPossibleTemplateFile(value string) bool {
 	return isFile(value)
 }
 
-// TemplateFileSearcher resolves template files into template objects
+// TemplateFileSearcher resolves template files into template objects. A
+// template file is either a local path, a file://, http:// or https:// URL,
+// or "-" for the standard input.
 type TemplateFileSearcher struct {
 	Mapper       meta.RESTMapper
 	Typer        runtime.ObjectTyper
 	ClientMapper resource.ClientMapper
 	Namespace    string
+
+	// SHA256 is the hex encoded SHA-256 checksum the content of the template
+	// files must match, if set.
+	SHA256 string
+	// In is read for the "-" template file, os.Stdin if nil.
+	In io.Reader
+	// Client retrieves the http:// and https:// template files,
+	// http.DefaultClient if nil.
+	Client *http.Client
 }
 
 // Search attemps to read template files and transform it into template objects
@@ -105,13 +126,27 @@ func (r *TemplateFileSearcher) Search(precise bool, terms ...string) (ComponentM
 			continue
 		}
 
-		var isSingular bool
-		obj, err := resource.NewBuilder(r.Mapper, r.Typer, r.ClientMapper, kapi.Codecs.UniversalDecoder()).
-			NamespaceParam(r.Namespace).RequireNamespace().
-			FilenameParam(false, term).
-			Do().
-			IntoSingular(&isSingular).
-			Object()
+		builder := resource.NewBuilder(r.Mapper, r.Typer, r.ClientMapper, kapi.Codecs.UniversalDecoder()).
+			NamespaceParam(r.Namespace).RequireNamespace()
+		filename := localTemplateFile(term)
+		if len(filename) > 0 && len(r.SHA256) == 0 {
+			builder = builder.FilenameParam(false, filename)
+		} else {
+			data, err := r.read(term)
+			if err != nil {
+				if os.IsNotExist(err) {
+					continue
+				}
+				errs = append(errs, fmt.Errorf("unable to load template file %q: %v", term, err))
+				continue
+			}
+			if err := verifySHA256(data, r.SHA256); err != nil {
+				errs = append(errs, fmt.Errorf("unable to load template file %q: %v", term, err))
+				continue
+			}
+			builder = builder.Stream(bytes.NewReader(data), term)
+		}
+		infos, err := builder.Do().Infos()
 
 		if err != nil {
 			switch {
@@ -126,17 +161,25 @@ func (r *TemplateFileSearcher) Search(precise bool, terms ...string) (ComponentM
 			}
 		}
 
-		if !isSingular {
-			errs = append(errs, fmt.Errorf("there is more than one object in %q", term))
-			continue
+		templates := []*templateapi.Template{}
+		for _, info := range infos {
+			if t, ok := info.Object.(*templateapi.Template); ok {
+				templates = append(templates, t)
+			}
 		}
-
-		template, ok := obj.(*templateapi.Template)
-		if !ok {
+		if len(templates) != len(infos) || len(templates) == 0 {
 			errs = append(errs, fmt.Errorf("object in %q is not a template", term))
 			continue
 		}
 
+		// a file holding several templates, e.g. a multi-document YAML file, is
+		// instantiated as a single template including all of them
+		template := templates[0]
+		if len(templates) > 1 {
+			name := strings.TrimSuffix(filepath.Base(term), filepath.Ext(term))
+			template = templateprocessor.CombineTemplates(name, templates)
+		}
+
 		matches = append(matches, &ComponentMatch{
 			Value:       term,
 			Argument:    fmt.Sprintf("--file=%q", template.Name),
@@ -149,3 +192,60 @@ func (r *TemplateFileSearcher) Search(precise bool, terms ...string) (ComponentM
 
 	return matches, errs
 }
+
+// localTemplateFile returns the local path of the template file term, or an
+// empty string if term is not a local file.
+func localTemplateFile(term string) string {
+	switch {
+	case term == "-", strings.HasPrefix(term, "http://"), strings.HasPrefix(term, "https://"):
+		return ""
+	case strings.HasPrefix(term, "file://"):
+		location, err := url.Parse(term)
+		if err != nil {
+			return ""
+		}
+		return location.Path
+	}
+	return term
+}
+
+// read returns the content of the template file term.
+func (r *TemplateFileSearcher) read(term string) ([]byte, error) {
+	if filename := localTemplateFile(term); len(filename) > 0 {
+		return ioutil.ReadFile(filename)
+	}
+	if term == "-" {
+		in := r.In
+		if in == nil {
+			in = os.Stdin
+		}
+		return ioutil.ReadAll(in)
+	}
+	client := r.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Get(term)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to retrieve %s: %s", term, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// verifySHA256 returns an error if the SHA-256 checksum of data is not the
+// hex encoded checksum expected. Any checksum is accepted if expected is
+// empty.
+func verifySHA256(data []byte, expected string) error {
+	if len(expected) == 0 {
+		return nil
+	}
+	sum := sha256.Sum256(data)
+	if actual := hex.EncodeToString(sum[:]); actual != strings.ToLower(expected) {
+		return fmt.Errorf("the SHA-256 checksum of the content is %s, expected %s", actual, expected)
+	}
+	return nil
+}
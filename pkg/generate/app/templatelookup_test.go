@@ -0,0 +1,115 @@
+package app
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/apimachinery/registered"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+)
+
+const testTemplateFile = `{
+	"kind": "Template",
+	"apiVersion": "v1",
+	"metadata": {"name": "remote-template"},
+	"objects": []
+}`
+
+func testTemplateFileSearcher() *TemplateFileSearcher {
+	return &TemplateFileSearcher{
+		Mapper: registered.RESTMapper(),
+		Typer:  kapi.Scheme,
+		ClientMapper: resource.ClientMapperFunc(func(mapping *meta.RESTMapping) (resource.RESTClient, error) {
+			return nil, nil
+		}),
+		Namespace: "default",
+	}
+}
+
+func TestTemplateFileSearcherSources(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/app-template.json" {
+			http.NotFound(w, req)
+			return
+		}
+		w.Write([]byte(testTemplateFile))
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "templatelookup")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "app-template.json")
+	if err := ioutil.WriteFile(filename, []byte(testTemplateFile), 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sum := sha256.Sum256([]byte(testTemplateFile))
+	checksum := hex.EncodeToString(sum[:])
+
+	tests := map[string]struct {
+		term   string
+		sha256 string
+		err    string
+	}{
+		"local file": {
+			term: filename,
+		},
+		"local file with checksum": {
+			term:   filename,
+			sha256: strings.ToUpper(checksum),
+		},
+		"file URL": {
+			term: "file://" + filename,
+		},
+		"http URL": {
+			term: server.URL + "/app-template.json",
+		},
+		"http URL with checksum": {
+			term:   server.URL + "/app-template.json",
+			sha256: checksum,
+		},
+		"stdin": {
+			term: "-",
+		},
+		"checksum mismatch": {
+			term:   server.URL + "/app-template.json",
+			sha256: strings.Repeat("0", 64),
+			err:    "the SHA-256 checksum of the content is " + checksum,
+		},
+		"http error": {
+			term: server.URL + "/missing.json",
+			err:  "404 Not Found",
+		},
+	}
+	for name, test := range tests {
+		searcher := testTemplateFileSearcher()
+		searcher.SHA256 = test.sha256
+		searcher.In = strings.NewReader(testTemplateFile)
+		matches, errs := searcher.Search(true, test.term)
+		if len(test.err) > 0 {
+			if len(errs) != 1 || !strings.Contains(errs[0].Error(), test.err) {
+				t.Errorf("%s: expected error %q, got %v", name, test.err, errs)
+			}
+			continue
+		}
+		if len(errs) > 0 {
+			t.Errorf("%s: unexpected errors: %v", name, errs)
+			continue
+		}
+		if len(matches) != 1 || matches[0].Template == nil || matches[0].Template.Name != "remote-template" {
+			t.Errorf("%s: unexpected matches: %#v", name, matches)
+		}
+	}
+}
@@ -45,8 +45,13 @@ type SourceInfo struct {
 
 // CloneOptions are options used in cloning a git repository
 type CloneOptions struct {
-	Recursive bool
-	Quiet     bool
+	Recursive  bool
+	Quiet      bool
+	NoCheckout bool
+	// Filter requests a partial clone omitting the objects it matches, eg.
+	// "blob:none" to only download file contents when they are checked out.
+	// Servers that do not support partial clones send every object.
+	Filter string
 }
 
 // execGitFunc is a function that executes a Git command
@@ -180,6 +185,12 @@ func (r *repository) CloneWithOptions(location string, url string, opts CloneOpt
 	if opts.Recursive {
 		args = append(args, "--recursive")
 	}
+	if opts.NoCheckout {
+		args = append(args, "--no-checkout")
+	}
+	if len(opts.Filter) > 0 {
+		args = append(args, "--filter="+opts.Filter)
+	}
 	args = append(args, url)
 	args = append(args, location)
 	_, _, err := r.git(nil, "", args...)
@@ -2,6 +2,7 @@ package git
 
 import (
 	"io"
+	"reflect"
 	"testing"
 )
 
@@ -108,3 +109,18 @@ func makeExecFunc(output string, err error) execGitFunc {
 		return
 	}
 }
+
+func TestCloneWithFilter(t *testing.T) {
+	var args []string
+	r := &repository{git: func(w io.Writer, dir string, a ...string) (string, string, error) {
+		args = a
+		return "", "", nil
+	}}
+	if err := r.CloneWithOptions("/test/dir", "https://test/url/to/repository", CloneOptions{NoCheckout: true, Filter: "blob:none"}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	expected := []string{"clone", "--no-checkout", "--filter=blob:none", "https://test/url/to/repository", "/test/dir"}
+	if !reflect.DeepEqual(args, expected) {
+		t.Errorf("Expected arguments %v, got %v", expected, args)
+	}
+}
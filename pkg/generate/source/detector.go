@@ -28,6 +28,7 @@ var DefaultDetectors = Detectors{
 	DetectPython,
 	DetectPerl,
 	DetectScala,
+	DetectRust,
 }
 
 type sourceDetector struct {
@@ -50,9 +51,10 @@ func DetectRuby(dir string) (*Info, bool) {
 	return detect("ruby", dir, "Gemfile", "Rakefile", "config.ru")
 }
 
-// DetectJava detects Java source
+// DetectJava detects Java source built with Maven or Gradle. The settings
+// files identify multi-module projects without a build file at their root.
 func DetectJava(dir string) (*Info, bool) {
-	return detect("jee", dir, "pom.xml")
+	return detect("jee", dir, "pom.xml", "build.gradle", "build.gradle.kts", "settings.gradle", "settings.gradle.kts")
 }
 
 // DetectNodeJS detects NodeJS source
@@ -75,9 +77,15 @@ func DetectPerl(dir string) (*Info, bool) {
 	return detect("perl", dir, "index.pl", "cpanfile")
 }
 
-// DetectScala  detects Scala source
+// DetectScala detects Scala source built with sbt. Multi-project builds may
+// only be defined in the project directory.
 func DetectScala(dir string) (*Info, bool) {
-	return detect("scala", dir, "build.sbt")
+	return detect("scala", dir, "build.sbt", filepath.Join("project", "build.properties"), filepath.Join("project", "Build.scala"))
+}
+
+// DetectRust detects Rust source built with Cargo, including workspaces
+func DetectRust(dir string) (*Info, bool) {
+	return detect("rust", dir, "Cargo.toml")
 }
 
 // detect returns an Info object with the given platform if the source at dir contains any of the argument files
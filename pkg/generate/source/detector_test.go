@@ -1,6 +1,10 @@
 package source
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -44,3 +48,48 @@ func fake2(dir string) (*Info, bool) {
 	return nil, false
 
 }
+
+func TestDefaultDetectors(t *testing.T) {
+	tests := map[string]struct {
+		files     []string
+		platforms []string
+	}{
+		"maven":              {files: []string{"pom.xml"}, platforms: []string{"jee"}},
+		"gradle":             {files: []string{"build.gradle"}, platforms: []string{"jee"}},
+		"gradle kotlin":      {files: []string{"build.gradle.kts"}, platforms: []string{"jee"}},
+		"gradle multi-build": {files: []string{"settings.gradle", "api/build.gradle", "web/build.gradle"}, platforms: []string{"jee"}},
+		"sbt":                {files: []string{"build.sbt"}, platforms: []string{"scala"}},
+		"sbt multi-project":  {files: []string{"project/build.properties", "core/build.sbt"}, platforms: []string{"scala"}},
+		"cargo":              {files: []string{"Cargo.toml"}, platforms: []string{"rust"}},
+		"cargo and npm":      {files: []string{"Cargo.toml", "package.json"}, platforms: []string{"nodejs", "rust"}},
+		"module only":        {files: []string{"api/build.gradle"}},
+	}
+	for name, test := range tests {
+		dir, err := ioutil.TempDir("", "detector")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer os.RemoveAll(dir)
+		for _, file := range test.files {
+			path := filepath.Join(dir, file)
+			if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := ioutil.WriteFile(path, []byte{}, 0600); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		platforms := []string{}
+		for _, d := range DefaultDetectors {
+			if info, ok := d(dir); ok {
+				platforms = append(platforms, info.Platform)
+			}
+		}
+		if len(test.platforms) == 0 {
+			test.platforms = []string{}
+		}
+		if !reflect.DeepEqual(platforms, test.platforms) {
+			t.Errorf("%s: expected platforms %v, got %v", name, test.platforms, platforms)
+		}
+	}
+}
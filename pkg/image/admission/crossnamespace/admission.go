@@ -0,0 +1,195 @@
+package crossnamespace
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	clientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/serviceaccount"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+	"github.com/openshift/origin/pkg/client"
+	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
+	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func init() {
+	admission.RegisterPlugin("ImageStreamCrossNamespaceAccess", func(c clientset.Interface, config io.Reader) (admission.Interface, error) {
+		return NewImageStreamCrossNamespaceAccess(), nil
+	})
+}
+
+type crossNamespaceAccess struct {
+	*admission.Handler
+	client client.Interface
+}
+
+var _ = oadmission.WantsOpenshiftClient(&crossNamespaceAccess{})
+var _ = oadmission.Validator(&crossNamespaceAccess{})
+
+// NewImageStreamCrossNamespaceAccess returns an admission control for builds, build configs
+// and deployment configs that only allows them to reference image streams in another
+// namespace when the service accounts of their own namespace may pull from those streams.
+func NewImageStreamCrossNamespaceAccess() admission.Interface {
+	return &crossNamespaceAccess{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}
+}
+
+var (
+	buildsResource            = buildapi.Resource("builds")
+	buildConfigsResource      = buildapi.Resource("buildconfigs")
+	deploymentConfigsResource = deployapi.Resource("deploymentconfigs")
+)
+
+func (a *crossNamespaceAccess) Admit(attr admission.Attributes) error {
+	switch attr.GetResource() {
+	case buildsResource, buildConfigsResource, deploymentConfigsResource:
+	default:
+		return nil
+	}
+	if len(attr.GetSubresource()) > 0 {
+		return nil
+	}
+
+	namespace := attr.GetNamespace()
+	// references which are already held by the object were checked when they were added
+	existing := sets.NewString()
+	if attr.GetOperation() == admission.Update {
+		old, err := a.currentObject(attr)
+		if err != nil {
+			return admission.NewForbidden(attr, err)
+		}
+		existing = crossNamespaceStreams(namespace, imageReferences(old))
+	}
+	streams := crossNamespaceStreams(namespace, imageReferences(attr.GetObject()))
+	for _, key := range streams.Difference(existing).List() {
+		parts := strings.SplitN(key, "/", 2)
+		if err := a.checkPullAccess(namespace, parts[0], parts[1], attr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *crossNamespaceAccess) SetOpenshiftClient(c client.Interface) {
+	a.client = c
+}
+
+func (a *crossNamespaceAccess) Validate() error {
+	if a.client == nil {
+		return fmt.Errorf("ImageStreamCrossNamespaceAccess needs an Openshift client")
+	}
+	return nil
+}
+
+// currentObject returns the stored object being updated, or nil if it does not exist.
+func (a *crossNamespaceAccess) currentObject(attr admission.Attributes) (runtime.Object, error) {
+	var (
+		obj runtime.Object
+		err error
+	)
+	switch attr.GetResource() {
+	case buildsResource:
+		obj, err = a.client.Builds(attr.GetNamespace()).Get(attr.GetName())
+	case buildConfigsResource:
+		obj, err = a.client.BuildConfigs(attr.GetNamespace()).Get(attr.GetName())
+	case deploymentConfigsResource:
+		obj, err = a.client.DeploymentConfigs(attr.GetNamespace()).Get(attr.GetName())
+	}
+	if kapierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return obj, err
+}
+
+// imageReferences returns the image references of builds, build configs and deployment
+// configs.
+func imageReferences(obj runtime.Object) []*kapi.ObjectReference {
+	var refs []*kapi.ObjectReference
+	switch obj := obj.(type) {
+	case *buildapi.Build:
+		refs = buildutil.GetImageReferences(&obj.Spec)
+	case *buildapi.BuildConfig:
+		refs = buildutil.GetImageReferences(&obj.Spec.BuildSpec)
+		for i := range obj.Spec.Triggers {
+			if trigger := obj.Spec.Triggers[i].ImageChange; trigger != nil && trigger.From != nil {
+				refs = append(refs, trigger.From)
+			}
+		}
+	case *deployapi.DeploymentConfig:
+		for i := range obj.Spec.Triggers {
+			if params := obj.Spec.Triggers[i].ImageChangeParams; params != nil {
+				refs = append(refs, &params.From)
+			}
+		}
+	}
+	return refs
+}
+
+// crossNamespaceStreams returns the image streams outside of namespace that refs point to,
+// as <namespace>/<name>. Image stream references in the form <namespace>/<name> are
+// expanded without modifying refs.
+func crossNamespaceStreams(namespace string, refs []*kapi.ObjectReference) sets.String {
+	streams := sets.NewString()
+	for _, ref := range refs {
+		expanded := *ref
+		imageapi.ExpandImageStreamReferenceNamespace(&expanded)
+		if len(expanded.Namespace) == 0 || expanded.Namespace == namespace {
+			continue
+		}
+		if stream, ok := imageStreamName(&expanded); ok {
+			streams.Insert(expanded.Namespace + "/" + stream)
+		}
+	}
+	return streams
+}
+
+// imageStreamName returns the name of the image stream ref points to, or false if ref
+// does not point to an image stream.
+func imageStreamName(ref *kapi.ObjectReference) (string, bool) {
+	switch ref.Kind {
+	case "ImageStreamTag":
+		name, _, ok := imageapi.SplitImageStreamTag(ref.Name)
+		return name, ok
+	case "ImageStreamImage":
+		parts := strings.Split(ref.Name, "@")
+		return parts[0], len(parts) == 2 && len(parts[0]) > 0
+	case "ImageStream":
+		return ref.Name, len(ref.Name) > 0
+	default:
+		return "", false
+	}
+}
+
+// checkPullAccess verifies that the service accounts of namespace are allowed to pull
+// images from the image stream name in target.
+func (a *crossNamespaceAccess) checkPullAccess(namespace, target, name string, attr admission.Attributes) error {
+	subjectAccessReview := &authorizationapi.LocalSubjectAccessReview{
+		Action: authorizationapi.AuthorizationAttributes{
+			Verb:         "get",
+			Group:        imageapi.GroupName,
+			Resource:     "imagestreams/layers",
+			ResourceName: name,
+		},
+		Groups: sets.NewString(serviceaccount.MakeNamespaceGroupName(namespace), serviceaccount.AllServiceAccountsGroup, bootstrappolicy.AuthenticatedGroup),
+	}
+	resp, err := a.client.LocalSubjectAccessReviews(target).Create(subjectAccessReview)
+	if err != nil {
+		return admission.NewForbidden(attr, err)
+	}
+	if !resp.Allowed {
+		return admission.NewForbidden(attr, fmt.Errorf("service accounts in namespace %q are not allowed to pull images from image stream %s/%s", namespace, target, name))
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package crossnamespace
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/auth/user"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/client/testclient"
+	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+)
+
+func TestCrossNamespaceAdmission(t *testing.T) {
+	tests := []struct {
+		name          string
+		kind          unversioned.GroupKind
+		resource      unversioned.GroupResource
+		subresource   string
+		object        runtime.Object
+		existing      runtime.Object
+		allowed       map[string]bool
+		expectReviews []string
+		expectAccept  bool
+	}{
+		{
+			name:         "same namespace build",
+			kind:         buildapi.Kind("Build"),
+			resource:     buildsResource,
+			object:       testBuild(kapi.ObjectReference{Kind: "ImageStreamTag", Name: "ruby:latest"}),
+			expectAccept: true,
+		},
+		{
+			name:         "docker image build",
+			kind:         buildapi.Kind("Build"),
+			resource:     buildsResource,
+			object:       testBuild(kapi.ObjectReference{Kind: "DockerImage", Name: "other/ruby:latest"}),
+			expectAccept: true,
+		},
+		{
+			name:          "allowed cross namespace build",
+			kind:          buildapi.Kind("Build"),
+			resource:      buildsResource,
+			object:        testBuild(kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "openshift", Name: "ruby:latest"}),
+			allowed:       map[string]bool{"openshift/ruby": true},
+			expectReviews: []string{"openshift/ruby"},
+			expectAccept:  true,
+		},
+		{
+			name:          "forbidden cross namespace build",
+			kind:          buildapi.Kind("Build"),
+			resource:      buildsResource,
+			object:        testBuild(kapi.ObjectReference{Kind: "ImageStreamImage", Namespace: "other", Name: "ruby@sha256:0000"}),
+			expectReviews: []string{"other/ruby"},
+		},
+		{
+			name:          "namespace shorthand in build config",
+			kind:          buildapi.Kind("BuildConfig"),
+			resource:      buildConfigsResource,
+			object:        testBuildConfig(kapi.ObjectReference{Kind: "ImageStreamTag", Name: "openshift/ruby:latest"}),
+			allowed:       map[string]bool{"openshift/ruby": true},
+			expectReviews: []string{"openshift/ruby"},
+			expectAccept:  true,
+		},
+		{
+			name:         "unchanged reference on update",
+			kind:         buildapi.Kind("BuildConfig"),
+			resource:     buildConfigsResource,
+			object:       testBuildConfig(kapi.ObjectReference{Kind: "ImageStreamTag", Name: "other/ruby:latest"}),
+			existing:     testBuildConfig(kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "other", Name: "ruby:1.0"}),
+			expectAccept: true,
+		},
+		{
+			name:          "changed reference on update",
+			kind:          deployapi.Kind("DeploymentConfig"),
+			resource:      deploymentConfigsResource,
+			object:        testDeploymentConfig(kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "other", Name: "mysql:latest"}),
+			existing:      testDeploymentConfig(kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "other", Name: "postgresql:latest"}),
+			expectReviews: []string{"other/mysql"},
+		},
+		{
+			name:          "forbidden build config trigger",
+			kind:          buildapi.Kind("BuildConfig"),
+			resource:      buildConfigsResource,
+			object:        testBuildConfig(kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "other", Name: "ruby:latest"}),
+			expectReviews: []string{"other/ruby"},
+		},
+		{
+			name:          "allowed deployment config",
+			kind:          deployapi.Kind("DeploymentConfig"),
+			resource:      deploymentConfigsResource,
+			object:        testDeploymentConfig(kapi.ObjectReference{Kind: "ImageStreamTag", Name: "other/mysql:latest"}),
+			allowed:       map[string]bool{"other/mysql": true},
+			expectReviews: []string{"other/mysql"},
+			expectAccept:  true,
+		},
+		{
+			name:          "forbidden deployment config",
+			kind:          deployapi.Kind("DeploymentConfig"),
+			resource:      deploymentConfigsResource,
+			object:        testDeploymentConfig(kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "other", Name: "mysql:latest"}),
+			expectReviews: []string{"other/mysql"},
+		},
+		{
+			name:         "status update",
+			kind:         deployapi.Kind("DeploymentConfig"),
+			resource:     deploymentConfigsResource,
+			subresource:  "status",
+			object:       testDeploymentConfig(kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "other", Name: "mysql:latest"}),
+			expectAccept: true,
+		},
+	}
+
+	for _, test := range tests {
+		var reviews []string
+		fake := &testclient.Fake{}
+		fake.AddReactor("create", "localsubjectaccessreviews", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			review := action.(ktestclient.CreateAction).GetObject().(*authorizationapi.LocalSubjectAccessReview)
+			if review.Action.Verb != "get" || review.Action.Resource != "imagestreams/layers" {
+				t.Errorf("%s: unexpected review: %#v", test.name, review.Action)
+			}
+			if !review.Groups.Has("system:serviceaccounts:default") {
+				t.Errorf("%s: review should check the service accounts of the namespace: %v", test.name, review.Groups.List())
+			}
+			key := action.GetNamespace() + "/" + review.Action.ResourceName
+			reviews = append(reviews, key)
+			return true, &authorizationapi.SubjectAccessReviewResponse{Allowed: test.allowed[key]}, nil
+		})
+		operation := admission.Create
+		if test.existing != nil {
+			operation = admission.Update
+			fake.AddReactor("get", "*", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+				return true, test.existing, nil
+			})
+		}
+		original, err := kapi.Scheme.DeepCopy(test.object)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+
+		c := NewImageStreamCrossNamespaceAccess()
+		c.(oadmission.WantsOpenshiftClient).SetOpenshiftClient(fake)
+		attrs := admission.NewAttributesRecord(test.object, test.kind, "default", "name", test.resource, test.subresource, operation, &user.DefaultInfo{Name: "testuser"})
+		err = c.Admit(attrs)
+		if test.expectAccept && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if !test.expectAccept && !apierrors.IsForbidden(err) {
+			t.Errorf("%s: expected a forbidden error, got %v", test.name, err)
+		}
+		if len(reviews) != len(test.expectReviews) {
+			t.Errorf("%s: unexpected reviews: %v", test.name, reviews)
+			continue
+		}
+		for i := range reviews {
+			if reviews[i] != test.expectReviews[i] {
+				t.Errorf("%s: unexpected reviews: %v", test.name, reviews)
+			}
+		}
+		if !kapi.Semantic.DeepEqual(original, test.object) {
+			t.Errorf("%s: object was modified: %#v", test.name, test.object)
+		}
+	}
+}
+
+func testBuild(from kapi.ObjectReference) *buildapi.Build {
+	return &buildapi.Build{
+		ObjectMeta: kapi.ObjectMeta{Name: "test-build", Namespace: "default"},
+		Spec: buildapi.BuildSpec{
+			Strategy: buildapi.BuildStrategy{SourceStrategy: &buildapi.SourceBuildStrategy{From: from}},
+		},
+	}
+}
+
+func testBuildConfig(from kapi.ObjectReference) *buildapi.BuildConfig {
+	trigger := from
+	return &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "test-buildconfig", Namespace: "default"},
+		Spec: buildapi.BuildConfigSpec{
+			Triggers: []buildapi.BuildTriggerPolicy{
+				{Type: buildapi.ImageChangeBuildTriggerType, ImageChange: &buildapi.ImageChangeTrigger{From: &trigger}},
+			},
+			BuildSpec: buildapi.BuildSpec{
+				Strategy: buildapi.BuildStrategy{SourceStrategy: &buildapi.SourceBuildStrategy{From: from}},
+			},
+		},
+	}
+}
+
+func testDeploymentConfig(from kapi.ObjectReference) *deployapi.DeploymentConfig {
+	return &deployapi.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "test-deploymentconfig", Namespace: "default"},
+		Spec: deployapi.DeploymentConfigSpec{
+			Triggers: []deployapi.DeploymentTriggerPolicy{
+				{
+					Type:              deployapi.DeploymentTriggerOnImageChange,
+					ImageChangeParams: &deployapi.DeploymentTriggerImageChangeParams{From: from},
+				},
+			},
+		},
+	}
+}
@@ -70,6 +70,28 @@ type DockerImageManifest struct {
 	// schema2
 	Layers []distribution.Descriptor `json:"layers"`
 	Config distribution.Descriptor   `json:"config"`
+
+	// manifest lists
+	Manifests []DockerManifestDescriptor `json:"manifests"`
+}
+
+// DockerManifestDescriptor references the manifest of the image for a single platform from a
+// manifest list.
+type DockerManifestDescriptor struct {
+	distribution.Descriptor
+
+	// Platform specifies which platform the referenced manifest runs on.
+	Platform DockerManifestPlatform `json:"platform"`
+}
+
+// DockerManifestPlatform describes the platform which the image in a manifest list runs on.
+type DockerManifestPlatform struct {
+	Architecture string   `json:"architecture"`
+	OS           string   `json:"os"`
+	OSVersion    string   `json:"os.version,omitempty"`
+	OSFeatures   []string `json:"os.features,omitempty"`
+	Variant      string   `json:"variant,omitempty"`
+	Features     []string `json:"features,omitempty"`
 }
 
 // DockerFSLayer is a container struct for BlobSums defined in an image manifest
@@ -101,6 +123,40 @@ type DockerV1CompatibilityImage struct {
 	Size            int64            `json:"size,omitempty"`
 }
 
+// DockerImageConfig stores the image configuration referenced by the config
+// descriptor of a schema 2 or OCI manifest.
+type DockerImageConfig struct {
+	ID              string                `json:"id"`
+	Parent          string                `json:"parent,omitempty"`
+	Comment         string                `json:"comment,omitempty"`
+	Created         unversioned.Time      `json:"created"`
+	Container       string                `json:"container,omitempty"`
+	ContainerConfig DockerConfig          `json:"container_config,omitempty"`
+	DockerVersion   string                `json:"docker_version,omitempty"`
+	Author          string                `json:"author,omitempty"`
+	Config          *DockerConfig         `json:"config,omitempty"`
+	Architecture    string                `json:"architecture,omitempty"`
+	OS              string                `json:"os,omitempty"`
+	Size            int64                 `json:"size,omitempty"`
+	RootFS          *DockerConfigRootFS   `json:"rootfs,omitempty"`
+	History         []DockerConfigHistory `json:"history,omitempty"`
+}
+
+// DockerConfigRootFS describes the layers of the root filesystem of an image config.
+type DockerConfigRootFS struct {
+	Type    string   `json:"type"`
+	DiffIDs []string `json:"diff_ids,omitempty"`
+}
+
+// DockerConfigHistory stores the build history of an image config.
+type DockerConfigHistory struct {
+	Created    unversioned.Time `json:"created"`
+	Author     string           `json:"author,omitempty"`
+	CreatedBy  string           `json:"created_by,omitempty"`
+	Comment    string           `json:"comment,omitempty"`
+	EmptyLayer bool             `json:"empty_layer,omitempty"`
+}
+
 // DockerV1CompatibilityImageSize represents the structured v1
 // compatibility information for size
 type DockerV1CompatibilityImageSize struct {
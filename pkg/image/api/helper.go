@@ -4,10 +4,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"path"
 	"regexp"
 	"sort"
 	"strings"
 
+	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/util/sets"
@@ -285,6 +287,23 @@ func SplitImageStreamTag(nameAndTag string) (name string, tag string, ok bool) {
 	return name, tag, len(parts) == 2
 }
 
+// ExpandImageStreamReferenceNamespace sets the namespace of a reference to an image stream,
+// image stream tag or image stream image whose name is in the form <namespace>/<name> and
+// whose namespace is not set, and strips the namespace from its name.
+func ExpandImageStreamReferenceNamespace(ref *kapi.ObjectReference) {
+	switch ref.Kind {
+	case "ImageStream", "ImageStreamTag", "ImageStreamImage":
+	default:
+		return
+	}
+	if len(ref.Namespace) != 0 {
+		return
+	}
+	if parts := strings.SplitN(ref.Name, "/", 2); len(parts) == 2 {
+		ref.Namespace, ref.Name = parts[0], parts[1]
+	}
+}
+
 // JoinImageStreamTag turns a name and tag into the name of an ImageStreamTag
 func JoinImageStreamTag(name, tag string) string {
 	if len(tag) == 0 {
@@ -400,8 +419,52 @@ func ImageWithMetadata(image *Image) error {
 			image.DockerImageMetadata.Size = v1Metadata.Size
 		}
 	case 2:
-		// TODO: need to prepare for this
-		return fmt.Errorf("unrecognized Docker image manifest schema %d for %q (%s)", manifest.SchemaVersion, image.Name, image.DockerImageReference)
+		switch {
+		case manifest.MediaType == DockerManifestListMediaType || manifest.MediaType == OCIImageIndexMediaType || len(manifest.Manifests) > 0:
+			// manifest lists only reference the images of each platform and carry no
+			// metadata of their own
+			if len(image.DockerImageManifestMediaType) == 0 {
+				image.DockerImageManifestMediaType = manifest.MediaType
+			}
+			return nil
+		case len(image.DockerImageConfig) == 0:
+			return fmt.Errorf("the image config of %q (%s) is required to read its schema 2 manifest", image.Name, image.DockerImageReference)
+		}
+
+		config := DockerImageConfig{}
+		if err := json.Unmarshal([]byte(image.DockerImageConfig), &config); err != nil {
+			return err
+		}
+
+		if len(image.DockerImageManifestMediaType) == 0 {
+			image.DockerImageManifestMediaType = manifest.MediaType
+			if len(image.DockerImageManifestMediaType) == 0 {
+				// the media type is optional in OCI manifests
+				image.DockerImageManifestMediaType = OCIManifestMediaType
+			}
+		}
+
+		// schema 2 layers are already ordered from the lowest to the highest
+		size := int64(0)
+		image.DockerImageLayers = make([]ImageLayer, len(manifest.Layers))
+		for i, layer := range manifest.Layers {
+			image.DockerImageLayers[i].Name = layer.Digest.String()
+			image.DockerImageLayers[i].Size = layer.Size
+			image.DockerImageLayers[i].MediaType = layer.MediaType
+			size += layer.Size
+		}
+
+		image.DockerImageMetadata.ID = manifest.Config.Digest.String()
+		image.DockerImageMetadata.Parent = config.Parent
+		image.DockerImageMetadata.Comment = config.Comment
+		image.DockerImageMetadata.Created = config.Created
+		image.DockerImageMetadata.Container = config.Container
+		image.DockerImageMetadata.ContainerConfig = config.ContainerConfig
+		image.DockerImageMetadata.DockerVersion = config.DockerVersion
+		image.DockerImageMetadata.Author = config.Author
+		image.DockerImageMetadata.Config = config.Config
+		image.DockerImageMetadata.Architecture = config.Architecture
+		image.DockerImageMetadata.Size = size + int64(len(image.DockerImageConfig))
 	default:
 		return fmt.Errorf("unrecognized Docker image manifest schema %d for %q (%s)", manifest.SchemaVersion, image.Name, image.DockerImageReference)
 	}
@@ -470,6 +533,67 @@ func LatestTaggedImage(stream *ImageStream, tag string) *TagEvent {
 	return nil
 }
 
+// ResolveReferenceForTagEvent returns the pull spec consumers should use for the image recorded by
+// latest in the given tag of stream, honoring the reference policy of the spec tag. Tags with a
+// local reference policy resolve to the image in the integrated registry when the stream has a
+// local repository and the event has an image ID, otherwise the original pull spec is returned.
+func ResolveReferenceForTagEvent(stream *ImageStream, tag string, latest *TagEvent) string {
+	ref, ok := stream.Spec.Tags[tag]
+	if !ok || ref.ReferencePolicy.Type != LocalTagReferencePolicy {
+		return latest.DockerImageReference
+	}
+
+	local := stream.Status.DockerImageRepository
+	if len(local) == 0 || len(latest.Image) == 0 {
+		return latest.DockerImageReference
+	}
+	localRef, err := ParseDockerImageReference(local)
+	if err != nil {
+		return latest.DockerImageReference
+	}
+	localRef.Tag = ""
+	localRef.ID = latest.Image
+	return localRef.Exact()
+}
+
+// IsTagPattern returns true if the tag contains any of the glob characters
+// accepted by path.Match and so refers to a set of tags rather than a single one.
+func IsTagPattern(tag string) bool {
+	return strings.ContainsAny(tag, "*?[")
+}
+
+// LatestMatchingTaggedImage returns the name and the most recent TagEvent of the
+// tag in stream.status.tags that was updated last among those matching pattern.
+// A pattern without glob characters behaves like LatestTaggedImage. Returns nil
+// if no tag matches.
+func LatestMatchingTaggedImage(stream *ImageStream, pattern string) (string, *TagEvent) {
+	if !IsTagPattern(pattern) {
+		if len(pattern) == 0 {
+			pattern = DefaultImageTag
+		}
+		return pattern, LatestTaggedImage(stream, pattern)
+	}
+	var (
+		latestTag   string
+		latestEvent *TagEvent
+	)
+	for tag, history := range stream.Status.Tags {
+		if len(history.Items) == 0 {
+			continue
+		}
+		if ok, err := path.Match(pattern, tag); err != nil || !ok {
+			continue
+		}
+		event := &history.Items[0]
+		// break ties on the tag name so the result does not depend on map ordering
+		if latestEvent == nil || latestEvent.Created.Before(event.Created) ||
+			(latestEvent.Created.Equal(event.Created) && tag > latestTag) {
+			latestTag, latestEvent = tag, event
+		}
+	}
+	return latestTag, latestEvent
+}
+
 // DifferentTagEvent returns true if the supplied tag event matches the current stream tag event.
 // Generation is not compared.
 func DifferentTagEvent(stream *ImageStream, tag string, next TagEvent) bool {
@@ -639,6 +763,31 @@ func UpdateTrackingTags(stream *ImageStream, updatedTag string, updatedImage Tag
 	return updated
 }
 
+// LimitTagHistory removes the oldest tag events from the status of every tag whose spec tag sets a
+// history limit, keeping at most HistoryLimit events per tag. The current event of a tag is always
+// kept. It returns the number of events that were removed.
+func LimitTagHistory(stream *ImageStream) int {
+	removed := 0
+	for tag, tagRef := range stream.Spec.Tags {
+		if tagRef.HistoryLimit == nil {
+			continue
+		}
+		limit := int(*tagRef.HistoryLimit)
+		if limit < 1 {
+			limit = 1
+		}
+		history, ok := stream.Status.Tags[tag]
+		if !ok || len(history.Items) <= limit {
+			continue
+		}
+		glog.V(5).Infof("Limiting history of tag %s in stream %s/%s from %d to %d events", tag, stream.Namespace, stream.Name, len(history.Items), limit)
+		removed += len(history.Items) - limit
+		history.Items = history.Items[:limit]
+		stream.Status.Tags[tag] = history
+	}
+	return removed
+}
+
 // ResolveImageID returns latest TagEvent for specified imageID and an error if
 // there's more than one image matching the ID or when one does not exist.
 func ResolveImageID(stream *ImageStream, imageID string) (*TagEvent, error) {
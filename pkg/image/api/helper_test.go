@@ -523,6 +523,49 @@ func validImageWithManifestData() Image {
 	}
 }
 
+const (
+	schema2Manifest = `{
+   "schemaVersion": 2,
+   "mediaType": "application/vnd.docker.distribution.manifest.v2+json",
+   "config": {
+      "mediaType": "application/vnd.docker.container.image.v1+json",
+      "size": 120,
+      "digest": "sha256:f3ee18c7fc3e0f4d7d0ac2fae5d4d0d5b8c8dd3d0a71bd1cf6e2c8f4b3b1a03e"
+   },
+   "layers": [
+      {
+         "mediaType": "application/vnd.docker.image.rootfs.diff.tar.gzip",
+         "size": 1000,
+         "digest": "sha256:b4ca4c215f483111b64ec6919f1659ff475d7080a649d6acd78a6ade562a4a63"
+      },
+      {
+         "mediaType": "application/vnd.docker.image.rootfs.diff.tar.gzip",
+         "size": 2000,
+         "digest": "sha256:c937c4bb1c1a21cc6d94340812262c6472092028972ae69b551b1a70d4276171"
+      }
+   ]
+}`
+	schema2Config = `{"architecture":"arm64","os":"linux","created":"2016-06-14T10:55:41Z","docker_version":"1.12.0","config":{"Cmd":["/bin/sh"]},"rootfs":{"type":"layers","diff_ids":[]}}`
+	manifestList  = `{
+   "schemaVersion": 2,
+   "mediaType": "application/vnd.docker.distribution.manifest.list.v2+json",
+   "manifests": [
+      {
+         "mediaType": "application/vnd.docker.distribution.manifest.v2+json",
+         "size": 528,
+         "digest": "sha256:b4ca4c215f483111b64ec6919f1659ff475d7080a649d6acd78a6ade562a4a63",
+         "platform": {"architecture": "amd64", "os": "linux"}
+      },
+      {
+         "mediaType": "application/vnd.docker.distribution.manifest.v2+json",
+         "size": 528,
+         "digest": "sha256:c937c4bb1c1a21cc6d94340812262c6472092028972ae69b551b1a70d4276171",
+         "platform": {"architecture": "arm", "os": "linux", "variant": "v7"}
+      }
+   ]
+}`
+)
+
 func TestImageWithMetadata(t *testing.T) {
 	tests := map[string]struct {
 		image         Image
@@ -632,6 +675,48 @@ func TestImageWithMetadata(t *testing.T) {
 				},
 			},
 		},
+		"schema 2 without config": {
+			image: Image{
+				DockerImageManifest: schema2Manifest,
+			},
+			expectError: true,
+		},
+		"schema 2": {
+			image: Image{
+				ObjectMeta:          kapi.ObjectMeta{Name: "sha256:0000000000000000000000000000000000000000000000000000000000000000"},
+				DockerImageManifest: schema2Manifest,
+				DockerImageConfig:   schema2Config,
+			},
+			expectedImage: Image{
+				ObjectMeta:                   kapi.ObjectMeta{Name: "sha256:0000000000000000000000000000000000000000000000000000000000000000"},
+				DockerImageManifest:          schema2Manifest,
+				DockerImageConfig:            schema2Config,
+				DockerImageManifestMediaType: DockerManifestSchema2MediaType,
+				DockerImageLayers: []ImageLayer{
+					{Name: "sha256:b4ca4c215f483111b64ec6919f1659ff475d7080a649d6acd78a6ade562a4a63", Size: 1000, MediaType: "application/vnd.docker.image.rootfs.diff.tar.gzip"},
+					{Name: "sha256:c937c4bb1c1a21cc6d94340812262c6472092028972ae69b551b1a70d4276171", Size: 2000, MediaType: "application/vnd.docker.image.rootfs.diff.tar.gzip"},
+				},
+				DockerImageMetadata: DockerImage{
+					ID:            "sha256:f3ee18c7fc3e0f4d7d0ac2fae5d4d0d5b8c8dd3d0a71bd1cf6e2c8f4b3b1a03e",
+					Created:       unversioned.Date(2016, 6, 14, 10, 55, 41, 0, time.UTC),
+					DockerVersion: "1.12.0",
+					Config: &DockerConfig{
+						Cmd: []string{"/bin/sh"},
+					},
+					Architecture: "arm64",
+					Size:         3000 + int64(len(schema2Config)),
+				},
+			},
+		},
+		"manifest list": {
+			image: Image{
+				DockerImageManifest: manifestList,
+			},
+			expectedImage: Image{
+				DockerImageManifest:          manifestList,
+				DockerImageManifestMediaType: DockerManifestListMediaType,
+			},
+		},
 	}
 
 	for name, test := range tests {
@@ -723,6 +808,76 @@ func TestLatestTaggedImage(t *testing.T) {
 	}
 }
 
+func TestLatestMatchingTaggedImage(t *testing.T) {
+	older := unversioned.NewTime(time.Unix(1000, 0))
+	newer := unversioned.NewTime(time.Unix(2000, 0))
+	tags := map[string]TagEventList{
+		"latest":      {Items: []TagEvent{{DockerImageReference: "latest-ref", Created: newer}}},
+		"release-1.0": {Items: []TagEvent{{DockerImageReference: "release-1.0-ref", Created: newer}, {DockerImageReference: "older"}}},
+		"release-1.1": {Items: []TagEvent{{DockerImageReference: "release-1.1-ref", Created: older}}},
+		"release-2.0": {Items: []TagEvent{}},
+	}
+	tests := []struct {
+		pattern     string
+		expectedTag string
+		expectedRef string
+	}{
+		{pattern: "", expectedTag: "latest", expectedRef: "latest-ref"},
+		{pattern: "release-1.1", expectedTag: "release-1.1", expectedRef: "release-1.1-ref"},
+		{pattern: "release-*", expectedTag: "release-1.0", expectedRef: "release-1.0-ref"},
+		{pattern: "release-1.?", expectedTag: "release-1.0", expectedRef: "release-1.0-ref"},
+		{pattern: "release-2.*"},
+		{pattern: "[", expectedTag: ""},
+	}
+
+	for _, test := range tests {
+		stream := &ImageStream{}
+		stream.Status.Tags = tags
+
+		tag, event := LatestMatchingTaggedImage(stream, test.pattern)
+		if len(test.expectedRef) == 0 {
+			if event != nil {
+				t.Errorf("%q: expected no match, got tag %q", test.pattern, tag)
+			}
+			continue
+		}
+		if event == nil {
+			t.Errorf("%q: unexpected nil result", test.pattern)
+			continue
+		}
+		if tag != test.expectedTag || event.DockerImageReference != test.expectedRef {
+			t.Errorf("%q: expected %s (%s), got %s (%s)", test.pattern, test.expectedTag, test.expectedRef, tag, event.DockerImageReference)
+		}
+	}
+}
+
+func TestResolveReferenceForTagEvent(t *testing.T) {
+	event := &TagEvent{DockerImageReference: "docker.io/openshift/origin@sha256:6b646fa6bf5e5e4c7fa41056c27910e679c03ebe7f93e361e6515a9da7e258cc", Image: "sha256:6b646fa6bf5e5e4c7fa41056c27910e679c03ebe7f93e361e6515a9da7e258cc"}
+	tests := []struct {
+		name        string
+		policy      TagReferencePolicyType
+		local       string
+		event       *TagEvent
+		expectedRef string
+	}{
+		{name: "no policy", local: "172.30.1.1:5000/test/origin", event: event, expectedRef: "docker.io/openshift/origin@sha256:6b646fa6bf5e5e4c7fa41056c27910e679c03ebe7f93e361e6515a9da7e258cc"},
+		{name: "source", policy: SourceTagReferencePolicy, local: "172.30.1.1:5000/test/origin", event: event, expectedRef: "docker.io/openshift/origin@sha256:6b646fa6bf5e5e4c7fa41056c27910e679c03ebe7f93e361e6515a9da7e258cc"},
+		{name: "local", policy: LocalTagReferencePolicy, local: "172.30.1.1:5000/test/origin", event: event, expectedRef: "172.30.1.1:5000/test/origin@sha256:6b646fa6bf5e5e4c7fa41056c27910e679c03ebe7f93e361e6515a9da7e258cc"},
+		{name: "local without registry", policy: LocalTagReferencePolicy, event: event, expectedRef: "docker.io/openshift/origin@sha256:6b646fa6bf5e5e4c7fa41056c27910e679c03ebe7f93e361e6515a9da7e258cc"},
+		{name: "local without image", policy: LocalTagReferencePolicy, local: "172.30.1.1:5000/test/origin", event: &TagEvent{DockerImageReference: "openshift/origin:latest"}, expectedRef: "openshift/origin:latest"},
+	}
+
+	for _, test := range tests {
+		stream := &ImageStream{}
+		stream.Spec.Tags = map[string]TagReference{"latest": {ReferencePolicy: TagReferencePolicy{Type: test.policy}}}
+		stream.Status.DockerImageRepository = test.local
+
+		if ref := ResolveReferenceForTagEvent(stream, "latest", test.event); ref != test.expectedRef {
+			t.Errorf("%s: expected %s, got %s", test.name, test.expectedRef, ref)
+		}
+	}
+}
+
 func TestAddTagEventToImageStream(t *testing.T) {
 	tests := map[string]struct {
 		tags           map[string]TagEventList
@@ -1046,6 +1201,33 @@ func TestJoinImageStreamTag(t *testing.T) {
 	}
 }
 
+func TestExpandImageStreamReferenceNamespace(t *testing.T) {
+	tests := map[string]struct {
+		ref      kapi.ObjectReference
+		expected kapi.ObjectReference
+	}{
+		"namespace shorthand": {
+			ref:      kapi.ObjectReference{Kind: "ImageStreamTag", Name: "openshift/ruby:latest"},
+			expected: kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "openshift", Name: "ruby:latest"},
+		},
+		"namespace set": {
+			ref:      kapi.ObjectReference{Kind: "ImageStreamImage", Namespace: "other", Name: "openshift/ruby@sha256:0000"},
+			expected: kapi.ObjectReference{Kind: "ImageStreamImage", Namespace: "other", Name: "openshift/ruby@sha256:0000"},
+		},
+		"docker image": {
+			ref:      kapi.ObjectReference{Kind: "DockerImage", Name: "openshift/ruby:latest"},
+			expected: kapi.ObjectReference{Kind: "DockerImage", Name: "openshift/ruby:latest"},
+		},
+	}
+
+	for name, test := range tests {
+		ExpandImageStreamReferenceNamespace(&test.ref)
+		if !reflect.DeepEqual(test.ref, test.expected) {
+			t.Errorf("%s: expected %#v, got %#v", name, test.expected, test.ref)
+		}
+	}
+}
+
 func TestResolveImageID(t *testing.T) {
 	tests := map[string]struct {
 		tags     map[string]TagEventList
@@ -1302,3 +1484,45 @@ func TestPrioritizeTags(t *testing.T) {
 		t.Errorf("unexpected order: %v", tags)
 	}
 }
+
+func TestLimitTagHistory(t *testing.T) {
+	two := int32(2)
+	events := func(images ...string) TagEventList {
+		list := TagEventList{}
+		for _, image := range images {
+			list.Items = append(list.Items, TagEvent{Image: image})
+		}
+		return list
+	}
+	stream := &ImageStream{
+		Spec: ImageStreamSpec{
+			Tags: map[string]TagReference{
+				"limited":   {HistoryLimit: &two},
+				"short":     {HistoryLimit: &two},
+				"unlimited": {},
+				"nostatus":  {HistoryLimit: &two},
+			},
+		},
+		Status: ImageStreamStatus{
+			Tags: map[string]TagEventList{
+				"limited":   events("d", "c", "b", "a"),
+				"short":     events("a"),
+				"unlimited": events("c", "b", "a"),
+				"pushed":    events("c", "b", "a"),
+			},
+		},
+	}
+
+	if removed := LimitTagHistory(stream); removed != 2 {
+		t.Errorf("expected 2 events to be removed, got %d", removed)
+	}
+	expected := map[string]TagEventList{
+		"limited":   events("d", "c"),
+		"short":     events("a"),
+		"unlimited": events("c", "b", "a"),
+		"pushed":    events("c", "b", "a"),
+	}
+	if !reflect.DeepEqual(expected, stream.Status.Tags) {
+		t.Errorf("unexpected status tags: %s", util.ObjectDiff(expected, stream.Status.Tags))
+	}
+}
@@ -28,11 +28,48 @@ const (
 	// ExcludeImageSecretAnnotation indicates that a secret should not be returned by imagestream/secrets.
 	ExcludeImageSecretAnnotation = "openshift.io/image.excludeSecret"
 
+	// TagUpdateNotificationURLAnnotation may be set on an image stream to the URL of a webhook that is
+	// notified whenever a tag of the stream points to a new image. It is only honored when the master
+	// allows per image stream notifications.
+	TagUpdateNotificationURLAnnotation = "openshift.io/image.tagUpdateNotificationURL"
+
 	// DefaultImageTag is used when an image tag is needed and the configuration does not specify a tag to use.
 	DefaultImageTag = "latest"
 
 	// ResourceImages represents a number of images in a project.
 	ResourceImages kapi.ResourceName = "openshift.io/images"
+	// ResourceImageStreams represents a number of image streams in a project.
+	ResourceImageStreams kapi.ResourceName = "openshift.io/imagestreams"
+	// ResourceImagesSize represents the total size of the images stored in the integrated registry for a
+	// project.
+	ResourceImagesSize kapi.ResourceName = "openshift.io/images-size"
+	// ResourceImageStreamTags represents a number of tags in a single image stream. It may be limited by
+	// the max of a limit range item of type LimitTypeImageStream.
+	ResourceImageStreamTags kapi.ResourceName = "openshift.io/image-tags"
+
+	// LimitTypeImageStream is a limit range type that constrains each image stream in a project.
+	LimitTypeImageStream kapi.LimitType = "openshift.io/ImageStream"
+)
+
+const (
+	// DockerManifestSchema1MediaType is the media type of a Docker schema 1 manifest.
+	DockerManifestSchema1MediaType = "application/vnd.docker.distribution.manifest.v1+json"
+	// DockerManifestSchema1SignedMediaType is the media type of a signed Docker schema 1 manifest.
+	DockerManifestSchema1SignedMediaType = "application/vnd.docker.distribution.manifest.v1+prettyjws"
+	// DockerManifestSchema2MediaType is the media type of a Docker schema 2 manifest.
+	DockerManifestSchema2MediaType = "application/vnd.docker.distribution.manifest.v2+json"
+	// DockerManifestListMediaType is the media type of a Docker manifest list, which references
+	// the manifests of an image built for several platforms.
+	DockerManifestListMediaType = "application/vnd.docker.distribution.manifest.list.v2+json"
+	// DockerImageConfigMediaType is the media type of the config blob of a Docker schema 2 manifest.
+	DockerImageConfigMediaType = "application/vnd.docker.container.image.v1+json"
+	// OCIManifestMediaType is the media type of an OCI image manifest.
+	OCIManifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+	// OCIImageIndexMediaType is the media type of an OCI image index, the OCI equivalent of a
+	// Docker manifest list.
+	OCIImageIndexMediaType = "application/vnd.oci.image.index.v1+json"
+	// OCIImageConfigMediaType is the media type of the config blob of an OCI image manifest.
+	OCIImageConfigMediaType = "application/vnd.oci.image.config.v1+json"
 )
 
 // Image is an immutable representation of a Docker image and metadata at a point in time.
@@ -50,6 +87,11 @@ type Image struct {
 	DockerImageManifest string
 	// DockerImageLayers represents the layers in the image. May not be set if the image does not define that data.
 	DockerImageLayers []ImageLayer
+	// DockerImageConfig is the raw JSON of the image config referenced by a schema 2 or OCI manifest.
+	DockerImageConfig string
+	// DockerImageManifestMediaType is the media type of DockerImageManifest. If empty, the manifest is
+	// a Docker schema 1 manifest.
+	DockerImageManifestMediaType string
 }
 
 // ImageLayer represents a single layer of the image. Some images may have multiple layers. Some may have none.
@@ -58,6 +100,8 @@ type ImageLayer struct {
 	Name string
 	// Size of the layer as defined by the underlying store.
 	Size int64
+	// MediaType of the referenced layer. Only set for schema 2 and OCI manifests.
+	MediaType string
 }
 
 // ImageStreamList is a list of ImageStream objects.
@@ -112,6 +156,13 @@ type TagReference struct {
 	Generation *int64
 	// ImportPolicy is information that controls how images may be imported by the server.
 	ImportPolicy TagImportPolicy
+	// HistoryLimit is the maximum number of tag events retained in the status history of this tag.
+	// Older events are removed when a new image is tagged, which allows the images they reference to
+	// be pruned. If nil, the history is not limited.
+	HistoryLimit *int32
+	// ReferencePolicy defines how other components should consume the image. An empty policy is
+	// treated as SourceTagReferencePolicy.
+	ReferencePolicy TagReferencePolicy
 }
 
 type TagImportPolicy struct {
@@ -121,6 +172,31 @@ type TagImportPolicy struct {
 	Scheduled bool
 }
 
+// TagReferencePolicyType describes how pull-specs for images in an image stream tag are generated when
+// image change triggers are fired.
+type TagReferencePolicyType string
+
+const (
+	// SourceTagReferencePolicy indicates the image's original location should be used when the image stream tag
+	// is resolved into other resources (builds and deployment configurations).
+	SourceTagReferencePolicy TagReferencePolicyType = "Source"
+	// LocalTagReferencePolicy indicates the image should prefer to pull via the local integrated registry,
+	// falling back to the remote location if the integrated registry has not been configured. The reference will
+	// use the internal DNS name or registry service IP.
+	LocalTagReferencePolicy TagReferencePolicyType = "Local"
+)
+
+// TagReferencePolicy describes how pull-specs for images in this image stream tag are generated when
+// image change triggers in deployment configs or builds are resolved.
+type TagReferencePolicy struct {
+	// Type determines how the image pull spec should be transformed when the image stream tag is used in
+	// deployment config triggers or new builds. The default value is `Source`, indicating the original
+	// location of the image should be used (if imported). The user may also specify `Local`, indicating
+	// that the pull spec should point to the integrated Docker registry and leverage the registry's
+	// ability to proxy the pull to an upstream registry.
+	Type TagReferencePolicyType
+}
+
 // ImageStreamStatus contains information about the state of this image stream.
 type ImageStreamStatus struct {
 	// DockerImageRepository represents the effective location this stream may be accessed at. May be empty until the server
@@ -19,13 +19,15 @@ func (DockerImageReference) SwaggerDoc() map[string]string {
 }
 
 var map_Image = map[string]string{
-	"":                           "Image is an immutable representation of a Docker image and metadata at a point in time.",
-	"metadata":                   "Standard object's metadata.",
-	"dockerImageReference":       "DockerImageReference is the string that can be used to pull this image.",
-	"dockerImageMetadata":        "DockerImageMetadata contains metadata about this image",
-	"dockerImageMetadataVersion": "DockerImageMetadataVersion conveys the version of the object, which if empty defaults to \"1.0\"",
-	"dockerImageManifest":        "DockerImageManifest is the raw JSON of the manifest",
-	"dockerImageLayers":          "DockerImageLayers represents the layers in the image. May not be set if the image does not define that data.",
+	"":                             "Image is an immutable representation of a Docker image and metadata at a point in time.",
+	"metadata":                     "Standard object's metadata.",
+	"dockerImageReference":         "DockerImageReference is the string that can be used to pull this image.",
+	"dockerImageMetadata":          "DockerImageMetadata contains metadata about this image",
+	"dockerImageMetadataVersion":   "DockerImageMetadataVersion conveys the version of the object, which if empty defaults to \"1.0\"",
+	"dockerImageManifest":          "DockerImageManifest is the raw JSON of the manifest",
+	"dockerImageLayers":            "DockerImageLayers represents the layers in the image. May not be set if the image does not define that data.",
+	"dockerImageConfig":            "DockerImageConfig is the raw JSON of the image config referenced by a schema 2 or OCI manifest.",
+	"dockerImageManifestMediaType": "DockerImageManifestMediaType is the media type of the manifest. If empty, the manifest is a Docker schema 1 manifest.",
 }
 
 func (Image) SwaggerDoc() map[string]string {
@@ -56,9 +58,10 @@ func (ImageImportStatus) SwaggerDoc() map[string]string {
 }
 
 var map_ImageLayer = map[string]string{
-	"":     "ImageLayer represents a single layer of the image. Some images may have multiple layers. Some may have none.",
-	"name": "Name of the layer as defined by the underlying store.",
-	"size": "Size of the layer as defined by the underlying store.",
+	"":          "ImageLayer represents a single layer of the image. Some images may have multiple layers. Some may have none.",
+	"name":      "Name of the layer as defined by the underlying store.",
+	"size":      "Size of the layer as defined by the underlying store.",
+	"mediaType": "MediaType of the referenced layer. Only set for schema 2 and OCI manifests.",
 }
 
 func (ImageLayer) SwaggerDoc() map[string]string {
@@ -151,7 +154,7 @@ func (ImageStreamMapping) SwaggerDoc() map[string]string {
 }
 
 var map_ImageStreamSpec = map[string]string{
-	"": "ImageStreamSpec represents options for ImageStreams.",
+	"":                      "ImageStreamSpec represents options for ImageStreams.",
 	"dockerImageRepository": "DockerImageRepository is optional, if specified this stream is backed by a Docker repository on this server",
 	"tags":                  "Tags map arbitrary string values to specific image locators",
 }
@@ -161,7 +164,7 @@ func (ImageStreamSpec) SwaggerDoc() map[string]string {
 }
 
 var map_ImageStreamStatus = map[string]string{
-	"": "ImageStreamStatus contains information about the state of this image stream.",
+	"":                      "ImageStreamStatus contains information about the state of this image stream.",
 	"dockerImageRepository": "DockerImageRepository represents the effective location this stream may be accessed at. May be empty until the server determines where the repository is located",
 	"tags":                  "Tags are a historical record of images associated with each tag. The first entry in the TagEvent array is the currently tagged image.",
 }
@@ -263,15 +266,26 @@ func (TagImportPolicy) SwaggerDoc() map[string]string {
 }
 
 var map_TagReference = map[string]string{
-	"":             "TagReference specifies optional annotations for images using this tag and an optional reference to an ImageStreamTag, ImageStreamImage, or DockerImage this tag should track.",
-	"name":         "Name of the tag",
-	"annotations":  "Annotations associated with images using this tag",
-	"from":         "From is a reference to an image stream tag or image stream this tag should track",
-	"reference":    "Reference states if the tag will be imported. Default value is false, which means the tag will be imported.",
-	"generation":   "Generation is the image stream generation that updated this tag - setting it to 0 is an indication that the generation must be updated. Legacy clients will send this as nil, which means the client doesn't know or care.",
-	"importPolicy": "Import is information that controls how images may be imported by the server.",
+	"":                "TagReference specifies optional annotations for images using this tag and an optional reference to an ImageStreamTag, ImageStreamImage, or DockerImage this tag should track.",
+	"name":            "Name of the tag",
+	"annotations":     "Annotations associated with images using this tag",
+	"from":            "From is a reference to an image stream tag or image stream this tag should track",
+	"reference":       "Reference states if the tag will be imported. Default value is false, which means the tag will be imported.",
+	"generation":      "Generation is the image stream generation that updated this tag - setting it to 0 is an indication that the generation must be updated. Legacy clients will send this as nil, which means the client doesn't know or care.",
+	"importPolicy":    "Import is information that controls how images may be imported by the server.",
+	"historyLimit":    "HistoryLimit is the maximum number of tag events retained in the status history of this tag. Older events are removed when a new image is tagged, which allows the images they reference to be pruned. If unset, the history is not limited.",
+	"referencePolicy": "ReferencePolicy defines how other components should consume the image. Defaults to a policy of type Source.",
 }
 
 func (TagReference) SwaggerDoc() map[string]string {
 	return map_TagReference
 }
+
+var map_TagReferencePolicy = map[string]string{
+	"":     "TagReferencePolicy describes how pull-specs for images in this image stream tag are generated when image change triggers in deployment configs or builds are resolved.",
+	"type": "Type determines how the image pull spec should be transformed when the image stream tag is used in deployment config triggers or new builds. The default value is `Source`, indicating the original location of the image should be used (if imported). The user may also specify `Local`, indicating that the pull spec should point to the integrated Docker registry and leverage the registry's ability to proxy the pull to an upstream registry.",
+}
+
+func (TagReferencePolicy) SwaggerDoc() map[string]string {
+	return map_TagReferencePolicy
+}
@@ -29,6 +29,10 @@ type Image struct {
 	DockerImageManifest string `json:"dockerImageManifest,omitempty"`
 	// DockerImageLayers represents the layers in the image. May not be set if the image does not define that data.
 	DockerImageLayers []ImageLayer `json:"dockerImageLayers"`
+	// DockerImageConfig is the raw JSON of the image config referenced by a schema 2 or OCI manifest.
+	DockerImageConfig string `json:"dockerImageConfig,omitempty"`
+	// DockerImageManifestMediaType is the media type of the manifest. If empty, the manifest is a Docker schema 1 manifest.
+	DockerImageManifestMediaType string `json:"dockerImageManifestMediaType,omitempty"`
 }
 
 // ImageLayer represents a single layer of the image. Some images may have multiple layers. Some may have none.
@@ -37,6 +41,8 @@ type ImageLayer struct {
 	Name string `json:"name"`
 	// Size of the layer as defined by the underlying store.
 	Size int64 `json:"size"`
+	// MediaType of the referenced layer. Only set for schema 2 and OCI manifests.
+	MediaType string `json:"mediaType,omitempty"`
 }
 
 // ImageStreamList is a list of ImageStream objects.
@@ -80,6 +86,10 @@ type TagReference struct {
 	Generation *int64 `json:"generation"`
 	// Import is information that controls how images may be imported by the server.
 	ImportPolicy TagImportPolicy `json:"importPolicy,omitempty"`
+	// HistoryLimit is the maximum number of tag events retained in the status history of this tag. Older events are removed when a new image is tagged, which allows the images they reference to be pruned. If unset, the history is not limited.
+	HistoryLimit *int32 `json:"historyLimit,omitempty"`
+	// ReferencePolicy defines how other components should consume the image. Defaults to a policy of type Source.
+	ReferencePolicy TagReferencePolicy `json:"referencePolicy,omitempty"`
 }
 
 type TagImportPolicy struct {
@@ -89,6 +99,26 @@ type TagImportPolicy struct {
 	Scheduled bool `json:"scheduled,omitempty"`
 }
 
+// TagReferencePolicyType describes how pull-specs for images in an image stream tag are generated when
+// image change triggers are fired.
+type TagReferencePolicyType string
+
+const (
+	// SourceTagReferencePolicy indicates the image's original location should be used when the image stream tag
+	// is resolved into other resources (builds and deployment configurations).
+	SourceTagReferencePolicy TagReferencePolicyType = "Source"
+	// LocalTagReferencePolicy indicates the image should prefer to pull via the local integrated registry,
+	// falling back to the remote location if the integrated registry has not been configured.
+	LocalTagReferencePolicy TagReferencePolicyType = "Local"
+)
+
+// TagReferencePolicy describes how pull-specs for images in this image stream tag are generated when
+// image change triggers in deployment configs or builds are resolved.
+type TagReferencePolicy struct {
+	// Type determines how the image pull spec should be transformed when the image stream tag is used in deployment config triggers or new builds. The default value is `Source`, indicating the original location of the image should be used (if imported). The user may also specify `Local`, indicating that the pull spec should point to the integrated Docker registry and leverage the registry's ability to proxy the pull to an upstream registry.
+	Type TagReferencePolicyType `json:"type"`
+}
+
 // ImageStreamStatus contains information about the state of this image stream.
 type ImageStreamStatus struct {
 	// Represents the effective location this stream may be accessed at. May be empty until the server
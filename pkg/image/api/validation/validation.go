@@ -126,6 +126,14 @@ func ValidateImageStreamTagReference(tagRef api.TagReference, fldPath *field.Pat
 			errs = append(errs, field.Required(fldPath.Child("from", "kind"), "valid values are 'DockerImage', 'ImageStreamImage', 'ImageStreamTag'"))
 		}
 	}
+	if tagRef.HistoryLimit != nil && *tagRef.HistoryLimit < 1 {
+		errs = append(errs, field.Invalid(fldPath.Child("historyLimit"), *tagRef.HistoryLimit, "must be greater than zero"))
+	}
+	switch tagRef.ReferencePolicy.Type {
+	case "", api.SourceTagReferencePolicy, api.LocalTagReferencePolicy:
+	default:
+		errs = append(errs, field.NotSupported(fldPath.Child("referencePolicy", "type"), tagRef.ReferencePolicy.Type, []string{string(api.SourceTagReferencePolicy), string(api.LocalTagReferencePolicy)}))
+	}
 	return errs
 }
 
@@ -131,7 +131,7 @@ func TestValidateImageStreamMappingNotOK(t *testing.T) {
 					Namespace: "default",
 				},
 				DockerImageRepository: "openshift/ruby-19-centos",
-				Tag: api.DefaultImageTag,
+				Tag:                   api.DefaultImageTag,
 				Image: api.Image{
 					DockerImageReference: "openshift/ruby-19-centos",
 				},
@@ -145,7 +145,7 @@ func TestValidateImageStreamMappingNotOK(t *testing.T) {
 					Namespace: "default",
 				},
 				DockerImageRepository: "registry/extra/openshift/ruby-19-centos",
-				Tag: api.DefaultImageTag,
+				Tag:                   api.DefaultImageTag,
 				Image: api.Image{
 					ObjectMeta: kapi.ObjectMeta{
 						Name:      "foo",
@@ -236,24 +236,24 @@ func TestValidateImageStream(t *testing.T) {
 			},
 		},
 		"invalid dockerImageRepository": {
-			namespace: "namespace",
-			name:      "foo",
+			namespace:             "namespace",
+			name:                  "foo",
 			dockerImageRepository: "a-|///bbb",
 			expected: field.ErrorList{
 				field.Invalid(field.NewPath("spec", "dockerImageRepository"), "a-|///bbb", "the docker pull spec \"a-|///bbb\" must be two or three segments separated by slashes"),
 			},
 		},
 		"invalid dockerImageRepository with tag": {
-			namespace: "namespace",
-			name:      "foo",
+			namespace:             "namespace",
+			name:                  "foo",
 			dockerImageRepository: "a/b:tag",
 			expected: field.ErrorList{
 				field.Invalid(field.NewPath("spec", "dockerImageRepository"), "a/b:tag", "the repository name may not contain a tag"),
 			},
 		},
 		"invalid dockerImageRepository with ID": {
-			namespace: "namespace",
-			name:      "foo",
+			namespace:             "namespace",
+			name:                  "foo",
 			dockerImageRepository: "a/b@sha256:something",
 			expected: field.ErrorList{
 				field.Invalid(field.NewPath("spec", "dockerImageRepository"), "a/b@sha256:something", "the repository name may not contain an ID"),
@@ -299,6 +299,40 @@ func TestValidateImageStream(t *testing.T) {
 				field.Invalid(field.NewPath("spec", "tags").Key("other").Child("importPolicy", "scheduled"), true, "only tags pointing to Docker repositories may be scheduled for background import"),
 			},
 		},
+		"history limit must be positive": {
+			namespace: "namespace",
+			name:      "foo",
+			specTags: map[string]api.TagReference{
+				"limited": {
+					From:         &kapi.ObjectReference{Kind: "DockerImage", Name: "abc"},
+					HistoryLimit: newInt32(1),
+				},
+				"zero": {
+					From:         &kapi.ObjectReference{Kind: "DockerImage", Name: "abc"},
+					HistoryLimit: newInt32(0),
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "tags").Key("zero").Child("historyLimit"), int32(0), "must be greater than zero"),
+			},
+		},
+		"reference policy type must be supported": {
+			namespace: "namespace",
+			name:      "foo",
+			specTags: map[string]api.TagReference{
+				"local": {
+					From:            &kapi.ObjectReference{Kind: "DockerImage", Name: "abc"},
+					ReferencePolicy: api.TagReferencePolicy{Type: api.LocalTagReferencePolicy},
+				},
+				"other": {
+					From:            &kapi.ObjectReference{Kind: "DockerImage", Name: "abc"},
+					ReferencePolicy: api.TagReferencePolicy{Type: "Other"},
+				},
+			},
+			expected: field.ErrorList{
+				field.NotSupported(field.NewPath("spec", "tags").Key("other").Child("referencePolicy", "type"), api.TagReferencePolicyType("Other"), []string{"Source", "Local"}),
+			},
+		},
 		"image IDs can't be scheduled": {
 			namespace: "namespace",
 			name:      "foo",
@@ -389,7 +423,7 @@ func TestValidateImageStream(t *testing.T) {
 			},
 			Spec: api.ImageStreamSpec{
 				DockerImageRepository: test.dockerImageRepository,
-				Tags: test.specTags,
+				Tags:                  test.specTags,
 			},
 			Status: api.ImageStreamStatus{
 				Tags: test.statusTags,
@@ -659,3 +693,7 @@ func TestValidateImageStreamImport(t *testing.T) {
 		}
 	}
 }
+
+func newInt32(i int32) *int32 {
+	return &i
+}
@@ -7,6 +7,7 @@ import (
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	apierrs "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 
 	"github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/image/api"
@@ -14,6 +15,10 @@ import (
 
 var ErrNotImportable = errors.New("the specified stream cannot be imported")
 
+// ErrImportFailed is returned by NextTimed when the import completed but at least one of the
+// images could not be imported.
+var ErrImportFailed = errors.New("one or more images in the stream could not be imported")
+
 type ImportController struct {
 	streams client.ImageStreamsNamespacer
 }
@@ -125,9 +130,16 @@ const retryCount = 2
 //
 // Notifier, if passed, will be invoked if the stream is going to be imported.
 func (c *ImportController) Next(stream *api.ImageStream, notifier Notifier) error {
+	_, err := c.next(stream, notifier)
+	return err
+}
+
+// next imports the stream if necessary and returns the result of the import, or nil if no import
+// was performed.
+func (c *ImportController) next(stream *api.ImageStream, notifier Notifier) (*api.ImageStreamImport, error) {
 	ok, partial := needsImport(stream)
 	if !ok {
-		return nil
+		return nil, nil
 	}
 	glog.V(3).Infof("Importing stream %s/%s partial=%t...", stream.Namespace, stream.Name, partial)
 
@@ -164,13 +176,27 @@ func (c *ImportController) Next(stream *api.ImageStream, notifier Notifier) erro
 	result, err := c.streams.ImageStreams(stream.Namespace).Import(isi)
 	if err != nil {
 		if apierrs.IsNotFound(err) && client.IsStatusErrorKind(err, "imageStream") {
-			return ErrNotImportable
+			return nil, ErrNotImportable
 		}
 		glog.V(4).Infof("Import stream %s/%s partial=%t error: %v", stream.Namespace, stream.Name, partial, err)
-	} else {
-		glog.V(5).Infof("Import stream %s/%s partial=%t import: %#v", stream.Namespace, stream.Name, partial, result.Status.Import)
+		return nil, err
 	}
-	return err
+	glog.V(5).Infof("Import stream %s/%s partial=%t import: %#v", stream.Namespace, stream.Name, partial, result.Status.Import)
+	return result, nil
+}
+
+// importFailed returns true if any of the images or the repository in the import result could
+// not be imported. The reason is recorded by the server as a condition on the status tag.
+func importFailed(result *api.ImageStreamImport) bool {
+	for _, image := range result.Status.Images {
+		if image.Status.Status != unversioned.StatusSuccess {
+			return true
+		}
+	}
+	if repository := result.Status.Repository; repository != nil && repository.Status.Status != unversioned.StatusSuccess {
+		return true
+	}
+	return false
 }
 
 func (c *ImportController) NextTimedByName(namespace, name string) error {
@@ -192,5 +218,12 @@ func (c *ImportController) NextTimed(stream *api.ImageStream) error {
 
 	glog.V(3).Infof("Scheduled import of stream %s/%s...", stream.Namespace, stream.Name)
 
-	return c.Next(stream, nil)
+	result, err := c.next(stream, nil)
+	if err != nil {
+		return err
+	}
+	if result != nil && importFailed(result) {
+		return ErrImportFailed
+	}
+	return nil
 }
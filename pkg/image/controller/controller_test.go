@@ -424,3 +424,92 @@ func TestScheduledImport(t *testing.T) {
 		t.Fatalf("should have left scheduled: %#v", b.scheduler)
 	}
 }
+
+func TestScheduledImportBackoff(t *testing.T) {
+	one := int64(1)
+	stream := &api.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{
+			Name: "test", Namespace: "other", UID: "1", ResourceVersion: "1",
+			Annotations: map[string]string{api.DockerImageRepositoryCheckAnnotation: "done"},
+			Generation:  1,
+		},
+		Spec: api.ImageStreamSpec{
+			Tags: map[string]api.TagReference{
+				"default": {
+					From:         &kapi.ObjectReference{Kind: "DockerImage", Name: "mysql:latest"},
+					Generation:   &one,
+					ImportPolicy: api.TagImportPolicy{Scheduled: true},
+				},
+			},
+		},
+		Status: api.ImageStreamStatus{
+			Tags: map[string]api.TagEventList{
+				"default": {Items: []api.TagEvent{{Generation: 1}}},
+			},
+		},
+	}
+	importWithStatus := func(status string) *api.ImageStreamImport {
+		return &api.ImageStreamImport{
+			ObjectMeta: kapi.ObjectMeta{Name: "test"},
+			Spec: api.ImageStreamImportSpec{
+				Import: true,
+				Images: []api.ImageImportSpec{{From: kapi.ObjectReference{Kind: "DockerImage", Name: "mysql:latest"}}},
+			},
+			Status: api.ImageStreamImportStatus{
+				Images: []api.ImageImportStatus{{Status: unversioned.Status{Status: status}}},
+			},
+		}
+	}
+
+	b := newScheduled(true, &client.Fake{}, 1, nil, nil)
+	if err := b.Handle(stream); err != nil {
+		t.Fatal(err)
+	}
+
+	// each failure doubles the number of checks that are skipped
+	failedImport := importWithStatus(unversioned.StatusFailure)
+	for i, expected := range []bool{true, false, true, false, false, false, true, false} {
+		fake := client.NewSimpleFake(stream, failedImport)
+		b.controller.streams = fake
+		b.scheduler.RunOnce()
+		if imported := len(fake.Actions()) > 0; imported != expected {
+			t.Fatalf("%d: expected import %t, got actions: %#v", i, expected, fake.Actions())
+		}
+	}
+	if b.scheduler.Len() != 1 {
+		t.Fatalf("should have left item in scheduler: %#v", b.scheduler)
+	}
+	for _, backoff := range b.backoff {
+		if backoff.failures != 3 || backoff.skip != 6 {
+			t.Fatalf("unexpected backoff: %#v", backoff)
+		}
+	}
+
+	// the backoff is capped
+	for i := 0; i < 10; i++ {
+		for _, backoff := range b.backoff {
+			backoff.skip = 0
+		}
+		b.controller.streams = client.NewSimpleFake(stream, failedImport)
+		b.scheduler.RunOnce()
+	}
+	for _, backoff := range b.backoff {
+		if backoff.skip != 1<<maxBackoffSteps-1 {
+			t.Fatalf("unexpected backoff: %#v", backoff)
+		}
+		backoff.skip = 0
+	}
+
+	// a successful import clears the backoff
+	b.controller.streams = client.NewSimpleFake(stream, importWithStatus(unversioned.StatusSuccess))
+	b.scheduler.RunOnce()
+	if len(b.backoff) != 0 {
+		t.Fatalf("should have cleared backoff: %#v", b.backoff)
+	}
+	fake := client.NewSimpleFake(stream, importWithStatus(unversioned.StatusSuccess))
+	b.controller.streams = fake
+	b.scheduler.RunOnce()
+	if len(fake.Actions()) != 2 {
+		t.Fatalf("should have imported: %#v", fake.Actions())
+	}
+}
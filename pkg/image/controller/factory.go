@@ -71,6 +71,10 @@ func (f *ImportControllerFactory) Create() (controller.RunnableController, contr
 	return changed, b.scheduler
 }
 
+// maxBackoffSteps bounds the exponential backoff applied to scheduled imports that fail. A stream
+// that keeps failing is checked at most once every 2^maxBackoffSteps scheduler intervals.
+const maxBackoffSteps = 5
+
 type uniqueItem struct {
 	uid             string
 	resourceVersion string
@@ -83,6 +87,17 @@ type scheduled struct {
 	scheduler   *controller.Scheduler
 	rateLimiter util.RateLimiter
 	controller  *ImportController
+
+	// backoff tracks the scheduled imports that have failed, keyed by the stream key. It is only
+	// accessed from the scheduler.
+	backoff map[interface{}]*importBackoff
+}
+
+// importBackoff records the consecutive failures of the scheduled imports of a stream and the
+// number of scheduler intervals to skip before the stream is checked again.
+type importBackoff struct {
+	failures int
+	skip     int
 }
 
 // newScheduled initializes a scheduled import object and sets its scheduler. Limiter is optional.
@@ -93,6 +108,7 @@ func newScheduled(enabled bool, client client.ImageStreamsNamespacer, buckets in
 		controller: &ImportController{
 			streams: client,
 		},
+		backoff: make(map[interface{}]*importBackoff),
 	}
 	b.scheduler = controller.NewScheduler(buckets, bucketLimiter, b.HandleTimed)
 	return b
@@ -115,24 +131,50 @@ func (b *scheduled) HandleTimed(key, value interface{}) {
 		return
 	}
 	glog.V(5).Infof("DEBUG: checking %s", key)
+	if backoff, ok := b.backoff[key]; ok && backoff.skip > 0 {
+		backoff.skip--
+		glog.V(5).Infof("DEBUG: check of %s is backing off after %d failures", key, backoff.failures)
+		return
+	}
 	if b.rateLimiter != nil && !b.rateLimiter.TryAccept() {
 		glog.V(5).Infof("DEBUG: check of %s exceeded rate limit, will retry later", key)
 		return
 	}
 	namespace, name, _ := cache.SplitMetaNamespaceKey(key.(string))
-	if err := b.controller.NextTimedByName(namespace, name); err != nil {
+	switch err := b.controller.NextTimedByName(namespace, name); err {
+	case nil:
+		delete(b.backoff, key)
+	case ErrNotImportable:
 		// the stream cannot be imported
-		if err == ErrNotImportable {
-			// value must match to be removed, so we avoid races against creation by ensuring that we only
-			// remove the stream if the uid and resource version in the scheduler are exactly the same.
-			b.scheduler.Remove(key, value)
-			return
-		}
+		// value must match to be removed, so we avoid races against creation by ensuring that we only
+		// remove the stream if the uid and resource version in the scheduler are exactly the same.
+		b.scheduler.Remove(key, value)
+		delete(b.backoff, key)
+	case ErrImportFailed:
+		b.importFailed(key)
+		glog.V(4).Infof("Scheduled import of %s failed, will retry in %d intervals", key, b.backoff[key].skip+1)
+	default:
+		b.importFailed(key)
 		utilruntime.HandleError(err)
-		return
 	}
 }
 
+// importFailed records a failed scheduled import of the stream identified by key and doubles the
+// number of scheduler intervals before it is checked again, up to maxBackoffSteps.
+func (b *scheduled) importFailed(key interface{}) {
+	backoff, ok := b.backoff[key]
+	if !ok {
+		backoff = &importBackoff{}
+		b.backoff[key] = backoff
+	}
+	backoff.failures++
+	steps := backoff.failures
+	if steps > maxBackoffSteps {
+		steps = maxBackoffSteps
+	}
+	backoff.skip = 1<<uint(steps) - 1
+}
+
 // Importing is invoked when the controller decides to import a stream in order to push back
 // the next schedule time.
 func (b *scheduled) Importing(stream *api.ImageStream) {
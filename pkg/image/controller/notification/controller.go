@@ -0,0 +1,265 @@
+package notification
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/controller/framework"
+	"k8s.io/kubernetes/pkg/runtime"
+	kutil "k8s.io/kubernetes/pkg/util"
+	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/util/workqueue"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"github.com/openshift/origin/pkg/client"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+const (
+	// maxPendingNotifications bounds the number of notifications waiting to be delivered. Notifications
+	// for tag updates that happen while the queue is full are dropped.
+	maxPendingNotifications = 100
+	// notificationWorkers is the number of notifications posted concurrently, so that a slow URL does
+	// not hold up the notifications sent to other URLs.
+	notificationWorkers = 5
+	// notificationTimeout bounds the time spent posting a notification to a URL.
+	notificationTimeout = 10 * time.Second
+	// maxNotificationRetries is the number of times posting a notification to a URL is retried.
+	maxNotificationRetries = 5
+	// notificationQPS and notificationBurst limit the rate notifications are posted at.
+	notificationQPS   = 10
+	notificationBurst = 20
+)
+
+// TagUpdateNotification is the payload posted to the notification URLs when a tag of an image
+// stream points to a new image.
+type TagUpdateNotification struct {
+	// Namespace of the image stream.
+	Namespace string `json:"namespace"`
+	// ImageStream is the name of the image stream.
+	ImageStream string `json:"imageStream"`
+	// Tag is the name of the updated tag.
+	Tag string `json:"tag"`
+	// Image is the digest of the image the tag now points to.
+	Image string `json:"image"`
+	// PreviousImage is the digest of the image the tag pointed to before the update, if any.
+	PreviousImage string `json:"previousImage,omitempty"`
+	// DockerImageReference is the pull spec of the new image.
+	DockerImageReference string `json:"dockerImageReference"`
+	// Created is the time the tag was updated.
+	Created unversioned.Time `json:"created"`
+	// DockerImageMetadata holds the metadata of the new image, when it is known to the server.
+	DockerImageMetadata *imageapi.DockerImage `json:"dockerImageMetadata,omitempty"`
+}
+
+// delivery is a notification waiting to be posted to url.
+type delivery struct {
+	url          string
+	notification TagUpdateNotification
+	// attempts is the number of times posting the notification failed.
+	attempts int
+}
+
+// TagUpdateNotificationController posts a TagUpdateNotification to a webhook whenever a tag of an
+// image stream is updated to point to a new image. Notifications are sent to the cluster wide URL,
+// if any, and to the URL in the TagUpdateNotificationURLAnnotation of the image stream when allowAnnotation
+// is true.
+type TagUpdateNotificationController struct {
+	client          client.ImagesInterfacer
+	httpClient      *http.Client
+	url             string
+	allowAnnotation bool
+
+	streamController *framework.Controller
+	// queue holds the pending deliveries. Failed deliveries are added back once the backoff of
+	// their URL expires.
+	queue       *workqueue.Type
+	backoff     *kutil.Backoff
+	rateLimiter kutil.RateLimiter
+	stopChan    chan struct{}
+}
+
+// NewTagUpdateNotificationController returns a controller notifying of the tag updates of all image
+// streams visible to osClient.
+func NewTagUpdateNotificationController(osClient client.Interface, url string, allowAnnotation bool, resync time.Duration) *TagUpdateNotificationController {
+	c := &TagUpdateNotificationController{
+		client:          osClient,
+		httpClient:      &http.Client{Timeout: notificationTimeout},
+		url:             url,
+		allowAnnotation: allowAnnotation,
+		queue:           workqueue.New(),
+		backoff:         kutil.NewBackOff(5*time.Second, 5*time.Minute),
+		rateLimiter:     kutil.NewTokenBucketRateLimiter(notificationQPS, notificationBurst),
+	}
+
+	_, c.streamController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+				return osClient.ImageStreams(kapi.NamespaceAll).List(options)
+			},
+			WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+				return osClient.ImageStreams(kapi.NamespaceAll).Watch(options)
+			},
+		},
+		&imageapi.ImageStream{},
+		resync,
+		framework.ResourceEventHandlerFuncs{
+			UpdateFunc: func(old, obj interface{}) {
+				c.handleUpdate(old.(*imageapi.ImageStream), obj.(*imageapi.ImageStream))
+			},
+		},
+	)
+
+	return c
+}
+
+// Run starts the controller and returns immediately.
+func (c *TagUpdateNotificationController) Run() {
+	if c.stopChan == nil {
+		c.stopChan = make(chan struct{})
+		go c.streamController.Run(c.stopChan)
+		for i := 0; i < notificationWorkers; i++ {
+			go c.worker()
+		}
+	}
+}
+
+// Stop gracefully shuts down the controller. Pending notifications are dropped.
+func (c *TagUpdateNotificationController) Stop() {
+	if c.stopChan != nil {
+		close(c.stopChan)
+		c.stopChan = nil
+		c.queue.ShutDown()
+	}
+}
+
+// urlsFor returns the URLs notified of the tag updates of stream.
+func (c *TagUpdateNotificationController) urlsFor(stream *imageapi.ImageStream) []string {
+	urls := sets.NewString()
+	if len(c.url) > 0 {
+		urls.Insert(c.url)
+	}
+	if url := stream.Annotations[imageapi.TagUpdateNotificationURLAnnotation]; c.allowAnnotation && len(url) > 0 {
+		urls.Insert(url)
+	}
+	return urls.List()
+}
+
+// handleUpdate queues a notification for every tag of stream whose latest image differs from
+// the one recorded in old.
+func (c *TagUpdateNotificationController) handleUpdate(old, stream *imageapi.ImageStream) {
+	urls := c.urlsFor(stream)
+	if len(urls) == 0 {
+		return
+	}
+	for _, notification := range tagUpdates(old, stream) {
+		for _, url := range urls {
+			if c.queue.Len() >= maxPendingNotifications {
+				utilruntime.HandleError(fmt.Errorf("dropped the notification of %s of the update of tag %s/%s:%s, too many notifications are pending", url, stream.Namespace, stream.Name, notification.Tag))
+				continue
+			}
+			c.queue.Add(&delivery{url: url, notification: notification})
+		}
+	}
+}
+
+// tagUpdates returns a notification for every tag of stream whose latest image differs from the
+// one recorded in old.
+func tagUpdates(old, stream *imageapi.ImageStream) []TagUpdateNotification {
+	var notifications []TagUpdateNotification
+	for tag := range stream.Status.Tags {
+		latest := imageapi.LatestTaggedImage(stream, tag)
+		if latest == nil || len(latest.Image) == 0 {
+			continue
+		}
+		notification := TagUpdateNotification{
+			Namespace:            stream.Namespace,
+			ImageStream:          stream.Name,
+			Tag:                  tag,
+			Image:                latest.Image,
+			DockerImageReference: latest.DockerImageReference,
+			Created:              latest.Created,
+		}
+		if previous := imageapi.LatestTaggedImage(old, tag); previous != nil {
+			if previous.Image == latest.Image {
+				continue
+			}
+			notification.PreviousImage = previous.Image
+		}
+		notifications = append(notifications, notification)
+	}
+	return notifications
+}
+
+// worker posts the queued notifications until the queue is shut down.
+func (c *TagUpdateNotificationController) worker() {
+	for {
+		item, quit := c.queue.Get()
+		if quit {
+			return
+		}
+		c.send(item.(*delivery))
+		c.queue.Done(item)
+	}
+}
+
+// send adds the image metadata to the notification of d and posts it to its URL. Failed
+// deliveries are retried with a backoff per URL.
+func (c *TagUpdateNotificationController) send(d *delivery) {
+	notification := &d.notification
+	if notification.DockerImageMetadata == nil {
+		if image, err := c.client.Images().Get(notification.Image); err == nil {
+			notification.DockerImageMetadata = &image.DockerImageMetadata
+		} else {
+			glog.V(4).Infof("Unable to retrieve the metadata of image %s for the notification of tag %s/%s:%s: %v", notification.Image, notification.Namespace, notification.ImageStream, notification.Tag, err)
+		}
+	}
+
+	body, err := json.Marshal(notification)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	c.rateLimiter.Accept()
+	if err := c.post(d.url, body); err != nil {
+		c.retry(d, err)
+		return
+	}
+	c.backoff.Reset(d.url)
+	glog.V(4).Infof("Notified %s of the update of tag %s/%s:%s to %s", d.url, notification.Namespace, notification.ImageStream, notification.Tag, notification.Image)
+}
+
+// retry queues d again once the backoff of its URL expires, unless it was retried too many times.
+func (c *TagUpdateNotificationController) retry(d *delivery, err error) {
+	notification := d.notification
+	if d.attempts >= maxNotificationRetries {
+		utilruntime.HandleError(fmt.Errorf("unable to notify %s of the update of tag %s/%s:%s, giving up: %v", d.url, notification.Namespace, notification.ImageStream, notification.Tag, err))
+		return
+	}
+	d.attempts++
+	c.backoff.Next(d.url, c.backoff.Clock.Now())
+	delay := c.backoff.Get(d.url)
+	glog.V(4).Infof("Unable to notify %s of the update of tag %s/%s:%s, retrying in %s: %v", d.url, notification.Namespace, notification.ImageStream, notification.Tag, delay, err)
+	time.AfterFunc(delay, func() { c.queue.Add(d) })
+}
+
+func (c *TagUpdateNotificationController) post(url string, body []byte) error {
+	resp, err := c.httpClient.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+	return nil
+}
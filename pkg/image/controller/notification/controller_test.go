@@ -0,0 +1,165 @@
+package notification
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kutil "k8s.io/kubernetes/pkg/util"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func testStream(tags map[string]string) *imageapi.ImageStream {
+	stream := &imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "default", Name: "ruby"},
+		Status:     imageapi.ImageStreamStatus{Tags: map[string]imageapi.TagEventList{}},
+	}
+	for tag, image := range tags {
+		stream.Status.Tags[tag] = imageapi.TagEventList{
+			Items: []imageapi.TagEvent{{Image: image, DockerImageReference: "registry/default/ruby@" + image}},
+		}
+	}
+	return stream
+}
+
+func TestTagUpdates(t *testing.T) {
+	tests := []struct {
+		name     string
+		old      map[string]string
+		new      map[string]string
+		expected map[string]string
+	}{
+		{
+			name: "no change",
+			old:  map[string]string{"latest": "sha256:1"},
+			new:  map[string]string{"latest": "sha256:1"},
+		},
+		{
+			name:     "new tag",
+			old:      map[string]string{"latest": "sha256:1"},
+			new:      map[string]string{"latest": "sha256:1", "2.0": "sha256:2"},
+			expected: map[string]string{"2.0": ""},
+		},
+		{
+			name:     "updated tag",
+			old:      map[string]string{"latest": "sha256:1", "2.0": "sha256:2"},
+			new:      map[string]string{"latest": "sha256:3", "2.0": "sha256:2"},
+			expected: map[string]string{"latest": "sha256:1"},
+		},
+		{
+			name: "removed tag",
+			old:  map[string]string{"latest": "sha256:1"},
+			new:  map[string]string{},
+		},
+	}
+
+	for _, test := range tests {
+		notifications := tagUpdates(testStream(test.old), testStream(test.new))
+		previous := map[string]string{}
+		for _, n := range notifications {
+			if n.Namespace != "default" || n.ImageStream != "ruby" || n.Image != test.new[n.Tag] {
+				t.Errorf("%s: unexpected notification: %#v", test.name, n)
+			}
+			previous[n.Tag] = n.PreviousImage
+		}
+		if len(previous) != len(test.expected) || (len(previous) > 0 && !reflect.DeepEqual(previous, test.expected)) {
+			t.Errorf("%s: expected updates of %v, got %v", test.name, test.expected, previous)
+		}
+	}
+}
+
+func TestNotifyTagUpdates(t *testing.T) {
+	received := make(chan TagUpdateNotification, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var notification TagUpdateNotification
+		if err := json.NewDecoder(r.Body).Decode(&notification); err != nil {
+			t.Errorf("unable to decode notification: %v", err)
+		}
+		received <- notification
+	}))
+	defer server.Close()
+
+	image := &imageapi.Image{
+		ObjectMeta:          kapi.ObjectMeta{Name: "sha256:2"},
+		DockerImageMetadata: imageapi.DockerImage{Architecture: "amd64"},
+	}
+	tests := []struct {
+		name            string
+		url             string
+		allowAnnotation bool
+		expected        int
+	}{
+		{name: "cluster wide", url: server.URL, expected: 1},
+		{name: "annotation allowed", allowAnnotation: true, expected: 1},
+		{name: "annotation ignored"},
+		{name: "same url", url: server.URL, allowAnnotation: true, expected: 1},
+	}
+
+	for _, test := range tests {
+		c := NewTagUpdateNotificationController(testclient.NewSimpleFake(image), test.url, test.allowAnnotation, 0)
+		old := testStream(map[string]string{"latest": "sha256:1"})
+		stream := testStream(map[string]string{"latest": "sha256:2"})
+		stream.Annotations = map[string]string{imageapi.TagUpdateNotificationURLAnnotation: server.URL}
+
+		c.handleUpdate(old, stream)
+		for c.queue.Len() > 0 {
+			item, _ := c.queue.Get()
+			c.send(item.(*delivery))
+			c.queue.Done(item)
+		}
+
+		if len(received) != test.expected {
+			t.Errorf("%s: expected %d notifications, got %d", test.name, test.expected, len(received))
+		}
+		for len(received) > 0 {
+			notification := <-received
+			if notification.Tag != "latest" || notification.Image != "sha256:2" || notification.PreviousImage != "sha256:1" {
+				t.Errorf("%s: unexpected notification: %#v", test.name, notification)
+			}
+			if notification.DockerImageMetadata == nil || notification.DockerImageMetadata.Architecture != "amd64" {
+				t.Errorf("%s: expected the image metadata in the notification: %#v", test.name, notification.DockerImageMetadata)
+			}
+		}
+	}
+}
+
+func TestNotifyTagUpdatesRetry(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	c := NewTagUpdateNotificationController(testclient.NewSimpleFake(), server.URL, false, 0)
+	c.backoff = kutil.NewBackOff(time.Millisecond, time.Millisecond)
+	c.handleUpdate(testStream(map[string]string{"latest": "sha256:1"}), testStream(map[string]string{"latest": "sha256:2"}))
+
+	// the failed delivery is queued again after its backoff
+	for i := 0; i < 2; i++ {
+		item, _ := c.queue.Get()
+		c.send(item.(*delivery))
+		c.queue.Done(item)
+	}
+	if requests != 2 {
+		t.Fatalf("expected the notification to be retried once, got %d requests", requests)
+	}
+
+	// deliveries which failed too many times are dropped
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	c.send(&delivery{url: server.URL, attempts: maxNotificationRetries, notification: TagUpdateNotification{Image: "sha256:3"}})
+	time.Sleep(10 * time.Millisecond)
+	if c.queue.Len() != 0 {
+		t.Errorf("expected the delivery not to be retried")
+	}
+}
@@ -217,6 +217,7 @@ func (s Strategy) tagsChanged(old, stream *api.ImageStream) field.ErrorList {
 	}
 
 	api.UpdateChangedTrackingTags(stream, old)
+	api.LimitTagHistory(stream)
 
 	// use a consistent timestamp on creation
 	if old == nil && !stream.CreationTimestamp.IsZero() {
@@ -456,6 +457,9 @@ func (s Strategy) prepareForUpdate(obj, old runtime.Object, resetStatus bool) {
 
 	// default spec tag generations afterwards (to avoid updating the generation for legacy objects)
 	ensureSpecTagGenerationsAreSet(stream, oldStream)
+
+	// drop tag events beyond the history limit of each tag
+	api.LimitTagHistory(stream)
 }
 
 func (s Strategy) PrepareForUpdate(obj, old runtime.Object) {
@@ -509,6 +513,7 @@ func (StatusStrategy) PrepareForUpdate(obj, old runtime.Object) {
 	stream.Spec.DockerImageRepository = oldStream.Spec.DockerImageRepository
 
 	updateObservedGenerationForStatusUpdate(stream, oldStream)
+	api.LimitTagHistory(stream)
 }
 
 func (StatusStrategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) field.ErrorList {
@@ -566,6 +571,7 @@ func (s InternalStrategy) PrepareForCreate(obj runtime.Object) {
 		ref.Generation = &stream.Generation
 		stream.Spec.Tags[tag] = ref
 	}
+	api.LimitTagHistory(stream)
 }
 
 func (s InternalStrategy) PrepareForUpdate(obj, old runtime.Object) {
@@ -1050,3 +1050,40 @@ func TestTagRefChanged(t *testing.T) {
 		}
 	}
 }
+
+func TestStatusUpdateLimitsTagHistory(t *testing.T) {
+	one := int32(1)
+	old := &api.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Name: "stream", Namespace: "default", ResourceVersion: "1"},
+		Spec: api.ImageStreamSpec{
+			Tags: map[string]api.TagReference{
+				"latest": {Name: "latest", HistoryLimit: &one},
+			},
+		},
+		Status: api.ImageStreamStatus{
+			Tags: map[string]api.TagEventList{
+				"latest": {Items: []api.TagEvent{{Image: "a", DockerImageReference: "registry/default/stream@a"}}},
+			},
+		},
+	}
+	stream := &api.ImageStream{
+		ObjectMeta: old.ObjectMeta,
+		Spec:       old.Spec,
+		Status: api.ImageStreamStatus{
+			Tags: map[string]api.TagEventList{
+				"latest": {Items: []api.TagEvent{
+					{Image: "b", DockerImageReference: "registry/default/stream@b"},
+					{Image: "a", DockerImageReference: "registry/default/stream@a"},
+				}},
+			},
+		},
+	}
+
+	strategy := NewStatusStrategy(NewStrategy(&fakeDefaultRegistry{}, &fakeSubjectAccessReviewRegistry{}))
+	strategy.PrepareForUpdate(stream, old)
+
+	items := stream.Status.Tags["latest"].Items
+	if len(items) != 1 || items[0].Image != "b" {
+		t.Errorf("expected only the most recent event to be kept: %#v", items)
+	}
+}
@@ -82,6 +82,7 @@ func (r *REST) Get(ctx kapi.Context, id string) (runtime.Object, error) {
 		return nil, err
 	}
 	image.DockerImageManifest = ""
+	image.DockerImageConfig = ""
 
 	if d, err := digest.ParseDigest(imageName); err == nil {
 		imageName = d.Hex()
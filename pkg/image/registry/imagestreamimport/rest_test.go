@@ -0,0 +1,129 @@
+package imagestreamimport
+
+import (
+	"errors"
+	"testing"
+
+	gocontext "golang.org/x/net/context"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	"github.com/openshift/origin/pkg/image/api"
+	"github.com/openshift/origin/pkg/image/importer"
+
+	_ "github.com/openshift/origin/pkg/api/install"
+)
+
+type fakeImporter struct {
+	images []api.ImageImportStatus
+	err    error
+}
+
+func (i *fakeImporter) Import(ctx gocontext.Context, isi *api.ImageStreamImport) error {
+	isi.Status.Images = i.images
+	return i.err
+}
+
+func newTestREST(i *fakeImporter) *REST {
+	importFn := func(r importer.RepositoryRetriever) importer.Interface {
+		return i
+	}
+	// streams, images and secrets are left unset: an import that is not persisted must not
+	// read or write image streams and images.
+	return NewREST(importFn, nil, nil, nil, nil, nil, nil, nil)
+}
+
+func TestCreateWithoutImport(t *testing.T) {
+	fake := &fakeImporter{
+		images: []api.ImageImportStatus{
+			{
+				Status: unversioned.Status{Status: unversioned.StatusSuccess},
+				Image: &api.Image{
+					ObjectMeta:          kapi.ObjectMeta{Name: "sha256:0000"},
+					DockerImageManifest: `{"name":"mysql"}`,
+					DockerImageLayers:   []api.ImageLayer{{Name: "sha256:1111", Size: 10}},
+					DockerImageMetadata: api.DockerImage{
+						Config: &api.DockerConfig{
+							Env:          []string{"MYSQL_VERSION=5.6"},
+							ExposedPorts: map[string]struct{}{"3306/tcp": {}},
+							Labels:       map[string]string{"io.k8s.description": "MySQL"},
+						},
+					},
+				},
+			},
+			{
+				Status: unversioned.Status{Status: unversioned.StatusSuccess},
+				Image: &api.Image{
+					ObjectMeta:          kapi.ObjectMeta{Name: "sha256:2222"},
+					DockerImageManifest: `{"name":"redis"}`,
+				},
+			},
+		},
+	}
+	storage := newTestREST(fake)
+
+	isi := &api.ImageStreamImport{
+		ObjectMeta: kapi.ObjectMeta{Name: "test"},
+		Spec: api.ImageStreamImportSpec{
+			Images: []api.ImageImportSpec{
+				{From: kapi.ObjectReference{Kind: "DockerImage", Name: "mysql:latest"}},
+				{From: kapi.ObjectReference{Kind: "DockerImage", Name: "redis:latest"}, IncludeManifest: true},
+			},
+		},
+	}
+	obj, err := storage.Create(kapi.NewDefaultContext(), isi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := obj.(*api.ImageStreamImport)
+	if result.Status.Import != nil {
+		t.Errorf("should not have returned an image stream: %#v", result.Status.Import)
+	}
+	if len(result.Status.Images) != 2 {
+		t.Fatalf("unexpected images: %#v", result.Status.Images)
+	}
+
+	image := result.Status.Images[0].Image
+	if len(image.DockerImageManifest) != 0 {
+		t.Errorf("should have cleared the manifest: %#v", image)
+	}
+	if len(image.DockerImageLayers) != 1 || image.DockerImageMetadata.Config == nil || len(image.DockerImageMetadata.Config.Env) != 1 ||
+		len(image.DockerImageMetadata.Config.ExposedPorts) != 1 || len(image.DockerImageMetadata.Config.Labels) != 1 {
+		t.Errorf("should have returned the image metadata: %#v", image)
+	}
+	if image := result.Status.Images[1].Image; len(image.DockerImageManifest) == 0 {
+		t.Errorf("should have returned the requested manifest: %#v", image)
+	}
+}
+
+func TestCreateImportError(t *testing.T) {
+	storage := newTestREST(&fakeImporter{err: errors.New("registry unavailable")})
+
+	isi := &api.ImageStreamImport{
+		ObjectMeta: kapi.ObjectMeta{Name: "test"},
+		Spec: api.ImageStreamImportSpec{
+			Images: []api.ImageImportSpec{{From: kapi.ObjectReference{Kind: "DockerImage", Name: "mysql:latest"}}},
+		},
+	}
+	_, err := storage.Create(kapi.NewDefaultContext(), isi)
+	status, ok := err.(kapierrors.APIStatus)
+	if !ok || status.Status().Reason != unversioned.StatusReasonInternalError {
+		t.Fatalf("expected an internal error: %v", err)
+	}
+}
+
+func TestCreateInvalid(t *testing.T) {
+	storage := newTestREST(&fakeImporter{})
+
+	isi := &api.ImageStreamImport{
+		ObjectMeta: kapi.ObjectMeta{Name: "test"},
+		Spec: api.ImageStreamImportSpec{
+			Images: []api.ImageImportSpec{{From: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "mysql:latest"}}},
+		},
+	}
+	if _, err := storage.Create(kapi.NewDefaultContext(), isi); !kapierrors.IsInvalid(err) {
+		t.Fatalf("expected an invalid error: %v", err)
+	}
+}
@@ -17,7 +17,7 @@ import (
 )
 
 // REST implements the RESTStorage interface for ImageStreamTag
-// It only supports the Get method and is used to simplify retrieving an Image by tag from an ImageStream
+// It is used to simplify retrieving an Image by tag from an ImageStream and to manage single tags of an ImageStream
 type REST struct {
 	imageRegistry       image.Registry
 	imageStreamRegistry imagestream.Registry
@@ -107,6 +107,50 @@ func (r *REST) Get(ctx kapi.Context, id string) (runtime.Object, error) {
 	return newISTag(tag, imageStream, image, false)
 }
 
+// Create adds a tag to an image stream, creating the image stream if it does not exist. It is an error
+// to create a tag that already exists in the spec or the status of the image stream.
+func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
+	istag, ok := obj.(*api.ImageStreamTag)
+	if !ok {
+		return nil, kapierrors.NewBadRequest(fmt.Sprintf("obj is not an ImageStreamTag: %#v", obj))
+	}
+
+	name, tag, err := nameAndTag(istag.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	imageStream, err := r.imageStreamRegistry.GetImageStream(ctx, name)
+	switch {
+	case err == nil:
+		_, specTag := imageStream.Spec.Tags[tag]
+		_, statusTag := imageStream.Status.Tags[tag]
+		if specTag || statusTag {
+			return nil, kapierrors.NewAlreadyExists(api.Resource("imagestreamtags"), istag.Name)
+		}
+	case kapierrors.IsNotFound(err):
+		namespace, ok := kapi.NamespaceFrom(ctx)
+		if !ok {
+			return nil, kapierrors.NewBadRequest("namespace is required on ImageStreamTags")
+		}
+		imageStream = &api.ImageStream{
+			ObjectMeta: kapi.ObjectMeta{Namespace: namespace, Name: name},
+		}
+	default:
+		return nil, err
+	}
+
+	istag.ResourceVersion = ""
+	if err := rest.BeforeCreate(Strategy, ctx, obj); err != nil {
+		return nil, err
+	}
+
+	// the image stream is saved at the resource version the tag was found missing at, so a
+	// concurrent change to the stream results in a conflict rather than overwriting a new tag
+	created, _, err := r.saveTag(ctx, istag, tag, imageStream)
+	return created, err
+}
+
 func (r *REST) Update(ctx kapi.Context, obj runtime.Object) (runtime.Object, bool, error) {
 	istag, ok := obj.(*api.ImageStreamTag)
 	if !ok {
@@ -156,6 +200,13 @@ func (r *REST) Update(ctx kapi.Context, obj runtime.Object) (runtime.Object, boo
 		}
 	}
 
+	return r.saveTag(ctx, istag, tag, imageStream)
+}
+
+// saveTag sets the spec tag of imageStream from istag and creates or updates the image stream. The
+// image stream is updated at its resource version. It returns the resulting tag and whether the spec
+// tag was added.
+func (r *REST) saveTag(ctx kapi.Context, istag *api.ImageStreamTag, tag string, imageStream *api.ImageStream) (runtime.Object, bool, error) {
 	// update the spec tag
 	if imageStream.Spec.Tags == nil {
 		imageStream.Spec.Tags = map[string]api.TagReference{}
@@ -171,6 +222,7 @@ func (r *REST) Update(ctx kapi.Context, obj runtime.Object) (runtime.Object, boo
 
 	// mutate the image stream
 	var newImageStream *api.ImageStream
+	var err error
 	if imageStream.CreationTimestamp.IsZero() {
 		newImageStream, err = r.imageStreamRegistry.CreateImageStream(ctx, imageStream)
 	} else {
@@ -299,6 +351,7 @@ func newISTag(tag string, imageStream *api.ImageStream, image *api.Image, allowE
 			return nil, err
 		}
 		image.DockerImageManifest = ""
+		image.DockerImageConfig = ""
 		ist.Image = *image
 	} else {
 		ist.Image = api.Image{}
@@ -309,8 +362,9 @@ func newISTag(tag string, imageStream *api.ImageStream, image *api.Image, allowE
 	// real value from status. This should fix the problem for v1 registries,
 	// where mutliple tags point to a single id and only the first image's metadata
 	// is saved. This in turn will always return the pull spec from the first
-	// imported image, which might be different than the requested tag.
-	ist.Image.DockerImageReference = event.DockerImageReference
+	// imported image, which might be different than the requested tag. The reference
+	// policy of the tag may point the pull spec at the integrated registry instead.
+	ist.Image.DockerImageReference = api.ResolveReferenceForTagEvent(imageStream, tag, event)
 
 	return ist, nil
 }
@@ -501,3 +501,150 @@ func TestDeleteImageStreamTag(t *testing.T) {
 
 	}
 }
+
+func TestCreateImageStreamTag(t *testing.T) {
+	tests := map[string]struct {
+		repo        *api.ImageStream
+		expectError bool
+	}{
+		"new image stream": {},
+		"new tag": {
+			repo: &api.ImageStream{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "default", Name: "test", CreationTimestamp: unversioned.Now()},
+				Spec: api.ImageStreamSpec{
+					Tags: map[string]api.TagReference{
+						"other": {From: &kapi.ObjectReference{Kind: "DockerImage", Name: "openshift/origin:other"}},
+					},
+				},
+			},
+		},
+		"existing spec tag": {
+			repo: &api.ImageStream{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "default", Name: "test", CreationTimestamp: unversioned.Now()},
+				Spec: api.ImageStreamSpec{
+					Tags: map[string]api.TagReference{
+						"latest": {From: &kapi.ObjectReference{Kind: "DockerImage", Name: "openshift/origin:other"}},
+					},
+				},
+			},
+			expectError: true,
+		},
+		"existing status tag": {
+			repo: &api.ImageStream{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "default", Name: "test", CreationTimestamp: unversioned.Now()},
+				Status: api.ImageStreamStatus{
+					Tags: map[string]api.TagEventList{
+						"latest": {Items: []api.TagEvent{{DockerImageReference: "openshift/origin:latest"}}},
+					},
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range tests {
+		client, server, storage := setup(t)
+		defer server.Terminate(t)
+
+		if testCase.repo != nil {
+			client.Create(
+				context.TODO(),
+				etcdtest.AddPrefix("/imagestreams/default/test"),
+				runtime.EncodeOrDie(kapi.Codecs.LegacyCodec(v1.SchemeGroupVersion), testCase.repo),
+			)
+		}
+
+		istag := &api.ImageStreamTag{
+			ObjectMeta: kapi.ObjectMeta{Namespace: "default", Name: "test:latest"},
+			Tag: &api.TagReference{
+				From:      &kapi.ObjectReference{Kind: "DockerImage", Name: "openshift/origin:latest"},
+				Reference: true,
+			},
+		}
+		ctx := kapi.WithUser(kapi.NewDefaultContext(), &fakeUser{})
+		_, err := storage.Create(ctx, istag)
+		if testCase.expectError {
+			if !errors.IsAlreadyExists(err) {
+				t.Errorf("%s: expected an already exists error, got %v", name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		stream, err := storage.imageStreamRegistry.GetImageStream(kapi.NewDefaultContext(), "test")
+		if err != nil {
+			t.Fatalf("%s: error retrieving image stream: %v", name, err)
+		}
+		tag, ok := stream.Spec.Tags["latest"]
+		if !ok || tag.From == nil || tag.From.Name != "openshift/origin:latest" || !tag.Reference {
+			t.Errorf("%s: unexpected spec tag: %#v", name, tag)
+		}
+	}
+}
+
+// concurrentTagRegistry tags the image stream the first time it is retrieved, after reading
+// it, as a concurrent caller would.
+type concurrentTagRegistry struct {
+	imagestream.Registry
+	tag    string
+	tagged bool
+}
+
+func (r *concurrentTagRegistry) GetImageStream(ctx kapi.Context, name string) (*api.ImageStream, error) {
+	stream, err := r.Registry.GetImageStream(ctx, name)
+	if err != nil || r.tagged {
+		return stream, err
+	}
+	r.tagged = true
+	updated, err := r.Registry.GetImageStream(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	updated.Spec.Tags[r.tag] = api.TagReference{From: &kapi.ObjectReference{Kind: "DockerImage", Name: "openshift/origin:concurrent"}}
+	if _, err := r.Registry.UpdateImageStream(ctx, updated); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+func TestCreateImageStreamTagConflict(t *testing.T) {
+	client, server, storage := setup(t)
+	defer server.Terminate(t)
+
+	repo := &api.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "default", Name: "test", CreationTimestamp: unversioned.Now()},
+		Spec: api.ImageStreamSpec{
+			Tags: map[string]api.TagReference{
+				"other": {From: &kapi.ObjectReference{Kind: "DockerImage", Name: "openshift/origin:other"}},
+			},
+		},
+	}
+	client.Create(
+		context.TODO(),
+		etcdtest.AddPrefix("/imagestreams/default/test"),
+		runtime.EncodeOrDie(kapi.Codecs.LegacyCodec(v1.SchemeGroupVersion), repo),
+	)
+	registry := storage.imageStreamRegistry
+	storage.imageStreamRegistry = &concurrentTagRegistry{Registry: registry, tag: "latest"}
+
+	istag := &api.ImageStreamTag{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "default", Name: "test:latest"},
+		Tag: &api.TagReference{
+			From: &kapi.ObjectReference{Kind: "DockerImage", Name: "openshift/origin:latest"},
+		},
+	}
+	ctx := kapi.WithUser(kapi.NewDefaultContext(), &fakeUser{})
+	if _, err := storage.Create(ctx, istag); !errors.IsConflict(err) {
+		t.Fatalf("expected a conflict error, got %v", err)
+	}
+
+	stream, err := registry.GetImageStream(kapi.NewDefaultContext(), "test")
+	if err != nil {
+		t.Fatalf("error retrieving image stream: %v", err)
+	}
+	if tag := stream.Spec.Tags["latest"]; tag.From == nil || tag.From.Name != "openshift/origin:concurrent" {
+		t.Errorf("expected the concurrently created tag to be kept, got %#v", tag)
+	}
+}
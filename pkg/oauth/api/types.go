@@ -5,6 +5,10 @@ import (
 	"k8s.io/kubernetes/pkg/api/unversioned"
 )
 
+// AccessTokenNoExpiry is the ExpiresIn of access tokens that do not expire, and the AccessTokenMaxAgeSeconds of
+// clients whose access tokens do not expire.
+const AccessTokenNoExpiry = -1
+
 type OAuthAccessToken struct {
 	unversioned.TypeMeta
 	kapi.ObjectMeta
@@ -12,7 +16,7 @@ type OAuthAccessToken struct {
 	// ClientName references the client that created this token.
 	ClientName string
 
-	// ExpiresIn is the seconds from CreationTime before this token expires.
+	// ExpiresIn is the seconds from CreationTime before this token expires. -1 means the token does not expire.
 	ExpiresIn int64
 
 	// Scopes is an array of the requested scopes.
@@ -32,6 +36,10 @@ type OAuthAccessToken struct {
 
 	// RefreshToken is the value by which this token can be renewed. Can be blank.
 	RefreshToken string
+
+	// InactivityTimeoutSeconds is the seconds from CreationTime after which this token can no longer be used.
+	// It is extended each time the token is used. 0 means the token never times out from inactivity.
+	InactivityTimeoutSeconds int32
 }
 
 type OAuthAuthorizeToken struct {
@@ -59,6 +67,12 @@ type OAuthAuthorizeToken struct {
 	// UserUID is the unique UID associated with this token. UserUID and UserName must both match
 	// for this token to be valid.
 	UserUID string
+
+	// CodeChallenge is the optional code_challenge associated with this authorization code, as described in RFC 7636
+	CodeChallenge string
+
+	// CodeChallengeMethod is the optional code_challenge_method associated with this authorization code, as described in RFC 7636
+	CodeChallengeMethod string
 }
 
 type OAuthClient struct {
@@ -73,6 +87,37 @@ type OAuthClient struct {
 
 	// RedirectURIs is the valid redirection URIs associated with a client
 	RedirectURIs []string
+
+	// AccessTokenMaxAgeSeconds overrides the default access token max age for tokens granted to this client.
+	// -1 means tokens granted to this client do not expire.
+	AccessTokenMaxAgeSeconds *int32
+
+	// AccessTokenInactivityTimeoutSeconds overrides the default token inactivity timeout for tokens granted to this client.
+	// 0 means tokens granted to this client never time out from inactivity.
+	AccessTokenInactivityTimeoutSeconds *int32
+
+	// ScopeRestrictions describes which scopes this client can request. Each requested scope is checked against
+	// each restriction, it is allowed if any restriction matches. No restriction means any scope is allowed.
+	ScopeRestrictions []ScopeRestriction
+}
+
+// ScopeRestriction describes one restriction on scopes. Exactly one option must be set.
+type ScopeRestriction struct {
+	// ExactValues means the scope has to match a particular set of strings exactly
+	ExactValues []string
+
+	// ClusterRole describes a set of restrictions for cluster role scoping
+	ClusterRole *ClusterRoleScopeRestriction
+}
+
+// ClusterRoleScopeRestriction describes restrictions on cluster role scopes
+type ClusterRoleScopeRestriction struct {
+	// RoleNames is the list of cluster roles that can be referenced. * means anything
+	RoleNames []string
+	// Namespaces is the list of namespaces that can be referenced. * means any of them (including *)
+	Namespaces []string
+	// AllowEscalation indicates whether you can request roles and their escalating resources
+	AllowEscalation bool
 }
 
 type OAuthClientAuthorization struct {
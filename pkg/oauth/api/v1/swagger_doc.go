@@ -5,17 +5,29 @@ package v1
 // by hack/update-generated-swagger-descriptions.sh and should be run after a full build of OpenShift.
 // ==== DO NOT EDIT THIS FILE MANUALLY ====
 
+var map_ClusterRoleScopeRestriction = map[string]string{
+	"":                "ClusterRoleScopeRestriction describes restrictions on cluster role scopes",
+	"roleNames":       "RoleNames is the list of cluster roles that can be referenced. * means anything",
+	"namespaces":      "Namespaces is the list of namespaces that can be referenced. * means any of them (including *)",
+	"allowEscalation": "AllowEscalation indicates whether you can request roles and their escalating resources",
+}
+
+func (ClusterRoleScopeRestriction) SwaggerDoc() map[string]string {
+	return map_ClusterRoleScopeRestriction
+}
+
 var map_OAuthAccessToken = map[string]string{
-	"":               "OAuthAccessToken describes an OAuth access token",
-	"metadata":       "Standard object's metadata.",
-	"clientName":     "ClientName references the client that created this token.",
-	"expiresIn":      "ExpiresIn is the seconds from CreationTime before this token expires.",
-	"scopes":         "Scopes is an array of the requested scopes.",
-	"redirectURI":    "RedirectURI is the redirection associated with the token.",
-	"userName":       "UserName is the user name associated with this token",
-	"userUID":        "UserUID is the unique UID associated with this token",
-	"authorizeToken": "AuthorizeToken contains the token that authorized this token",
-	"refreshToken":   "RefreshToken is the value by which this token can be renewed. Can be blank.",
+	"":                         "OAuthAccessToken describes an OAuth access token",
+	"metadata":                 "Standard object's metadata.",
+	"clientName":               "ClientName references the client that created this token.",
+	"expiresIn":                "ExpiresIn is the seconds from CreationTime before this token expires. -1 means the token does not expire.",
+	"scopes":                   "Scopes is an array of the requested scopes.",
+	"redirectURI":              "RedirectURI is the redirection associated with the token.",
+	"userName":                 "UserName is the user name associated with this token",
+	"userUID":                  "UserUID is the unique UID associated with this token",
+	"authorizeToken":           "AuthorizeToken contains the token that authorized this token",
+	"refreshToken":             "RefreshToken is the value by which this token can be renewed. Can be blank.",
+	"inactivityTimeoutSeconds": "InactivityTimeoutSeconds is the seconds from CreationTime after which this token can no longer be used. It is extended each time the token is used. 0 means the token never times out from inactivity.",
 }
 
 func (OAuthAccessToken) SwaggerDoc() map[string]string {
@@ -33,15 +45,17 @@ func (OAuthAccessTokenList) SwaggerDoc() map[string]string {
 }
 
 var map_OAuthAuthorizeToken = map[string]string{
-	"":            "OAuthAuthorizeToken describes an OAuth authorization token",
-	"metadata":    "Standard object's metadata.",
-	"clientName":  "ClientName references the client that created this token.",
-	"expiresIn":   "ExpiresIn is the seconds from CreationTime before this token expires.",
-	"scopes":      "Scopes is an array of the requested scopes.",
-	"redirectURI": "RedirectURI is the redirection associated with the token.",
-	"state":       "State data from request",
-	"userName":    "UserName is the user name associated with this token",
-	"userUID":     "UserUID is the unique UID associated with this token. UserUID and UserName must both match for this token to be valid.",
+	"":                    "OAuthAuthorizeToken describes an OAuth authorization token",
+	"metadata":            "Standard object's metadata.",
+	"clientName":          "ClientName references the client that created this token.",
+	"expiresIn":           "ExpiresIn is the seconds from CreationTime before this token expires.",
+	"scopes":              "Scopes is an array of the requested scopes.",
+	"redirectURI":         "RedirectURI is the redirection associated with the token.",
+	"state":               "State data from request",
+	"userName":            "UserName is the user name associated with this token",
+	"userUID":             "UserUID is the unique UID associated with this token. UserUID and UserName must both match for this token to be valid.",
+	"codeChallenge":       "CodeChallenge is the optional code_challenge associated with this authorization code, as described in RFC 7636",
+	"codeChallengeMethod": "CodeChallengeMethod is the optional code_challenge_method associated with this authorization code, as described in RFC 7636",
 }
 
 func (OAuthAuthorizeToken) SwaggerDoc() map[string]string {
@@ -59,11 +73,14 @@ func (OAuthAuthorizeTokenList) SwaggerDoc() map[string]string {
 }
 
 var map_OAuthClient = map[string]string{
-	"":                      "OAuthClient describes an OAuth client",
-	"metadata":              "Standard object's metadata.",
-	"secret":                "Secret is the unique secret associated with a client",
-	"respondWithChallenges": "RespondWithChallenges indicates whether the client wants authentication needed responses made in the form of challenges instead of redirects",
-	"redirectURIs":          "RedirectURIs is the valid redirection URIs associated with a client",
+	"":                                    "OAuthClient describes an OAuth client",
+	"metadata":                            "Standard object's metadata.",
+	"secret":                              "Secret is the unique secret associated with a client",
+	"respondWithChallenges":               "RespondWithChallenges indicates whether the client wants authentication needed responses made in the form of challenges instead of redirects",
+	"redirectURIs":                        "RedirectURIs is the valid redirection URIs associated with a client",
+	"accessTokenMaxAgeSeconds":            "AccessTokenMaxAgeSeconds overrides the default access token max age for tokens granted to this client. -1 means tokens granted to this client do not expire.",
+	"accessTokenInactivityTimeoutSeconds": "AccessTokenInactivityTimeoutSeconds overrides the default token inactivity timeout for tokens granted to this client. 0 means tokens granted to this client never time out from inactivity.",
+	"scopeRestrictions":                   "ScopeRestrictions describes which scopes this client can request. Each requested scope is checked against each restriction, it is allowed if any restriction matches. No restriction means any scope is allowed.",
 }
 
 func (OAuthClient) SwaggerDoc() map[string]string {
@@ -102,3 +119,13 @@ var map_OAuthClientList = map[string]string{
 func (OAuthClientList) SwaggerDoc() map[string]string {
 	return map_OAuthClientList
 }
+
+var map_ScopeRestriction = map[string]string{
+	"":            "ScopeRestriction describes one restriction on scopes. Exactly one option must be set.",
+	"literals":    "ExactValues means the scope has to match a particular set of strings exactly",
+	"clusterRole": "ClusterRole describes a set of restrictions for cluster role scoping",
+}
+
+func (ScopeRestriction) SwaggerDoc() map[string]string {
+	return map_ScopeRestriction
+}
@@ -12,7 +12,7 @@ type OAuthAccessToken struct {
 	// ClientName references the client that created this token.
 	ClientName string `json:"clientName,omitempty"`
 
-	// ExpiresIn is the seconds from CreationTime before this token expires.
+	// ExpiresIn is the seconds from CreationTime before this token expires. -1 means the token does not expire.
 	ExpiresIn int64 `json:"expiresIn,omitempty"`
 
 	// Scopes is an array of the requested scopes.
@@ -32,6 +32,10 @@ type OAuthAccessToken struct {
 
 	// RefreshToken is the value by which this token can be renewed. Can be blank.
 	RefreshToken string `json:"refreshToken,omitempty"`
+
+	// InactivityTimeoutSeconds is the seconds from CreationTime after which this token can no longer be used.
+	// It is extended each time the token is used. 0 means the token never times out from inactivity.
+	InactivityTimeoutSeconds int32 `json:"inactivityTimeoutSeconds,omitempty"`
 }
 
 type OAuthAuthorizeToken struct {
@@ -59,6 +63,12 @@ type OAuthAuthorizeToken struct {
 	// UserUID is the unique UID associated with this token. UserUID and UserName must both match
 	// for this token to be valid.
 	UserUID string `json:"userUID,omitempty"`
+
+	// CodeChallenge is the optional code_challenge associated with this authorization code, as described in RFC 7636
+	CodeChallenge string `json:"codeChallenge,omitempty"`
+
+	// CodeChallengeMethod is the optional code_challenge_method associated with this authorization code, as described in RFC 7636
+	CodeChallengeMethod string `json:"codeChallengeMethod,omitempty"`
 }
 
 type OAuthClient struct {
@@ -73,6 +83,37 @@ type OAuthClient struct {
 
 	// RedirectURIs is the valid redirection URIs associated with a client
 	RedirectURIs []string `json:"redirectURIs,omitempty"`
+
+	// AccessTokenMaxAgeSeconds overrides the default access token max age for tokens granted to this client.
+	// -1 means tokens granted to this client do not expire.
+	AccessTokenMaxAgeSeconds *int32 `json:"accessTokenMaxAgeSeconds,omitempty"`
+
+	// AccessTokenInactivityTimeoutSeconds overrides the default token inactivity timeout for tokens granted to this client.
+	// 0 means tokens granted to this client never time out from inactivity.
+	AccessTokenInactivityTimeoutSeconds *int32 `json:"accessTokenInactivityTimeoutSeconds,omitempty"`
+
+	// ScopeRestrictions describes which scopes this client can request. Each requested scope is checked against
+	// each restriction, it is allowed if any restriction matches. No restriction means any scope is allowed.
+	ScopeRestrictions []ScopeRestriction `json:"scopeRestrictions,omitempty"`
+}
+
+// ScopeRestriction describes one restriction on scopes. Exactly one option must be set.
+type ScopeRestriction struct {
+	// ExactValues means the scope has to match a particular set of strings exactly
+	ExactValues []string `json:"literals,omitempty"`
+
+	// ClusterRole describes a set of restrictions for cluster role scoping
+	ClusterRole *ClusterRoleScopeRestriction `json:"clusterRole,omitempty"`
+}
+
+// ClusterRoleScopeRestriction describes restrictions on cluster role scopes
+type ClusterRoleScopeRestriction struct {
+	// RoleNames is the list of cluster roles that can be referenced. * means anything
+	RoleNames []string `json:"roleNames"`
+	// Namespaces is the list of namespaces that can be referenced. * means any of them (including *)
+	Namespaces []string `json:"namespaces"`
+	// AllowEscalation indicates whether you can request roles and their escalating resources
+	AllowEscalation bool `json:"allowEscalation"`
 }
 
 type OAuthClientAuthorization struct {
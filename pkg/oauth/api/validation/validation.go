@@ -5,7 +5,10 @@ import (
 	"net/url"
 	"strings"
 
+	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/validation"
+	"k8s.io/kubernetes/pkg/serviceaccount"
+	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	oapi "github.com/openshift/origin/pkg/api"
@@ -15,6 +18,9 @@ import (
 
 const MinTokenLength = 32
 
+// codeChallengeMethods holds the code_challenge_method values allowed by RFC 7636
+var codeChallengeMethods = sets.NewString("plain", "S256")
+
 func ValidateTokenName(name string, prefix bool) (bool, string) {
 	if ok, reason := oapi.MinimalNameRequirements(name, prefix); !ok {
 		return ok, reason
@@ -60,6 +66,31 @@ func ValidateAccessToken(accessToken *api.OAuthAccessToken) field.ErrorList {
 	if ok, msg := ValidateRedirectURI(accessToken.RedirectURI); !ok {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("redirectURI"), accessToken.RedirectURI, msg))
 	}
+	if accessToken.ExpiresIn < 0 && accessToken.ExpiresIn != api.AccessTokenNoExpiry {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("expiresIn"), accessToken.ExpiresIn, fmt.Sprintf("must be greater than or equal to 0, or %d for tokens that do not expire", api.AccessTokenNoExpiry)))
+	}
+
+	return allErrs
+}
+
+// ValidateAccessTokenUpdate only allows the inactivity timeout of the token to be extended
+func ValidateAccessTokenUpdate(newToken, oldToken *api.OAuthAccessToken) field.ErrorList {
+	allErrs := ValidateAccessToken(newToken)
+	allErrs = append(allErrs, validation.ValidateObjectMetaUpdate(&newToken.ObjectMeta, &oldToken.ObjectMeta, field.NewPath("metadata"))...)
+
+	if oldToken.InactivityTimeoutSeconds == 0 && newToken.InactivityTimeoutSeconds != 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("inactivityTimeoutSeconds"), newToken.InactivityTimeoutSeconds, "may not be set on a token that does not time out"))
+	} else if newToken.InactivityTimeoutSeconds < oldToken.InactivityTimeoutSeconds {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("inactivityTimeoutSeconds"), newToken.InactivityTimeoutSeconds, "may not be decreased"))
+	}
+
+	// everything but the inactivity timeout is immutable
+	copied := *oldToken
+	copied.ObjectMeta = newToken.ObjectMeta
+	copied.InactivityTimeoutSeconds = newToken.InactivityTimeoutSeconds
+	if !kapi.Semantic.DeepEqual(&copied, newToken) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath(""), "only inactivityTimeoutSeconds may be updated"))
+	}
 
 	return allErrs
 }
@@ -75,6 +106,14 @@ func ValidateAuthorizeToken(authorizeToken *api.OAuthAuthorizeToken) field.Error
 	if ok, msg := ValidateRedirectURI(authorizeToken.RedirectURI); !ok {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("redirectURI"), authorizeToken.RedirectURI, msg))
 	}
+	if len(authorizeToken.CodeChallenge) > 0 || len(authorizeToken.CodeChallengeMethod) > 0 {
+		switch {
+		case len(authorizeToken.CodeChallenge) == 0:
+			allErrs = append(allErrs, field.Required(field.NewPath("codeChallenge"), "required if codeChallengeMethod is specified"))
+		case !codeChallengeMethods.Has(authorizeToken.CodeChallengeMethod):
+			allErrs = append(allErrs, field.NotSupported(field.NewPath("codeChallengeMethod"), authorizeToken.CodeChallengeMethod, codeChallengeMethods.List()))
+		}
+	}
 
 	return allErrs
 }
@@ -86,7 +125,46 @@ func ValidateClient(client *api.OAuthClient) field.ErrorList {
 			allErrs = append(allErrs, field.Invalid(field.NewPath("redirectURIs").Index(i), redirect, msg))
 		}
 	}
+	if client.AccessTokenMaxAgeSeconds != nil && *client.AccessTokenMaxAgeSeconds <= 0 && *client.AccessTokenMaxAgeSeconds != api.AccessTokenNoExpiry {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("accessTokenMaxAgeSeconds"), *client.AccessTokenMaxAgeSeconds, fmt.Sprintf("must be greater than 0, or %d for tokens that do not expire", api.AccessTokenNoExpiry)))
+	}
+	if client.AccessTokenInactivityTimeoutSeconds != nil && *client.AccessTokenInactivityTimeoutSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("accessTokenInactivityTimeoutSeconds"), *client.AccessTokenInactivityTimeoutSeconds, "must be greater than or equal to 0"))
+	}
+	for i, restriction := range client.ScopeRestrictions {
+		allErrs = append(allErrs, ValidateScopeRestriction(restriction, field.NewPath("scopeRestrictions").Index(i))...)
+	}
+
+	return allErrs
+}
+
+// ValidateScopeRestriction checks that exactly one kind of restriction is set in restriction
+func ValidateScopeRestriction(restriction api.ScopeRestriction, fldPath *field.Path) field.ErrorList {
+	specifiers := 0
+	if len(restriction.ExactValues) > 0 {
+		specifiers = specifiers + 1
+	}
+	if restriction.ClusterRole != nil {
+		specifiers = specifiers + 1
+	}
+	if specifiers != 1 {
+		return field.ErrorList{field.Invalid(fldPath, restriction, "exactly one of literals, clusterRole is required")}
+	}
 
+	allErrs := field.ErrorList{}
+	for i, literal := range restriction.ExactValues {
+		if len(literal) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("literals").Index(i), literal, "may not be empty"))
+		}
+	}
+	if restriction.ClusterRole != nil {
+		if len(restriction.ClusterRole.RoleNames) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("clusterRole", "roleNames"), ""))
+		}
+		if len(restriction.ClusterRole.Namespaces) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("clusterRole", "namespaces"), ""))
+		}
+	}
 	return allErrs
 }
 
@@ -104,7 +182,8 @@ func ValidateClientAuthorizationName(name string, prefix bool) (bool, string) {
 		return ok, reason
 	}
 
-	parts := strings.Split(name, ":")
+	// user names cannot contain ":", client names can (see ValidateClientNameField)
+	parts := strings.SplitN(name, ":", 2)
 	if len(parts) != 2 {
 		return false, "must be in the format <userName>:<clientName>"
 	}
@@ -161,6 +240,9 @@ func ValidateClientAuthorizationUpdate(newAuth *api.OAuthClientAuthorization, ol
 func ValidateClientNameField(value string, fldPath *field.Path) field.ErrorList {
 	if len(value) == 0 {
 		return field.ErrorList{field.Required(fldPath, "")}
+	} else if _, _, err := serviceaccount.SplitUsername(value); err == nil {
+		// service accounts can act as OAuth clients, in which case their username is the client name
+		return field.ErrorList{}
 	} else if ok, msg := validation.NameIsDNSSubdomain(value, false); !ok {
 		return field.ErrorList{field.Invalid(fldPath, value, msg)}
 	}
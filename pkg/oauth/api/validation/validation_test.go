@@ -97,6 +97,16 @@ func TestValidateClientAuthorization(t *testing.T) {
 		t.Errorf("expected success: %v", errs)
 	}
 
+	errs = ValidateClientAuthorization(&oapi.OAuthClientAuthorization{
+		ObjectMeta: api.ObjectMeta{Name: "myusername:system:serviceaccount:myns:mysa"},
+		ClientName: "system:serviceaccount:myns:mysa",
+		UserName:   "myusername",
+		UserUID:    "myuseruid",
+	})
+	if len(errs) != 0 {
+		t.Errorf("expected success for a service account client: %v", errs)
+	}
+
 	errorCases := map[string]struct {
 		A oapi.OAuthClientAuthorization
 		T field.ErrorType
@@ -157,6 +167,15 @@ func TestValidateClient(t *testing.T) {
 		t.Errorf("expected success: %v", errs)
 	}
 
+	noExpiry := int32(oapi.AccessTokenNoExpiry)
+	errs = ValidateClient(&oapi.OAuthClient{
+		ObjectMeta:               api.ObjectMeta{Name: "client-name"},
+		AccessTokenMaxAgeSeconds: &noExpiry,
+	})
+	if len(errs) != 0 {
+		t.Errorf("expected success for a client whose tokens do not expire: %v", errs)
+	}
+
 	errorCases := map[string]struct {
 		Client oapi.OAuthClient
 		T      field.ErrorType
@@ -172,6 +191,44 @@ func TestValidateClient(t *testing.T) {
 			T:      field.ErrorTypeForbidden,
 			F:      "metadata.namespace",
 		},
+		"empty scope restriction": {
+			Client: oapi.OAuthClient{
+				ObjectMeta:        api.ObjectMeta{Name: "name"},
+				ScopeRestrictions: []oapi.ScopeRestriction{{}},
+			},
+			T: field.ErrorTypeInvalid,
+			F: "scopeRestrictions[0]",
+		},
+		"scope restriction with literals and cluster role": {
+			Client: oapi.OAuthClient{
+				ObjectMeta: api.ObjectMeta{Name: "name"},
+				ScopeRestrictions: []oapi.ScopeRestriction{{
+					ExactValues: []string{"user:info"},
+					ClusterRole: &oapi.ClusterRoleScopeRestriction{RoleNames: []string{"*"}, Namespaces: []string{"*"}},
+				}},
+			},
+			T: field.ErrorTypeInvalid,
+			F: "scopeRestrictions[0]",
+		},
+		"cluster role scope restriction without role names": {
+			Client: oapi.OAuthClient{
+				ObjectMeta: api.ObjectMeta{Name: "name"},
+				ScopeRestrictions: []oapi.ScopeRestriction{
+					{ExactValues: []string{"user:info"}},
+					{ClusterRole: &oapi.ClusterRoleScopeRestriction{Namespaces: []string{"*"}}},
+				},
+			},
+			T: field.ErrorTypeRequired,
+			F: "scopeRestrictions[1].clusterRole.roleNames",
+		},
+		"zero access token max age": {
+			Client: oapi.OAuthClient{
+				ObjectMeta:               api.ObjectMeta{Name: "name"},
+				AccessTokenMaxAgeSeconds: new(int32),
+			},
+			T: field.ErrorTypeInvalid,
+			F: "accessTokenMaxAgeSeconds",
+		},
 	}
 	for k, v := range errorCases {
 		errs := ValidateClient(&v.Client)
@@ -243,6 +300,63 @@ func TestValidateAccessTokens(t *testing.T) {
 	}
 }
 
+func TestValidateAccessTokenUpdate(t *testing.T) {
+	valid := &oapi.OAuthAccessToken{
+		ObjectMeta:               api.ObjectMeta{Name: "accessTokenNameWithMinimumLength", ResourceVersion: "1"},
+		ClientName:               "myclient",
+		UserName:                 "myusername",
+		UserUID:                  "myuseruid",
+		InactivityTimeoutSeconds: 600,
+	}
+
+	extended := *valid
+	extended.InactivityTimeoutSeconds = 1200
+	if errs := ValidateAccessTokenUpdate(&extended, valid); len(errs) != 0 {
+		t.Errorf("expected success: %v", errs)
+	}
+
+	errorCases := map[string]struct {
+		Token    oapi.OAuthAccessToken
+		OldToken oapi.OAuthAccessToken
+		T        field.ErrorType
+		F        string
+	}{
+		"decreased inactivity timeout": {
+			Token:    oapi.OAuthAccessToken{ObjectMeta: valid.ObjectMeta, ClientName: "myclient", UserName: "myusername", UserUID: "myuseruid", InactivityTimeoutSeconds: 300},
+			OldToken: *valid,
+			T:        field.ErrorTypeInvalid,
+			F:        "inactivityTimeoutSeconds",
+		},
+		"inactivity timeout set on a token that does not time out": {
+			Token:    *valid,
+			OldToken: oapi.OAuthAccessToken{ObjectMeta: valid.ObjectMeta, ClientName: "myclient", UserName: "myusername", UserUID: "myuseruid"},
+			T:        field.ErrorTypeInvalid,
+			F:        "inactivityTimeoutSeconds",
+		},
+		"changed user": {
+			Token:    oapi.OAuthAccessToken{ObjectMeta: valid.ObjectMeta, ClientName: "myclient", UserName: "otheruser", UserUID: "myuseruid", InactivityTimeoutSeconds: 600},
+			OldToken: *valid,
+			T:        field.ErrorTypeForbidden,
+			F:        "[]",
+		},
+	}
+	for k, v := range errorCases {
+		errs := ValidateAccessTokenUpdate(&v.Token, &v.OldToken)
+		if len(errs) == 0 {
+			t.Errorf("expected failure %s for %v", k, v.Token)
+			continue
+		}
+		for i := range errs {
+			if errs[i].Type != v.T {
+				t.Errorf("%s: expected errors to have type %s: %v", k, v.T, errs[i])
+			}
+			if errs[i].Field != v.F {
+				t.Errorf("%s: expected errors to have field %s: %v", k, v.F, errs[i])
+			}
+		}
+	}
+}
+
 func TestValidateAuthorizeTokens(t *testing.T) {
 	errs := ValidateAuthorizeToken(&oapi.OAuthAuthorizeToken{
 		ObjectMeta: api.ObjectMeta{Name: "authorizeTokenNameWithMinimumLength"},
@@ -254,6 +368,18 @@ func TestValidateAuthorizeTokens(t *testing.T) {
 		t.Errorf("expected success: %v", errs)
 	}
 
+	errs = ValidateAuthorizeToken(&oapi.OAuthAuthorizeToken{
+		ObjectMeta:          api.ObjectMeta{Name: "authorizeTokenNameWithMinimumLength"},
+		ClientName:          "myclient",
+		UserName:            "myusername",
+		UserUID:             "myuseruid",
+		CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+		CodeChallengeMethod: "S256",
+	})
+	if len(errs) != 0 {
+		t.Errorf("expected success: %v", errs)
+	}
+
 	errorCases := map[string]struct {
 		Token oapi.OAuthAuthorizeToken
 		T     field.ErrorType
@@ -305,6 +431,29 @@ func TestValidateAuthorizeTokens(t *testing.T) {
 			T: field.ErrorTypeForbidden,
 			F: "metadata.namespace",
 		},
+		"code challenge method without code challenge": {
+			Token: oapi.OAuthAuthorizeToken{
+				ObjectMeta:          api.ObjectMeta{Name: "authorizeTokenNameWithMinimumLength"},
+				ClientName:          "myclient",
+				UserName:            "myusername",
+				UserUID:             "myuseruid",
+				CodeChallengeMethod: "S256",
+			},
+			T: field.ErrorTypeRequired,
+			F: "codeChallenge",
+		},
+		"unsupported code challenge method": {
+			Token: oapi.OAuthAuthorizeToken{
+				ObjectMeta:          api.ObjectMeta{Name: "authorizeTokenNameWithMinimumLength"},
+				ClientName:          "myclient",
+				UserName:            "myusername",
+				UserUID:             "myuseruid",
+				CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+				CodeChallengeMethod: "S512",
+			},
+			T: field.ErrorTypeNotSupported,
+			F: "codeChallengeMethod",
+		},
 	}
 	for k, v := range errorCases {
 		errs := ValidateAuthorizeToken(&v.Token)
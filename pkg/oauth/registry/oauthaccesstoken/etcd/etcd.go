@@ -44,7 +44,15 @@ func NewREST(s storage.Interface, backends ...storage.Interface) *REST {
 			return oauthaccesstoken.Matcher(label, field)
 		},
 		TTLFunc: func(obj runtime.Object, existing uint64, update bool) (uint64, error) {
+			// Updates do not change the expiration of the token
+			if update {
+				return existing, nil
+			}
 			token := obj.(*api.OAuthAccessToken)
+			// Tokens that do not expire are kept until they are deleted
+			if token.ExpiresIn == api.AccessTokenNoExpiry {
+				return 0, nil
+			}
 			expires := uint64(token.ExpiresIn)
 			return expires, nil
 		},
@@ -54,6 +62,7 @@ func NewREST(s storage.Interface, backends ...storage.Interface) *REST {
 	}
 
 	store.CreateStrategy = oauthaccesstoken.Strategy
+	store.UpdateStrategy = oauthaccesstoken.Strategy
 
 	if len(backends) > 0 {
 		// Build identical stores that talk to a single etcd, so we can verify the token is distributed after creation
@@ -94,6 +103,16 @@ func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 	return r.store.Create(ctx, obj)
 }
 
+func (r *REST) Update(ctx kapi.Context, obj runtime.Object) (runtime.Object, bool, error) {
+	return r.store.Update(ctx, obj)
+}
+
 func (r *REST) Delete(ctx kapi.Context, name string, options *kapi.DeleteOptions) (runtime.Object, error) {
 	return r.store.Delete(ctx, name, options)
 }
+
+// DeleteCollection deletes every token matching the list options, allowing administrators to revoke
+// the tokens of a user or client at once.
+func (r *REST) DeleteCollection(ctx kapi.Context, options *kapi.DeleteOptions, listOptions *kapi.ListOptions) (runtime.Object, error) {
+	return r.store.DeleteCollection(ctx, options, listOptions)
+}
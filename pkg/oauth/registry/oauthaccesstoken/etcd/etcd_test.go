@@ -0,0 +1,64 @@
+package etcd
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/registry/registrytest"
+	etcdtesting "k8s.io/kubernetes/pkg/storage/etcd/testing"
+
+	"github.com/openshift/origin/pkg/oauth/api"
+	_ "github.com/openshift/origin/pkg/oauth/api/install"
+)
+
+func newStorage(t *testing.T) (*REST, *etcdtesting.EtcdTestServer) {
+	etcdStorage, server := registrytest.NewEtcdStorage(t, "")
+	storage := NewREST(etcdStorage)
+	return storage, server
+}
+
+func validAccessToken(name, userName string) *api.OAuthAccessToken {
+	return &api.OAuthAccessToken{
+		ObjectMeta: kapi.ObjectMeta{Name: name},
+		ClientName: "myclient",
+		UserName:   userName,
+		UserUID:    userName + "-uid",
+		ExpiresIn:  3600,
+	}
+}
+
+func TestDeleteCollection(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	ctx := kapi.NewContext()
+
+	for _, token := range []*api.OAuthAccessToken{
+		validAccessToken("bobTokenWithMinimumLengthOf32Chars1", "bob"),
+		validAccessToken("bobTokenWithMinimumLengthOf32Chars2", "bob"),
+		validAccessToken("aliceTokenWithMinimumLengthOf32Chars", "alice"),
+	} {
+		if _, err := storage.Create(ctx, token); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// an administrator revokes every token of bob
+	options := &kapi.ListOptions{FieldSelector: fields.OneTermEqualSelector("userName", "bob")}
+	obj, err := storage.DeleteCollection(ctx, nil, options)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deleted := obj.(*api.OAuthAccessTokenList).Items; len(deleted) != 2 {
+		t.Errorf("expected the 2 tokens of bob to be deleted, got %#v", deleted)
+	}
+
+	obj, err = storage.List(ctx, &kapi.ListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	remaining := obj.(*api.OAuthAccessTokenList).Items
+	if len(remaining) != 1 || remaining[0].UserName != "alice" {
+		t.Errorf("expected only the token of alice to remain, got %#v", remaining)
+	}
+}
@@ -42,6 +42,13 @@ func (strategy) Validate(ctx kapi.Context, obj runtime.Object) field.ErrorList {
 	return validation.ValidateAccessToken(token)
 }
 
+// ValidateUpdate validates a token update
+func (strategy) ValidateUpdate(ctx kapi.Context, obj runtime.Object, old runtime.Object) field.ErrorList {
+	token := obj.(*api.OAuthAccessToken)
+	oldToken := old.(*api.OAuthAccessToken)
+	return validation.ValidateAccessTokenUpdate(token, oldToken)
+}
+
 // AllowCreateOnUpdate is false for OAuth objects
 func (strategy) AllowCreateOnUpdate() bool {
 	return false
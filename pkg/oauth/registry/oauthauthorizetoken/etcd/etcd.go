@@ -97,3 +97,9 @@ func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 func (r *REST) Delete(ctx kapi.Context, name string, options *kapi.DeleteOptions) (runtime.Object, error) {
 	return r.store.Delete(ctx, name, options)
 }
+
+// DeleteCollection deletes every token matching the list options, allowing administrators to revoke
+// the tokens of a user or client at once.
+func (r *REST) DeleteCollection(ctx kapi.Context, options *kapi.DeleteOptions, listOptions *kapi.ListOptions) (runtime.Object, error) {
+	return r.store.DeleteCollection(ctx, options, listOptions)
+}
@@ -8,6 +8,7 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/util/sets"
 
 	"github.com/openshift/origin/pkg/oauth/api"
 	"github.com/openshift/origin/pkg/oauth/registry/oauthaccesstoken"
@@ -28,20 +29,32 @@ type storage struct {
 	authorizetoken oauthauthorizetoken.Registry
 	client         oauthclient.Registry
 	user           UserConversion
+
+	// accessTokenInactivityTimeoutSeconds is the inactivity timeout of the access tokens granted to clients
+	// that do not override it. 0 means tokens do not time out from inactivity.
+	accessTokenInactivityTimeoutSeconds int32
+
+	// nativeClients are the names of the clients of native applications, which may be redirected to any port of
+	// their loopback redirect URIs and must use PKCE
+	nativeClients sets.String
 }
 
-func New(access oauthaccesstoken.Registry, authorize oauthauthorizetoken.Registry, client oauthclient.Registry, user UserConversion) osin.Storage {
+func New(access oauthaccesstoken.Registry, authorize oauthauthorizetoken.Registry, client oauthclient.Registry, user UserConversion, accessTokenInactivityTimeoutSeconds int32, nativeClients sets.String) osin.Storage {
 	return &storage{
 		accesstoken:    access,
 		authorizetoken: authorize,
 		client:         client,
 		user:           user,
+
+		accessTokenInactivityTimeoutSeconds: accessTokenInactivityTimeoutSeconds,
+		nativeClients:                       nativeClients,
 	}
 }
 
 type clientWrapper struct {
 	id     string
 	client *api.OAuthClient
+	native bool
 }
 
 func (w *clientWrapper) GetId() string {
@@ -63,6 +76,10 @@ func (w *clientWrapper) GetUserData() interface{} {
 	return w.client
 }
 
+func (w *clientWrapper) IsNative() bool {
+	return w.native
+}
+
 // Clone the storage if needed. For example, using mgo, you can clone the session with session.Clone
 // to avoid concurrent access problems.
 // This is to avoid cloning the connection at each method access.
@@ -84,7 +101,7 @@ func (s *storage) GetClient(id string) (osin.Client, error) {
 		}
 		return nil, err
 	}
-	return &clientWrapper{id, c}, nil
+	return s.wrapClient(id, c), nil
 }
 
 // SaveAuthorize saves authorize data.
@@ -117,6 +134,11 @@ func (s *storage) RemoveAuthorize(code string) error {
 // SaveAccess writes AccessData.
 // If RefreshToken is not blank, it must save in a way that can be loaded using LoadRefresh.
 func (s *storage) SaveAccess(data *osin.AccessData) error {
+	// The client can override the max age of its tokens, which is also returned to it as expires_in.
+	// Tokens of clients whose tokens do not expire are stored with an ExpiresIn of api.AccessTokenNoExpiry.
+	if client, ok := data.Client.GetUserData().(*api.OAuthClient); ok && client.AccessTokenMaxAgeSeconds != nil {
+		data.ExpiresIn = *client.AccessTokenMaxAgeSeconds
+	}
 	token, err := s.convertToAccessToken(data)
 	if err != nil {
 		return err
@@ -165,6 +187,9 @@ func (s *storage) convertToAuthorizeToken(data *osin.AuthorizeData) (*api.OAuthA
 		Scopes:      scope.Split(data.Scope),
 		RedirectURI: data.RedirectUri,
 		State:       data.State,
+
+		CodeChallenge:       data.CodeChallenge,
+		CodeChallengeMethod: data.CodeChallengeMethod,
 	}
 	if err := s.user.ConvertToAuthorizeToken(data.UserData, token); err != nil {
 		return nil, err
@@ -184,13 +209,16 @@ func (s *storage) convertFromAuthorizeToken(authorize *api.OAuthAuthorizeToken)
 
 	return &osin.AuthorizeData{
 		Code:        authorize.Name,
-		Client:      &clientWrapper{authorize.ClientName, client},
+		Client:      s.wrapClient(authorize.ClientName, client),
 		ExpiresIn:   int32(authorize.ExpiresIn),
 		Scope:       scope.Join(authorize.Scopes),
 		RedirectUri: authorize.RedirectURI,
 		State:       authorize.State,
 		CreatedAt:   authorize.CreationTimestamp.Time,
 		UserData:    user,
+
+		CodeChallenge:       authorize.CodeChallenge,
+		CodeChallengeMethod: authorize.CodeChallengeMethod,
 	}, nil
 }
 
@@ -205,6 +233,11 @@ func (s *storage) convertToAccessToken(data *osin.AccessData) (*api.OAuthAccessT
 		ClientName:   data.Client.GetId(),
 		Scopes:       scope.Split(data.Scope),
 		RedirectURI:  data.RedirectUri,
+
+		InactivityTimeoutSeconds: s.accessTokenInactivityTimeoutSeconds,
+	}
+	if client, ok := data.Client.GetUserData().(*api.OAuthClient); ok && client.AccessTokenInactivityTimeoutSeconds != nil {
+		token.InactivityTimeoutSeconds = *client.AccessTokenInactivityTimeoutSeconds
 	}
 	if data.AuthorizeData != nil {
 		token.AuthorizeToken = data.AuthorizeData.Code
@@ -228,7 +261,7 @@ func (s *storage) convertFromAccessToken(access *api.OAuthAccessToken) (*osin.Ac
 	return &osin.AccessData{
 		AccessToken:  access.Name,
 		RefreshToken: access.RefreshToken,
-		Client:       &clientWrapper{access.ClientName, client},
+		Client:       s.wrapClient(access.ClientName, client),
 		ExpiresIn:    int32(access.ExpiresIn),
 		Scope:        scope.Join(access.Scopes),
 		RedirectUri:  access.RedirectURI,
@@ -236,3 +269,7 @@ func (s *storage) convertFromAccessToken(access *api.OAuthAccessToken) (*osin.Ac
 		UserData:     user,
 	}, nil
 }
+
+func (s *storage) wrapClient(id string, client *api.OAuthClient) *clientWrapper {
+	return &clientWrapper{id: id, client: client, native: s.nativeClients.Has(id)}
+}
@@ -27,6 +27,8 @@ const (
 func NewImageStreamEvaluator(osClient osclient.Interface) kquota.Evaluator {
 	computeResources := []kapi.ResourceName{
 		imageapi.ResourceImages,
+		imageapi.ResourceImageStreams,
+		imageapi.ResourceImagesSize,
 	}
 
 	matchesScopeFunc := func(kapi.ResourceQuotaScope, runtime.Object) bool { return true }
@@ -97,9 +99,11 @@ func (c *imageStreamUsageComputer) Usage(object runtime.Object) kapi.ResourceLis
 		return kapi.ResourceList{}
 	}
 
-	images := c.GetImageStreamUsage(is, c.processedImages)
+	images, size := c.GetImageStreamUsage(is, c.processedImages)
 	return kapi.ResourceList{
-		imageapi.ResourceImages: *images,
+		imageapi.ResourceImages:       *images,
+		imageapi.ResourceImageStreams: *resource.NewQuantity(1, resource.DecimalSI),
+		imageapi.ResourceImagesSize:   *size,
 	}
 }
 
@@ -125,13 +129,17 @@ func (c *imageStreamUsageComputerForAdmission) Usage(object runtime.Object) kapi
 		return kapi.ResourceList{}
 	}
 
-	_, imagesIncrement, err := c.GetProjectImagesUsageIncrement(is.Namespace, is, nil)
+	_, imagesIncrement, sizeIncrement, err := c.GetProjectImagesUsageIncrement(is.Namespace, is, nil)
 	if err != nil {
 		glog.Errorf("Failed to compute project images size increment in namespace %q: %v", is.Namespace, err)
 		return kapi.ResourceList{}
 	}
 
+	// the image stream itself is counted for both the new and the old object during an update, so only
+	// its creation changes the usage
 	return map[kapi.ResourceName]resource.Quantity{
-		imageapi.ResourceImages: *imagesIncrement,
+		imageapi.ResourceImages:       *imagesIncrement,
+		imageapi.ResourceImageStreams: *resource.NewQuantity(1, resource.DecimalSI),
+		imageapi.ResourceImagesSize:   *sizeIncrement,
 	}
 }
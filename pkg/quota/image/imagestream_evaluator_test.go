@@ -18,6 +18,20 @@ import (
 var (
 	expectedResources = []kapi.ResourceName{
 		imageapi.ResourceImages,
+		imageapi.ResourceImagesSize,
+	}
+	expectedImageStreamResources = []kapi.ResourceName{
+		imageapi.ResourceImages,
+		imageapi.ResourceImageStreams,
+		imageapi.ResourceImagesSize,
+	}
+	// imageSizes holds the total size of layers of each test image
+	imageSizes = map[string]int64{
+		baseImageWith1LayerDigest:   128,
+		baseImageWith2LayersDigest:  240,
+		childImageWith2LayersDigest: 254,
+		childImageWith3LayersDigest: 310,
+		miscImageDigest:             554,
 	}
 )
 
@@ -200,23 +214,24 @@ func TestImageStreamEvaluatorUsage(t *testing.T) {
 		}
 		usage := evaluator.Usage(is)
 
-		if len(usage) != len(expectedResources) {
-			t.Errorf("[%s]: got unexpected number of computed resources: %d != %d", tc.name, len(usage), len(expectedResources))
+		if len(usage) != len(expectedImageStreamResources) {
+			t.Errorf("[%s]: got unexpected number of computed resources: %d != %d", tc.name, len(usage), len(expectedImageStreamResources))
 		}
 
-		masked := kquota.Mask(usage, expectedResources)
+		masked := kquota.Mask(usage, expectedImageStreamResources)
 		expectedUsage := kapi.ResourceList{
-			imageapi.ResourceImages: *resource.NewQuantity(tc.expectedImages, resource.DecimalSI),
+			imageapi.ResourceImages:       *resource.NewQuantity(tc.expectedImages, resource.DecimalSI),
+			imageapi.ResourceImageStreams: *resource.NewQuantity(1, resource.DecimalSI),
 		}
 
-		if len(masked) != len(expectedResources) {
+		if len(masked) != len(expectedImageStreamResources) {
 			for k := range usage {
 				if _, exists := masked[k]; !exists {
 					t.Errorf("[%s]: got unexpected resource %q from Usage() method", tc.name, k)
 				}
 			}
 
-			for _, k := range expectedResources {
+			for _, k := range expectedImageStreamResources {
 				if _, exists := masked[k]; !exists {
 					t.Errorf("[%s]: expected resource %q not computed", tc.name, k)
 				}
@@ -233,6 +248,22 @@ func TestImageStreamEvaluatorUsage(t *testing.T) {
 	}
 }
 
+func TestImageStreamEvaluatorUsageSize(t *testing.T) {
+	is := getSharedImageStream("test", "is")
+
+	fakeClient := &testclient.Fake{}
+	fakeClient.AddReactor("get", "imagestreamimages", getFakeImageStreamImageGetHandler(t, *is))
+
+	evaluator := NewImageStreamEvaluator(fakeClient)
+	usage := evaluator.Usage(is)
+
+	// all the images of the shared image stream are counted once
+	expectedSize := resource.NewQuantity(128+240+254+310+554, resource.BinarySI)
+	if size, ok := usage[imageapi.ResourceImagesSize]; !ok || size.Cmp(*expectedSize) != 0 {
+		t.Errorf("got unexpected size usage: %s != %s", size.String(), expectedSize.String())
+	}
+}
+
 func TestImageStreamEvaluatorUsageStats(t *testing.T) {
 	for _, tc := range []struct {
 		name           string
@@ -455,23 +486,23 @@ func TestImageStreamEvaluatorUsageStats(t *testing.T) {
 			continue
 		}
 
-		if len(stats.Used) != len(expectedResources) {
-			t.Errorf("[%s]: got unexpected number of computed resources: %d != %d", tc.name, len(stats.Used), len(expectedResources))
+		if len(stats.Used) != len(expectedImageStreamResources) {
+			t.Errorf("[%s]: got unexpected number of computed resources: %d != %d", tc.name, len(stats.Used), len(expectedImageStreamResources))
 		}
 
-		masked := kquota.Mask(stats.Used, expectedResources)
+		masked := kquota.Mask(stats.Used, expectedImageStreamResources)
 		expectedUsage := kapi.ResourceList{
 			imageapi.ResourceImages: *resource.NewQuantity(tc.expectedImages, resource.DecimalSI),
 		}
 
-		if len(masked) != len(expectedResources) {
+		if len(masked) != len(expectedImageStreamResources) {
 			for k := range stats.Used {
 				if _, exists := masked[k]; !exists {
 					t.Errorf("[%s]: got unexpected resource %q from Usage() method", tc.name, k)
 				}
 			}
 
-			for _, k := range expectedResources {
+			for _, k := range expectedImageStreamResources {
 				if _, exists := masked[k]; !exists {
 					t.Errorf("[%s]: expected resource %q not computed", tc.name, k)
 				}
@@ -859,15 +890,16 @@ func TestImageStreamAdmissionEvaluatorUsage(t *testing.T) {
 
 		usage := evaluator.Usage(newIS)
 
-		if len(usage) != len(expectedResources) {
-			t.Errorf("[%s]: got unexpected number of computed resources: %d != %d", tc.name, len(usage), len(expectedResources))
+		if len(usage) != len(expectedImageStreamResources) {
+			t.Errorf("[%s]: got unexpected number of computed resources: %d != %d", tc.name, len(usage), len(expectedImageStreamResources))
 		}
 
 		expectedUsage := kapi.ResourceList{
-			imageapi.ResourceImages: *resource.NewQuantity(tc.expectedImages, resource.DecimalSI),
+			imageapi.ResourceImages:       *resource.NewQuantity(tc.expectedImages, resource.DecimalSI),
+			imageapi.ResourceImageStreams: *resource.NewQuantity(1, resource.DecimalSI),
 		}
 
-		masked := kquota.Mask(usage, expectedResources)
+		masked := kquota.Mask(usage, expectedImageStreamResources)
 		if len(masked) != len(expectedUsage) {
 			for k := range usage {
 				if _, exists := masked[k]; !exists {
@@ -994,6 +1026,7 @@ func getFakeImageStreamImageGetHandler(t *testing.T, iss ...imageapi.ImageStream
 							Annotations: map[string]string{imageapi.ManagedByOpenShiftAnnotation: "true"},
 						},
 						DockerImageReference: fmt.Sprintf("registry.example.org/%s/%s", a.GetNamespace(), a.GetName()),
+						DockerImageMetadata:  imageapi.DockerImage{Size: imageSizes[name]},
 					},
 				}
 
@@ -1038,6 +1071,7 @@ func getFakeImageGetHandler(t *testing.T, namespace string) ktestclient.Reaction
 					Annotations: map[string]string{imageapi.ManagedByOpenShiftAnnotation: "true"},
 				},
 				DockerImageReference: fmt.Sprintf("registry.example.org/%s/%s", namespace, a.GetName()),
+				DockerImageMetadata:  imageapi.DockerImage{Size: imageSizes[name]},
 			}
 
 			switch name {
@@ -24,6 +24,7 @@ const imageStreamMappingName = "Evaluator.ImageStreamMapping"
 func NewImageStreamMappingEvaluator(osClient osclient.Interface) kquota.Evaluator {
 	computeResources := []kapi.ResourceName{
 		imageapi.ResourceImages,
+		imageapi.ResourceImagesSize,
 	}
 
 	matchesScopeFunc := func(kapi.ResourceQuotaScope, runtime.Object) bool { return true }
@@ -69,13 +70,14 @@ func (c *imageStreamMappingUsageComputer) Usage(object runtime.Object) kapi.Reso
 		return kapi.ResourceList{}
 	}
 
-	_, imagesIncrement, err := c.GetProjectImagesUsageIncrement(ism.Namespace, nil, &ism.Image)
+	_, imagesIncrement, sizeIncrement, err := c.GetProjectImagesUsageIncrement(ism.Namespace, nil, &ism.Image)
 	if err != nil {
 		glog.Errorf("Failed to get project images size increment of %q caused by an image %q: %v", ism.Namespace, ism.Image.Name, err)
 		return map[kapi.ResourceName]resource.Quantity{}
 	}
 
 	return map[kapi.ResourceName]resource.Quantity{
-		imageapi.ResourceImages: *imagesIncrement,
+		imageapi.ResourceImages:     *imagesIncrement,
+		imageapi.ResourceImagesSize: *sizeIncrement,
 	}
 }
@@ -25,6 +25,7 @@ const imageStreamTagEvaluatorName = "Evaluator.ImageStreamTag"
 func NewImageStreamTagEvaluator(osClient osclient.Interface) kquota.Evaluator {
 	computeResources := []kapi.ResourceName{
 		imageapi.ResourceImages,
+		imageapi.ResourceImagesSize,
 	}
 
 	matchesScopeFunc := func(kapi.ResourceQuotaScope, runtime.Object) bool { return true }
@@ -92,7 +93,8 @@ func (c *imageStreamTagUsageComputer) Usage(object runtime.Object) kapi.Resource
 	}
 
 	res := map[kapi.ResourceName]resource.Quantity{
-		imageapi.ResourceImages: *resource.NewQuantity(0, resource.BinarySI),
+		imageapi.ResourceImages:     *resource.NewQuantity(0, resource.BinarySI),
+		imageapi.ResourceImagesSize: *resource.NewQuantity(0, resource.BinarySI),
 	}
 
 	if ist.Tag == nil {
@@ -123,13 +125,14 @@ func (c *imageStreamTagUsageComputer) Usage(object runtime.Object) kapi.Resource
 		return res
 	}
 
-	_, imagesIncrement, err := c.GetProjectImagesUsageIncrement(ist.Namespace, nil, img)
+	_, imagesIncrement, sizeIncrement, err := c.GetProjectImagesUsageIncrement(ist.Namespace, nil, img)
 	if err != nil {
 		glog.Errorf("Failed to get namespace size increment of %q with an image %q: %v", ist.Namespace, img.Name, err)
 		return res
 	}
 
 	res[imageapi.ResourceImages] = *imagesIncrement
+	res[imageapi.ResourceImagesSize] = *sizeIncrement
 
 	return res
 }
@@ -328,7 +328,7 @@ func TestImageStreamTagEvaluatorUsage(t *testing.T) {
 			imageapi.ResourceImages: *resource.NewQuantity(tc.expectedImages, resource.DecimalSI),
 		}
 
-		if len(usage) != len(expectedUsage) {
+		if len(usage) != len(expectedResources) {
 			t.Errorf("[%s]: got unexpected number of computed resources: %d != %d", tc.name, len(usage), len(expectedResources))
 		}
 
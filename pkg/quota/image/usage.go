@@ -48,13 +48,15 @@ func NewGenericImageStreamUsageComputer(osClient osclient.Interface, processSpec
 	}
 }
 
-// GetImageStreamUsage counts number of unique internally managed images occupying given image stream. Each
-// Images given in processedImages won't be taken into account. The set will be updated with new images found.
+// GetImageStreamUsage counts number of unique internally managed images occupying given image stream and
+// their total size. Each Images given in processedImages won't be taken into account. The set will be updated
+// with new images found.
 func (c *GenericImageStreamUsageComputer) GetImageStreamUsage(
 	is *imageapi.ImageStream,
 	processedImages sets.String,
-) *resource.Quantity {
-	images := resource.NewQuantity(0, resource.DecimalSI)
+) (images, size *resource.Quantity) {
+	images = resource.NewQuantity(0, resource.DecimalSI)
+	size = resource.NewQuantity(0, resource.BinarySI)
 
 	c.processImageStreamImages(is, func(_, _ string, img *imageapi.Image) error {
 		if processedImages.Has(img.Name) {
@@ -62,10 +64,11 @@ func (c *GenericImageStreamUsageComputer) GetImageStreamUsage(
 		}
 		processedImages.Insert(img.Name)
 		images.Set(images.Value() + 1)
+		size.Set(size.Value() + img.DockerImageMetadata.Size)
 		return nil
 	})
 
-	return images
+	return images, size
 }
 
 // GetProjectImagesUsage returns a number of internally managed images tagged in the given namespace.
@@ -98,12 +101,13 @@ func (c *GenericImageStreamUsageComputer) GetProjectImagesUsage(namespace string
 //  1. number of images currently tagged in the namespace; the image and images tagged in the given is don't
 //     count unless they are tagged in other is as well
 //  2. number of new internally managed images referenced either by the is or by the image
-//  3. an error if something goes wrong
+//  3. total size of the new internally managed images
+//  4. an error if something goes wrong
 func (c *GenericImageStreamUsageComputer) GetProjectImagesUsageIncrement(
 	namespace string,
 	is *imageapi.ImageStream,
 	image *imageapi.Image,
-) (images, imagesIncrement *resource.Quantity, err error) {
+) (images, imagesIncrement, sizeIncrement *resource.Quantity, err error) {
 	processedImages := sets.NewString()
 
 	iss, err := c.listImageStreams(namespace)
@@ -112,6 +116,7 @@ func (c *GenericImageStreamUsageComputer) GetProjectImagesUsageIncrement(
 	}
 
 	imagesIncrement = resource.NewQuantity(0, resource.DecimalSI)
+	sizeIncrement = resource.NewQuantity(0, resource.BinarySI)
 
 	for _, imageStream := range iss.Items {
 		if is != nil && imageStream.Name == is.Name {
@@ -128,6 +133,7 @@ func (c *GenericImageStreamUsageComputer) GetProjectImagesUsageIncrement(
 			if !processedImages.Has(img.Name) {
 				processedImages.Insert(img.Name)
 				imagesIncrement.Set(imagesIncrement.Value() + 1)
+				sizeIncrement.Set(sizeIncrement.Value() + img.DockerImageMetadata.Size)
 			}
 			return nil
 		})
@@ -137,6 +143,7 @@ func (c *GenericImageStreamUsageComputer) GetProjectImagesUsageIncrement(
 		if value, ok := image.Annotations[imageapi.ManagedByOpenShiftAnnotation]; ok && value == "true" {
 			if !processedImages.Has(image.Name) {
 				imagesIncrement.Set(imagesIncrement.Value() + 1)
+				sizeIncrement.Set(sizeIncrement.Value() + image.DockerImageMetadata.Size)
 			}
 		}
 	}
@@ -15,3 +15,19 @@ func IngressConditionStatus(ingress *RouteIngress, t RouteIngressConditionType)
 	}
 	return kapi.ConditionUnknown, RouteIngressCondition{}
 }
+
+// DefaultBackendWeight is the weight of a route backend which does not specify one.
+const DefaultBackendWeight = 100
+
+// BackendWeight returns the weight of the route backend ref.
+func BackendWeight(ref *RouteTargetReference) int32 {
+	if ref.Weight == nil {
+		return DefaultBackendWeight
+	}
+	return *ref.Weight
+}
+
+// RouteBackends returns the primary and alternate backends of route.
+func RouteBackends(route *Route) []RouteTargetReference {
+	return append([]RouteTargetReference{route.Spec.To}, route.Spec.AlternateBackends...)
+}
@@ -26,18 +26,41 @@ type RouteSpec struct {
 	Path string
 
 	// An object the route points to. Only the Service kind is allowed, and it will
-	// be defaulted to Service.
-	To kapi.ObjectReference
+	// be defaulted to Service. If the route has alternate backends, the weight of this
+	// backend is used to share requests between them.
+	To RouteTargetReference
+
+	// AlternateBackends are additional services which receive a share of the requests
+	// to the route, in proportion to their weight. At most 3 alternate backends may be
+	// specified.
+	AlternateBackends []RouteTargetReference
 
 	// If specified, the port to be used by the router. Most routers will use all
 	// endpoints exposed by the service by default - set this value to instruct routers
 	// which port to use.
 	Port *RoutePort
 
+	// HealthCheck configures how the router checks the health of the endpoints of the
+	// route. If not specified, endpoints are checked by opening a TCP connection to them.
+	HealthCheck *RouteHealthCheck
+
 	//TLS provides the ability to configure certificates and termination for the route
 	TLS *TLSConfig
 }
 
+// RouteTargetReference specifies the target that resolves into endpoints. Only the 'Service'
+// kind is allowed.
+type RouteTargetReference struct {
+	// Kind of the referent. Only Service is allowed, and it will be defaulted to Service.
+	Kind string
+	// Name of the service the route points to.
+	Name string
+	// Weight is the relative share of the requests to the route sent to this backend,
+	// between 0 and 256. A backend with a weight of 0 receives no requests. If not
+	// specified, a weight of 100 is assumed.
+	Weight *int32
+}
+
 // RoutePort defines a port mapping from a router to an endpoint in the service endpoints.
 type RoutePort struct {
 	// The target port on pods selected by the service this route points to.
@@ -46,6 +69,18 @@ type RoutePort struct {
 	TargetPort intstr.IntOrString
 }
 
+// RouteHealthCheck defines the health check the router performs against each endpoint of a route.
+type RouteHealthCheck struct {
+	// Path is requested from each endpoint with HTTP GET, and the endpoint is considered
+	// healthy when it answers with a 2xx or 3xx status. For passthrough routes the request
+	// is sent over TLS. If empty, endpoints are checked by opening a TCP connection to them.
+	// The path may contain letters, digits and the characters -._~%!$&*+,;=:@/?.
+	Path string
+	// IntervalSeconds is the number of seconds between two checks of an endpoint. Defaults
+	// to 5 seconds, and may not exceed 300 seconds.
+	IntervalSeconds int32
+}
+
 // RouteStatus provides relevant info about the status of a route, including which routers
 // acknowledge it.
 type RouteStatus struct {
@@ -130,7 +165,10 @@ type TLSConfig struct {
 	CACertificate string
 
 	// DestinationCACertificate provides the contents of the ca certificate of the final destination.  When using reencrypt
-	// termination this file should be provided in order to have routers use it for health checks on the secure connection
+	// termination this file should be provided in order to have routers use it for health checks on the secure connection.
+	// If this field is not specified, the router may provide its own destination CA and perform hostname validation using
+	// the short service name (service.namespace.svc), which allows infrastructure generated certificates to automatically
+	// verify.
 	DestinationCACertificate string
 
 	// InsecureEdgeTerminationPolicy indicates the desired behavior for
@@ -13,6 +13,11 @@ func addConversionFuncs(scheme *runtime.Scheme) {
 			if len(obj.To.Kind) == 0 {
 				obj.To.Kind = "Service"
 			}
+			for i := range obj.AlternateBackends {
+				if len(obj.AlternateBackends[i].Kind) == 0 {
+					obj.AlternateBackends[i].Kind = "Service"
+				}
+			}
 		},
 		func(obj *TLSConfig) {
 			if len(obj.Termination) == 0 && len(obj.DestinationCACertificate) == 0 {
@@ -16,6 +16,16 @@ func (Route) SwaggerDoc() map[string]string {
 	return map_Route
 }
 
+var map_RouteHealthCheck = map[string]string{
+	"":                "RouteHealthCheck defines the health check the router performs against each endpoint of a route.",
+	"path":            "Path is requested from each endpoint with HTTP GET, and the endpoint is considered healthy when it answers with a 2xx or 3xx status. For passthrough routes the request is sent over TLS. If empty, endpoints are checked by opening a TCP connection to them. The path may contain letters, digits and the characters -._~%!$&*+,;=:@/?.",
+	"intervalSeconds": "IntervalSeconds is the number of seconds between two checks of an endpoint. Defaults to 5 seconds, and may not exceed 300 seconds.",
+}
+
+func (RouteHealthCheck) SwaggerDoc() map[string]string {
+	return map_RouteHealthCheck
+}
+
 var map_RouteIngress = map[string]string{
 	"":           "RouteIngress holds information about the places where a route is exposed",
 	"host":       "Host is the host string under which the route is exposed; this value is required",
@@ -60,12 +70,14 @@ func (RoutePort) SwaggerDoc() map[string]string {
 }
 
 var map_RouteSpec = map[string]string{
-	"":     "RouteSpec describes the route the user wishes to exist.",
-	"host": "Host is an alias/DNS that points to the service. Optional Must follow DNS952 subdomain conventions.",
-	"path": "Path that the router watches for, to route traffic for to the service. Optional",
-	"to":   "To is an object the route points to. Only the Service kind is allowed, and it will be defaulted to Service.",
-	"port": "If specified, the port to be used by the router. Most routers will use all endpoints exposed by the service by default - set this value to instruct routers which port to use.",
-	"tls":  "TLS provides the ability to configure certificates and termination for the route",
+	"":                  "RouteSpec describes the route the user wishes to exist.",
+	"host":              "Host is an alias/DNS that points to the service. Optional Must follow DNS952 subdomain conventions.",
+	"path":              "Path that the router watches for, to route traffic for to the service. Optional",
+	"to":                "To is an object the route points to. Only the Service kind is allowed, and it will be defaulted to Service. If the route has alternate backends, the weight of this backend is used to share requests between them.",
+	"alternateBackends": "AlternateBackends are additional services which receive a share of the requests to the route, in proportion to their weight. At most 3 alternate backends may be specified.",
+	"port":              "If specified, the port to be used by the router. Most routers will use all endpoints exposed by the service by default - set this value to instruct routers which port to use.",
+	"healthCheck":       "HealthCheck configures how the router checks the health of the endpoints of the route. If not specified, endpoints are checked by opening a TCP connection to them.",
+	"tls":               "TLS provides the ability to configure certificates and termination for the route",
 }
 
 func (RouteSpec) SwaggerDoc() map[string]string {
@@ -81,6 +93,17 @@ func (RouteStatus) SwaggerDoc() map[string]string {
 	return map_RouteStatus
 }
 
+var map_RouteTargetReference = map[string]string{
+	"":       "RouteTargetReference specifies the target that resolves into endpoints. Only the 'Service' kind is allowed.",
+	"kind":   "Kind of the referent. Only Service is allowed, and it will be defaulted to Service.",
+	"name":   "Name of the service the route points to.",
+	"weight": "Weight is the relative share of the requests to the route sent to this backend, between 0 and 256. A backend with a weight of 0 receives no requests. If not specified, a weight of 100 is assumed.",
+}
+
+func (RouteTargetReference) SwaggerDoc() map[string]string {
+	return map_RouteTargetReference
+}
+
 var map_RouterShard = map[string]string{
 	"":          "RouterShard has information of a routing shard and is used to generate host names and routing table entries when a routing shard is allocated for a specific route. Caveat: This is WIP and will likely undergo modifications when sharding\n        support is added.",
 	"shardName": "ShardName uniquely identifies a router shard in the \"set\" of routers used for routing traffic to the services.",
@@ -97,7 +120,7 @@ var map_TLSConfig = map[string]string{
 	"certificate":                   "Certificate provides certificate contents",
 	"key":                           "Key provides key file contents",
 	"caCertificate":                 "CACertificate provides the cert authority certificate contents",
-	"destinationCACertificate":      "DestinationCACertificate provides the contents of the ca certificate of the final destination.  When using reencrypt termination this file should be provided in order to have routers use it for health checks on the secure connection",
+	"destinationCACertificate":      "DestinationCACertificate provides the contents of the ca certificate of the final destination.  When using reencrypt termination this file should be provided in order to have routers use it for health checks on the secure connection. If this field is not specified, the router may provide its own destination CA and perform hostname validation using the short service name (service.namespace.svc), which allows infrastructure generated certificates to automatically verify.",
 	"insecureEdgeTerminationPolicy": "InsecureEdgeTerminationPolicy indicates the desired behavior for insecure connections to an edge-terminated route:\n  disable, allow or redirect",
 }
 
@@ -2,11 +2,13 @@ package validation
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"k8s.io/kubernetes/pkg/api/validation"
 	kval "k8s.io/kubernetes/pkg/api/validation"
 	"k8s.io/kubernetes/pkg/util/intstr"
+	"k8s.io/kubernetes/pkg/util/sets"
 	kvalidation "k8s.io/kubernetes/pkg/util/validation"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
@@ -37,11 +39,20 @@ func ValidateRoute(route *routeapi.Route) field.ErrorList {
 		result = append(result, field.Invalid(specPath.Child("path"), route.Spec.Path, "passthrough termination does not support paths"))
 	}
 
-	if len(route.Spec.To.Name) == 0 {
-		result = append(result, field.Required(specPath.Child("to", "name"), ""))
+	result = append(result, validateBackend(&route.Spec.To, specPath.Child("to"))...)
+
+	if len(route.Spec.AlternateBackends) > maxAlternateBackends {
+		result = append(result, field.Invalid(specPath.Child("alternateBackends"), len(route.Spec.AlternateBackends), fmt.Sprintf("may not have more than %d alternate backends", maxAlternateBackends)))
 	}
-	if route.Spec.To.Kind != "Service" {
-		result = append(result, field.Invalid(specPath.Child("to", "kind"), route.Spec.To.Kind, "must reference a Service"))
+	backends := sets.NewString(route.Spec.To.Name)
+	for i := range route.Spec.AlternateBackends {
+		backend := &route.Spec.AlternateBackends[i]
+		backendPath := specPath.Child("alternateBackends").Index(i)
+		result = append(result, validateBackend(backend, backendPath)...)
+		if backends.Has(backend.Name) {
+			result = append(result, field.Duplicate(backendPath.Child("name"), backend.Name))
+		}
+		backends.Insert(backend.Name)
 	}
 
 	if route.Spec.Port != nil {
@@ -52,6 +63,10 @@ func ValidateRoute(route *routeapi.Route) field.ErrorList {
 		}
 	}
 
+	if route.Spec.HealthCheck != nil {
+		result = append(result, validateHealthCheck(route.Spec.HealthCheck, specPath.Child("healthCheck"))...)
+	}
+
 	if errs := validateTLS(route, specPath.Child("tls")); len(errs) != 0 {
 		result = append(result, errs...)
 	}
@@ -59,6 +74,51 @@ func ValidateRoute(route *routeapi.Route) field.ErrorList {
 	return result
 }
 
+// maxHealthCheckIntervalSeconds is the longest interval between two health
+// checks of an endpoint.
+const maxHealthCheckIntervalSeconds = 300
+
+// healthCheckPathPattern matches the paths that may be requested by health
+// checks. The path is written verbatim into the router configuration, so it is
+// limited to a subset of the URI characters without quotes, escapes or comments.
+var healthCheckPathPattern = regexp.MustCompile(`^/[A-Za-z0-9\-._~%!$&*+,;=:@/?]*$`)
+
+// validateHealthCheck tests that the health check path is an absolute path
+// made of safe URI characters and that the interval is within bounds.
+func validateHealthCheck(check *routeapi.RouteHealthCheck, fldPath *field.Path) field.ErrorList {
+	result := field.ErrorList{}
+	if len(check.Path) > 0 {
+		if !strings.HasPrefix(check.Path, "/") {
+			result = append(result, field.Invalid(fldPath.Child("path"), check.Path, "path must begin with /"))
+		} else if !healthCheckPathPattern.MatchString(check.Path) {
+			result = append(result, field.Invalid(fldPath.Child("path"), check.Path, "path may only contain letters, digits and the characters -._~%!$&*+,;=:@/?"))
+		}
+	}
+	if check.IntervalSeconds < 0 || check.IntervalSeconds > maxHealthCheckIntervalSeconds {
+		result = append(result, field.Invalid(fldPath.Child("intervalSeconds"), check.IntervalSeconds, fmt.Sprintf("must be between 0 and %d", maxHealthCheckIntervalSeconds)))
+	}
+	return result
+}
+
+// maxAlternateBackends is the number of alternate backends a route may have.
+const maxAlternateBackends = 3
+
+// validateBackend tests that a route backend references a service and has a
+// valid weight.
+func validateBackend(backend *routeapi.RouteTargetReference, fldPath *field.Path) field.ErrorList {
+	result := field.ErrorList{}
+	if len(backend.Name) == 0 {
+		result = append(result, field.Required(fldPath.Child("name"), ""))
+	}
+	if backend.Kind != "Service" {
+		result = append(result, field.Invalid(fldPath.Child("kind"), backend.Kind, "must reference a Service"))
+	}
+	if backend.Weight != nil && (*backend.Weight < 0 || *backend.Weight > 256) {
+		result = append(result, field.Invalid(fldPath.Child("weight"), *backend.Weight, "weight must be between 0 and 256"))
+	}
+	return result
+}
+
 func ValidateRouteUpdate(route *routeapi.Route, older *routeapi.Route) field.ErrorList {
 	allErrs := validation.ValidateObjectMetaUpdate(&route.ObjectMeta, &older.ObjectMeta, field.NewPath("metadata"))
 	allErrs = append(allErrs, ValidateRoute(route)...)
@@ -88,12 +148,9 @@ func validateTLS(route *routeapi.Route, fldPath *field.Path) field.ErrorList {
 	}
 
 	switch tls.Termination {
-	// reencrypt must specify destination ca cert
+	// reencrypt may specify destination ca cert
 	// cert, key, cacert may not be specified because the route may be a wildcard
 	case routeapi.TLSTerminationReencrypt:
-		if len(tls.DestinationCACertificate) == 0 {
-			result = append(result, field.Required(fldPath.Child("destinationCACertificate"), ""))
-		}
 	//passthrough term should not specify any cert
 	case routeapi.TLSTerminationPassthrough:
 		if len(tls.Certificate) > 0 {
@@ -25,7 +25,7 @@ func TestValidateRoute(t *testing.T) {
 				},
 				Spec: api.RouteSpec{
 					Host: "host",
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Name: "serviceName",
 						Kind: "Service",
 					},
@@ -41,7 +41,7 @@ func TestValidateRoute(t *testing.T) {
 				},
 				Spec: api.RouteSpec{
 					Host: "host",
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Name: "serviceName",
 						Kind: "Service",
 					},
@@ -57,7 +57,7 @@ func TestValidateRoute(t *testing.T) {
 					Namespace: "foo",
 				},
 				Spec: api.RouteSpec{
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Name: "serviceName",
 						Kind: "Service",
 					},
@@ -74,7 +74,7 @@ func TestValidateRoute(t *testing.T) {
 				},
 				Spec: api.RouteSpec{
 					Host: "**",
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Name: "serviceName",
 						Kind: "Service",
 					},
@@ -91,7 +91,7 @@ func TestValidateRoute(t *testing.T) {
 				},
 				Spec: api.RouteSpec{
 					Host: "host",
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Kind: "Service",
 					},
 				},
@@ -107,7 +107,7 @@ func TestValidateRoute(t *testing.T) {
 				},
 				Spec: api.RouteSpec{
 					Host: "host",
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Name: "serviceName",
 					},
 				},
@@ -123,7 +123,7 @@ func TestValidateRoute(t *testing.T) {
 				},
 				Spec: api.RouteSpec{
 					Host: "www.example.com",
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Name: "serviceName",
 						Kind: "Service",
 					},
@@ -143,7 +143,7 @@ func TestValidateRoute(t *testing.T) {
 				},
 				Spec: api.RouteSpec{
 					Host: "www.example.com",
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Name: "serviceName",
 						Kind: "Service",
 					},
@@ -163,7 +163,7 @@ func TestValidateRoute(t *testing.T) {
 				},
 				Spec: api.RouteSpec{
 					Host: "www.example.com",
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Name: "serviceName",
 						Kind: "Service",
 					},
@@ -180,7 +180,7 @@ func TestValidateRoute(t *testing.T) {
 				},
 				Spec: api.RouteSpec{
 					Host: "www.example.com",
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Name: "serviceName",
 						Kind: "Service",
 					},
@@ -198,7 +198,7 @@ func TestValidateRoute(t *testing.T) {
 				},
 				Spec: api.RouteSpec{
 					Host: "www.example.com",
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Name: "serviceName",
 						Kind: "Service",
 					},
@@ -217,7 +217,7 @@ func TestValidateRoute(t *testing.T) {
 				Spec: api.RouteSpec{
 					Host: "www.example.com",
 					Path: "/test",
-					To: kapi.ObjectReference{
+					To: api.RouteTargetReference{
 						Name: "serviceName",
 						Kind: "Service",
 					},
@@ -228,6 +228,257 @@ func TestValidateRoute(t *testing.T) {
 			},
 			expectedErrors: 1,
 		},
+		{
+			name: "Valid route with alternate backends",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: api.RouteTargetReference{
+						Name:   "serviceName",
+						Kind:   "Service",
+						Weight: int32p(80),
+					},
+					AlternateBackends: []api.RouteTargetReference{
+						{Name: "other", Kind: "Service", Weight: int32p(20)},
+						{Name: "another", Kind: "Service", Weight: int32p(0)},
+					},
+				},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "Alternate backend with invalid weight",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: api.RouteTargetReference{
+						Name:   "serviceName",
+						Kind:   "Service",
+						Weight: int32p(-1),
+					},
+					AlternateBackends: []api.RouteTargetReference{
+						{Name: "other", Kind: "Service", Weight: int32p(257)},
+					},
+				},
+			},
+			expectedErrors: 2,
+		},
+		{
+			name: "Alternate backend without a service",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: api.RouteTargetReference{
+						Name: "serviceName",
+						Kind: "Service",
+					},
+					AlternateBackends: []api.RouteTargetReference{
+						{Name: ""},
+					},
+				},
+			},
+			expectedErrors: 2,
+		},
+		{
+			name: "Duplicate alternate backend",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: api.RouteTargetReference{
+						Name: "serviceName",
+						Kind: "Service",
+					},
+					AlternateBackends: []api.RouteTargetReference{
+						{Name: "other", Kind: "Service"},
+						{Name: "serviceName", Kind: "Service"},
+					},
+				},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "Too many alternate backends",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: api.RouteTargetReference{
+						Name: "serviceName",
+						Kind: "Service",
+					},
+					AlternateBackends: []api.RouteTargetReference{
+						{Name: "a", Kind: "Service"},
+						{Name: "b", Kind: "Service"},
+						{Name: "c", Kind: "Service"},
+						{Name: "d", Kind: "Service"},
+					},
+				},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "Valid health check",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: api.RouteTargetReference{
+						Name: "serviceName",
+						Kind: "Service",
+					},
+					HealthCheck: &api.RouteHealthCheck{Path: "/healthz", IntervalSeconds: 10},
+				},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "Health check path without leading slash",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: api.RouteTargetReference{
+						Name: "serviceName",
+						Kind: "Service",
+					},
+					HealthCheck: &api.RouteHealthCheck{Path: "healthz"},
+				},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "Health check path with whitespace",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: api.RouteTargetReference{
+						Name: "serviceName",
+						Kind: "Service",
+					},
+					HealthCheck: &api.RouteHealthCheck{Path: "/healthz HTTP/1.1"},
+				},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "Negative health check interval",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: api.RouteTargetReference{
+						Name: "serviceName",
+						Kind: "Service",
+					},
+					HealthCheck: &api.RouteHealthCheck{IntervalSeconds: -1},
+				},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "Health check path with a query",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: api.RouteTargetReference{
+						Name: "serviceName",
+						Kind: "Service",
+					},
+					HealthCheck: &api.RouteHealthCheck{Path: "/healthz?verbose=1&probe=router"},
+				},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "Health check path with a comment",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: api.RouteTargetReference{
+						Name: "serviceName",
+						Kind: "Service",
+					},
+					HealthCheck: &api.RouteHealthCheck{Path: "/healthz#frag"},
+				},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "Health check path with quotes",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: api.RouteTargetReference{
+						Name: "serviceName",
+						Kind: "Service",
+					},
+					HealthCheck: &api.RouteHealthCheck{Path: `/healthz"\nserver evil`},
+				},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "Health check interval above the maximum",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: api.RouteTargetReference{
+						Name: "serviceName",
+						Kind: "Service",
+					},
+					HealthCheck: &api.RouteHealthCheck{Path: "/healthz", IntervalSeconds: 301},
+				},
+			},
+			expectedErrors: 1,
+		},
 	}
 
 	for _, tc := range tests {
@@ -306,7 +557,7 @@ func TestValidateTLS(t *testing.T) {
 					},
 				},
 			},
-			expectedErrors: 1,
+			expectedErrors: 0,
 		},
 		{
 			name: "Edge termination OK with certs",
@@ -559,3 +810,7 @@ func TestValidateNoTLSInsecureEdgeTerminationPolicy(t *testing.T) {
 		}
 	}
 }
+
+func int32p(i int32) *int32 {
+	return &i
+}
@@ -0,0 +1,99 @@
+package controller
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/util/errors"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/watch"
+
+	routeapi "github.com/openshift/origin/pkg/route/api"
+	"github.com/openshift/origin/pkg/router"
+)
+
+// BackendPlugin implements the router.Plugin interface on top of a
+// router.Backend, so that load balancer integrations do not have to handle
+// watch events or namespace filtering.
+type BackendPlugin struct {
+	backend router.Backend
+
+	// routes and endpoints are the objects added to the backend, keyed by
+	// namespace/name.
+	routes    map[string]*routeapi.Route
+	endpoints map[string]*kapi.Endpoints
+}
+
+// NewBackendPlugin creates a plugin which applies the routes and endpoints it
+// is given to backend.
+func NewBackendPlugin(backend router.Backend) *BackendPlugin {
+	return &BackendPlugin{
+		backend:   backend,
+		routes:    make(map[string]*routeapi.Route),
+		endpoints: make(map[string]*kapi.Endpoints),
+	}
+}
+
+// HandleRoute adds or removes route from the backend and commits it.
+func (p *BackendPlugin) HandleRoute(eventType watch.EventType, route *routeapi.Route) error {
+	key := route.Namespace + "/" + route.Name
+	switch eventType {
+	case watch.Added, watch.Modified:
+		if err := p.backend.AddRoute(route); err != nil {
+			return err
+		}
+		p.routes[key] = route
+	case watch.Deleted:
+		if err := p.backend.RemoveRoute(route); err != nil {
+			return err
+		}
+		delete(p.routes, key)
+	}
+	return p.backend.Commit()
+}
+
+// HandleEndpoints adds or removes endpoints from the backend and commits it.
+func (p *BackendPlugin) HandleEndpoints(eventType watch.EventType, endpoints *kapi.Endpoints) error {
+	key := endpoints.Namespace + "/" + endpoints.Name
+	switch eventType {
+	case watch.Added, watch.Modified:
+		if err := p.backend.AddEndpoints(endpoints); err != nil {
+			return err
+		}
+		p.endpoints[key] = endpoints
+	case watch.Deleted:
+		if err := p.backend.RemoveEndpoints(endpoints); err != nil {
+			return err
+		}
+		delete(p.endpoints, key)
+	}
+	return p.backend.Commit()
+}
+
+// HandleNamespaces removes the routes and endpoints of the namespaces not in
+// namespaces from the backend and commits it.
+func (p *BackendPlugin) HandleNamespaces(namespaces sets.String) error {
+	errs := []error{}
+	for key, route := range p.routes {
+		if namespaces.Has(route.Namespace) {
+			continue
+		}
+		if err := p.backend.RemoveRoute(route); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		delete(p.routes, key)
+	}
+	for key, endpoints := range p.endpoints {
+		if namespaces.Has(endpoints.Namespace) {
+			continue
+		}
+		if err := p.backend.RemoveEndpoints(endpoints); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		delete(p.endpoints, key)
+	}
+	if err := p.backend.Commit(); err != nil {
+		errs = append(errs, err)
+	}
+	return kerrors.NewAggregate(errs)
+}
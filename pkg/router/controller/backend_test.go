@@ -0,0 +1,97 @@
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/watch"
+
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+// fakeBackend records the calls made to a router.Backend.
+type fakeBackend struct {
+	calls []string
+}
+
+func (b *fakeBackend) AddRoute(route *routeapi.Route) error {
+	b.calls = append(b.calls, "AddRoute "+route.Namespace+"/"+route.Name)
+	return nil
+}
+
+func (b *fakeBackend) RemoveRoute(route *routeapi.Route) error {
+	b.calls = append(b.calls, "RemoveRoute "+route.Namespace+"/"+route.Name)
+	return nil
+}
+
+func (b *fakeBackend) AddEndpoints(endpoints *kapi.Endpoints) error {
+	b.calls = append(b.calls, "AddEndpoints "+endpoints.Namespace+"/"+endpoints.Name)
+	return nil
+}
+
+func (b *fakeBackend) RemoveEndpoints(endpoints *kapi.Endpoints) error {
+	b.calls = append(b.calls, "RemoveEndpoints "+endpoints.Namespace+"/"+endpoints.Name)
+	return nil
+}
+
+func (b *fakeBackend) Commit() error {
+	b.calls = append(b.calls, "Commit")
+	return nil
+}
+
+func TestBackendPlugin(t *testing.T) {
+	route := func(namespace, name string) *routeapi.Route {
+		return &routeapi.Route{ObjectMeta: kapi.ObjectMeta{Namespace: namespace, Name: name}}
+	}
+	endpoints := func(namespace, name string) *kapi.Endpoints {
+		return &kapi.Endpoints{ObjectMeta: kapi.ObjectMeta{Namespace: namespace, Name: name}}
+	}
+
+	backend := &fakeBackend{}
+	plugin := NewBackendPlugin(backend)
+	steps := []func() error{
+		func() error { return plugin.HandleRoute(watch.Added, route("ns1", "web")) },
+		func() error { return plugin.HandleRoute(watch.Modified, route("ns1", "web")) },
+		func() error { return plugin.HandleEndpoints(watch.Added, endpoints("ns1", "web")) },
+		func() error { return plugin.HandleRoute(watch.Added, route("ns2", "db")) },
+		func() error { return plugin.HandleEndpoints(watch.Added, endpoints("ns2", "db")) },
+		func() error { return plugin.HandleRoute(watch.Deleted, route("ns1", "web")) },
+	}
+	for i, step := range steps {
+		if err := step(); err != nil {
+			t.Fatalf("%d: unexpected error: %v", i, err)
+		}
+	}
+	expected := []string{
+		"AddRoute ns1/web", "Commit",
+		"AddRoute ns1/web", "Commit",
+		"AddEndpoints ns1/web", "Commit",
+		"AddRoute ns2/db", "Commit",
+		"AddEndpoints ns2/db", "Commit",
+		"RemoveRoute ns1/web", "Commit",
+	}
+	if !reflect.DeepEqual(backend.calls, expected) {
+		t.Fatalf("expected calls %v, got %v", expected, backend.calls)
+	}
+
+	// the objects of namespaces which are no longer watched are removed
+	backend.calls = nil
+	if err := plugin.HandleNamespaces(sets.NewString("ns1")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = []string{"RemoveRoute ns2/db", "RemoveEndpoints ns2/db", "Commit"}
+	if !reflect.DeepEqual(backend.calls, expected) {
+		t.Fatalf("expected calls %v, got %v", expected, backend.calls)
+	}
+
+	backend.calls = nil
+	if err := plugin.HandleNamespaces(sets.NewString()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = []string{"RemoveEndpoints ns1/web", "Commit"}
+	if !reflect.DeepEqual(backend.calls, expected) {
+		t.Fatalf("expected calls %v, got %v", expected, backend.calls)
+	}
+}
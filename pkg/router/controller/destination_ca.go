@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"strings"
+
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/watch"
+
+	routeapi "github.com/openshift/origin/pkg/route/api"
+	"github.com/openshift/origin/pkg/router"
+)
+
+// DestinationCA implements the router.Plugin interface to reject re-encrypt routes which do
+// not provide a destination CA certificate when the router has no default destination CA to
+// verify their endpoints with. Such routes could never be served.
+type DestinationCA struct {
+	plugin       router.Plugin
+	hasDefaultCA bool
+	recorder     RejectionRecorder
+
+	// admitted holds the routes passed to plugin, keyed by route name, so that a route which
+	// becomes invalid can be removed from it.
+	admitted sets.String
+	// nil means different than empty
+	allowedNamespaces sets.String
+}
+
+// NewDestinationCA creates a plugin wrapper that rejects the re-encrypt routes without a
+// destination CA certificate unless hasDefaultCA is true. Recorder is an interface for
+// indicating why a route was rejected.
+func NewDestinationCA(plugin router.Plugin, hasDefaultCA bool, recorder RejectionRecorder) *DestinationCA {
+	return &DestinationCA{
+		plugin:       plugin,
+		hasDefaultCA: hasDefaultCA,
+		recorder:     recorder,
+		admitted:     sets.NewString(),
+	}
+}
+
+// HandleEndpoints processes watch events on the Endpoints resource.
+func (p *DestinationCA) HandleEndpoints(eventType watch.EventType, endpoints *kapi.Endpoints) error {
+	return p.plugin.HandleEndpoints(eventType, endpoints)
+}
+
+// HandleRoute processes watch events on the Route resource.
+func (p *DestinationCA) HandleRoute(eventType watch.EventType, route *routeapi.Route) error {
+	if p.allowedNamespaces != nil && !p.allowedNamespaces.Has(route.Namespace) {
+		return nil
+	}
+
+	routeName := routeNameKey(route)
+
+	if eventType == watch.Deleted {
+		if !p.admitted.Has(routeName) {
+			return nil
+		}
+		p.admitted.Delete(routeName)
+		return p.plugin.HandleRoute(eventType, route)
+	}
+
+	if !p.hasDefaultCA {
+		if tls := route.Spec.TLS; tls != nil && tls.Termination == routeapi.TLSTerminationReencrypt && len(tls.DestinationCACertificate) == 0 {
+			glog.V(4).Infof("Route %s has no destination CA certificate", routeName)
+			p.recorder.RecordRouteRejection(route, "NoDestinationCA", "the route does not specify a destination CA certificate and the router has no default destination CA")
+			if p.admitted.Has(routeName) {
+				p.admitted.Delete(routeName)
+				return p.plugin.HandleRoute(watch.Deleted, route)
+			}
+			return nil
+		}
+	}
+
+	p.admitted.Insert(routeName)
+	return p.plugin.HandleRoute(eventType, route)
+}
+
+// HandleNamespaces limits the scope of valid routes to only those that match
+// the provided namespace list.
+func (p *DestinationCA) HandleNamespaces(namespaces sets.String) error {
+	p.allowedNamespaces = namespaces
+	for _, routeName := range p.admitted.List() {
+		if namespace := strings.SplitN(routeName, "/", 2)[0]; !namespaces.Has(namespace) {
+			p.admitted.Delete(routeName)
+		}
+	}
+	return p.plugin.HandleNamespaces(namespaces)
+}
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/watch"
+
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+type fakeRejections struct {
+	rejections []string
+}
+
+func (r *fakeRejections) RecordRouteRejection(route *routeapi.Route, reason, message string) {
+	r.rejections = append(r.rejections, route.Name+":"+reason)
+}
+
+func TestDestinationCARejectsReencryptRoutesWithoutCA(t *testing.T) {
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "ns1", Name: "secure"},
+		Spec: routeapi.RouteSpec{
+			Host: "www.example.com",
+			TLS:  &routeapi.TLSConfig{Termination: routeapi.TLSTerminationReencrypt, DestinationCACertificate: "ca"},
+		},
+	}
+	withoutCA := &routeapi.Route{ObjectMeta: route.ObjectMeta, Spec: route.Spec}
+	withoutCA.Spec.TLS = &routeapi.TLSConfig{Termination: routeapi.TLSTerminationReencrypt}
+
+	p := &fakePlugin{}
+	recorder := &fakeRejections{}
+	plugin := NewDestinationCA(p, false, recorder)
+
+	if err := plugin.HandleRoute(watch.Added, route); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.t != watch.Added || p.route != route {
+		t.Fatalf("expected route to be admitted, got %s %#v", p.t, p.route)
+	}
+
+	// dropping the destination CA removes the admitted route
+	if err := plugin.HandleRoute(watch.Modified, withoutCA); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.t != watch.Deleted || p.route != withoutCA {
+		t.Fatalf("expected route to be removed, got %s %#v", p.t, p.route)
+	}
+	if len(recorder.rejections) != 1 || recorder.rejections[0] != "secure:NoDestinationCA" {
+		t.Fatalf("unexpected rejections: %v", recorder.rejections)
+	}
+
+	// deleting the rejected route is not passed on
+	p.t, p.route = "", nil
+	if err := plugin.HandleRoute(watch.Deleted, withoutCA); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.route != nil {
+		t.Fatalf("expected the deletion of a rejected route to be ignored, got %s %#v", p.t, p.route)
+	}
+
+	// a router with a default destination CA admits the route
+	plugin = NewDestinationCA(p, true, recorder)
+	if err := plugin.HandleRoute(watch.Added, withoutCA); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.t != watch.Added || p.route != withoutCA {
+		t.Fatalf("expected route to be admitted, got %s %#v", p.t, p.route)
+	}
+}
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/golang/glog"
 	kapi "k8s.io/kubernetes/pkg/api"
@@ -44,8 +45,15 @@ type UniqueHost struct {
 
 	recorder RejectionRecorder
 
+	// AllowPathSharing lets routes in the namespace owning a host claim the host with
+	// different paths. If false, a host is served by a single route.
+	AllowPathSharing bool
+
 	hostToRoute HostToRouteMap
 	routeToHost RouteToHostMap
+	// blocked holds the routes rejected because another route claims their host, keyed by
+	// route name, so they can be admitted once the host is released.
+	blocked map[string]*routeapi.Route
 	// nil means different than empty
 	allowedNamespaces sets.String
 }
@@ -60,8 +68,11 @@ func NewUniqueHost(plugin router.Plugin, fn RouteHostFunc, recorder RejectionRec
 
 		recorder: recorder,
 
+		AllowPathSharing: true,
+
 		hostToRoute: make(HostToRouteMap),
 		routeToHost: make(RouteToHostMap),
+		blocked:     make(map[string]*routeapi.Route),
 	}
 }
 
@@ -104,6 +115,10 @@ func (p *UniqueHost) HandleRoute(eventType watch.EventType, route *routeapi.Rout
 	}
 	route.Spec.Host = host
 
+	if eventType == watch.Deleted {
+		delete(p.blocked, routeName)
+	}
+
 	// ensure hosts can only be claimed by one namespace at a time
 	// TODO: this could be abstracted above this layer?
 	if old, ok := p.hostToRoute[host]; ok {
@@ -113,11 +128,12 @@ func (p *UniqueHost) HandleRoute(eventType watch.EventType, route *routeapi.Rout
 		if oldest.Namespace == route.Namespace {
 			added := false
 			for i := range old {
-				if old[i].Spec.Path == route.Spec.Path {
+				if old[i].Spec.Path == route.Spec.Path || !p.AllowPathSharing {
 					if old[i].CreationTimestamp.Before(route.CreationTimestamp) {
 						glog.V(4).Infof("Route %s cannot take %s from %s", routeName, host, routeNameKey(oldest))
 						err := fmt.Errorf("route %s already exposes %s and is older", oldest.Name, host)
 						p.recorder.RecordRouteRejection(route, "HostAlreadyClaimed", err.Error())
+						p.block(eventType, route)
 						return err
 					}
 					added = true
@@ -128,6 +144,7 @@ func (p *UniqueHost) HandleRoute(eventType watch.EventType, route *routeapi.Rout
 					glog.V(4).Infof("route %s will replace path %s from %s because it is older", routeName, route.Spec.Path, old[i].Name)
 					p.recorder.RecordRouteRejection(old[i], "HostAlreadyClaimed", fmt.Sprintf("replaced by older route %s", route.Name))
 					p.plugin.HandleRoute(watch.Deleted, old[i])
+					p.block(eventType, old[i])
 					old[i] = route
 				}
 			}
@@ -143,6 +160,7 @@ func (p *UniqueHost) HandleRoute(eventType watch.EventType, route *routeapi.Rout
 				glog.V(4).Infof("Route %s cannot take %s from %s", routeName, host, routeNameKey(oldest))
 				err := fmt.Errorf("a route in another namespace holds %s and is older than %s", host, route.Name)
 				p.recorder.RecordRouteRejection(route, "HostAlreadyClaimed", err.Error())
+				p.block(eventType, route)
 				return err
 			}
 
@@ -150,6 +168,7 @@ func (p *UniqueHost) HandleRoute(eventType watch.EventType, route *routeapi.Rout
 			for i := range old {
 				p.recorder.RecordRouteRejection(old[i], "HostAlreadyClaimed", fmt.Sprintf("namespace %s owns hostname %s", oldest.Namespace, host))
 				p.plugin.HandleRoute(watch.Deleted, old[i])
+				p.block(eventType, old[i])
 			}
 			p.hostToRoute[host] = []*routeapi.Route{route}
 		}
@@ -160,10 +179,12 @@ func (p *UniqueHost) HandleRoute(eventType watch.EventType, route *routeapi.Rout
 
 	switch eventType {
 	case watch.Added, watch.Modified:
+		delete(p.blocked, routeName)
 		if old, ok := p.routeToHost[routeName]; ok {
 			if old != host {
 				glog.V(4).Infof("Route %s changed from serving host %s to host %s", key, old, host)
 				delete(p.hostToRoute, old)
+				defer p.unblock(old)
 			}
 		}
 		p.routeToHost[routeName] = host
@@ -186,16 +207,55 @@ func (p *UniqueHost) HandleRoute(eventType watch.EventType, route *routeapi.Rout
 			}
 		}
 		delete(p.routeToHost, routeName)
+		defer p.unblock(host)
 		return p.plugin.HandleRoute(eventType, route)
 	}
 	return nil
 }
 
+// block remembers a route rejected because its host is claimed by another route.
+func (p *UniqueHost) block(eventType watch.EventType, route *routeapi.Route) {
+	if eventType == watch.Deleted {
+		return
+	}
+	p.blocked[routeNameKey(route)] = route
+}
+
+// unblock retries the routes blocked on host, oldest first, so they are admitted once the
+// routes claiming the host are gone. Routes which still conflict are rejected and blocked again.
+func (p *UniqueHost) unblock(host string) {
+	routes := []*routeapi.Route{}
+	for name, route := range p.blocked {
+		if route.Spec.Host != host {
+			continue
+		}
+		routes = append(routes, route)
+		delete(p.blocked, name)
+	}
+	sort.Sort(routeAge(routes))
+	for _, route := range routes {
+		glog.V(4).Infof("Route %s may claim released host %s", routeNameKey(route), host)
+		if err := p.HandleRoute(watch.Added, route); err != nil {
+			glog.V(4).Infof("Route %s could not claim %s: %v", routeNameKey(route), host, err)
+		}
+	}
+}
+
+// routeAge sorts routes from the oldest to the newest.
+type routeAge []*routeapi.Route
+
+func (r routeAge) Len() int      { return len(r) }
+func (r routeAge) Swap(i, j int) { r[i], r[j] = r[j], r[i] }
+func (r routeAge) Less(i, j int) bool {
+	return r[i].CreationTimestamp.Before(r[j].CreationTimestamp)
+}
+
 // HandleAllowedNamespaces limits the scope of valid routes to only those that match
 // the provided namespace list.
 func (p *UniqueHost) HandleNamespaces(namespaces sets.String) error {
 	p.allowedNamespaces = namespaces
 	changed := false
+	released := []string{}
 	for k, v := range p.hostToRoute {
 		if namespaces.Has(v[0].Namespace) {
 			continue
@@ -204,12 +264,19 @@ func (p *UniqueHost) HandleNamespaces(namespaces sets.String) error {
 		for i := range v {
 			delete(p.routeToHost, routeNameKey(v[i]))
 		}
+		released = append(released, k)
 		changed = true
 	}
 	if !changed && len(namespaces) > 0 {
 		return nil
 	}
-	return p.plugin.HandleNamespaces(namespaces)
+	if err := p.plugin.HandleNamespaces(namespaces); err != nil {
+		return err
+	}
+	for _, host := range released {
+		p.unblock(host)
+	}
+	return nil
 }
 
 // routeKey returns the internal router key to use for the given Route.
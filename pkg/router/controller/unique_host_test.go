@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/watch"
+
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+func TestUniqueHostAdmitsBlockedRouteWhenHostReleased(t *testing.T) {
+	now := time.Now()
+	route := func(namespace, name string, age time.Duration) *routeapi.Route {
+		return &routeapi.Route{
+			ObjectMeta: kapi.ObjectMeta{
+				Namespace:         namespace,
+				Name:              name,
+				CreationTimestamp: unversioned.Time{Time: now.Add(-age)},
+			},
+			Spec: routeapi.RouteSpec{Host: "www.example.com"},
+		}
+	}
+	oldest := route("ns1", "oldest", 3*time.Hour)
+	older := route("ns2", "older", 2*time.Hour)
+	newest := route("ns3", "newest", time.Hour)
+
+	p := &fakePlugin{}
+	plugin := NewUniqueHost(p, HostForRoute, LogRejections)
+	if err := plugin.HandleRoute(watch.Added, oldest); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, r := range []*routeapi.Route{newest, older} {
+		if err := plugin.HandleRoute(watch.Added, r); err == nil {
+			t.Fatalf("expected route %s to be rejected", r.Name)
+		}
+	}
+
+	// the oldest of the blocked routes claims the host once it is released
+	if err := plugin.HandleRoute(watch.Deleted, oldest); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.t != watch.Added || p.route != older {
+		t.Fatalf("expected route %s to be admitted, got %s %#v", older.Name, p.t, p.route)
+	}
+	if routes, ok := plugin.RoutesForHost("www.example.com"); !ok || len(routes) != 1 || routes[0] != older {
+		t.Fatalf("unexpected claimed routes: %#v", routes)
+	}
+
+	// a deleted blocked route is not admitted later
+	if err := plugin.HandleRoute(watch.Deleted, newest); err == nil {
+		t.Fatalf("expected deleting the blocked route %s to be rejected", newest.Name)
+	}
+	p.t, p.route = "", nil
+	if err := plugin.HandleRoute(watch.Deleted, older); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.t != watch.Deleted || p.route != older {
+		t.Fatalf("expected only route %s to be deleted, got %s %#v", older.Name, p.t, p.route)
+	}
+	if plugin.HostLen() != 0 {
+		t.Fatalf("expected no claimed hosts, got %d", plugin.HostLen())
+	}
+}
+
+func TestUniqueHostPathSharing(t *testing.T) {
+	now := time.Now()
+	route := func(name, path string, age time.Duration) *routeapi.Route {
+		return &routeapi.Route{
+			ObjectMeta: kapi.ObjectMeta{
+				Namespace:         "ns1",
+				Name:              name,
+				CreationTimestamp: unversioned.Time{Time: now.Add(-age)},
+			},
+			Spec: routeapi.RouteSpec{Host: "www.example.com", Path: path},
+		}
+	}
+
+	for _, allow := range []bool{true, false} {
+		plugin := NewUniqueHost(&fakePlugin{}, HostForRoute, LogRejections)
+		plugin.AllowPathSharing = allow
+		if err := plugin.HandleRoute(watch.Added, route("web", "", 2*time.Hour)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		err := plugin.HandleRoute(watch.Added, route("api", "/api", time.Hour))
+		routes, _ := plugin.RoutesForHost("www.example.com")
+		switch {
+		case allow && (err != nil || len(routes) != 2):
+			t.Errorf("expected routes with different paths to share the host, got %v: %#v", err, routes)
+		case !allow && (err == nil || len(routes) != 1 || routes[0].Name != "web"):
+			t.Errorf("expected the host to be served by the oldest route only, got %v: %#v", err, routes)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package external
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+// RoutingTable is the desired configuration of the load balancer.
+type RoutingTable struct {
+	// Routes are the routes of the load balancer, ordered by namespace and name.
+	Routes []Route `json:"routes"`
+}
+
+// Route sends the requests for a host and path to the endpoints of services.
+type Route struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Host      string `json:"host"`
+	Path      string `json:"path,omitempty"`
+	// TLSTermination is edge, passthrough or reencrypt, empty for plain HTTP.
+	TLSTermination string `json:"tlsTermination,omitempty"`
+	// Services share the requests of the route according to their weight.
+	Services []Service `json:"services"`
+}
+
+// Service is a service backing a route.
+type Service struct {
+	Name   string `json:"name"`
+	Weight int32  `json:"weight"`
+	// Endpoints are the ip:port addresses of the service.
+	Endpoints []string `json:"endpoints"`
+}
+
+// Backend is a router.Backend which writes the routing table to a file on
+// each commit that changes it.
+type Backend struct {
+	// path is the file the routing table is written to.
+	path string
+
+	routes    map[string]*routeapi.Route
+	endpoints map[string]*kapi.Endpoints
+
+	// written is the last routing table written.
+	written []byte
+}
+
+// NewBackend creates a backend writing the routing table to path.
+func NewBackend(path string) *Backend {
+	return &Backend{
+		path:      path,
+		routes:    make(map[string]*routeapi.Route),
+		endpoints: make(map[string]*kapi.Endpoints),
+	}
+}
+
+// AddRoute implements router.Backend.
+func (b *Backend) AddRoute(route *routeapi.Route) error {
+	b.routes[route.Namespace+"/"+route.Name] = route
+	return nil
+}
+
+// RemoveRoute implements router.Backend.
+func (b *Backend) RemoveRoute(route *routeapi.Route) error {
+	delete(b.routes, route.Namespace+"/"+route.Name)
+	return nil
+}
+
+// AddEndpoints implements router.Backend.
+func (b *Backend) AddEndpoints(endpoints *kapi.Endpoints) error {
+	b.endpoints[endpoints.Namespace+"/"+endpoints.Name] = endpoints
+	return nil
+}
+
+// RemoveEndpoints implements router.Backend.
+func (b *Backend) RemoveEndpoints(endpoints *kapi.Endpoints) error {
+	delete(b.endpoints, endpoints.Namespace+"/"+endpoints.Name)
+	return nil
+}
+
+// Commit implements router.Backend. The routing table is replaced atomically,
+// so that readers never see a partially written file.
+func (b *Backend) Commit() error {
+	data, err := json.MarshalIndent(b.RoutingTable(), "", "  ")
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(data, b.written) {
+		return nil
+	}
+
+	file, err := ioutil.TempFile(filepath.Dir(b.path), filepath.Base(b.path))
+	if err != nil {
+		return fmt.Errorf("unable to write the routing table: %v", err)
+	}
+	defer os.Remove(file.Name())
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("unable to write the routing table: %v", err)
+	}
+	if err := os.Rename(file.Name(), b.path); err != nil {
+		return fmt.Errorf("unable to write the routing table: %v", err)
+	}
+
+	b.written = data
+	glog.V(4).Infof("Wrote the routing table of %d routes to %s", len(b.routes), b.path)
+	return nil
+}
+
+// RoutingTable returns the routing table of the routes and endpoints added to
+// the backend.
+func (b *Backend) RoutingTable() *RoutingTable {
+	keys := make([]string, 0, len(b.routes))
+	for key := range b.routes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	table := &RoutingTable{Routes: []Route{}}
+	for _, key := range keys {
+		route := b.routes[key]
+		r := Route{
+			Namespace: route.Namespace,
+			Name:      route.Name,
+			Host:      route.Spec.Host,
+			Path:      route.Spec.Path,
+			Services:  []Service{},
+		}
+		if route.Spec.TLS != nil {
+			r.TLSTermination = string(route.Spec.TLS.Termination)
+		}
+		for _, backend := range routeapi.RouteBackends(route) {
+			r.Services = append(r.Services, Service{
+				Name:      backend.Name,
+				Weight:    routeapi.BackendWeight(&backend),
+				Endpoints: b.addresses(route, backend.Name),
+			})
+		}
+		table.Routes = append(table.Routes, r)
+	}
+	return table
+}
+
+// addresses returns the ip:port addresses of the named service of route,
+// restricted to the target port of the route if it sets one.
+func (b *Backend) addresses(route *routeapi.Route, service string) []string {
+	addresses := []string{}
+	endpoints, ok := b.endpoints[route.Namespace+"/"+service]
+	if !ok {
+		return addresses
+	}
+	targetPort := ""
+	if route.Spec.Port != nil {
+		targetPort = route.Spec.Port.TargetPort.String()
+	}
+	for _, subset := range endpoints.Subsets {
+		for _, port := range subset.Ports {
+			if len(targetPort) > 0 && port.Name != targetPort && strconv.Itoa(port.Port) != targetPort {
+				continue
+			}
+			for _, address := range subset.Addresses {
+				addresses = append(addresses, fmt.Sprintf("%s:%d", address.IP, port.Port))
+			}
+		}
+	}
+	sort.Strings(addresses)
+	return addresses
+}
@@ -0,0 +1,92 @@
+package external
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/intstr"
+
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+func TestBackendWritesRoutingTable(t *testing.T) {
+	dir, err := ioutil.TempDir("", "external-backend")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "routes.json")
+
+	weight := int32(20)
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "ns", Name: "web"},
+		Spec: routeapi.RouteSpec{
+			Host:              "www.example.com",
+			Path:              "/app",
+			To:                routeapi.RouteTargetReference{Kind: "Service", Name: "blue"},
+			AlternateBackends: []routeapi.RouteTargetReference{{Kind: "Service", Name: "green", Weight: &weight}},
+			Port:              &routeapi.RoutePort{TargetPort: intstr.FromString("http")},
+			TLS:               &routeapi.TLSConfig{Termination: routeapi.TLSTerminationEdge},
+		},
+	}
+	blue := &kapi.Endpoints{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "ns", Name: "blue"},
+		Subsets: []kapi.EndpointSubset{{
+			Addresses: []kapi.EndpointAddress{{IP: "10.1.0.3"}, {IP: "10.1.0.2"}},
+			Ports:     []kapi.EndpointPort{{Name: "http", Port: 8080}, {Name: "metrics", Port: 9090}},
+		}},
+	}
+
+	backend := NewBackend(path)
+	if err := backend.AddRoute(route); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := backend.AddEndpoints(blue); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := backend.Commit(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := RoutingTable{Routes: []Route{{
+		Namespace:      "ns",
+		Name:           "web",
+		Host:           "www.example.com",
+		Path:           "/app",
+		TLSTermination: "edge",
+		Services: []Service{
+			{Name: "blue", Weight: routeapi.DefaultBackendWeight, Endpoints: []string{"10.1.0.2:8080", "10.1.0.3:8080"}},
+			{Name: "green", Weight: 20, Endpoints: []string{}},
+		},
+	}}}
+	if table := readRoutingTable(t, path); !reflect.DeepEqual(table, expected) {
+		t.Errorf("expected routing table %#v, got %#v", expected, table)
+	}
+
+	if err := backend.RemoveRoute(route); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := backend.Commit(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if table := readRoutingTable(t, path); len(table.Routes) != 0 {
+		t.Errorf("expected an empty routing table, got %#v", table)
+	}
+}
+
+func readRoutingTable(t *testing.T, path string) RoutingTable {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	table := RoutingTable{}
+	if err := json.Unmarshal(data, &table); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return table
+}
@@ -0,0 +1,4 @@
+// Package external contains an example router.Backend for external load
+// balancers. It publishes the desired routing table as a JSON file, which an
+// agent managing the load balancer can watch and apply.
+package external
@@ -1603,7 +1603,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 				},
@@ -1657,7 +1657,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example2.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 					Path: "/foo/bar",
@@ -1710,7 +1710,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example2.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 				},
@@ -1739,7 +1739,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 					TLS: &routeapi.TLSConfig{
@@ -1805,7 +1805,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 					TLS: &routeapi.TLSConfig{
@@ -1871,7 +1871,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example3.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 					TLS: &routeapi.TLSConfig{
@@ -1902,7 +1902,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example3.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 					TLS: &routeapi.TLSConfig{
@@ -1935,7 +1935,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example3.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 				},
@@ -1963,7 +1963,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example3.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 				},
@@ -1991,7 +1991,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example3.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 					TLS: &routeapi.TLSConfig{
@@ -2022,7 +2022,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example4.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 					TLS: &routeapi.TLSConfig{
@@ -2106,7 +2106,7 @@ func TestHandleRoute(t *testing.T) {
 				},
 				Spec: routeapi.RouteSpec{
 					Host: "www.example4.com",
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: "TestService",
 					},
 					TLS: &routeapi.TLSConfig{
@@ -2202,7 +2202,7 @@ func TestHandleRouteModifications(t *testing.T) {
 		},
 		Spec: routeapi.RouteSpec{
 			Host: "www.example.com",
-			To: kapi.ObjectReference{
+			To: routeapi.RouteTargetReference{
 				Name: "testendpoint",
 			},
 		},
@@ -2271,7 +2271,7 @@ func TestF5RouterSuccessiveInstances(t *testing.T) {
 		},
 		Spec: routeapi.RouteSpec{
 			Host: "www.example.com",
-			To: kapi.ObjectReference{
+			To: routeapi.RouteTargetReference{
 				Name: "testendpoint",
 			},
 			TLS: &routeapi.TLSConfig{
@@ -2291,7 +2291,7 @@ func TestF5RouterSuccessiveInstances(t *testing.T) {
 		},
 		Spec: routeapi.RouteSpec{
 			Host: "www.example2.com",
-			To: kapi.ObjectReference{
+			To: routeapi.RouteTargetReference{
 				Name: "testhttpsendpoint",
 			},
 			TLS: &routeapi.TLSConfig{
@@ -16,3 +16,30 @@ type Plugin interface {
 	// If sent, filter the list of accepted routes and endpoints to this set
 	HandleNamespaces(namespaces sets.String) error
 }
+
+// Backend is the interface implemented by integrations with load balancers
+// which only need to know the desired set of routes and endpoints, such as
+// external hardware or cloud load balancers. The controller.BackendPlugin
+// adapts a Backend to the Plugin interface: it turns watch events into calls
+// to the Backend and removes the routes and endpoints of namespaces the router
+// stops watching.
+//
+// The routes given to a Backend have been admitted by the router and their
+// host is set. Methods are never called concurrently.
+type Backend interface {
+	// AddRoute adds route, or replaces the route with the same namespace and
+	// name.
+	AddRoute(route *routeapi.Route) error
+	// RemoveRoute removes route.
+	RemoveRoute(route *routeapi.Route) error
+	// AddEndpoints adds endpoints, or replaces the endpoints with the same
+	// namespace and name. Endpoints are named after the service they belong
+	// to, which routes refer to.
+	AddEndpoints(endpoints *kapi.Endpoints) error
+	// RemoveEndpoints removes endpoints.
+	RemoveEndpoints(endpoints *kapi.Endpoints) error
+	// Commit applies the changes made since the last commit to the load
+	// balancer. It is called after each change, implementations may coalesce
+	// commits.
+	Commit() error
+}
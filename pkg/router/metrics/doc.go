@@ -0,0 +1,3 @@
+// Package metrics exposes the metrics of a router process and of the proxy it
+// manages to prometheus.
+package metrics
@@ -0,0 +1,216 @@
+package metrics
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// routeBackendPrefixes are the prefixes of the names the HAProxy router gives
+// to the backend of a route, followed by ${namespace}_${name}.
+var routeBackendPrefixes = []string{"be_http_", "be_edge_http_", "be_tcp_", "be_secure_"}
+
+var (
+	backendLabels = []string{"backend", "namespace", "route"}
+
+	haproxyUpDesc = prometheus.NewDesc(
+		"haproxy_up",
+		"Whether the statistics of HAProxy could be read from its stats socket.",
+		nil, nil,
+	)
+	backendUpDesc = prometheus.NewDesc(
+		"haproxy_backend_up",
+		"Whether the backend of a route has at least one endpoint available.",
+		backendLabels, nil,
+	)
+	serverUpDesc = prometheus.NewDesc(
+		"haproxy_server_up",
+		"Whether an endpoint of the backend of a route is available.",
+		append(backendLabels, "server"), nil,
+	)
+	backendSessionsDesc = prometheus.NewDesc(
+		"haproxy_backend_sessions_total",
+		"Counter of the connections handled by the backend of a route.",
+		backendLabels, nil,
+	)
+	backendResponsesDesc = prometheus.NewDesc(
+		"haproxy_backend_http_responses_total",
+		"Counter of the HTTP responses of the backend of a route broken out by status code class.",
+		append(backendLabels, "code"), nil,
+	)
+
+	// responseCodeFields maps the columns of HAProxy statistics counting
+	// HTTP responses to the value of the code label.
+	responseCodeFields = map[string]string{
+		"hrsp_1xx":   "1xx",
+		"hrsp_2xx":   "2xx",
+		"hrsp_3xx":   "3xx",
+		"hrsp_4xx":   "4xx",
+		"hrsp_5xx":   "5xx",
+		"hrsp_other": "other",
+	}
+)
+
+// HAProxyCollector is a prometheus collector reading the statistics of an
+// HAProxy process from its stats socket each time it is scraped.
+type HAProxyCollector struct {
+	// SocketPath is the path of the stats socket of HAProxy.
+	SocketPath string
+	// Timeout bounds the time spent reading the statistics.
+	Timeout time.Duration
+
+	// lock serializes scrapes, HAProxy answers a single command per connection.
+	lock sync.Mutex
+}
+
+// NewHAProxyCollector returns a collector for the HAProxy process listening on
+// the stats socket at socketPath.
+func NewHAProxyCollector(socketPath string) *HAProxyCollector {
+	return &HAProxyCollector{
+		SocketPath: socketPath,
+		Timeout:    5 * time.Second,
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *HAProxyCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- haproxyUpDesc
+	ch <- backendUpDesc
+	ch <- serverUpDesc
+	ch <- backendSessionsDesc
+	ch <- backendResponsesDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *HAProxyCollector) Collect(ch chan<- prometheus.Metric) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	stats, err := c.readStats()
+	if err != nil {
+		glog.V(2).Infof("Unable to read the statistics of HAProxy: %v", err)
+		ch <- prometheus.MustNewConstMetric(haproxyUpDesc, prometheus.GaugeValue, 0)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(haproxyUpDesc, prometheus.GaugeValue, 1)
+
+	for _, stat := range stats {
+		backend := stat["pxname"]
+		namespace, route := routeForBackend(backend)
+		switch stat["svname"] {
+		case "FRONTEND":
+			continue
+		case "BACKEND":
+			ch <- prometheus.MustNewConstMetric(backendUpDesc, prometheus.GaugeValue, isUp(stat["status"]), backend, namespace, route)
+			if value, ok := parseCounter(stat["stot"]); ok {
+				ch <- prometheus.MustNewConstMetric(backendSessionsDesc, prometheus.CounterValue, value, backend, namespace, route)
+			}
+			for field, code := range responseCodeFields {
+				if value, ok := parseCounter(stat[field]); ok {
+					ch <- prometheus.MustNewConstMetric(backendResponsesDesc, prometheus.CounterValue, value, backend, namespace, route, code)
+				}
+			}
+		default:
+			ch <- prometheus.MustNewConstMetric(serverUpDesc, prometheus.GaugeValue, isUp(stat["status"]), backend, namespace, route, stat["svname"])
+		}
+	}
+}
+
+// readStats runs the show stat command on the stats socket of HAProxy.
+func (c *HAProxyCollector) readStats() ([]map[string]string, error) {
+	conn, err := net.DialTimeout("unix", c.SocketPath, c.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(c.Timeout)); err != nil {
+		return nil, err
+	}
+	if _, err := io.WriteString(conn, "show stat\n"); err != nil {
+		return nil, err
+	}
+	return parseStats(conn)
+}
+
+// parseStats parses the CSV output of the show stat command of HAProxy into
+// one map of column name to value for each proxy and server.
+func parseStats(r io.Reader) ([]map[string]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the statistics header: %v", err)
+	}
+	if len(header) == 0 || !strings.HasPrefix(header[0], "#") {
+		return nil, fmt.Errorf("unexpected statistics header: %q", strings.Join(header, ","))
+	}
+	header[0] = strings.TrimSpace(strings.TrimPrefix(header[0], "#"))
+
+	stats := []map[string]string{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		stat := make(map[string]string, len(header))
+		for i, value := range record {
+			if i < len(header) && len(header[i]) > 0 {
+				stat[header[i]] = value
+			}
+		}
+		stats = append(stats, stat)
+	}
+	return stats, nil
+}
+
+// routeForBackend returns the namespace and the name of the route served by
+// the named backend, or empty strings if the backend does not serve a route.
+func routeForBackend(backend string) (string, string) {
+	for _, prefix := range routeBackendPrefixes {
+		if !strings.HasPrefix(backend, prefix) {
+			continue
+		}
+		parts := strings.SplitN(strings.TrimPrefix(backend, prefix), "_", 2)
+		if len(parts) != 2 {
+			return "", ""
+		}
+		return parts[0], parts[1]
+	}
+	return "", ""
+}
+
+// isUp returns 1 if the HAProxy status of a backend or a server means that it
+// accepts connections, 0 otherwise. Servers without health checks are always
+// considered available by HAProxy.
+func isUp(status string) float64 {
+	if status == "UP" || strings.HasPrefix(status, "UP ") || status == "no check" {
+		return 1
+	}
+	return 0
+}
+
+// parseCounter parses a counter column of HAProxy statistics, which is left
+// empty when the counter does not apply to a proxy.
+func parseCounter(value string) (float64, bool) {
+	if len(value) == 0 {
+		return 0, false
+	}
+	counter, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return float64(counter), true
+}
@@ -0,0 +1,142 @@
+package metrics
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+const testStats = `# pxname,svname,qcur,qmax,scur,smax,slim,stot,bin,bout,dreq,dresp,ereq,econ,eresp,wretr,wredis,status,weight,act,bck,chkfail,chkdown,lastchg,downtime,qlimit,pid,iid,sid,throttle,lbtot,tracked,type,rate,rate_lim,rate_max,check_status,check_code,check_duration,hrsp_1xx,hrsp_2xx,hrsp_3xx,hrsp_4xx,hrsp_5xx,hrsp_other,hanafail,req_rate,req_rate_max,req_tot,cli_abrt,srv_abrt,
+public,FRONTEND,,,0,1,20000,12,1024,2048,0,0,0,,,,,OPEN,,,,,,,,,1,1,0,,,,0,0,0,1,,,,0,10,0,2,0,0,,0,1,12,,,
+be_http_myns_web,10.1.0.2:8080,0,0,0,1,,7,512,1024,,0,,0,0,0,0,UP,100,1,0,0,0,60,0,,1,3,1,,7,,2,0,,1,L4OK,,0,0,6,0,1,0,0,0,,,,0,0,
+be_http_myns_web,10.1.0.3:8080,0,0,0,0,,0,0,0,,0,,0,0,0,0,DOWN,100,1,0,1,1,60,60,,1,3,2,,0,,2,0,,0,L4CON,,0,0,0,0,0,0,0,0,,,,0,0,
+be_http_myns_web,BACKEND,0,0,0,1,2000,7,512,1024,0,0,,0,0,0,0,UP,200,2,0,,0,60,0,,1,3,0,,7,,1,0,,1,,,,0,6,0,1,0,0,,,,,0,0,
+openshift_default,BACKEND,0,0,0,1,2000,5,512,1024,0,0,,0,0,0,0,DOWN,0,0,0,,0,60,0,,1,4,0,,0,,1,0,,1,,,,0,0,0,0,5,0,,,,,0,0,
+`
+
+func TestParseStats(t *testing.T) {
+	stats, err := parseStats(strings.NewReader(testStats))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(stats) != 5 {
+		t.Fatalf("expected 5 statistics, got %d", len(stats))
+	}
+	if stats[1]["pxname"] != "be_http_myns_web" || stats[1]["svname"] != "10.1.0.2:8080" || stats[1]["status"] != "UP" || stats[1]["stot"] != "7" {
+		t.Errorf("unexpected server statistics: %#v", stats[1])
+	}
+	if stats[3]["hrsp_2xx"] != "6" || stats[3]["hrsp_4xx"] != "1" {
+		t.Errorf("unexpected backend statistics: %#v", stats[3])
+	}
+
+	if _, err := parseStats(strings.NewReader("Unknown command.\n")); err == nil {
+		t.Errorf("expected an error for output without a header")
+	}
+}
+
+func TestRouteForBackend(t *testing.T) {
+	tests := map[string][]string{
+		"be_http_myns_web":         {"myns", "web"},
+		"be_edge_http_myns_web":    {"myns", "web"},
+		"be_tcp_my-ns_my-route":    {"my-ns", "my-route"},
+		"be_secure_myns_web.app":   {"myns", "web.app"},
+		"openshift_default":        {"", ""},
+		"be_sni":                   {"", ""},
+		"be_http_missingseparator": {"", ""},
+	}
+	for backend, expected := range tests {
+		namespace, route := routeForBackend(backend)
+		if namespace != expected[0] || route != expected[1] {
+			t.Errorf("%s: expected %v, got [%s %s]", backend, expected, namespace, route)
+		}
+	}
+}
+
+func TestHAProxyCollector(t *testing.T) {
+	dir, err := ioutil.TempDir("", "haproxy-metrics")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	socketPath := filepath.Join(dir, "haproxy.sock")
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			command, _ := bufio.NewReader(conn).ReadString('\n')
+			if command == "show stat\n" {
+				io.WriteString(conn, testStats)
+			}
+			conn.Close()
+		}
+	}()
+
+	values := collect(t, NewHAProxyCollector(socketPath))
+	expected := map[string]float64{
+		`haproxy_up{}`: 1,
+		`haproxy_backend_up{backend="be_http_myns_web",namespace="myns",route="web"}`:                              1,
+		`haproxy_backend_up{backend="openshift_default",namespace="",route=""}`:                                    0,
+		`haproxy_server_up{backend="be_http_myns_web",namespace="myns",route="web",server="10.1.0.2:8080"}`:        1,
+		`haproxy_server_up{backend="be_http_myns_web",namespace="myns",route="web",server="10.1.0.3:8080"}`:        0,
+		`haproxy_backend_sessions_total{backend="be_http_myns_web",namespace="myns",route="web"}`:                  7,
+		`haproxy_backend_sessions_total{backend="openshift_default",namespace="",route=""}`:                        5,
+		`haproxy_backend_http_responses_total{backend="be_http_myns_web",code="2xx",namespace="myns",route="web"}`: 6,
+		`haproxy_backend_http_responses_total{backend="be_http_myns_web",code="4xx",namespace="myns",route="web"}`: 1,
+		`haproxy_backend_http_responses_total{backend="openshift_default",code="5xx",namespace="",route=""}`:       5,
+	}
+	for key, value := range expected {
+		if actual, ok := values[key]; !ok || actual != value {
+			t.Errorf("expected %s to be %v, got %v (found: %t)", key, value, actual, ok)
+		}
+	}
+
+	listener.Close()
+	values = collect(t, NewHAProxyCollector(socketPath))
+	if !reflect.DeepEqual(values, map[string]float64{`haproxy_up{}`: 0}) {
+		t.Errorf("expected only haproxy_up to be reported when the socket is unavailable, got %v", values)
+	}
+}
+
+// collect returns the value of each metric of collector keyed by its name and
+// sorted labels.
+func collect(t *testing.T, collector prometheus.Collector) map[string]float64 {
+	ch := make(chan prometheus.Metric)
+	go func() {
+		collector.Collect(ch)
+		close(ch)
+	}()
+	values := map[string]float64{}
+	for metric := range ch {
+		m := &dto.Metric{}
+		if err := metric.Write(m); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		labels := []string{}
+		for _, label := range m.Label {
+			labels = append(labels, label.GetName()+"=\""+label.GetValue()+"\"")
+		}
+		desc := metric.Desc().String()
+		name := desc[strings.Index(desc, "fqName: \"")+9:]
+		name = name[:strings.Index(name, "\"")]
+		value := m.GetGauge().GetValue() + m.GetCounter().GetValue()
+		values[name+"{"+strings.Join(labels, ",")+"}"] = value
+	}
+	return values
+}
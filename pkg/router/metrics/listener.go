@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"crypto/subtle"
+	"crypto/tls"
+	"net"
+	"net/http"
+
+	"github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Listener serves the registered prometheus metrics on /metrics.
+type Listener struct {
+	// Addr is the host:port the metrics are served on.
+	Addr string
+
+	// Username and Password, when both are set, are required from clients
+	// with HTTP basic authentication.
+	Username string
+	Password string
+
+	// CertFile and KeyFile, when both are set, serve the metrics over TLS.
+	CertFile string
+	KeyFile  string
+}
+
+// Listen starts serving the metrics in the background. It returns an error
+// if the listener cannot be opened.
+func (l Listener) Listen() error {
+	listener, err := net.Listen("tcp", l.Addr)
+	if err != nil {
+		return err
+	}
+	if len(l.CertFile) > 0 && len(l.KeyFile) > 0 {
+		cert, err := tls.LoadX509KeyPair(l.CertFile, l.KeyFile)
+		if err != nil {
+			listener.Close()
+			return err
+		}
+		listener = tls.NewListener(listener, &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS10,
+		})
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", l.authorize(prometheus.Handler()))
+
+	go func() {
+		glog.Infof("Serving router metrics on %s", l.Addr)
+		glog.Fatal(http.Serve(listener, mux))
+	}()
+	return nil
+}
+
+// authorize requires clients of handler to authenticate with the username and
+// password of the listener, if any.
+func (l Listener) authorize(handler http.Handler) http.Handler {
+	if len(l.Username) == 0 || len(l.Password) == 0 {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		username, password, ok := req.BasicAuth()
+		if !ok ||
+			subtle.ConstantTimeCompare([]byte(username), []byte(l.Username)) != 1 ||
+			subtle.ConstantTimeCompare([]byte(password), []byte(l.Password)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Basic realm="router"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
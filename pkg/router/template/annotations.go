@@ -0,0 +1,95 @@
+package templaterouter
+
+import (
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/golang/glog"
+
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+// The route annotations below tune the configuration the router generates for the backend of a
+// route. Only these annotations are made available to the router template, and only when their
+// value is valid, since route annotations may be set by any user able to edit the route.
+const (
+	// timeoutAnnotation is the server timeout of the route, as a number optionally followed by one
+	// of the units us, ms, s, m, h or d (milliseconds if omitted), e.g. 5s.
+	timeoutAnnotation = "haproxy.router.openshift.io/timeout"
+	// balanceAnnotation is the algorithm used to balance requests between the endpoints of the
+	// route, one of roundrobin, leastconn or source.
+	balanceAnnotation = "haproxy.router.openshift.io/balance"
+	// ipWhitelistAnnotation is a space separated list of IP addresses and CIDR ranges which are
+	// allowed to access the route. Connections from any other address are rejected.
+	ipWhitelistAnnotation = "haproxy.router.openshift.io/ip_whitelist"
+	// rateLimitAnnotation enables the rate limits below when set to true. The limits apply to
+	// each client IP address.
+	rateLimitAnnotation = "haproxy.router.openshift.io/rate-limit-connections"
+	// rateLimitConcurrentTCPAnnotation is the number of concurrent connections a client may open.
+	rateLimitConcurrentTCPAnnotation = "haproxy.router.openshift.io/rate-limit-connections.concurrent-tcp"
+	// rateLimitTCPAnnotation is the number of connections a client may open in 3 seconds.
+	rateLimitTCPAnnotation = "haproxy.router.openshift.io/rate-limit-connections.rate-tcp"
+	// rateLimitHTTPAnnotation is the number of HTTP requests a client may make in 10 seconds.
+	rateLimitHTTPAnnotation = "haproxy.router.openshift.io/rate-limit-connections.rate-http"
+	// cookieNameAnnotation is the name of the cookie used to keep a client on the same endpoint
+	// of an HTTP, edge or re-encrypt route.
+	cookieNameAnnotation = "router.openshift.io/cookie_name"
+)
+
+var (
+	timeoutPattern    = regexp.MustCompile(`^[0-9]+(us|ms|s|m|h|d)?$`)
+	cookieNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+	balanceAlgorithms = map[string]bool{"roundrobin": true, "leastconn": true, "source": true}
+)
+
+// routeConfigAnnotations returns the annotations of route which tune its router configuration,
+// dropping those with an invalid value.
+func routeConfigAnnotations(route *routeapi.Route) map[string]string {
+	annotations := map[string]string{}
+	for key, value := range route.Annotations {
+		var valid bool
+		switch key {
+		case timeoutAnnotation:
+			valid = timeoutPattern.MatchString(value)
+		case balanceAnnotation:
+			valid = balanceAlgorithms[value]
+		case ipWhitelistAnnotation:
+			valid = isIPWhitelist(value)
+			value = strings.Join(strings.Fields(value), " ")
+		case rateLimitAnnotation:
+			valid = value == "true" || value == "false"
+		case rateLimitConcurrentTCPAnnotation, rateLimitTCPAnnotation, rateLimitHTTPAnnotation:
+			limit, err := strconv.Atoi(value)
+			valid = err == nil && limit > 0
+		case cookieNameAnnotation:
+			valid = cookieNamePattern.MatchString(value)
+		default:
+			continue
+		}
+		if !valid {
+			glog.V(2).Infof("Ignoring annotation %s of route %s/%s, %q is not a valid value", key, route.Namespace, route.Name, value)
+			continue
+		}
+		annotations[key] = value
+	}
+	return annotations
+}
+
+// isIPWhitelist returns true if value is a non-empty list of IP addresses and CIDR ranges.
+func isIPWhitelist(value string) bool {
+	entries := strings.Fields(value)
+	if len(entries) == 0 {
+		return false
+	}
+	for _, entry := range entries {
+		if net.ParseIP(entry) != nil {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(entry); err != nil {
+			return false
+		}
+	}
+	return true
+}
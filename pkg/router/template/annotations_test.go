@@ -0,0 +1,65 @@
+package templaterouter
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+func TestRouteConfigAnnotations(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    map[string]string
+	}{
+		{
+			name:     "no annotations",
+			expected: map[string]string{},
+		},
+		{
+			name: "valid annotations",
+			annotations: map[string]string{
+				"haproxy.router.openshift.io/timeout":                               "5s",
+				"haproxy.router.openshift.io/balance":                               "source",
+				"haproxy.router.openshift.io/ip_whitelist":                          " 10.0.0.1  192.168.0.0/16 ",
+				"haproxy.router.openshift.io/rate-limit-connections":                "true",
+				"haproxy.router.openshift.io/rate-limit-connections.concurrent-tcp": "10",
+				"haproxy.router.openshift.io/rate-limit-connections.rate-http":      "100",
+				"router.openshift.io/cookie_name":                                   "my_cookie",
+				"description":                                                       "ignored",
+			},
+			expected: map[string]string{
+				"haproxy.router.openshift.io/timeout":                               "5s",
+				"haproxy.router.openshift.io/balance":                               "source",
+				"haproxy.router.openshift.io/ip_whitelist":                          "10.0.0.1 192.168.0.0/16",
+				"haproxy.router.openshift.io/rate-limit-connections":                "true",
+				"haproxy.router.openshift.io/rate-limit-connections.concurrent-tcp": "10",
+				"haproxy.router.openshift.io/rate-limit-connections.rate-http":      "100",
+				"router.openshift.io/cookie_name":                                   "my_cookie",
+			},
+		},
+		{
+			name: "invalid annotations",
+			annotations: map[string]string{
+				"haproxy.router.openshift.io/timeout":                          "5 seconds",
+				"haproxy.router.openshift.io/balance":                          "random\n  option httpclose",
+				"haproxy.router.openshift.io/ip_whitelist":                     "10.0.0.1 example.com",
+				"haproxy.router.openshift.io/rate-limit-connections":           "yes",
+				"haproxy.router.openshift.io/rate-limit-connections.rate-tcp":  "-1",
+				"haproxy.router.openshift.io/rate-limit-connections.rate-http": "",
+				"router.openshift.io/cookie_name":                              "my cookie",
+			},
+			expected: map[string]string{},
+		},
+	}
+
+	for _, test := range tests {
+		route := &routeapi.Route{ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: "bar", Annotations: test.annotations}}
+		if annotations := routeConfigAnnotations(route); !reflect.DeepEqual(annotations, test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, annotations)
+		}
+	}
+}
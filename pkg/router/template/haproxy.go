@@ -0,0 +1,177 @@
+package templaterouter
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// haproxyConfig is the part of a generated HAProxy configuration used to decide
+// whether a change can be applied to a running HAProxy through its stats socket,
+// which may change the weight of servers and enable or disable them, instead of
+// reloading it. Reloads start a new HAProxy process and drop the long-lived
+// connections of the old one once it stops.
+type haproxyConfig struct {
+	// skeleton is the configuration without its server lines.
+	skeleton string
+	// servers are the servers of the configuration keyed by backend/server.
+	servers map[string]haproxyServer
+}
+
+// haproxyServer is a server line of an HAProxy configuration.
+type haproxyServer struct {
+	// line is the server line without its weight.
+	line string
+	// weight is the weight of the server, 1 if the line does not set one.
+	weight int32
+	// disabled is true if the server was disabled through the stats socket.
+	disabled bool
+}
+
+// parseHAProxyConfig parses the server lines of the backends of the generated
+// configuration files, keyed by path. Other lines are kept in the skeleton
+// with their surrounding whitespace and empty lines removed, since these vary
+// with the number of servers of the templates.
+func parseHAProxyConfig(files map[string][]byte) *haproxyConfig {
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	config := &haproxyConfig{servers: map[string]haproxyServer{}}
+	skeleton := &bytes.Buffer{}
+	for _, path := range paths {
+		fmt.Fprintf(skeleton, "# %s\n", path)
+		section := ""
+		scanner := bufio.NewScanner(bytes.NewReader(files[path]))
+		for scanner.Scan() {
+			fields := strings.Fields(scanner.Text())
+			if len(fields) == 0 {
+				continue
+			}
+			switch fields[0] {
+			case "backend", "listen":
+				if len(fields) > 1 {
+					section = fields[1]
+				}
+			case "global", "defaults", "frontend", "userlist", "peers":
+				section = ""
+			case "server":
+				if len(section) > 0 && len(fields) > 2 {
+					config.servers[section+"/"+fields[1]] = parseHAProxyServer(fields)
+					continue
+				}
+			}
+			fmt.Fprintln(skeleton, strings.Join(fields, " "))
+		}
+	}
+	config.skeleton = skeleton.String()
+	return config
+}
+
+// parseHAProxyServer parses the fields of a server line.
+func parseHAProxyServer(fields []string) haproxyServer {
+	server := haproxyServer{weight: 1}
+	line := make([]string, 0, len(fields))
+	for i := 0; i < len(fields); i++ {
+		if fields[i] == "weight" && i+1 < len(fields) {
+			if weight, err := strconv.ParseInt(fields[i+1], 10, 32); err == nil {
+				server.weight = int32(weight)
+				i++
+				continue
+			}
+		}
+		line = append(line, fields[i])
+	}
+	server.line = strings.Join(line, " ")
+	return server
+}
+
+// serverUpdates returns the stats socket commands which change the servers of
+// the running configuration into the servers of config, in a stable order.
+// Servers removed from config are disabled rather than removed, which HAProxy
+// does not support. It returns false if config cannot be applied without a
+// reload, because its skeleton differs from the running one or it adds or
+// changes servers.
+func (running *haproxyConfig) serverUpdates(config *haproxyConfig) ([]string, bool) {
+	if running == nil || running.skeleton != config.skeleton {
+		return nil, false
+	}
+	for key, server := range config.servers {
+		current, ok := running.servers[key]
+		if !ok || current.line != server.line {
+			return nil, false
+		}
+	}
+
+	keys := make([]string, 0, len(running.servers))
+	for key := range running.servers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	commands := []string{}
+	for _, key := range keys {
+		current := running.servers[key]
+		server, ok := config.servers[key]
+		if !ok {
+			if !current.disabled {
+				commands = append(commands, fmt.Sprintf("disable server %s", key))
+			}
+			continue
+		}
+		if current.disabled {
+			commands = append(commands, fmt.Sprintf("enable server %s", key))
+		}
+		if current.weight != server.weight {
+			commands = append(commands, fmt.Sprintf("set weight %s %d", key, server.weight))
+		}
+	}
+	return commands, true
+}
+
+// updateServers records the weight and state of the servers of config, once
+// the commands returned by serverUpdates were executed.
+func (running *haproxyConfig) updateServers(config *haproxyConfig) {
+	for key, current := range running.servers {
+		server, ok := config.servers[key]
+		current.disabled = !ok
+		if ok {
+			current.weight = server.weight
+		}
+		running.servers[key] = current
+	}
+}
+
+// executeHAProxyCommand executes a command on the stats socket of HAProxy at
+// socketPath. HAProxy answers commands which succeed with an empty line, any
+// other answer is returned as an error.
+func executeHAProxyCommand(socketPath, command string, timeout time.Duration) error {
+	conn, err := net.DialTimeout("unix", socketPath, timeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(conn, command+"\n"); err != nil {
+		return err
+	}
+	out, err := ioutil.ReadAll(conn)
+	if err != nil {
+		return err
+	}
+	if answer := strings.TrimSpace(string(out)); len(answer) > 0 {
+		return fmt.Errorf("%q failed: %s", command, answer)
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package templaterouter
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const testHAProxyConfig = `
+global
+  stats socket /var/lib/haproxy/run/haproxy.sock mode 600 level admin
+
+frontend public
+  bind :80
+  default_backend openshift_default
+
+backend be_http_ns_web
+  mode http
+  balance roundrobin
+  server 10.1.0.2:8080 10.1.0.2:8080 check inter 5000ms cookie 10.1.0.2:8080 weight 256
+  server 10.1.0.3:8080 10.1.0.3:8080 check inter 5000ms cookie 10.1.0.3:8080 weight 128
+
+backend be_tcp_ns_db
+  server 10.1.0.4:5432 10.1.0.4:5432 check inter 5000ms
+`
+
+func TestParseHAProxyConfig(t *testing.T) {
+	config := parseHAProxyConfig(map[string][]byte{"haproxy.config": []byte(testHAProxyConfig)})
+
+	expected := map[string]haproxyServer{
+		"be_http_ns_web/10.1.0.2:8080": {line: "server 10.1.0.2:8080 10.1.0.2:8080 check inter 5000ms cookie 10.1.0.2:8080", weight: 256},
+		"be_http_ns_web/10.1.0.3:8080": {line: "server 10.1.0.3:8080 10.1.0.3:8080 check inter 5000ms cookie 10.1.0.3:8080", weight: 128},
+		"be_tcp_ns_db/10.1.0.4:5432":   {line: "server 10.1.0.4:5432 10.1.0.4:5432 check inter 5000ms", weight: 1},
+	}
+	if !reflect.DeepEqual(config.servers, expected) {
+		t.Errorf("expected servers %#v, got %#v", expected, config.servers)
+	}
+
+	// whitespace left by the templates around server lines is not part of the skeleton
+	other := parseHAProxyConfig(map[string][]byte{"haproxy.config": []byte(testHAProxyConfig + "\n\n    \n")})
+	if config.skeleton != other.skeleton {
+		t.Errorf("expected the skeletons to be the same:\n%s\n%s", config.skeleton, other.skeleton)
+	}
+}
+
+func TestHAProxyServerUpdates(t *testing.T) {
+	running := map[string]haproxyServer{
+		"be/a": {line: "server a 10.1.0.2:8080 check", weight: 256},
+		"be/b": {line: "server b 10.1.0.3:8080 check", weight: 128},
+		"be/c": {line: "server c 10.1.0.4:8080 check", weight: 1, disabled: true},
+	}
+	tests := []struct {
+		name     string
+		skeleton string
+		servers  map[string]haproxyServer
+		commands []string
+		reload   bool
+	}{
+		{
+			name:     "unchanged",
+			servers:  map[string]haproxyServer{"be/a": running["be/a"], "be/b": running["be/b"]},
+			commands: []string{},
+		},
+		{
+			name: "weights changed",
+			servers: map[string]haproxyServer{
+				"be/a": {line: "server a 10.1.0.2:8080 check", weight: 0},
+				"be/b": running["be/b"],
+			},
+			commands: []string{"set weight be/a 0"},
+		},
+		{
+			name:     "server removed",
+			servers:  map[string]haproxyServer{"be/a": running["be/a"]},
+			commands: []string{"disable server be/b"},
+		},
+		{
+			name: "disabled server added back",
+			servers: map[string]haproxyServer{
+				"be/a": running["be/a"],
+				"be/b": running["be/b"],
+				"be/c": {line: "server c 10.1.0.4:8080 check", weight: 2},
+			},
+			commands: []string{"enable server be/c", "set weight be/c 2"},
+		},
+		{
+			name: "server added",
+			servers: map[string]haproxyServer{
+				"be/a": running["be/a"],
+				"be/d": {line: "server d 10.1.0.5:8080 check", weight: 1},
+			},
+			reload: true,
+		},
+		{
+			name: "server changed",
+			servers: map[string]haproxyServer{
+				"be/a": {line: "server a 10.1.0.2:8080 check inter 10s", weight: 256},
+			},
+			reload: true,
+		},
+		{
+			name:     "skeleton changed",
+			skeleton: "backend other\n",
+			servers:  map[string]haproxyServer{"be/a": running["be/a"]},
+			reload:   true,
+		},
+	}
+	for _, test := range tests {
+		current := &haproxyConfig{skeleton: "", servers: map[string]haproxyServer{}}
+		for key, server := range running {
+			current.servers[key] = server
+		}
+		config := &haproxyConfig{skeleton: test.skeleton, servers: test.servers}
+
+		commands, ok := current.serverUpdates(config)
+		if ok == test.reload {
+			t.Errorf("%s: expected a reload to be %t", test.name, test.reload)
+			continue
+		}
+		if test.reload {
+			continue
+		}
+		if !reflect.DeepEqual(commands, test.commands) {
+			t.Errorf("%s: expected commands %v, got %v", test.name, test.commands, commands)
+		}
+
+		current.updateServers(config)
+		if commands, _ := current.serverUpdates(config); len(commands) != 0 {
+			t.Errorf("%s: expected no commands once the servers were updated, got %v", test.name, commands)
+		}
+	}
+
+	var unknown *haproxyConfig
+	if _, ok := unknown.serverUpdates(&haproxyConfig{}); ok {
+		t.Errorf("expected a reload when the running configuration is unknown")
+	}
+}
+
+func TestExecuteHAProxyCommand(t *testing.T) {
+	dir, err := ioutil.TempDir("", "haproxy-socket")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	socketPath := filepath.Join(dir, "haproxy.sock")
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			command, _ := bufio.NewReader(conn).ReadString('\n')
+			if command == "set weight be/a 10\n" {
+				io.WriteString(conn, "\n")
+			} else {
+				io.WriteString(conn, "No such server.\n\n")
+			}
+			conn.Close()
+		}
+	}()
+
+	if err := executeHAProxyCommand(socketPath, "set weight be/a 10", time.Second); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := executeHAProxyCommand(socketPath, "set weight be/b 10", time.Second); err == nil {
+		t.Errorf("expected an error for a failed command")
+	}
+	if err := executeHAProxyCommand(filepath.Join(dir, "missing.sock"), "set weight be/a 10", time.Second); err == nil {
+		t.Errorf("expected an error for a missing socket")
+	}
+}
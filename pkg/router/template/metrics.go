@@ -0,0 +1,66 @@
+package templaterouter
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	reloadCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "template_router_reload_count",
+			Help: "Counter of router reloads broken out by result, either success or failure.",
+		},
+		[]string{"result"},
+	)
+	reloadLatencies = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name: "template_router_reload_latencies",
+			Help: "Router reload latency distribution in microseconds.",
+			// Use buckets ranging from 10 ms to about 40 seconds.
+			Buckets: prometheus.ExponentialBuckets(10000, 2.0, 13),
+		},
+	)
+	serverUpdateCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "template_router_server_update_count",
+			Help: "Counter of HAProxy server changes applied through the stats socket instead of a reload broken out by result, either success or failure.",
+		},
+		[]string{"result"},
+	)
+
+	registerMetrics sync.Once
+)
+
+// RegisterMetrics registers the template router metrics, so that they are
+// exposed by the metrics endpoint of the router. It may be called more than
+// once.
+func RegisterMetrics() {
+	registerMetrics.Do(func() {
+		prometheus.MustRegister(reloadCounter)
+		prometheus.MustRegister(reloadLatencies)
+		prometheus.MustRegister(serverUpdateCounter)
+	})
+}
+
+// recordReload records the result and the latency of a router reload.
+func recordReload(start time.Time, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	reloadCounter.WithLabelValues(result).Inc()
+	reloadLatencies.Observe(float64(time.Since(start) / time.Microsecond))
+}
+
+// recordServerUpdate records the result of a change of the servers of HAProxy
+// through its stats socket.
+func recordServerUpdate(err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	serverUpdateCounter.WithLabelValues(result).Inc()
+}
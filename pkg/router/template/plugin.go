@@ -32,17 +32,20 @@ func newDefaultTemplatePlugin(router routerInterface, includeUDP bool) *Template
 }
 
 type TemplatePluginConfig struct {
-	WorkingDir             string
-	TemplatePath           string
-	ReloadScriptPath       string
-	ReloadInterval         time.Duration
-	DefaultCertificate     string
-	DefaultCertificatePath string
-	StatsPort              int
-	StatsUsername          string
-	StatsPassword          string
-	IncludeUDP             bool
-	PeerService            *ktypes.NamespacedName
+	WorkingDir               string
+	TemplatePath             string
+	ReloadScriptPath         string
+	ReloadInterval           time.Duration
+	MinReloadInterval        time.Duration
+	HAProxySocket            string
+	DefaultCertificate       string
+	DefaultCertificatePath   string
+	DefaultDestinationCAPath string
+	StatsPort                int
+	StatsUsername            string
+	StatsPassword            string
+	IncludeUDP               bool
+	PeerService              *ktypes.NamespacedName
 }
 
 // routerInterface controls the interaction of the plugin with the underlying router implementation
@@ -87,8 +90,10 @@ func env(name, defaultValue string) string {
 func NewTemplatePlugin(cfg TemplatePluginConfig) (*TemplatePlugin, error) {
 	templateBaseName := filepath.Base(cfg.TemplatePath)
 	globalFuncs := template.FuncMap{
-		"endpointsForAlias": endpointsForAlias,
-		"env":               env,
+		"backendEndpointsForAlias":  backendEndpointsForAlias,
+		"endpointsForAlias":         endpointsForAlias,
+		"env":                       env,
+		"sortedServiceAliasConfigs": sortedServiceAliasConfigs,
 	}
 	masterTemplate, err := template.New("config").Funcs(globalFuncs).ParseFiles(cfg.TemplatePath)
 	if err != nil {
@@ -110,16 +115,19 @@ func NewTemplatePlugin(cfg TemplatePluginConfig) (*TemplatePlugin, error) {
 	}
 
 	templateRouterCfg := templateRouterCfg{
-		dir:                    cfg.WorkingDir,
-		templates:              templates,
-		reloadScriptPath:       cfg.ReloadScriptPath,
-		reloadInterval:         cfg.ReloadInterval,
-		defaultCertificate:     cfg.DefaultCertificate,
-		defaultCertificatePath: cfg.DefaultCertificatePath,
-		statsUser:              cfg.StatsUsername,
-		statsPassword:          cfg.StatsPassword,
-		statsPort:              cfg.StatsPort,
-		peerEndpointsKey:       peerKey,
+		dir:                      cfg.WorkingDir,
+		templates:                templates,
+		reloadScriptPath:         cfg.ReloadScriptPath,
+		reloadInterval:           cfg.ReloadInterval,
+		minReloadInterval:        cfg.MinReloadInterval,
+		haproxySocket:            cfg.HAProxySocket,
+		defaultCertificate:       cfg.DefaultCertificate,
+		defaultCertificatePath:   cfg.DefaultCertificatePath,
+		defaultDestinationCAPath: cfg.DefaultDestinationCAPath,
+		statsUser:                cfg.StatsUsername,
+		statsPassword:            cfg.StatsPassword,
+		statsPort:                cfg.StatsPort,
+		peerEndpointsKey:         peerKey,
 	}
 	router, err := newTemplateRouter(templateRouterCfg)
 	return newDefaultTemplatePlugin(router, cfg.IncludeUDP), err
@@ -155,8 +163,9 @@ func (p *TemplatePlugin) HandleEndpoints(eventType watch.EventType, endpoints *k
 
 // HandleRoute processes watch events on the Route resource.
 // TODO: this function can probably be collapsed with the router itself, as a function that
-//   determines which component needs to be recalculated (which template) and then does so
-//   on demand.
+//
+//	determines which component needs to be recalculated (which template) and then does so
+//	on demand.
 func (p *TemplatePlugin) HandleRoute(eventType watch.EventType, route *routeapi.Route) error {
 	key := routeKey(route)
 
@@ -374,7 +374,7 @@ func TestHandleRoute(t *testing.T) {
 		},
 		Spec: routeapi.RouteSpec{
 			Host: "www.example.com",
-			To: kapi.ObjectReference{
+			To: routeapi.RouteTargetReference{
 				Name: "TestService",
 			},
 		},
@@ -416,7 +416,7 @@ func TestHandleRoute(t *testing.T) {
 		},
 		Spec: routeapi.RouteSpec{
 			Host: "www.example.com",
-			To: kapi.ObjectReference{
+			To: routeapi.RouteTargetReference{
 				Name: "TestService2",
 			},
 		},
@@ -551,7 +551,7 @@ func TestNamespaceScopingFromEmpty(t *testing.T) {
 		ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: "test"},
 		Spec: routeapi.RouteSpec{
 			Host: "www.example.com",
-			To: kapi.ObjectReference{
+			To: routeapi.RouteTargetReference{
 				Name: "TestService",
 			},
 		},
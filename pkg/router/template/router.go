@@ -1,13 +1,15 @@
 package templaterouter
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
-	"os"
+	"math"
 	"os/exec"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 	"text/template"
@@ -55,6 +57,9 @@ type templateRouter struct {
 	defaultCertificate string
 	// if the default certificate is populated then this will be filled in so it can be passed to the templates
 	defaultCertificatePath string
+	// defaultDestinationCAPath is a path to a CA bundle that should be used by the underlying implementation to verify
+	// the certificates of re-encrypt routes that do not provide a destination CA certificate.
+	defaultDestinationCAPath string
 	// peerService provides a namespace/name to check against when receiving endpoint events in order
 	// to track the peers of this router.  This may be used to populate the set of peer ip addresses
 	// that a router can use for talking to other routers controlled by the same service.
@@ -73,23 +78,40 @@ type templateRouter struct {
 	rateLimitedCommitFunction *ratelimiter.RateLimitedFunction
 	// rateLimitedCommitStopChannel is the stop/terminate channel.
 	rateLimitedCommitStopChannel chan struct{}
+	// minReloadInterval is the minimum time between two reloads of the router. Commits
+	// which require a reload sooner are delayed.
+	minReloadInterval time.Duration
+	// lastReload is the time of the last reload of the router.
+	lastReload time.Time
+	// reloadTimer commits again once a delayed reload is allowed, nil until a
+	// reload is first delayed.
+	reloadTimer *time.Timer
+	// haproxySocket is the path of the stats socket of HAProxy. If set, commits which only
+	// change the weight of servers or remove servers are applied through the socket instead
+	// of reloading the router.
+	haproxySocket string
+	// running is the configuration of the running HAProxy, nil if unknown.
+	running *haproxyConfig
 	// lock is a mutex used to prevent concurrent router reloads.
 	lock sync.Mutex
 }
 
 // templateRouterCfg holds all configuration items required to initialize the template router
 type templateRouterCfg struct {
-	dir                    string
-	templates              map[string]*template.Template
-	reloadScriptPath       string
-	reloadInterval         time.Duration
-	defaultCertificate     string
-	defaultCertificatePath string
-	statsUser              string
-	statsPassword          string
-	statsPort              int
-	peerEndpointsKey       string
-	includeUDP             bool
+	dir                      string
+	templates                map[string]*template.Template
+	reloadScriptPath         string
+	reloadInterval           time.Duration
+	minReloadInterval        time.Duration
+	haproxySocket            string
+	defaultCertificate       string
+	defaultCertificatePath   string
+	defaultDestinationCAPath string
+	statsUser                string
+	statsPassword            string
+	statsPort                int
+	peerEndpointsKey         string
+	includeUDP               bool
 }
 
 // templateConfig is a subset of the templateRouter information that should be passed to the template for generating
@@ -101,6 +123,8 @@ type templateData struct {
 	State map[string]ServiceUnit
 	// full path and file name to the default certificate
 	DefaultCertificate string
+	// full path and file name to the default destination CA certificate for re-encrypt routes
+	DefaultDestinationCA string
 	// peers
 	PeerEndpoints []Endpoint
 	//username to expose stats with (if the template supports it)
@@ -131,22 +155,25 @@ func newTemplateRouter(cfg templateRouterCfg) (*templateRouter, error) {
 	}
 
 	router := &templateRouter{
-		dir:                    dir,
-		templates:              cfg.templates,
-		reloadScriptPath:       cfg.reloadScriptPath,
-		reloadInterval:         cfg.reloadInterval,
-		state:                  make(map[string]ServiceUnit),
-		certManager:            certManager,
-		defaultCertificate:     cfg.defaultCertificate,
-		defaultCertificatePath: cfg.defaultCertificatePath,
-		statsUser:              cfg.statsUser,
-		statsPassword:          cfg.statsPassword,
-		statsPort:              cfg.statsPort,
-		peerEndpointsKey:       cfg.peerEndpointsKey,
-		peerEndpoints:          []Endpoint{},
+		dir:                      dir,
+		templates:                cfg.templates,
+		reloadScriptPath:         cfg.reloadScriptPath,
+		reloadInterval:           cfg.reloadInterval,
+		state:                    make(map[string]ServiceUnit),
+		certManager:              certManager,
+		defaultCertificate:       cfg.defaultCertificate,
+		defaultCertificatePath:   cfg.defaultCertificatePath,
+		defaultDestinationCAPath: cfg.defaultDestinationCAPath,
+		statsUser:                cfg.statsUser,
+		statsPassword:            cfg.statsPassword,
+		statsPort:                cfg.statsPort,
+		peerEndpointsKey:         cfg.peerEndpointsKey,
+		peerEndpoints:            []Endpoint{},
 
 		rateLimitedCommitFunction:    nil,
 		rateLimitedCommitStopChannel: make(chan struct{}),
+		minReloadInterval:            cfg.minReloadInterval,
+		haproxySocket:                cfg.haproxySocket,
 	}
 
 	keyFunc := func(_ interface{}) (string, error) {
@@ -184,6 +211,94 @@ func endpointsForAlias(alias ServiceAliasConfig, svc ServiceUnit) []Endpoint {
 	return endpoints
 }
 
+// maxEndpointWeight is the largest weight the router may give an endpoint.
+const maxEndpointWeight = 256
+
+// backendEndpointsForAlias returns the endpoints of all the service units backing alias
+// with their weights. The weight of each service unit is shared between its endpoints,
+// and the weights are scaled so the largest one is maxEndpointWeight. svc is the service
+// unit alias belongs to, which is the only backend of aliases from older state.
+func backendEndpointsForAlias(alias ServiceAliasConfig, svc ServiceUnit, state map[string]ServiceUnit) []BackendEndpoint {
+	if len(alias.ServiceUnitNames) == 0 {
+		alias.ServiceUnitNames = map[string]int32{svc.Name: routeapi.DefaultBackendWeight}
+	}
+	names := make([]string, 0, len(alias.ServiceUnitNames))
+	for name := range alias.ServiceUnitNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	endpoints := map[string][]Endpoint{}
+	shares := map[string]float64{}
+	maxShare := 0.0
+	for _, name := range names {
+		serviceEndpoints := endpointsForAlias(alias, state[name])
+		if len(serviceEndpoints) == 0 {
+			continue
+		}
+		endpoints[name] = serviceEndpoints
+		shares[name] = float64(alias.ServiceUnitNames[name]) / float64(len(serviceEndpoints))
+		if shares[name] > maxShare {
+			maxShare = shares[name]
+		}
+	}
+
+	backends := []BackendEndpoint{}
+	for _, name := range names {
+		weight := int32(0)
+		if maxShare > 0 {
+			weight = int32(math.Ceil(shares[name] * maxEndpointWeight / maxShare))
+		}
+		hostname := serviceHostname(name)
+		for _, endpoint := range endpoints[name] {
+			backends = append(backends, BackendEndpoint{Endpoint: endpoint, Weight: weight, Hostname: hostname})
+		}
+	}
+	return backends
+}
+
+// serviceHostname returns the DNS name of the service with the given service unit key
+// (<namespace>/<name>) within the cluster.
+func serviceHostname(key string) string {
+	parts := strings.SplitN(key, "/", 2)
+	if len(parts) != 2 {
+		return ""
+	}
+	return fmt.Sprintf("%s.%s.svc", parts[1], parts[0])
+}
+
+// sortedServiceAliasConfigs returns the routes of all the service units in state ordered by
+// host, with the longest paths of a host first. Routers which match requests against the
+// routes in order will then pick the route with the longest path that prefixes the request.
+func sortedServiceAliasConfigs(state map[string]ServiceUnit) []ServiceAliasConfigEntry {
+	entries := []ServiceAliasConfigEntry{}
+	for _, svc := range state {
+		for key, cfg := range svc.ServiceAliasConfigs {
+			entries = append(entries, ServiceAliasConfigEntry{Key: key, Config: cfg})
+		}
+	}
+	sort.Sort(byHostAndPathLength(entries))
+	return entries
+}
+
+// byHostAndPathLength orders routes by host and then by descending path length.
+type byHostAndPathLength []ServiceAliasConfigEntry
+
+func (e byHostAndPathLength) Len() int      { return len(e) }
+func (e byHostAndPathLength) Swap(i, j int) { e[i], e[j] = e[j], e[i] }
+func (e byHostAndPathLength) Less(i, j int) bool {
+	a, b := e[i].Config, e[j].Config
+	switch {
+	case a.Host != b.Host:
+		return a.Host < b.Host
+	case len(a.Path) != len(b.Path):
+		return len(a.Path) > len(b.Path)
+	case a.Path != b.Path:
+		return a.Path < b.Path
+	}
+	return e[i].Key < e[j].Key
+}
+
 // writeDefaultCert is called a single time during init to write out the default certificate
 func (r *templateRouter) writeDefaultCert() error {
 	if len(r.defaultCertificate) == 0 {
@@ -228,18 +343,62 @@ func (r *templateRouter) commitAndReload() error {
 	}
 
 	glog.V(4).Infof("Writing the router config")
-	if err := r.writeConfig(); err != nil {
+	files, err := r.writeConfig()
+	if err != nil {
 		return err
 	}
 
+	config := parseHAProxyConfig(files)
+	if r.updateServers(config) {
+		return nil
+	}
+
+	if wait := r.lastReload.Add(r.minReloadInterval).Sub(time.Now()); wait > 0 {
+		glog.V(4).Infof("Delaying the router reload by %v", wait)
+		if r.reloadTimer == nil {
+			r.reloadTimer = time.AfterFunc(wait, r.Commit)
+		} else {
+			r.reloadTimer.Reset(wait)
+		}
+		return nil
+	}
+
 	glog.V(4).Infof("Reloading the router")
+	r.lastReload = time.Now()
 	if err := r.reloadRouter(); err != nil {
+		r.running = nil
 		return err
 	}
+	r.running = config
 
 	return nil
 }
 
+// updateServers applies config to the running HAProxy through its stats socket, and
+// returns false if the router must be reloaded instead.
+func (r *templateRouter) updateServers(config *haproxyConfig) bool {
+	if len(r.haproxySocket) == 0 {
+		return false
+	}
+	commands, ok := r.running.serverUpdates(config)
+	if !ok {
+		return false
+	}
+	for _, command := range commands {
+		err := executeHAProxyCommand(r.haproxySocket, command, 5*time.Second)
+		recordServerUpdate(err)
+		if err != nil {
+			glog.Warningf("Unable to update the servers of HAProxy, reloading the router: %v", err)
+			return false
+		}
+	}
+	r.running.updateServers(config)
+	if len(commands) > 0 {
+		glog.V(2).Infof("Router servers updated without a reload:\n%s", strings.Join(commands, "\n"))
+	}
+	return true
+}
+
 // writeState writes the state of this router to disk.
 func (r *templateRouter) writeState() error {
 	data, err := json.MarshalIndent(r.state, "", "  ")
@@ -252,42 +411,43 @@ func (r *templateRouter) writeState() error {
 	return nil
 }
 
-// writeConfig writes the config to disk
-func (r *templateRouter) writeConfig() error {
+// writeConfig writes the config to disk and returns the content of each file
+// keyed by its path.
+func (r *templateRouter) writeConfig() (map[string][]byte, error) {
 	//write out any certificate files that don't exist
 	for _, serviceUnit := range r.state {
 		for k, cfg := range serviceUnit.ServiceAliasConfigs {
 			if err := r.writeCertificates(&cfg); err != nil {
-				return fmt.Errorf("error writing certificates for %s: %v", serviceUnit.Name, err)
+				return nil, fmt.Errorf("error writing certificates for %s: %v", serviceUnit.Name, err)
 			}
 			cfg.Status = ServiceAliasConfigStatusSaved
 			serviceUnit.ServiceAliasConfigs[k] = cfg
 		}
 	}
 
+	files := map[string][]byte{}
 	for path, template := range r.templates {
-		file, err := os.Create(path)
-		if err != nil {
-			return fmt.Errorf("error creating config file %s: %v", path, err)
-		}
-
 		data := templateData{
-			WorkingDir:         r.dir,
-			State:              r.state,
-			DefaultCertificate: r.defaultCertificatePath,
-			PeerEndpoints:      r.peerEndpoints,
-			StatsUser:          r.statsUser,
-			StatsPassword:      r.statsPassword,
-			StatsPort:          r.statsPort,
+			WorkingDir:           r.dir,
+			State:                r.state,
+			DefaultCertificate:   r.defaultCertificatePath,
+			DefaultDestinationCA: r.defaultDestinationCAPath,
+			PeerEndpoints:        r.peerEndpoints,
+			StatsUser:            r.statsUser,
+			StatsPassword:        r.statsPassword,
+			StatsPort:            r.statsPort,
 		}
-		if err := template.Execute(file, data); err != nil {
-			file.Close()
-			return fmt.Errorf("error executing template for file %s: %v", path, err)
+		out := &bytes.Buffer{}
+		if err := template.Execute(out, data); err != nil {
+			return nil, fmt.Errorf("error executing template for file %s: %v", path, err)
 		}
-		file.Close()
+		if err := ioutil.WriteFile(path, out.Bytes(), 0644); err != nil {
+			return nil, fmt.Errorf("error writing config file %s: %v", path, err)
+		}
+		files[path] = out.Bytes()
 	}
 
-	return nil
+	return files, nil
 }
 
 // writeCertificates attempts to write certificates only if the cfg requires it see shouldWriteCerts
@@ -302,8 +462,10 @@ func (r *templateRouter) writeCertificates(cfg *ServiceAliasConfig) error {
 
 // reloadRouter executes the router's reload script.
 func (r *templateRouter) reloadRouter() error {
+	start := time.Now()
 	cmd := exec.Command(r.reloadScriptPath)
 	out, err := cmd.CombinedOutput()
+	recordReload(start, err)
 	if err != nil {
 		return fmt.Errorf("error reloading router: %v\n%s", err, out)
 	}
@@ -396,14 +558,26 @@ func (r *templateRouter) AddRoute(id string, route *routeapi.Route, host string)
 	backendKey := r.routeKey(route)
 
 	config := ServiceAliasConfig{
-		Host: host,
-		Path: route.Spec.Path,
+		Host:             host,
+		Path:             route.Spec.Path,
+		ServiceUnitNames: map[string]int32{},
+		Annotations:      routeConfigAnnotations(route),
+	}
+	for _, backend := range routeapi.RouteBackends(route) {
+		config.ServiceUnitNames[fmt.Sprintf("%s/%s", route.Namespace, backend.Name)] = routeapi.BackendWeight(&backend)
 	}
 
 	if route.Spec.Port != nil {
 		config.PreferPort = route.Spec.Port.TargetPort.String()
 	}
 
+	if check := route.Spec.HealthCheck; check != nil {
+		config.HealthCheckPath = check.Path
+		if check.IntervalSeconds > 0 {
+			config.HealthCheckInterval = fmt.Sprintf("%ds", check.IntervalSeconds)
+		}
+	}
+
 	tls := route.Spec.TLS
 	if tls != nil && len(tls.Termination) > 0 {
 		config.TLSTermination = tls.Termination
@@ -2,6 +2,7 @@ package templaterouter
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	routeapi "github.com/openshift/origin/pkg/route/api"
@@ -530,7 +531,7 @@ func TestRouteExistsUnderOneServiceOnly(t *testing.T) {
 		Spec: routeapi.RouteSpec{
 			Host: "host",
 			Path: "path",
-			To: kapi.ObjectReference{
+			To: routeapi.RouteTargetReference{
 				Name: "bad-service",
 			},
 		},
@@ -543,7 +544,7 @@ func TestRouteExistsUnderOneServiceOnly(t *testing.T) {
 		Spec: routeapi.RouteSpec{
 			Host: "host",
 			Path: "path",
-			To: kapi.ObjectReference{
+			To: routeapi.RouteTargetReference{
 				Name: "good-service",
 			},
 		},
@@ -556,7 +557,7 @@ func TestRouteExistsUnderOneServiceOnly(t *testing.T) {
 		Spec: routeapi.RouteSpec{
 			Host: "host",
 			Path: "path",
-			To: kapi.ObjectReference{
+			To: routeapi.RouteTargetReference{
 				Name: "good-service",
 			},
 		},
@@ -709,3 +710,177 @@ func TestAddRouteEdgeTerminationInsecurePolicy(t *testing.T) {
 		}
 	}
 }
+
+// TestAddRouteAlternateBackends ensures that the service units of all the
+// backends of a route are recorded with their weights.
+func TestAddRouteAlternateBackends(t *testing.T) {
+	router := newFakeTemplateRouter()
+	weight := int32(20)
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: "foo",
+			Name:      "bar",
+		},
+		Spec: routeapi.RouteSpec{
+			Host: "host",
+			To: routeapi.RouteTargetReference{
+				Name: "blue",
+			},
+			AlternateBackends: []routeapi.RouteTargetReference{
+				{Name: "green", Weight: &weight},
+			},
+		},
+	}
+	suKey := "foo/blue"
+	router.CreateServiceUnit(suKey)
+	router.AddRoute(suKey, route, route.Spec.Host)
+
+	su, _ := router.FindServiceUnit(suKey)
+	saCfg := su.ServiceAliasConfigs[router.routeKey(route)]
+	expected := map[string]int32{"foo/blue": routeapi.DefaultBackendWeight, "foo/green": 20}
+	if !reflect.DeepEqual(saCfg.ServiceUnitNames, expected) {
+		t.Errorf("expected service units %v, got %v", expected, saCfg.ServiceUnitNames)
+	}
+}
+
+// TestAddRouteHealthCheck ensures the health check of a route is copied to its service alias config.
+func TestAddRouteHealthCheck(t *testing.T) {
+	router := newFakeTemplateRouter()
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: "foo",
+			Name:      "bar",
+		},
+		Spec: routeapi.RouteSpec{
+			Host: "host",
+			To: routeapi.RouteTargetReference{
+				Name: "baz",
+			},
+			HealthCheck: &routeapi.RouteHealthCheck{
+				Path:            "/healthz",
+				IntervalSeconds: 10,
+			},
+		},
+	}
+	suKey := "foo/baz"
+	router.CreateServiceUnit(suKey)
+	router.AddRoute(suKey, route, route.Spec.Host)
+
+	su, _ := router.FindServiceUnit(suKey)
+	saCfg := su.ServiceAliasConfigs[router.routeKey(route)]
+	if saCfg.HealthCheckPath != "/healthz" || saCfg.HealthCheckInterval != "10s" {
+		t.Errorf("expected health check /healthz every 10s, got %q every %q", saCfg.HealthCheckPath, saCfg.HealthCheckInterval)
+	}
+}
+
+// TestBackendEndpointsForAlias ensures that the weight of each service is
+// shared between its endpoints.
+func TestBackendEndpointsForAlias(t *testing.T) {
+	endpoints := func(ids ...string) []Endpoint {
+		list := []Endpoint{}
+		for _, id := range ids {
+			list = append(list, Endpoint{ID: id, IP: id, Port: "8080"})
+		}
+		return list
+	}
+	state := map[string]ServiceUnit{
+		"foo/blue":  {Name: "foo/blue", EndpointTable: endpoints("b1")},
+		"foo/green": {Name: "foo/green", EndpointTable: endpoints("g1", "g2", "g3", "g4")},
+		"foo/empty": {Name: "foo/empty"},
+	}
+
+	tests := []struct {
+		name     string
+		units    map[string]int32
+		expected map[string]int32
+	}{
+		{
+			name:     "state without service units",
+			expected: map[string]int32{"b1": 256},
+		},
+		{
+			name:     "single service",
+			units:    map[string]int32{"foo/blue": 100},
+			expected: map[string]int32{"b1": 256},
+		},
+		{
+			name:     "weights shared between endpoints",
+			units:    map[string]int32{"foo/blue": 20, "foo/green": 80},
+			expected: map[string]int32{"b1": 256, "g1": 256, "g2": 256, "g3": 256, "g4": 256},
+		},
+		{
+			name:     "service without requests",
+			units:    map[string]int32{"foo/blue": 100, "foo/green": 0},
+			expected: map[string]int32{"b1": 256, "g1": 0, "g2": 0, "g3": 0, "g4": 0},
+		},
+		{
+			name:     "service without endpoints",
+			units:    map[string]int32{"foo/green": 50, "foo/empty": 50},
+			expected: map[string]int32{"g1": 256, "g2": 256, "g3": 256, "g4": 256},
+		},
+		{
+			name:     "uneven weights",
+			units:    map[string]int32{"foo/blue": 90, "foo/green": 10},
+			expected: map[string]int32{"b1": 256, "g1": 8, "g2": 8, "g3": 8, "g4": 8},
+		},
+	}
+
+	for _, test := range tests {
+		alias := ServiceAliasConfig{ServiceUnitNames: test.units}
+		weights := map[string]int32{}
+		for _, endpoint := range backendEndpointsForAlias(alias, state["foo/blue"], state) {
+			weights[endpoint.ID] = endpoint.Weight
+		}
+		if !reflect.DeepEqual(weights, test.expected) {
+			t.Errorf("%s: expected weights %v, got %v", test.name, test.expected, weights)
+		}
+	}
+}
+
+// TestBackendEndpointsHostname ensures each endpoint carries the DNS name of its own service,
+// which the router verifies the certificates of re-encrypt endpoints against.
+func TestBackendEndpointsHostname(t *testing.T) {
+	state := map[string]ServiceUnit{
+		"foo/blue":  {Name: "foo/blue", EndpointTable: []Endpoint{{ID: "b1"}}},
+		"foo/green": {Name: "foo/green", EndpointTable: []Endpoint{{ID: "g1"}}},
+	}
+	alias := ServiceAliasConfig{ServiceUnitNames: map[string]int32{"foo/blue": 50, "foo/green": 50}}
+	hostnames := map[string]string{}
+	for _, endpoint := range backendEndpointsForAlias(alias, state["foo/blue"], state) {
+		hostnames[endpoint.ID] = endpoint.Hostname
+	}
+	expected := map[string]string{"b1": "blue.foo.svc", "g1": "green.foo.svc"}
+	if !reflect.DeepEqual(hostnames, expected) {
+		t.Errorf("expected hostnames %v, got %v", expected, hostnames)
+	}
+}
+
+// TestSortedServiceAliasConfigs ensures the routes of a host are ordered longest path first
+// so prefix matching picks the most specific route.
+func TestSortedServiceAliasConfigs(t *testing.T) {
+	state := map[string]ServiceUnit{
+		"foo/web": {
+			Name: "foo/web",
+			ServiceAliasConfigs: map[string]ServiceAliasConfig{
+				"foo_web":  {Host: "www.example.com"},
+				"foo_blog": {Host: "blog.example.com"},
+			},
+		},
+		"foo/api": {
+			Name: "foo/api",
+			ServiceAliasConfigs: map[string]ServiceAliasConfig{
+				"foo_api":    {Host: "www.example.com", Path: "/api"},
+				"foo_api-v2": {Host: "www.example.com", Path: "/api/v2"},
+				"foo_apps":   {Host: "www.example.com", Path: "/app"},
+			},
+		},
+	}
+
+	keys := []string{}
+	for _, entry := range sortedServiceAliasConfigs(state) {
+		keys = append(keys, entry.Key)
+	}
+	if expected := []string{"foo_blog", "foo_api-v2", "foo_api", "foo_apps", "foo_web"}; !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected routes in order %v, got %v", expected, keys)
+	}
+}
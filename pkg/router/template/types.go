@@ -37,6 +37,18 @@ type ServiceAliasConfig struct {
 	// insecure connections to an edge-terminated route:
 	//   none (or disable), allow or redirect
 	InsecureEdgeTerminationPolicy routeapi.InsecureEdgeTerminationPolicyType
+	// ServiceUnitNames are the keys of the service units backing the route, mapped to the
+	// relative share of the requests each of them should receive.
+	ServiceUnitNames map[string]int32
+	// HealthCheckPath is the path requested from the endpoints to check their health. If empty,
+	// endpoints are checked by opening a connection.
+	HealthCheckPath string
+	// HealthCheckInterval is the interval between two checks of an endpoint, e.g. 5000ms. If empty,
+	// the router default is used.
+	HealthCheckInterval string
+	// Annotations are the annotations of the route which tune its backend configuration. Only
+	// known annotations with valid values are included.
+	Annotations map[string]string
 }
 
 type ServiceAliasConfigStatus string
@@ -64,6 +76,22 @@ type Endpoint struct {
 	PortName   string
 }
 
+// BackendEndpoint is an endpoint of one of the services backing a route, along with the
+// weight it is given so that each service receives its share of the requests.
+type BackendEndpoint struct {
+	Endpoint
+	Weight int32
+	// Hostname is the DNS name of the service the endpoint belongs to, <name>.<namespace>.svc,
+	// which service serving certificates are issued for.
+	Hostname string
+}
+
+// ServiceAliasConfigEntry is a route along with the key it is stored under in its service unit.
+type ServiceAliasConfigEntry struct {
+	Key    string
+	Config ServiceAliasConfig
+}
+
 // certificateManager provides the ability to write certificates for a ServiceAliasConfig
 type certificateManager interface {
 	// WriteCertificatesForConfig writes all certificates for all ServiceAliasConfigs in config
@@ -0,0 +1,4 @@
+// Package servingcert contains the controller which provisions serving
+// certificates for services into secrets, so that the pods of a service can
+// serve TLS with a certificate trusted by the cluster.
+package servingcert
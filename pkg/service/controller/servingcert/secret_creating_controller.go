@@ -0,0 +1,212 @@
+package servingcert
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/cache"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/controller/framework"
+	"k8s.io/kubernetes/pkg/runtime"
+	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"github.com/openshift/origin/pkg/cmd/server/crypto"
+)
+
+const (
+	// ServingCertSecretAnnotation is the annotation on a service naming the secret the
+	// controller writes the serving certificate of the service to.
+	ServingCertSecretAnnotation = "service.alpha.openshift.io/serving-cert-secret-name"
+	// ServingCertCreatedByAnnotation is the annotation on a service and on its secret
+	// recording the name of the CA which signed the serving certificate.
+	ServingCertCreatedByAnnotation = "service.alpha.openshift.io/serving-cert-signed-by"
+	// ServingCertErrorAnnotation is the annotation on a service reporting why its serving
+	// certificate could not be created.
+	ServingCertErrorAnnotation = "service.alpha.openshift.io/serving-cert-generation-error"
+
+	// ServiceUIDAnnotation is the annotation on a secret recording the UID of the service
+	// the serving certificate was created for.
+	ServiceUIDAnnotation = "service.alpha.openshift.io/originating-service-uid"
+	// ServiceNameAnnotation is the annotation on a secret recording the name of the
+	// service the serving certificate was created for.
+	ServiceNameAnnotation = "service.alpha.openshift.io/originating-service-name"
+)
+
+// ServiceServingCertController creates a secret holding a serving certificate and
+// key for each service with the ServingCertSecretAnnotation. The certificate is
+// valid for the DNS names of the service, <name>.<namespace>.svc and
+// <name>.<namespace>.svc.<dnsSuffix>, and is signed by the service serving CA.
+type ServiceServingCertController struct {
+	client kclient.Interface
+
+	ca        *crypto.CA
+	dnsSuffix string
+
+	serviceController *framework.Controller
+	stopChan          chan struct{}
+}
+
+// NewServiceServingCertController returns a controller signing serving certificates with ca.
+func NewServiceServingCertController(client kclient.Interface, ca *crypto.CA, dnsSuffix string, resync time.Duration) *ServiceServingCertController {
+	c := &ServiceServingCertController{
+		client:    client,
+		ca:        ca,
+		dnsSuffix: dnsSuffix,
+	}
+
+	_, c.serviceController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+				return c.client.Services(kapi.NamespaceAll).List(options)
+			},
+			WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+				return c.client.Services(kapi.NamespaceAll).Watch(options)
+			},
+		},
+		&kapi.Service{},
+		resync,
+		framework.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				c.handleService(obj.(*kapi.Service))
+			},
+			UpdateFunc: func(_, obj interface{}) {
+				c.handleService(obj.(*kapi.Service))
+			},
+		},
+	)
+
+	return c
+}
+
+// Run starts the controller and returns immediately.
+func (c *ServiceServingCertController) Run() {
+	if c.stopChan == nil {
+		c.stopChan = make(chan struct{})
+		go c.serviceController.Run(c.stopChan)
+	}
+}
+
+// Stop gracefully shuts down the controller.
+func (c *ServiceServingCertController) Stop() {
+	if c.stopChan != nil {
+		close(c.stopChan)
+		c.stopChan = nil
+	}
+}
+
+func (c *ServiceServingCertController) handleService(service *kapi.Service) {
+	if err := c.syncService(service); err != nil {
+		utilruntime.HandleError(err)
+	}
+}
+
+// caName returns the name of the CA recorded on the services and secrets it signed.
+func (c *ServiceServingCertController) caName() string {
+	return c.ca.Config.Certs[0].Subject.CommonName
+}
+
+// syncService creates the serving certificate secret of service if it requests one and
+// records the result on the service.
+func (c *ServiceServingCertController) syncService(service *kapi.Service) error {
+	secretName := service.Annotations[ServingCertSecretAnnotation]
+	if len(secretName) == 0 {
+		return nil
+	}
+	if service.Annotations[ServingCertCreatedByAnnotation] == c.caName() {
+		return nil
+	}
+
+	secret, err := c.makeSecret(service, secretName)
+	if err != nil {
+		return c.recordError(service, err)
+	}
+	_, err = c.client.Secrets(service.Namespace).Create(secret)
+	if kapierrors.IsAlreadyExists(err) {
+		existing, getErr := c.client.Secrets(service.Namespace).Get(secretName)
+		if getErr != nil {
+			return getErr
+		}
+		if existing.Annotations[ServiceUIDAnnotation] != string(service.UID) {
+			return c.recordError(service, fmt.Errorf("secret/%s already exists for another service", secretName))
+		}
+		err = nil
+	}
+	if err != nil {
+		return c.recordError(service, err)
+	}
+
+	glog.V(4).Infof("Created the serving certificate secret %s/%s for service %s", service.Namespace, secretName, service.Name)
+	return c.updateService(service, func(annotations map[string]string) {
+		annotations[ServingCertCreatedByAnnotation] = c.caName()
+		delete(annotations, ServingCertErrorAnnotation)
+	})
+}
+
+// makeSecret returns a secret holding a new serving certificate for service.
+func (c *ServiceServingCertController) makeSecret(service *kapi.Service, secretName string) (*kapi.Secret, error) {
+	dnsName := fmt.Sprintf("%s.%s.svc", service.Name, service.Namespace)
+	hostnames := sets.NewString(dnsName)
+	if len(c.dnsSuffix) > 0 {
+		hostnames.Insert(dnsName + "." + c.dnsSuffix)
+	}
+	cert, err := c.ca.MakeServerCertInMemory(hostnames)
+	if err != nil {
+		return nil, err
+	}
+	certBytes, keyBytes, err := cert.GetPEMBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return &kapi.Secret{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: service.Namespace,
+			Name:      secretName,
+			Annotations: map[string]string{
+				ServiceUIDAnnotation:           string(service.UID),
+				ServiceNameAnnotation:          service.Name,
+				ServingCertCreatedByAnnotation: c.caName(),
+			},
+		},
+		Type: kapi.SecretTypeTLS,
+		Data: map[string][]byte{
+			kapi.TLSCertKey:       certBytes,
+			kapi.TLSPrivateKeyKey: keyBytes,
+		},
+	}, nil
+}
+
+// recordError reports err on service and returns it.
+func (c *ServiceServingCertController) recordError(service *kapi.Service, err error) error {
+	message := err.Error()
+	if service.Annotations[ServingCertErrorAnnotation] == message {
+		return err
+	}
+	if updateErr := c.updateService(service, func(annotations map[string]string) {
+		annotations[ServingCertErrorAnnotation] = message
+	}); updateErr != nil {
+		utilruntime.HandleError(updateErr)
+	}
+	return err
+}
+
+// updateService updates the annotations of a copy of service with fn.
+func (c *ServiceServingCertController) updateService(service *kapi.Service, fn func(map[string]string)) error {
+	obj, err := kapi.Scheme.Copy(service)
+	if err != nil {
+		return err
+	}
+	updated := obj.(*kapi.Service)
+	if updated.Annotations == nil {
+		updated.Annotations = map[string]string{}
+	}
+	fn(updated.Annotations)
+	_, err = c.client.Services(updated.Namespace).Update(updated)
+	return err
+}
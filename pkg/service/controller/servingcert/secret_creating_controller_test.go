@@ -0,0 +1,138 @@
+package servingcert
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/cmd/server/crypto"
+)
+
+func newTestCA(t *testing.T) (*crypto.CA, func()) {
+	dir, err := ioutil.TempDir("", "serving-cert-ca")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ca, err := crypto.MakeCA(filepath.Join(dir, "ca.crt"), filepath.Join(dir, "ca.key"), "", "service-signer")
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return ca, func() { os.RemoveAll(dir) }
+}
+
+func TestServiceServingCertControllerCreatesSecret(t *testing.T) {
+	ca, cleanup := newTestCA(t)
+	defer cleanup()
+
+	service := &kapi.Service{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace:   "ns",
+			Name:        "web",
+			UID:         "service-uid",
+			Annotations: map[string]string{ServingCertSecretAnnotation: "web-tls"},
+		},
+	}
+	client := ktestclient.NewSimpleFake(service)
+	client.PrependReactor("create", "secrets", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, action.(ktestclient.CreateAction).GetObject(), nil
+	})
+	controller := NewServiceServingCertController(client, ca, "cluster.local", 0)
+	if err := controller.syncService(service); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := client.Actions()
+	if len(actions) != 2 || !actions[0].Matches("create", "secrets") || !actions[1].Matches("update", "services") {
+		t.Fatalf("unexpected actions: %#v", actions)
+	}
+
+	secret := actions[0].(ktestclient.CreateAction).GetObject().(*kapi.Secret)
+	if secret.Namespace != "ns" || secret.Name != "web-tls" || secret.Type != kapi.SecretTypeTLS {
+		t.Errorf("unexpected secret: %#v", secret)
+	}
+	if secret.Annotations[ServiceUIDAnnotation] != "service-uid" || secret.Annotations[ServiceNameAnnotation] != "web" {
+		t.Errorf("unexpected secret annotations: %#v", secret.Annotations)
+	}
+	if len(secret.Data[kapi.TLSPrivateKeyKey]) == 0 {
+		t.Errorf("expected a private key in the secret")
+	}
+	block, _ := pem.Decode(secret.Data[kapi.TLSCertKey])
+	if block == nil {
+		t.Fatalf("expected a certificate in the secret")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []string{"web.ns.svc", "web.ns.svc.cluster.local"}; !reflect.DeepEqual(cert.DNSNames, expected) {
+		t.Errorf("expected DNS names %v, got %v", expected, cert.DNSNames)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.Config.Certs[0])
+	if _, err := cert.Verify(x509.VerifyOptions{DNSName: "web.ns.svc", Roots: roots}); err != nil {
+		t.Errorf("expected the certificate to be signed by the CA: %v", err)
+	}
+
+	updated := actions[1].(ktestclient.UpdateAction).GetObject().(*kapi.Service)
+	if updated.Annotations[ServingCertCreatedByAnnotation] != "service-signer" {
+		t.Errorf("expected the service to record its signer, got %#v", updated.Annotations)
+	}
+
+	// a service which already has a certificate from the CA is ignored
+	client.ClearActions()
+	if err := controller.syncService(updated); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actions := client.Actions(); len(actions) != 0 {
+		t.Errorf("unexpected actions: %#v", actions)
+	}
+}
+
+func TestServiceServingCertControllerSecretConflict(t *testing.T) {
+	ca, cleanup := newTestCA(t)
+	defer cleanup()
+
+	service := &kapi.Service{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace:   "ns",
+			Name:        "web",
+			UID:         "service-uid",
+			Annotations: map[string]string{ServingCertSecretAnnotation: "taken"},
+		},
+	}
+	secret := &kapi.Secret{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace:   "ns",
+			Name:        "taken",
+			Annotations: map[string]string{ServiceUIDAnnotation: "other-uid"},
+		},
+	}
+	client := ktestclient.NewSimpleFake(service, secret)
+	client.PrependReactor("create", "secrets", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, nil, kapierrors.NewAlreadyExists(kapi.Resource("secrets"), "taken")
+	})
+	controller := NewServiceServingCertController(client, ca, "cluster.local", 0)
+	if err := controller.syncService(service); err == nil {
+		t.Fatalf("expected an error for a secret owned by another service")
+	}
+
+	actions := client.Actions()
+	last := actions[len(actions)-1]
+	if !last.Matches("update", "services") {
+		t.Fatalf("expected the service to be updated, got %#v", actions)
+	}
+	updated := last.(ktestclient.UpdateAction).GetObject().(*kapi.Service)
+	if len(updated.Annotations[ServingCertErrorAnnotation]) == 0 || len(updated.Annotations[ServingCertCreatedByAnnotation]) != 0 {
+		t.Errorf("expected the service to report the error, got %#v", updated.Annotations)
+	}
+}
@@ -0,0 +1,167 @@
+package oauthclient
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrs "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/serviceaccount"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/authorization/authorizer/scope"
+	osclient "github.com/openshift/origin/pkg/client"
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+	"github.com/openshift/origin/pkg/oauth/api/validation"
+	"github.com/openshift/origin/pkg/oauth/registry/oauthclient"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+const (
+	// OAuthRedirectURIAnnotationPrefix is the prefix of the service account annotations whose values are
+	// redirect URIs of the service account when it acts as an OAuth client
+	OAuthRedirectURIAnnotationPrefix = "serviceaccounts.openshift.io/oauth-redirecturi."
+	// OAuthRedirectRouteAnnotationPrefix is the prefix of the service account annotations whose values are
+	// names of routes in the namespace of the service account. The URLs of the routes are redirect URIs of the
+	// service account when it acts as an OAuth client
+	OAuthRedirectRouteAnnotationPrefix = "serviceaccounts.openshift.io/oauth-redirectroute."
+)
+
+// saOAuthClientAdapter lets service accounts act as OAuth clients. The client ID of a service account is its
+// username (system:serviceaccount:<namespace>:<name>), its secret is one of its API tokens and its redirect URIs
+// come from its annotations. Other clients are handled by the delegate registry.
+type saOAuthClientAdapter struct {
+	oauthclient.Registry
+
+	saClient     kclient.ServiceAccountsNamespacer
+	secretClient kclient.SecretsNamespacer
+	routeClient  osclient.RoutesNamespacer
+}
+
+// NewServiceAccountOAuthClientRegistry returns an OAuth client registry that resolves the clients named after
+// service accounts annotated with redirect URIs, and passes everything else to delegate.
+func NewServiceAccountOAuthClientRegistry(delegate oauthclient.Registry, saClient kclient.ServiceAccountsNamespacer, secretClient kclient.SecretsNamespacer, routeClient osclient.RoutesNamespacer) oauthclient.Registry {
+	return &saOAuthClientAdapter{
+		Registry:     delegate,
+		saClient:     saClient,
+		secretClient: secretClient,
+		routeClient:  routeClient,
+	}
+}
+
+// IsServiceAccountClient returns true if clientName is the name of the OAuth client of a service account
+func IsServiceAccountClient(clientName string) bool {
+	_, _, err := serviceaccount.SplitUsername(clientName)
+	return err == nil
+}
+
+// GetClient returns the OAuth client of the service account with the username name, or the client from the
+// delegate registry for other names
+func (a *saOAuthClientAdapter) GetClient(ctx kapi.Context, name string) (*oauthapi.OAuthClient, error) {
+	namespace, saName, err := serviceaccount.SplitUsername(name)
+	if err != nil {
+		return a.Registry.GetClient(ctx, name)
+	}
+
+	sa, err := a.saClient.ServiceAccounts(namespace).Get(saName)
+	if err != nil {
+		return nil, err
+	}
+
+	redirectURIs, err := a.redirectURIs(sa)
+	if err != nil {
+		return nil, err
+	}
+	// Service accounts must opt in to being OAuth clients
+	if len(redirectURIs) == 0 {
+		return nil, kerrs.NewNotFound(oauthapi.Resource("oauthclients"), name)
+	}
+
+	secret, err := a.token(sa)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oauthapi.OAuthClient{
+		ObjectMeta:   kapi.ObjectMeta{Name: name},
+		Secret:       secret,
+		RedirectURIs: redirectURIs,
+		// Service accounts can only get tokens identifying the user and acting in their own namespace
+		ScopeRestrictions: []oauthapi.ScopeRestriction{
+			{ExactValues: []string{scope.UserInfo, scope.UserAccessCheck}},
+			{ClusterRole: &oauthapi.ClusterRoleScopeRestriction{RoleNames: []string{"*"}, Namespaces: []string{namespace}, AllowEscalation: true}},
+		},
+	}, nil
+}
+
+// redirectURIs returns the valid redirect URIs set in the annotations of sa, sorted
+func (a *saOAuthClientAdapter) redirectURIs(sa *kapi.ServiceAccount) ([]string, error) {
+	redirectURIs := sets.NewString()
+	for key, value := range sa.Annotations {
+		switch {
+		case strings.HasPrefix(key, OAuthRedirectURIAnnotationPrefix):
+			if ok, _ := validation.ValidateRedirectURI(value); ok && len(value) > 0 {
+				redirectURIs.Insert(value)
+			}
+
+		case strings.HasPrefix(key, OAuthRedirectRouteAnnotationPrefix):
+			route, err := a.routeClient.Routes(sa.Namespace).Get(value)
+			if kerrs.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			redirectURIs.Insert(routeURLs(route)...)
+		}
+	}
+	return redirectURIs.List(), nil
+}
+
+// token returns the first API token of sa
+func (a *saOAuthClientAdapter) token(sa *kapi.ServiceAccount) (string, error) {
+	for _, ref := range sa.Secrets {
+		secret, err := a.secretClient.Secrets(sa.Namespace).Get(ref.Name)
+		if kerrs.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		if serviceaccount.IsServiceAccountToken(secret, sa) && len(secret.Data[kapi.ServiceAccountTokenKey]) > 0 {
+			return string(secret.Data[kapi.ServiceAccountTokenKey]), nil
+		}
+	}
+	return "", fmt.Errorf("service account %s/%s has no API token to use as OAuth client secret", sa.Namespace, sa.Name)
+}
+
+// routeURLs returns the URLs under which route is exposed
+func routeURLs(route *routeapi.Route) []string {
+	hosts := []string{}
+	if len(route.Spec.Host) > 0 {
+		hosts = append(hosts, route.Spec.Host)
+	} else {
+		for _, ingress := range route.Status.Ingress {
+			hosts = append(hosts, ingress.Host)
+		}
+	}
+
+	scheme := "http"
+	if route.Spec.TLS != nil {
+		scheme = "https"
+	}
+
+	urls := []string{}
+	for _, host := range hosts {
+		if len(host) == 0 {
+			continue
+		}
+		u := url.URL{Scheme: scheme, Host: host, Path: route.Spec.Path}
+		urls = append(urls, u.String())
+	}
+	sort.Strings(urls)
+	return urls
+}
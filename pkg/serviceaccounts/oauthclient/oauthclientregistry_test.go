@@ -0,0 +1,124 @@
+package oauthclient
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrs "k8s.io/kubernetes/pkg/api/errors"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+	oauthtest "github.com/openshift/origin/pkg/oauth/registry/test"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+func TestGetClient(t *testing.T) {
+	tokenSecret := &kapi.Secret{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        "sa-token",
+			Namespace:   "ns",
+			Annotations: map[string]string{kapi.ServiceAccountNameKey: "sa", kapi.ServiceAccountUIDKey: "sa-uid"},
+		},
+		Type: kapi.SecretTypeServiceAccountToken,
+		Data: map[string][]byte{kapi.ServiceAccountTokenKey: []byte("token")},
+	}
+	otherSecret := &kapi.Secret{
+		ObjectMeta: kapi.ObjectMeta{Name: "other", Namespace: "ns"},
+		Data:       map[string][]byte{"key": []byte("value")},
+	}
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Name: "app", Namespace: "ns"},
+		Spec: routeapi.RouteSpec{
+			Host: "app.example.com",
+			Path: "/callback",
+			TLS:  &routeapi.TLSConfig{Termination: routeapi.TLSTerminationEdge},
+		},
+	}
+	makeSA := func(annotations map[string]string) *kapi.ServiceAccount {
+		return &kapi.ServiceAccount{
+			ObjectMeta: kapi.ObjectMeta{Name: "sa", Namespace: "ns", UID: "sa-uid", Annotations: annotations},
+			Secrets:    []kapi.ObjectReference{{Name: "other"}, {Name: "sa-token"}},
+		}
+	}
+
+	testCases := map[string]struct {
+		ClientName     string
+		KubeObjects    []runtime.Object
+		DelegateClient *oauthapi.OAuthClient
+
+		ExpectedClient *oauthapi.OAuthClient
+		ExpectNotFound bool
+		ExpectError    bool
+	}{
+		"delegates other clients": {
+			ClientName:     "openshift-web-console",
+			DelegateClient: &oauthapi.OAuthClient{ObjectMeta: kapi.ObjectMeta{Name: "openshift-web-console"}},
+			ExpectedClient: &oauthapi.OAuthClient{ObjectMeta: kapi.ObjectMeta{Name: "openshift-web-console"}},
+		},
+		"missing service account": {
+			ClientName:     "system:serviceaccount:ns:sa",
+			ExpectNotFound: true,
+		},
+		"service account without redirect URIs": {
+			ClientName:     "system:serviceaccount:ns:sa",
+			KubeObjects:    []runtime.Object{makeSA(map[string]string{"foo": "bar"}), tokenSecret, otherSecret},
+			ExpectNotFound: true,
+		},
+		"service account without token": {
+			ClientName:  "system:serviceaccount:ns:sa",
+			KubeObjects: []runtime.Object{makeSA(map[string]string{OAuthRedirectURIAnnotationPrefix + "one": "https://one.example.com"}), otherSecret},
+			ExpectError: true,
+		},
+		"service account with redirect URIs": {
+			ClientName: "system:serviceaccount:ns:sa",
+			KubeObjects: []runtime.Object{
+				makeSA(map[string]string{
+					OAuthRedirectURIAnnotationPrefix + "one":     "https://one.example.com",
+					OAuthRedirectURIAnnotationPrefix + "invalid": "https://invalid.example.com/../",
+					OAuthRedirectRouteAnnotationPrefix + "app":   "app",
+					OAuthRedirectRouteAnnotationPrefix + "gone":  "missing",
+				}),
+				tokenSecret,
+				otherSecret,
+			},
+			ExpectedClient: &oauthapi.OAuthClient{
+				ObjectMeta:   kapi.ObjectMeta{Name: "system:serviceaccount:ns:sa"},
+				Secret:       "token",
+				RedirectURIs: []string{"https://app.example.com/callback", "https://one.example.com"},
+				ScopeRestrictions: []oauthapi.ScopeRestriction{
+					{ExactValues: []string{"user:info", "user:check-access"}},
+					{ClusterRole: &oauthapi.ClusterRoleScopeRestriction{RoleNames: []string{"*"}, Namespaces: []string{"ns"}, AllowEscalation: true}},
+				},
+			},
+		},
+	}
+
+	for k, tc := range testCases {
+		delegate := &oauthtest.ClientRegistry{Client: tc.DelegateClient}
+		kubeClient := ktestclient.NewSimpleFake(tc.KubeObjects...)
+		registry := NewServiceAccountOAuthClientRegistry(delegate, kubeClient, kubeClient, testclient.NewSimpleFake(route))
+
+		client, err := registry.GetClient(kapi.NewContext(), tc.ClientName)
+		switch {
+		case tc.ExpectNotFound:
+			if !kerrs.IsNotFound(err) {
+				t.Errorf("%s: expected a not found error, got %v", k, err)
+			}
+			continue
+		case tc.ExpectError:
+			if err == nil {
+				t.Errorf("%s: expected an error", k)
+			}
+			continue
+		case err != nil:
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+		if !reflect.DeepEqual(client, tc.ExpectedClient) {
+			t.Errorf("%s: expected client %#v, got %#v", k, tc.ExpectedClient, client)
+		}
+	}
+}
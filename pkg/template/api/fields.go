@@ -9,3 +9,19 @@ func TemplateToSelectableFields(template *Template) fields.Set {
 		"metadata.name": template.Name,
 	}
 }
+
+// TemplateInstanceToSelectableFields returns a label set that represents the object
+// changes to the returned keys require registering conversions for existing versions using Scheme.AddFieldLabelConversionFunc
+func TemplateInstanceToSelectableFields(instance *TemplateInstance) fields.Set {
+	return fields.Set{
+		"metadata.name": instance.Name,
+	}
+}
+
+// TemplateRepositoryToSelectableFields returns a label set that represents the object
+// changes to the returned keys require registering conversions for existing versions using Scheme.AddFieldLabelConversionFunc
+func TemplateRepositoryToSelectableFields(repository *TemplateRepository) fields.Set {
+	return fields.Set{
+		"metadata.name": repository.Name,
+	}
+}
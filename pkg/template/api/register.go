@@ -30,8 +30,16 @@ func addKnownTypes(scheme *runtime.Scheme) {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Template{},
 		&TemplateList{},
+		&TemplateInstance{},
+		&TemplateInstanceList{},
+		&TemplateRepository{},
+		&TemplateRepositoryList{},
 	)
 }
 
-func (obj *Template) GetObjectKind() unversioned.ObjectKind     { return &obj.TypeMeta }
-func (obj *TemplateList) GetObjectKind() unversioned.ObjectKind { return &obj.TypeMeta }
+func (obj *Template) GetObjectKind() unversioned.ObjectKind               { return &obj.TypeMeta }
+func (obj *TemplateList) GetObjectKind() unversioned.ObjectKind           { return &obj.TypeMeta }
+func (obj *TemplateInstance) GetObjectKind() unversioned.ObjectKind       { return &obj.TypeMeta }
+func (obj *TemplateInstanceList) GetObjectKind() unversioned.ObjectKind   { return &obj.TypeMeta }
+func (obj *TemplateRepository) GetObjectKind() unversioned.ObjectKind     { return &obj.TypeMeta }
+func (obj *TemplateRepositoryList) GetObjectKind() unversioned.ObjectKind { return &obj.TypeMeta }
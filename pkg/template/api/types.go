@@ -21,8 +21,80 @@ type Template struct {
 	// Optional: ObjectLabels is a set of labels that are applied to every
 	// object during the Template to Config transformation
 	ObjectLabels map[string]string
+
+	// Optional: ObjectAnnotations is a set of annotations that are applied to
+	// every object during the Template to Config transformation
+	ObjectAnnotations map[string]string
+
+	// Optional: Includes is a list of other Templates whose objects and
+	// parameters are merged into this Template during the Template to Config
+	// transformation. Parameters declared in this Template take precedence
+	// over the parameters declared in the included Templates.
+	Includes []TemplateInclude
+
+	// Optional: Message is an informational message displayed to the user
+	// once the Template is instantiated, eg. usage instructions or generated
+	// credentials. It may reference parameters, which are substituted during
+	// the Template to Config transformation.
+	Message string
+}
+
+// TemplateInclude references a Template included in another Template.
+type TemplateInclude struct {
+	// Required: Name of the included Template.
+	Name string
+
+	// Optional: Namespace of the included Template. Defaults to the namespace
+	// of the including Template.
+	Namespace string
 }
 
+const (
+	// CountAnnotation on a Template object requests the object to be
+	// replicated the given number of times during the Template to Config
+	// transformation. The value may reference parameters, eg. "${REPLICAS}".
+	CountAnnotation = "template.alpha.openshift.io/count"
+
+	// MaxCount is the largest number of copies of an object CountAnnotation
+	// may request.
+	MaxCount = 1000
+
+	// CountIndexParameter is the name of the parameter holding the zero based
+	// index of each copy of an object replicated through CountAnnotation.
+	CountIndexParameter = "TEMPLATE_INDEX"
+
+	// TemplateInstanceLabel is added to every object created by an
+	// instantiation of a Template recorded by a TemplateInstance and holds
+	// the name of that TemplateInstance.
+	TemplateInstanceLabel = "template.openshift.io/instance"
+
+	// ExcludePathsAnnotation on a Template lists, one per line, regular
+	// expressions matching the paths of the object fields parameters must not
+	// be substituted into, eg. "metadata.annotations.prometheus.io/.*". Paths
+	// are made of the field names and map keys separated by dots, with slice
+	// elements identified by their index, eg. "spec.ports[0].name".
+	ExcludePathsAnnotation = "template.alpha.openshift.io/exclude-paths"
+
+	// TemplateVersionAnnotation on a Template holds the version of the
+	// Template, so that an application instantiated from it can be upgraded
+	// to a later version.
+	TemplateVersionAnnotation = "template.openshift.io/version"
+
+	// TemplateRepositoryLabel is set on the Templates synchronized from a
+	// template repository to the name of that repository, so that the
+	// Templates it manages can be told apart from the ones created by hand.
+	TemplateRepositoryLabel = "template.openshift.io/repository"
+
+	// TemplateCategoryLabelPrefix prefixes the labels set to "true" on a
+	// Template for each category it belongs to, eg.
+	// "category.template.openshift.io/database".
+	TemplateCategoryLabelPrefix = "category.template.openshift.io/"
+
+	// RedactedParameterValue replaces the value of a sensitive Parameter
+	// wherever the Template is shown once processed.
+	RedactedParameterValue = "<redacted>"
+)
+
 // TemplateList is a list of Template objects.
 type TemplateList struct {
 	unversioned.TypeMeta
@@ -30,6 +102,80 @@ type TemplateList struct {
 	Items []Template
 }
 
+// TemplateInstance records an instantiation of a Template. The objects created
+// by the instantiation are labeled with TemplateInstanceLabel set to the name
+// of the TemplateInstance, and they are deleted together with it.
+type TemplateInstance struct {
+	unversioned.TypeMeta
+	kapi.ObjectMeta
+
+	// Optional: Template is the name of the instantiated Template.
+	Template string
+
+	// Required: Objects references the objects created by the instantiation,
+	// in the namespace of the TemplateInstance.
+	Objects []kapi.ObjectReference
+}
+
+// TemplateInstanceList is a list of TemplateInstance objects.
+type TemplateInstanceList struct {
+	unversioned.TypeMeta
+	unversioned.ListMeta
+	Items []TemplateInstance
+}
+
+// TemplateRepository publishes Templates that the template repository
+// controller synchronizes into the namespace of the TemplateRepository. The
+// synchronized Templates are labeled with TemplateRepositoryLabel set to the
+// name of the TemplateRepository.
+type TemplateRepository struct {
+	unversioned.TypeMeta
+	kapi.ObjectMeta
+
+	// Spec describes where the Templates are published.
+	Spec TemplateRepositorySpec
+
+	// Status describes the last synchronization.
+	Status TemplateRepositoryStatus
+}
+
+// TemplateRepositorySpec describes where the Templates of a
+// TemplateRepository are published. Exactly one of Git and Index is set.
+type TemplateRepositorySpec struct {
+	// Git is the URL of a Git repository holding the Templates, which belong
+	// to the categories named after the directories holding them.
+	Git string
+
+	// Index is the URL of an HTTP index listing the Templates and their
+	// categories.
+	Index string
+
+	// Prune deletes the synchronized Templates the repository no longer
+	// publishes.
+	Prune bool
+}
+
+// TemplateRepositoryStatus describes the last synchronization of a
+// TemplateRepository.
+type TemplateRepositoryStatus struct {
+	// LastSyncTime is the time of the last synchronization.
+	LastSyncTime *unversioned.Time
+
+	// Templates are the names of the Templates synchronized from the
+	// repository.
+	Templates []string
+
+	// Message describes why the last synchronization failed, if it did.
+	Message string
+}
+
+// TemplateRepositoryList is a list of TemplateRepository objects.
+type TemplateRepositoryList struct {
+	unversioned.TypeMeta
+	unversioned.ListMeta
+	Items []TemplateRepository
+}
+
 // Parameter defines a name/value variable that is to be processed during
 // the Template to Config transformation.
 type Parameter struct {
@@ -45,7 +191,8 @@ type Parameter struct {
 
 	// Optional: Value holds the Parameter data. If specified, the generator
 	// will be ignored. The value replaces all occurrences of the Parameter
-	// ${Name} expression during the Template to Config transformation.
+	// ${Name} expression during the Template to Config transformation. The
+	// value may reference other parameters using the ${Name} expression.
 	Value string
 
 	// Optional: Generate specifies the generator to be used to generate
@@ -57,6 +204,75 @@ type Parameter struct {
 	// Optional: From is an input value for the generator.
 	From string
 
+	// Optional: FromEnv is the name of the environment variable the Parameter
+	// value is read from when it is not set and the Template is processed
+	// with environment lookups enabled. The variable takes precedence over
+	// the generator.
+	FromEnv string
+
 	// Optional: Indicates the parameter must have a value.  Defaults to false.
 	Required bool
+
+	// Optional: Type is the type the Parameter value must conform to. The
+	// value is validated against the type before it is substituted. Defaults
+	// to ParameterTypeString.
+	Type ParameterType
+
+	// Optional: Allowed is the list of values permitted for a Parameter of
+	// the ParameterTypeEnum type.
+	Allowed []string
+
+	// Optional: Sensitive indicates that the Parameter value must not be
+	// disclosed. The value is substituted as usual, but it is replaced with
+	// RedactedParameterValue in the processed Template returned to the
+	// client and in the logged errors.
+	Sensitive bool
+
+	// Optional: GeneratorOptions configures the generator selected by
+	// Generate, for the generators that support it. It lets the Template
+	// author control the generated value, eg. the policy of a password,
+	// without changing the set of generators of the server.
+	GeneratorOptions *GeneratorOptions
 }
+
+// ParameterType is the type of a Parameter value.
+type ParameterType string
+
+const (
+	// ParameterTypeString accepts any value.
+	ParameterTypeString ParameterType = "string"
+	// ParameterTypeInt accepts only base 10 integer values.
+	ParameterTypeInt ParameterType = "int"
+	// ParameterTypeBool accepts only "true" or "false".
+	ParameterTypeBool ParameterType = "bool"
+	// ParameterTypeEnum accepts only the values listed in Parameter.Allowed.
+	ParameterTypeEnum ParameterType = "enum"
+)
+
+// GeneratorOptions configures the value generated for a Parameter.
+type GeneratorOptions struct {
+	// Optional: Charset is the set of characters the generated value is made
+	// of. It may contain ranges, eg. "a-z", and the \w, \d, \a and \A classes
+	// of the expression generator. A literal '-' or '\' must be escaped with
+	// '\'. Defaults to \a, letters and digits.
+	Charset string
+
+	// Optional: Exclude lists the characters removed from Charset.
+	Exclude string
+
+	// Optional: ExcludeAmbiguous removes the characters that are easily
+	// confused with each other, see AmbiguousCharacters, from Charset.
+	ExcludeAmbiguous bool
+
+	// Optional: MinLength is the minimum length of the generated value.
+	MinLength int
+
+	// Optional: MaxLength is the maximum length of the generated value. The
+	// length is chosen randomly between MinLength and MaxLength. Defaults
+	// to MinLength.
+	MaxLength int
+}
+
+// AmbiguousCharacters are the characters removed from the charset of a
+// generator when GeneratorOptions.ExcludeAmbiguous is set.
+const AmbiguousCharacters = "0O1lI|"
@@ -55,4 +55,15 @@ func addConversionFuncs(scheme *runtime.Scheme) {
 	); err != nil {
 		panic(err)
 	}
+
+	if err := scheme.AddFieldLabelConversionFunc("v1", "TemplateInstance",
+		oapi.GetFieldLabelConversionFunc(newer.TemplateInstanceToSelectableFields(&newer.TemplateInstance{}), nil),
+	); err != nil {
+		panic(err)
+	}
+	if err := scheme.AddFieldLabelConversionFunc("v1", "TemplateRepository",
+		oapi.GetFieldLabelConversionFunc(newer.TemplateRepositoryToSelectableFields(&newer.TemplateRepository{}), nil),
+	); err != nil {
+		panic(err)
+	}
 }
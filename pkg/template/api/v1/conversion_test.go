@@ -15,4 +15,12 @@ func TestFieldSelectorConversions(t *testing.T) {
 		// Ensure all currently returned labels are supported
 		api.TemplateToSelectableFields(&api.Template{}),
 	)
+	testutil.CheckFieldLabelConversions(t, "v1", "TemplateInstance",
+		// Ensure all currently returned labels are supported
+		api.TemplateInstanceToSelectableFields(&api.TemplateInstance{}),
+	)
+	testutil.CheckFieldLabelConversions(t, "v1", "TemplateRepository",
+		// Ensure all currently returned labels are supported
+		api.TemplateRepositoryToSelectableFields(&api.TemplateRepository{}),
+	)
 }
@@ -20,11 +20,19 @@ func addKnownTypes(scheme *runtime.Scheme) {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Template{},
 		&TemplateList{},
+		&TemplateInstance{},
+		&TemplateInstanceList{},
+		&TemplateRepository{},
+		&TemplateRepositoryList{},
 	)
 
 	scheme.AddKnownTypeWithName(SchemeGroupVersion.WithKind("TemplateConfig"), &Template{})
 	scheme.AddKnownTypeWithName(SchemeGroupVersion.WithKind("ProcessedTemplate"), &Template{})
 }
 
-func (obj *Template) GetObjectKind() unversioned.ObjectKind     { return &obj.TypeMeta }
-func (obj *TemplateList) GetObjectKind() unversioned.ObjectKind { return &obj.TypeMeta }
+func (obj *Template) GetObjectKind() unversioned.ObjectKind               { return &obj.TypeMeta }
+func (obj *TemplateList) GetObjectKind() unversioned.ObjectKind           { return &obj.TypeMeta }
+func (obj *TemplateInstance) GetObjectKind() unversioned.ObjectKind       { return &obj.TypeMeta }
+func (obj *TemplateInstanceList) GetObjectKind() unversioned.ObjectKind   { return &obj.TypeMeta }
+func (obj *TemplateRepository) GetObjectKind() unversioned.ObjectKind     { return &obj.TypeMeta }
+func (obj *TemplateRepositoryList) GetObjectKind() unversioned.ObjectKind { return &obj.TypeMeta }
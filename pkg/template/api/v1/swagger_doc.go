@@ -5,15 +5,33 @@ package v1
 // by hack/update-generated-swagger-descriptions.sh and should be run after a full build of OpenShift.
 // ==== DO NOT EDIT THIS FILE MANUALLY ====
 
+var map_GeneratorOptions = map[string]string{
+	"":                 "GeneratorOptions configures the value generated for a parameter.",
+	"charset":          "Charset is the set of characters the generated value is made of. It may contain ranges, eg. \"a-z\", and the character classes of the expression generator. A literal dash or backslash must be escaped with a backslash. Defaults to letters and digits. Optional.",
+	"exclude":          "Exclude lists the characters removed from the charset. Optional.",
+	"excludeAmbiguous": "ExcludeAmbiguous removes the characters that are easily confused with each other, \"0O1lI|\", from the charset. Optional.",
+	"minLength":        "MinLength is the minimum length of the generated value. Optional.",
+	"maxLength":        "MaxLength is the maximum length of the generated value. The length is chosen randomly between minLength and maxLength. Defaults to minLength. Optional.",
+}
+
+func (GeneratorOptions) SwaggerDoc() map[string]string {
+	return map_GeneratorOptions
+}
+
 var map_Parameter = map[string]string{
-	"":            "Parameter defines a name/value variable that is to be processed during the Template to Config transformation.",
-	"name":        "Name must be set and it can be referenced in Template Items using ${PARAMETER_NAME}. Required.",
-	"displayName": "Optional: The name that will show in UI instead of parameter 'Name'",
-	"description": "Description of a parameter. Optional.",
-	"value":       "Value holds the Parameter data. If specified, the generator will be ignored. The value replaces all occurrences of the Parameter ${Name} expression during the Template to Config transformation. Optional.",
-	"generate":    "Generate specifies the generator to be used to generate random string from an input value specified by From field. The result string is stored into Value field. If empty, no generator is being used, leaving the result Value untouched. Optional.",
-	"from":        "From is an input value for the generator. Optional.",
-	"required":    "Optional: Indicates the parameter must have a value.  Defaults to false.",
+	"":                 "Parameter defines a name/value variable that is to be processed during the Template to Config transformation.",
+	"name":             "Name must be set and it can be referenced in Template Items using ${PARAMETER_NAME}. Required.",
+	"displayName":      "Optional: The name that will show in UI instead of parameter 'Name'",
+	"description":      "Description of a parameter. Optional.",
+	"value":            "Value holds the Parameter data. If specified, the generator will be ignored. The value replaces all occurrences of the Parameter ${Name} expression during the Template to Config transformation. The value may reference other parameters using the ${Name} expression. Optional.",
+	"generate":         "Generate specifies the generator to be used to generate random string from an input value specified by From field. The result string is stored into Value field. If empty, no generator is being used, leaving the result Value untouched. Optional.",
+	"from":             "From is an input value for the generator. Optional.",
+	"fromEnv":          "FromEnv is the name of the environment variable the parameter value is read from when it is not set and the template is processed with environment lookups enabled. The variable takes precedence over the generator. Optional.",
+	"required":         "Optional: Indicates the parameter must have a value.  Defaults to false.",
+	"type":             "Type is the type the parameter value must conform to. One of \"string\", \"int\", \"bool\" or \"enum\". Defaults to \"string\". Optional.",
+	"allowed":          "Allowed is the list of values permitted for a parameter of the \"enum\" type. Optional.",
+	"sensitive":        "Sensitive indicates that the parameter value must not be disclosed. The value is substituted as usual, but it is replaced with \"<redacted>\" in the processed template returned to the client. Optional.",
+	"generatorOptions": "GeneratorOptions configures the generator selected by Generate, for the generators that support it, eg. \"charset\". Optional.",
 }
 
 func (Parameter) SwaggerDoc() map[string]string {
@@ -21,17 +39,51 @@ func (Parameter) SwaggerDoc() map[string]string {
 }
 
 var map_Template = map[string]string{
-	"":           "Template contains the inputs needed to produce a Config.",
-	"metadata":   "Standard object's metadata.",
-	"objects":    "Objects is an array of objects to include in this template. Required.",
-	"parameters": "Optional: Parameters is an array of Parameters used during the Template to Config transformation.",
-	"labels":     "Labels is a set of labels that are applied to every object during the Template to Config transformation. Optional",
+	"":                  "Template contains the inputs needed to produce a Config.",
+	"metadata":          "Standard object's metadata.",
+	"objects":           "Objects is an array of objects to include in this template. Required.",
+	"parameters":        "Optional: Parameters is an array of Parameters used during the Template to Config transformation.",
+	"labels":            "Labels is a set of labels that are applied to every object during the Template to Config transformation. Optional",
+	"objectAnnotations": "ObjectAnnotations is a set of annotations that are applied to every object during the Template to Config transformation. Optional.",
+	"includes":          "Includes is a list of other templates whose objects and parameters are merged into this template during the Template to Config transformation. Parameters declared in this template take precedence over the parameters declared in the included templates. Optional.",
+	"message":           "Message is an informational message displayed to the user once the template is instantiated, such as usage instructions or generated credentials. Parameter references are substituted in the message during the Template to Config transformation. Optional.",
 }
 
 func (Template) SwaggerDoc() map[string]string {
 	return map_Template
 }
 
+var map_TemplateInclude = map[string]string{
+	"":          "TemplateInclude references a template included in another template.",
+	"name":      "Name of the included template. Required.",
+	"namespace": "Namespace of the included template. Defaults to the namespace of the including template. Optional.",
+}
+
+func (TemplateInclude) SwaggerDoc() map[string]string {
+	return map_TemplateInclude
+}
+
+var map_TemplateInstance = map[string]string{
+	"":         "TemplateInstance records an instantiation of a template. The objects created by the instantiation are labeled with template.openshift.io/instance set to the name of the template instance, and they are deleted together with it.",
+	"metadata": "Standard object's metadata.",
+	"template": "Template is the name of the instantiated template. Optional.",
+	"objects":  "Objects references the objects created by the instantiation, in the namespace of the template instance. Required.",
+}
+
+func (TemplateInstance) SwaggerDoc() map[string]string {
+	return map_TemplateInstance
+}
+
+var map_TemplateInstanceList = map[string]string{
+	"":         "TemplateInstanceList is a list of TemplateInstance objects.",
+	"metadata": "Standard object's metadata.",
+	"items":    "Items is a list of template instances",
+}
+
+func (TemplateInstanceList) SwaggerDoc() map[string]string {
+	return map_TemplateInstanceList
+}
+
 var map_TemplateList = map[string]string{
 	"":         "TemplateList is a list of Template objects.",
 	"metadata": "Standard object's metadata.",
@@ -41,3 +93,46 @@ var map_TemplateList = map[string]string{
 func (TemplateList) SwaggerDoc() map[string]string {
 	return map_TemplateList
 }
+
+var map_TemplateRepository = map[string]string{
+	"":         "TemplateRepository publishes templates that are synchronized into the namespace of the template repository. The synchronized templates are labeled with template.openshift.io/repository set to the name of the template repository.",
+	"metadata": "Standard object's metadata.",
+	"spec":     "Spec describes where the templates are published.",
+	"status":   "Status describes the last synchronization.",
+}
+
+func (TemplateRepository) SwaggerDoc() map[string]string {
+	return map_TemplateRepository
+}
+
+var map_TemplateRepositoryList = map[string]string{
+	"":         "TemplateRepositoryList is a list of TemplateRepository objects.",
+	"metadata": "Standard object's metadata.",
+	"items":    "Items is a list of template repositories",
+}
+
+func (TemplateRepositoryList) SwaggerDoc() map[string]string {
+	return map_TemplateRepositoryList
+}
+
+var map_TemplateRepositorySpec = map[string]string{
+	"":      "TemplateRepositorySpec describes where the templates of a template repository are published. Exactly one of git and index is set.",
+	"git":   "Git is the URL of a Git repository holding the templates, which belong to the categories named after the directories holding them.",
+	"index": "Index is the URL of an HTTP index listing the templates and their categories.",
+	"prune": "Prune deletes the synchronized templates the repository no longer publishes.",
+}
+
+func (TemplateRepositorySpec) SwaggerDoc() map[string]string {
+	return map_TemplateRepositorySpec
+}
+
+var map_TemplateRepositoryStatus = map[string]string{
+	"":             "TemplateRepositoryStatus describes the last synchronization of a template repository.",
+	"lastSyncTime": "LastSyncTime is the time of the last synchronization.",
+	"templates":    "Templates are the names of the templates synchronized from the repository.",
+	"message":      "Message describes why the last synchronization failed, if it did.",
+}
+
+func (TemplateRepositoryStatus) SwaggerDoc() map[string]string {
+	return map_TemplateRepositoryStatus
+}
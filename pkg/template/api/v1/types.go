@@ -22,6 +22,32 @@ type Template struct {
 	// Labels is a set of labels that are applied to every
 	// object during the Template to Config transformation. Optional
 	Labels map[string]string `json:"labels,omitempty"`
+
+	// ObjectAnnotations is a set of annotations that are applied to every
+	// object during the Template to Config transformation. Optional.
+	ObjectAnnotations map[string]string `json:"objectAnnotations,omitempty"`
+
+	// Includes is a list of other templates whose objects and parameters are
+	// merged into this template during the Template to Config transformation.
+	// Parameters declared in this template take precedence over the parameters
+	// declared in the included templates. Optional.
+	Includes []TemplateInclude `json:"includes,omitempty"`
+
+	// Message is an informational message displayed to the user once the
+	// template is instantiated, such as usage instructions or generated
+	// credentials. Parameter references are substituted in the message
+	// during the Template to Config transformation. Optional.
+	Message string `json:"message,omitempty"`
+}
+
+// TemplateInclude references a template included in another template.
+type TemplateInclude struct {
+	// Name of the included template. Required.
+	Name string `json:"name"`
+
+	// Namespace of the included template. Defaults to the namespace of the
+	// including template. Optional.
+	Namespace string `json:"namespace,omitempty"`
 }
 
 // TemplateList is a list of Template objects.
@@ -34,6 +60,88 @@ type TemplateList struct {
 	Items []Template `json:"items"`
 }
 
+// TemplateInstance records an instantiation of a template. The objects created
+// by the instantiation are labeled with template.openshift.io/instance set to
+// the name of the template instance, and they are deleted together with it.
+type TemplateInstance struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	kapi.ObjectMeta `json:"metadata,omitempty"`
+
+	// Template is the name of the instantiated template. Optional.
+	Template string `json:"template,omitempty"`
+
+	// Objects references the objects created by the instantiation, in the
+	// namespace of the template instance. Required.
+	Objects []kapi.ObjectReference `json:"objects"`
+}
+
+// TemplateInstanceList is a list of TemplateInstance objects.
+type TemplateInstanceList struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of template instances
+	Items []TemplateInstance `json:"items"`
+}
+
+// TemplateRepository publishes templates that are synchronized into the
+// namespace of the template repository. The synchronized templates are labeled
+// with template.openshift.io/repository set to the name of the template
+// repository.
+type TemplateRepository struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	kapi.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec describes where the templates are published.
+	Spec TemplateRepositorySpec `json:"spec"`
+
+	// Status describes the last synchronization.
+	Status TemplateRepositoryStatus `json:"status,omitempty"`
+}
+
+// TemplateRepositorySpec describes where the templates of a template
+// repository are published. Exactly one of git and index is set.
+type TemplateRepositorySpec struct {
+	// Git is the URL of a Git repository holding the templates, which belong
+	// to the categories named after the directories holding them.
+	Git string `json:"git,omitempty"`
+
+	// Index is the URL of an HTTP index listing the templates and their
+	// categories.
+	Index string `json:"index,omitempty"`
+
+	// Prune deletes the synchronized templates the repository no longer
+	// publishes.
+	Prune bool `json:"prune,omitempty"`
+}
+
+// TemplateRepositoryStatus describes the last synchronization of a template
+// repository.
+type TemplateRepositoryStatus struct {
+	// LastSyncTime is the time of the last synchronization.
+	LastSyncTime *unversioned.Time `json:"lastSyncTime,omitempty"`
+
+	// Templates are the names of the templates synchronized from the
+	// repository.
+	Templates []string `json:"templates,omitempty"`
+
+	// Message describes why the last synchronization failed, if it did.
+	Message string `json:"message,omitempty"`
+}
+
+// TemplateRepositoryList is a list of TemplateRepository objects.
+type TemplateRepositoryList struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of template repositories
+	Items []TemplateRepository `json:"items"`
+}
+
 // Parameter defines a name/value variable that is to be processed during
 // the Template to Config transformation.
 type Parameter struct {
@@ -49,7 +157,8 @@ type Parameter struct {
 
 	// Value holds the Parameter data. If specified, the generator will be
 	// ignored. The value replaces all occurrences of the Parameter ${Name}
-	// expression during the Template to Config transformation. Optional.
+	// expression during the Template to Config transformation. The value may
+	// reference other parameters using the ${Name} expression. Optional.
 	Value string `json:"value,omitempty"`
 
 	// Generate specifies the generator to be used to generate random string
@@ -61,6 +170,67 @@ type Parameter struct {
 	// From is an input value for the generator. Optional.
 	From string `json:"from,omitempty"`
 
+	// FromEnv is the name of the environment variable the parameter value is
+	// read from when it is not set and the template is processed with
+	// environment lookups enabled. The variable takes precedence over the
+	// generator. Optional.
+	FromEnv string `json:"fromEnv,omitempty"`
+
 	// Optional: Indicates the parameter must have a value.  Defaults to false.
 	Required bool `json:"required,omitempty"`
+
+	// Type is the type the parameter value must conform to. One of "string",
+	// "int", "bool" or "enum". Defaults to "string". Optional.
+	Type ParameterType `json:"type,omitempty"`
+
+	// Allowed is the list of values permitted for a parameter of the "enum"
+	// type. Optional.
+	Allowed []string `json:"allowed,omitempty"`
+
+	// Sensitive indicates that the parameter value must not be disclosed. The
+	// value is substituted as usual, but it is replaced with "<redacted>" in
+	// the processed template returned to the client. Optional.
+	Sensitive bool `json:"sensitive,omitempty"`
+
+	// GeneratorOptions configures the generator selected by Generate, for
+	// the generators that support it, eg. "charset". Optional.
+	GeneratorOptions *GeneratorOptions `json:"generatorOptions,omitempty"`
+}
+
+// ParameterType is the type of a Parameter value.
+type ParameterType string
+
+const (
+	// ParameterTypeString accepts any value.
+	ParameterTypeString ParameterType = "string"
+	// ParameterTypeInt accepts only base 10 integer values.
+	ParameterTypeInt ParameterType = "int"
+	// ParameterTypeBool accepts only "true" or "false".
+	ParameterTypeBool ParameterType = "bool"
+	// ParameterTypeEnum accepts only the values listed in Parameter.Allowed.
+	ParameterTypeEnum ParameterType = "enum"
+)
+
+// GeneratorOptions configures the value generated for a parameter.
+type GeneratorOptions struct {
+	// Charset is the set of characters the generated value is made of. It
+	// may contain ranges, eg. "a-z", and the character classes of the
+	// expression generator. A literal dash or backslash must be escaped with
+	// a backslash. Defaults to letters and digits. Optional.
+	Charset string `json:"charset,omitempty"`
+
+	// Exclude lists the characters removed from the charset. Optional.
+	Exclude string `json:"exclude,omitempty"`
+
+	// ExcludeAmbiguous removes the characters that are easily confused with
+	// each other, "0O1lI|", from the charset. Optional.
+	ExcludeAmbiguous bool `json:"excludeAmbiguous,omitempty"`
+
+	// MinLength is the minimum length of the generated value. Optional.
+	MinLength int `json:"minLength,omitempty"`
+
+	// MaxLength is the maximum length of the generated value. The length is
+	// chosen randomly between minLength and maxLength. Defaults to
+	// minLength. Optional.
+	MaxLength int `json:"maxLength,omitempty"`
 }
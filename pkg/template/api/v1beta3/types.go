@@ -21,6 +21,29 @@ type Template struct {
 	// Optional: Labels is a set of labels that are applied to every
 	// object during the Template to Config transformation
 	Labels map[string]string `json:"labels,omitempty"`
+
+	// Optional: ObjectAnnotations is a set of annotations that are applied to
+	// every object during the Template to Config transformation
+	ObjectAnnotations map[string]string `json:"objectAnnotations,omitempty"`
+
+	// Optional: Includes is a list of other Templates whose objects and
+	// parameters are merged into this Template during the Template to Config
+	// transformation.
+	Includes []TemplateInclude `json:"includes,omitempty"`
+
+	// Optional: Message is an informational message displayed to the user
+	// once the Template is instantiated. Parameter references are substituted
+	// in the message during the Template to Config transformation.
+	Message string `json:"message,omitempty"`
+}
+
+// TemplateInclude references a Template included in another Template.
+type TemplateInclude struct {
+	// Required: Name of the included Template.
+	Name string `json:"name"`
+
+	// Optional: Namespace of the included Template.
+	Namespace string `json:"namespace,omitempty"`
 }
 
 // TemplateList is a list of Template objects.
@@ -57,6 +80,56 @@ type Parameter struct {
 	// Optional: From is an input value for the generator.
 	From string `json:"from,omitempty"`
 
+	// Optional: FromEnv is the name of the environment variable the Parameter
+	// value is read from when it is not set and the Template is processed
+	// with environment lookups enabled. The variable takes precedence over
+	// the generator.
+	FromEnv string `json:"fromEnv,omitempty"`
+
 	// Optional: Indicates the parameter must have a value.  Defaults to false.
 	Required bool `json:"required,omitempty"`
+
+	// Optional: Type is the type the Parameter value must conform to. One of
+	// "string", "int", "bool" or "enum". Defaults to "string".
+	Type ParameterType `json:"type,omitempty"`
+
+	// Optional: Allowed is the list of values permitted for a Parameter of
+	// the "enum" type.
+	Allowed []string `json:"allowed,omitempty"`
+
+	// Optional: Sensitive indicates that the Parameter value must not be
+	// disclosed. The value is substituted as usual, but it is replaced with
+	// "<redacted>" in the processed Template returned to the client.
+	Sensitive bool `json:"sensitive,omitempty"`
+
+	// Optional: GeneratorOptions configures the generator selected by
+	// Generate, for the generators that support it, eg. "charset".
+	GeneratorOptions *GeneratorOptions `json:"generatorOptions,omitempty"`
+}
+
+// ParameterType is the type of a Parameter value.
+type ParameterType string
+
+// GeneratorOptions configures the value generated for a Parameter.
+type GeneratorOptions struct {
+	// Optional: Charset is the set of characters the generated value is made
+	// of. It may contain ranges, eg. "a-z", and the \w, \d, \a and \A classes
+	// of the expression generator. A literal '-' or '\' must be escaped with
+	// '\'. Defaults to letters and digits.
+	Charset string `json:"charset,omitempty"`
+
+	// Optional: Exclude lists the characters removed from the charset.
+	Exclude string `json:"exclude,omitempty"`
+
+	// Optional: ExcludeAmbiguous removes the characters that are easily
+	// confused with each other, "0O1lI|", from the charset.
+	ExcludeAmbiguous bool `json:"excludeAmbiguous,omitempty"`
+
+	// Optional: MinLength is the minimum length of the generated value.
+	MinLength int `json:"minLength,omitempty"`
+
+	// Optional: MaxLength is the maximum length of the generated value. The
+	// length is chosen randomly between MinLength and MaxLength. Defaults
+	// to MinLength.
+	MaxLength int `json:"maxLength,omitempty"`
 }
@@ -1,14 +1,24 @@
 package validation
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"regexp"
+	"strconv"
+	"strings"
 
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/meta"
 	"k8s.io/kubernetes/pkg/api/validation"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+	kvalidation "k8s.io/kubernetes/pkg/util/validation"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	oapi "github.com/openshift/origin/pkg/api"
 	"github.com/openshift/origin/pkg/template/api"
+	"github.com/openshift/origin/pkg/template/generator"
 )
 
 var parameterNameExp = regexp.MustCompile(`^[a-zA-Z0-9\_]+$`)
@@ -22,6 +32,43 @@ func ValidateParameter(param *api.Parameter, fldPath *field.Path) (allErrs field
 	if !parameterNameExp.MatchString(param.Name) {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), param.Name, fmt.Sprintf("does not match %v", parameterNameExp)))
 	}
+	if len(param.FromEnv) > 0 && !kvalidation.IsCIdentifier(param.FromEnv) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("fromEnv"), param.FromEnv, "must be a valid environment variable name"))
+	}
+	if param.GeneratorOptions != nil {
+		allErrs = append(allErrs, validateGeneratorOptions(param, fldPath.Child("generatorOptions"))...)
+	}
+	switch param.Type {
+	case "", api.ParameterTypeString, api.ParameterTypeInt, api.ParameterTypeBool:
+		if len(param.Allowed) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("allowed"), param.Allowed, fmt.Sprintf("may only be set for parameters of type %q", api.ParameterTypeEnum)))
+		}
+	case api.ParameterTypeEnum:
+		if len(param.Allowed) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("allowed"), fmt.Sprintf("must be set for parameters of type %q", api.ParameterTypeEnum)))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), param.Type, []string{string(api.ParameterTypeString), string(api.ParameterTypeInt), string(api.ParameterTypeBool), string(api.ParameterTypeEnum)}))
+	}
+	return
+}
+
+func validateGeneratorOptions(param *api.Parameter, fldPath *field.Path) (allErrs field.ErrorList) {
+	options := param.GeneratorOptions
+	if len(param.Generate) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, "", "may only be set for parameters with a generator"))
+	}
+	if _, err := generator.ExpandCharset(options.Charset); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("charset"), options.Charset, err.Error()))
+	}
+	if options.MinLength < 0 || options.MinLength > generator.MaxCharsetLength {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minLength"), options.MinLength, fmt.Sprintf("must be within [0-%d]", generator.MaxCharsetLength)))
+	}
+	if options.MaxLength < 0 || options.MaxLength > generator.MaxCharsetLength {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxLength"), options.MaxLength, fmt.Sprintf("must be within [0-%d]", generator.MaxCharsetLength)))
+	} else if options.MaxLength > 0 && options.MaxLength < options.MinLength {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxLength"), options.MaxLength, "must not be less than minLength"))
+	}
 	return
 }
 
@@ -42,11 +89,162 @@ func ValidateTemplateUpdate(template, oldTemplate *api.Template) field.ErrorList
 	return validation.ValidateObjectMetaUpdate(&template.ObjectMeta, &oldTemplate.ObjectMeta, field.NewPath("metadata"))
 }
 
+// ValidateTemplateInstance tests if required fields in the TemplateInstance are set.
+func ValidateTemplateInstance(instance *api.TemplateInstance) (allErrs field.ErrorList) {
+	allErrs = validation.ValidateObjectMeta(&instance.ObjectMeta, true, validateLabelValueName, field.NewPath("metadata"))
+	if len(instance.Template) > 0 {
+		if ok, msg := oapi.GetNameValidationFunc(validation.ValidatePodName)(instance.Template, false); !ok {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("template"), instance.Template, msg))
+		}
+	}
+	if len(instance.Objects) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("objects"), ""))
+	}
+	for i, ref := range instance.Objects {
+		refPath := field.NewPath("objects").Index(i)
+		if len(ref.Kind) == 0 {
+			allErrs = append(allErrs, field.Required(refPath.Child("kind"), ""))
+		}
+		if len(ref.Name) == 0 {
+			allErrs = append(allErrs, field.Required(refPath.Child("name"), ""))
+		}
+		if len(ref.Namespace) > 0 && ref.Namespace != instance.Namespace {
+			allErrs = append(allErrs, field.Invalid(refPath.Child("namespace"), ref.Namespace, "must be the namespace of the template instance"))
+		}
+	}
+	return
+}
+
+// ValidateTemplateInstanceUpdate tests if required fields in the TemplateInstance are set during an update.
+// The objects of an instantiation never change.
+func ValidateTemplateInstanceUpdate(instance, oldInstance *api.TemplateInstance) field.ErrorList {
+	allErrs := validation.ValidateObjectMetaUpdate(&instance.ObjectMeta, &oldInstance.ObjectMeta, field.NewPath("metadata"))
+	if instance.Template != oldInstance.Template {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("template"), instance.Template, "field is immutable"))
+	}
+	if !kapi.Semantic.DeepEqual(instance.Objects, oldInstance.Objects) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("objects"), instance.Objects, "field is immutable"))
+	}
+	return allErrs
+}
+
+// ValidateTemplateRepository tests if required fields in the TemplateRepository are set.
+func ValidateTemplateRepository(repository *api.TemplateRepository) field.ErrorList {
+	allErrs := validation.ValidateObjectMeta(&repository.ObjectMeta, true, validateLabelValueName, field.NewPath("metadata"))
+	allErrs = append(allErrs, validateTemplateRepositorySpec(&repository.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateTemplateRepositoryUpdate tests if required fields in the TemplateRepository are set during an update.
+func ValidateTemplateRepositoryUpdate(repository, oldRepository *api.TemplateRepository) field.ErrorList {
+	allErrs := validation.ValidateObjectMetaUpdate(&repository.ObjectMeta, &oldRepository.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, validateTemplateRepositorySpec(&repository.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// validateTemplateRepositorySpec checks that exactly one source is set. The
+// sources are retrieved by the master, so only remote URLs are accepted.
+func validateTemplateRepositorySpec(spec *api.TemplateRepositorySpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	switch {
+	case len(spec.Git) == 0 && len(spec.Index) == 0:
+		allErrs = append(allErrs, field.Required(fldPath.Child("git"), "either git or index must be specified"))
+	case len(spec.Git) > 0 && len(spec.Index) > 0:
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("index"), spec.Index, "may not be specified with git"))
+	case len(spec.Git) > 0:
+		allErrs = append(allErrs, validateTemplateRepositoryURL(spec.Git, sets.NewString("http", "https", "git", "ssh"), fldPath.Child("git"))...)
+	default:
+		allErrs = append(allErrs, validateTemplateRepositoryURL(spec.Index, sets.NewString("http", "https"), fldPath.Child("index"))...)
+	}
+	return
+}
+
+func validateTemplateRepositoryURL(value string, schemes sets.String, fldPath *field.Path) field.ErrorList {
+	u, err := url.Parse(value)
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath, value, err.Error())}
+	}
+	if !schemes.Has(u.Scheme) || len(u.Host) == 0 {
+		return field.ErrorList{field.Invalid(fldPath, value, fmt.Sprintf("must be an absolute URL with one of the schemes %s", strings.Join(schemes.List(), ", ")))}
+	}
+	return nil
+}
+
+// validateLabelValueName checks that the name of a TemplateInstance or a
+// TemplateRepository can be used as the value of the label referencing it.
+func validateLabelValueName(name string, prefix bool) (bool, string) {
+	if ok, msg := oapi.GetNameValidationFunc(validation.ValidatePodName)(name, prefix); !ok {
+		return ok, msg
+	}
+	if !prefix && !kvalidation.IsValidLabelValue(name) {
+		return false, fmt.Sprintf("must be a valid label value, at most %d characters", kvalidation.LabelValueMaxLength)
+	}
+	return true, ""
+}
+
 // validateTemplateBody checks the body of a template.
 func validateTemplateBody(template *api.Template) (allErrs field.ErrorList) {
 	for i := range template.Parameters {
 		allErrs = append(allErrs, ValidateParameter(&template.Parameters[i], field.NewPath("parameters").Index(i))...)
 	}
 	allErrs = append(allErrs, validation.ValidateLabels(template.ObjectLabels, field.NewPath("labels"))...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(template.ObjectAnnotations, field.NewPath("objectAnnotations"))...)
+	for i, include := range template.Includes {
+		includePath := field.NewPath("includes").Index(i)
+		if len(include.Name) == 0 {
+			allErrs = append(allErrs, field.Required(includePath.Child("name"), ""))
+		} else if ok, msg := oapi.GetNameValidationFunc(validation.ValidatePodName)(include.Name, false); !ok {
+			allErrs = append(allErrs, field.Invalid(includePath.Child("name"), include.Name, msg))
+		}
+		if len(include.Namespace) > 0 {
+			if ok, msg := validation.ValidateNamespaceName(include.Namespace, false); !ok {
+				allErrs = append(allErrs, field.Invalid(includePath.Child("namespace"), include.Namespace, msg))
+			}
+		}
+	}
+	for i, obj := range template.Objects {
+		value, ok := objectAnnotation(obj, api.CountAnnotation)
+		if !ok || strings.Contains(value, "${") {
+			continue
+		}
+		if count, err := strconv.Atoi(strings.TrimSpace(value)); err != nil || count < 0 || count > api.MaxCount {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("objects").Index(i).Child("metadata", "annotations").Key(api.CountAnnotation), value, fmt.Sprintf("must be an integer within [0-%d]", api.MaxCount)))
+		}
+	}
+	if value, ok := template.Annotations[api.ExcludePathsAnnotation]; ok {
+		for _, exp := range strings.Split(value, "\n") {
+			if _, err := regexp.Compile(strings.TrimSpace(exp)); err != nil {
+				allErrs = append(allErrs, field.Invalid(field.NewPath("metadata", "annotations").Key(api.ExcludePathsAnnotation), exp, err.Error()))
+			}
+		}
+	}
 	return
 }
+
+// objectAnnotation returns the value of the named annotation of a Template
+// object, whether it is decoded or not. Counts referencing parameters are
+// only known, and checked, when the Template is processed.
+func objectAnnotation(obj runtime.Object, name string) (string, bool) {
+	switch t := obj.(type) {
+	case *runtime.Unknown:
+		var partial struct {
+			Metadata struct {
+				Annotations map[string]string `json:"annotations"`
+			} `json:"metadata"`
+		}
+		if err := json.Unmarshal(t.RawJSON, &partial); err != nil {
+			return "", false
+		}
+		value, ok := partial.Metadata.Annotations[name]
+		return value, ok
+	case *runtime.Unstructured:
+		metadata, _ := t.Object["metadata"].(map[string]interface{})
+		annotations, _ := metadata["annotations"].(map[string]interface{})
+		value, ok := annotations[name].(string)
+		return value, ok
+	}
+	if objMeta, err := meta.Accessor(obj); err == nil {
+		value, ok := objMeta.GetAnnotations()[name]
+		return value, ok
+	}
+	return "", false
+}
@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"strings"
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
@@ -43,6 +44,93 @@ func TestValidateParameter(t *testing.T) {
 	}
 }
 
+func TestValidateParameterType(t *testing.T) {
+	var tests = []struct {
+		Type            api.ParameterType
+		Allowed         []string
+		IsValidExpected bool
+	}{
+		{"", nil, true},
+		{api.ParameterTypeString, nil, true},
+		{api.ParameterTypeInt, nil, true},
+		{api.ParameterTypeBool, nil, true},
+		{api.ParameterTypeEnum, []string{"debug", "info"}, true},
+		{api.ParameterTypeEnum, nil, false},
+		{api.ParameterTypeInt, []string{"1"}, false},
+		{"float", nil, false},
+	}
+
+	for i, test := range tests {
+		param := makeParameter("PARAM", "1")
+		param.Type = test.Type
+		param.Allowed = test.Allowed
+		errs := ValidateParameter(param, nil)
+		if test.IsValidExpected && len(errs) != 0 {
+			t.Errorf("%d: Expected zero validation errors, got %v", i, errs)
+		}
+		if !test.IsValidExpected && len(errs) == 0 {
+			t.Errorf("%d: Expected some validation errors on parameter type %q", i, test.Type)
+		}
+	}
+}
+
+func TestValidateParameterFromEnv(t *testing.T) {
+	var tests = []struct {
+		FromEnv         string
+		IsValidExpected bool
+	}{
+		{"", true},
+		{"DB_PASSWORD", true},
+		{"_ci_token", true},
+		{"1PASSWORD", false},
+		{"DB-PASSWORD", false},
+		{"${DB_PASSWORD}", false},
+	}
+
+	for _, test := range tests {
+		param := makeParameter("PARAM", "")
+		param.FromEnv = test.FromEnv
+		errs := ValidateParameter(param, nil)
+		if test.IsValidExpected && len(errs) != 0 {
+			t.Errorf("%q: Expected zero validation errors, got %v", test.FromEnv, errs)
+		}
+		if !test.IsValidExpected && len(errs) == 0 {
+			t.Errorf("%q: Expected some validation errors", test.FromEnv)
+		}
+	}
+}
+
+func TestValidateParameterGeneratorOptions(t *testing.T) {
+	var tests = []struct {
+		Generate        string
+		Options         api.GeneratorOptions
+		IsValidExpected bool
+	}{
+		{"charset", api.GeneratorOptions{}, true},
+		{"charset", api.GeneratorOptions{Charset: "a-z0-9", ExcludeAmbiguous: true, MinLength: 16, MaxLength: 32}, true},
+		{"charset", api.GeneratorOptions{MinLength: 16}, true},
+		{"", api.GeneratorOptions{MinLength: 16}, false},
+		{"charset", api.GeneratorOptions{Charset: "z-a"}, false},
+		{"charset", api.GeneratorOptions{MinLength: -1}, false},
+		{"charset", api.GeneratorOptions{MinLength: 16, MaxLength: 8}, false},
+		{"charset", api.GeneratorOptions{MaxLength: 1000}, false},
+	}
+
+	for i, test := range tests {
+		param := makeParameter("PARAM", "")
+		param.Generate = test.Generate
+		options := test.Options
+		param.GeneratorOptions = &options
+		errs := ValidateParameter(param, nil)
+		if test.IsValidExpected && len(errs) != 0 {
+			t.Errorf("%d: Expected zero validation errors, got %v", i, errs)
+		}
+		if !test.IsValidExpected && len(errs) == 0 {
+			t.Errorf("%d: Expected some validation errors", i)
+		}
+	}
+}
+
 func TestValidateProcessTemplate(t *testing.T) {
 	var tests = []struct {
 		template        *api.Template
@@ -67,6 +155,27 @@ func TestValidateProcessTemplate(t *testing.T) {
 			},
 			false,
 		},
+		{ // Template with include without name, should fail
+			&api.Template{
+				ObjectMeta: kapi.ObjectMeta{Name: "templateId"},
+				Includes:   []api.TemplateInclude{{Namespace: "ns"}},
+			},
+			false,
+		},
+		{ // Template with include in invalid namespace, should fail
+			&api.Template{
+				ObjectMeta: kapi.ObjectMeta{Name: "templateId"},
+				Includes:   []api.TemplateInclude{{Name: "db", Namespace: "Invalid_NS"}},
+			},
+			false,
+		},
+		{ // Template with valid include, should pass
+			&api.Template{
+				ObjectMeta: kapi.ObjectMeta{Name: "templateId"},
+				Includes:   []api.TemplateInclude{{Name: "db", Namespace: "ns"}, {Name: "web"}},
+			},
+			true,
+		},
 		{ // Template with valid Parameter, should pass
 			&api.Template{
 				ObjectMeta: kapi.ObjectMeta{Name: "templateId"},
@@ -177,6 +286,53 @@ func TestValidateTemplate(t *testing.T) {
 			},
 			true,
 		},
+		{ // Template with an object requesting too many copies, should fail
+			&api.Template{
+				ObjectMeta: kapi.ObjectMeta{Name: "template", Namespace: kapi.NamespaceDefault},
+				Objects: []runtime.Object{
+					&kapi.Service{
+						ObjectMeta: kapi.ObjectMeta{Name: "test", Annotations: map[string]string{api.CountAnnotation: "1000000000"}},
+					},
+				},
+			},
+			false,
+		},
+		{ // Template with an undecoded object requesting too many copies, should fail
+			&api.Template{
+				ObjectMeta: kapi.ObjectMeta{Name: "template", Namespace: kapi.NamespaceDefault},
+				Objects: []runtime.Object{
+					&runtime.Unknown{RawJSON: []byte(`{"kind":"Service","metadata":{"annotations":{"` + api.CountAnnotation + `":"1001"}}}`)},
+				},
+			},
+			false,
+		},
+		{ // Template with an object whose count references a parameter, should pass
+			&api.Template{
+				ObjectMeta: kapi.ObjectMeta{Name: "template", Namespace: kapi.NamespaceDefault},
+				Objects: []runtime.Object{
+					&kapi.Service{
+						ObjectMeta: kapi.ObjectMeta{Name: "test", Annotations: map[string]string{api.CountAnnotation: "${REPLICAS}"}},
+					},
+				},
+			},
+			true,
+		},
+		{ // Template with valid excluded paths, should pass
+			&api.Template{
+				ObjectMeta: kapi.ObjectMeta{Name: "template", Namespace: kapi.NamespaceDefault, Annotations: map[string]string{
+					api.ExcludePathsAnnotation: "metadata.annotations.prometheus.io/.*\nspec.template.spec.containers\\[[0-9]+\\].args.*",
+				}},
+			},
+			true,
+		},
+		{ // Template with an invalid excluded path, should fail
+			&api.Template{
+				ObjectMeta: kapi.ObjectMeta{Name: "template", Namespace: kapi.NamespaceDefault, Annotations: map[string]string{
+					api.ExcludePathsAnnotation: "metadata.annotations.(",
+				}},
+			},
+			false,
+		},
 	}
 
 	for i, test := range tests {
@@ -189,3 +345,128 @@ func TestValidateTemplate(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateTemplateInstance(t *testing.T) {
+	validObjects := []kapi.ObjectReference{{Kind: "Service", Name: "frontend"}}
+	var tests = []struct {
+		instance        *api.TemplateInstance
+		isValidExpected bool
+	}{
+		{ // Empty TemplateInstance, should fail on empty name and objects
+			&api.TemplateInstance{},
+			false,
+		},
+		{ // TemplateInstance with name and objects, should pass
+			&api.TemplateInstance{
+				ObjectMeta: kapi.ObjectMeta{Name: "instance", Namespace: kapi.NamespaceDefault},
+				Template:   "template",
+				Objects:    validObjects,
+			},
+			true,
+		},
+		{ // TemplateInstance with a name that is not a label value, should fail
+			&api.TemplateInstance{
+				ObjectMeta: kapi.ObjectMeta{Name: strings.Repeat("a", 64), Namespace: kapi.NamespaceDefault},
+				Objects:    validObjects,
+			},
+			false,
+		},
+		{ // TemplateInstance without objects, should fail
+			&api.TemplateInstance{
+				ObjectMeta: kapi.ObjectMeta{Name: "instance", Namespace: kapi.NamespaceDefault},
+			},
+			false,
+		},
+		{ // TemplateInstance with an object without kind, should fail
+			&api.TemplateInstance{
+				ObjectMeta: kapi.ObjectMeta{Name: "instance", Namespace: kapi.NamespaceDefault},
+				Objects:    []kapi.ObjectReference{{Name: "frontend"}},
+			},
+			false,
+		},
+		{ // TemplateInstance with an object in another namespace, should fail
+			&api.TemplateInstance{
+				ObjectMeta: kapi.ObjectMeta{Name: "instance", Namespace: kapi.NamespaceDefault},
+				Objects:    []kapi.ObjectReference{{Kind: "Service", Name: "frontend", Namespace: "other"}},
+			},
+			false,
+		},
+	}
+
+	for i, test := range tests {
+		errs := ValidateTemplateInstance(test.instance)
+		if len(errs) != 0 && test.isValidExpected {
+			t.Errorf("%d: Unexpected non-empty error list: %v", i, errs.ToAggregate())
+		}
+		if len(errs) == 0 && !test.isValidExpected {
+			t.Errorf("%d: Unexpected empty error list: %v", i, errs.ToAggregate())
+		}
+	}
+}
+
+func TestValidateTemplateInstanceUpdate(t *testing.T) {
+	old := &api.TemplateInstance{
+		ObjectMeta: kapi.ObjectMeta{Name: "instance", Namespace: kapi.NamespaceDefault, ResourceVersion: "1"},
+		Template:   "template",
+		Objects:    []kapi.ObjectReference{{Kind: "Service", Name: "frontend"}},
+	}
+
+	labeled := *old
+	labeled.Labels = map[string]string{"foo": "bar"}
+	if errs := ValidateTemplateInstanceUpdate(&labeled, old); len(errs) != 0 {
+		t.Errorf("Unexpected non-empty error list: %v", errs.ToAggregate())
+	}
+
+	changed := *old
+	changed.Objects = []kapi.ObjectReference{{Kind: "Service", Name: "backend"}}
+	if errs := ValidateTemplateInstanceUpdate(&changed, old); len(errs) == 0 {
+		t.Errorf("Unexpected empty error list when the objects change")
+	}
+}
+
+func TestValidateTemplateRepository(t *testing.T) {
+	validMeta := kapi.ObjectMeta{Name: "library", Namespace: kapi.NamespaceDefault}
+	var tests = []struct {
+		repository      *api.TemplateRepository
+		isValidExpected bool
+	}{
+		{ // TemplateRepository with a Git repository, should pass
+			&api.TemplateRepository{ObjectMeta: validMeta, Spec: api.TemplateRepositorySpec{Git: "https://github.com/openshift/library.git"}},
+			true,
+		},
+		{ // TemplateRepository with an index, should pass
+			&api.TemplateRepository{ObjectMeta: validMeta, Spec: api.TemplateRepositorySpec{Index: "https://example.com/index.yaml", Prune: true}},
+			true,
+		},
+		{ // TemplateRepository without source, should fail
+			&api.TemplateRepository{ObjectMeta: validMeta},
+			false,
+		},
+		{ // TemplateRepository with both sources, should fail
+			&api.TemplateRepository{ObjectMeta: validMeta, Spec: api.TemplateRepositorySpec{Git: "https://github.com/openshift/library.git", Index: "https://example.com/index.yaml"}},
+			false,
+		},
+		{ // TemplateRepository with a local Git repository, should fail
+			&api.TemplateRepository{ObjectMeta: validMeta, Spec: api.TemplateRepositorySpec{Git: "file:///var/lib/origin"}},
+			false,
+		},
+		{ // TemplateRepository with a relative index, should fail
+			&api.TemplateRepository{ObjectMeta: validMeta, Spec: api.TemplateRepositorySpec{Index: "index.yaml"}},
+			false,
+		},
+		{ // TemplateRepository with a name that is not a label value, should fail
+			&api.TemplateRepository{ObjectMeta: kapi.ObjectMeta{Name: strings.Repeat("a", 64), Namespace: kapi.NamespaceDefault}, Spec: api.TemplateRepositorySpec{Index: "https://example.com/index.yaml"}},
+			false,
+		},
+	}
+
+	for i, test := range tests {
+		errs := ValidateTemplateRepository(test.repository)
+		if len(errs) != 0 && test.isValidExpected {
+			t.Errorf("%d: Unexpected non-empty error list: %v", i, errs.ToAggregate())
+		}
+		if len(errs) == 0 && !test.isValidExpected {
+			t.Errorf("%d: Unexpected empty error list: %v", i, errs.ToAggregate())
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v2"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// yamlDocumentSeparator separates the documents of a YAML stream.
+const yamlDocumentSeparator = "---\n"
+
+// ObjectsPrinter prints the objects produced by processing a Template, either
+// wrapped in a single List or as a stream of separate documents that can be
+// consumed by tools that do not understand Lists.
+type ObjectsPrinter struct {
+	// Printer formats the printed List or every printed object.
+	Printer kubectl.ResourcePrinter
+	// Split prints every object as a separate document instead of a List.
+	Split bool
+	// Separator is written before every document but the first when Split
+	// is set.
+	Separator string
+}
+
+// NewObjectsPrinter returns an ObjectsPrinter using the kubectl printer for
+// the given output format, converting the objects to version before printing
+// them. YAML documents are separated by "---" when split is set, and their
+// keys are printed in the order of the JSON representation of the objects,
+// see OrderedYAMLPrinter.
+func NewObjectsPrinter(format, formatArgument string, split bool, version unversioned.GroupVersion) (*ObjectsPrinter, error) {
+	p, _, err := kubectl.GetPrinter(format, formatArgument)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, fmt.Errorf("output format %q is not supported", format)
+	}
+	separator := ""
+	if format == "yaml" {
+		p = &OrderedYAMLPrinter{}
+		separator = yamlDocumentSeparator
+	}
+	return &ObjectsPrinter{
+		Printer:   kubectl.NewVersionedPrinter(p, kapi.Scheme, version),
+		Split:     split,
+		Separator: separator,
+	}, nil
+}
+
+// PrintObjects writes objects to out.
+func (p *ObjectsPrinter) PrintObjects(objects []runtime.Object, out io.Writer) error {
+	if !p.Split {
+		return p.Printer.PrintObj(&kapi.List{
+			ListMeta: unversioned.ListMeta{},
+			Items:    objects,
+		}, out)
+	}
+	for i, obj := range objects {
+		if i > 0 && len(p.Separator) > 0 {
+			if _, err := io.WriteString(out, p.Separator); err != nil {
+				return err
+			}
+		}
+		if err := p.Printer.PrintObj(obj, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OrderedYAMLPrinter prints objects as YAML. Unlike kubectl.YAMLPrinter, which
+// sorts the keys, it keeps the order of the keys of the JSON representation
+// of the objects, so that the processed objects of a Template are printed
+// with the keys in the order the Template author wrote them.
+type OrderedYAMLPrinter struct{}
+
+// PrintObj prints the object as YAML.
+func (p *OrderedYAMLPrinter) PrintObj(obj runtime.Object, w io.Writer) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	value, err := decodeOrderedValue(decoder)
+	if err != nil {
+		return err
+	}
+	output, err := yaml.Marshal(value)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(output)
+	return err
+}
+
+// HandledResources implements kubectl.ResourcePrinter
+func (p *OrderedYAMLPrinter) HandledResources() []string {
+	return []string{}
+}
+
+// decodeOrderedValue decodes the next JSON value of decoder, decoding objects
+// into yaml.MapSlices to keep the order of their keys.
+func decodeOrderedValue(decoder *json.Decoder) (interface{}, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch t := token.(type) {
+	case json.Delim:
+		switch t {
+		case '{':
+			object := yaml.MapSlice{}
+			for decoder.More() {
+				key, err := decoder.Token()
+				if err != nil {
+					return nil, err
+				}
+				value, err := decodeOrderedValue(decoder)
+				if err != nil {
+					return nil, err
+				}
+				object = append(object, yaml.MapItem{Key: key, Value: value})
+			}
+			// consume the closing delimiter
+			_, err := decoder.Token()
+			return object, err
+		case '[':
+			array := []interface{}{}
+			for decoder.More() {
+				value, err := decodeOrderedValue(decoder)
+				if err != nil {
+					return nil, err
+				}
+				array = append(array, value)
+			}
+			_, err := decoder.Token()
+			return array, err
+		}
+		return nil, fmt.Errorf("unexpected delimiter %v", t)
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i, nil
+		}
+		return t.Float64()
+	}
+	return token, nil
+}
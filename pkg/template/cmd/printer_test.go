@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	_ "github.com/openshift/origin/pkg/api/install"
+)
+
+func TestPrintObjects(t *testing.T) {
+	objects := []runtime.Object{
+		&kapi.Service{ObjectMeta: kapi.ObjectMeta{Name: "frontend"}},
+		&kapi.Service{ObjectMeta: kapi.ObjectMeta{Name: "backend"}},
+	}
+	version := unversioned.GroupVersion{Version: "v1"}
+
+	tests := map[string]struct {
+		format    string
+		split     bool
+		documents int
+		contains  []string
+	}{
+		"json list": {
+			format:    "json",
+			documents: 1,
+			contains:  []string{`"kind": "List"`, `"name": "frontend"`, `"name": "backend"`},
+		},
+		"yaml list": {
+			format:    "yaml",
+			documents: 1,
+			contains:  []string{"kind: List", "name: frontend", "name: backend"},
+		},
+		"yaml split": {
+			format:    "yaml",
+			split:     true,
+			documents: 2,
+			contains:  []string{"kind: Service", "name: frontend", "name: backend"},
+		},
+		"name split": {
+			format:   "name",
+			split:    true,
+			contains: []string{"service/frontend\nservice/backend\n"},
+		},
+	}
+
+	for name, test := range tests {
+		p, err := NewObjectsPrinter(test.format, "", test.split, version)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		out := &bytes.Buffer{}
+		if err := p.PrintObjects(objects, out); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		for _, s := range test.contains {
+			if !strings.Contains(out.String(), s) {
+				t.Errorf("%s: expected output to contain %q, got:\n%s", name, s, out.String())
+			}
+		}
+		if strings.Contains(out.String(), "List") == test.split {
+			t.Errorf("%s: unexpected List in output:\n%s", name, out.String())
+		}
+		if test.format == "yaml" {
+			if documents := strings.Count(out.String(), yamlDocumentSeparator) + 1; documents != test.documents {
+				t.Errorf("%s: expected %d documents, got %d:\n%s", name, test.documents, documents, out.String())
+			}
+		}
+	}
+
+	if _, err := NewObjectsPrinter("template", "", false, version); err == nil {
+		t.Errorf("expected an error for a template format without a template")
+	}
+}
+
+func TestOrderedYAMLPrinter(t *testing.T) {
+	obj := &runtime.Unknown{RawJSON: []byte(`{"kind":"Widget","apiVersion":"example.com/v1","spec":{"size":3,"ratio":1.5,"tags":["b","a"],"owner":null,"enabled":true}}`)}
+	out := &bytes.Buffer{}
+	if err := (&OrderedYAMLPrinter{}).PrintObj(obj, out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `kind: Widget
+apiVersion: example.com/v1
+spec:
+  size: 3
+  ratio: 1.5
+  tags:
+  - b
+  - a
+  owner: null
+  enabled: true
+`
+	if out.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, out.String())
+	}
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	cmdutil "github.com/openshift/origin/pkg/cmd/util"
+	"github.com/openshift/origin/pkg/template"
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+// ParameterPrompter asks for the values of the required parameters of a
+// Template that have neither a value nor a generator, which would otherwise
+// make processing fail.
+type ParameterPrompter struct {
+	// In is read for the values, usually a terminal.
+	In io.Reader
+	// Out receives the prompts.
+	Out io.Writer
+}
+
+// NewParameterPrompter returns a ParameterPrompter reading from in, or nil if
+// in is not a terminal and the user cannot be prompted.
+func NewParameterPrompter(in io.Reader, out io.Writer) *ParameterPrompter {
+	if !cmdutil.IsTerminalReader(in) {
+		return nil
+	}
+	return &ParameterPrompter{In: in, Out: out}
+}
+
+// PromptForRequiredParameters sets the values of the required parameters of
+// t that have no value and no generator to the values entered by the user,
+// and returns the names of the parameters that were set. The input is not
+// echoed for parameters holding secrets, see template.IsSecretParameter.
+func (p *ParameterPrompter) PromptForRequiredParameters(t *api.Template) sets.String {
+	set := sets.NewString()
+	for i := range t.Parameters {
+		param := &t.Parameters[i]
+		if !param.Required || len(param.Value) > 0 || len(param.Generate) > 0 {
+			continue
+		}
+		prompt := param.Name
+		if len(param.Description) > 0 {
+			prompt = fmt.Sprintf("%s (%s)", param.Name, param.Description)
+		}
+		var value string
+		if template.IsSecretParameter(param) {
+			value = cmdutil.PromptForPasswordString(p.In, p.Out, "%s: ", prompt)
+		} else {
+			value = cmdutil.PromptForString(p.In, p.Out, "%s: ", prompt)
+		}
+		if len(value) > 0 {
+			param.Value = value
+			set.Insert(param.Name)
+		}
+	}
+	return set
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+func TestPromptForRequiredParameters(t *testing.T) {
+	template := &api.Template{Parameters: []api.Parameter{
+		{Name: "NAME", Required: true, Description: "The application name"},
+		{Name: "DB_USER", Required: true, Value: "admin"},
+		{Name: "DB_PASSWORD", Required: true},
+		{Name: "TOKEN", Required: true, Generate: "expression", From: "[a-z]{8}"},
+		{Name: "OPTIONAL"},
+		{Name: "EMPTY", Required: true},
+	}}
+	out := &bytes.Buffer{}
+	prompter := &ParameterPrompter{In: strings.NewReader("frontend\nsecret\n"), Out: out}
+
+	set := prompter.PromptForRequiredParameters(template)
+	if !reflect.DeepEqual(set.List(), []string{"DB_PASSWORD", "NAME"}) {
+		t.Errorf("unexpected parameters set: %v", set.List())
+	}
+	expected := []string{"frontend", "admin", "secret", "", "", ""}
+	for i, param := range template.Parameters {
+		if param.Value != expected[i] {
+			t.Errorf("expected %s to be %q, got %q", param.Name, expected[i], param.Value)
+		}
+	}
+	if prompts := "NAME (The application name): DB_PASSWORD: EMPTY: "; out.String() != prompts {
+		t.Errorf("expected prompts %q, got %q", prompts, out.String())
+	}
+}
+
+func TestNewParameterPrompter(t *testing.T) {
+	if p := NewParameterPrompter(strings.NewReader(""), &bytes.Buffer{}); p != nil {
+		t.Errorf("expected no prompter for a reader that is not a terminal")
+	}
+}
@@ -0,0 +1,136 @@
+package templateinstance
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/controller/framework"
+	"k8s.io/kubernetes/pkg/runtime"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
+	"k8s.io/kubernetes/pkg/watch"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	templateapi "github.com/openshift/origin/pkg/template/api"
+)
+
+// ObjectDeleter deletes the objects created by an instantiation of a template.
+type ObjectDeleter interface {
+	// Delete deletes the object ref refers to in namespace if it still carries the
+	// template instance label with the value instance. Objects which no longer exist
+	// or which carry another label value are left alone.
+	Delete(namespace, instance string, ref kapi.ObjectReference) error
+}
+
+// TemplateInstanceController deletes the objects a TemplateInstance refers to once the TemplateInstance is
+// deleted.  TemplateInstances are deleted gracefully, so the controller sees them with a deletion timestamp,
+// deletes their objects in the reverse order of their creation and then deletes them for good.  If the grace
+// period ends first, the objects are deleted when the TemplateInstance disappears.
+type TemplateInstanceController struct {
+	client  osclient.TemplateInstancesNamespacer
+	deleter ObjectDeleter
+
+	instanceController *framework.Controller
+	stopChan           chan struct{}
+}
+
+// NewTemplateInstanceController returns a controller deleting the objects of deleted template instances with
+// deleter.
+func NewTemplateInstanceController(client osclient.TemplateInstancesNamespacer, deleter ObjectDeleter, resync time.Duration) *TemplateInstanceController {
+	c := &TemplateInstanceController{
+		client:  client,
+		deleter: deleter,
+	}
+
+	_, c.instanceController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+				return c.client.TemplateInstances(kapi.NamespaceAll).List(options)
+			},
+			WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+				return c.client.TemplateInstances(kapi.NamespaceAll).Watch(options)
+			},
+		},
+		&templateapi.TemplateInstance{},
+		resync,
+		framework.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				c.handleTemplateInstance(obj.(*templateapi.TemplateInstance))
+			},
+			UpdateFunc: func(_, obj interface{}) {
+				c.handleTemplateInstance(obj.(*templateapi.TemplateInstance))
+			},
+			DeleteFunc: func(obj interface{}) {
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				instance, ok := obj.(*templateapi.TemplateInstance)
+				if !ok {
+					utilruntime.HandleError(fmt.Errorf("unexpected object in the template instance tombstone: %#v", obj))
+					return
+				}
+				if err := c.deleteObjects(instance); err != nil {
+					utilruntime.HandleError(err)
+				}
+			},
+		},
+	)
+
+	return c
+}
+
+// Run starts the controller and returns immediately.
+func (c *TemplateInstanceController) Run() {
+	if c.stopChan == nil {
+		c.stopChan = make(chan struct{})
+		go c.instanceController.Run(c.stopChan)
+	}
+}
+
+// Stop gracefully shuts down the controller.
+func (c *TemplateInstanceController) Stop() {
+	if c.stopChan != nil {
+		close(c.stopChan)
+		c.stopChan = nil
+	}
+}
+
+func (c *TemplateInstanceController) handleTemplateInstance(instance *templateapi.TemplateInstance) {
+	if instance.DeletionTimestamp == nil {
+		return
+	}
+	if err := c.syncTemplateInstance(instance); err != nil {
+		utilruntime.HandleError(err)
+	}
+}
+
+// syncTemplateInstance deletes the objects of a template instance being deleted and then the template instance
+// itself.  The template instance is kept when an object could not be deleted, so the next sync retries.
+func (c *TemplateInstanceController) syncTemplateInstance(instance *templateapi.TemplateInstance) error {
+	if err := c.deleteObjects(instance); err != nil {
+		return err
+	}
+	err := c.client.TemplateInstances(instance.Namespace).Delete(instance.Name, kapi.NewDeleteOptions(0))
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	glog.V(4).Infof("Deleted templateinstance/%s in namespace %s and its %d objects", instance.Name, instance.Namespace, len(instance.Objects))
+	return nil
+}
+
+// deleteObjects deletes the objects of instance in the reverse order of their creation.
+func (c *TemplateInstanceController) deleteObjects(instance *templateapi.TemplateInstance) error {
+	errs := []error{}
+	for i := len(instance.Objects) - 1; i >= 0; i-- {
+		ref := instance.Objects[i]
+		if err := c.deleter.Delete(instance.Namespace, instance.Name, ref); err != nil {
+			errs = append(errs, fmt.Errorf("unable to delete %s/%s of templateinstance/%s in namespace %s: %v", ref.Kind, ref.Name, instance.Name, instance.Namespace, err))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
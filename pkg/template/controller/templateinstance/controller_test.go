@@ -0,0 +1,84 @@
+package templateinstance
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	templateapi "github.com/openshift/origin/pkg/template/api"
+)
+
+type fakeDeleter struct {
+	deleted []string
+	fail    string
+}
+
+func (d *fakeDeleter) Delete(namespace, instance string, ref kapi.ObjectReference) error {
+	if ref.Name == d.fail {
+		return errors.New("failed")
+	}
+	d.deleted = append(d.deleted, namespace+"/"+instance+"/"+ref.Kind+"/"+ref.Name)
+	return nil
+}
+
+func newInstance(deleted bool) *templateapi.TemplateInstance {
+	instance := &templateapi.TemplateInstance{
+		ObjectMeta: kapi.ObjectMeta{Name: "instance", Namespace: "ns"},
+		Objects: []kapi.ObjectReference{
+			{Kind: "Service", APIVersion: "v1", Name: "database"},
+			{Kind: "DeploymentConfig", APIVersion: "v1", Name: "frontend"},
+		},
+	}
+	if deleted {
+		now := unversioned.Now()
+		instance.DeletionTimestamp = &now
+	}
+	return instance
+}
+
+func TestSyncTemplateInstance(t *testing.T) {
+	tests := map[string]struct {
+		instance *templateapi.TemplateInstance
+		fail     string
+
+		expectedDeleted []string
+		expectedActions []ktestclient.Action
+	}{
+		"not deleted": {
+			instance:        newInstance(false),
+			expectedDeleted: []string{},
+			expectedActions: []ktestclient.Action{},
+		},
+		"deleted": {
+			instance:        newInstance(true),
+			expectedDeleted: []string{"ns/instance/DeploymentConfig/frontend", "ns/instance/Service/database"},
+			expectedActions: []ktestclient.Action{ktestclient.NewDeleteAction("templateinstances", "ns", "instance")},
+		},
+		"object not deleted": {
+			instance:        newInstance(true),
+			fail:            "frontend",
+			expectedDeleted: []string{"ns/instance/Service/database"},
+			expectedActions: []ktestclient.Action{},
+		},
+	}
+
+	for name, test := range tests {
+		client := &testclient.Fake{}
+		deleter := &fakeDeleter{deleted: []string{}, fail: test.fail}
+		c := &TemplateInstanceController{client: client, deleter: deleter}
+
+		c.handleTemplateInstance(test.instance)
+
+		if !reflect.DeepEqual(deleter.deleted, test.expectedDeleted) {
+			t.Errorf("%s: expected deleted objects %v, got %v", name, test.expectedDeleted, deleter.deleted)
+		}
+		if !reflect.DeepEqual(client.Actions(), test.expectedActions) {
+			t.Errorf("%s: expected actions %v, got %v", name, test.expectedActions, client.Actions())
+		}
+	}
+}
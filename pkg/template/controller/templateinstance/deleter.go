@@ -0,0 +1,112 @@
+package templateinstance
+
+import (
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apimachinery/registered"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/api/latest"
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildreaper "github.com/openshift/origin/pkg/build/reaper"
+	osclient "github.com/openshift/origin/pkg/client"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployreaper "github.com/openshift/origin/pkg/deploy/reaper"
+	templateapi "github.com/openshift/origin/pkg/template/api"
+)
+
+// clientObjectDeleter deletes objects through the API, using the same reapers as oc delete so that deleting
+// a deployment config or a replication controller also deletes what they manage.
+type clientObjectDeleter struct {
+	oc     *osclient.Client
+	kc     *kclient.Client
+	mapper meta.RESTMapper
+}
+
+// NewObjectDeleter returns an ObjectDeleter deleting objects with the OpenShift and Kubernetes clients.
+func NewObjectDeleter(oc *osclient.Client, kc *kclient.Client) ObjectDeleter {
+	var mapper meta.MultiRESTMapper
+	seenGroups := sets.String{}
+	for _, gv := range registered.EnabledVersions() {
+		if seenGroups.Has(gv.Group) {
+			continue
+		}
+		seenGroups.Insert(gv.Group)
+
+		groupMeta, err := registered.Group(gv.Group)
+		if err != nil {
+			continue
+		}
+		mapper = meta.MultiRESTMapper(append(mapper, groupMeta.RESTMapper))
+	}
+
+	return &clientObjectDeleter{oc: oc, kc: kc, mapper: mapper}
+}
+
+func (d *clientObjectDeleter) Delete(namespace, instance string, ref kapi.ObjectReference) error {
+	gv, err := unversioned.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return err
+	}
+	mapping, err := d.mapper.RESTMapping(unversioned.GroupKind{Group: gv.Group, Kind: ref.Kind}, gv.Version)
+	if err != nil {
+		return err
+	}
+	helper := resource.NewHelper(d.restClient(mapping), mapping)
+
+	obj, err := helper.Get(namespace, ref.Name, false)
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	if value := accessor.GetLabels()[templateapi.TemplateInstanceLabel]; value != instance {
+		glog.V(4).Infof("Not deleting %s/%s in namespace %s: it is not labeled %s=%s", ref.Kind, ref.Name, namespace, templateapi.TemplateInstanceLabel, instance)
+		return nil
+	}
+
+	reaper, err := d.reaperFor(mapping.GroupVersionKind.GroupKind())
+	switch {
+	case kubectl.IsNoSuchReaperError(err):
+		err = helper.Delete(namespace, ref.Name)
+	case err == nil:
+		err = reaper.Stop(namespace, ref.Name, 0, nil)
+	}
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (d *clientObjectDeleter) restClient(mapping *meta.RESTMapping) resource.RESTClient {
+	switch {
+	case latest.OriginKind(mapping.GroupVersionKind):
+		return d.oc
+	case mapping.GroupVersionKind.Group == extensions.GroupName:
+		return d.kc.ExtensionsClient
+	}
+	return d.kc
+}
+
+func (d *clientObjectDeleter) reaperFor(kind unversioned.GroupKind) (kubectl.Reaper, error) {
+	switch kind {
+	case deployapi.Kind("DeploymentConfig"):
+		return deployreaper.NewDeploymentConfigReaper(d.oc, d.kc), nil
+	case buildapi.Kind("BuildConfig"):
+		return buildreaper.NewBuildConfigReaper(d.oc), nil
+	}
+	return kubectl.ReaperFor(kind, d.kc)
+}
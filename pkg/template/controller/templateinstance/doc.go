@@ -0,0 +1,4 @@
+// Package templateinstance contains the controller which deletes the objects
+// created by an instantiation of a template when the TemplateInstance
+// recording it is deleted.
+package templateinstance
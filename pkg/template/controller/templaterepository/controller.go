@@ -0,0 +1,137 @@
+package templaterepository
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/controller/framework"
+	"k8s.io/kubernetes/pkg/runtime"
+	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
+	"k8s.io/kubernetes/pkg/watch"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	templateapi "github.com/openshift/origin/pkg/template/api"
+	"github.com/openshift/origin/pkg/template/library"
+)
+
+// indexTimeout bounds each request made to retrieve the Templates listed in an HTTP index.
+const indexTimeout = time.Minute
+
+// TemplateRepositoryController synchronizes the Templates of every TemplateRepository into its namespace when
+// it is created or its spec changes, and then at every resync.  The outcome of the last synchronization is
+// recorded in the status of the TemplateRepository.
+type TemplateRepositoryController struct {
+	client osclient.Interface
+	// newSource returns the source of the Templates of a repository, replaced in tests.
+	newSource func(spec *templateapi.TemplateRepositorySpec) library.Source
+
+	repositoryController *framework.Controller
+	stopChan             chan struct{}
+}
+
+// NewTemplateRepositoryController returns a controller synchronizing the Templates of template repositories
+// every resync period.
+func NewTemplateRepositoryController(client osclient.Interface, resync time.Duration) *TemplateRepositoryController {
+	c := &TemplateRepositoryController{
+		client:    client,
+		newSource: newSource,
+	}
+
+	_, c.repositoryController = framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+				return c.client.TemplateRepositories(kapi.NamespaceAll).List(options)
+			},
+			WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+				return c.client.TemplateRepositories(kapi.NamespaceAll).Watch(options)
+			},
+		},
+		&templateapi.TemplateRepository{},
+		resync,
+		framework.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				c.handleTemplateRepository(obj.(*templateapi.TemplateRepository))
+			},
+			UpdateFunc: func(old, obj interface{}) {
+				oldRepository, repository := old.(*templateapi.TemplateRepository), obj.(*templateapi.TemplateRepository)
+				// Updates that leave the spec alone, such as the status updates made by the controller, wait for
+				// the next resync.
+				if oldRepository.ResourceVersion != repository.ResourceVersion && kapi.Semantic.DeepEqual(oldRepository.Spec, repository.Spec) {
+					return
+				}
+				c.handleTemplateRepository(repository)
+			},
+		},
+	)
+
+	return c
+}
+
+// Run starts the controller and returns immediately.
+func (c *TemplateRepositoryController) Run() {
+	if c.stopChan == nil {
+		c.stopChan = make(chan struct{})
+		go c.repositoryController.Run(c.stopChan)
+	}
+}
+
+// Stop gracefully shuts down the controller.
+func (c *TemplateRepositoryController) Stop() {
+	if c.stopChan != nil {
+		close(c.stopChan)
+		c.stopChan = nil
+	}
+}
+
+func (c *TemplateRepositoryController) handleTemplateRepository(repository *templateapi.TemplateRepository) {
+	if repository.DeletionTimestamp != nil {
+		return
+	}
+	if err := c.syncTemplateRepository(repository); err != nil {
+		utilruntime.HandleError(err)
+	}
+}
+
+// syncTemplateRepository synchronizes the Templates of repository and records the outcome in its status.  A
+// synchronization error is only recorded, the returned error reports a failure to update the status.
+func (c *TemplateRepositoryController) syncTemplateRepository(repository *templateapi.TemplateRepository) error {
+	syncer := &library.Syncer{
+		Client:     c.client,
+		Namespace:  repository.Namespace,
+		Repository: repository.Name,
+		Prune:      repository.Spec.Prune,
+	}
+	result, syncErr := syncer.Sync(c.newSource(&repository.Spec))
+
+	obj, err := kapi.Scheme.Copy(repository)
+	if err != nil {
+		return err
+	}
+	updated := obj.(*templateapi.TemplateRepository)
+	now := unversioned.Now()
+	updated.Status = templateapi.TemplateRepositoryStatus{LastSyncTime: &now}
+	if result != nil {
+		updated.Status.Templates = append(append([]string{}, result.Created...), result.Updated...)
+		sort.Strings(updated.Status.Templates)
+	}
+	if syncErr != nil {
+		updated.Status.Message = syncErr.Error()
+	}
+
+	if _, err := c.client.TemplateRepositories(updated.Namespace).Update(updated); err != nil {
+		if kerrors.IsNotFound(err) || kerrors.IsConflict(err) {
+			// the repository was deleted or changed meanwhile, the changed one is synchronized again
+			return nil
+		}
+		return fmt.Errorf("unable to update the status of templaterepository/%s in namespace %s: %v", updated.Name, updated.Namespace, err)
+	}
+	glog.V(4).Infof("Synchronized templaterepository/%s in namespace %s: %d templates, error: %v", updated.Name, updated.Namespace, len(updated.Status.Templates), syncErr)
+	return nil
+}
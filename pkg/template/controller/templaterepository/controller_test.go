@@ -0,0 +1,97 @@
+package templaterepository
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	templateapi "github.com/openshift/origin/pkg/template/api"
+	"github.com/openshift/origin/pkg/template/library"
+)
+
+type fakeSource struct {
+	templates []*templateapi.Template
+	err       error
+}
+
+func (s *fakeSource) Templates() ([]*templateapi.Template, error) {
+	return s.templates, s.err
+}
+
+func TestSyncTemplateRepository(t *testing.T) {
+	tests := map[string]struct {
+		source *fakeSource
+
+		expectedTemplates []string
+		expectedMessage   bool
+	}{
+		"synchronized": {
+			source: &fakeSource{templates: []*templateapi.Template{
+				{ObjectMeta: kapi.ObjectMeta{Name: "ruby"}},
+				{ObjectMeta: kapi.ObjectMeta{Name: "mysql"}},
+			}},
+			expectedTemplates: []string{"mysql", "ruby"},
+		},
+		"source failure": {
+			source:          &fakeSource{err: errors.New("unable to clone")},
+			expectedMessage: true,
+		},
+	}
+
+	for name, test := range tests {
+		client := &testclient.Fake{}
+		client.AddReactor("get", "templates", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			return true, nil, kerrors.NewNotFound(templateapi.Resource("templates"), action.(ktestclient.GetAction).GetName())
+		})
+		c := &TemplateRepositoryController{
+			client:    client,
+			newSource: func(*templateapi.TemplateRepositorySpec) library.Source { return test.source },
+		}
+		repository := &templateapi.TemplateRepository{
+			ObjectMeta: kapi.ObjectMeta{Name: "library", Namespace: "openshift"},
+			Spec:       templateapi.TemplateRepositorySpec{Git: "https://github.com/openshift/library.git"},
+		}
+
+		c.handleTemplateRepository(repository)
+
+		var updated *templateapi.TemplateRepository
+		created := []string{}
+		for _, action := range client.Actions() {
+			switch {
+			case action.Matches("create", "templates"):
+				template := action.(ktestclient.CreateAction).GetObject().(*templateapi.Template)
+				if template.Labels[templateapi.TemplateRepositoryLabel] != "library" {
+					t.Errorf("%s: expected template %s to be labeled with the repository", name, template.Name)
+				}
+				created = append(created, template.Name)
+			case action.Matches("update", "templaterepositories"):
+				updated = action.(ktestclient.UpdateAction).GetObject().(*templateapi.TemplateRepository)
+			}
+		}
+		if len(created) != len(test.expectedTemplates) {
+			t.Errorf("%s: expected templates %v to be created, got %v", name, test.expectedTemplates, created)
+		}
+		if updated == nil {
+			t.Errorf("%s: expected the status to be updated", name)
+			continue
+		}
+		if updated.Status.LastSyncTime == nil {
+			t.Errorf("%s: expected the synchronization time to be recorded", name)
+		}
+		if !reflect.DeepEqual(updated.Status.Templates, test.expectedTemplates) {
+			t.Errorf("%s: expected templates %v, got %v", name, test.expectedTemplates, updated.Status.Templates)
+		}
+		if (len(updated.Status.Message) > 0) != test.expectedMessage {
+			t.Errorf("%s: unexpected message %q", name, updated.Status.Message)
+		}
+		if repository.Status.LastSyncTime != nil {
+			t.Errorf("%s: expected the repository from the cache to be left untouched", name)
+		}
+	}
+}
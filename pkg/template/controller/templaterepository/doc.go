@@ -0,0 +1,4 @@
+// Package templaterepository contains the controller which periodically
+// synchronizes the Templates published by the template repository a
+// TemplateRepository describes into its namespace.
+package templaterepository
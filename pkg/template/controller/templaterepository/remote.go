@@ -0,0 +1,184 @@
+package templaterepository
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/openshift/origin/pkg/generate/git"
+	templateapi "github.com/openshift/origin/pkg/template/api"
+	"github.com/openshift/origin/pkg/template/library"
+)
+
+// blockedNetworks holds the networks template repositories may not be retrieved from. The controller runs in
+// the master, so without this any user could have it reach hosts that only the master can reach, such as the
+// cloud metadata service, etcd or the other services of the cluster.
+var blockedNetworks = parseNetworks(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.0.0.0/24",
+	"192.168.0.0/16",
+	"198.18.0.0/15",
+	"224.0.0.0/4",
+	"240.0.0.0/4",
+	"::/128",
+	"::1/128",
+	"fc00::/7",
+	"fe80::/10",
+	"ff00::/8",
+)
+
+// lookupIP resolves host names, replaced in tests.
+var lookupIP = net.LookupIP
+
+// newRepository returns the repository cloning templates with the given environment, replaced in tests.
+var newRepository = git.NewRepositoryWithEnv
+
+func parseNetworks(cidrs ...string) []*net.IPNet {
+	networks := []*net.IPNet{}
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// checkIP returns an error if ip belongs to a blocked network.
+func checkIP(host string, ip net.IP) error {
+	for _, network := range blockedNetworks {
+		if network.Contains(ip) {
+			return fmt.Errorf("%s resolves to %s, template repositories may not be retrieved from private, loopback or link local addresses", host, ip)
+		}
+	}
+	return nil
+}
+
+// resolve returns the addresses of host, or an error if any of them belongs to a blocked network.
+func resolve(host string) ([]net.IP, error) {
+	ips := []net.IP{}
+	if ip := net.ParseIP(host); ip != nil {
+		ips = append(ips, ip)
+	} else {
+		found, err := lookupIP(host)
+		if err != nil {
+			return nil, err
+		}
+		ips = append(ips, found...)
+	}
+	if len(ips) == 0 {
+		return nil, fmt.Errorf("%s has no addresses", host)
+	}
+	for _, ip := range ips {
+		if err := checkIP(host, ip); err != nil {
+			return nil, err
+		}
+	}
+	return ips, nil
+}
+
+// dial connects to addr after checking the address its host resolves to, so that neither the host of a
+// template nor a redirection can lead to a blocked network, even if its name resolves differently later on.
+func dial(network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	ips, err := resolve(host)
+	if err != nil {
+		return nil, err
+	}
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	return dialer.Dial(network, net.JoinHostPort(ips[0].String(), port))
+}
+
+// hostname returns the host of u without its port.
+func hostname(u *url.URL) string {
+	if host, _, err := net.SplitHostPort(u.Host); err == nil {
+		return host
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(u.Host, "["), "]")
+}
+
+// remoteGitSource is a GitSource that refuses to clone from a blocked network.
+type remoteGitSource struct {
+	library.GitSource
+}
+
+// Templates implements Source. The repository is cloned from the address that was checked: git neither resolves
+// the host again nor follows redirections, as either could lead it to a blocked network.
+func (s *remoteGitSource) Templates() ([]*templateapi.Template, error) {
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		return nil, err
+	}
+	host := hostname(u)
+	ips, err := resolve(host)
+	if err != nil {
+		return nil, err
+	}
+	pinned, config := pinAddress(u, host, ips[0])
+	source := library.GitSource{URL: pinned.String(), Repository: s.Repository}
+	if source.Repository == nil {
+		env := append(os.Environ(), fmt.Sprintf("GIT_CONFIG_COUNT=%d", len(config)))
+		for i, option := range config {
+			env = append(env, fmt.Sprintf("GIT_CONFIG_KEY_%d=%s", i, option[0]), fmt.Sprintf("GIT_CONFIG_VALUE_%d=%s", i, option[1]))
+		}
+		source.Repository = newRepository(env)
+	}
+	return source.Templates()
+}
+
+// pinAddress returns the URL and the git configuration options cloning u from ip. HTTP URLs keep their host, so
+// that certificates are verified against it, and have curl resolve it to ip instead. The host of the other URLs
+// is replaced by ip.
+func pinAddress(u *url.URL, host string, ip net.IP) (*url.URL, [][2]string) {
+	pinned := *u
+	port := ""
+	if _, p, err := net.SplitHostPort(u.Host); err == nil {
+		port = p
+	}
+	address := ip.String()
+	if ip.To4() == nil {
+		address = "[" + address + "]"
+	}
+	switch u.Scheme {
+	case "http", "https":
+		if len(port) == 0 {
+			port = map[string]string{"http": "80", "https": "443"}[u.Scheme]
+		}
+		return &pinned, [][2]string{
+			{"http.followRedirects", "false"},
+			{"http.curloptResolve", fmt.Sprintf("%s:%s:%s", host, port, address)},
+		}
+	default:
+		if len(port) > 0 {
+			pinned.Host = net.JoinHostPort(ip.String(), port)
+		} else {
+			pinned.Host = address
+		}
+		return &pinned, nil
+	}
+}
+
+// newSource returns the source matching the validated spec of a repository.
+func newSource(spec *templateapi.TemplateRepositorySpec) library.Source {
+	if len(spec.Git) > 0 {
+		return &remoteGitSource{library.GitSource{URL: spec.Git}}
+	}
+	client := &http.Client{
+		Transport: &http.Transport{Dial: dial, TLSHandshakeTimeout: 10 * time.Second},
+		Timeout:   indexTimeout,
+	}
+	return &library.HTTPIndexSource{URL: spec.Index, Client: client}
+}
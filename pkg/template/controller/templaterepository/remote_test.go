@@ -0,0 +1,159 @@
+package templaterepository
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/openshift/origin/pkg/generate/git"
+	templateapi "github.com/openshift/origin/pkg/template/api"
+	"github.com/openshift/origin/pkg/template/library"
+)
+
+func TestCheckIP(t *testing.T) {
+	tests := map[string]bool{
+		"8.8.8.8":                true,
+		"2001:4860:4860::8888":   true,
+		"127.0.0.1":              false,
+		"10.1.2.3":               false,
+		"172.30.0.1":             false,
+		"192.168.1.1":            false,
+		"169.254.169.254":        false,
+		"100.64.0.1":             false,
+		"0.0.0.0":                false,
+		"::1":                    false,
+		"::":                     false,
+		"fd00::1":                false,
+		"fe80::1":                false,
+		"::ffff:127.0.0.1":       false,
+		"::ffff:169.254.169.254": false,
+	}
+	for address, allowed := range tests {
+		if err := checkIP(address, net.ParseIP(address)); (err == nil) != allowed {
+			t.Errorf("%s: expected allowed to be %t, got error %v", address, allowed, err)
+		}
+	}
+}
+
+func TestGitSourceBlockedHost(t *testing.T) {
+	defer func(f func(string) ([]net.IP, error)) { lookupIP = f }(lookupIP)
+	lookupIP = func(host string) ([]net.IP, error) {
+		return []net.IP{net.ParseIP("93.184.216.34"), net.ParseIP("169.254.169.254")}, nil
+	}
+
+	for _, url := range []string{"https://metadata.example.com/templates.git", "git://127.0.0.1:9418/templates.git", "ssh://git@[::1]/templates.git"} {
+		source := newSource(&templateapi.TemplateRepositorySpec{Git: url})
+		if _, err := source.Templates(); err == nil || !strings.Contains(err.Error(), "may not be retrieved") {
+			t.Errorf("%s: expected the host to be blocked, got %v", url, err)
+		}
+	}
+}
+
+type fakeRepository struct {
+	git.Repository
+	url string
+}
+
+func (r *fakeRepository) CloneWithOptions(dir string, url string, opts git.CloneOptions) error {
+	r.url = url
+	return nil
+}
+
+func TestGitSourcePinnedAddress(t *testing.T) {
+	defer func(f func(string) ([]net.IP, error)) { lookupIP = f }(lookupIP)
+	defer func(f func([]string) git.Repository) { newRepository = f }(newRepository)
+
+	tests := []struct {
+		url, ip  string
+		expected string
+		config   []string
+	}{
+		{
+			url:      "https://example.com/templates.git",
+			ip:       "93.184.216.34",
+			expected: "https://example.com/templates.git",
+			config: []string{
+				"GIT_CONFIG_COUNT=2",
+				"GIT_CONFIG_KEY_0=http.followRedirects", "GIT_CONFIG_VALUE_0=false",
+				"GIT_CONFIG_KEY_1=http.curloptResolve", "GIT_CONFIG_VALUE_1=example.com:443:93.184.216.34",
+			},
+		},
+		{
+			url:      "http://example.com:8080/templates.git",
+			ip:       "2606:2800:220:1:248:1893:25c8:1946",
+			expected: "http://example.com:8080/templates.git",
+			config: []string{
+				"GIT_CONFIG_COUNT=2",
+				"GIT_CONFIG_KEY_0=http.followRedirects", "GIT_CONFIG_VALUE_0=false",
+				"GIT_CONFIG_KEY_1=http.curloptResolve", "GIT_CONFIG_VALUE_1=example.com:8080:[2606:2800:220:1:248:1893:25c8:1946]",
+			},
+		},
+		{
+			url:      "git://example.com/templates.git",
+			ip:       "93.184.216.34",
+			expected: "git://93.184.216.34/templates.git",
+			config:   []string{"GIT_CONFIG_COUNT=0"},
+		},
+		{
+			url:      "ssh://git@example.com:2222/templates.git",
+			ip:       "2606:2800:220:1:248:1893:25c8:1946",
+			expected: "ssh://git@[2606:2800:220:1:248:1893:25c8:1946]:2222/templates.git",
+			config:   []string{"GIT_CONFIG_COUNT=0"},
+		},
+	}
+	for _, test := range tests {
+		lookupIP = func(host string) ([]net.IP, error) {
+			return []net.IP{net.ParseIP(test.ip)}, nil
+		}
+		repository := &fakeRepository{}
+		var env []string
+		newRepository = func(e []string) git.Repository {
+			env = e
+			return repository
+		}
+
+		source := newSource(&templateapi.TemplateRepositorySpec{Git: test.url})
+		if _, err := source.Templates(); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.url, err)
+			continue
+		}
+		if repository.url != test.expected {
+			t.Errorf("%s: expected to clone %s, got %s", test.url, test.expected, repository.url)
+		}
+		config := []string{}
+		for _, value := range env {
+			if strings.HasPrefix(value, "GIT_CONFIG_") {
+				config = append(config, value)
+			}
+		}
+		if !reflect.DeepEqual(config, test.config) {
+			t.Errorf("%s: unexpected git configuration: %v", test.url, config)
+		}
+	}
+}
+
+func TestHTTPIndexSourceBlockedHost(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		http.Redirect(w, req, "http://169.254.169.254/latest/meta-data/", http.StatusFound)
+	}))
+	defer server.Close()
+
+	source := newSource(&templateapi.TemplateRepositorySpec{Index: server.URL})
+	if _, err := source.Templates(); err == nil || !strings.Contains(err.Error(), "may not be retrieved") {
+		t.Errorf("expected the loopback index to be blocked, got %v", err)
+	}
+
+	// redirections are checked as well
+	defer func(networks []*net.IPNet) { blockedNetworks = networks }(blockedNetworks)
+	blockedNetworks = parseNetworks("169.254.0.0/16")
+	source = newSource(&templateapi.TemplateRepositorySpec{Index: server.URL})
+	if _, ok := source.(*library.HTTPIndexSource); !ok {
+		t.Fatalf("unexpected source %#v", source)
+	}
+	if _, err := source.Templates(); err == nil || !strings.Contains(err.Error(), "169.254.169.254") {
+		t.Errorf("expected the redirection to be blocked, got %v", err)
+	}
+}
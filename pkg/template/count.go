@@ -0,0 +1,93 @@
+package template
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+// objectCopy is an object produced from a Template object together with the
+// parameters to substitute into it.
+type objectCopy struct {
+	object runtime.Object
+	params []api.Parameter
+}
+
+// expandObject replicates the object as requested by its CountAnnotation. Each
+// copy is paired with the given parameters plus the CountIndexParameter
+// holding its index. Objects without the annotation are returned unchanged.
+func expandObject(params []api.Parameter, obj runtime.Object) ([]objectCopy, error) {
+	value, ok := popObjectAnnotation(obj, api.CountAnnotation)
+	if !ok {
+		return []objectCopy{{object: obj, params: params}}, nil
+	}
+
+	paramMap := make(map[string]string, len(params))
+	for _, param := range params {
+		paramMap[param.Name] = param.Value
+	}
+	for _, exp := range []*regexp.Regexp{nonStringParameterReferenceExp, parameterExp} {
+		for _, match := range exp.FindAllStringSubmatch(value, -1) {
+			if paramValue, found := paramMap[match[1]]; found {
+				value = strings.Replace(value, match[0], paramValue, 1)
+			}
+		}
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || count < 0 || count > api.MaxCount {
+		return nil, fmt.Errorf("must be an integer within [0-%d], got %q", api.MaxCount, value)
+	}
+
+	copies := make([]objectCopy, 0, count)
+	for i := 0; i < count; i++ {
+		copied, err := kapi.Scheme.DeepCopy(obj)
+		if err != nil {
+			return nil, err
+		}
+		indexParams := make([]api.Parameter, len(params), len(params)+1)
+		copy(indexParams, params)
+		indexParams = append(indexParams, api.Parameter{Name: api.CountIndexParameter, Value: strconv.Itoa(i)})
+		copies = append(copies, objectCopy{object: copied.(runtime.Object), params: indexParams})
+	}
+	return copies, nil
+}
+
+// popObjectAnnotation removes the named annotation from obj and returns its
+// value.
+func popObjectAnnotation(obj runtime.Object, name string) (string, bool) {
+	if itemMeta, err := meta.Accessor(obj); err == nil {
+		annotations := itemMeta.GetAnnotations()
+		value, ok := annotations[name]
+		if ok {
+			delete(annotations, name)
+			itemMeta.SetAnnotations(annotations)
+		}
+		return value, ok
+	}
+	// TODO: allow meta.Accessor to handle runtime.Unstructured
+	if unstruct, ok := obj.(*runtime.Unstructured); ok && unstruct.Object != nil {
+		metadata, ok := unstruct.Object["metadata"].(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		annotations, ok := metadata["annotations"].(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		value, ok := annotations[name]
+		if !ok {
+			return "", false
+		}
+		delete(annotations, name)
+		s, _ := value.(string)
+		return s, true
+	}
+	return "", false
+}
@@ -0,0 +1,136 @@
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+// FieldChange is a field of an object that was changed by processing.
+type FieldChange struct {
+	// Path is the path of the field, eg. "spec.ports[0].port"
+	Path string
+	// Original is the value before processing, nil if the field was added
+	Original interface{}
+	// Processed is the value after processing, nil if the field was removed
+	Processed interface{}
+}
+
+// ObjectDiff holds the changes made by processing to a Template object.
+type ObjectDiff struct {
+	// Index is the index of the Template object the processed object was
+	// produced from. Objects replicated with api.CountAnnotation share it.
+	Index int
+	// Object is the processed object in the "kind/name" form
+	Object  string
+	Changes []FieldChange
+}
+
+// ProcessDiffResult is the outcome of ProcessDiff.
+type ProcessDiffResult struct {
+	// Original is the Template as it was submitted
+	Original *api.Template
+	// Processed is the processed copy of the Template
+	Processed *api.Template
+	// Diffs hold the changes made to every processed object
+	Diffs []ObjectDiff
+}
+
+// ProcessDiff processes a copy of the Template, leaving the Template itself
+// untouched, and returns both the original and the processed Template along
+// with the field level changes made to every object by processing.
+func (p *Processor) ProcessDiff(t *api.Template) (*ProcessDiffResult, field.ErrorList) {
+	copied, err := kapi.Scheme.DeepCopy(t)
+	if err != nil {
+		return nil, field.ErrorList{field.InternalError(field.NewPath("template"), err)}
+	}
+	result := &ProcessDiffResult{Original: t, Processed: copied.(*api.Template)}
+	errs := p.process(result.Processed, func(index int, original interface{}, processed runtime.Object) {
+		result.Diffs = append(result.Diffs, ObjectDiff{
+			Index:   index,
+			Object:  objectReference(processed),
+			Changes: diffValues("", original, genericObject(processed), nil),
+		})
+	})
+	return result, errs
+}
+
+// genericObject returns the JSON compatible representation of the object.
+func genericObject(obj runtime.Object) interface{} {
+	if unstructured, ok := obj.(*runtime.Unstructured); ok {
+		copied, err := runtime.Encode(runtime.UnstructuredJSONScheme, unstructured)
+		if err == nil {
+			var out interface{}
+			if err := json.Unmarshal(copied, &out); err == nil {
+				return out
+			}
+		}
+		return nil
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil
+	}
+	var out interface{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil
+	}
+	return out
+}
+
+// diffValues appends the changes between the JSON compatible values a and b
+// found under path to changes.
+func diffValues(path string, a, b interface{}, changes []FieldChange) []FieldChange {
+	switch aValue := a.(type) {
+	case map[string]interface{}:
+		bValue, ok := b.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(aValue)+len(bValue))
+		for k := range aValue {
+			keys = append(keys, k)
+		}
+		for k := range bValue {
+			if _, found := aValue[k]; !found {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			child := k
+			if len(path) > 0 {
+				child = path + "." + k
+			}
+			changes = diffValues(child, aValue[k], bValue[k], changes)
+		}
+		return changes
+	case []interface{}:
+		bValue, ok := b.([]interface{})
+		if !ok {
+			break
+		}
+		for i := 0; i < len(aValue) || i < len(bValue); i++ {
+			var aItem, bItem interface{}
+			if i < len(aValue) {
+				aItem = aValue[i]
+			}
+			if i < len(bValue) {
+				bItem = bValue[i]
+			}
+			changes = diffValues(fmt.Sprintf("%s[%d]", path, i), aItem, bItem, changes)
+		}
+		return changes
+	}
+	if !reflect.DeepEqual(a, b) {
+		changes = append(changes, FieldChange{Path: path, Original: a, Processed: b})
+	}
+	return changes
+}
@@ -0,0 +1,58 @@
+package template
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+func TestProcessDiff(t *testing.T) {
+	template := &api.Template{
+		Parameters: []api.Parameter{
+			{Name: "NAME", Value: "frontend"},
+			{Name: "REPLICAS", Value: "2"},
+		},
+		ObjectLabels: map[string]string{"app": "test"},
+		Objects: []runtime.Object{
+			&runtime.Unknown{RawJSON: []byte(`{"kind":"Service","apiVersion":"v1","metadata":{"name":"${NAME}"},"spec":{"ports":[{"port":80}]}}`)},
+			&runtime.Unknown{RawJSON: []byte(`{"kind":"Pod","apiVersion":"v1","metadata":{"name":"${NAME}-${TEMPLATE_INDEX}","annotations":{"template.alpha.openshift.io/count":"${REPLICAS}"}}}`)},
+		},
+	}
+
+	result, errs := NewProcessor(nil).ProcessDiff(template)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if result.Original != template {
+		t.Errorf("expected the original template to be returned")
+	}
+	if _, ok := template.Objects[0].(*runtime.Unknown); !ok {
+		t.Errorf("expected the original template to be left untouched, got %#v", template.Objects[0])
+	}
+	if len(result.Processed.Objects) != 3 {
+		t.Fatalf("expected 3 processed objects, got %d", len(result.Processed.Objects))
+	}
+
+	expected := []ObjectDiff{
+		{Index: 0, Object: "Service/frontend", Changes: []FieldChange{
+			{Path: "metadata.labels", Processed: map[string]interface{}{"app": "test"}},
+			{Path: "metadata.name", Original: "${NAME}", Processed: "frontend"},
+		}},
+		{Index: 1, Object: "Pod/frontend-0", Changes: []FieldChange{
+			{Path: "metadata.annotations.template.alpha.openshift.io/count", Original: "${REPLICAS}"},
+			{Path: "metadata.labels", Processed: map[string]interface{}{"app": "test"}},
+			{Path: "metadata.name", Original: "${NAME}-${TEMPLATE_INDEX}", Processed: "frontend-0"},
+		}},
+		{Index: 1, Object: "Pod/frontend-1", Changes: []FieldChange{
+			{Path: "metadata.annotations.template.alpha.openshift.io/count", Original: "${REPLICAS}"},
+			{Path: "metadata.labels", Processed: map[string]interface{}{"app": "test"}},
+			{Path: "metadata.name", Original: "${NAME}-${TEMPLATE_INDEX}", Processed: "frontend-1"},
+		}},
+	}
+	if !reflect.DeepEqual(result.Diffs, expected) {
+		t.Errorf("expected\n%#v\ngot\n%#v", expected, result.Diffs)
+	}
+}
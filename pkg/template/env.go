@@ -0,0 +1,43 @@
+package template
+
+import (
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+// EnvLookupFunc returns the value of the named environment variable and
+// whether it is set, like os.LookupEnv.
+type EnvLookupFunc func(name string) (string, bool)
+
+// SetParameterValuesFromEnv sets the Value of every Parameter of t that has
+// no value yet and declares FromEnv to the value of the named environment
+// variable, and returns the names of the Parameters it set. Parameters that
+// do not declare FromEnv are never read from the environment.
+func SetParameterValuesFromEnv(t *api.Template, lookup EnvLookupFunc) sets.String {
+	set := sets.NewString()
+	for i := range t.Parameters {
+		param := &t.Parameters[i]
+		if len(param.Value) > 0 {
+			continue
+		}
+		if value, ok := parameterValueFromEnv(param, lookup); ok {
+			param.Value = value
+			set.Insert(param.Name)
+		}
+	}
+	return set
+}
+
+// parameterValueFromEnv returns the non-empty value of the environment
+// variable named by the FromEnv field of param.
+func parameterValueFromEnv(param *api.Parameter, lookup EnvLookupFunc) (string, bool) {
+	if lookup == nil || len(param.FromEnv) == 0 {
+		return "", false
+	}
+	value, ok := lookup(param.FromEnv)
+	if !ok || len(value) == 0 {
+		return "", false
+	}
+	return value, true
+}
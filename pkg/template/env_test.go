@@ -0,0 +1,76 @@
+package template
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/openshift/origin/pkg/template/api"
+	"github.com/openshift/origin/pkg/template/generator"
+)
+
+func fakeEnv(env map[string]string) EnvLookupFunc {
+	return func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+}
+
+func TestProcessorEnv(t *testing.T) {
+	env := fakeEnv(map[string]string{"CI_PASSWORD": "${USER}-secret", "CI_TOKEN": "", "CI_USER": "ci"})
+	newTemplate := func() *api.Template {
+		template := &api.Template{Parameters: []api.Parameter{
+			makeParameter("USER", "admin", "", false),
+			makeParameter("PASSWORD", "", "expression", false),
+			makeParameter("TOKEN", "", "expression", false),
+			makeParameter("NAME", "", "expression", false),
+			makeParameter("OTHER", "", "expression", false),
+		}}
+		template.Parameters[0].FromEnv = "CI_USER"
+		template.Parameters[1].FromEnv = "CI_PASSWORD"
+		template.Parameters[2].FromEnv = "CI_TOKEN"
+		template.Parameters[3].FromEnv = "CI_MISSING"
+		return template
+	}
+
+	processor := NewProcessor(map[string]generator.Generator{"expression": FooGenerator{}})
+	template := newTemplate()
+	if errs := processor.GenerateParameterValues(template); len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	for _, param := range template.Parameters[1:] {
+		if param.Value != "foo" {
+			t.Errorf("expected %s to be generated without an Env lookup, got %q", param.Name, param.Value)
+		}
+	}
+
+	processor.Env = env
+	template = newTemplate()
+	if errs := processor.GenerateParameterValues(template); len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	expected := []string{"admin", "${USER}-secret", "foo", "foo", "foo"}
+	for i, param := range template.Parameters {
+		if param.Value != expected[i] {
+			t.Errorf("expected %s to be %q, got %q", param.Name, expected[i], param.Value)
+		}
+	}
+}
+
+func TestSetParameterValuesFromEnv(t *testing.T) {
+	template := &api.Template{Parameters: []api.Parameter{
+		{Name: "USER", Value: "admin", FromEnv: "CI_USER"},
+		{Name: "PASSWORD", FromEnv: "CI_PASSWORD"},
+		{Name: "TOKEN", FromEnv: "CI_TOKEN"},
+		{Name: "CI_PASSWORD"},
+	}}
+	set := SetParameterValuesFromEnv(template, fakeEnv(map[string]string{"CI_USER": "ci", "CI_PASSWORD": "secret", "CI_TOKEN": ""}))
+	if !reflect.DeepEqual(set.List(), []string{"PASSWORD"}) {
+		t.Errorf("unexpected parameters set: %v", set.List())
+	}
+	expected := []string{"admin", "secret", "", ""}
+	for i, param := range template.Parameters {
+		if param.Value != expected[i] {
+			t.Errorf("expected %s to be %q, got %q", param.Name, expected[i], param.Value)
+		}
+	}
+}
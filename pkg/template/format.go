@@ -0,0 +1,191 @@
+package template
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// preserveFormat returns obj as a runtime.Unknown object encoded with the
+// formatting of original, see encodePreservingFormat. Objects that are not
+// runtime.Unstructured are returned as is.
+func preserveFormat(original []byte, obj runtime.Object) (runtime.Object, error) {
+	unstructured, ok := obj.(*runtime.Unstructured)
+	if !ok {
+		return obj, nil
+	}
+	data, err := encodePreservingFormat(original, unstructured.Object)
+	if err != nil {
+		return obj, err
+	}
+	return &runtime.Unknown{TypeMeta: unstructured.TypeMeta, RawJSON: data}, nil
+}
+
+// encodePreservingFormat encodes value as JSON, reusing the formatting of
+// original, the JSON document value was decoded from. The keys of the
+// objects are written in the order they have in original, the keys that are
+// not present there are written after them in sorted order, and the values
+// that are unchanged are written as they appear in original, so that, for
+// example, numbers keep their formatting. The result is compact, without any
+// insignificant whitespace.
+func encodePreservingFormat(original []byte, value interface{}) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := writePreservingFormat(buf, bytes.TrimSpace(original), value); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writePreservingFormat(buf *bytes.Buffer, original []byte, value interface{}) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys, values, ok := decodeObjectMembers(original)
+		if !ok {
+			return writeJSON(buf, v)
+		}
+		buf.WriteByte('{')
+		written := map[string]bool{}
+		for _, key := range keys {
+			member, found := v[key]
+			if !found || written[key] {
+				continue
+			}
+			if len(written) > 0 {
+				buf.WriteByte(',')
+			}
+			written[key] = true
+			if err := writeJSON(buf, key); err != nil {
+				return err
+			}
+			buf.WriteByte(':')
+			if err := writePreservingFormat(buf, values[key], member); err != nil {
+				return err
+			}
+		}
+		added := []string{}
+		for key := range v {
+			if !written[key] {
+				added = append(added, key)
+			}
+		}
+		sort.Strings(added)
+		for _, key := range added {
+			if len(written) > 0 {
+				buf.WriteByte(',')
+			}
+			written[key] = true
+			if err := writeJSON(buf, key); err != nil {
+				return err
+			}
+			buf.WriteByte(':')
+			if err := writeJSON(buf, v[key]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+		return nil
+
+	case []interface{}:
+		var elements []json.RawMessage
+		if err := json.Unmarshal(original, &elements); err != nil {
+			return writeJSON(buf, v)
+		}
+		buf.WriteByte('[')
+		for i, element := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			var err error
+			if i < len(elements) {
+				err = writePreservingFormat(buf, bytes.TrimSpace(elements[i]), element)
+			} else {
+				err = writeJSON(buf, element)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+	}
+
+	if len(original) > 0 && sameScalar(original, value) {
+		buf.Write(original)
+		return nil
+	}
+	return writeJSON(buf, value)
+}
+
+// decodeObjectMembers returns the keys of the JSON object data in order,
+// along with their raw values. It returns false if data is not an object.
+func decodeObjectMembers(data []byte) ([]string, map[string][]byte, bool) {
+	if len(data) == 0 || data[0] != '{' {
+		return nil, nil, false
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if _, err := decoder.Token(); err != nil {
+		return nil, nil, false
+	}
+	keys := []string{}
+	values := map[string][]byte{}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, nil, false
+		}
+		key, ok := token.(string)
+		if !ok {
+			return nil, nil, false
+		}
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			return nil, nil, false
+		}
+		keys = append(keys, key)
+		values[key] = bytes.TrimSpace(raw)
+	}
+	return keys, values, true
+}
+
+// sameScalar returns true if the JSON scalar original holds value. Numbers
+// are compared by value, so that 1.0 and 1 are the same.
+func sameScalar(original []byte, value interface{}) bool {
+	decoder := json.NewDecoder(bytes.NewReader(original))
+	decoder.UseNumber()
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return false
+	}
+	number, ok := decoded.(json.Number)
+	if !ok {
+		return reflect.DeepEqual(decoded, value)
+	}
+	switch value.(type) {
+	case json.Number, int, int32, int64, float32, float64:
+	default:
+		return false
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return false
+	}
+	if string(encoded) == number.String() {
+		return true
+	}
+	a, errA := strconv.ParseFloat(number.String(), 64)
+	b, errB := strconv.ParseFloat(string(encoded), 64)
+	return errA == nil && errB == nil && a == b
+}
+
+func writeJSON(buf *bytes.Buffer, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	buf.Write(data)
+	return nil
+}
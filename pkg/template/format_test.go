@@ -0,0 +1,65 @@
+package template
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEncodePreservingFormat(t *testing.T) {
+	tests := map[string]struct {
+		original string
+		modify   func(obj map[string]interface{})
+		expected string
+	}{
+		"unchanged": {
+			original: `{"kind": "Widget", "apiVersion": "v1", "spec": {"ratio": 1.50, "size": 1e3, "tags": ["b", "a"], "enabled": true, "owner": null}}`,
+			expected: `{"kind":"Widget","apiVersion":"v1","spec":{"ratio":1.50,"size":1e3,"tags":["b","a"],"enabled":true,"owner":null}}`,
+		},
+		"changed values": {
+			original: `{"kind": "Widget", "spec": {"size": 1e3, "owner": "${NAME}", "tags": ["${NAME}", "a"]}}`,
+			modify: func(obj map[string]interface{}) {
+				spec := obj["spec"].(map[string]interface{})
+				spec["owner"] = "app"
+				spec["size"] = float64(2000)
+				spec["tags"].([]interface{})[0] = "app"
+			},
+			expected: `{"kind":"Widget","spec":{"size":2000,"owner":"app","tags":["app","a"]}}`,
+		},
+		"added and removed keys": {
+			original: `{"metadata": {"name": "a", "namespace": "b"}, "kind": "Widget"}`,
+			modify: func(obj map[string]interface{}) {
+				metadata := obj["metadata"].(map[string]interface{})
+				delete(metadata, "namespace")
+				metadata["labels"] = map[string]interface{}{"z": "1", "a": "2"}
+				obj["data"] = []interface{}{"x"}
+			},
+			expected: `{"metadata":{"name":"a","labels":{"a":"2","z":"1"}},"kind":"Widget","data":["x"]}`,
+		},
+		"changed types": {
+			original: `{"replicas": "${{REPLICAS}}", "items": {"a": 1}}`,
+			modify: func(obj map[string]interface{}) {
+				obj["replicas"] = float64(3)
+				obj["items"] = []interface{}{"a"}
+			},
+			expected: `{"replicas":3,"items":["a"]}`,
+		},
+	}
+
+	for name, test := range tests {
+		obj := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(test.original), &obj); err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if test.modify != nil {
+			test.modify(obj)
+		}
+		data, err := encodePreservingFormat([]byte(test.original), obj)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if string(data) != test.expected {
+			t.Errorf("%s: expected %s, got %s", name, test.expected, data)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package template
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// functionExp matches a function applied to a parameter value, eg.
+// ${lower(APP_NAME)} or ${trunc(BUILD_ID,8)}. Additional arguments follow the
+// parameter name separated by commas and may not contain ',', ')' or '}'.
+var functionExp = regexp.MustCompile(`\$\{([a-zA-Z]+)\(([a-zA-Z0-9\_]+)((?:,[^,\)\}]*)*)\)\}`)
+
+// templateFunction transforms a parameter value using the additional
+// arguments supplied in the substitution expression.
+type templateFunction struct {
+	// args is the number of additional arguments the function requires
+	args int
+	fn   func(value string, args []string) (string, error)
+}
+
+// templateFunctions are the functions available in substitution expressions.
+var templateFunctions = map[string]templateFunction{
+	"lower": {0, func(value string, args []string) (string, error) {
+		return strings.ToLower(value), nil
+	}},
+	"upper": {0, func(value string, args []string) (string, error) {
+		return strings.ToUpper(value), nil
+	}},
+	"trunc": {1, func(value string, args []string) (string, error) {
+		length, err := strconv.Atoi(args[0])
+		if err != nil || length < 0 {
+			return "", fmt.Errorf("trunc length must be a non-negative integer, got %q", args[0])
+		}
+		// truncate by characters so that multi-byte characters are not split
+		if runes := []rune(value); len(runes) > length {
+			value = string(runes[:length])
+		}
+		return value, nil
+	}},
+	"replace": {2, func(value string, args []string) (string, error) {
+		return strings.Replace(value, args[0], args[1], -1), nil
+	}},
+}
+
+// evaluateFunction applies the named function to value. rawArgs holds the
+// additional arguments as matched by functionExp, each preceded by a comma.
+func evaluateFunction(name, value, rawArgs string) (string, error) {
+	function, ok := templateFunctions[name]
+	if !ok {
+		return "", fmt.Errorf("unknown function %q", name)
+	}
+	args := []string{}
+	if len(rawArgs) > 0 {
+		args = strings.Split(rawArgs[1:], ",")
+	}
+	if len(args) != function.args {
+		return "", fmt.Errorf("function %q requires %d argument(s) in addition to the parameter name, got %d", name, function.args, len(args))
+	}
+	return function.fn(value, args)
+}
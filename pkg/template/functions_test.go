@@ -0,0 +1,43 @@
+package template
+
+import "testing"
+
+func TestEvaluateFunction(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		args     string
+		expected string
+		err      bool
+	}{
+		{"lower", "MyApp", "", "myapp", false},
+		{"upper", "MyApp", "", "MYAPP", false},
+		{"trunc", "0123456789abcdef", ",8", "01234567", false},
+		{"trunc", "0123", ",8", "0123", false},
+		{"trunc", "héllo wörld", ",7", "héllo w", false},
+		{"trunc", "0123", ",-1", "", true},
+		{"trunc", "0123", ",x", "", true},
+		{"trunc", "0123", "", "", true},
+		{"replace", "my_app_name", ",_,-", "my-app-name", false},
+		{"replace", "my_app_name", ",_", "", true},
+		{"lower", "MyApp", ",extra", "", true},
+		{"unknown", "MyApp", "", "", true},
+	}
+
+	for i, test := range tests {
+		value, err := evaluateFunction(test.name, test.value, test.args)
+		if test.err {
+			if err == nil {
+				t.Errorf("%d: expected error, got %q", i, value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		if value != test.expected {
+			t.Errorf("%d: expected %q, got %q", i, test.expected, value)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package generator
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+// Base64ValueGenerator implements Generator interface. It generates random
+// bytes read from the given source and returns them encoded as a standard
+// base64 string. The input expression defines the number of random bytes
+// to generate in the "[length]" form.
+//
+// Examples:
+//
+// from   | value
+// -----------------------------
+// "[4]"  | "q1xDHg=="
+// "[32]" | "0lJx3aTL8cD+fV9Zk3Q8YpWrq6VmDGOe+oXSh0mhcCs="
+type Base64ValueGenerator struct {
+	source io.Reader
+}
+
+var base64Exp = regexp.MustCompile(`^\[([0-9]+)\]$`)
+
+// NewBase64ValueGenerator creates new Base64ValueGenerator. The source should
+// be a cryptographically secure random source, such as crypto/rand.Reader.
+func NewBase64ValueGenerator(source io.Reader) Base64ValueGenerator {
+	return Base64ValueGenerator{source: source}
+}
+
+// GenerateValue reads the number of random bytes specified by the input
+// expression and returns them encoded as base64.
+func (g Base64ValueGenerator) GenerateValue(expression string) (interface{}, error) {
+	match := base64Exp.FindStringSubmatch(expression)
+	if match == nil {
+		return "", fmt.Errorf("malformed expresion syntax: %s", expression)
+	}
+	length, _ := strconv.Atoi(match[1])
+	if length <= 0 || length > 255 {
+		return "", fmt.Errorf("length must be within [1-255] bytes (%d)", length)
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(g.source, data); err != nil {
+		return "", fmt.Errorf("unable to read random bytes: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
@@ -0,0 +1,56 @@
+package generator
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+)
+
+func TestBase64ValueGenerator(t *testing.T) {
+	var tests = []struct {
+		Expression    string
+		Source        []byte
+		ExpectedValue string
+	}{
+		{"[1]", []byte{0xff}, "/w=="},
+		{"[4]", []byte("test"), "dGVzdA=="},
+		{"[3]", []byte("abcdef"), "YWJj"},
+	}
+
+	for _, test := range tests {
+		generator := NewBase64ValueGenerator(bytes.NewReader(test.Source))
+		value, err := generator.GenerateValue(test.Expression)
+		if err != nil {
+			t.Errorf("Failed to generate value from %s due to error: %v", test.Expression, err)
+		}
+		if value != test.ExpectedValue {
+			t.Errorf("Failed to generate expected value from %s\n. Generated: %s\n. Expected: %s\n", test.Expression, value, test.ExpectedValue)
+		}
+	}
+}
+
+func TestBase64ValueGeneratorLength(t *testing.T) {
+	generator := NewBase64ValueGenerator(rand.Reader)
+	value, err := generator.GenerateValue("[32]")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, err := base64.StdEncoding.DecodeString(value.(string))
+	if err != nil {
+		t.Fatalf("expected valid base64, got %q: %v", value, err)
+	}
+	if len(data) != 32 {
+		t.Errorf("expected 32 bytes, got %d", len(data))
+	}
+}
+
+func TestBase64ValueGeneratorErrors(t *testing.T) {
+	generator := NewBase64ValueGenerator(bytes.NewReader([]byte("short")))
+
+	for _, expression := range []string{"32", "[A-Z]{3}", "[0]", "[300]", "[10]"} {
+		if v, err := generator.GenerateValue(expression); err == nil {
+			t.Errorf("Expected %s to produce an error (returned: %s)", expression, v)
+		}
+	}
+}
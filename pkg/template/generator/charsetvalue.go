@@ -0,0 +1,147 @@
+package generator
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+// DefaultCharsetLength is the length of the values generated by the
+// CharsetValueGenerator when no length is configured.
+const DefaultCharsetLength = 16
+
+// MaxCharsetLength is the maximum length of the values generated by the
+// CharsetValueGenerator.
+const MaxCharsetLength = 255
+
+// ConfigurableGenerator is a Generator that can be configured by the
+// GeneratorOptions of a Parameter.
+type ConfigurableGenerator interface {
+	Generator
+	GenerateConfiguredValue(options api.GeneratorOptions) (interface{}, error)
+}
+
+// CharsetValueGenerator implements the ConfigurableGenerator interface. It
+// generates random strings made of the characters of a charset, with a
+// length chosen randomly within a range. The charset may contain ranges of
+// characters and the \w, \d, \a and \A classes of the
+// ExpressionValueGenerator.
+//
+// Examples:
+//
+// charset        | exclude | excludeAmbiguous | minLength | maxLength | value
+// -----------------------------------------------------------------------------
+// "a-z0-9"       |         | false            | 8         |           | "k3x09qzd"
+// "\a"           |         | true             | 12        | 16        | "hW4yQU5iNbXa7"
+// "\a\A"         | "\"'"   | false            | 16        |           | "x%H2e_w9!Rk{u+7b"
+type CharsetValueGenerator struct {
+	seed *rand.Rand
+}
+
+// NewCharsetValueGenerator creates new CharsetValueGenerator.
+func NewCharsetValueGenerator(seed *rand.Rand) CharsetValueGenerator {
+	return CharsetValueGenerator{seed: seed}
+}
+
+// GenerateValue generates a random string of DefaultCharsetLength characters
+// of the charset given as expression.
+func (g CharsetValueGenerator) GenerateValue(expression string) (interface{}, error) {
+	return g.GenerateConfiguredValue(api.GeneratorOptions{Charset: expression})
+}
+
+// GenerateConfiguredValue generates a random string as configured by the
+// given options. See CharsetValueGenerator for more details.
+func (g CharsetValueGenerator) GenerateConfiguredValue(options api.GeneratorOptions) (interface{}, error) {
+	alphabet, err := ExpandCharset(options.Charset)
+	if err != nil {
+		return "", err
+	}
+	exclude := options.Exclude
+	if options.ExcludeAmbiguous {
+		exclude += api.AmbiguousCharacters
+	}
+	alphabet = removeChars(alphabet, exclude)
+	if len(alphabet) == 0 {
+		return "", fmt.Errorf("no character is left in the charset %q once %q are excluded", options.Charset, exclude)
+	}
+
+	min, max := options.MinLength, options.MaxLength
+	if min == 0 && max == 0 {
+		min = DefaultCharsetLength
+	}
+	if max == 0 {
+		max = min
+	}
+	if min < 0 || min > max || max > MaxCharsetLength {
+		return "", fmt.Errorf("length must be within [0-%d] characters and the minimum must not exceed the maximum (%d-%d)", MaxCharsetLength, min, max)
+	}
+
+	length := min + g.seed.Intn(max-min+1)
+	result := make([]byte, length)
+	for i := range result {
+		result[i] = alphabet[g.seed.Intn(len(alphabet))]
+	}
+	return string(result), nil
+}
+
+// ExpandCharset returns the characters described by the given charset, see
+// api.GeneratorOptions. An empty charset expands to letters and digits.
+func ExpandCharset(charset string) (string, error) {
+	if len(charset) == 0 {
+		return Alphabet + Numerals, nil
+	}
+	var alphabet string
+	chars := []byte(charset)
+	for i := 0; i < len(chars); i++ {
+		c := chars[i]
+		if c == '\\' {
+			if i+1 == len(chars) {
+				return "", fmt.Errorf("the charset %q must not end with an unescaped '\\'", charset)
+			}
+			i++
+			switch chars[i] {
+			case 'w':
+				alphabet += Alphabet + Numerals + "_"
+			case 'd':
+				alphabet += Numerals
+			case 'a':
+				alphabet += Alphabet + Numerals
+			case 'A':
+				alphabet += Symbols
+			case '\\', '-':
+				alphabet += string(chars[i])
+			default:
+				return "", fmt.Errorf("unknown character class '\\%c' in the charset %q", chars[i], charset)
+			}
+			continue
+		}
+		if i+2 < len(chars) && chars[i+1] == '-' && chars[i+2] != '\\' {
+			from, to := c, chars[i+2]
+			if from > to {
+				return "", fmt.Errorf("invalid range specified: %c-%c", from, to)
+			}
+			for r := int(from); r <= int(to); r++ {
+				alphabet += string(rune(r))
+			}
+			i += 2
+			continue
+		}
+		alphabet += string(c)
+	}
+	if strings.IndexFunc(alphabet, func(r rune) bool { return r < ' ' || r > '~' }) != -1 {
+		return "", fmt.Errorf("the charset %q must contain printable ASCII characters only", charset)
+	}
+	return removeDuplicateChars(alphabet), nil
+}
+
+// removeChars removes the characters of exclude from s.
+func removeChars(s, exclude string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(exclude, r) {
+			return -1
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,127 @@
+package generator
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+func TestExpandCharset(t *testing.T) {
+	tests := []struct {
+		charset  string
+		expected string
+		err      bool
+	}{
+		{charset: "", expected: Alphabet + Numerals},
+		{charset: "a-e", expected: "abcde"},
+		{charset: "0-3x-z", expected: "0123xyz"},
+		{charset: "!-$", expected: "!\"#$"},
+		{charset: `\d`, expected: Numerals},
+		{charset: `a\-z`, expected: "a-z"},
+		{charset: `\\-`, expected: `\-`},
+		{charset: "aab-c", expected: "abc"},
+		{charset: "z-a", err: true},
+		{charset: `\q`, err: true},
+		{charset: `abc\`, err: true},
+		{charset: "é", err: true},
+	}
+
+	for _, test := range tests {
+		alphabet, err := ExpandCharset(test.charset)
+		if test.err {
+			if err == nil {
+				t.Errorf("%q: expected an error, got %q", test.charset, alphabet)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", test.charset, err)
+			continue
+		}
+		if len(alphabet) != len(test.expected) || strings.Trim(alphabet, test.expected) != "" {
+			t.Errorf("%q: expected the characters %q, got %q", test.charset, test.expected, alphabet)
+		}
+	}
+}
+
+func TestCharsetValueGenerator(t *testing.T) {
+	tests := []struct {
+		options   api.GeneratorOptions
+		alphabet  string
+		minLength int
+		maxLength int
+		err       bool
+	}{
+		{
+			options:   api.GeneratorOptions{},
+			alphabet:  Alphabet + Numerals,
+			minLength: DefaultCharsetLength,
+			maxLength: DefaultCharsetLength,
+		},
+		{
+			options:   api.GeneratorOptions{Charset: "0-9", MinLength: 4, MaxLength: 8},
+			alphabet:  Numerals,
+			minLength: 4,
+			maxLength: 8,
+		},
+		{
+			options:   api.GeneratorOptions{Charset: `\a`, ExcludeAmbiguous: true, MinLength: 200},
+			alphabet:  removeChars(Alphabet+Numerals, api.AmbiguousCharacters),
+			minLength: 200,
+			maxLength: 200,
+		},
+		{
+			options:   api.GeneratorOptions{Charset: "a-f", Exclude: "abc", MaxLength: 3},
+			alphabet:  "def",
+			minLength: 0,
+			maxLength: 3,
+		},
+		{
+			options: api.GeneratorOptions{Charset: "a-c", Exclude: "abc"},
+			err:     true,
+		},
+		{
+			options: api.GeneratorOptions{MinLength: 8, MaxLength: 4},
+			err:     true,
+		},
+		{
+			options: api.GeneratorOptions{MinLength: MaxCharsetLength + 1},
+			err:     true,
+		},
+	}
+
+	generator := NewCharsetValueGenerator(rand.New(rand.NewSource(1337)))
+	for i, test := range tests {
+		value, err := generator.GenerateConfiguredValue(test.options)
+		if test.err {
+			if err == nil {
+				t.Errorf("%d: expected an error, got %q", i, value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		s := value.(string)
+		if len(s) < test.minLength || len(s) > test.maxLength {
+			t.Errorf("%d: expected a length within [%d-%d], got %q", i, test.minLength, test.maxLength, s)
+		}
+		if strings.Trim(s, test.alphabet) != "" {
+			t.Errorf("%d: expected characters of %q only, got %q", i, test.alphabet, s)
+		}
+	}
+}
+
+func TestCharsetValueGeneratorExpression(t *testing.T) {
+	generator := NewCharsetValueGenerator(rand.New(rand.NewSource(1337)))
+	value, err := generator.GenerateValue("xy")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s := value.(string); len(s) != DefaultCharsetLength || strings.Trim(s, "xy") != "" {
+		t.Errorf("expected %d characters of \"xy\", got %q", DefaultCharsetLength, s)
+	}
+}
@@ -0,0 +1,30 @@
+package generator
+
+import (
+	cryptorand "crypto/rand"
+	"encoding/binary"
+	"math/rand"
+)
+
+// cryptoSource is a math/rand Source backed by crypto/rand, so the values
+// generated from it are not predictable.
+type cryptoSource struct{}
+
+// NewCryptoSource returns a math/rand Source that reads from crypto/rand. It
+// can be used with NewExpressionValueGenerator to generate secrets, eg.
+// NewExpressionValueGenerator(rand.New(NewCryptoSource())).
+func NewCryptoSource() rand.Source {
+	return cryptoSource{}
+}
+
+// Int63 returns a non-negative random 63-bit integer.
+func (cryptoSource) Int63() int64 {
+	var data [8]byte
+	if _, err := cryptorand.Read(data[:]); err != nil {
+		panic(err)
+	}
+	return int64(binary.BigEndian.Uint64(data[:]) & (1<<63 - 1))
+}
+
+// Seed is a no-op, a crypto/rand backed source cannot be seeded.
+func (cryptoSource) Seed(int64) {}
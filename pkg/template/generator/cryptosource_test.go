@@ -0,0 +1,30 @@
+package generator
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestCryptoSource(t *testing.T) {
+	source := NewCryptoSource()
+	seen := map[int64]bool{}
+	for i := 0; i < 100; i++ {
+		value := source.Int63()
+		if value < 0 {
+			t.Fatalf("expected a non-negative value, got %d", value)
+		}
+		seen[value] = true
+	}
+	if len(seen) < 99 {
+		t.Errorf("expected random values, got %d distinct values out of 100", len(seen))
+	}
+
+	generator := NewExpressionValueGenerator(rand.New(source))
+	value, err := generator.GenerateValue("[a-zA-Z0-9]{32}")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(value.(string)) != 32 {
+		t.Errorf("expected a 32 characters value, got %q", value)
+	}
+}
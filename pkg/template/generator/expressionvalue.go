@@ -10,19 +10,32 @@ import (
 
 // ExpressionValueGenerator implements Generator interface. It generates
 // random string based on the input expression. The input expression is
-// a string, which may contain "[a-zA-Z0-9]{length}" constructs,
-// defining range and length of the result random characters.
+// a string, which may contain the following constructs:
+//
+// "[a-zA-Z0-9]{length}" generates length random characters of the given
+// ranges. The \w, \d, \a and \A classes may be used instead of the ranges.
+// The length may be a range as well, eg. "{8,12}".
+//
+// "[min-max]" generates a random number between min and max. When min has
+// leading zeros, the number is padded with zeros to the width of min.
+//
+// "(expression){count}" repeats the generation of the enclosed expression
+// count times. The count may be a range as well, eg. "{2,4}".
+//
+// Any other character is copied to the value as is.
 //
 // Examples:
 //
-// from             | value
+// from                    | value
 // -----------------------------
-// "test[0-9]{1}x"  | "test7x"
-// "[0-1]{8}"       | "01001100"
-// "0x[A-F0-9]{4}"  | "0xB3AF"
-// "[a-zA-Z0-9]{8}" | "hW4yQU5i"
-//
-// TODO: Support more regexp constructs.
+// "test[0-9]{1}x"         | "test7x"
+// "[0-1]{8}"              | "01001100"
+// "0x[A-F0-9]{4}"         | "0xB3AF"
+// "[a-zA-Z0-9]{8}"        | "hW4yQU5i"
+// "[\a]{8,12}"            | "Cr3bGq0eZ2"
+// "port-[1000-9999]"      | "port-4711"
+// "id-[0001-9999]"        | "id-0042"
+// "([a-z]{4}-){3}[\d]{4}" | "kqzt-ahwe-ploc-7205"
 type ExpressionValueGenerator struct {
 	seed *rand.Rand
 }
@@ -34,12 +47,31 @@ const (
 	ASCII    = Alphabet + Numerals + Symbols
 )
 
+// MaxExpressionValueLength is the maximum length of the values generated by
+// the ExpressionValueGenerator.
+const MaxExpressionValueLength = 4096
+
 var (
-	rangeExp      = regexp.MustCompile(`([\\]?[a-zA-Z0-9]\-?[a-zA-Z0-9]?)`)
-	generatorsExp = regexp.MustCompile(`\[([a-zA-Z0-9\-\\]+)\](\{([0-9]+)\})`)
-	expressionExp = regexp.MustCompile(`\[(\\w|\\d|\\a|\\A)|([a-zA-Z0-9]\-[a-zA-Z0-9])+\]`)
+	rangeExp        = regexp.MustCompile(`([\\]?[a-zA-Z0-9]\-?[a-zA-Z0-9]?)`)
+	rangesExp       = regexp.MustCompile(`^[a-zA-Z0-9\-\\]+$`)
+	expressionExp   = regexp.MustCompile(`\[(\\w|\\d|\\a|\\A)|([a-zA-Z0-9]\-[a-zA-Z0-9])+\]`)
+	numericRangeExp = regexp.MustCompile(`^([0-9]+)-([0-9]+)$`)
+	countExp        = regexp.MustCompile(`^([0-9]+)(,([0-9]+))?$`)
 )
 
+// ExpressionError is returned for an invalid expression. Position is the
+// offset, starting at 0, of the offending construct in the expression.
+type ExpressionError struct {
+	Expression string
+	Position   int
+	Message    string
+}
+
+// Error implements error.
+func (e *ExpressionError) Error() string {
+	return fmt.Sprintf("invalid expression %q at position %d: %s", e.Expression, e.Position, e.Message)
+}
+
 // NewExpressionValueGenerator creates new ExpressionValueGenerator.
 func NewExpressionValueGenerator(seed *rand.Rand) ExpressionValueGenerator {
 	return ExpressionValueGenerator{seed: seed}
@@ -49,27 +81,189 @@ func NewExpressionValueGenerator(seed *rand.Rand) ExpressionValueGenerator {
 // The input expression is a pseudo-regex formatted string. See
 // ExpressionValueGenerator for more details.
 func (g ExpressionValueGenerator) GenerateValue(expression string) (interface{}, error) {
-	for {
-		r := generatorsExp.FindStringIndex(expression)
-		if r == nil {
-			break
+	p := expressionParser{expression: expression, seed: g.seed}
+	value, err := p.generate(0, len(expression))
+	if err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+// expressionParser generates the value of an expression while parsing it.
+type expressionParser struct {
+	expression string
+	seed       *rand.Rand
+}
+
+func (p *expressionParser) errorf(pos int, format string, args ...interface{}) error {
+	return &ExpressionError{Expression: p.expression, Position: pos, Message: fmt.Sprintf(format, args...)}
+}
+
+// generate returns the value generated from p.expression[start:end].
+func (p *expressionParser) generate(start, end int) (string, error) {
+	s := p.expression
+	result := ""
+	for i := start; i < end; {
+		var value string
+		next := i + 1
+		switch s[i] {
+		case '[':
+			closing := strings.IndexByte(s[i:end], ']')
+			if closing == -1 {
+				value = s[i:next]
+				break
+			}
+			closing += i
+			contents := s[i+1 : closing]
+			after := closing + 1
+			switch {
+			case after < end && s[after] == '{':
+				min, max, countEnd, err := p.parseCount(after, end)
+				if err != nil {
+					return "", err
+				}
+				value, err = p.generateCharacters(i, contents, min, max)
+				if err != nil {
+					return "", err
+				}
+				next = countEnd
+			case numericRangeExp.MatchString(contents):
+				var err error
+				value, err = p.generateNumber(i, contents)
+				if err != nil {
+					return "", err
+				}
+				next = after
+			default:
+				value = s[i:next]
+			}
+		case '(':
+			closing := p.findGroupEnd(i, end)
+			if closing == -1 || closing+1 == end || s[closing+1] != '{' {
+				value = s[i:next]
+				break
+			}
+			min, max, countEnd, err := p.parseCount(closing+1, end)
+			if err != nil {
+				return "", err
+			}
+			count := p.randomLength(min, max)
+			for j := 0; j < count; j++ {
+				repeated, err := p.generate(i+1, closing)
+				if err != nil {
+					return "", err
+				}
+				value += repeated
+				if len(value) > MaxExpressionValueLength {
+					break
+				}
+			}
+			next = countEnd
+		default:
+			value = s[i:next]
 		}
-		ranges, length, err := rangesAndLength(expression[r[0]:r[1]])
-		if err != nil {
-			return "", err
+		result += value
+		if len(result) > MaxExpressionValueLength {
+			return "", p.errorf(i, "the generated value exceeds %d characters", MaxExpressionValueLength)
 		}
-		err = replaceWithGenerated(
-			&expression,
-			expression[r[0]:r[1]],
-			findExpressionPos(ranges),
-			length,
-			g.seed,
-		)
-		if err != nil {
-			return "", err
+		i = next
+	}
+	return result, nil
+}
+
+// parseCount parses the "{count}" or "{min,max}" construct starting at pos
+// and returns its bounds and the position following it.
+func (p *expressionParser) parseCount(pos, end int) (int, int, int, error) {
+	closing := strings.IndexByte(p.expression[pos:end], '}')
+	if closing == -1 {
+		return 0, 0, 0, p.errorf(pos, "missing '}'")
+	}
+	closing += pos
+	spec := p.expression[pos+1 : closing]
+	match := countExp.FindStringSubmatch(spec)
+	if match == nil {
+		return 0, 0, 0, p.errorf(pos, "expected {count} or {min,max}, got {%s}", spec)
+	}
+	min, _ := strconv.Atoi(match[1])
+	max := min
+	if len(match[3]) > 0 {
+		max, _ = strconv.Atoi(match[3])
+	}
+	// TODO: We do need to set a better limit for the number of generated characters.
+	if max <= 0 || max > 255 || min > max {
+		return 0, 0, 0, p.errorf(pos, "range must be within [1-255] characters (%s)", spec)
+	}
+	return min, max, closing + 1, nil
+}
+
+// generateCharacters generates between min and max random characters of the
+// ranges of the "[ranges]" construct starting at pos.
+func (p *expressionParser) generateCharacters(pos int, ranges string, min, max int) (string, error) {
+	if !rangesExp.MatchString(ranges) || !expressionExp.MatchString("["+ranges+"]") {
+		return "", p.errorf(pos, "malformed expression syntax: [%s]", ranges)
+	}
+	alphabet, err := rangesAlphabet(findExpressionPos(ranges))
+	if err != nil {
+		return "", p.errorf(pos, "%v", err)
+	}
+	length := p.randomLength(min, max)
+	result := make([]byte, length)
+	for i := 0; i < length; i++ {
+		result[i] = alphabet[p.seed.Intn(len(alphabet))]
+	}
+	return string(result), nil
+}
+
+// generateNumber generates a random number within the bounds of the
+// "[min-max]" construct starting at pos.
+func (p *expressionParser) generateNumber(pos int, bounds string) (string, error) {
+	match := numericRangeExp.FindStringSubmatch(bounds)
+	min, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil {
+		return "", p.errorf(pos, "invalid number %s", match[1])
+	}
+	max, err := strconv.ParseInt(match[2], 10, 64)
+	if err != nil {
+		return "", p.errorf(pos, "invalid number %s", match[2])
+	}
+	if min > max {
+		return "", p.errorf(pos, "invalid range specified: %s", bounds)
+	}
+	size := max - min + 1
+	if size <= 0 {
+		return "", p.errorf(pos, "the range %s is too large", bounds)
+	}
+	value := min + p.seed.Int63n(size)
+	if width := len(match[1]); width > 1 && match[1][0] == '0' {
+		return fmt.Sprintf("%0*d", width, value), nil
+	}
+	return strconv.FormatInt(value, 10), nil
+}
+
+// findGroupEnd returns the position of the ')' closing the group starting
+// at pos, or -1 if the group is not closed.
+func (p *expressionParser) findGroupEnd(pos, end int) int {
+	depth := 0
+	for i := pos; i < end; i++ {
+		switch p.expression[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
 		}
 	}
-	return expression, nil
+	return -1
+}
+
+// randomLength returns a random length between min and max.
+func (p *expressionParser) randomLength(min, max int) int {
+	if min == max {
+		return min
+	}
+	return min + p.seed.Intn(max-min+1)
 }
 
 // alphabetSlice produces a string slice that contains all characters within
@@ -83,9 +277,9 @@ func alphabetSlice(from, to byte) (string, error) {
 	return ASCII[leftPos:rightPos], nil
 }
 
-// replaceWithGenerated replaces all occurrences of the given expression
-// in the string with random characters of the specified range and length.
-func replaceWithGenerated(s *string, expression string, ranges [][]byte, length int, seed *rand.Rand) error {
+// rangesAlphabet returns the characters of the given ranges, see
+// findExpressionPos.
+func rangesAlphabet(ranges [][]byte) (string, error) {
 	var alphabet string
 	for _, r := range ranges {
 		switch string(r[0]) + string(r[1]) {
@@ -100,18 +294,16 @@ func replaceWithGenerated(s *string, expression string, ranges [][]byte, length
 		default:
 			slice, err := alphabetSlice(r[0], r[1])
 			if err != nil {
-				return err
+				return "", err
 			}
 			alphabet += slice
 		}
 	}
-	result := make([]byte, length)
 	alphabet = removeDuplicateChars(alphabet)
-	for i := 0; i < length; i++ {
-		result[i] = alphabet[seed.Intn(len(alphabet))]
+	if len(alphabet) == 0 {
+		return "", fmt.Errorf("the ranges do not contain any character")
 	}
-	*s = strings.Replace(*s, expression, string(result), 1)
-	return nil
+	return alphabet, nil
 }
 
 // removeDuplicateChars removes the duplicate characters from the data slice
@@ -141,20 +333,3 @@ func findExpressionPos(s string) [][]byte {
 	}
 	return result
 }
-
-// rangesAndLength extracts the expression ranges (eg. [A-Z0-9]) and length
-// (eg. {3}). This helper function also validates the expression syntax and
-// its length (must be within 1..255).
-func rangesAndLength(s string) (string, int, error) {
-	expr := s[0:strings.LastIndex(s, "{")]
-	if !expressionExp.MatchString(expr) {
-		return "", 0, fmt.Errorf("malformed expresion syntax: %s", expr)
-	}
-
-	length, _ := strconv.Atoi(s[strings.LastIndex(s, "{")+1 : len(s)-1])
-	// TODO: We do need to set a better limit for the number of generated characters.
-	if length > 0 && length <= 255 {
-		return expr, length, nil
-	}
-	return "", 0, fmt.Errorf("range must be within [1-255] characters (%d)", length)
-}
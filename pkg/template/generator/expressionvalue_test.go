@@ -2,6 +2,8 @@ package generator
 
 import (
 	"math/rand"
+	"regexp"
+	"strconv"
 	"testing"
 )
 
@@ -71,3 +73,81 @@ func TestExpressionValueGeneratorErrors(t *testing.T) {
 		t.Errorf("Expected Invalid range specified error, got %s", v)
 	}
 }
+
+func TestExpressionValueGeneratorConstructs(t *testing.T) {
+	var tests = []struct {
+		Expression string
+		Pattern    string
+	}{
+		{"port-[1000-9999]", `^port-[1-9][0-9]{3}$`},
+		{"id-[0001-0099]", `^id-00[0-9]{2}$`},
+		{"[0-0]", `^0$`},
+		{"[a-z]{3,6}", `^[a-y]{3,6}$`},
+		{"[\\d]{0,2}x", `^[0-9]{0,2}x$`},
+		{"([A-Z]{2}-){3}[\\d]{2}", `^([A-Y]{2}-){3}[0-9]{2}$`},
+		{"(([0-9]{1}){2}.){2,3}", `^([0-8]{2}\.){2,3}$`},
+		{"array[0]", `^array\[0\]$`},
+		{"[a-z] (default)", `^\[a-z\] \(default\)$`},
+		{"[unclosed {3}", `^\[unclosed \{3\}$`},
+	}
+
+	for _, test := range tests {
+		generator := NewExpressionValueGenerator(rand.New(rand.NewSource(1337)))
+		value, err := generator.GenerateValue(test.Expression)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.Expression, err)
+			continue
+		}
+		if !regexp.MustCompile(test.Pattern).MatchString(value.(string)) {
+			t.Errorf("%s: expected a value matching %s, got %q", test.Expression, test.Pattern, value)
+		}
+	}
+}
+
+func TestExpressionValueGeneratorNumericRange(t *testing.T) {
+	generator := NewExpressionValueGenerator(rand.New(rand.NewSource(1337)))
+	for i := 0; i < 100; i++ {
+		value, err := generator.GenerateValue("[10-20]")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		n, err := strconv.Atoi(value.(string))
+		if err != nil || n < 10 || n > 20 {
+			t.Fatalf("expected a number within [10-20], got %q", value)
+		}
+	}
+}
+
+func TestExpressionValueGeneratorErrorPosition(t *testing.T) {
+	var tests = []struct {
+		Expression string
+		Position   int
+	}{
+		{"abc[ABC]{3}", 3},
+		{"abc[A-Z]{3", 8},
+		{"abc[A-Z]{x}", 8},
+		{"abc[A-Z]{5,2}", 8},
+		{"x[9-1]", 1},
+		{"x[0-99999999999999999999]", 1},
+		{"ab(cd[A-Z]{3}){0}", 14},
+		{"ab(c[Z-A]{3}){2}", 4},
+		{"(((x){255}){255}){255}", 1},
+	}
+
+	generator := NewExpressionValueGenerator(rand.New(rand.NewSource(1337)))
+	for _, test := range tests {
+		v, err := generator.GenerateValue(test.Expression)
+		if err == nil {
+			t.Errorf("%s: expected an error, got %q", test.Expression, v)
+			continue
+		}
+		exprErr, ok := err.(*ExpressionError)
+		if !ok {
+			t.Errorf("%s: expected an ExpressionError, got %v", test.Expression, err)
+			continue
+		}
+		if exprErr.Position != test.Position {
+			t.Errorf("%s: expected the error at position %d, got %v", test.Expression, test.Position, err)
+		}
+	}
+}
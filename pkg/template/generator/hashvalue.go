@@ -0,0 +1,66 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// BcryptValueGenerator implements Generator interface. It generates the bcrypt
+// hash of the input expression, typically a password referenced through a
+// parameter, eg. "${ADMIN_PASSWORD}".
+type BcryptValueGenerator struct {
+	cost int
+}
+
+// NewBcryptValueGenerator creates new BcryptValueGenerator using the default
+// bcrypt cost.
+func NewBcryptValueGenerator() BcryptValueGenerator {
+	return BcryptValueGenerator{cost: bcrypt.DefaultCost}
+}
+
+// GenerateValue returns the bcrypt hash of the input expression.
+func (g BcryptValueGenerator) GenerateValue(expression string) (interface{}, error) {
+	if len(expression) == 0 {
+		return "", fmt.Errorf("the value to hash must not be empty")
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(expression), g.cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// HtpasswdValueGenerator implements Generator interface. It generates an
+// htpasswd file entry, with the password hashed using bcrypt, from an input
+// expression of the form "username:password".
+//
+// Examples:
+//
+// from                       | value
+// -----------------------------
+// "admin:${ADMIN_PASSWORD}"  | "admin:$2y$10$..."
+type HtpasswdValueGenerator struct {
+	bcrypt BcryptValueGenerator
+}
+
+// NewHtpasswdValueGenerator creates new HtpasswdValueGenerator.
+func NewHtpasswdValueGenerator() HtpasswdValueGenerator {
+	return HtpasswdValueGenerator{bcrypt: NewBcryptValueGenerator()}
+}
+
+// GenerateValue returns the htpasswd entry for the "username:password" input
+// expression.
+func (g HtpasswdValueGenerator) GenerateValue(expression string) (interface{}, error) {
+	parts := strings.SplitN(expression, ":", 2)
+	if len(parts) != 2 || len(parts[0]) == 0 {
+		return "", fmt.Errorf("malformed expresion syntax, expected username:password")
+	}
+	hash, err := g.bcrypt.GenerateValue(parts[1])
+	if err != nil {
+		return "", err
+	}
+	// htpasswd identifies bcrypt hashes with the $2y$ prefix
+	return parts[0] + ":$2y$" + strings.TrimPrefix(hash.(string), "$2a$"), nil
+}
@@ -0,0 +1,47 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestBcryptValueGenerator(t *testing.T) {
+	generator := NewBcryptValueGenerator()
+	value, err := generator.GenerateValue("secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(value.(string)), []byte("secret")); err != nil {
+		t.Errorf("expected %q to be the hash of the password: %v", value, err)
+	}
+
+	if v, err := generator.GenerateValue(""); err == nil {
+		t.Errorf("Expected an error hashing an empty value (returned: %s)", v)
+	}
+}
+
+func TestHtpasswdValueGenerator(t *testing.T) {
+	generator := NewHtpasswdValueGenerator()
+	value, err := generator.GenerateValue("admin:pass:word")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	parts := strings.SplitN(value.(string), ":", 2)
+	if parts[0] != "admin" {
+		t.Errorf("expected the admin user, got %q", value)
+	}
+	if !strings.HasPrefix(parts[1], "$2y$") {
+		t.Errorf("expected an htpasswd bcrypt hash, got %q", value)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(parts[1]), []byte("pass:word")); err != nil {
+		t.Errorf("expected %q to be the hash of the password: %v", value, err)
+	}
+
+	for _, expression := range []string{"admin", ":password", "admin:"} {
+		if v, err := generator.GenerateValue(expression); err == nil {
+			t.Errorf("Expected %s to produce an error (returned: %s)", expression, v)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package generator
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	servercrypto "github.com/openshift/origin/pkg/cmd/server/crypto"
+)
+
+// certificateLifetime is the validity of the generated self-signed certificates
+const certificateLifetime = 365 * 24 * time.Hour
+
+var (
+	rsaKeySizes = map[string]int{"": 2048, "2048": 2048, "3072": 3072, "4096": 4096}
+	ecdsaCurves = map[string]elliptic.Curve{"": elliptic.P256(), "P256": elliptic.P256(), "P384": elliptic.P384(), "P521": elliptic.P521()}
+)
+
+// PrivateKeyValueGenerator implements Generator interface. It generates a
+// private key and returns it PEM encoded. The input expression selects the
+// key algorithm and, optionally, its size or curve in the "algorithm[:size]"
+// form. The public part of the key, or a certificate for it, can then be
+// generated in other parameters referencing this one.
+//
+// Examples:
+//
+// from          | value
+// -----------------------------
+// ""            | RSA 2048 bit key
+// "rsa:4096"    | RSA 4096 bit key
+// "ecdsa"       | ECDSA P256 key
+// "ecdsa:P384"  | ECDSA P384 key
+type PrivateKeyValueGenerator struct {
+	source io.Reader
+}
+
+// NewPrivateKeyValueGenerator creates new PrivateKeyValueGenerator. The source
+// should be a cryptographically secure random source, such as
+// crypto/rand.Reader.
+func NewPrivateKeyValueGenerator(source io.Reader) PrivateKeyValueGenerator {
+	return PrivateKeyValueGenerator{source: source}
+}
+
+// GenerateValue generates a new private key as specified by the input
+// expression.
+func (g PrivateKeyValueGenerator) GenerateValue(expression string) (interface{}, error) {
+	parts := strings.SplitN(expression, ":", 2)
+	size := ""
+	if len(parts) == 2 {
+		size = parts[1]
+	}
+	var block *pem.Block
+	switch parts[0] {
+	case "", "rsa":
+		bits, ok := rsaKeySizes[size]
+		if !ok {
+			return "", fmt.Errorf("unsupported RSA key size %q, must be one of 2048, 3072 or 4096", size)
+		}
+		key, err := rsa.GenerateKey(g.source, bits)
+		if err != nil {
+			return "", err
+		}
+		block = &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}
+	case "ecdsa":
+		curve, ok := ecdsaCurves[size]
+		if !ok {
+			return "", fmt.Errorf("unsupported ECDSA curve %q, must be one of P256, P384 or P521", size)
+		}
+		key, err := ecdsa.GenerateKey(curve, g.source)
+		if err != nil {
+			return "", err
+		}
+		data, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return "", err
+		}
+		block = &pem.Block{Type: "EC PRIVATE KEY", Bytes: data}
+	default:
+		return "", fmt.Errorf("malformed expresion syntax: %s", expression)
+	}
+	return string(pem.EncodeToMemory(block)), nil
+}
+
+// PublicKeyValueGenerator implements Generator interface. It returns the
+// public key of the PEM encoded private key given as the input expression,
+// usually a reference to a parameter generated by PrivateKeyValueGenerator,
+// eg. "${SSH_PRIVATE_KEY}". The public key is either PEM encoded or in the
+// SSH authorized_keys format.
+type PublicKeyValueGenerator struct {
+	ssh bool
+}
+
+// NewPublicKeyValueGenerator creates new PublicKeyValueGenerator returning PEM
+// encoded public keys.
+func NewPublicKeyValueGenerator() PublicKeyValueGenerator {
+	return PublicKeyValueGenerator{}
+}
+
+// NewSSHPublicKeyValueGenerator creates new PublicKeyValueGenerator returning
+// public keys in the SSH authorized_keys format.
+func NewSSHPublicKeyValueGenerator() PublicKeyValueGenerator {
+	return PublicKeyValueGenerator{ssh: true}
+}
+
+// GenerateValue returns the public key of the private key given as the input
+// expression.
+func (g PublicKeyValueGenerator) GenerateValue(expression string) (interface{}, error) {
+	key, err := parsePrivateKey(expression)
+	if err != nil {
+		return "", err
+	}
+	public := key.Public()
+	if g.ssh {
+		sshKey, err := ssh.NewPublicKey(public)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshKey))), nil
+	}
+	data, err := x509.MarshalPKIXPublicKey(public)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: data})), nil
+}
+
+// CertificateValueGenerator implements Generator interface. It generates a
+// self-signed serving certificate and returns it PEM encoded. The input
+// expression is a comma separated list of the hostnames or IP addresses
+// the certificate is valid for, followed by the PEM encoded private key of
+// the certificate, usually a reference to a parameter generated by
+// PrivateKeyValueGenerator.
+//
+// Examples:
+//
+// from                                        | value
+// -----------------------------
+// "www.example.com ${TLS_KEY}"                | certificate for www.example.com
+// "www.example.com,10.0.0.1 ${TLS_KEY}"       | certificate for both hosts
+type CertificateValueGenerator struct {
+	source io.Reader
+}
+
+// NewCertificateValueGenerator creates new CertificateValueGenerator. The
+// source should be a cryptographically secure random source, such as
+// crypto/rand.Reader.
+func NewCertificateValueGenerator(source io.Reader) CertificateValueGenerator {
+	return CertificateValueGenerator{source: source}
+}
+
+// GenerateValue generates a self-signed certificate as specified by the input
+// expression.
+func (g CertificateValueGenerator) GenerateValue(expression string) (interface{}, error) {
+	index := strings.Index(expression, "-----BEGIN")
+	if index == -1 {
+		return "", fmt.Errorf("malformed expresion syntax, expected the hostnames followed by a PEM encoded private key")
+	}
+	hosts := []string{}
+	for _, host := range strings.Split(expression[:index], ",") {
+		if host = strings.TrimSpace(host); len(host) > 0 {
+			hosts = append(hosts, host)
+		}
+	}
+	if len(hosts) == 0 {
+		return "", fmt.Errorf("at least one hostname must be specified")
+	}
+	key, err := parsePrivateKey(expression[index:])
+	if err != nil {
+		return "", err
+	}
+
+	serial, err := serialNumber(g.source)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		Subject:      pkix.Name{CommonName: hosts[0]},
+		NotBefore:    now.Add(-1 * time.Second),
+		NotAfter:     now.Add(certificateLifetime),
+		SerialNumber: serial,
+
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}
+	template.IPAddresses, template.DNSNames = servercrypto.IPAddressesDNSNames(hosts)
+
+	data, err := x509.CreateCertificate(g.source, template, template, key.Public(), key)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: data})), nil
+}
+
+// parsePrivateKey decodes the PEM encoded RSA or ECDSA private key in data.
+func parsePrivateKey(data string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(data)))
+	if block == nil {
+		return nil, fmt.Errorf("the value must be a PEM encoded private key")
+	}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+	return nil, fmt.Errorf("unsupported private key type %q", block.Type)
+}
+
+// serialNumber returns a random 128 bit certificate serial number.
+func serialNumber(source io.Reader) (*big.Int, error) {
+	data := make([]byte, 16)
+	if _, err := io.ReadFull(source, data); err != nil {
+		return nil, fmt.Errorf("unable to read random bytes: %v", err)
+	}
+	return new(big.Int).SetBytes(data), nil
+}
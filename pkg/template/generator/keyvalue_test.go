@@ -0,0 +1,126 @@
+package generator
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func TestPrivateKeyValueGenerator(t *testing.T) {
+	generator := NewPrivateKeyValueGenerator(rand.Reader)
+	tests := map[string]string{
+		"":           "RSA PRIVATE KEY",
+		"rsa":        "RSA PRIVATE KEY",
+		"rsa:3072":   "RSA PRIVATE KEY",
+		"ecdsa":      "EC PRIVATE KEY",
+		"ecdsa:P384": "EC PRIVATE KEY",
+	}
+	for expression, blockType := range tests {
+		value, err := generator.GenerateValue(expression)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", expression, err)
+			continue
+		}
+		key, err := parsePrivateKey(value.(string))
+		if err != nil {
+			t.Errorf("%q: unable to parse the generated key: %v", expression, err)
+			continue
+		}
+		if block, _ := pem.Decode([]byte(value.(string))); block.Type != blockType {
+			t.Errorf("%q: expected a %s, got %s", expression, blockType, block.Type)
+		}
+		if expression == "rsa:3072" && key.(*rsa.PrivateKey).N.BitLen() != 3072 {
+			t.Errorf("%q: expected a 3072 bit key", expression)
+		}
+		if expression == "ecdsa:P384" && key.(*ecdsa.PrivateKey).Curve.Params().Name != "P-384" {
+			t.Errorf("%q: expected a P-384 key", expression)
+		}
+	}
+
+	for _, expression := range []string{"dsa", "rsa:1024", "ecdsa:P224"} {
+		if v, err := generator.GenerateValue(expression); err == nil {
+			t.Errorf("Expected %s to produce an error (returned: %s)", expression, v)
+		}
+	}
+}
+
+func TestPublicKeyValueGenerator(t *testing.T) {
+	value, err := NewPrivateKeyValueGenerator(rand.Reader).GenerateValue("ecdsa")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	private := value.(string)
+
+	value, err = NewPublicKeyValueGenerator().GenerateValue(private)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	block, _ := pem.Decode([]byte(value.(string)))
+	if block == nil || block.Type != "PUBLIC KEY" {
+		t.Fatalf("expected a PEM encoded public key, got %q", value)
+	}
+	if _, err := x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+		t.Errorf("unable to parse the public key: %v", err)
+	}
+
+	value, err = NewSSHPublicKeyValueGenerator().GenerateValue(private)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(value.(string), "ecdsa-sha2-nistp256 ") {
+		t.Errorf("expected an SSH public key, got %q", value)
+	}
+	if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(value.(string))); err != nil {
+		t.Errorf("unable to parse the SSH public key: %v", err)
+	}
+
+	if v, err := NewPublicKeyValueGenerator().GenerateValue("not a key"); err == nil {
+		t.Errorf("Expected an invalid key to produce an error (returned: %s)", v)
+	}
+}
+
+func TestCertificateValueGenerator(t *testing.T) {
+	value, err := NewPrivateKeyValueGenerator(rand.Reader).GenerateValue("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	private := value.(string)
+
+	generator := NewCertificateValueGenerator(rand.Reader)
+	value, err = generator.GenerateValue("www.example.com, 10.0.0.1 " + private)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	block, _ := pem.Decode([]byte(value.(string)))
+	if block == nil || block.Type != "CERTIFICATE" {
+		t.Fatalf("expected a PEM encoded certificate, got %q", value)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("unable to parse the certificate: %v", err)
+	}
+	if cert.Subject.CommonName != "www.example.com" {
+		t.Errorf("unexpected common name %q", cert.Subject.CommonName)
+	}
+	if err := cert.VerifyHostname("www.example.com"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := cert.VerifyHostname("10.0.0.1"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature); err != nil {
+		t.Errorf("expected a self-signed certificate: %v", err)
+	}
+
+	for _, expression := range []string{"www.example.com", private, "www.example.com -----BEGIN invalid"} {
+		if v, err := generator.GenerateValue(expression); err == nil {
+			t.Errorf("Expected %q to produce an error (returned: %s)", expression, v)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pborman/uuid"
+)
+
+// UUIDValueGenerator implements Generator interface. It generates random
+// (version 4) UUIDs as defined by RFC 4122, eg.
+// "1b4db7eb-4057-4ddf-91e0-36dec72071f5". The input expression is ignored.
+type UUIDValueGenerator struct {
+	source io.Reader
+}
+
+// NewUUIDValueGenerator creates new UUIDValueGenerator. The source should be
+// a cryptographically secure random source, such as crypto/rand.Reader.
+func NewUUIDValueGenerator(source io.Reader) UUIDValueGenerator {
+	return UUIDValueGenerator{source: source}
+}
+
+// GenerateValue generates a random UUID.
+func (g UUIDValueGenerator) GenerateValue(expression string) (interface{}, error) {
+	data := make([]byte, 16)
+	if _, err := io.ReadFull(g.source, data); err != nil {
+		return "", fmt.Errorf("unable to read random bytes: %v", err)
+	}
+	data[6] = (data[6] & 0x0f) | 0x40 // version 4
+	data[8] = (data[8] & 0x3f) | 0x80 // RFC 4122 variant
+	return uuid.UUID(data).String(), nil
+}
@@ -0,0 +1,43 @@
+package generator
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/pborman/uuid"
+)
+
+func TestUUIDValueGenerator(t *testing.T) {
+	source := bytes.Repeat([]byte{0xff}, 16)
+	generator := NewUUIDValueGenerator(bytes.NewReader(source))
+	value, err := generator.GenerateValue("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := "ffffffff-ffff-4fff-bfff-ffffffffffff"; value != expected {
+		t.Errorf("expected %s, got %s", expected, value)
+	}
+
+	generator = NewUUIDValueGenerator(rand.Reader)
+	for i := 0; i < 10; i++ {
+		value, err := generator.GenerateValue("")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		parsed := uuid.Parse(value.(string))
+		if parsed == nil {
+			t.Fatalf("expected a valid UUID, got %q", value)
+		}
+		if version, ok := parsed.Version(); !ok || version != 4 || parsed.Variant() != uuid.RFC4122 {
+			t.Errorf("expected a version 4 RFC 4122 UUID, got %q", value)
+		}
+	}
+}
+
+func TestUUIDValueGeneratorErrors(t *testing.T) {
+	generator := NewUUIDValueGenerator(bytes.NewReader([]byte("short")))
+	if v, err := generator.GenerateValue(""); err == nil {
+		t.Errorf("Expected an error reading from a short source (returned: %s)", v)
+	}
+}
@@ -0,0 +1,188 @@
+package template
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	"github.com/openshift/origin/pkg/template/api"
+	"github.com/openshift/origin/pkg/util"
+)
+
+// TemplateGetter retrieves the Templates referenced by Template includes.
+type TemplateGetter interface {
+	Get(namespace, name string) (*api.Template, error)
+}
+
+// TemplateGetterFunc is a function that implements TemplateGetter.
+type TemplateGetterFunc func(namespace, name string) (*api.Template, error)
+
+// Get implements TemplateGetter
+func (f TemplateGetterFunc) Get(namespace, name string) (*api.Template, error) {
+	return f(namespace, name)
+}
+
+// ResolveIncludes flattens the Templates included by t, either through its
+// Includes or as inline Template objects, into t. The objects of an included
+// Template are labeled and annotated with its ObjectLabels and
+// ObjectAnnotations and its parameters are added to t unless t already
+// declares a parameter with the same name.
+func (p *Processor) ResolveIncludes(t *api.Template) field.ErrorList {
+	return p.resolveIncludes(t, field.NewPath("template"), sets.NewString())
+}
+
+func (p *Processor) resolveIncludes(t *api.Template, fldPath *field.Path, visited sets.String) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	objects := []runtime.Object{}
+	objectsPath := fldPath.Child("objects")
+	for i, obj := range t.Objects {
+		included, err := inlineTemplate(obj)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(objectsPath.Index(i), obj, fmt.Sprintf("unable to decode the included template: %v", err)))
+			continue
+		}
+		if included == nil {
+			objects = append(objects, obj)
+			continue
+		}
+		if len(included.Namespace) == 0 {
+			included.Namespace = t.Namespace
+		}
+		if errs := p.resolveIncludes(included, objectsPath.Index(i), visited); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+			continue
+		}
+		objects = append(objects, mergeTemplate(t, included)...)
+	}
+
+	includesPath := fldPath.Child("includes")
+	for i, include := range t.Includes {
+		includePath := includesPath.Index(i)
+		namespace := include.Namespace
+		if len(namespace) == 0 {
+			namespace = t.Namespace
+		}
+		key := namespace + "/" + include.Name
+		if visited.Has(key) {
+			allErrs = append(allErrs, field.Invalid(includePath, key, "the template is included recursively"))
+			continue
+		}
+		if p.Templates == nil {
+			allErrs = append(allErrs, field.Invalid(includePath, key, "included templates cannot be retrieved"))
+			continue
+		}
+		stored, err := p.Templates.Get(namespace, include.Name)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(includePath, key, fmt.Sprintf("unable to retrieve the included template: %v", err)))
+			continue
+		}
+		copied, err := kapi.Scheme.DeepCopy(stored)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(includePath, key, err.Error()))
+			continue
+		}
+		included := copied.(*api.Template)
+		included.Namespace = namespace
+
+		visited.Insert(key)
+		errs := p.resolveIncludes(included, includePath, visited)
+		visited.Delete(key)
+		if len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+			continue
+		}
+		objects = append(objects, mergeTemplate(t, included)...)
+	}
+
+	t.Objects = objects
+	t.Includes = nil
+	return allErrs
+}
+
+// CombineTemplates returns a Template with the given name including all the
+// given Templates as inline Template objects, so that processing it produces
+// the objects of all of them. The parameters of the Templates are declared in
+// the returned Template as well, so that their values can be set before
+// processing. When several Templates declare the same parameter, the first
+// declaration is used.
+func CombineTemplates(name string, templates []*api.Template) *api.Template {
+	combined := &api.Template{ObjectMeta: kapi.ObjectMeta{Name: name}}
+	for _, t := range templates {
+		if len(combined.Namespace) == 0 {
+			combined.Namespace = t.Namespace
+		}
+		for _, param := range t.Parameters {
+			if GetParameterByName(combined, param.Name) == nil {
+				combined.Parameters = append(combined.Parameters, param)
+			}
+		}
+		combined.Objects = append(combined.Objects, t)
+	}
+	return combined
+}
+
+// mergeTemplate adds the parameters of the included Template that are not
+// declared in t to t, and returns the objects of the included Template
+// labeled and annotated with its ObjectLabels and ObjectAnnotations.
+func mergeTemplate(t, included *api.Template) []runtime.Object {
+	for _, param := range included.Parameters {
+		if GetParameterByName(t, param.Name) == nil {
+			t.Parameters = append(t.Parameters, param)
+		}
+	}
+	objects := []runtime.Object{}
+	for _, obj := range included.Objects {
+		if unknown, ok := obj.(*runtime.Unknown); ok {
+			if decoded, err := decodeUnstructured(unknown.RawJSON); err == nil {
+				obj = decoded
+			}
+		}
+		if len(included.ObjectLabels) > 0 {
+			// labels are applied again during processing, so a failure is reported there
+			util.AddObjectLabels(obj, included.ObjectLabels)
+		}
+		if len(included.ObjectAnnotations) > 0 {
+			util.AddObjectAnnotations(obj, included.ObjectAnnotations)
+		}
+		objects = append(objects, obj)
+	}
+	return objects
+}
+
+// inlineTemplate returns the Template stored in obj, or nil if obj is not a
+// Template.
+func inlineTemplate(obj runtime.Object) (*api.Template, error) {
+	switch t := obj.(type) {
+	case *api.Template:
+		return t, nil
+	case *runtime.Unknown:
+		decoded, err := runtime.Decode(runtime.UnstructuredJSONScheme, t.RawJSON)
+		if err != nil {
+			// not handled here, processing reports objects that cannot be decoded
+			return nil, nil
+		}
+		return inlineTemplate(decoded)
+	case *runtime.Unstructured:
+		if t.Kind != "Template" {
+			return nil, nil
+		}
+		data, err := runtime.Encode(runtime.UnstructuredJSONScheme, t)
+		if err != nil {
+			return nil, err
+		}
+		decoded, err := runtime.Decode(kapi.Codecs.UniversalDecoder(), data)
+		if err != nil {
+			return nil, err
+		}
+		template, ok := decoded.(*api.Template)
+		if !ok {
+			return nil, fmt.Errorf("expected a template, got %T", decoded)
+		}
+		return template, nil
+	}
+	return nil, nil
+}
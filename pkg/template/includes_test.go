@@ -0,0 +1,164 @@
+package template
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/template/api"
+	"github.com/openshift/origin/pkg/template/generator"
+)
+
+func makeObject(name string) runtime.Object {
+	return &runtime.Unstructured{
+		Object: map[string]interface{}{
+			"kind":     "Service",
+			"metadata": map[string]interface{}{"name": name},
+		},
+	}
+}
+
+func objectNames(objects []runtime.Object) []string {
+	names := []string{}
+	for _, obj := range objects {
+		names = append(names, obj.(*runtime.Unstructured).Object["metadata"].(map[string]interface{})["name"].(string))
+	}
+	return names
+}
+
+func TestResolveIncludes(t *testing.T) {
+	stored := map[string]*api.Template{
+		"ns/db": {
+			ObjectMeta: kapi.ObjectMeta{Name: "db", Namespace: "ns"},
+			Parameters: []api.Parameter{makeParameter("NAME", "db", "", false), makeParameter("DB_USER", "admin", "", false)},
+			Objects:    []runtime.Object{makeObject("${NAME}-database")},
+			Includes:   []api.TemplateInclude{{Name: "volume"}},
+		},
+		"ns/volume": {
+			ObjectMeta: kapi.ObjectMeta{Name: "volume", Namespace: "ns"},
+			Objects:    []runtime.Object{makeObject("${NAME}-volume")},
+		},
+		"ns/loop": {
+			ObjectMeta: kapi.ObjectMeta{Name: "loop", Namespace: "ns"},
+			Includes:   []api.TemplateInclude{{Name: "loop"}},
+		},
+	}
+	getter := TemplateGetterFunc(func(namespace, name string) (*api.Template, error) {
+		if t, ok := stored[namespace+"/"+name]; ok {
+			return t, nil
+		}
+		return nil, fmt.Errorf("not found")
+	})
+
+	tests := map[string]struct {
+		template   api.Template
+		getter     TemplateGetter
+		objects    []string
+		parameters []string
+		errs       int
+	}{
+		"stored include": {
+			template: api.Template{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "ns"},
+				Parameters: []api.Parameter{makeParameter("NAME", "app", "", false)},
+				Objects:    []runtime.Object{makeObject("${NAME}-frontend")},
+				Includes:   []api.TemplateInclude{{Name: "db"}},
+			},
+			getter:     getter,
+			objects:    []string{"app-frontend", "app-database", "app-volume"},
+			parameters: []string{"NAME=app", "DB_USER=admin"},
+		},
+		"inline include": {
+			template: api.Template{
+				Parameters: []api.Parameter{makeParameter("NAME", "app", "", false)},
+				Objects: []runtime.Object{
+					makeObject("${NAME}-frontend"),
+					&api.Template{
+						Parameters: []api.Parameter{makeParameter("SUFFIX", "inline", "", false)},
+						Objects:    []runtime.Object{makeObject("${NAME}-${SUFFIX}")},
+					},
+					makeObject("${NAME}-backend"),
+				},
+			},
+			objects:    []string{"app-frontend", "app-inline", "app-backend"},
+			parameters: []string{"NAME=app", "SUFFIX=inline"},
+		},
+		"missing getter": {
+			template: api.Template{Includes: []api.TemplateInclude{{Name: "db"}}},
+			errs:     1,
+		},
+		"missing template": {
+			template: api.Template{Includes: []api.TemplateInclude{{Name: "missing"}}},
+			getter:   getter,
+			errs:     1,
+		},
+		"recursive include": {
+			template: api.Template{ObjectMeta: kapi.ObjectMeta{Namespace: "ns"}, Includes: []api.TemplateInclude{{Name: "loop"}}},
+			getter:   getter,
+			errs:     1,
+		},
+	}
+
+	for name, test := range tests {
+		processor := NewProcessor(map[string]generator.Generator{})
+		processor.Templates = test.getter
+		template := test.template
+		errs := processor.Process(&template)
+		if len(errs) != test.errs {
+			t.Errorf("%s: expected %d errors, got %v", name, test.errs, errs)
+			continue
+		}
+		if test.errs > 0 {
+			continue
+		}
+		if names := objectNames(template.Objects); !reflect.DeepEqual(names, test.objects) {
+			t.Errorf("%s: expected objects %v, got %v", name, test.objects, names)
+		}
+		parameters := []string{}
+		for _, param := range template.Parameters {
+			parameters = append(parameters, param.Name+"="+param.Value)
+		}
+		if !reflect.DeepEqual(parameters, test.parameters) {
+			t.Errorf("%s: expected parameters %v, got %v", name, test.parameters, parameters)
+		}
+		if len(template.Includes) != 0 {
+			t.Errorf("%s: expected includes to be resolved, got %v", name, template.Includes)
+		}
+	}
+
+	// the stored templates must not be modified
+	if len(stored["ns/db"].Includes) != 1 || objectNames(stored["ns/db"].Objects)[0] != "${NAME}-database" {
+		t.Errorf("stored template was modified: %#v", stored["ns/db"])
+	}
+}
+
+func TestCombineTemplates(t *testing.T) {
+	frontend := &api.Template{
+		ObjectMeta: kapi.ObjectMeta{Name: "frontend", Namespace: "ns"},
+		Parameters: []api.Parameter{makeParameter("NAME", "app", "", false)},
+		Objects:    []runtime.Object{makeObject("${NAME}-frontend")},
+	}
+	backend := &api.Template{
+		ObjectMeta: kapi.ObjectMeta{Name: "backend", Namespace: "ns"},
+		Parameters: []api.Parameter{makeParameter("NAME", "ignored", "", false), makeParameter("DB", "db", "", false)},
+		Objects:    []runtime.Object{makeObject("${NAME}-${DB}")},
+	}
+	combined := CombineTemplates("app", []*api.Template{frontend, backend})
+	if combined.Name != "app" || combined.Namespace != "ns" {
+		t.Errorf("unexpected metadata %#v", combined.ObjectMeta)
+	}
+	if len(combined.Parameters) != 2 || combined.Parameters[0].Value != "app" || combined.Parameters[1].Name != "DB" {
+		t.Errorf("unexpected parameters %#v", combined.Parameters)
+	}
+
+	GetParameterByName(combined, "NAME").Value = "custom"
+	if errs := NewProcessor(nil).Process(combined); len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if names, expected := objectNames(combined.Objects), []string{"custom-frontend", "custom-db"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected objects %v, got %v", expected, names)
+	}
+}
@@ -0,0 +1,60 @@
+package template
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/apimachinery/registered"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+// AddInstanceLabel generates the name of a TemplateInstance recording a new
+// instantiation of the Template and adds the api.TemplateInstanceLabel with
+// that name to the ObjectLabels of the Template, so that every object created
+// by the instantiation is labeled with it. The name is returned.
+func AddInstanceLabel(t *api.Template) string {
+	base := t.Name
+	if len(base) == 0 {
+		base = "template"
+	}
+	name := kapi.SimpleNameGenerator.GenerateName(base + "-")
+	if t.ObjectLabels == nil {
+		t.ObjectLabels = map[string]string{}
+	}
+	t.ObjectLabels[api.TemplateInstanceLabel] = name
+	return name
+}
+
+// NewTemplateInstance returns the TemplateInstance labeled on the objects of
+// the processed Template t by AddInstanceLabel, referring to the named objects
+// of objects in the order they are created.
+func NewTemplateInstance(t *api.Template, objects []runtime.Object) (*api.TemplateInstance, error) {
+	instance := &api.TemplateInstance{
+		ObjectMeta: kapi.ObjectMeta{Name: t.ObjectLabels[api.TemplateInstanceLabel]},
+		Template:   t.Name,
+	}
+	for _, obj := range objects {
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return nil, err
+		}
+		if len(accessor.GetName()) == 0 {
+			continue
+		}
+		gvk, err := kapi.Scheme.ObjectKind(obj)
+		if err != nil {
+			return nil, err
+		}
+		group, err := registered.Group(gvk.Group)
+		if err != nil {
+			return nil, err
+		}
+		instance.Objects = append(instance.Objects, kapi.ObjectReference{
+			Kind:       gvk.Kind,
+			APIVersion: group.GroupVersion.String(),
+			Name:       accessor.GetName(),
+		})
+	}
+	return instance, nil
+}
@@ -0,0 +1,58 @@
+package template
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	_ "github.com/openshift/origin/pkg/api/install"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+func TestAddInstanceLabel(t *testing.T) {
+	template := &api.Template{
+		ObjectMeta:   kapi.ObjectMeta{Name: "ruby"},
+		ObjectLabels: map[string]string{"app": "test"},
+	}
+	name := AddInstanceLabel(template)
+	if !strings.HasPrefix(name, "ruby-") {
+		t.Fatalf("expected the instance to be named after the template, got %s", name)
+	}
+	if template.ObjectLabels[api.TemplateInstanceLabel] != name || template.ObjectLabels["app"] != "test" {
+		t.Errorf("expected the objects to be labeled with %s, got %v", name, template.ObjectLabels)
+	}
+	if other := AddInstanceLabel(template); other == name {
+		t.Errorf("expected a unique instance name, got %s twice", name)
+	}
+}
+
+func TestNewTemplateInstance(t *testing.T) {
+	template := &api.Template{
+		ObjectMeta:   kapi.ObjectMeta{Name: "ruby"},
+		ObjectLabels: map[string]string{api.TemplateInstanceLabel: "ruby-abcde"},
+	}
+	objects := []runtime.Object{
+		&kapi.Service{ObjectMeta: kapi.ObjectMeta{Name: "frontend"}},
+		&kapi.Pod{ObjectMeta: kapi.ObjectMeta{GenerateName: "unnamed-"}},
+		&deployapi.DeploymentConfig{ObjectMeta: kapi.ObjectMeta{Name: "frontend"}},
+	}
+
+	instance, err := NewTemplateInstance(template, objects)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if instance.Name != "ruby-abcde" || instance.Template != "ruby" {
+		t.Errorf("unexpected template instance %#v", instance)
+	}
+	expected := []kapi.ObjectReference{
+		{Kind: "Service", APIVersion: "v1", Name: "frontend"},
+		{Kind: "DeploymentConfig", APIVersion: "v1", Name: "frontend"},
+	}
+	if !reflect.DeepEqual(instance.Objects, expected) {
+		t.Errorf("expected objects %#v, got %#v", expected, instance.Objects)
+	}
+}
@@ -0,0 +1,133 @@
+package library
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/glog"
+
+	"github.com/openshift/origin/pkg/generate/git"
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+// templateFileExtensions are the extensions of the files searched for
+// Templates in a directory.
+var templateFileExtensions = []string{".json", ".yaml", ".yml"}
+
+// GitSource retrieves the Templates stored in a Git repository.
+type GitSource struct {
+	// URL of the repository.
+	URL string
+	// Repository clones the repository, git.NewRepository() if nil.
+	Repository git.Repository
+}
+
+// Templates implements Source. The repository is cloned in a temporary
+// directory, which is read like a DirectorySource.
+func (s *GitSource) Templates() ([]*api.Template, error) {
+	dir, err := ioutil.TempDir("", "templates")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	repository := s.Repository
+	if repository == nil {
+		repository = git.NewRepository()
+	}
+	if err := repository.CloneWithOptions(dir, s.URL, git.CloneOptions{Recursive: false, Quiet: true}); err != nil {
+		return nil, err
+	}
+	return (&DirectorySource{Dir: dir}).Templates()
+}
+
+// DirectorySource retrieves the Templates stored in the JSON and YAML files
+// of a directory tree. The Templates of a file belong to the categories named
+// after the directories containing it, eg. "database/mysql.json" holds
+// Templates in the "database" category. Files that do not hold Templates are
+// ignored.
+type DirectorySource struct {
+	// Dir is the root of the directory tree.
+	Dir string
+}
+
+// Templates implements Source. Symbolic links are only followed to the files
+// of the directory tree, so that a cloned repository cannot have the files of
+// the host read.
+func (s *DirectorySource) Templates() ([]*api.Template, error) {
+	root, err := filepath.EvalSymlinks(s.Dir)
+	if err != nil {
+		return nil, err
+	}
+	templates := []*api.Template{}
+	err = filepath.Walk(s.Dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path != s.Dir && strings.HasPrefix(info.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !isTemplateFile(path) {
+			return nil
+		}
+		if info.Mode()&os.ModeSymlink != 0 && !linksWithin(root, path) {
+			glog.V(4).Infof("Ignoring %s: not a link to a file in %s", path, s.Dir)
+			return nil
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		found, err := decodeTemplates(data)
+		if err != nil {
+			glog.V(4).Infof("Ignoring %s: %v", path, err)
+			return nil
+		}
+		rel, err := filepath.Rel(s.Dir, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		categories := []string{}
+		if rel != "." {
+			categories = strings.Split(filepath.ToSlash(rel), "/")
+		}
+		for _, t := range found {
+			SetCategories(t, categories...)
+		}
+		templates = append(templates, found...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// linksWithin returns true if the symbolic link path resolves to a regular
+// file under root.
+func linksWithin(root, path string) bool {
+	target, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return false
+	}
+	if info, err := os.Stat(target); err != nil || !info.Mode().IsRegular() {
+		return false
+	}
+	rel, err := filepath.Rel(root, target)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func isTemplateFile(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	for _, allowed := range templateFileExtensions {
+		if ext == allowed {
+			return true
+		}
+	}
+	return false
+}
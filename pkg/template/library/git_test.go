@@ -0,0 +1,105 @@
+package library
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestDirectorySource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "templates")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"database/mysql.json":    mysqlTemplate,
+		"languages/ruby.yml":     rubyTemplate,
+		"README.md":              "# templates",
+		"pod.json":               `{"kind": "Pod", "apiVersion": "v1", "metadata": {"name": "pod"}}`,
+		"invalid.yaml":           "{",
+		".git/config/mysql.json": mysqlTemplate,
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	templates, err := (&DirectorySource{Dir: dir}).Templates()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	found := map[string][]string{}
+	for _, template := range templates {
+		found[template.Name] = Categories(template)
+	}
+	expected := map[string][]string{"mysql": {"database"}, "ruby": {"languages"}}
+	if !reflect.DeepEqual(found, expected) {
+		names := []string{}
+		for name := range found {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		t.Errorf("expected %v, got %v (%v)", expected, found, names)
+	}
+}
+
+func TestDirectorySourceSymlinks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "templates")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	outside, err := ioutil.TempDir("", "outside")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(outside)
+
+	if err := os.MkdirAll(filepath.Join(dir, "database"), 0755); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "links"), 0755); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "database", "mysql.json"), []byte(mysqlTemplate), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(outside, "ruby.yml"), []byte(rubyTemplate), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	links := map[string]string{
+		"links/mysql.json": "../database/mysql.json",
+		"links/ruby.yml":   filepath.Join(outside, "ruby.yml"),
+		"links/up.json":    "../../" + filepath.Base(outside) + "/ruby.yml",
+		"links/dir.json":   "../database",
+	}
+	for name, target := range links {
+		if err := os.Symlink(target, filepath.Join(dir, name)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	templates, err := (&DirectorySource{Dir: dir}).Templates()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	found := []string{}
+	for _, template := range templates {
+		found = append(found, fmt.Sprintf("%s %v", template.Name, Categories(template)))
+	}
+	sort.Strings(found)
+	if expected := []string{"mysql [database]", "mysql [links]"}; !reflect.DeepEqual(found, expected) {
+		t.Errorf("expected %v, got %v", expected, found)
+	}
+}
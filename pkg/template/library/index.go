@@ -0,0 +1,110 @@
+package library
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	kyaml "k8s.io/kubernetes/pkg/util/yaml"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+// Index lists the Templates published by an HTTP template repository.
+type Index struct {
+	// Templates are the entries of the index.
+	Templates []IndexEntry `json:"templates"`
+}
+
+// IndexEntry is a file of an HTTP template repository.
+type IndexEntry struct {
+	// Location is the URL of the file holding the Templates, either absolute
+	// or relative to the index.
+	Location string `json:"location"`
+	// Categories the Templates of the file belong to.
+	Categories []string `json:"categories,omitempty"`
+}
+
+// ReadIndex decodes a JSON or YAML Index.
+func ReadIndex(data []byte) (*Index, error) {
+	data, err := kyaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	index := &Index{}
+	if err := json.Unmarshal(data, index); err != nil {
+		return nil, err
+	}
+	for i, entry := range index.Templates {
+		if len(entry.Location) == 0 {
+			return nil, fmt.Errorf("templates[%d]: a location is required", i)
+		}
+	}
+	return index, nil
+}
+
+// HTTPIndexSource retrieves the Templates listed in an Index served over HTTP.
+type HTTPIndexSource struct {
+	// URL of the Index.
+	URL string
+	// Client is used for the requests, http.DefaultClient if nil.
+	Client *http.Client
+}
+
+// Templates implements Source.
+func (s *HTTPIndexSource) Templates() ([]*api.Template, error) {
+	base, err := url.Parse(s.URL)
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.get(base)
+	if err != nil {
+		return nil, err
+	}
+	index, err := ReadIndex(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the template index %s: %v", s.URL, err)
+	}
+
+	templates := []*api.Template{}
+	for _, entry := range index.Templates {
+		location, err := base.Parse(entry.Location)
+		if err != nil {
+			return nil, err
+		}
+		data, err := s.get(location)
+		if err != nil {
+			return nil, err
+		}
+		found, err := decodeTemplatesFrom(location.String(), data)
+		if err != nil {
+			return nil, err
+		}
+		if len(found) == 0 {
+			return nil, fmt.Errorf("%s does not contain a template", location)
+		}
+		for _, t := range found {
+			SetCategories(t, entry.Categories...)
+		}
+		templates = append(templates, found...)
+	}
+	return templates, nil
+}
+
+func (s *HTTPIndexSource) get(location *url.URL) ([]byte, error) {
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Get(location.String())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to retrieve %s: %s", location, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
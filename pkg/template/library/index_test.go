@@ -0,0 +1,78 @@
+package library
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	_ "github.com/openshift/origin/pkg/api/install"
+)
+
+const mysqlTemplate = `{
+	"kind": "Template",
+	"apiVersion": "v1",
+	"metadata": {"name": "mysql", "annotations": {"description": "MySQL database"}},
+	"objects": []
+}`
+
+const rubyTemplate = `kind: Template
+apiVersion: v1
+metadata:
+  name: ruby
+objects: []
+`
+
+func TestHTTPIndexSource(t *testing.T) {
+	files := map[string]string{
+		"/library/index.yaml": `
+templates:
+- location: mysql.json
+  categories: [Database, "not a category"]
+- location: /other/ruby.yaml
+`,
+		"/library/mysql.json": mysqlTemplate,
+		"/other/ruby.yaml":    rubyTemplate,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(content))
+	}))
+	defer server.Close()
+
+	templates, err := (&HTTPIndexSource{URL: server.URL + "/library/index.yaml"}).Templates()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(templates) != 2 || templates[0].Name != "mysql" || templates[1].Name != "ruby" {
+		t.Fatalf("unexpected templates: %#v", templates)
+	}
+	if categories := Categories(templates[0]); !reflect.DeepEqual(categories, []string{"database"}) {
+		t.Errorf("unexpected categories: %v", categories)
+	}
+	if categories := Categories(templates[1]); len(categories) != 0 {
+		t.Errorf("unexpected categories: %v", categories)
+	}
+
+	files["/library/index.yaml"] = "templates:\n- location: missing.json\n"
+	if _, err := (&HTTPIndexSource{URL: server.URL + "/library/index.yaml"}).Templates(); err == nil {
+		t.Errorf("expected an error for a missing template file")
+	}
+}
+
+func TestReadIndex(t *testing.T) {
+	if _, err := ReadIndex([]byte(`{"templates": [{"categories": ["database"]}]}`)); err == nil {
+		t.Errorf("expected an error for an entry without location")
+	}
+	index, err := ReadIndex([]byte(`{"templates": [{"location": "a.json"}]}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(index.Templates) != 1 || index.Templates[0].Location != "a.json" {
+		t.Errorf("unexpected index: %#v", index)
+	}
+}
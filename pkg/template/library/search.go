@@ -0,0 +1,32 @@
+package library
+
+import (
+	"strings"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+// Matches returns true if every term is found, ignoring case, in the name of
+// t, its "description" or "tags" annotations, or one of its categories.
+func Matches(t *api.Template, terms ...string) bool {
+	fields := []string{t.Name, t.Annotations["description"], t.Annotations["tags"]}
+	fields = append(fields, Categories(t)...)
+	text := strings.ToLower(strings.Join(fields, "\n"))
+	for _, term := range terms {
+		if !strings.Contains(text, strings.ToLower(term)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Search returns the Templates matching all the terms, see Matches.
+func Search(templates []api.Template, terms ...string) []api.Template {
+	found := []api.Template{}
+	for i := range templates {
+		if Matches(&templates[i], terms...) {
+			found = append(found, templates[i])
+		}
+	}
+	return found
+}
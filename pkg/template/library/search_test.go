@@ -0,0 +1,44 @@
+package library
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+func TestSearch(t *testing.T) {
+	templates := []api.Template{
+		{ObjectMeta: kapi.ObjectMeta{Name: "mysql-persistent", Annotations: map[string]string{"description": "MySQL database with persistent storage"}}},
+		{ObjectMeta: kapi.ObjectMeta{Name: "mongodb", Annotations: map[string]string{"tags": "nosql,document"}}},
+		{ObjectMeta: kapi.ObjectMeta{Name: "ruby-app"}},
+	}
+	SetCategories(&templates[1], "database")
+	SetCategories(&templates[2], "languages")
+
+	tests := map[string][]string{
+		"database":   {"mysql-persistent", "mongodb"},
+		"DATABASE":   {"mysql-persistent", "mongodb"},
+		"nosql":      {"mongodb"},
+		"language":   {"ruby-app"},
+		"persistent": {"mysql-persistent"},
+		"postgresql": {},
+	}
+	for term, expected := range tests {
+		found := Search(templates, term)
+		if len(found) != len(expected) {
+			t.Errorf("%s: expected %v, got %d templates", term, expected, len(found))
+			continue
+		}
+		for i := range found {
+			if found[i].Name != expected[i] {
+				t.Errorf("%s: expected %v, got %s at %d", term, expected, found[i].Name, i)
+			}
+		}
+	}
+
+	if found := Search(templates, "database", "storage"); len(found) != 1 || found[0].Name != "mysql-persistent" {
+		t.Errorf("expected all the terms to match, got %v", found)
+	}
+}
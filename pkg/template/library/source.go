@@ -0,0 +1,85 @@
+// Package library synchronizes the Templates published by a template
+// repository, either a Git repository or an HTTP index, into a namespace and
+// searches the synchronized Templates.
+package library
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/validation"
+	kyaml "k8s.io/kubernetes/pkg/util/yaml"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+// Source retrieves the Templates published by a template repository.
+type Source interface {
+	// Templates returns the published Templates, labeled with their
+	// categories.
+	Templates() ([]*api.Template, error)
+}
+
+// SetCategories labels t with the given categories. Categories are made
+// lower case and the ones that are not valid label names are ignored.
+func SetCategories(t *api.Template, categories ...string) {
+	for _, category := range categories {
+		category = strings.ToLower(strings.TrimSpace(category))
+		if !validation.IsQualifiedName(api.TemplateCategoryLabelPrefix + category) {
+			continue
+		}
+		if t.Labels == nil {
+			t.Labels = map[string]string{}
+		}
+		t.Labels[api.TemplateCategoryLabelPrefix+category] = "true"
+	}
+}
+
+// Categories returns the sorted categories t is labeled with.
+func Categories(t *api.Template) []string {
+	categories := []string{}
+	for key, value := range t.Labels {
+		if strings.HasPrefix(key, api.TemplateCategoryLabelPrefix) && value == "true" {
+			categories = append(categories, strings.TrimPrefix(key, api.TemplateCategoryLabelPrefix))
+		}
+	}
+	sort.Strings(categories)
+	return categories
+}
+
+// decodeTemplates returns the Templates stored in data, either a Template or
+// a TemplateList in JSON or YAML. Other objects are ignored.
+func decodeTemplates(data []byte) ([]*api.Template, error) {
+	data, err := kyaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := runtime.Decode(kapi.Codecs.UniversalDecoder(), data)
+	if err != nil {
+		return nil, err
+	}
+	switch t := obj.(type) {
+	case *api.Template:
+		return []*api.Template{t}, nil
+	case *api.TemplateList:
+		templates := []*api.Template{}
+		for i := range t.Items {
+			templates = append(templates, &t.Items[i])
+		}
+		return templates, nil
+	}
+	return nil, nil
+}
+
+// decodeTemplatesFrom behaves like decodeTemplates but reports the location
+// the data was read from in its errors.
+func decodeTemplatesFrom(location string, data []byte) ([]*api.Template, error) {
+	templates, err := decodeTemplates(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode the templates in %s: %v", location, err)
+	}
+	return templates, nil
+}
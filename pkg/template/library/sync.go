@@ -0,0 +1,107 @@
+package library
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/labels"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+// Syncer synchronizes the Templates of a template repository into a
+// namespace. The synchronized Templates are labeled with the name of the
+// repository, Templates that already exist but are not managed by the
+// repository are left untouched.
+type Syncer struct {
+	// Client creates, updates and deletes the Templates.
+	Client client.TemplatesNamespacer
+	// Namespace the Templates are synchronized into.
+	Namespace string
+	// Repository is the name of the template repository.
+	Repository string
+	// Prune deletes the Templates managed by the repository that it no
+	// longer publishes.
+	Prune bool
+}
+
+// SyncResult lists the names of the Templates changed by a synchronization.
+type SyncResult struct {
+	Created []string
+	Updated []string
+	Deleted []string
+}
+
+// Sync synchronizes the Templates retrieved from source. An error is
+// returned for every Template that could not be synchronized, the others are
+// synchronized anyway.
+func (s *Syncer) Sync(source Source) (*SyncResult, error) {
+	templates, err := source.Templates()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SyncResult{}
+	errs := []error{}
+	published := sets.NewString()
+	for _, t := range templates {
+		if published.Has(t.Name) {
+			errs = append(errs, fmt.Errorf("template %q is published more than once", t.Name))
+			continue
+		}
+		published.Insert(t.Name)
+
+		t.Namespace = s.Namespace
+		t.ResourceVersion = ""
+		if t.Labels == nil {
+			t.Labels = map[string]string{}
+		}
+		t.Labels[api.TemplateRepositoryLabel] = s.Repository
+
+		existing, err := s.Client.Templates(s.Namespace).Get(t.Name)
+		switch {
+		case kerrors.IsNotFound(err):
+			if _, err := s.Client.Templates(s.Namespace).Create(t); err != nil {
+				errs = append(errs, fmt.Errorf("unable to create template %q: %v", t.Name, err))
+				continue
+			}
+			result.Created = append(result.Created, t.Name)
+		case err != nil:
+			errs = append(errs, fmt.Errorf("unable to retrieve template %q: %v", t.Name, err))
+		case existing.Labels[api.TemplateRepositoryLabel] != s.Repository:
+			errs = append(errs, fmt.Errorf("template %q already exists and is not managed by repository %q", t.Name, s.Repository))
+		default:
+			t.ResourceVersion = existing.ResourceVersion
+			if _, err := s.Client.Templates(s.Namespace).Update(t); err != nil {
+				errs = append(errs, fmt.Errorf("unable to update template %q: %v", t.Name, err))
+				continue
+			}
+			result.Updated = append(result.Updated, t.Name)
+		}
+	}
+
+	if s.Prune {
+		selector := labels.SelectorFromSet(labels.Set{api.TemplateRepositoryLabel: s.Repository})
+		managed, err := s.Client.Templates(s.Namespace).List(kapi.ListOptions{LabelSelector: selector})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to list the templates of repository %q: %v", s.Repository, err))
+		} else {
+			for _, t := range managed.Items {
+				if published.Has(t.Name) {
+					continue
+				}
+				if err := s.Client.Templates(s.Namespace).Delete(t.Name); err != nil && !kerrors.IsNotFound(err) {
+					errs = append(errs, fmt.Errorf("unable to delete template %q: %v", t.Name, err))
+					continue
+				}
+				result.Deleted = append(result.Deleted, t.Name)
+			}
+		}
+	}
+
+	return result, utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,104 @@
+package library
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+type fakeSource []*api.Template
+
+func (s fakeSource) Templates() ([]*api.Template, error) {
+	return s, nil
+}
+
+func repositoryTemplate(name, repository string) *api.Template {
+	t := &api.Template{ObjectMeta: kapi.ObjectMeta{Name: name, Namespace: "openshift", ResourceVersion: "1"}}
+	if len(repository) > 0 {
+		t.Labels = map[string]string{api.TemplateRepositoryLabel: repository}
+	}
+	return t
+}
+
+// fakeTemplatesClient returns a client serving the given templates, that
+// honors the label selectors of list requests.
+func fakeTemplatesClient(templates ...*api.Template) *testclient.Fake {
+	stored := map[string]*api.Template{}
+	for _, t := range templates {
+		stored[t.Name] = t
+	}
+	client := &testclient.Fake{}
+	client.AddReactor("get", "templates", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		name := action.(ktestclient.GetAction).GetName()
+		if t, ok := stored[name]; ok {
+			return true, t, nil
+		}
+		return true, nil, kerrors.NewNotFound(api.Resource("templates"), name)
+	})
+	client.AddReactor("list", "templates", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		selector := action.(ktestclient.ListAction).GetListRestrictions().Labels
+		list := &api.TemplateList{}
+		for _, t := range stored {
+			if selector == nil || selector.Matches(labels.Set(t.Labels)) {
+				list.Items = append(list.Items, *t)
+			}
+		}
+		return true, list, nil
+	})
+	client.AddReactor("*", "templates", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, nil, nil
+	})
+	return client
+}
+
+func TestSync(t *testing.T) {
+	client := fakeTemplatesClient(
+		repositoryTemplate("mysql", "library"),
+		repositoryTemplate("ruby", "library"),
+		repositoryTemplate("custom", ""),
+		repositoryTemplate("other", "other"),
+	)
+	source := fakeSource{
+		{ObjectMeta: kapi.ObjectMeta{Name: "mysql"}},
+		{ObjectMeta: kapi.ObjectMeta{Name: "mongodb"}},
+		{ObjectMeta: kapi.ObjectMeta{Name: "custom"}},
+	}
+	SetCategories(source[1], "database")
+
+	syncer := &Syncer{Client: client, Namespace: "openshift", Repository: "library", Prune: true}
+	result, err := syncer.Sync(source)
+	if err == nil {
+		t.Errorf("expected an error for the template not managed by the repository")
+	}
+	expected := &SyncResult{Created: []string{"mongodb"}, Updated: []string{"mysql"}, Deleted: []string{"ruby"}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %#v, got %#v", expected, result)
+	}
+
+	for _, action := range client.Actions() {
+		switch action.GetVerb() {
+		case "create":
+			created := action.(ktestclient.CreateAction).GetObject().(*api.Template)
+			if created.Labels[api.TemplateRepositoryLabel] != "library" || created.Labels[api.TemplateCategoryLabelPrefix+"database"] != "true" {
+				t.Errorf("unexpected labels on the created template: %v", created.Labels)
+			}
+		case "update":
+			updated := action.(ktestclient.UpdateAction).GetObject().(*api.Template)
+			if updated.Name != "mysql" || updated.ResourceVersion != "1" {
+				t.Errorf("unexpected update of %s with resource version %q", updated.Name, updated.ResourceVersion)
+			}
+		case "delete":
+			if name := action.(ktestclient.DeleteAction).GetName(); name != "ruby" {
+				t.Errorf("unexpected deletion of %s", name)
+			}
+		}
+	}
+}
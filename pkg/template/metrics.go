@@ -0,0 +1,71 @@
+package template
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	kutil "k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+)
+
+var (
+	processCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "template_process_count",
+			Help: "Counter of processed templates broken out by result, either success or failure.",
+		},
+		[]string{"result"},
+	)
+	processLatencies = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name: "template_process_latencies",
+			Help: "Template processing latency distribution in microseconds.",
+			// Use buckets ranging from 1 ms to 4 seconds.
+			Buckets: prometheus.ExponentialBuckets(1000, 2.0, 13),
+		},
+	)
+	generationFailureCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "template_parameter_generation_failure_count",
+			Help: "Counter of parameter values that could not be generated broken out by generator.",
+		},
+		[]string{"generator"},
+	)
+	substitutionCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "template_parameter_substitution_count",
+			Help: "Counter of parameter references substituted into the objects of processed templates.",
+		},
+	)
+
+	registerMetrics sync.Once
+)
+
+// RegisterMetrics registers the template processing metrics, so that they
+// are exposed by the metrics endpoint of the server. It may be called more
+// than once.
+func RegisterMetrics() {
+	registerMetrics.Do(func() {
+		prometheus.MustRegister(processCounter)
+		prometheus.MustRegister(processLatencies)
+		prometheus.MustRegister(generationFailureCounter)
+		prometheus.MustRegister(substitutionCounter)
+	})
+}
+
+// recordProcessing records the result and the latency of the processing of
+// a Template and logs its trace if it took longer than the TraceThreshold
+// of the Processor.
+func (p *Processor) recordProcessing(trace *kutil.Trace, start time.Time, errs field.ErrorList) {
+	result := "success"
+	if len(errs) > 0 {
+		result = "failure"
+	}
+	processCounter.WithLabelValues(result).Inc()
+	processLatencies.Observe(float64(time.Since(start) / time.Microsecond))
+	if p.TraceThreshold > 0 {
+		trace.Step("Processing finished: " + result)
+		trace.LogIfLong(p.TraceThreshold)
+	}
+}
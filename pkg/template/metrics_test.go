@@ -0,0 +1,56 @@
+package template
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/template/api"
+	"github.com/openshift/origin/pkg/template/generator"
+)
+
+func counterValue(t *testing.T, c prometheus.Counter) float64 {
+	m := &dto.Metric{}
+	if err := c.Write(m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return m.GetCounter().GetValue()
+}
+
+func TestProcessMetrics(t *testing.T) {
+	successes := counterValue(t, processCounter.WithLabelValues("success"))
+	failures := counterValue(t, processCounter.WithLabelValues("failure"))
+	generationFailures := counterValue(t, generationFailureCounter.WithLabelValues("error"))
+	substitutions := counterValue(t, substitutionCounter)
+
+	processor := NewProcessor(map[string]generator.Generator{"error": ErrorGenerator{}})
+	template := &api.Template{
+		Parameters: []api.Parameter{makeParameter("NAME", "frontend", "", false)},
+		Objects: []runtime.Object{
+			&kapi.Service{ObjectMeta: kapi.ObjectMeta{Name: "${NAME}", Labels: map[string]string{"app": "${NAME}"}}},
+		},
+	}
+	if errs := processor.Process(template); len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if value := counterValue(t, processCounter.WithLabelValues("success")); value != successes+1 {
+		t.Errorf("expected %v successes, got %v", successes+1, value)
+	}
+	if value := counterValue(t, substitutionCounter); value != substitutions+2 {
+		t.Errorf("expected %v substitutions, got %v", substitutions+2, value)
+	}
+
+	template = &api.Template{Parameters: []api.Parameter{makeParameter("PASSWORD", "", "error", false)}}
+	if errs := processor.Process(template); len(errs) == 0 {
+		t.Fatalf("expected the processing to fail")
+	}
+	if value := counterValue(t, processCounter.WithLabelValues("failure")); value != failures+1 {
+		t.Errorf("expected %v failures, got %v", failures+1, value)
+	}
+	if value := counterValue(t, generationFailureCounter.WithLabelValues("error")); value != generationFailures+1 {
+		t.Errorf("expected %v generation failures, got %v", generationFailures+1, value)
+	}
+}
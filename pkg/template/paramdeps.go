@@ -0,0 +1,97 @@
+package template
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+// parameterReferences returns the names of the parameters referenced by the
+// given parameter. A parameter with a Value references the parameters used in
+// it, otherwise the parameters used in its From field are referenced.
+func parameterReferences(param *api.Parameter) []string {
+	in := param.Value
+	if len(in) == 0 {
+		in = param.From
+	}
+	names := []string{}
+	for _, match := range parameterExp.FindAllStringSubmatch(in, -1) {
+		names = append(names, match[1])
+	}
+	return names
+}
+
+// parameterOrder returns the indexes of the given parameters sorted so that
+// every parameter comes after the parameters it references. Parameters that
+// do not depend on each other keep their declaration order. An error is
+// returned if the references are circular.
+func parameterOrder(params []api.Parameter) ([]int, *field.Error) {
+	indexes := make(map[string]int, len(params))
+	for i := range params {
+		indexes[params[i].Name] = i
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make([]int, len(params))
+	order := make([]int, 0, len(params))
+
+	var visit func(i int, path []string) *field.Error
+	visit = func(i int, path []string) *field.Error {
+		switch state[i] {
+		case visited:
+			return nil
+		case visiting:
+			cycle := append(path, params[i].Name)
+			for j, name := range cycle {
+				if name == params[i].Name {
+					cycle = cycle[j:]
+					break
+				}
+			}
+			err := fmt.Errorf("parameter %s has a circular reference: %s", params[i].Name, strings.Join(cycle, " -> "))
+			return field.Invalid(field.NewPath("template").Child("parameters").Index(i), params[i].Name, err.Error())
+		}
+		state[i] = visiting
+		for _, name := range parameterReferences(&params[i]) {
+			j, ok := indexes[name]
+			if !ok {
+				continue
+			}
+			if err := visit(j, append(path, params[i].Name)); err != nil {
+				return err
+			}
+		}
+		state[i] = visited
+		order = append(order, i)
+		return nil
+	}
+
+	for i := range params {
+		if err := visit(i, nil); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+// expandParameterReferences replaces the ${PARAMETER_NAME} expressions in the
+// input with the values of the referenced parameters. References to
+// parameters that are not declared are left untouched.
+func expandParameterReferences(in string, params []api.Parameter) string {
+	return parameterExp.ReplaceAllStringFunc(in, func(match string) string {
+		name := parameterExp.FindStringSubmatch(match)[1]
+		for _, param := range params {
+			if param.Name == name {
+				return param.Value
+			}
+		}
+		return match
+	})
+}
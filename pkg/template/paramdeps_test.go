@@ -0,0 +1,73 @@
+package template
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+func TestParameterOrder(t *testing.T) {
+	tests := map[string]struct {
+		params   []api.Parameter
+		expected []int
+		cycle    string
+	}{
+		"independent": {
+			params:   []api.Parameter{{Name: "A"}, {Name: "B"}, {Name: "C"}},
+			expected: []int{0, 1, 2},
+		},
+		"dependencies first": {
+			params: []api.Parameter{
+				{Name: "URL", Value: "postgres://${USER}:${PASSWORD}@${HOST}"},
+				{Name: "USER", Value: "admin"},
+				{Name: "PASSWORD", Generate: "expression", From: "[a-z]{8}"},
+				{Name: "HOST", Value: "${SERVICE}.svc"},
+				{Name: "SERVICE", Value: "db"},
+			},
+			expected: []int{1, 2, 4, 3, 0},
+		},
+		"generator input": {
+			params: []api.Parameter{
+				{Name: "HASH", Generate: "bcrypt", From: "${PASSWORD}"},
+				{Name: "PASSWORD", Generate: "expression", From: "[a-z]{8}"},
+			},
+			expected: []int{1, 0},
+		},
+		"undeclared": {
+			params:   []api.Parameter{{Name: "A", Value: "${MISSING}"}},
+			expected: []int{0},
+		},
+		"self reference": {
+			params: []api.Parameter{{Name: "A", Value: "${A}"}},
+			cycle:  "A -> A",
+		},
+		"cycle": {
+			params: []api.Parameter{
+				{Name: "A", Value: "x"},
+				{Name: "B", Value: "${C}"},
+				{Name: "C", Value: "${D}"},
+				{Name: "D", Generate: "expression", From: "${B}"},
+			},
+			cycle: "B -> C -> D -> B",
+		},
+	}
+
+	for name, test := range tests {
+		order, err := parameterOrder(test.params)
+		if len(test.cycle) > 0 {
+			if err == nil || !strings.Contains(err.Detail, test.cycle) {
+				t.Errorf("%s: expected circular reference %q, got %v", name, test.cycle, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !reflect.DeepEqual(order, test.expected) {
+			t.Errorf("%s: expected %v, got %v", name, test.expected, order)
+		}
+	}
+}
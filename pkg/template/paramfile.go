@@ -0,0 +1,94 @@
+package template
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"github.com/ghodss/yaml"
+)
+
+var envLineExp = regexp.MustCompile(`^[a-zA-Z0-9\_]+=`)
+
+// ReadParameterFile reads the parameter values stored in r. The content can
+// either be a list of KEY=VALUE lines, where empty lines and lines starting
+// with '#' are ignored, or a JSON or YAML map of parameter names to values.
+func ReadParameterFile(r io.Reader) (map[string]string, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if isEnvFile(data) {
+		return readEnvParameters(data)
+	}
+	return readMapParameters(data)
+}
+
+// isEnvFile returns true when every significant line of data is a KEY=VALUE
+// assignment.
+func isEnvFile(data []byte) bool {
+	found := false
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if !envLineExp.MatchString(line) {
+			return false
+		}
+		found = true
+	}
+	return found
+}
+
+func readEnvParameters(data []byte) (map[string]string, error) {
+	params := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		params[parts[0]] = parts[1]
+	}
+	return params, scanner.Err()
+}
+
+func readMapParameters(data []byte) (map[string]string, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return map[string]string{}, nil
+	}
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse parameter file: %v", err)
+	}
+	values := map[string]interface{}{}
+	if err := json.Unmarshal(jsonData, &values); err != nil {
+		return nil, fmt.Errorf("parameter file must contain KEY=VALUE lines or a map of parameter names to values: %v", err)
+	}
+	params := make(map[string]string, len(values))
+	for name, value := range values {
+		switch v := value.(type) {
+		case string:
+			params[name] = v
+		case nil:
+			params[name] = ""
+		case map[string]interface{}, []interface{}:
+			return nil, fmt.Errorf("the value of parameter %q must be a scalar", name)
+		default:
+			// numbers and booleans keep their JSON representation
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			params[name] = string(encoded)
+		}
+	}
+	return params, nil
+}
@@ -0,0 +1,64 @@
+package template
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadParameterFile(t *testing.T) {
+	tests := map[string]struct {
+		content  string
+		expected map[string]string
+		err      bool
+	}{
+		"empty": {
+			content:  "",
+			expected: map[string]string{},
+		},
+		"env": {
+			content: `
+# comment
+NAME=frontend
+REPLICAS=3
+
+URL=http://example.com/?a=b
+EMPTY=
+`,
+			expected: map[string]string{"NAME": "frontend", "REPLICAS": "3", "URL": "http://example.com/?a=b", "EMPTY": ""},
+		},
+		"json": {
+			content:  `{"NAME": "frontend", "REPLICAS": 3, "DEBUG": true}`,
+			expected: map[string]string{"NAME": "frontend", "REPLICAS": "3", "DEBUG": "true"},
+		},
+		"yaml": {
+			content:  "NAME: frontend\nREPLICAS: 3\nURL: http://example.com/?a=b\n",
+			expected: map[string]string{"NAME": "frontend", "REPLICAS": "3", "URL": "http://example.com/?a=b"},
+		},
+		"yaml nested": {
+			content: "NAME:\n  nested: value\n",
+			err:     true,
+		},
+		"not a map": {
+			content: "- NAME\n- REPLICAS\n",
+			err:     true,
+		},
+	}
+
+	for name, test := range tests {
+		params, err := ReadParameterFile(strings.NewReader(test.content))
+		if test.err {
+			if err == nil {
+				t.Errorf("%s: expected error", name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !reflect.DeepEqual(params, test.expected) {
+			t.Errorf("%s: expected %#v, got %#v", name, test.expected, params)
+		}
+	}
+}
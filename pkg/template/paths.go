@@ -0,0 +1,75 @@
+package template
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+// substitutable returns true if parameters may be substituted into the object
+// field at the given path, see Processor.IncludePaths and
+// Processor.ExcludePaths.
+func (p *Processor) substitutable(path string) bool {
+	if len(p.IncludePaths) > 0 && !matchesAny(p.IncludePaths, path) {
+		return false
+	}
+	return !matchesAny(p.ExcludePaths, path)
+}
+
+func matchesAny(exps []*regexp.Regexp, path string) bool {
+	for _, exp := range exps {
+		if exp.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// objectPath converts a path reported by stringreplace.VisitObjectPaths into
+// the path of the field in the serialized object. The content of
+// unstructured objects is held in their Object field.
+func objectPath(item runtime.Object, path string) string {
+	if _, ok := item.(*runtime.Unstructured); ok {
+		switch {
+		case path == "name":
+			return "metadata.name"
+		case strings.HasPrefix(path, "object."):
+			return strings.TrimPrefix(path, "object.")
+		}
+	}
+	return path
+}
+
+// CompilePathExpressions compiles the given regular expressions matching
+// object field paths. Each expression must match the whole path.
+func CompilePathExpressions(exps []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(exps))
+	for _, exp := range exps {
+		re, err := regexp.Compile("^(?:" + exp + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid path expression %q: %v", exp, err)
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
+
+// templateExcludePaths returns the path expressions listed in the
+// api.ExcludePathsAnnotation of the Template, one per line.
+func templateExcludePaths(t *api.Template) ([]*regexp.Regexp, error) {
+	value, ok := t.Annotations[api.ExcludePathsAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	exps := []string{}
+	for _, line := range strings.Split(value, "\n") {
+		if line = strings.TrimSpace(line); len(line) > 0 {
+			exps = append(exps, line)
+		}
+	}
+	return CompilePathExpressions(exps)
+}
@@ -1,12 +1,15 @@
 package registry
 
 import (
+	cryptorand "crypto/rand"
+	"fmt"
 	"math/rand"
 	"time"
 
 	"github.com/golang/glog"
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/rest"
 	"k8s.io/kubernetes/pkg/runtime"
 
 	"github.com/openshift/origin/pkg/template"
@@ -17,12 +20,16 @@ import (
 
 // REST implements RESTStorage interface for processing Template objects.
 type REST struct {
+	templates rest.Getter
 }
 
 // NewREST creates new RESTStorage interface for processing Template objects. If
-// legacyReturn is used, a Config object is returned. Otherwise, a List is returned
-func NewREST() *REST {
-	return &REST{}
+// legacyReturn is used, a Config object is returned. Otherwise, a List is returned.
+// The templates storage is used to retrieve the templates included by the
+// processed template, if nil, templates with includes cannot be processed.
+func NewREST(templates rest.Getter) *REST {
+	template.RegisterMetrics()
+	return &REST{templates: templates}
 }
 
 // New returns a new Template
@@ -45,14 +52,34 @@ func (s *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 
 	generators := map[string]generator.Generator{
 		"expression": generator.NewExpressionValueGenerator(rand.New(rand.NewSource(time.Now().UnixNano()))),
+		"base64":     generator.NewBase64ValueGenerator(cryptorand.Reader),
+		"uuid":       generator.NewUUIDValueGenerator(cryptorand.Reader),
+		"bcrypt":     generator.NewBcryptValueGenerator(),
+		"htpasswd":   generator.NewHtpasswdValueGenerator(),
+		"privatekey": generator.NewPrivateKeyValueGenerator(cryptorand.Reader),
+		"publickey":  generator.NewPublicKeyValueGenerator(),
+		"sshkey":     generator.NewSSHPublicKeyValueGenerator(),
+		"tlscert":    generator.NewCertificateValueGenerator(cryptorand.Reader),
+		"charset":    generator.NewCharsetValueGenerator(rand.New(generator.NewCryptoSource())),
 	}
 	processor := template.NewProcessor(generators)
+	processor.TraceThreshold = 500 * time.Millisecond
+	// the processed objects only differ from the template objects by the processing changes
+	processor.PreserveFormatting = true
+	processor.SecureGenerators = map[string]generator.Generator{
+		"expression": generator.NewExpressionValueGenerator(rand.New(generator.NewCryptoSource())),
+	}
+	if s.templates != nil {
+		processor.Templates = s.templateGetter(ctx)
+	}
 	if errs := processor.Process(tpl); len(errs) > 0 {
 		glog.V(1).Infof(errs.ToAggregate().Error())
 		return nil, errors.NewInvalid(api.Kind("Template"), tpl.Name, errs)
 	}
+	// the objects hold the values, the returned parameters must not disclose them
+	template.RedactParameters(tpl)
 
-	// we know that we get back runtime.Unstructured objects from the Process call.  We need to encode those
+	// we know that we get back runtime.Unknown or runtime.Unstructured objects from the Process call.  We need to encode those
 	// objects using the unstructured codec BEFORE the REST layers gets its shot at encoding to avoid a layered
 	// encode being done.
 	for i := range tpl.Objects {
@@ -61,3 +88,23 @@ func (s *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 
 	return tpl, nil
 }
+
+// templateGetter returns a TemplateGetter retrieving the templates of the
+// namespace of the request. Templates from other namespaces cannot be
+// included, since the permissions of the user on them are not checked.
+func (s *REST) templateGetter(ctx kapi.Context) template.TemplateGetter {
+	return template.TemplateGetterFunc(func(namespace, name string) (*api.Template, error) {
+		if requestNamespace, _ := kapi.NamespaceFrom(ctx); namespace != requestNamespace {
+			return nil, fmt.Errorf("templates from namespace %q cannot be included in namespace %q", namespace, requestNamespace)
+		}
+		obj, err := s.templates.Get(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		t, ok := obj.(*api.Template)
+		if !ok {
+			return nil, fmt.Errorf("not a template: %#v", obj)
+		}
+		return t, nil
+	})
+}
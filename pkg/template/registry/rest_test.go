@@ -1,6 +1,7 @@
 package registry
 
 import (
+	"strings"
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
@@ -16,7 +17,7 @@ import (
 )
 
 func TestNewRESTInvalidType(t *testing.T) {
-	storage := NewREST()
+	storage := NewREST(nil)
 	_, err := storage.Create(nil, &kapi.Pod{})
 	if err == nil {
 		t.Errorf("Expected type error.")
@@ -28,7 +29,7 @@ func TestNewRESTInvalidType(t *testing.T) {
 }
 
 func TestNewRESTDefaultsName(t *testing.T) {
-	storage := NewREST()
+	storage := NewREST(nil)
 	obj, err := storage.Create(nil, &template.Template{
 		ObjectMeta: kapi.ObjectMeta{
 			Name: "test",
@@ -44,7 +45,7 @@ func TestNewRESTDefaultsName(t *testing.T) {
 }
 
 func TestNewRESTInvalidParameter(t *testing.T) {
-	storage := NewREST()
+	storage := NewREST(nil)
 	_, err := storage.Create(nil, &template.Template{
 		ObjectMeta: kapi.ObjectMeta{
 			Name: "test",
@@ -67,7 +68,7 @@ func TestNewRESTTemplateLabels(t *testing.T) {
 		"label1": "value1",
 		"label2": "value2",
 	}
-	storage := NewREST()
+	storage := NewREST(nil)
 
 	// because of encoding changes, we to round-trip ourselves
 	templateToCreate := &template.Template{
@@ -135,6 +136,9 @@ func TestNewRESTTemplateLabels(t *testing.T) {
 			t.Fatalf("Unexpected label value: %s", value)
 		}
 	}
+	if _, ok := svc.Labels[template.TemplateInstanceLabel]; ok {
+		t.Fatalf("Unexpected template instance label: %v", svc.Labels)
+	}
 }
 
 func TestNewRESTTemplateLabelsList(t *testing.T) {
@@ -142,7 +146,7 @@ func TestNewRESTTemplateLabelsList(t *testing.T) {
 		"label1": "value1",
 		"label2": "value2",
 	}
-	storage := NewREST()
+	storage := NewREST(nil)
 	// because of encoding changes, we to round-trip ourselves
 	templateToCreate := &template.Template{
 		ObjectMeta: kapi.ObjectMeta{
@@ -210,3 +214,86 @@ func TestNewRESTTemplateLabelsList(t *testing.T) {
 		}
 	}
 }
+
+func TestNewRESTSensitiveParameters(t *testing.T) {
+	storage := NewREST(nil)
+
+	templateToCreate := &template.Template{
+		ObjectMeta: kapi.ObjectMeta{
+			Name: "test",
+		},
+		Parameters: []template.Parameter{
+			{Name: "ADMIN_USER", Value: "admin"},
+			{Name: "ADMIN_PASSWORD", Generate: "expression", From: "[a-z]{8}", Sensitive: true},
+		},
+	}
+	templateObjects := []runtime.Object{
+		&kapi.Secret{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:        "test-secret",
+				Annotations: map[string]string{"credentials": "${ADMIN_USER}:${ADMIN_PASSWORD}"},
+			},
+		},
+	}
+	template.AddObjectsToTemplate(templateToCreate, templateObjects, registered.GroupOrDie(kapi.GroupName).GroupVersions[0])
+	originalBytes, err := runtime.Encode(kapi.Codecs.LegacyCodec(registered.GroupOrDie(kapi.GroupName).GroupVersions[0]), templateToCreate)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	objToCreate, err := runtime.Decode(kapi.Codecs.UniversalDecoder(), originalBytes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	obj, err := storage.Create(nil, objToCreate)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bytes, err := runtime.Encode(kapi.Codecs.LegacyCodec(registered.GroupOrDie(kapi.GroupName).GroupVersions[0]), obj)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err = runtime.Decode(kapi.Codecs.UniversalDecoder(), bytes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	config := obj.(*template.Template)
+	if value := config.Parameters[0].Value; value != "admin" {
+		t.Errorf("Unexpected value of the parameter that is not sensitive: %q", value)
+	}
+	if value := config.Parameters[1].Value; value != template.RedactedParameterValue {
+		t.Errorf("Expected the sensitive parameter to be redacted, got %q", value)
+	}
+	if err := utilerrors.NewAggregate(runtime.DecodeList(config.Objects, kapi.Codecs.UniversalDecoder())); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	secret, ok := config.Objects[0].(*kapi.Secret)
+	if !ok {
+		t.Fatalf("Unexpected object in config: %#v", config.Objects[0])
+	}
+	if value := secret.Annotations["credentials"]; len(value) != len("admin:")+8 || value == "admin:"+template.RedactedParameterValue {
+		t.Errorf("Expected the generated value to be substituted, got %q", value)
+	}
+}
+
+func TestNewRESTPreservesFormatting(t *testing.T) {
+	storage := NewREST(nil)
+	data := []byte(`{"kind":"Template","apiVersion":"v1","metadata":{"name":"test"},"objects":[{"kind":"Widget","apiVersion":"example.com/v1","spec":{"ratio":1.50,"owner":"${NAME}"}}],"parameters":[{"name":"NAME","value":"app"}]}`)
+	templateToCreate, err := runtime.Decode(kapi.Codecs.UniversalDecoder(), data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	obj, err := storage.Create(nil, templateToCreate)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bytes, err := runtime.Encode(kapi.Codecs.LegacyCodec(registered.GroupOrDie(kapi.GroupName).GroupVersions[0]), obj)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := `"spec":{"ratio":1.50,"owner":"app"}`; !strings.Contains(string(bytes), expected) {
+		t.Errorf("expected %s in the processed template: %s", expected, bytes)
+	}
+}
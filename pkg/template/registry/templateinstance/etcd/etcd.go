@@ -0,0 +1,128 @@
+package etcd
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apimachinery/registered"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	etcdgeneric "k8s.io/kubernetes/pkg/registry/generic/etcd"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/authorization/registry/subjectaccessreview"
+	"github.com/openshift/origin/pkg/template/api"
+	"github.com/openshift/origin/pkg/template/registry/templateinstance"
+)
+
+// REST implements a RESTStorage for template instances against etcd
+type REST struct {
+	*etcdgeneric.Etcd
+	subjectAccessReviewRegistry subjectaccessreview.Registry
+	mapper                      meta.RESTMapper
+}
+
+// NewREST returns a RESTStorage object that will work against template instances.
+func NewREST(s storage.Interface, subjectAccessReviewRegistry subjectaccessreview.Registry) *REST {
+	prefix := "/templateinstances"
+
+	store := &etcdgeneric.Etcd{
+		NewFunc:     func() runtime.Object { return &api.TemplateInstance{} },
+		NewListFunc: func() runtime.Object { return &api.TemplateInstanceList{} },
+		KeyRootFunc: func(ctx kapi.Context) string {
+			return etcdgeneric.NamespaceKeyRootFunc(ctx, prefix)
+		},
+		KeyFunc: func(ctx kapi.Context, name string) (string, error) {
+			return etcdgeneric.NamespaceKeyFunc(ctx, prefix, name)
+		},
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return obj.(*api.TemplateInstance).Name, nil
+		},
+		PredicateFunc: func(label labels.Selector, field fields.Selector) generic.Matcher {
+			return templateinstance.Matcher(label, field)
+		},
+		QualifiedResource: api.Resource("templateinstances"),
+
+		CreateStrategy: templateinstance.Strategy,
+		UpdateStrategy: templateinstance.Strategy,
+		DeleteStrategy: templateinstance.Strategy,
+
+		ReturnDeletedObject: true,
+
+		Storage: s,
+	}
+
+	return &REST{Etcd: store, subjectAccessReviewRegistry: subjectAccessReviewRegistry, mapper: registered.RESTMapper()}
+}
+
+// Delete deletes a template instance. The template instance controller then
+// deletes the objects it refers to with its own privileges, so the user must
+// be allowed to delete each of them.
+func (r *REST) Delete(ctx kapi.Context, name string, options *kapi.DeleteOptions) (runtime.Object, error) {
+	obj, err := r.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.verifyDelete(ctx, obj.(*api.TemplateInstance)); err != nil {
+		return nil, err
+	}
+	return r.Etcd.Delete(ctx, name, options)
+}
+
+// DeleteCollection deletes the template instances one by one, so that each is
+// checked like in Delete.
+func (r *REST) DeleteCollection(ctx kapi.Context, options *kapi.DeleteOptions, listOptions *kapi.ListOptions) (runtime.Object, error) {
+	listObj, err := r.List(ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range listObj.(*api.TemplateInstanceList).Items {
+		if _, err := r.Delete(ctx, instance.Name, options); err != nil && !kerrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	return listObj, nil
+}
+
+// verifyDelete checks that the user in ctx may delete every object referred
+// to by instance.
+func (r *REST) verifyDelete(ctx kapi.Context, instance *api.TemplateInstance) error {
+	if _, ok := kapi.UserFrom(ctx); !ok {
+		return kerrors.NewForbidden(api.Resource("templateinstances"), instance.Name, fmt.Errorf("user missing from context"))
+	}
+	ctx = kapi.WithNamespace(ctx, instance.Namespace)
+	for _, ref := range instance.Objects {
+		gv, err := unversioned.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			return kerrors.NewForbidden(api.Resource("templateinstances"), instance.Name, err)
+		}
+		mapping, err := r.mapper.RESTMapping(unversioned.GroupKind{Group: gv.Group, Kind: ref.Kind}, gv.Version)
+		if err != nil {
+			return kerrors.NewForbidden(api.Resource("templateinstances"), instance.Name, err)
+		}
+
+		// an empty user and groups check the user in the context
+		subjectAccessReview := &authorizationapi.SubjectAccessReview{
+			Action: authorizationapi.AuthorizationAttributes{
+				Verb:         "delete",
+				Group:        mapping.GroupVersionKind.Group,
+				Resource:     mapping.Resource,
+				ResourceName: ref.Name,
+			},
+		}
+		glog.V(4).Infof("Performing SubjectAccessReview to delete %s/%s in %s for template instance %s", mapping.Resource, ref.Name, instance.Namespace, instance.Name)
+		resp, err := r.subjectAccessReviewRegistry.CreateSubjectAccessReview(ctx, subjectAccessReview)
+		if err != nil || resp == nil || !resp.Allowed {
+			return kerrors.NewForbidden(api.Resource("templateinstances"), instance.Name, fmt.Errorf("cannot delete %s %q created by the template instance", mapping.Resource, ref.Name))
+		}
+	}
+	return nil
+}
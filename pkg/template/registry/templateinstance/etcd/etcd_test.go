@@ -0,0 +1,211 @@
+package etcd
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/registrytest"
+	etcdtesting "k8s.io/kubernetes/pkg/storage/etcd/testing"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/authorization/registry/subjectaccessreview"
+	"github.com/openshift/origin/pkg/template/api"
+
+	// install all APIs
+	_ "github.com/openshift/origin/pkg/api/install"
+)
+
+type fakeSubjectAccessReviewRegistry struct {
+	denied   map[string]bool
+	requests []authorizationapi.AuthorizationAttributes
+}
+
+var _ subjectaccessreview.Registry = &fakeSubjectAccessReviewRegistry{}
+
+func (f *fakeSubjectAccessReviewRegistry) CreateSubjectAccessReview(ctx kapi.Context, subjectAccessReview *authorizationapi.SubjectAccessReview) (*authorizationapi.SubjectAccessReviewResponse, error) {
+	f.requests = append(f.requests, subjectAccessReview.Action)
+	return &authorizationapi.SubjectAccessReviewResponse{Allowed: !f.denied[subjectAccessReview.Action.Resource]}, nil
+}
+
+func newStorage(t *testing.T) (*REST, *etcdtesting.EtcdTestServer) {
+	return newStorageWithSubjectAccessReviews(t, &fakeSubjectAccessReviewRegistry{})
+}
+
+func newStorageWithSubjectAccessReviews(t *testing.T, sar *fakeSubjectAccessReviewRegistry) (*REST, *etcdtesting.EtcdTestServer) {
+	etcdStorage, server := registrytest.NewEtcdStorage(t, "")
+	storage := NewREST(etcdStorage, sar)
+	return storage, server
+}
+
+func validTemplateInstance() *api.TemplateInstance {
+	return &api.TemplateInstance{
+		ObjectMeta: kapi.ObjectMeta{
+			Name: "foo",
+		},
+		Template: "mytemplate",
+		Objects: []kapi.ObjectReference{
+			{Kind: "Service", Name: "frontend"},
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	test := registrytest.New(t, storage.Etcd)
+	valid := validTemplateInstance()
+	valid.Name = ""
+	valid.GenerateName = "test-"
+	test.TestCreate(
+		valid,
+		// invalid
+		&api.TemplateInstance{},
+	)
+}
+
+func TestList(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	test := registrytest.New(t, storage.Etcd)
+	test.TestList(
+		validTemplateInstance(),
+	)
+}
+
+func TestGet(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	test := registrytest.New(t, storage.Etcd)
+	test.TestGet(
+		validTemplateInstance(),
+	)
+}
+
+func TestDelete(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	test := registrytest.New(t, storage.Etcd).ReturnDeletedObject()
+	test.TestDeleteGraceful(
+		validTemplateInstance(),
+		30,
+	)
+}
+
+func TestWatch(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	test := registrytest.New(t, storage.Etcd)
+
+	valid := validTemplateInstance()
+	valid.Name = "foo"
+	valid.Labels = map[string]string{"foo": "bar"}
+
+	test.TestWatch(
+		valid,
+		// matching labels
+		[]labels.Set{{"foo": "bar"}},
+		// not matching labels
+		[]labels.Set{{"foo": "baz"}},
+		// matching fields
+		[]fields.Set{
+			{"metadata.name": "foo"},
+		},
+		// not matching fields
+		[]fields.Set{
+			{"metadata.name": "bar"},
+		},
+	)
+}
+
+func validInstantiation() *api.TemplateInstance {
+	return &api.TemplateInstance{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:      "foo",
+			Namespace: kapi.NamespaceDefault,
+		},
+		Template: "ruby-helloworld-sample",
+		Objects: []kapi.ObjectReference{
+			{Kind: "Service", APIVersion: "v1", Name: "frontend"},
+			{Kind: "RoleBinding", APIVersion: "v1", Name: "edit"},
+		},
+	}
+}
+
+func TestDeleteChecksObjects(t *testing.T) {
+	tests := map[string]struct {
+		denied  map[string]bool
+		noUser  bool
+		allowed bool
+	}{
+		"allowed": {
+			allowed: true,
+		},
+		"denied for one object": {
+			denied: map[string]bool{"rolebindings": true},
+		},
+		"no user": {
+			noUser: true,
+		},
+	}
+	for name, test := range tests {
+		sar := &fakeSubjectAccessReviewRegistry{denied: test.denied}
+		storage, server := newStorageWithSubjectAccessReviews(t, sar)
+
+		ctx := kapi.NewDefaultContext()
+		if _, err := storage.Create(ctx, validInstantiation()); err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if !test.noUser {
+			ctx = kapi.WithUser(ctx, &user.DefaultInfo{Name: "editor"})
+		}
+
+		_, err := storage.Delete(ctx, "foo", kapi.NewDeleteOptions(0))
+		switch {
+		case test.allowed && err != nil:
+			t.Errorf("%s: unexpected error: %v", name, err)
+		case !test.allowed && !kerrors.IsForbidden(err):
+			t.Errorf("%s: expected a forbidden error, got %v", name, err)
+		}
+		_, err = storage.Get(ctx, "foo")
+		if deleted := kerrors.IsNotFound(err); deleted != test.allowed {
+			t.Errorf("%s: expected deleted to be %t, got %t", name, test.allowed, deleted)
+		}
+
+		if !test.noUser {
+			expected := []authorizationapi.AuthorizationAttributes{
+				{Verb: "delete", Resource: "services", ResourceName: "frontend"},
+				{Verb: "delete", Resource: "rolebindings", ResourceName: "edit"},
+			}
+			if test.denied["services"] {
+				expected = expected[:1]
+			}
+			if !kapi.Semantic.DeepEqual(sar.requests, expected) {
+				t.Errorf("%s: unexpected subject access reviews: %#v", name, sar.requests)
+			}
+		}
+		server.Terminate(t)
+	}
+}
+
+func TestDeleteCollectionChecksObjects(t *testing.T) {
+	sar := &fakeSubjectAccessReviewRegistry{denied: map[string]bool{"rolebindings": true}}
+	storage, server := newStorageWithSubjectAccessReviews(t, sar)
+	defer server.Terminate(t)
+
+	ctx := kapi.NewDefaultContext()
+	if _, err := storage.Create(ctx, validInstantiation()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx = kapi.WithUser(ctx, &user.DefaultInfo{Name: "editor"})
+
+	if _, err := storage.DeleteCollection(ctx, kapi.NewDeleteOptions(0), &kapi.ListOptions{}); !kerrors.IsForbidden(err) {
+		t.Errorf("expected a forbidden error, got %v", err)
+	}
+	if _, err := storage.Get(ctx, "foo"); err != nil {
+		t.Errorf("expected the template instance to be kept, got %v", err)
+	}
+}
@@ -0,0 +1,51 @@
+package etcd
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	etcdgeneric "k8s.io/kubernetes/pkg/registry/generic/etcd"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+
+	"github.com/openshift/origin/pkg/template/api"
+	"github.com/openshift/origin/pkg/template/registry/templaterepository"
+)
+
+// REST implements a RESTStorage for template repositories against etcd
+type REST struct {
+	*etcdgeneric.Etcd
+}
+
+// NewREST returns a RESTStorage object that will work against template repositories.
+func NewREST(s storage.Interface) *REST {
+	prefix := "/templaterepositories"
+
+	store := &etcdgeneric.Etcd{
+		NewFunc:     func() runtime.Object { return &api.TemplateRepository{} },
+		NewListFunc: func() runtime.Object { return &api.TemplateRepositoryList{} },
+		KeyRootFunc: func(ctx kapi.Context) string {
+			return etcdgeneric.NamespaceKeyRootFunc(ctx, prefix)
+		},
+		KeyFunc: func(ctx kapi.Context, name string) (string, error) {
+			return etcdgeneric.NamespaceKeyFunc(ctx, prefix, name)
+		},
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return obj.(*api.TemplateRepository).Name, nil
+		},
+		PredicateFunc: func(label labels.Selector, field fields.Selector) generic.Matcher {
+			return templaterepository.Matcher(label, field)
+		},
+		QualifiedResource: api.Resource("templaterepositories"),
+
+		CreateStrategy: templaterepository.Strategy,
+		UpdateStrategy: templaterepository.Strategy,
+
+		ReturnDeletedObject: true,
+
+		Storage: s,
+	}
+
+	return &REST{store}
+}
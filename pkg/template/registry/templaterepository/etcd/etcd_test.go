@@ -0,0 +1,98 @@
+package etcd
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/registrytest"
+	etcdtesting "k8s.io/kubernetes/pkg/storage/etcd/testing"
+
+	"github.com/openshift/origin/pkg/template/api"
+	_ "github.com/openshift/origin/pkg/template/api/install"
+)
+
+func newStorage(t *testing.T) (*REST, *etcdtesting.EtcdTestServer) {
+	etcdStorage, server := registrytest.NewEtcdStorage(t, "")
+	storage := NewREST(etcdStorage)
+	return storage, server
+}
+
+func validTemplateRepository() *api.TemplateRepository {
+	return &api.TemplateRepository{
+		ObjectMeta: kapi.ObjectMeta{
+			Name: "foo",
+		},
+		Spec: api.TemplateRepositorySpec{
+			Git: "https://github.com/openshift/library.git",
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	test := registrytest.New(t, storage.Etcd)
+	valid := validTemplateRepository()
+	valid.Name = ""
+	valid.GenerateName = "test-"
+	test.TestCreate(
+		valid,
+		// invalid
+		&api.TemplateRepository{},
+	)
+}
+
+func TestList(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	test := registrytest.New(t, storage.Etcd)
+	test.TestList(
+		validTemplateRepository(),
+	)
+}
+
+func TestGet(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	test := registrytest.New(t, storage.Etcd)
+	test.TestGet(
+		validTemplateRepository(),
+	)
+}
+
+func TestDelete(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	test := registrytest.New(t, storage.Etcd).ReturnDeletedObject()
+	test.TestDelete(
+		validTemplateRepository(),
+	)
+}
+
+func TestWatch(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	test := registrytest.New(t, storage.Etcd)
+
+	valid := validTemplateRepository()
+	valid.Name = "foo"
+	valid.Labels = map[string]string{"foo": "bar"}
+
+	test.TestWatch(
+		valid,
+		// matching labels
+		[]labels.Set{{"foo": "bar"}},
+		// not matching labels
+		[]labels.Set{{"foo": "baz"}},
+		// matching fields
+		[]fields.Set{
+			{"metadata.name": "foo"},
+		},
+		// not matching fields
+		[]fields.Set{
+			{"metadata.name": "bar"},
+		},
+	)
+}
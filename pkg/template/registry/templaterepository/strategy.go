@@ -0,0 +1,74 @@
+package templaterepository
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	"github.com/openshift/origin/pkg/template/api"
+	"github.com/openshift/origin/pkg/template/api/validation"
+)
+
+// strategy implements behavior for TemplateRepositories
+type strategy struct {
+	runtime.ObjectTyper
+	kapi.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating TemplateRepository
+// objects via the REST API.
+var Strategy = strategy{kapi.Scheme, kapi.SimpleNameGenerator}
+
+// NamespaceScoped is true for template repositories.
+func (strategy) NamespaceScoped() bool {
+	return true
+}
+
+// PrepareForUpdate clears fields that are not allowed to be set by end users on update.
+func (strategy) PrepareForUpdate(obj, old runtime.Object) {}
+
+// Canonicalize normalizes the object after validation.
+func (strategy) Canonicalize(obj runtime.Object) {
+}
+
+// PrepareForCreate clears fields that are not allowed to be set by end users on creation.
+// The status is set by the template repository controller.
+func (strategy) PrepareForCreate(obj runtime.Object) {
+	repository := obj.(*api.TemplateRepository)
+	repository.Status = api.TemplateRepositoryStatus{}
+}
+
+// Validate validates a new template repository.
+func (strategy) Validate(ctx kapi.Context, obj runtime.Object) field.ErrorList {
+	return validation.ValidateTemplateRepository(obj.(*api.TemplateRepository))
+}
+
+// AllowCreateOnUpdate is false for template repositories.
+func (strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (strategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateTemplateRepositoryUpdate(obj.(*api.TemplateRepository), old.(*api.TemplateRepository))
+}
+
+// Matcher returns a generic matcher for a given label and field selector.
+func Matcher(label labels.Selector, field fields.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		o, ok := obj.(*api.TemplateRepository)
+		if !ok {
+			return false, fmt.Errorf("not a TemplateRepository")
+		}
+		return label.Matches(labels.Set(o.Labels)) && field.Matches(api.TemplateRepositoryToSelectableFields(o)), nil
+	})
+}
@@ -0,0 +1,124 @@
+package template
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/template/api"
+	"github.com/openshift/origin/pkg/util/stringreplace"
+)
+
+// ParameterSource describes where the value of a parameter comes from.
+type ParameterSource string
+
+const (
+	// ParameterSourceTemplate is the source of the values set in the Template
+	ParameterSourceTemplate ParameterSource = "template"
+	// ParameterSourceUser is the source of the values supplied by the user
+	ParameterSourceUser ParameterSource = "user"
+	// ParameterSourceGenerated is the source of the values produced by a
+	// generator
+	ParameterSourceGenerated ParameterSource = "generated"
+	// ParameterSourceNone is the source of the parameters without a value
+	ParameterSourceNone ParameterSource = "none"
+)
+
+// ParameterReport describes the value a parameter had when a Template was
+// processed, so that it can be presented to the user.
+type ParameterReport struct {
+	Name        string          `json:"name"`
+	DisplayName string          `json:"displayName,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Value       string          `json:"value"`
+	Source      ParameterSource `json:"source"`
+	Required    bool            `json:"required,omitempty"`
+	// References are the objects of the Template using the parameter, in the
+	// "kind/name" form.
+	References []string `json:"references,omitempty"`
+}
+
+// ReportParameters describes the parameters of the processed Template. The
+// original Template is the one that was submitted for processing, it is used
+// to find which objects reference each parameter and which values were
+// generated. The supplied set holds the names of the parameters whose values
+// were supplied by the user.
+func ReportParameters(original, processed *api.Template, supplied sets.String) []ParameterReport {
+	references := ParameterReferences(original)
+	reports := make([]ParameterReport, 0, len(processed.Parameters))
+	for _, param := range processed.Parameters {
+		report := ParameterReport{
+			Name:        param.Name,
+			DisplayName: param.DisplayName,
+			Description: param.Description,
+			Value:       param.Value,
+			Required:    param.Required,
+			References:  references[param.Name],
+		}
+		// parameters added by included templates are not part of the original,
+		// their values are assumed to be generated whenever they can be
+		generated := len(param.Generate) > 0
+		if declared := GetParameterByName(original, param.Name); declared != nil {
+			generated = len(declared.Value) == 0 && len(declared.Generate) > 0
+		}
+		switch {
+		case supplied.Has(param.Name):
+			report.Source = ParameterSourceUser
+		case generated && len(param.Value) > 0:
+			report.Source = ParameterSourceGenerated
+		case len(param.Value) > 0:
+			report.Source = ParameterSourceTemplate
+		default:
+			report.Source = ParameterSourceNone
+		}
+		reports = append(reports, report)
+	}
+	return reports
+}
+
+// ParameterReferences returns the objects of the Template, in the "kind/name"
+// form, that reference each parameter using the ${PARAMETER_NAME},
+// ${{PARAMETER_NAME}} or ${function(PARAMETER_NAME)} expressions.
+func ParameterReferences(t *api.Template) map[string][]string {
+	references := map[string][]string{}
+	for _, item := range t.Objects {
+		if unknown, ok := item.(*runtime.Unknown); ok {
+			decoded, err := runtime.Decode(runtime.UnstructuredJSONScheme, unknown.RawJSON)
+			if err != nil {
+				continue
+			}
+			item = decoded
+		}
+		names := sets.NewString()
+		stringreplace.VisitObjectStrings(item, func(in string) string {
+			names.Insert(referencedParameters(in).List()...)
+			return in
+		})
+		ref := objectReference(item)
+		for _, name := range names.List() {
+			references[name] = append(references[name], ref)
+		}
+	}
+	return references
+}
+
+// objectReference returns the "kind/name" of the given object.
+func objectReference(obj runtime.Object) string {
+	if unstructured, ok := obj.(*runtime.Unstructured); ok {
+		return fmt.Sprintf("%s/%s", unstructured.Kind, unstructured.Name)
+	}
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	if len(kind) == 0 {
+		if gvk, err := kapi.Scheme.ObjectKind(obj); err == nil {
+			kind = gvk.Kind
+		}
+	}
+	name := ""
+	if accessor, err := meta.Accessor(obj); err == nil {
+		name = accessor.GetName()
+	}
+	return fmt.Sprintf("%s/%s", kind, name)
+}
@@ -0,0 +1,49 @@
+package template
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+func TestReportParameters(t *testing.T) {
+	original := &api.Template{
+		Parameters: []api.Parameter{
+			{Name: "NAME", DisplayName: "Name", Description: "The name", Value: "frontend"},
+			{Name: "PASSWORD", Generate: "expression", From: "[a-z]{8}", Required: true},
+			{Name: "REPLICAS", Value: "3"},
+			{Name: "UNUSED"},
+		},
+		Objects: []runtime.Object{
+			&runtime.Unknown{RawJSON: []byte(`{"kind":"Service","apiVersion":"v1","metadata":{"name":"${NAME}"}}`)},
+			&runtime.Unknown{RawJSON: []byte(`{"kind":"DeploymentConfig","apiVersion":"v1","metadata":{"name":"${lower(NAME)}"},"spec":{"replicas":"${{REPLICAS}}"}}`)},
+			&kapi.Secret{ObjectMeta: kapi.ObjectMeta{Name: "secret", Annotations: map[string]string{"password": "${PASSWORD}"}}},
+		},
+	}
+	processed := &api.Template{
+		Parameters: []api.Parameter{
+			{Name: "NAME", DisplayName: "Name", Description: "The name", Value: "frontend"},
+			{Name: "PASSWORD", Generate: "expression", From: "[a-z]{8}", Value: "abcdefgh", Required: true},
+			{Name: "REPLICAS", Value: "5"},
+			{Name: "UNUSED"},
+			{Name: "INCLUDED", Generate: "expression", From: "[a-z]{8}", Value: "included"},
+		},
+	}
+
+	reports := ReportParameters(original, processed, sets.NewString("REPLICAS"))
+	expected := []ParameterReport{
+		{Name: "NAME", DisplayName: "Name", Description: "The name", Value: "frontend", Source: ParameterSourceTemplate, References: []string{"Service/${NAME}", "DeploymentConfig/${lower(NAME)}"}},
+		{Name: "PASSWORD", Value: "abcdefgh", Source: ParameterSourceGenerated, Required: true, References: []string{"Secret/secret"}},
+		{Name: "REPLICAS", Value: "5", Source: ParameterSourceUser, References: []string{"DeploymentConfig/${lower(NAME)}"}},
+		{Name: "UNUSED", Source: ParameterSourceNone},
+		{Name: "INCLUDED", Value: "included", Source: ParameterSourceGenerated},
+	}
+	if !reflect.DeepEqual(reports, expected) {
+		t.Errorf("expected\n%#v\ngot\n%#v", expected, reports)
+	}
+}
@@ -0,0 +1,37 @@
+package template
+
+import (
+	"regexp"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+// secretParameterExp matches the names of the parameters that hold secrets.
+var secretParameterExp = regexp.MustCompile(`(?i)(PASSWORD|PASSWD|SECRET|TOKEN|(^|_)KEY($|_))`)
+
+// IsSecretParameter returns true if the Parameter is marked Sensitive or if
+// its name indicates that its value is a secret, eg. MYSQL_PASSWORD or
+// GITHUB_WEBHOOK_SECRET.
+func IsSecretParameter(param *api.Parameter) bool {
+	return param.Sensitive || secretParameterExp.MatchString(param.Name)
+}
+
+// RedactParameters replaces the values of the Parameters of t marked
+// Sensitive with api.RedactedParameterValue, so that a processed Template can
+// be returned or logged without disclosing them. Parameters are only
+// redacted when explicitly marked, regardless of their name.
+func RedactParameters(t *api.Template) {
+	for i := range t.Parameters {
+		t.Parameters[i] = redactedParameter(&t.Parameters[i])
+	}
+}
+
+// redactedParameter returns a copy of param without its value when it is
+// marked Sensitive.
+func redactedParameter(param *api.Parameter) api.Parameter {
+	redacted := *param
+	if redacted.Sensitive && len(redacted.Value) > 0 {
+		redacted.Value = api.RedactedParameterValue
+	}
+	return redacted
+}
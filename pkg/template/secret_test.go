@@ -0,0 +1,60 @@
+package template
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+func TestIsSecretParameter(t *testing.T) {
+	tests := map[string]bool{
+		"MYSQL_PASSWORD":        true,
+		"admin_passwd":          true,
+		"GITHUB_WEBHOOK_SECRET": true,
+		"API_TOKEN":             true,
+		"KEY":                   true,
+		"SECRET_KEY_BASE":       true,
+		"MONKEY_NAME":           false,
+		"KEYBOARD":              false,
+		"APPLICATION_NAME":      false,
+		"MEMORY_LIMIT":          false,
+	}
+	for name, expected := range tests {
+		if actual := IsSecretParameter(&api.Parameter{Name: name}); actual != expected {
+			t.Errorf("%s: expected %t, got %t", name, expected, actual)
+		}
+	}
+}
+
+func TestRedactParameters(t *testing.T) {
+	template := &api.Template{Parameters: []api.Parameter{
+		{Name: "ADMIN_USER", Value: "admin"},
+		{Name: "ADMIN_PASSWORD", Value: "secret"},
+		{Name: "API_KEY", Value: "key", Sensitive: true},
+		{Name: "EMPTY", Sensitive: true},
+	}}
+	RedactParameters(template)
+	expected := []string{"admin", "secret", api.RedactedParameterValue, ""}
+	for i, param := range template.Parameters {
+		if param.Value != expected[i] {
+			t.Errorf("expected %s to be %q, got %q", param.Name, expected[i], param.Value)
+		}
+	}
+	if !IsSecretParameter(&api.Parameter{Name: "CREDENTIALS", Sensitive: true}) {
+		t.Errorf("expected a sensitive parameter to be a secret")
+	}
+}
+
+func TestGenerateParameterValuesDoesNotDiscloseValues(t *testing.T) {
+	template := &api.Template{Parameters: []api.Parameter{
+		{Name: "ADMIN_PASSWORD", Value: "s3cr3t", Type: api.ParameterTypeInt},
+	}}
+	errs := NewProcessor(nil).GenerateParameterValues(template)
+	if len(errs) != 1 {
+		t.Fatalf("expected an error for a value that is not an integer, got %v", errs)
+	}
+	if errs[0].BadValue != "ADMIN_PASSWORD" || strings.Contains(errs[0].Error(), "s3cr3t") {
+		t.Errorf("expected the error to report the parameter name without its value, got %v", errs[0])
+	}
+}
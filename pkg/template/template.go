@@ -3,23 +3,84 @@ package template
 import (
 	"fmt"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"k8s.io/kubernetes/pkg/api/meta"
 	"k8s.io/kubernetes/pkg/runtime"
+	kutil "k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	"github.com/openshift/origin/pkg/template/api"
 	. "github.com/openshift/origin/pkg/template/generator"
-	"github.com/openshift/origin/pkg/util"
 	"github.com/openshift/origin/pkg/util/stringreplace"
 )
 
-var parameterExp = regexp.MustCompile(`\$\{([a-zA-Z0-9\_]+)\}`)
+var (
+	parameterExp          = regexp.MustCompile(`\$\{([a-zA-Z0-9\_]+)\}`)
+	nonStringParameterExp = regexp.MustCompile(`^\$\{\{([a-zA-Z0-9\_]+)\}\}$`)
+	// nonStringParameterReferenceExp matches ${{PARAMETER_NAME}} anywhere in a value
+	nonStringParameterReferenceExp = regexp.MustCompile(`\$\{\{([a-zA-Z0-9\_]+)\}\}`)
+)
+
+// escapedExpressionPrefix starts an expression that is not substituted. It is
+// kept without its first "$", so that "$${HOME}" becomes "${HOME}", eg. for
+// the shell commands of a container.
+const escapedExpressionPrefix = "$${"
 
 // Processor process the Template into the List with substituted parameters
 type Processor struct {
 	Generators map[string]Generator
+
+	// Strict makes SetParameterValues reject values supplied for parameters
+	// that are not declared in the Template instead of ignoring them.
+	Strict bool
+
+	// SecureGenerators, when set, take precedence over Generators for the
+	// parameters holding secrets, see IsSecretParameter. They are expected
+	// to produce unpredictable values.
+	SecureGenerators map[string]Generator
+
+	// Templates retrieves the Templates referenced by Template includes. If
+	// nil, processing a Template that includes stored Templates fails.
+	Templates TemplateGetter
+
+	// IncludePaths, when set, restricts the substitution of parameters to the
+	// object fields whose path matches one of the expressions. See
+	// api.ExcludePathsAnnotation for the format of the paths.
+	IncludePaths []*regexp.Regexp
+
+	// ExcludePaths prevents the substitution of parameters into the object
+	// fields whose path matches one of the expressions, for example to keep
+	// the literal ${} syntax of annotations consumed by other systems. The
+	// expressions listed in the api.ExcludePathsAnnotation of a Template are
+	// added to them while processing that Template.
+	ExcludePaths []*regexp.Regexp
+
+	// Transformers are applied in order to every object of the Template. If
+	// nil, DefaultTransformers are used.
+	Transformers []ObjectTransformer
+
+	// Env looks up the environment variables the Parameters declaring
+	// FromEnv are read from when they have no value. If nil, Parameter
+	// values are never read from the environment.
+	Env EnvLookupFunc
+
+	// TraceThreshold, when set, logs a trace of the processing steps of the
+	// Templates, identified by their namespace and name, whose processing
+	// takes longer than the threshold.
+	TraceThreshold time.Duration
+
+	// PreserveFormatting makes Process return the objects of the Template
+	// that were not decoded, eg. the objects of kinds that are not
+	// registered, as runtime.Unknown objects keeping the key order and the
+	// number formatting of the original objects, so that they only differ
+	// from them by the changes made by processing. Otherwise they are
+	// returned as runtime.Unstructured objects.
+	PreserveFormatting bool
 }
 
 // NewProcessor creates new Processor and initializes its set of generators.
@@ -27,48 +88,141 @@ func NewProcessor(generators map[string]Generator) *Processor {
 	return &Processor{Generators: generators}
 }
 
-// Process transforms Template object into List object. It generates
+// Process transforms Template object into List object. It resolves the
+// included Templates first, see ResolveIncludes. Then it generates
 // Parameter values using the defined set of generators first, and then it
 // substitutes all Parameter expression occurrences with their corresponding
-// values (currently in the containers' Environment variables only).
+// values in both the string values and the map keys of every object.
+// References to parameters that are not declared in the Template are
+// reported as errors. Objects annotated with api.CountAnnotation are
+// replicated, see expandObject. The substitution, along with the removal of
+// the namespace and the addition of the ObjectLabels and ObjectAnnotations, is
+// performed by the Transformers of the Processor. Parameter expressions are
+// finally substituted in the Message of the Template.
 func (p *Processor) Process(template *api.Template) field.ErrorList {
-	templateErrors := field.ErrorList{}
+	return p.process(template, nil)
+}
+
+// process implements Process. If record is not nil it is called for every
+// resulting object with the index of the Template object it was produced
+// from and that object as it was before processing.
+func (p *Processor) process(template *api.Template, record func(index int, original interface{}, processed runtime.Object)) (templateErrors field.ErrorList) {
+	trace := kutil.NewTrace(fmt.Sprintf("Process template %s/%s", template.Namespace, template.Name))
+	start := time.Now()
+	defer func() { p.recordProcessing(trace, start, templateErrors) }()
+	templateErrors = field.ErrorList{}
+
+	if errs := p.ResolveIncludes(template); len(errs) > 0 {
+		return append(templateErrors, errs...)
+	}
+	trace.Step("Includes resolved")
 
-	if fieldError := p.GenerateParameterValues(template); fieldError != nil {
-		return append(templateErrors, fieldError)
+	if errs := p.GenerateParameterValues(template); len(errs) > 0 {
+		return append(templateErrors, errs...)
+	}
+	trace.Step("Parameter values generated")
+
+	excludePaths, err := templateExcludePaths(template)
+	if err != nil {
+		return append(templateErrors, field.Invalid(field.NewPath("metadata", "annotations").Key(api.ExcludePathsAnnotation), template.Annotations[api.ExcludePathsAnnotation], err.Error()))
+	}
+	if len(excludePaths) > 0 {
+		processor := *p
+		processor.ExcludePaths = append(append([]*regexp.Regexp{}, p.ExcludePaths...), excludePaths...)
+		p = &processor
+	}
+
+	transformers := p.Transformers
+	if transformers == nil {
+		transformers = DefaultTransformers()
 	}
 
 	itemPath := field.NewPath("item")
+	objects := []runtime.Object{}
 	for i, item := range template.Objects {
 		idxPath := itemPath.Index(i)
+		var raw []byte
 		if obj, ok := item.(*runtime.Unknown); ok {
 			// TODO: use runtime.DecodeList when it returns ValidationErrorList
-			decodedObj, err := runtime.Decode(runtime.UnstructuredJSONScheme, obj.RawJSON)
+			decodedObj, err := decodeUnstructured(obj.RawJSON)
 			if err != nil {
 				templateErrors = append(templateErrors, field.Invalid(idxPath.Child("objects"), obj, fmt.Sprintf("unable to handle object: %v", err)))
+				objects = append(objects, item)
 				continue
 			}
 			item = decodedObj
+			raw = obj.RawJSON
+		}
+		var original interface{}
+		if record != nil {
+			original = genericObject(item)
 		}
 
-		newItem, err := p.SubstituteParameters(template.Parameters, item)
+		copies, err := expandObject(template.Parameters, item)
 		if err != nil {
-			templateErrors = append(templateErrors, field.Invalid(idxPath.Child("parameters"), template.Parameters, err.Error()))
+			templateErrors = append(templateErrors, field.Invalid(idxPath.Child("metadata", "annotations").Key(api.CountAnnotation), item, err.Error()))
+			objects = append(objects, item)
+			continue
 		}
-		// If an object definition's metadata includes a namespace field, the field will be stripped out of
-		// the definition during template instantiation.  This is necessary because all objects created during
-		// instantiation are placed into the target namespace, so it would be invalid for the object to declare
-		//a different namespace.
-		stripNamespace(newItem)
-		if err := util.AddObjectLabels(newItem, template.ObjectLabels); err != nil {
-			templateErrors = append(templateErrors, field.Invalid(idxPath.Child("labels"), err, "label could not be applied"))
+
+		for _, itemCopy := range copies {
+			newItem := itemCopy.object
+			ctx := ObjectContext{Processor: p, Template: template, Parameters: itemCopy.params, Path: idxPath}
+			for _, transformer := range transformers {
+				var errs field.ErrorList
+				newItem, errs = transformer.TransformObject(ctx, newItem)
+				templateErrors = append(templateErrors, errs...)
+			}
+			if record != nil {
+				record(i, original, newItem)
+			}
+			if p.PreserveFormatting && raw != nil {
+				newItem, err = preserveFormat(raw, newItem)
+				if err != nil {
+					templateErrors = append(templateErrors, field.Invalid(idxPath, newItem, fmt.Sprintf("unable to encode object: %v", err)))
+				}
+			}
+			objects = append(objects, newItem)
 		}
-		template.Objects[i] = newItem
+	}
+	template.Objects = objects
+	trace.Step(fmt.Sprintf("%d objects processed", len(objects)))
+
+	if len(template.Message) > 0 {
+		messagePath := field.NewPath("message")
+		declared := sets.NewString()
+		for _, param := range template.Parameters {
+			declared.Insert(param.Name)
+		}
+		for _, name := range referencedParameters(template.Message).Difference(declared).List() {
+			templateErrors = append(templateErrors, field.Invalid(messagePath, fmt.Sprintf("${%s}", name), "references a parameter that is not declared in the template"))
+		}
+		message, err := SubstituteMessage(template.Parameters, template.Message)
+		if err != nil {
+			templateErrors = append(templateErrors, field.Invalid(messagePath, template.Message, err.Error()))
+		}
+		template.Message = message
+		trace.Step("Message processed")
 	}
 
 	return templateErrors
 }
 
+// decodeUnstructured decodes an object of any kind, registered or not, into
+// an Unstructured object. Unlike runtime.UnstructuredJSONScheme, it does not
+// decode lists into UnstructuredLists, which would lose the fields of the
+// lists other than their items when encoded again.
+func decodeUnstructured(data []byte) (*runtime.Unstructured, error) {
+	obj := &runtime.Unstructured{}
+	if err := runtime.DecodeInto(runtime.UnstructuredJSONScheme, data, obj); err != nil {
+		return nil, err
+	}
+	if len(obj.Kind) == 0 {
+		return nil, runtime.NewMissingKindErr(string(data))
+	}
+	return obj, nil
+}
+
 func stripNamespace(obj runtime.Object) {
 	// Remove namespace from the item
 	if itemMeta, err := meta.Accessor(obj); err == nil {
@@ -92,6 +246,38 @@ func stripNamespace(obj runtime.Object) {
 	}
 }
 
+// SetParameterValues sets the Value of the Template Parameters named in values
+// and disables their generators. Values supplied for parameters that are not
+// declared in the Template are ignored, unless the Processor is Strict, in
+// which case an error is returned for each of them and no value is set.
+func (p *Processor) SetParameterValues(t *api.Template, values map[string]string) field.ErrorList {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	errs := field.ErrorList{}
+	if p.Strict {
+		paramPath := field.NewPath("template").Child("parameters")
+		for _, name := range names {
+			if GetParameterByName(t, name) == nil {
+				errs = append(errs, field.NotFound(paramPath.Key(name), name))
+			}
+		}
+		if len(errs) > 0 {
+			return errs
+		}
+	}
+	for _, name := range names {
+		if param := GetParameterByName(t, name); param != nil {
+			param.Value = values[name]
+			param.Generate = ""
+		}
+	}
+	return errs
+}
+
 // AddParameter adds new custom parameter to the Template. It overrides
 // the existing parameter, if already defined.
 func AddParameter(t *api.Template, param api.Parameter) {
@@ -113,36 +299,182 @@ func GetParameterByName(t *api.Template, name string) *api.Parameter {
 	return nil
 }
 
-// SubstituteParameters loops over all values defined in structured
-// and unstructured types that are children of item.
+// SubstituteParameters loops over all values and map keys defined in
+// structured and unstructured types that are children of item. Fields
+// excluded by IncludePaths or ExcludePaths are left untouched.
 //
 // Example of Parameter expression:
 //   - ${PARAMETER_NAME}
+//   - ${{PARAMETER_NAME}}, when it is the whole value, is replaced by the
+//     unquoted parameter value (e.g. a number or a boolean)
+//   - ${lower(PARAMETER_NAME)}, ${upper(PARAMETER_NAME)},
+//     ${trunc(PARAMETER_NAME,length)} and ${replace(PARAMETER_NAME,old,new)}
+//     are replaced by the parameter value transformed by the function
+//   - $${PARAMETER_NAME} is not substituted and becomes ${PARAMETER_NAME}
 //
 func (p *Processor) SubstituteParameters(params []api.Parameter, item runtime.Object) (runtime.Object, error) {
+	substituter := newParameterSubstituter(params)
+	err := stringreplace.VisitObjectPaths(item, func(path, in string) (string, bool) {
+		if !p.substitutable(objectPath(item, path)) {
+			return in, true
+		}
+		return substituter.substitute(in)
+	})
+	substitutionCounter.Add(float64(substituter.substitutions))
+	if err == nil {
+		err = substituter.err
+	}
+
+	return item, err
+}
+
+// SubstituteMessage returns message with the parameter expressions it
+// contains, as described by SubstituteParameters, replaced by the values of
+// params.
+func SubstituteMessage(params []api.Parameter, message string) (string, error) {
+	substituter := newParameterSubstituter(params)
+	message, _ = substituter.substitute(message)
+	substitutionCounter.Add(float64(substituter.substitutions))
+	return message, substituter.err
+}
+
+// parameterSubstituter replaces the parameter expressions of strings by the
+// values of the parameters, counting the substitutions and keeping the first
+// error that occurred.
+type parameterSubstituter struct {
+	params        map[string]string
+	substitutions int
+	err           error
+}
+
+func newParameterSubstituter(params []api.Parameter) *parameterSubstituter {
 	// Make searching for given parameter name/value more effective
 	paramMap := make(map[string]string, len(params))
 	for _, param := range params {
 		paramMap[param.Name] = param.Value
 	}
+	return &parameterSubstituter{params: paramMap}
+}
+
+// substitutionError is returned when the parameter expression of a
+// parameter cannot be evaluated.
+type substitutionError struct {
+	parameter  string
+	expression string
+	err        error
+}
+
+func (e *substitutionError) Error() string {
+	return fmt.Sprintf("unable to evaluate %s: %v", e.expression, e.err)
+}
 
-	stringreplace.VisitObjectStrings(item, func(in string) string {
-		for _, match := range parameterExp.FindAllStringSubmatch(in, -1) {
-			if len(match) > 1 {
-				if paramValue, found := paramMap[match[1]]; found {
-					in = strings.Replace(in, match[0], paramValue, 1)
+// substitute returns in with its parameter expressions replaced, and false
+// if the result is an unquoted parameter value.
+func (s *parameterSubstituter) substitute(in string) (string, bool) {
+	if strings.Contains(in, escapedExpressionPrefix) {
+		// the text between the escaped expressions is substituted, the
+		// escaped expressions lose their first "$"
+		segments := strings.Split(in, escapedExpressionPrefix)
+		for i := range segments {
+			segments[i] = s.substituteExpressions(segments[i])
+		}
+		return strings.Join(segments, "${"), true
+	}
+	// A value consisting only of "${{PARAMETER_NAME}}" is replaced by the
+	// unquoted parameter value, so it can populate non-string fields.
+	if match := nonStringParameterExp.FindStringSubmatch(in); len(match) > 1 {
+		if paramValue, found := s.params[match[1]]; found {
+			s.substitutions++
+			return paramValue, false
+		}
+	}
+	return s.substituteExpressions(in), true
+}
+
+// substituteExpressions returns in with its function and ${PARAMETER_NAME}
+// expressions replaced.
+func (s *parameterSubstituter) substituteExpressions(in string) string {
+	for _, match := range functionExp.FindAllStringSubmatch(in, -1) {
+		if len(match) > 3 {
+			if paramValue, found := s.params[match[2]]; found {
+				value, err := evaluateFunction(match[1], paramValue, match[3])
+				if err != nil {
+					if s.err == nil {
+						s.err = &substitutionError{parameter: match[2], expression: match[0], err: err}
+					}
+					continue
 				}
+				in = strings.Replace(in, match[0], value, 1)
+				s.substitutions++
 			}
 		}
-		return in
+	}
+	for _, match := range parameterExp.FindAllStringSubmatch(in, -1) {
+		if len(match) > 1 {
+			if paramValue, found := s.params[match[1]]; found {
+				in = strings.Replace(in, match[0], paramValue, 1)
+				s.substitutions++
+			}
+		}
+	}
+	return in
+}
+
+// UndeclaredParameterReferences returns the sorted names of the parameters
+// referenced by item, using the ${PARAMETER_NAME}, ${{PARAMETER_NAME}} or
+// ${function(PARAMETER_NAME)} expressions, that are not present in params.
+func UndeclaredParameterReferences(params []api.Parameter, item runtime.Object) []string {
+	return (&Processor{}).undeclaredParameterReferences(params, item)
+}
+
+// undeclaredParameterReferences implements UndeclaredParameterReferences
+// ignoring the fields parameters are not substituted into.
+func (p *Processor) undeclaredParameterReferences(params []api.Parameter, item runtime.Object) []string {
+	declared := sets.NewString()
+	for _, param := range params {
+		declared.Insert(param.Name)
+	}
+	undeclared := sets.NewString()
+	stringreplace.VisitObjectPaths(item, func(path, in string) (string, bool) {
+		if !p.substitutable(objectPath(item, path)) {
+			return in, true
+		}
+		undeclared.Insert(referencedParameters(in).Difference(declared).List()...)
+		return in, true
 	})
+	return undeclared.List()
+}
 
-	return item, nil
+// referencedParameters returns the names of the parameters referenced by in.
+// Escaped expressions do not reference parameters.
+func referencedParameters(in string) sets.String {
+	names := sets.NewString()
+	for _, segment := range strings.Split(in, escapedExpressionPrefix) {
+		for _, exp := range []*regexp.Regexp{parameterExp, nonStringParameterReferenceExp} {
+			for _, match := range exp.FindAllStringSubmatch(segment, -1) {
+				if len(match) > 1 {
+					names.Insert(match[1])
+				}
+			}
+		}
+		for _, match := range functionExp.FindAllStringSubmatch(segment, -1) {
+			if len(match) > 2 {
+				names.Insert(match[2])
+			}
+		}
+	}
+	return names
 }
 
 // GenerateParameterValues generates Value for each Parameter of the given
 // Template that has Generate field specified where Value is not already
-// supplied.
+// supplied. When the Processor has an Env lookup, Parameters declaring
+// FromEnv without a Value are read from the environment instead of being
+// generated. Both the Value and the From fields may reference other parameters
+// using the ${PARAMETER_NAME} expression, the references are replaced by the
+// values of the referenced parameters, which are resolved first. Circular
+// references are reported as an error. Every resulting Value is then
+// validated against the Type of its Parameter.
 //
 // Examples:
 //
@@ -152,37 +484,117 @@ func (p *Processor) SubstituteParameters(params []api.Parameter, item runtime.Ob
 // "[0-1]{8}"       | "01001100"
 // "0x[A-F0-9]{4}"  | "0xB3AF"
 // "[a-zA-Z0-9]{8}" | "hW4yQU5i"
-// If an error occurs, the parameter that caused the error is returned along with the error message.
-func (p *Processor) GenerateParameterValues(t *api.Template) *field.Error {
-	for i := range t.Parameters {
+//
+// Every Parameter is processed, and an error is returned for each Parameter
+// that caused one, so that they can all be fixed at once. The Parameters
+// referencing a Parameter that caused an error are skipped, their errors
+// would only be a consequence of the first one.
+func (p *Processor) GenerateParameterValues(t *api.Template) field.ErrorList {
+	order, err := parameterOrder(t.Parameters)
+	if err != nil {
+		return field.ErrorList{err}
+	}
+	allErrs := field.ErrorList{}
+	failed := sets.NewString()
+	for _, i := range order {
 		param := &t.Parameters[i]
-		if len(param.Value) > 0 {
+		if failed.HasAny(parameterReferences(param)...) {
+			failed.Insert(param.Name)
 			continue
 		}
-		templatePath := field.NewPath("template").Child("parameters").Index(i)
-		if param.Generate != "" {
-			generator, ok := p.Generators[param.Generate]
-			if !ok {
-				return field.NotFound(templatePath, param)
-			}
-			if generator == nil {
-				err := fmt.Errorf("template.parameters[%v]: Invalid '%v' generator for parameter %s", i, param.Generate, param.Name)
-				return field.Invalid(templatePath, param, err.Error())
-			}
-			value, err := generator.GenerateValue(param.From)
-			if err != nil {
-				return field.Invalid(templatePath, param, err.Error())
-			}
-			param.Value, ok = value.(string)
+		if err := p.generateParameterValue(t, i); err != nil {
+			allErrs = append(allErrs, err)
+			failed.Insert(param.Name)
+		}
+	}
+	return allErrs
+}
+
+// generateParameterValue sets and validates the Value of the Parameter at
+// index i of the given Template, see GenerateParameterValues.
+func (p *Processor) generateParameterValue(t *api.Template, i int) *field.Error {
+	param := &t.Parameters[i]
+	templatePath := field.NewPath("template").Child("parameters").Index(i)
+	if len(param.Value) > 0 {
+		param.Value = expandParameterReferences(param.Value, t.Parameters)
+	} else if value, ok := parameterValueFromEnv(param, p.Env); ok {
+		// values read from the environment are used verbatim
+		param.Value = value
+	} else if param.Generate != "" {
+		generator, ok := p.Generators[param.Generate]
+		if secureGenerator, found := p.SecureGenerators[param.Generate]; found && IsSecretParameter(param) {
+			generator, ok = secureGenerator, true
+		}
+		if !ok {
+			// the names of unknown generators are not used as labels, they are not bounded
+			generationFailureCounter.WithLabelValues("unknown").Inc()
+			return field.NotFound(templatePath, param.Name)
+		}
+		if generator == nil {
+			err := fmt.Errorf("template.parameters[%v]: Invalid '%v' generator for parameter %s", i, param.Generate, param.Name)
+			return field.Invalid(templatePath, param.Name, err.Error())
+		}
+		var value interface{}
+		var err error
+		if param.GeneratorOptions != nil {
+			configurable, ok := generator.(ConfigurableGenerator)
 			if !ok {
-				err := fmt.Errorf("template.parameters[%v]: Unable to convert the generated value '%#v' to string for parameter %s", i, value, param.Name)
-				return field.Invalid(templatePath, param, err.Error())
+				err := fmt.Errorf("template.parameters[%v]: The '%v' generator of parameter %s does not accept generator options", i, param.Generate, param.Name)
+				return field.Invalid(templatePath, param.Name, err.Error())
 			}
+			value, err = configurable.GenerateConfiguredValue(*param.GeneratorOptions)
+		} else {
+			value, err = generator.GenerateValue(expandParameterReferences(param.From, t.Parameters))
 		}
-		if len(param.Value) == 0 && param.Required {
-			err := fmt.Errorf("template.parameters[%v]: parameter %s is required and must be specified", i, param.Name)
-			return field.Required(templatePath, err.Error())
+		if err != nil {
+			generationFailureCounter.WithLabelValues(param.Generate).Inc()
+			return field.Invalid(templatePath, param.Name, err.Error())
+		}
+		param.Value, ok = value.(string)
+		if !ok {
+			err := fmt.Errorf("template.parameters[%v]: Unable to convert the generated value '%#v' to string for parameter %s", i, value, param.Name)
+			return field.Invalid(templatePath, param.Name, err.Error())
+		}
+	}
+	if len(param.Value) == 0 && param.Required {
+		err := fmt.Errorf("template.parameters[%v]: parameter %s is required and must be specified", i, param.Name)
+		return field.Required(templatePath, err.Error())
+	}
+	if err := validateParameterValue(param); err != nil {
+		err := fmt.Errorf("template.parameters[%v]: %v", i, err)
+		return field.Invalid(templatePath, param.Name, err.Error())
+	}
+	return nil
+}
+
+// validateParameterValue checks that the Value of the given Parameter conforms
+// to its declared Type. Empty values are not validated, use Required to
+// enforce that a value is set.
+func validateParameterValue(param *api.Parameter) error {
+	if len(param.Value) == 0 {
+		return nil
+	}
+	// the value is not disclosed in the error of a parameter holding a secret
+	shown := param.Value
+	if IsSecretParameter(param) {
+		shown = api.RedactedParameterValue
+	}
+	switch param.Type {
+	case api.ParameterTypeInt:
+		if _, err := strconv.ParseInt(param.Value, 10, 64); err != nil {
+			return fmt.Errorf("parameter %s must be an integer, got %q", param.Name, shown)
+		}
+	case api.ParameterTypeBool:
+		if param.Value != "true" && param.Value != "false" {
+			return fmt.Errorf("parameter %s must be either \"true\" or \"false\", got %q", param.Name, shown)
+		}
+	case api.ParameterTypeEnum:
+		for _, allowed := range param.Allowed {
+			if param.Value == allowed {
+				return nil
+			}
 		}
+		return fmt.Errorf("parameter %s must be one of %s, got %q", param.Name, strings.Join(param.Allowed, ", "), shown)
 	}
 	return nil
 }
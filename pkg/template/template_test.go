@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"math/rand"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -154,14 +155,15 @@ func TestParameterGenerators(t *testing.T) {
 	for i, test := range tests {
 		processor := NewProcessor(test.generators)
 		template := api.Template{Parameters: []api.Parameter{test.parameter}}
-		err := processor.GenerateParameterValues(&template)
-		if err != nil && test.shouldPass {
-			t.Errorf("test[%v]: Unexpected error %v", i, err)
+		errs := processor.GenerateParameterValues(&template)
+		if len(errs) > 0 && test.shouldPass {
+			t.Errorf("test[%v]: Unexpected error %v", i, errs)
 		}
-		if err == nil && !test.shouldPass {
+		if len(errs) == 0 && !test.shouldPass {
 			t.Errorf("test[%v]: Expected error", i)
 		}
-		if err != nil {
+		if len(errs) > 0 {
+			err := errs[0]
 			if test.errType != err.Type {
 				t.Errorf("test[%v]: Unexpected error type: Expected: %s, got %s", i, test.errType, err.Type)
 			}
@@ -177,6 +179,318 @@ func TestParameterGenerators(t *testing.T) {
 	}
 }
 
+type EchoGenerator struct {
+}
+
+func (g EchoGenerator) GenerateValue(expression string) (interface{}, error) {
+	return expression, nil
+}
+
+func TestGenerateParameterValuesAggregatesErrors(t *testing.T) {
+	processor := NewProcessor(map[string]generator.Generator{"expression": FooGenerator{}, "error": ErrorGenerator{}})
+	template := api.Template{Parameters: []api.Parameter{
+		makeParameter("NAME", "frontend", "", false),
+		makeParameter("PASSWORD", "", "error", false),
+		makeParameter("REPLICAS", "three", "", false),
+		makeParameter("URL", "http://${PASSWORD}@example.com", "", true),
+		makeParameter("TOKEN", "", "missing", false),
+		makeParameter("USER", "", "expression", false),
+	}}
+	template.Parameters[2].Type = api.ParameterTypeInt
+
+	errs := processor.GenerateParameterValues(&template)
+	fields := []string{}
+	for _, err := range errs {
+		fields = append(fields, err.Field)
+	}
+	expected := []string{"template.parameters[1]", "template.parameters[2]", "template.parameters[4]"}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("expected errors for %v, got %v", expected, errs)
+	}
+	if value := template.Parameters[5].Value; value != "foo" {
+		t.Errorf("expected the value of USER to be generated despite the errors, got %q", value)
+	}
+}
+
+func TestGenerateFromParameterReferences(t *testing.T) {
+	processor := NewProcessor(map[string]generator.Generator{"echo": EchoGenerator{}})
+	template := api.Template{Parameters: []api.Parameter{
+		makeParameter("USER", "admin", "", false),
+		makeParameter("PASSWORD", "", "echo", false),
+		makeParameter("HTPASSWD", "", "echo", false),
+	}}
+	template.Parameters[1].From = "secret"
+	template.Parameters[2].From = "${USER}:${PASSWORD}:${MISSING}"
+	if errs := processor.GenerateParameterValues(&template); len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if value := template.Parameters[2].Value; value != "admin:secret:${MISSING}" {
+		t.Errorf("unexpected value %q", value)
+	}
+}
+
+func TestParameterDependencies(t *testing.T) {
+	processor := NewProcessor(map[string]generator.Generator{"echo": EchoGenerator{}})
+	template := api.Template{Parameters: []api.Parameter{
+		makeParameter("DATABASE_URL", "postgres://${DB_USER}:${DB_PASSWORD}@${DB_HOST}/${DB_NAME}", "", false),
+		makeParameter("DB_USER", "admin", "", false),
+		makeParameter("DB_PASSWORD", "", "echo", false),
+		makeParameter("DB_HOST", "", "", false),
+		makeParameter("DB_NAME", "${DB_USER}db", "", false),
+	}}
+	template.Parameters[2].From = "${DB_USER}-secret"
+	if errs := processor.GenerateParameterValues(&template); len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if value := template.Parameters[0].Value; value != "postgres://admin:admin-secret@/admindb" {
+		t.Errorf("unexpected value %q", value)
+	}
+
+	template = api.Template{Parameters: []api.Parameter{
+		makeParameter("A", "${B}", "", false),
+		makeParameter("B", "${A}", "", false),
+	}}
+	if errs := processor.GenerateParameterValues(&template); len(errs) != 1 || errs[0].Field != "template.parameters[0]" {
+		t.Errorf("expected a circular reference error, got %v", errs)
+	}
+}
+
+func TestProcessExcludePaths(t *testing.T) {
+	template := api.Template{
+		ObjectMeta: kapi.ObjectMeta{Annotations: map[string]string{
+			api.ExcludePathsAnnotation: "metadata.annotations.prometheus.io/.*",
+		}},
+		Parameters: []api.Parameter{
+			makeParameter("NAME", "frontend", "", false),
+		},
+		Objects: []runtime.Object{
+			&runtime.Unknown{RawJSON: []byte(`{"kind":"Service","apiVersion":"v1","metadata":{"name":"${NAME}","annotations":{"prometheus.io/rule":"${job}","description":"${NAME}","${NAME}":"key"}},"spec":{"selector":{"name":"${NAME}"}}}`)},
+		},
+	}
+	processor := NewProcessor(nil)
+	processor.ExcludePaths, _ = CompilePathExpressions([]string{`spec\.selector\..*`})
+	if errs := processor.Process(&template); len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	data, err := runtime.Encode(runtime.UnstructuredJSONScheme, template.Objects[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"apiVersion":"v1","kind":"Service","metadata":{"annotations":{"description":"frontend","frontend":"key","prometheus.io/rule":"${job}"},"name":"frontend"},"spec":{"selector":{"name":"${NAME}"}}}`
+	if strings.TrimSpace(string(data)) != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, string(data))
+	}
+
+	processor = NewProcessor(nil)
+	processor.IncludePaths, _ = CompilePathExpressions([]string{`metadata\.name`})
+	item := &kapi.Service{ObjectMeta: kapi.ObjectMeta{Name: "${NAME}", Labels: map[string]string{"name": "${NAME}"}}}
+	if _, err := processor.SubstituteParameters(template.Parameters, item); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if item.Name != "frontend" || item.Labels["name"] != "${NAME}" {
+		t.Errorf("unexpected substitution result: %#v", item.ObjectMeta)
+	}
+}
+
+func TestProcessTransformers(t *testing.T) {
+	template := api.Template{
+		Parameters: []api.Parameter{
+			makeParameter("NAME", "frontend", "", false),
+		},
+		ObjectLabels: map[string]string{"app": "test"},
+		Objects: []runtime.Object{
+			&runtime.Unknown{RawJSON: []byte(`{"kind":"Service","apiVersion":"v1","metadata":{"name":"${NAME}","namespace":"other"}}`)},
+		},
+	}
+	processor := NewProcessor(nil)
+	processor.Transformers = append(DefaultTransformers(), ObjectTransformerFunc(func(ctx ObjectContext, obj runtime.Object) (runtime.Object, field.ErrorList) {
+		obj.(*runtime.Unstructured).Object["metadata"].(map[string]interface{})["annotations"] = map[string]interface{}{"transformed": ctx.Path.String()}
+		return obj, field.ErrorList{field.Invalid(ctx.Path, "", "transformer error")}
+	}))
+	errs := processor.Process(&template)
+	if len(errs) != 1 || errs[0].Detail != "transformer error" || errs[0].Field != "item[0]" {
+		t.Fatalf("expected the transformer error, got %v", errs)
+	}
+	data, err := runtime.Encode(runtime.UnstructuredJSONScheme, template.Objects[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"apiVersion":"v1","kind":"Service","metadata":{"annotations":{"transformed":"item[0]"},"labels":{"app":"test"},"name":"frontend","namespace":""}}`
+	if strings.TrimSpace(string(data)) != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, string(data))
+	}
+
+	// without the label applier
+	template.Objects = []runtime.Object{&runtime.Unknown{RawJSON: []byte(`{"kind":"Service","apiVersion":"v1","metadata":{"name":"${NAME}"}}`)}}
+	processor.Transformers = []ObjectTransformer{ParameterSubstituter}
+	if errs := processor.Process(&template); len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if labels := template.Objects[0].(*runtime.Unstructured).Object["metadata"].(map[string]interface{})["labels"]; labels != nil {
+		t.Errorf("unexpected labels %v", labels)
+	}
+}
+
+func TestProcessObjectAnnotations(t *testing.T) {
+	template := api.Template{
+		ObjectAnnotations: map[string]string{"example.com/owner": "team-a", "example.com/commit": "abc123"},
+		Objects: []runtime.Object{
+			&runtime.Unknown{RawJSON: []byte(`{"kind":"Service","apiVersion":"v1","metadata":{"name":"frontend","annotations":{"description":"web"}}}`)},
+			&runtime.Unknown{RawJSON: []byte(`{"kind":"Service","apiVersion":"v1","metadata":{"name":"backend"}}`)},
+		},
+	}
+	if errs := NewProcessor(nil).Process(&template); len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	expected := []map[string]interface{}{
+		{"description": "web", "example.com/owner": "team-a", "example.com/commit": "abc123"},
+		{"example.com/owner": "team-a", "example.com/commit": "abc123"},
+	}
+	for i, obj := range template.Objects {
+		annotations := obj.(*runtime.Unstructured).Object["metadata"].(map[string]interface{})["annotations"]
+		if !reflect.DeepEqual(annotations, expected[i]) {
+			t.Errorf("%d: expected annotations %v, got %v", i, expected[i], annotations)
+		}
+	}
+}
+
+func TestSecureGenerators(t *testing.T) {
+	processor := NewProcessor(map[string]generator.Generator{"expression": FooGenerator{}})
+	processor.SecureGenerators = map[string]generator.Generator{"expression": EmptyGenerator{}}
+	template := api.Template{Parameters: []api.Parameter{
+		makeParameter("APPLICATION_NAME", "", "expression", false),
+		makeParameter("ADMIN_PASSWORD", "", "expression", false),
+	}}
+	if errs := processor.GenerateParameterValues(&template); len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if value := template.Parameters[0].Value; value != "foo" {
+		t.Errorf("expected the regular generator to be used, got %q", value)
+	}
+	if value := template.Parameters[1].Value; value != "" {
+		t.Errorf("expected the secure generator to be used, got %q", value)
+	}
+}
+
+func TestGeneratorOptions(t *testing.T) {
+	processor := NewProcessor(map[string]generator.Generator{
+		"charset":    generator.NewCharsetValueGenerator(rand.New(rand.NewSource(1337))),
+		"expression": FooGenerator{},
+	})
+	template := api.Template{Parameters: []api.Parameter{
+		makeParameter("PASSWORD", "", "charset", false),
+	}}
+	template.Parameters[0].GeneratorOptions = &api.GeneratorOptions{Charset: "a-c", MinLength: 6, MaxLength: 10}
+	if errs := processor.GenerateParameterValues(&template); len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	value := template.Parameters[0].Value
+	if len(value) < 6 || len(value) > 10 || strings.Trim(value, "abc") != "" {
+		t.Errorf("expected 6 to 10 characters out of a-c, got %q", value)
+	}
+
+	template = api.Template{Parameters: []api.Parameter{
+		makeParameter("PASSWORD", "", "expression", false),
+	}}
+	template.Parameters[0].GeneratorOptions = &api.GeneratorOptions{MinLength: 6}
+	if errs := processor.GenerateParameterValues(&template); len(errs) == 0 {
+		t.Errorf("expected an error for a generator that does not accept options")
+	}
+}
+
+func TestParameterTypes(t *testing.T) {
+	tests := []struct {
+		value      string
+		paramType  api.ParameterType
+		allowed    []string
+		shouldPass bool
+	}{
+		{"anything", "", nil, true},
+		{"anything", api.ParameterTypeString, nil, true},
+		{"10", api.ParameterTypeInt, nil, true},
+		{"-3", api.ParameterTypeInt, nil, true},
+		{"ten", api.ParameterTypeInt, nil, false},
+		{"1.5", api.ParameterTypeInt, nil, false},
+		{"true", api.ParameterTypeBool, nil, true},
+		{"false", api.ParameterTypeBool, nil, true},
+		{"yes", api.ParameterTypeBool, nil, false},
+		{"info", api.ParameterTypeEnum, []string{"debug", "info", "warn"}, true},
+		{"trace", api.ParameterTypeEnum, []string{"debug", "info", "warn"}, false},
+		{"", api.ParameterTypeInt, nil, true},
+	}
+
+	for i, test := range tests {
+		processor := NewProcessor(map[string]generator.Generator{})
+		param := makeParameter("PARAM", test.value, "", false)
+		param.Type = test.paramType
+		param.Allowed = test.allowed
+		template := api.Template{Parameters: []api.Parameter{param}}
+		errs := processor.GenerateParameterValues(&template)
+		if len(errs) > 0 && test.shouldPass {
+			t.Errorf("test[%v]: Unexpected error %v", i, errs)
+		}
+		if len(errs) == 0 && !test.shouldPass {
+			t.Errorf("test[%v]: Expected error", i)
+		}
+		if len(errs) > 0 && errs[0].Type != field.ErrorTypeInvalid {
+			t.Errorf("test[%v]: Unexpected error type: Expected: %s, got %s", i, field.ErrorTypeInvalid, errs[0].Type)
+		}
+	}
+}
+
+func TestSetParameterValues(t *testing.T) {
+	tests := map[string]struct {
+		strict   bool
+		values   map[string]string
+		expected string
+		errs     int
+	}{
+		"known parameter": {
+			values:   map[string]string{"KNOWN": "bar"},
+			expected: "bar",
+		},
+		"unknown parameter ignored": {
+			values:   map[string]string{"KNOWN": "bar", "UNKNOWN": "baz"},
+			expected: "bar",
+		},
+		"strict known parameter": {
+			strict:   true,
+			values:   map[string]string{"KNOWN": "bar"},
+			expected: "bar",
+		},
+		"strict unknown parameters": {
+			strict:   true,
+			values:   map[string]string{"KNOWN": "bar", "UNKNOWN": "baz", "TYPO": "qux"},
+			expected: "",
+			errs:     2,
+		},
+	}
+
+	for name, test := range tests {
+		processor := NewProcessor(map[string]generator.Generator{})
+		processor.Strict = test.strict
+		template := api.Template{Parameters: []api.Parameter{makeParameter("KNOWN", "", "foo", false)}}
+		errs := processor.SetParameterValues(&template, test.values)
+		if len(errs) != test.errs {
+			t.Errorf("%s: expected %d errors, got %v", name, test.errs, errs)
+		}
+		for _, err := range errs {
+			if err.Type != field.ErrorTypeNotFound {
+				t.Errorf("%s: unexpected error type: %s", name, err.Type)
+			}
+		}
+		if len(template.Parameters) != 1 {
+			t.Errorf("%s: unexpected parameters: %#v", name, template.Parameters)
+		}
+		if actual := template.Parameters[0].Value; actual != test.expected {
+			t.Errorf("%s: expected value %q, got %q", name, test.expected, actual)
+		}
+		if test.errs == 0 && template.Parameters[0].Generate != "" {
+			t.Errorf("%s: expected the generator to be disabled", name)
+		}
+	}
+}
+
 func TestProcessValueEscape(t *testing.T) {
 	var template api.Template
 	if err := runtime.DecodeInto(kapi.Codecs.UniversalDecoder(), []byte(`{
@@ -187,7 +501,9 @@ func TestProcessValueEscape(t *testing.T) {
 				"metadata": {
 					"labels": {
 						"key1": "${VALUE}",
-						"key2": "$${VALUE}"
+						"key2": "$${VALUE}",
+						"key3": "${VALUE}-$${VALUE}-${lower(VALUE)}",
+						"key4": "$${{VALUE}}"
 					}
 				}
 			}
@@ -213,13 +529,392 @@ func TestProcessValueEscape(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unexpected error during encoding Config: %#v", err)
 	}
-	expect := `{"kind":"Template","apiVersion":"v1beta3","metadata":{"creationTimestamp":null},"objects":[{"apiVersion":"v1beta31","kind":"Service","metadata":{"labels":{"key1":"1","key2":"$1"}}}],"parameters":[{"name":"VALUE","value":"1"}]}`
+	expect := `{"kind":"Template","apiVersion":"v1beta3","metadata":{"creationTimestamp":null},"objects":[{"apiVersion":"v1beta31","kind":"Service","metadata":{"labels":{"key1":"1","key2":"${VALUE}","key3":"1-${VALUE}-1","key4":"${{VALUE}}"}}}],"parameters":[{"name":"VALUE","value":"1"}]}`
+	stringResult := strings.TrimSpace(string(result))
+	if expect != stringResult {
+		t.Errorf("unexpected output: %s", util.StringDiff(expect, stringResult))
+	}
+}
+
+func TestProcessMapKeys(t *testing.T) {
+	var template api.Template
+	if err := runtime.DecodeInto(kapi.Codecs.UniversalDecoder(), []byte(`{
+		"kind":"Template", "apiVersion":"v1",
+		"objects": [
+			{
+				"kind": "Service", "apiVersion": "v1beta3",
+				"metadata": {
+					"labels": {
+						"${NAME}-key": "${NAME}",
+						"static": "value"
+					}
+				}
+			}
+		]
+	}`), &template); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	processor := NewProcessor(map[string]generator.Generator{})
+	AddParameter(&template, makeParameter("NAME", "app", "", false))
+
+	errs := processor.Process(&template)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected error: %v", errs)
+	}
+	result, err := runtime.Encode(kapi.Codecs.LegacyCodec(v1beta3.SchemeGroupVersion), &template)
+	if err != nil {
+		t.Fatalf("unexpected error during encoding Config: %#v", err)
+	}
+	expect := `{"kind":"Template","apiVersion":"v1beta3","metadata":{"creationTimestamp":null},"objects":[{"apiVersion":"v1beta3","kind":"Service","metadata":{"labels":{"app-key":"app","static":"value"}}}],"parameters":[{"name":"NAME","value":"app"}]}`
+	stringResult := strings.TrimSpace(string(result))
+	if expect != stringResult {
+		t.Errorf("unexpected output: %s", util.StringDiff(expect, stringResult))
+	}
+}
+
+func TestProcessUnknownKinds(t *testing.T) {
+	var template api.Template
+	if err := runtime.DecodeInto(kapi.Codecs.UniversalDecoder(), []byte(`{
+		"kind":"Template", "apiVersion":"v1",
+		"objects": [
+			{
+				"kind": "Widget", "apiVersion": "example.com/v1",
+				"metadata": {"name": "${NAME}"},
+				"spec": {"size": 1234567890123456789, "owner": "${NAME}", "custom": {"nested": ["${NAME}"]}}
+			},
+			{
+				"kind": "List", "apiVersion": "v1",
+				"items": [
+					{"kind": "Widget", "apiVersion": "example.com/v1", "spec": {"owner": "${NAME}", "replicas": "${{REPLICAS}}"}}
+				]
+			}
+		]
+	}`), &template); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	processor := NewProcessor(map[string]generator.Generator{})
+	AddParameter(&template, makeParameter("NAME", "app", "", false))
+	AddParameter(&template, makeParameter("REPLICAS", "3", "", false))
+	if errs := processor.Process(&template); len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	result, err := runtime.Encode(kapi.Codecs.LegacyCodec(v1beta3.SchemeGroupVersion), &template)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, expected := range []string{
+		`"metadata":{"name":"app"}`,
+		`"spec":{"custom":{"nested":["app"]},"owner":"app","size":1234567890123456789}`,
+		`"items":[{"apiVersion":"example.com/v1","kind":"Widget","spec":{"owner":"app","replicas":3}}]`,
+	} {
+		if !strings.Contains(string(result), expected) {
+			t.Errorf("expected %s in the processed template: %s", expected, result)
+		}
+	}
+
+	// objects of unknown kinds nested in typed objects are substituted as well
+	template = api.Template{
+		Parameters: []api.Parameter{makeParameter("NAME", "app", "", false)},
+		Objects: []runtime.Object{
+			&kapi.List{Items: []runtime.Object{
+				&runtime.Unknown{RawJSON: []byte(`{"kind":"Widget","apiVersion":"example.com/v1","spec":{"owner":"${NAME}","size":10}}`)},
+			}},
+		},
+	}
+	if errs := processor.Process(&template); len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	raw := string(template.Objects[0].(*kapi.List).Items[0].(*runtime.Unknown).RawJSON)
+	if expected := `{"apiVersion":"example.com/v1","kind":"Widget","spec":{"owner":"app","size":10}}`; raw != expected {
+		t.Errorf("expected %s, got %s", expected, raw)
+	}
+}
+
+func TestProcessPreserveFormatting(t *testing.T) {
+	template := api.Template{
+		Parameters: []api.Parameter{makeParameter("NAME", "app", "", false)},
+		Objects: []runtime.Object{
+			&runtime.Unknown{RawJSON: []byte(`{"kind": "Widget", "metadata": {"name": "${NAME}", "namespace": "test"}, "apiVersion": "example.com/v1", "spec": {"ratio": 1.50}}`)},
+		},
+		ObjectLabels: map[string]string{"template": "widget"},
+	}
+	processor := NewProcessor(map[string]generator.Generator{})
+	processor.PreserveFormatting = true
+	if errs := processor.Process(&template); len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	unknown, ok := template.Objects[0].(*runtime.Unknown)
+	if !ok {
+		t.Fatalf("expected a runtime.Unknown object, got %#v", template.Objects[0])
+	}
+	expected := `{"kind":"Widget","metadata":{"name":"app","namespace":"","labels":{"template":"widget"}},"apiVersion":"example.com/v1","spec":{"ratio":1.50}}`
+	if string(unknown.RawJSON) != expected {
+		t.Errorf("expected %s, got %s", expected, unknown.RawJSON)
+	}
+	if unknown.Kind != "Widget" || unknown.APIVersion != "example.com/v1" {
+		t.Errorf("unexpected type of the object: %#v", unknown.TypeMeta)
+	}
+}
+
+func TestProcessNonStringParameters(t *testing.T) {
+	var template api.Template
+	if err := runtime.DecodeInto(kapi.Codecs.UniversalDecoder(), []byte(`{
+		"kind":"Template", "apiVersion":"v1",
+		"objects": [
+			{
+				"kind": "ReplicationController", "apiVersion": "v1beta3",
+				"spec": {
+					"replicas": "${{REPLICAS}}",
+					"privileged": "${{PRIVILEGED}}",
+					"name": "${{NAME}}",
+					"description": "${NAME} with ${{REPLICAS}} replicas"
+				}
+			}
+		]
+	}`), &template); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	processor := NewProcessor(map[string]generator.Generator{})
+	AddParameter(&template, makeParameter("REPLICAS", "2", "", false))
+	AddParameter(&template, makeParameter("PRIVILEGED", "true", "", false))
+	AddParameter(&template, makeParameter("NAME", "app", "", false))
+
+	errs := processor.Process(&template)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected error: %v", errs)
+	}
+	result, err := runtime.Encode(runtime.UnstructuredJSONScheme, template.Objects[0])
+	if err != nil {
+		t.Fatalf("unexpected error during encoding: %#v", err)
+	}
+	expect := `{"apiVersion":"v1beta3","kind":"ReplicationController","spec":{"description":"app with ${{REPLICAS}} replicas","name":"app","privileged":true,"replicas":2}}`
 	stringResult := strings.TrimSpace(string(result))
 	if expect != stringResult {
 		t.Errorf("unexpected output: %s", util.StringDiff(expect, stringResult))
 	}
 }
 
+func TestProcessFunctions(t *testing.T) {
+	var template api.Template
+	if err := runtime.DecodeInto(kapi.Codecs.UniversalDecoder(), []byte(`{
+		"kind":"Template", "apiVersion":"v1",
+		"objects": [
+			{
+				"kind": "Service", "apiVersion": "v1beta3",
+				"metadata": {
+					"name": "${lower(NAME)}-${trunc(BUILD_ID,4)}",
+					"labels": {
+						"${replace(NAME,_,-)}": "${upper(NAME)}"
+					}
+				}
+			}
+		]
+	}`), &template); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	processor := NewProcessor(map[string]generator.Generator{})
+	AddParameter(&template, makeParameter("NAME", "My_App", "", false))
+	AddParameter(&template, makeParameter("BUILD_ID", "abcdef123", "", false))
+
+	errs := processor.Process(&template)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected error: %v", errs)
+	}
+	result, err := runtime.Encode(runtime.UnstructuredJSONScheme, template.Objects[0])
+	if err != nil {
+		t.Fatalf("unexpected error during encoding: %#v", err)
+	}
+	expect := `{"apiVersion":"v1beta3","kind":"Service","metadata":{"labels":{"My-App":"MY_APP"},"name":"my_app-abcd"}}`
+	stringResult := strings.TrimSpace(string(result))
+	if expect != stringResult {
+		t.Errorf("unexpected output: %s", util.StringDiff(expect, stringResult))
+	}
+
+	template.Objects = []runtime.Object{&runtime.Unstructured{Object: map[string]interface{}{"name": "${trunc(NAME,short)}"}}}
+	if errs := processor.Process(&template); len(errs) != 1 {
+		t.Errorf("expected an error evaluating an invalid function, got %v", errs)
+	} else if errs[0].BadValue != "NAME" || strings.Contains(errs[0].Error(), "My_App") {
+		t.Errorf("expected the error to report the parameter name without its value, got %v", errs[0])
+	}
+	template.Objects = []runtime.Object{&runtime.Unstructured{Object: map[string]interface{}{"name": "${lower(MISSING)}"}}}
+	if errs := processor.Process(&template); len(errs) != 1 {
+		t.Errorf("expected an error referencing an undeclared parameter, got %v", errs)
+	}
+}
+
+func TestProcessCount(t *testing.T) {
+	tests := map[string]struct {
+		count    string
+		expected []string
+		errs     int
+	}{
+		"parameter count": {
+			count:    "${REPLICAS}",
+			expected: []string{"claim-0", "claim-1", "claim-2"},
+		},
+		"literal count": {
+			count:    "1",
+			expected: []string{"claim-0"},
+		},
+		"zero count": {
+			count:    "0",
+			expected: []string{},
+		},
+		"invalid count": {
+			count:    "many",
+			expected: []string{"claim-${TEMPLATE_INDEX}"},
+			errs:     1,
+		},
+		"count over the maximum": {
+			count:    "1000000000",
+			expected: []string{"claim-${TEMPLATE_INDEX}"},
+			errs:     1,
+		},
+	}
+
+	for name, test := range tests {
+		template := api.Template{
+			Objects: []runtime.Object{
+				&runtime.Unstructured{
+					Object: map[string]interface{}{
+						"kind": "PersistentVolumeClaim",
+						"metadata": map[string]interface{}{
+							"name":        "claim-${TEMPLATE_INDEX}",
+							"annotations": map[string]interface{}{api.CountAnnotation: test.count},
+						},
+					},
+				},
+			},
+		}
+		processor := NewProcessor(map[string]generator.Generator{})
+		AddParameter(&template, makeParameter("REPLICAS", "3", "", false))
+
+		errs := processor.Process(&template)
+		if len(errs) != test.errs {
+			t.Errorf("%s: expected %d errors, got %v", name, test.errs, errs)
+		}
+		names := []string{}
+		for _, obj := range template.Objects {
+			metadata := obj.(*runtime.Unstructured).Object["metadata"].(map[string]interface{})
+			if _, ok := metadata["annotations"].(map[string]interface{})[api.CountAnnotation]; ok && test.errs == 0 {
+				t.Errorf("%s: expected the count annotation to be removed", name)
+			}
+			names = append(names, metadata["name"].(string))
+		}
+		if !reflect.DeepEqual(names, test.expected) {
+			t.Errorf("%s: expected objects %v, got %v", name, test.expected, names)
+		}
+	}
+}
+
+func TestProcessCountStructured(t *testing.T) {
+	template := api.Template{
+		Objects: []runtime.Object{
+			&kapi.Service{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:        "shard-${TEMPLATE_INDEX}",
+					Annotations: map[string]string{api.CountAnnotation: "${{SHARDS}}"},
+				},
+			},
+		},
+	}
+	processor := NewProcessor(map[string]generator.Generator{})
+	AddParameter(&template, makeParameter("SHARDS", "2", "", false))
+
+	if errs := processor.Process(&template); len(errs) > 0 {
+		t.Fatalf("unexpected error: %v", errs)
+	}
+	if len(template.Objects) != 2 {
+		t.Fatalf("expected 2 objects, got %#v", template.Objects)
+	}
+	for i, obj := range template.Objects {
+		service := obj.(*kapi.Service)
+		if expected := fmt.Sprintf("shard-%d", i); service.Name != expected {
+			t.Errorf("expected name %s, got %s", expected, service.Name)
+		}
+		if _, ok := service.Annotations[api.CountAnnotation]; ok {
+			t.Errorf("expected the count annotation to be removed")
+		}
+	}
+}
+
+func TestProcessUndeclaredParameters(t *testing.T) {
+	var template api.Template
+	if err := runtime.DecodeInto(kapi.Codecs.UniversalDecoder(), []byte(`{
+		"kind":"Template", "apiVersion":"v1",
+		"objects": [
+			{
+				"kind": "Service", "apiVersion": "v1beta3",
+				"metadata": {
+					"labels": {
+						"key1": "${VALUE}",
+						"key2": "${MISSING}-${VALUE}",
+						"${MISSING_KEY}": "value",
+						"key3": "${{MISSING_NON_STRING}}"
+					}
+				}
+			},
+			{
+				"kind": "Service", "apiVersion": "v1beta3",
+				"metadata": {"labels": {"key1": "${VALUE}"}}
+			}
+		]
+	}`), &template); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	processor := NewProcessor(map[string]generator.Generator{})
+	AddParameter(&template, makeParameter("VALUE", "1", "", false))
+
+	errs := processor.Process(&template)
+	if len(errs) != 3 {
+		t.Fatalf("expected 3 errors, got %v", errs)
+	}
+	for i, name := range []string{"MISSING", "MISSING_KEY", "MISSING_NON_STRING"} {
+		if errs[i].Type != field.ErrorTypeInvalid || errs[i].Field != "item[0]" || errs[i].BadValue != fmt.Sprintf("${%s}", name) {
+			t.Errorf("unexpected error: %v", errs[i])
+		}
+	}
+}
+
+func TestProcessMessage(t *testing.T) {
+	processor := NewProcessor(map[string]generator.Generator{})
+
+	template := api.Template{
+		Message: "Log in as ${ADMIN_USER} with the password ${ADMIN_PASSWORD}, at ${lower(HOST)}.",
+		Parameters: []api.Parameter{
+			makeParameter("ADMIN_USER", "admin", "", false),
+			makeParameter("ADMIN_PASSWORD", "s3cr3t", "", false),
+			makeParameter("HOST", "Example.COM", "", false),
+		},
+	}
+	if errs := processor.Process(&template); len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if expected := "Log in as admin with the password s3cr3t, at example.com."; template.Message != expected {
+		t.Errorf("expected message %q, got %q", expected, template.Message)
+	}
+
+	template = api.Template{
+		Message:    "${USER} ${MISSING} ${trunc(USER,x)}",
+		Parameters: []api.Parameter{makeParameter("USER", "admin", "", false)},
+	}
+	errs := processor.Process(&template)
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors, got %v", errs)
+	}
+	if errs[0].Field != "message" || errs[0].BadValue != "${MISSING}" {
+		t.Errorf("unexpected error: %v", errs[0])
+	}
+	if errs[1].Field != "message" || !strings.Contains(errs[1].Detail, "${trunc(USER,x)}") {
+		t.Errorf("unexpected error: %v", errs[1])
+	}
+}
+
 var trailingWhitespace = regexp.MustCompile(`\n\s*`)
 
 func TestEvaluateLabels(t *testing.T) {
@@ -0,0 +1,301 @@
+// Package testing helps template authors test their Templates. A Template is
+// processed with a set of parameter values and the resulting objects are
+// checked against expectations written as JSONPath templates. The same
+// fixtures can be run from Go tests, see RunFixture, and from the command
+// line.
+package testing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/jsonpath"
+	kyaml "k8s.io/kubernetes/pkg/util/yaml"
+
+	"github.com/openshift/origin/pkg/api/v1"
+	"github.com/openshift/origin/pkg/template"
+	"github.com/openshift/origin/pkg/template/api"
+	"github.com/openshift/origin/pkg/template/generator"
+
+	_ "github.com/openshift/origin/pkg/api/install"
+)
+
+// Fixture is a set of test cases run against a Template.
+type Fixture struct {
+	// Template is the path of the file holding the Template, relative to the
+	// fixture file.
+	Template string `json:"template"`
+	// Cases are the test cases run against the Template.
+	Cases []Case `json:"cases"`
+}
+
+// Case processes a Template with a set of parameter values and checks the
+// resulting objects.
+type Case struct {
+	// Name identifies the test case.
+	Name string `json:"name"`
+	// Parameters are the parameter values the Template is processed with.
+	Parameters map[string]string `json:"parameters,omitempty"`
+	// ExpectError requires the processing of the Template to fail.
+	ExpectError bool `json:"expectError,omitempty"`
+	// Expectations are checked against the processed objects.
+	Expectations []Expectation `json:"expectations,omitempty"`
+}
+
+// Expectation requires a JSONPath template to produce a value when executed
+// against a processed object.
+type Expectation struct {
+	// Object is the kind and the name of the processed object the Path is
+	// executed against, eg. "Service/frontend". When empty, the Path is
+	// executed against a List of all the processed objects.
+	Object string `json:"object,omitempty"`
+	// Path is a JSONPath template, eg. "{.spec.replicas}". The braces may be
+	// omitted when the template is a single expression.
+	Path string `json:"path"`
+	// Value is the expected output of the Path.
+	Value string `json:"value"`
+}
+
+// Result holds the outcome of processing a Template.
+type Result struct {
+	// Template is the processed Template.
+	Template *api.Template
+	// Objects are the JSON compatible representations of the processed
+	// objects.
+	Objects []interface{}
+}
+
+// T is the subset of testing.T used to report the failures of a fixture.
+type T interface {
+	Errorf(format string, args ...interface{})
+}
+
+// NewProcessor returns a Processor with the generators of the server,
+// generating parameter values from a fixed seed so that processing the same
+// Template twice gives the same result. Hashes and keys still differ between
+// runs, since their salts and key generation are randomized anyway.
+func NewProcessor() *template.Processor {
+	seed := rand.New(rand.NewSource(1))
+	return template.NewProcessor(map[string]generator.Generator{
+		"expression": generator.NewExpressionValueGenerator(seed),
+		"charset":    generator.NewCharsetValueGenerator(seed),
+		"base64":     generator.NewBase64ValueGenerator(seed),
+		"uuid":       generator.NewUUIDValueGenerator(seed),
+		"bcrypt":     generator.NewBcryptValueGenerator(),
+		"htpasswd":   generator.NewHtpasswdValueGenerator(),
+		"privatekey": generator.NewPrivateKeyValueGenerator(seed),
+		"publickey":  generator.NewPublicKeyValueGenerator(),
+		"sshkey":     generator.NewSSHPublicKeyValueGenerator(),
+		"tlscert":    generator.NewCertificateValueGenerator(seed),
+	})
+}
+
+// LoadTemplate reads the Template stored in filename, in JSON or YAML.
+func LoadTemplate(filename string) (*api.Template, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	data, err = kyaml.ToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %v", filename, err)
+	}
+	obj, err := runtime.Decode(kapi.Codecs.UniversalDecoder(), data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode %s: %v", filename, err)
+	}
+	t, ok := obj.(*api.Template)
+	if !ok {
+		return nil, fmt.Errorf("%s does not hold a template", filename)
+	}
+	return t, nil
+}
+
+// LoadFixture reads the Fixture stored in filename, in JSON or YAML. The path
+// of its Template is made relative to the current directory.
+func LoadFixture(filename string) (*Fixture, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	fixture := &Fixture{}
+	if err := yaml.Unmarshal(data, fixture); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %v", filename, err)
+	}
+	if len(fixture.Template) == 0 {
+		return nil, fmt.Errorf("%s does not reference a template", filename)
+	}
+	if !filepath.IsAbs(fixture.Template) {
+		fixture.Template = filepath.Join(filepath.Dir(filename), fixture.Template)
+	}
+	return fixture, nil
+}
+
+// Process processes a copy of t with the given parameter values using
+// processor, or NewProcessor when processor is nil. Every parameter value
+// must set a parameter declared by t.
+func Process(processor *template.Processor, t *api.Template, parameters map[string]string) (*Result, error) {
+	copied, err := kapi.Scheme.DeepCopy(t)
+	if err != nil {
+		return nil, err
+	}
+	t = copied.(*api.Template)
+	for name, value := range parameters {
+		param := template.GetParameterByName(t, name)
+		if param == nil {
+			return nil, fmt.Errorf("unknown parameter %q", name)
+		}
+		param.Value = value
+		param.Generate = ""
+	}
+
+	if processor == nil {
+		processor = NewProcessor()
+	}
+	if errs := processor.Process(t); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	result := &Result{Template: t}
+	for _, obj := range t.Objects {
+		generic, err := genericObject(obj)
+		if err != nil {
+			return nil, err
+		}
+		result.Objects = append(result.Objects, generic)
+	}
+	return result, nil
+}
+
+// Find returns the processed object of the given kind and name, written as
+// "kind/name". Kinds are not case sensitive.
+func (r *Result) Find(object string) (interface{}, error) {
+	parts := strings.SplitN(object, "/", 2)
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return nil, fmt.Errorf("the object %q must be written as kind/name", object)
+	}
+	for _, obj := range r.Objects {
+		m, ok := obj.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		kind, _ := m["kind"].(string)
+		metadata, _ := m["metadata"].(map[string]interface{})
+		name, _ := metadata["name"].(string)
+		if strings.EqualFold(kind, parts[0]) && name == parts[1] {
+			return obj, nil
+		}
+	}
+	return nil, fmt.Errorf("no object %s was produced", object)
+}
+
+// Evaluate executes the JSONPath template path against the given processed
+// object, see Expectation, and returns its output.
+func (r *Result) Evaluate(object, path string) (string, error) {
+	var data interface{} = map[string]interface{}{"kind": "List", "items": r.Objects}
+	if len(object) > 0 {
+		var err error
+		if data, err = r.Find(object); err != nil {
+			return "", err
+		}
+	}
+	if !strings.Contains(path, "{") {
+		path = "{" + path + "}"
+	}
+	parser := jsonpath.New("expectation")
+	if err := parser.Parse(path); err != nil {
+		return "", fmt.Errorf("unable to parse %s: %v", path, err)
+	}
+	buf := &bytes.Buffer{}
+	if err := parser.Execute(buf, data); err != nil {
+		return "", fmt.Errorf("unable to execute %s: %v", path, err)
+	}
+	return buf.String(), nil
+}
+
+// Check returns an error if the processed objects do not meet e.
+func (r *Result) Check(e Expectation) error {
+	value, err := r.Evaluate(e.Object, e.Path)
+	if err != nil {
+		return err
+	}
+	if value != e.Value {
+		if len(e.Object) > 0 {
+			return fmt.Errorf("expected %s of %s to be %q, got %q", e.Path, e.Object, e.Value, value)
+		}
+		return fmt.Errorf("expected %s to be %q, got %q", e.Path, e.Value, value)
+	}
+	return nil
+}
+
+// Run processes t as described by the Case and returns the failed
+// expectations.
+func (c Case) Run(processor *template.Processor, t *api.Template) []error {
+	result, err := Process(processor, t, c.Parameters)
+	switch {
+	case c.ExpectError && err == nil:
+		return []error{fmt.Errorf("expected the template processing to fail")}
+	case c.ExpectError:
+		return nil
+	case err != nil:
+		return []error{fmt.Errorf("unable to process the template: %v", err)}
+	}
+	errs := []error{}
+	for _, e := range c.Expectations {
+		if err := result.Check(e); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// RunFixture runs every Case of the Fixture stored in filename and reports
+// their failures to t.
+func RunFixture(t T, filename string) {
+	fixture, err := LoadFixture(filename)
+	if err != nil {
+		t.Errorf("%v", err)
+		return
+	}
+	tpl, err := LoadTemplate(fixture.Template)
+	if err != nil {
+		t.Errorf("%v", err)
+		return
+	}
+	for _, c := range fixture.Cases {
+		for _, err := range c.Run(nil, tpl) {
+			t.Errorf("%s: %s: %v", filename, c.Name, err)
+		}
+	}
+}
+
+// genericObject returns the JSON compatible representation of a processed
+// object, encoded in the v1 version when its kind is known.
+func genericObject(obj runtime.Object) (interface{}, error) {
+	var data []byte
+	var err error
+	switch t := obj.(type) {
+	case *runtime.Unknown:
+		data = t.RawJSON
+	case *runtime.Unstructured:
+		data, err = runtime.Encode(runtime.UnstructuredJSONScheme, t)
+	default:
+		data, err = runtime.Encode(kapi.Codecs.LegacyCodec(v1.SchemeGroupVersion), obj)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
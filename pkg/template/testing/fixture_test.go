@@ -0,0 +1,172 @@
+package testing
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	gotesting "testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+type recorder struct {
+	errors []string
+}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestRunFixture(t *gotesting.T) {
+	r := &recorder{}
+	RunFixture(r, "../../../test/templates/fixtures/guestbook-test.yaml")
+	if len(r.errors) > 0 {
+		t.Errorf("unexpected failures: %v", r.errors)
+	}
+}
+
+func TestRunFixtureFailures(t *gotesting.T) {
+	dir, err := ioutil.TempDir("", "template-test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	template, err := ioutil.ReadFile("../../../test/templates/fixtures/guestbook.json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "guestbook.json"), template, 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fixture := `
+template: guestbook.json
+cases:
+- name: wrong value
+  expectations:
+  - object: Service/redis-master
+    path: .spec.ports[0].port
+    value: "1"
+- name: missing object
+  expectations:
+  - object: Service/missing
+    path: .metadata.name
+    value: missing
+- name: unexpected success
+  expectError: true
+`
+	filename := filepath.Join(dir, "fixture.yaml")
+	if err := ioutil.WriteFile(filename, []byte(fixture), 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	r := &recorder{}
+	RunFixture(r, filename)
+	if len(r.errors) != 3 {
+		t.Fatalf("expected 3 failures, got %v", r.errors)
+	}
+	for i, expected := range []string{
+		`wrong value: expected .spec.ports[0].port of Service/redis-master to be "1", got "10000"`,
+		"missing object: no object Service/missing was produced",
+		"unexpected success: expected the template processing to fail",
+	} {
+		if !strings.Contains(r.errors[i], expected) {
+			t.Errorf("expected failure %q, got %q", expected, r.errors[i])
+		}
+	}
+}
+
+func TestProcess(t *gotesting.T) {
+	template := &api.Template{
+		Parameters: []api.Parameter{
+			{Name: "NAME", Value: "frontend"},
+			{Name: "PASSWORD", Generate: "expression", From: "[a-z]{8}"},
+		},
+		Objects: []runtime.Object{
+			&runtime.Unknown{RawJSON: []byte(`{"kind":"Service","apiVersion":"v1","metadata":{"name":"${NAME}"},"spec":{"ports":[{"port":80}]}}`)},
+			&kapi.ConfigMap{ObjectMeta: kapi.ObjectMeta{Name: "${NAME}"}, Data: map[string]string{"password": "${PASSWORD}"}},
+		},
+	}
+
+	result, err := Process(nil, template, map[string]string{"NAME": "web"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if template.Parameters[0].Value != "frontend" {
+		t.Errorf("the template must not be modified: %#v", template.Parameters)
+	}
+	for _, test := range []Expectation{
+		{Path: "{.items[*].metadata.name}", Value: "web web"},
+		{Object: "service/web", Path: "{.spec.ports[0].port}", Value: "80"},
+		{Object: "ConfigMap/web", Path: "{.apiVersion}", Value: "v1"},
+	} {
+		if err := result.Check(test); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+
+	// generated values are reproducible
+	password, err := result.Evaluate("ConfigMap/web", "{.data.password}")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	other, err := Process(nil, template, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := other.Check(Expectation{Object: "ConfigMap/frontend", Path: "{.data.password}", Value: password}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if _, err := Process(nil, template, map[string]string{"UNKNOWN": "value"}); err == nil || !strings.Contains(err.Error(), `unknown parameter "UNKNOWN"`) {
+		t.Errorf("expected an unknown parameter error, got %v", err)
+	}
+}
+
+func TestProcessGenerators(t *gotesting.T) {
+	template := &api.Template{
+		Parameters: []api.Parameter{
+			{Name: "PASSWORD", Value: "secret"},
+			{Name: "HASH", Generate: "bcrypt", From: "${PASSWORD}"},
+			{Name: "HTPASSWD", Generate: "htpasswd", From: "admin:${PASSWORD}"},
+			{Name: "KEY", Generate: "privatekey", From: "ecdsa"},
+			{Name: "PUBLIC_KEY", Generate: "publickey", From: "${KEY}"},
+			{Name: "SSH_KEY", Generate: "sshkey", From: "${KEY}"},
+			{Name: "CERT", Generate: "tlscert", From: "www.example.com ${KEY}"},
+		},
+		Objects: []runtime.Object{
+			&kapi.ConfigMap{ObjectMeta: kapi.ObjectMeta{Name: "keys"}, Data: map[string]string{
+				"hash":      "${HASH}",
+				"htpasswd":  "${HTPASSWD}",
+				"publicKey": "${PUBLIC_KEY}",
+				"sshKey":    "${SSH_KEY}",
+				"cert":      "${CERT}",
+			}},
+		},
+	}
+
+	result, err := Process(nil, template, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for path, prefix := range map[string]string{
+		"{.data.hash}":      "$2a$",
+		"{.data.htpasswd}":  "admin:$2y$",
+		"{.data.publicKey}": "-----BEGIN PUBLIC KEY-----",
+		"{.data.sshKey}":    "ecdsa-sha2-nistp256 ",
+		"{.data.cert}":      "-----BEGIN CERTIFICATE-----",
+	} {
+		value, err := result.Evaluate("ConfigMap/keys", path)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.HasPrefix(value, prefix) {
+			t.Errorf("expected %s to start with %q, got %q", path, prefix, value)
+		}
+	}
+}
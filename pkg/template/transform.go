@@ -0,0 +1,97 @@
+package template
+
+import (
+	"fmt"
+
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	"github.com/openshift/origin/pkg/template/api"
+	"github.com/openshift/origin/pkg/util"
+)
+
+// ObjectContext describes the Template object being transformed.
+type ObjectContext struct {
+	// Processor is the Processor processing the Template
+	Processor *Processor
+	// Template is the Template being processed
+	Template *api.Template
+	// Parameters are the parameters of the object, they include the
+	// api.CountIndexParameter of the objects replicated through the
+	// api.CountAnnotation
+	Parameters []api.Parameter
+	// Path is the path of the object in the Template, used to report errors
+	Path *field.Path
+}
+
+// ObjectTransformer transforms the objects of a Template during processing.
+type ObjectTransformer interface {
+	// TransformObject returns the transformed object. The returned errors do
+	// not stop processing, the returned object is passed to the next
+	// transformer.
+	TransformObject(ctx ObjectContext, obj runtime.Object) (runtime.Object, field.ErrorList)
+}
+
+// ObjectTransformerFunc is a function that implements ObjectTransformer.
+type ObjectTransformerFunc func(ctx ObjectContext, obj runtime.Object) (runtime.Object, field.ErrorList)
+
+// TransformObject implements ObjectTransformer
+func (f ObjectTransformerFunc) TransformObject(ctx ObjectContext, obj runtime.Object) (runtime.Object, field.ErrorList) {
+	return f(ctx, obj)
+}
+
+var (
+	// ParameterSubstituter substitutes the parameters into the object, see
+	// Processor.SubstituteParameters. References to parameters that are not
+	// declared in the Template are reported as errors.
+	ParameterSubstituter ObjectTransformer = ObjectTransformerFunc(substituteParameters)
+
+	// NamespaceStripper removes the namespace of the object. All objects
+	// created during instantiation are placed into the target namespace, so
+	// it would be invalid for an object to declare a different namespace.
+	NamespaceStripper ObjectTransformer = ObjectTransformerFunc(func(ctx ObjectContext, obj runtime.Object) (runtime.Object, field.ErrorList) {
+		stripNamespace(obj)
+		return obj, nil
+	})
+
+	// LabelApplier adds the ObjectLabels of the Template to the object.
+	LabelApplier ObjectTransformer = ObjectTransformerFunc(func(ctx ObjectContext, obj runtime.Object) (runtime.Object, field.ErrorList) {
+		if err := util.AddObjectLabels(obj, ctx.Template.ObjectLabels); err != nil {
+			return obj, field.ErrorList{field.Invalid(ctx.Path.Child("labels"), err, "label could not be applied")}
+		}
+		return obj, nil
+	})
+
+	// AnnotationApplier adds the ObjectAnnotations of the Template to the
+	// object.
+	AnnotationApplier ObjectTransformer = ObjectTransformerFunc(func(ctx ObjectContext, obj runtime.Object) (runtime.Object, field.ErrorList) {
+		if err := util.AddObjectAnnotations(obj, ctx.Template.ObjectAnnotations); err != nil {
+			return obj, field.ErrorList{field.Invalid(ctx.Path.Child("annotations"), err, "annotation could not be applied")}
+		}
+		return obj, nil
+	})
+)
+
+// DefaultTransformers returns the transformers used by a Processor without
+// Transformers. Programs embedding the Processor can add their own
+// transformers to them.
+func DefaultTransformers() []ObjectTransformer {
+	return []ObjectTransformer{ParameterSubstituter, NamespaceStripper, LabelApplier, AnnotationApplier}
+}
+
+func substituteParameters(ctx ObjectContext, obj runtime.Object) (runtime.Object, field.ErrorList) {
+	errs := field.ErrorList{}
+	for _, name := range ctx.Processor.undeclaredParameterReferences(ctx.Parameters, obj) {
+		errs = append(errs, field.Invalid(ctx.Path, fmt.Sprintf("${%s}", name), "references a parameter that is not declared in the template"))
+	}
+	obj, err := ctx.Processor.SubstituteParameters(ctx.Parameters, obj)
+	if err != nil {
+		// only the name of the parameter is reported, its value may be a secret
+		var name string
+		if substitutionErr, ok := err.(*substitutionError); ok {
+			name = substitutionErr.parameter
+		}
+		errs = append(errs, field.Invalid(ctx.Path.Child("parameters"), name, err.Error()))
+	}
+	return obj, errs
+}
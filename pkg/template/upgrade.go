@@ -0,0 +1,128 @@
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/evanphx/json-patch"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+// UpgradePlan lists the changes that upgrade the objects instantiated from a
+// version of a Template to another version of that Template.
+type UpgradePlan struct {
+	// FromVersion and ToVersion are the values of the
+	// api.TemplateVersionAnnotation of the two versions.
+	FromVersion string `json:"fromVersion,omitempty"`
+	ToVersion   string `json:"toVersion,omitempty"`
+	// Create holds the objects only defined by the new version.
+	Create []interface{} `json:"create,omitempty"`
+	// Patch holds the changes made by the new version to the objects defined
+	// by both versions.
+	Patch []ObjectPatch `json:"patch,omitempty"`
+	// Delete lists the objects, in the "kind/name" form, that are no longer
+	// defined by the new version.
+	Delete []string `json:"delete,omitempty"`
+}
+
+// ObjectPatch is a JSON merge patch (RFC 7386) of an object. It only holds
+// the fields changed between the two versions of the Template, so applying it
+// preserves the fields of the object modified since its creation.
+type ObjectPatch struct {
+	// Object is the patched object in the "kind/name" form.
+	Object string      `json:"object"`
+	Patch  interface{} `json:"patch"`
+}
+
+// legacyGroupVersion is the version the objects are compared in.
+var legacyGroupVersion = unversioned.GroupVersion{Version: "v1"}
+
+// PlanUpgrade compares the objects of two processed versions of a Template,
+// matched by kind and name, and returns the changes that turn the objects of
+// the previous version into the ones of the next version. Both versions
+// should be processed with the same parameter values so that only the
+// changes made to the Template itself are planned.
+func PlanUpgrade(previous, next *api.Template) (*UpgradePlan, error) {
+	plan := &UpgradePlan{
+		FromVersion: previous.Annotations[api.TemplateVersionAnnotation],
+		ToVersion:   next.Annotations[api.TemplateVersionAnnotation],
+	}
+
+	previousObjects := map[string][]byte{}
+	for _, obj := range previous.Objects {
+		ref, data, err := upgradeDocument(obj)
+		if err != nil {
+			return nil, err
+		}
+		previousObjects[ref] = data
+	}
+
+	defined := sets.NewString()
+	for _, obj := range next.Objects {
+		ref, data, err := upgradeDocument(obj)
+		if err != nil {
+			return nil, err
+		}
+		defined.Insert(ref)
+		original, ok := previousObjects[ref]
+		if !ok {
+			var created interface{}
+			if err := json.Unmarshal(data, &created); err != nil {
+				return nil, err
+			}
+			plan.Create = append(plan.Create, created)
+			continue
+		}
+		patchData, err := jsonpatch.CreateMergePatch(original, data)
+		if err != nil {
+			return nil, fmt.Errorf("unable to compare the versions of %s: %v", ref, err)
+		}
+		patch := map[string]interface{}{}
+		if err := json.Unmarshal(patchData, &patch); err != nil {
+			return nil, err
+		}
+		if len(patch) > 0 {
+			plan.Patch = append(plan.Patch, ObjectPatch{Object: ref, Patch: patch})
+		}
+	}
+
+	for _, obj := range previous.Objects {
+		ref, _, err := upgradeDocument(obj)
+		if err != nil {
+			return nil, err
+		}
+		if !defined.Has(ref) {
+			plan.Delete = append(plan.Delete, ref)
+		}
+	}
+	return plan, nil
+}
+
+// upgradeDocument returns the "kind/name" and the JSON document of obj.
+func upgradeDocument(obj runtime.Object) (string, []byte, error) {
+	if unknown, ok := obj.(*runtime.Unknown); ok {
+		decoded, err := runtime.Decode(runtime.UnstructuredJSONScheme, unknown.RawJSON)
+		if err != nil {
+			return "", nil, err
+		}
+		obj = decoded
+	}
+	var (
+		data []byte
+		err  error
+	)
+	if _, ok := obj.(*runtime.Unstructured); ok {
+		data, err = runtime.Encode(runtime.UnstructuredJSONScheme, obj)
+	} else {
+		data, err = runtime.Encode(kapi.Codecs.LegacyCodec(legacyGroupVersion), obj)
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	return objectReference(obj), data, nil
+}
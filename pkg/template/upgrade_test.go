@@ -0,0 +1,64 @@
+package template
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+func TestPlanUpgrade(t *testing.T) {
+	service := func(name string, port int) *kapi.Service {
+		return &kapi.Service{
+			ObjectMeta: kapi.ObjectMeta{Name: name, Labels: map[string]string{"app": "example"}},
+			Spec:       kapi.ServiceSpec{Ports: []kapi.ServicePort{{Port: port}}},
+		}
+	}
+	previous := &api.Template{
+		ObjectMeta: kapi.ObjectMeta{Annotations: map[string]string{api.TemplateVersionAnnotation: "1.0"}},
+		Objects: []runtime.Object{
+			service("web", 8080),
+			service("cache", 6379),
+			&kapi.Secret{ObjectMeta: kapi.ObjectMeta{Name: "credentials"}},
+		},
+	}
+	next := &api.Template{
+		ObjectMeta: kapi.ObjectMeta{Annotations: map[string]string{api.TemplateVersionAnnotation: "1.1"}},
+		Objects: []runtime.Object{
+			service("web", 8081),
+			&kapi.Secret{ObjectMeta: kapi.ObjectMeta{Name: "credentials"}},
+			service("db", 5432),
+		},
+	}
+
+	plan, err := PlanUpgrade(previous, next)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if plan.FromVersion != "1.0" || plan.ToVersion != "1.1" {
+		t.Errorf("unexpected versions: %s -> %s", plan.FromVersion, plan.ToVersion)
+	}
+	if !reflect.DeepEqual(plan.Delete, []string{"Service/cache"}) {
+		t.Errorf("unexpected deletions: %v", plan.Delete)
+	}
+	if len(plan.Create) != 1 {
+		t.Fatalf("expected one object to be created, got %v", plan.Create)
+	}
+	if created := plan.Create[0].(map[string]interface{}); created["kind"] != "Service" || created["metadata"].(map[string]interface{})["name"] != "db" {
+		t.Errorf("unexpected created object: %v", created)
+	}
+	if len(plan.Patch) != 1 || plan.Patch[0].Object != "Service/web" {
+		t.Fatalf("expected only the web service to be patched, got %#v", plan.Patch)
+	}
+	patch, err := json.Marshal(plan.Patch[0].Patch)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := `{"spec":{"ports":[{"port":8081,"targetPort":0}]}}`; string(patch) != expected {
+		t.Errorf("expected patch %s, got %s", expected, patch)
+	}
+}
@@ -0,0 +1,130 @@
+package template
+
+import (
+	"fmt"
+	"reflect"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/meta"
+	kvalidation "k8s.io/kubernetes/pkg/api/validation"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	"github.com/openshift/origin/pkg/api/validation"
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+// kubeValidators validate the Kubernetes kinds commonly created by templates.
+// The OpenShift kinds are validated by the validators registered in
+// validation.Validator.
+var kubeValidators = map[reflect.Type]func(runtime.Object) field.ErrorList{
+	reflect.TypeOf(&kapi.Pod{}): func(obj runtime.Object) field.ErrorList {
+		return kvalidation.ValidatePod(obj.(*kapi.Pod))
+	},
+	reflect.TypeOf(&kapi.Service{}): func(obj runtime.Object) field.ErrorList {
+		return kvalidation.ValidateService(obj.(*kapi.Service))
+	},
+	reflect.TypeOf(&kapi.ReplicationController{}): func(obj runtime.Object) field.ErrorList {
+		return kvalidation.ValidateReplicationController(obj.(*kapi.ReplicationController))
+	},
+	reflect.TypeOf(&kapi.Secret{}): func(obj runtime.Object) field.ErrorList {
+		return kvalidation.ValidateSecret(obj.(*kapi.Secret))
+	},
+	reflect.TypeOf(&kapi.ServiceAccount{}): func(obj runtime.Object) field.ErrorList {
+		return kvalidation.ValidateServiceAccount(obj.(*kapi.ServiceAccount))
+	},
+	reflect.TypeOf(&kapi.PersistentVolumeClaim{}): func(obj runtime.Object) field.ErrorList {
+		return kvalidation.ValidatePersistentVolumeClaim(obj.(*kapi.PersistentVolumeClaim))
+	},
+	reflect.TypeOf(&kapi.ConfigMap{}): func(obj runtime.Object) field.ErrorList {
+		return kvalidation.ValidateConfigMap(obj.(*kapi.ConfigMap))
+	},
+	reflect.TypeOf(&kapi.Endpoints{}): func(obj runtime.Object) field.ErrorList {
+		return kvalidation.ValidateEndpoints(obj.(*kapi.Endpoints))
+	},
+	reflect.TypeOf(&kapi.LimitRange{}): func(obj runtime.Object) field.ErrorList {
+		return kvalidation.ValidateLimitRange(obj.(*kapi.LimitRange))
+	},
+	reflect.TypeOf(&kapi.ResourceQuota{}): func(obj runtime.Object) field.ErrorList {
+		return kvalidation.ValidateResourceQuota(obj.(*kapi.ResourceQuota))
+	},
+}
+
+// ValidateObjects decodes every object of the Template and validates the
+// objects of known kinds as the server would when they are created, so that
+// broken objects are reported before any of them is created. Objects of
+// unknown kinds are not validated. The objects are validated as they are,
+// parameter expressions are rarely valid values, so a Template should be
+// processed before its objects are validated. Objects without a namespace
+// are validated as if they were created in the namespace of the Template.
+// The returned errors are relative to the objects of the Template.
+func ValidateObjects(t *api.Template) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, item := range t.Objects {
+		objPath := field.NewPath("objects").Index(i)
+
+		var data []byte
+		switch obj := item.(type) {
+		case *runtime.Unknown:
+			data = obj.RawJSON
+		case *runtime.Unstructured:
+			encoded, err := runtime.Encode(runtime.UnstructuredJSONScheme, obj)
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(objPath, obj.Kind, fmt.Sprintf("unable to encode the object: %v", err)))
+				continue
+			}
+			data = encoded
+		}
+		obj := item
+		if data != nil {
+			decoded, err := runtime.Decode(kapi.Codecs.UniversalDecoder(), data)
+			if err != nil {
+				if runtime.IsNotRegisteredError(err) {
+					continue
+				}
+				allErrs = append(allErrs, field.Invalid(objPath, string(data), fmt.Sprintf("unable to decode the object: %v", err)))
+				continue
+			}
+			obj = decoded
+		} else {
+			// the object is validated in place, work on a copy
+			copied, err := kapi.Scheme.DeepCopy(obj)
+			if err != nil {
+				allErrs = append(allErrs, field.InternalError(objPath, err))
+				continue
+			}
+			obj = copied.(runtime.Object)
+		}
+
+		for _, err := range validateObject(obj, t.Namespace) {
+			err.Field = objPath.String() + "." + err.Field
+			allErrs = append(allErrs, err)
+		}
+	}
+	return allErrs
+}
+
+// validateObject validates the object if its kind is known.
+func validateObject(obj runtime.Object, namespace string) field.ErrorList {
+	if len(namespace) == 0 {
+		namespace = kapi.NamespaceDefault
+	}
+	if validate, ok := kubeValidators[reflect.TypeOf(obj)]; ok {
+		setDefaultNamespace(obj, namespace)
+		return validate(obj)
+	}
+	info, ok := validation.Validator.GetInfo(obj)
+	if !ok {
+		return nil
+	}
+	if info.IsNamespaced {
+		setDefaultNamespace(obj, namespace)
+	}
+	return validation.Validator.Validate(obj)
+}
+
+func setDefaultNamespace(obj runtime.Object, namespace string) {
+	if accessor, err := meta.Accessor(obj); err == nil && len(accessor.GetNamespace()) == 0 {
+		accessor.SetNamespace(namespace)
+	}
+}
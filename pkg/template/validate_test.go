@@ -0,0 +1,49 @@
+package template
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+func TestValidateObjects(t *testing.T) {
+	template := &api.Template{
+		Objects: []runtime.Object{
+			// valid
+			&runtime.Unknown{RawJSON: []byte(`{"kind":"Service","apiVersion":"v1","metadata":{"name":"frontend"},"spec":{"ports":[{"port":80}],"selector":{"name":"frontend"}}}`)},
+			// invalid name and missing ports
+			&runtime.Unknown{RawJSON: []byte(`{"kind":"Service","apiVersion":"v1","metadata":{"name":"Frontend"},"spec":{"selector":{"name":"frontend"}}}`)},
+			// unknown kind
+			&runtime.Unknown{RawJSON: []byte(`{"kind":"Unknown","apiVersion":"v1","metadata":{"name":"frontend"}}`)},
+			// origin kind without a host
+			&runtime.Unknown{RawJSON: []byte(`{"kind":"Route","apiVersion":"v1","metadata":{"name":"frontend"},"spec":{"to":{"kind":"Service","name":""}}}`)},
+			// not decodable
+			&runtime.Unknown{RawJSON: []byte(`{"kind":"Service","apiVersion":"v1","spec":{"ports":"80"}}`)},
+			// typed object
+			&kapi.Secret{ObjectMeta: kapi.ObjectMeta{Name: "secret_name"}},
+		},
+	}
+
+	errs := ValidateObjects(template)
+	fields := []string{}
+	for _, err := range errs {
+		fields = append(fields, err.Field)
+	}
+	expected := []string{
+		"objects[1].metadata.name",
+		"objects[1].spec.ports",
+		"objects[3].spec.to.name",
+		"objects[4]",
+		"objects[5].metadata.name",
+	}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("expected errors for %v, got %v", expected, errs)
+	}
+	if template.Objects[5].(*kapi.Secret).Namespace != "" {
+		t.Errorf("expected the template objects to be left untouched")
+	}
+}
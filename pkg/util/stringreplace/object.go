@@ -1,67 +1,168 @@
 package stringreplace
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/golang/glog"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+var (
+	unknownType      = reflect.TypeOf(runtime.Unknown{})
+	rawExtensionType = reflect.TypeOf(runtime.RawExtension{})
+	jsonNumberType   = reflect.TypeOf(json.Number(""))
 )
 
 // VisitObjectStrings visits recursively all string fields in the object and call the
 // visitor function on them. The visitor function can be used to modify the
-// value of the string fields.
+// value of the string fields. String map keys are visited as well, so a visitor
+// may also rename the keys of the maps it encounters. The JSON held by
+// runtime.Unknown and runtime.RawExtension values, eg. objects of kinds that
+// are not registered, is visited as well, all its other fields are preserved.
 func VisitObjectStrings(obj interface{}, visitor func(string) string) {
-	visitValue(reflect.ValueOf(obj), visitor)
+	// the visitor always produces strings, so the only possible error is a
+	// renamed map key colliding with another key, in which case the map is
+	// left untouched
+	VisitObjectValues(obj, func(in string) (string, bool) {
+		return visitor(in), true
+	})
+}
+
+// VisitObjectValues behaves like VisitObjectStrings, but the visitor function
+// additionally reports whether its result must be kept as a string. When it
+// returns false the result is decoded as a JSON value (number, boolean, ...)
+// and stored instead of the string. An error is returned if such a value
+// cannot be stored in the field being visited, for example in a string
+// field of a structured object, or if a renamed map key collides with another
+// key of its map.
+func VisitObjectValues(obj interface{}, visitor func(string) (string, bool)) error {
+	return VisitObjectPaths(obj, func(path, in string) (string, bool) {
+		return visitor(in)
+	})
 }
 
-func visitValue(v reflect.Value, visitor func(string) string) {
+// VisitObjectPaths behaves like VisitObjectValues, but the visitor function
+// also receives the path of the value being visited. Paths are made of the
+// JSON names of the struct fields (or the field names if they have none) and
+// of the map keys separated by dots, slice elements are identified by their
+// index, eg. "spec.template.spec.containers[0].env[1].value". The path of a
+// map key is the path of its entry.
+func VisitObjectPaths(obj interface{}, visitor func(path, in string) (string, bool)) error {
+	return visitValue(reflect.ValueOf(obj), "", visitor)
+}
+
+func visitValue(v reflect.Value, path string, visitor func(string, string) (string, bool)) error {
 	// you'll never be able to substitute on a nil.  Check the kind first or you'll accidentally
 	// end up panic-ing
 	switch v.Kind() {
 	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
 		if v.IsNil() {
-			return
+			return nil
 		}
 	}
 
 	switch v.Kind() {
 
 	case reflect.Ptr:
-		visitValue(v.Elem(), visitor)
+		return visitValue(v.Elem(), path, visitor)
 	case reflect.Interface:
-		visitValue(reflect.ValueOf(v.Interface()), visitor)
+		return visitValue(reflect.ValueOf(v.Interface()), path, visitor)
 
 	case reflect.Slice, reflect.Array:
 		vt := v.Type().Elem()
 		for i := 0; i < v.Len(); i++ {
-			val := visitUnsettableValues(vt, v.Index(i), visitor)
+			val, err := visitUnsettableValues(vt, v.Index(i), fmt.Sprintf("%s[%d]", path, i), visitor)
+			if err != nil {
+				return err
+			}
 			v.Index(i).Set(val)
 		}
 	case reflect.Struct:
+		raw := v.Type() == unknownType || v.Type() == rawExtensionType
+		if raw {
+			if err := visitRawJSON(v.FieldByName("RawJSON"), path, visitor); err != nil {
+				return err
+			}
+		}
 		for i := 0; i < v.NumField(); i++ {
-			visitValue(v.Field(i), visitor)
+			if raw && v.Type().Field(i).Name == "RawJSON" {
+				continue
+			}
+			if err := visitValue(v.Field(i), fieldPath(path, v.Type().Field(i)), visitor); err != nil {
+				return err
+			}
 		}
 
 	case reflect.Map:
 		vt := v.Type().Elem()
-		for _, k := range v.MapKeys() {
-			val := visitUnsettableValues(vt, v.MapIndex(k), visitor)
-			v.SetMapIndex(k, val)
+		keys := v.MapKeys()
+		newKeys := make([]reflect.Value, len(keys))
+		values := make([]reflect.Value, len(keys))
+		// the original key of every key of the visited map, to detect renamed
+		// keys colliding with each other or with the other keys
+		originalKeys := map[string]string{}
+		for i, k := range keys {
+			keyPath := path
+			if k.Kind() == reflect.String {
+				keyPath = childPath(path, k.String())
+			}
+			val, err := visitUnsettableValues(vt, v.MapIndex(k), keyPath, visitor)
+			if err != nil {
+				return err
+			}
+			values[i] = val
+			newKeys[i] = k
+			if k.Kind() != reflect.String {
+				continue
+			}
+			// map keys are always strings, regardless of what the visitor asks for
+			newKey, _ := visitor(keyPath, k.String())
+			if other, exists := originalKeys[newKey]; exists {
+				first, second := other, k.String()
+				if first > second {
+					first, second = second, first
+				}
+				return fmt.Errorf("the map keys %q and %q at %q would both become %q", first, second, path, newKey)
+			}
+			originalKeys[newKey] = k.String()
+			if newKey != k.String() {
+				newKeys[i] = reflect.ValueOf(newKey).Convert(k.Type())
+			}
+		}
+		// the renamed keys are removed before any value is stored, so that no
+		// value is stored under a key which is about to be removed
+		for i, k := range keys {
+			if newKeys[i].Interface() != k.Interface() {
+				v.SetMapIndex(k, reflect.Value{})
+			}
+		}
+		for i := range keys {
+			v.SetMapIndex(newKeys[i], values[i])
 		}
 
 	case reflect.String:
 		if !v.CanSet() {
 			glog.Infof("Unable to set String value '%v'", v)
-			return
+			return nil
+		}
+		s, asString := visitor(path, v.String())
+		if !asString {
+			return fmt.Errorf("unable to substitute the non-string value %q into a string field", s)
 		}
-		v.SetString(visitor(v.String()))
+		v.SetString(s)
 
 	default:
 		glog.V(5).Infof("Unknown field type '%s': %v", v.Kind(), v)
 	}
+	return nil
 }
 
 // visitUnsettableValues creates a copy of the object you want to modify and returns the modified result
-func visitUnsettableValues(typeOf reflect.Type, original reflect.Value, visitor func(string) string) reflect.Value {
+func visitUnsettableValues(typeOf reflect.Type, original reflect.Value, path string, visitor func(string, string) (string, bool)) (reflect.Value, error) {
 	val := reflect.New(typeOf).Elem()
 	existing := original
 	// if the value type is interface, we must resolve it to a concrete value prior to setting it back.
@@ -70,14 +171,88 @@ func visitUnsettableValues(typeOf reflect.Type, original reflect.Value, visitor
 	}
 	switch existing.Kind() {
 	case reflect.String:
-		s := visitor(existing.String())
-		val.Set(reflect.ValueOf(s))
+		if existing.Type() == jsonNumberType {
+			// numbers decoded from raw JSON are kept as is
+			val.Set(existing)
+			break
+		}
+		s, asString := visitor(path, existing.String())
+		if asString {
+			val.Set(reflect.ValueOf(s))
+			break
+		}
+		var data interface{}
+		if err := json.Unmarshal([]byte(s), &data); err != nil || data == nil {
+			// the value is not a valid JSON literal (e.g. an unquoted word), keep it as a string
+			val.Set(reflect.ValueOf(s))
+			break
+		}
+		decoded := reflect.ValueOf(data)
+		if !decoded.Type().AssignableTo(typeOf) {
+			return val, fmt.Errorf("unable to substitute the non-string value %q into a field of type %s", s, typeOf)
+		}
+		val.Set(decoded)
 	default:
 		if existing.IsValid() && existing.Kind() != reflect.Invalid {
 			val.Set(existing)
 		}
-		visitValue(val, visitor)
+		if err := visitValue(val, path, visitor); err != nil {
+			return val, err
+		}
 	}
 
-	return val
+	return val, nil
+}
+
+// visitRawJSON visits the strings of the JSON document held by the given
+// []byte value and stores the result back into it. Documents that are not
+// JSON are left untouched.
+func visitRawJSON(raw reflect.Value, path string, visitor func(string, string) (string, bool)) error {
+	data := raw.Bytes()
+	if len(data) == 0 {
+		return nil
+	}
+	if !raw.CanSet() {
+		glog.Infof("Unable to set raw JSON value at %q", path)
+		return nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// numbers are decoded as json.Number to preserve them exactly
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		glog.V(5).Infof("Unable to decode the raw JSON value at %q: %v", path, err)
+		return nil
+	}
+	value := reflect.ValueOf(&document).Elem()
+	visited, err := visitUnsettableValues(value.Type(), value, path, visitor)
+	if err != nil {
+		return err
+	}
+	encoded, err := json.Marshal(visited.Interface())
+	if err != nil {
+		return err
+	}
+	raw.SetBytes(encoded)
+	return nil
+}
+
+// fieldPath returns the path of the given struct field. Embedded structs and
+// fields serialized inline do not add to the path.
+func fieldPath(path string, field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if len(name) == 0 {
+		if field.Anonymous || strings.Contains(field.Tag.Get("json"), "inline") {
+			return path
+		}
+		name = strings.ToLower(field.Name[:1]) + field.Name[1:]
+	}
+	return childPath(path, name)
+}
+
+func childPath(path, name string) string {
+	if len(path) == 0 {
+		return name
+	}
+	return path + "." + name
 }
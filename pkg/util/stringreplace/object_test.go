@@ -1,9 +1,14 @@
 package stringreplace
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
+
+	"k8s.io/kubernetes/pkg/runtime"
 )
 
 type sampleInnerStruct struct {
@@ -39,7 +44,7 @@ func TestVisitObjectStringsOnStruct(t *testing.T) {
 			},
 			{
 				MapInMap: map[string]map[string]string{
-					"foo": {"bar": "sample-test"},
+					"sample-foo": {"sample-bar": "sample-test"},
 				},
 			},
 		},
@@ -49,7 +54,7 @@ func TestVisitObjectStringsOnStruct(t *testing.T) {
 		},
 		{
 			{ArrayInMap: map[string][]interface{}{"key": {"foo", "bar"}}},
-			{ArrayInMap: map[string][]interface{}{"key": {"sample-foo", "sample-bar"}}},
+			{ArrayInMap: map[string][]interface{}{"sample-key": {"sample-foo", "sample-bar"}}},
 		},
 	}
 	for i := range samples {
@@ -69,15 +74,15 @@ func TestVisitObjectStringsOnMap(t *testing.T) {
 	samples := [][]map[string]string{
 		{
 			{"foo": "bar"},
-			{"foo": "sample-bar"},
+			{"sample-foo": "sample-bar"},
 		},
 		{
 			{"empty": ""},
-			{"empty": "sample-"},
+			{"sample-empty": "sample-"},
 		},
 		{
 			{"": "invalid"},
-			{"": "sample-invalid"},
+			{"sample-": "sample-invalid"},
 		},
 	}
 
@@ -108,3 +113,150 @@ func TestVisitObjectStringsOnArray(t *testing.T) {
 		}
 	}
 }
+
+func TestVisitObjectValues(t *testing.T) {
+	samples := [][]map[string]interface{}{
+		{
+			{"int": "3", "bool": "true", "string": "foo"},
+			{"int": float64(3), "bool": true, "string": "foo"},
+		},
+		{
+			{"nested": map[string]interface{}{"list": []interface{}{"false", "1.5"}}},
+			{"nested": map[string]interface{}{"list": []interface{}{false, 1.5}}},
+		},
+	}
+
+	for i := range samples {
+		err := VisitObjectValues(&samples[i][0], func(in string) (string, bool) {
+			return in, in == "foo"
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(samples[i][0], samples[i][1]) {
+			t.Errorf("Got %#v, expected %#v", samples[i][0], samples[i][1])
+		}
+	}
+}
+
+func TestVisitObjectValuesErrors(t *testing.T) {
+	visitor := func(in string) (string, bool) {
+		return "3", false
+	}
+	if err := VisitObjectValues(&sampleStruct{Name: "foo"}, visitor); err == nil {
+		t.Errorf("expected error substituting a non-string value into a string field")
+	}
+	if err := VisitObjectValues(&[]string{"foo"}, visitor); err == nil {
+		t.Errorf("expected error substituting a non-string value into a string slice")
+	}
+}
+
+func TestVisitObjectValuesMapKeyCollision(t *testing.T) {
+	visitor := func(in string) (string, bool) {
+		return strings.Replace(in, "${A}", "foo", -1), true
+	}
+
+	// keys renamed to distinct keys are all kept
+	swapped := map[string]interface{}{"${A}-x": "1", "${A}": "2"}
+	if err := VisitObjectValues(&swapped, visitor); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := map[string]interface{}{"foo-x": "1", "foo": "2"}; !reflect.DeepEqual(swapped, expected) {
+		t.Errorf("Got %#v, expected %#v", swapped, expected)
+	}
+
+	for i := 0; i < 10; i++ {
+		obj := map[string]interface{}{"nested": map[string]interface{}{"${A}-x": "1", "foo-x": "2"}}
+		err := VisitObjectValues(&obj, visitor)
+		if err == nil || !strings.Contains(err.Error(), `"${A}-x" and "foo-x"`) {
+			t.Fatalf("expected an error naming both keys, got %v", err)
+		}
+		if expected := map[string]interface{}{"${A}-x": "1", "foo-x": "2"}; !reflect.DeepEqual(obj["nested"], expected) {
+			t.Errorf("expected the map to be left untouched, got %#v", obj["nested"])
+		}
+	}
+}
+
+func TestVisitObjectPaths(t *testing.T) {
+	type tagged struct {
+		sampleInnerStruct `json:",inline"`
+		Value             string   `json:"value,omitempty"`
+		Items             []string `json:"items"`
+	}
+	obj := &struct {
+		Meta   tagged
+		Inner  sampleInnerStruct
+		Object map[string]interface{}
+	}{
+		Meta:   tagged{sampleInnerStruct: sampleInnerStruct{Name: "a"}, Value: "b", Items: []string{"c", "d"}},
+		Inner:  sampleInnerStruct{Map: map[string]string{"key": "e"}},
+		Object: map[string]interface{}{"metadata": map[string]interface{}{"annotations": map[string]interface{}{"example.com/config": "f"}}},
+	}
+	paths := map[string]string{}
+	err := VisitObjectPaths(obj, func(path, in string) (string, bool) {
+		if len(in) > 0 {
+			paths[in] = path
+		}
+		return in, true
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]string{
+		"a":                  "meta.name",
+		"b":                  "meta.value",
+		"c":                  "meta.items[0]",
+		"d":                  "meta.items[1]",
+		"key":                "inner.map.key",
+		"e":                  "inner.map.key",
+		"metadata":           "object.metadata",
+		"annotations":        "object.metadata.annotations",
+		"example.com/config": "object.metadata.annotations.example.com/config",
+		"f":                  "object.metadata.annotations.example.com/config",
+	}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("expected %v, got %v", expected, paths)
+	}
+}
+
+func TestVisitObjectStringsOnRawJSON(t *testing.T) {
+	obj := &struct {
+		Items     []runtime.Object
+		Extension runtime.RawExtension
+		Invalid   runtime.RawExtension
+	}{
+		Items: []runtime.Object{
+			&runtime.Unknown{RawJSON: []byte(`{"kind":"Widget","spec":{"name":"foo","size":12345678901234567890,"enabled":true,"tags":["bar",null]}}`)},
+		},
+		Extension: runtime.RawExtension{RawJSON: []byte(`"foo"`)},
+		Invalid:   runtime.RawExtension{RawJSON: []byte(`kind: Widget`)},
+	}
+	VisitObjectStrings(obj, func(in string) string {
+		return "sample-" + in
+	})
+
+	expected := map[string]interface{}{
+		"sample-kind": "sample-Widget",
+		"sample-spec": map[string]interface{}{
+			"sample-name":    "sample-foo",
+			"sample-size":    json.Number("12345678901234567890"),
+			"sample-enabled": true,
+			"sample-tags":    []interface{}{"sample-bar", nil},
+		},
+	}
+	var actual map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(obj.Items[0].(*runtime.Unknown).RawJSON))
+	decoder.UseNumber()
+	if err := decoder.Decode(&actual); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+	if raw := string(obj.Extension.RawJSON); raw != `"sample-foo"` {
+		t.Errorf("expected the raw extension to be visited, got %s", raw)
+	}
+	if raw := string(obj.Invalid.RawJSON); raw != `kind: Widget` {
+		t.Errorf("expected a document that is not JSON to be left untouched, got %s", raw)
+	}
+}
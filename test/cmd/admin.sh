@@ -116,6 +116,9 @@ echo "groups: ok"
 os::cmd::expect_success 'oadm policy who-can get pods'
 os::cmd::expect_success 'oadm policy who-can get pods -n default'
 os::cmd::expect_success 'oadm policy who-can get pods --all-namespaces'
+os::cmd::expect_success_and_text 'oadm policy who-can get pods frontend --subresource=log -n default' 'Resource:  pods/log'
+os::cmd::expect_success_and_text 'oadm policy who-can get /healthz' 'Granted by:'
+os::cmd::expect_failure 'oadm policy who-can get /healthz foo'
 
 os::cmd::expect_success 'oadm policy add-role-to-group cluster-admin system:unauthenticated'
 os::cmd::expect_success 'oadm policy add-role-to-user cluster-admin system:no-user'
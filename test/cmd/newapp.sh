@@ -34,6 +34,14 @@ os::cmd::expect_success_and_not_text 'oc new-app mysql --dry-run' "runs as the '
 # trigger and output should say 5.6
 os::cmd::expect_success_and_text 'oc new-app mysql -o yaml' 'mysql:5.6'
 os::cmd::expect_success_and_text 'oc new-app mysql --dry-run' 'tag "5.6" for "mysql"'
+# dry run lists the resources without creating them
+os::cmd::expect_success_and_text 'oc new-app mysql --dry-run' 'deploymentconfig "mysql" created \(dry run\)'
+os::cmd::expect_success_and_text 'oc new-app mysql --dry-run -o name' 'service/mysql'
+os::cmd::expect_failure 'oc get dc/mysql'
+os::cmd::expect_success_and_text 'oc new-app mysql --dry-run -o yaml' 'kind: DeploymentConfig'
+# routes are generated with --expose
+os::cmd::expect_success_and_text 'oc new-app mysql --expose --hostname=mysql.example.com --tls-termination=passthrough --dry-run -o yaml' 'termination: passthrough'
+os::cmd::expect_failure_and_text 'oc new-app mysql --hostname=mysql.example.com --dry-run' 'require --expose'
 # test deployments are created with the boolean flag and printed in the UI
 os::cmd::expect_success_and_text 'oc new-app mysql --dry-run --as-test' 'This image will be test deployed'
 os::cmd::expect_success_and_text 'oc new-app mysql -o yaml --as-test' 'test: true'
@@ -140,6 +148,9 @@ os::cmd::expect_failure_and_text 'oc new-app --dry-run __template_fail __templat
 os::cmd::expect_failure_and_text 'oc new-app --dry-run __template_fail __templatefile_fail' 'error: no match for "__templatefile_fail"'
 os::cmd::expect_failure_and_text 'oc new-app --dry-run __template_fail __templatefile_fail' 'error: unable to find the specified template file'
 os::cmd::expect_failure_and_text 'oc new-app --dry-run __template_fail __templatefile_fail' "The 'oc new-app' command will match arguments"
+# multi-document YAML template files are instantiated as a whole
+os::cmd::expect_success_and_text 'oc new-app --dry-run -f test/templates/fixtures/multiple-templates.yaml -p NAME=yaml -o yaml' 'name: yaml-db'
+os::cmd::expect_success_and_text 'oc process -f test/templates/fixtures/multiple-templates.yaml -o yaml' 'name: multi-frontend'
 
 # verify partial match error
 os::cmd::expect_failure_and_text 'oc new-app --dry-run mysq' 'error: only a partial match was found for "mysq"'
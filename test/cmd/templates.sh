@@ -12,7 +12,7 @@ os::log::install_errexit
 # Cleanup cluster resources created by this test
 (
   set +e
-  oc delete all,templates --all
+  oc delete all,templates,templateinstances --all
   oc delete template/ruby-helloworld-sample -n openshift
   oc delete project test-template-project
   wait_for_command '! oc get project test-template-project'
@@ -34,6 +34,14 @@ os::cmd::expect_success 'oc get templates'
 # TODO: create directly from template
 echo "templates: ok"
 
+os::cmd::expect_success 'oc new-app -f examples/sample-app/application-template-dockerbuild.json'
+os::cmd::expect_success_and_text 'oc get templateinstances' 'ruby-helloworld-sample-'
+os::cmd::expect_success_and_text 'oc get services frontend -o yaml' 'template.openshift.io/instance: ruby-helloworld-sample-'
+os::cmd::expect_success 'oc delete templateinstances --all'
+os::cmd::try_until_failure 'oc get services frontend'
+os::cmd::try_until_failure 'oc get templateinstances -o name | grep templateinstance'
+echo "template instances: ok"
+
 os::cmd::expect_success 'oc process -f test/templates/fixtures/guestbook.json -l app=guestbook | oc create -f -'
 os::cmd::expect_success_and_text 'oc status' 'frontend-service'
 echo "template+config: ok"
@@ -47,6 +55,19 @@ os::cmd::expect_success_and_text 'oc process -f test/templates/fixtures/guestboo
 # Argument values are honored
 os::cmd::expect_success_and_text 'oc process ADMIN_USERNAME=myuser ADMIN_PASSWORD=mypassword -f test/templates/fixtures/guestbook.json'       '"myuser"'
 os::cmd::expect_success_and_text 'oc process -f test/templates/fixtures/guestbook.json ADMIN_USERNAME=myuser ADMIN_PASSWORD=mypassword'       '"mypassword"'
+# Parameter file values are honored and overridden by explicit values
+os::cmd::expect_success_and_text 'oc process -f test/templates/fixtures/guestbook.json --param-file=test/templates/fixtures/guestbook.env' '"fileuser"'
+os::cmd::expect_success_and_text 'oc process -f test/templates/fixtures/guestbook.json --param-file=test/templates/fixtures/guestbook.env -v ADMIN_USERNAME=myuser' '"myuser"'
+os::cmd::expect_success_and_text 'oc process -f test/templates/fixtures/guestbook.json --param-file=test/templates/fixtures/guestbook.env ADMIN_PASSWORD=mypassword' '"mypassword"'
+os::cmd::expect_success_and_text 'oc process -f test/templates/fixtures/guestbook.json -v ADMIN_USERNAME=myuser --report' '"source": "user"'
+os::cmd::expect_success_and_text 'oc process -f test/templates/fixtures/guestbook.json -v ADMIN_USERNAME=myuser --diff' '"myuser"'
+os::cmd::expect_success 'oc process -f test/templates/fixtures/guestbook.json --validate'
+os::cmd::expect_success_and_not_text 'oc process -f test/templates/fixtures/guestbook.json --upgrade-from=test/templates/fixtures/guestbook.json -v ADMIN_USERNAME=myuser' '"patch"'
+os::cmd::expect_success_and_text 'oc process -f test/templates/fixtures/guestbook.json -o yaml --split' '^---$'
+os::cmd::expect_success_and_not_text 'oc process -f test/templates/fixtures/guestbook.json -o yaml --split' 'kind: List'
+os::cmd::expect_success_and_text 'oc process -f test/templates/fixtures/guestbook.json ADMIN_USERNAME=myuser UNKNOWN_PARAM=value' 'unknown parameter name "UNKNOWN_PARAM"'
+os::cmd::expect_failure_and_text 'oc process -f test/templates/fixtures/guestbook.json --strict ADMIN_USERNAME=myuser UNKNOWN_PARAM=value' 'UNKNOWN_PARAM'
+os::cmd::expect_success_and_text 'openshift ex template-test test/templates/fixtures/guestbook-test.yaml' 'PASS .*: custom values'
 # Argument values with commas are honored
 os::cmd::expect_success 'oc create -f examples/sample-app/application-template-stibuild.json'
 os::cmd::expect_success_and_text 'oc process ruby-helloworld-sample MYSQL_USER=myself MYSQL_PASSWORD=my,1%pass'  '"myself"'
@@ -76,6 +97,15 @@ os::cmd::expect_success 'oc delete template/template-type-precision'
 os::cmd::expect_success 'oc delete pod/template-type-precision'
 echo "template data precision: ok"
 
+os::cmd::expect_success_and_text 'oadm sync-templates fixtures --from-dir=test/templates/fixtures/library' 'template/example-database created'
+os::cmd::expect_success_and_text 'oadm sync-templates fixtures --from-dir=test/templates/fixtures/library' 'template/example-web updated'
+os::cmd::expect_success_and_text 'oc get templates -l category.template.openshift.io/database=true' 'example-database'
+os::cmd::expect_success_and_text 'oc get templates --search=database' 'example-database'
+os::cmd::expect_success_and_not_text 'oc get templates --search=database' 'example-web'
+os::cmd::expect_failure_and_text 'oc get pods --search=database' 'can only be used to list templates'
+os::cmd::expect_success 'oc delete templates -l template.openshift.io/repository=fixtures'
+echo "template library: ok"
+
 
 os::cmd::expect_success 'oc create -f examples/sample-app/application-template-dockerbuild.json -n openshift'
 os::cmd::expect_success 'oc policy add-role-to-user admin test-user'
@@ -46,6 +46,16 @@ os::cmd::expect_success_and_text 'oc set triggers bc/ruby-hello-world --from-git
 os::cmd::expect_success_and_text 'oc set triggers bc/ruby-hello-world' 'github'
 os::cmd::expect_success_and_text 'oc set triggers bc/ruby-hello-world --remove --from-github' 'updated'
 os::cmd::expect_success_and_not_text 'oc set triggers bc/ruby-hello-world' 'github'
+# set gitlab hook
+os::cmd::expect_success_and_text 'oc set triggers bc/ruby-hello-world --from-gitlab' 'updated'
+os::cmd::expect_success_and_text 'oc set triggers bc/ruby-hello-world' 'gitlab'
+os::cmd::expect_success_and_text 'oc set triggers bc/ruby-hello-world --remove --from-gitlab' 'updated'
+os::cmd::expect_success_and_not_text 'oc set triggers bc/ruby-hello-world' 'gitlab'
+# set bitbucket hook
+os::cmd::expect_success_and_text 'oc set triggers bc/ruby-hello-world --from-bitbucket' 'updated'
+os::cmd::expect_success_and_text 'oc set triggers bc/ruby-hello-world' 'bitbucket'
+os::cmd::expect_success_and_text 'oc set triggers bc/ruby-hello-world --remove --from-bitbucket' 'updated'
+os::cmd::expect_success_and_not_text 'oc set triggers bc/ruby-hello-world' 'bitbucket'
 # set webhook
 os::cmd::expect_success_and_text 'oc set triggers bc/ruby-hello-world --from-webhook' 'updated'
 os::cmd::expect_success_and_text 'oc set triggers bc/ruby-hello-world' 'webhook'
@@ -65,10 +75,33 @@ os::cmd::expect_success_and_text 'oc set triggers bc --all' 'buildconfigs/ruby-h
 os::cmd::expect_success_and_text 'oc set triggers bc --all --auto' 'updated'
 os::cmd::expect_success_and_text 'oc set triggers bc --all' 'buildconfigs/ruby-hello-world.*image.*ruby-22-centos7:latest.*true'
 
+## Build hooks
+
+# error conditions
+os::cmd::expect_failure_and_text 'oc set build-hook bc/ruby-hello-world -- /bin/true' 'you must specify a type of hook'
+os::cmd::expect_failure_and_text 'oc set build-hook bc/ruby-hello-world --post-commit' 'you must specify either a script or command'
+os::cmd::expect_failure_and_text 'oc set build-hook bc/ruby-hello-world --post-commit --script="true" --command -- /bin/true' '--script and --command cannot be specified together'
+os::cmd::expect_failure_and_text 'oc set build-hook bc/ruby-hello-world --post-commit --remove --script="true"' '--remove may not be used'
+os::cmd::expect_failure_and_text 'oc set build-hook dc/ruby-hello-world --post-commit -- /bin/true' 'is not a build config'
+# set a command
+os::cmd::expect_success_and_text 'oc set build-hook bc/ruby-hello-world --post-commit --command -- /bin/bash -c "exit 0"' 'updated'
+os::cmd::expect_success_and_text 'oc get bc/ruby-hello-world -o jsonpath="{.spec.postCommit.command}"' '/bin/bash -c exit 0'
+# set a script
+os::cmd::expect_success_and_text 'oc set build-hook bc/ruby-hello-world --post-commit --script="echo test"' 'updated'
+os::cmd::expect_success_and_text 'oc get bc/ruby-hello-world -o jsonpath="{.spec.postCommit.script}"' 'echo test'
+os::cmd::expect_success_and_not_text 'oc get bc/ruby-hello-world -o jsonpath="{.spec.postCommit.command}"' 'bash'
+# set args
+os::cmd::expect_success_and_text 'oc set build-hook bc/ruby-hello-world --post-commit -- foo bar' 'updated'
+os::cmd::expect_success_and_text 'oc get bc/ruby-hello-world -o jsonpath="{.spec.postCommit.args}"' 'foo bar'
+# remove
+os::cmd::expect_success_and_text 'oc set build-hook bc/ruby-hello-world --post-commit --remove' 'updated'
+os::cmd::expect_success_and_not_text 'oc get bc/ruby-hello-world -o jsonpath="{.spec.postCommit}"' 'foo'
+
 ## Deployment configs
 
 # error conditions
 os::cmd::expect_failure_and_text 'oc set triggers dc/ruby-hello-world --from-github' 'deployment configs do not support GitHub web hooks'
+os::cmd::expect_failure_and_text 'oc set triggers dc/ruby-hello-world --from-gitlab' 'deployment configs do not support GitLab web hooks'
 os::cmd::expect_failure_and_text 'oc set triggers dc/ruby-hello-world --from-webhook' 'deployment configs do not support web hooks'
 os::cmd::expect_failure_and_text 'oc set triggers dc/ruby-hello-world --from-image=test:latest' 'you must specify --containers when setting --from-image'
 os::cmd::expect_failure_and_text 'oc set triggers dc/ruby-hello-world --from-image=test:latest --containers=other' 'not all container names exist: other \(accepts: ruby-hello-world\)'
@@ -72,7 +72,7 @@ func TestOAuthStorage(t *testing.T) {
 	clientRegistry := clientregistry.NewRegistry(clientStorage)
 
 	user := &testUser{UserName: "test", UserUID: "1"}
-	storage := registrystorage.New(accessTokenRegistry, authorizeTokenRegistry, clientRegistry, user)
+	storage := registrystorage.New(accessTokenRegistry, authorizeTokenRegistry, clientRegistry, user, 0, nil)
 
 	oauthServer := osinserver.New(
 		osinserver.NewDefaultServerConfig(),
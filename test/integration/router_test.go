@@ -337,7 +337,7 @@ func TestRouter(t *testing.T) {
 				Spec: routeapi.RouteSpec{
 					Host: tc.routeAlias,
 					Path: tc.routePath,
-					To: kapi.ObjectReference{
+					To: routeapi.RouteTargetReference{
 						Name: tc.serviceName,
 					},
 					TLS: tc.routeTLS,
@@ -449,7 +449,7 @@ func TestRouterPathSpecificity(t *testing.T) {
 			Spec: routeapi.RouteSpec{
 				Host: "www.example.com",
 				Path: "/test",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "myService",
 				},
 			},
@@ -492,7 +492,7 @@ func TestRouterPathSpecificity(t *testing.T) {
 			Spec: routeapi.RouteSpec{
 				Host: "www.example.com",
 				Path: "/test",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "altService",
 				},
 			},
@@ -516,7 +516,7 @@ func TestRouterPathSpecificity(t *testing.T) {
 			},
 			Spec: routeapi.RouteSpec{
 				Host: "www.example.com",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "myService",
 				},
 			},
@@ -543,7 +543,7 @@ func TestRouterPathSpecificity(t *testing.T) {
 			Spec: routeapi.RouteSpec{
 				Host: "www.example.com",
 				Path: "/test",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "myService",
 				},
 			},
@@ -575,7 +575,7 @@ func TestRouterPathSpecificity(t *testing.T) {
 			},
 			Spec: routeapi.RouteSpec{
 				Host: "www.example.com",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "altService",
 				},
 			},
@@ -601,7 +601,7 @@ func TestRouterPathSpecificity(t *testing.T) {
 			},
 			Spec: routeapi.RouteSpec{
 				Host: "www.example.com",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "altService",
 				},
 			},
@@ -626,7 +626,7 @@ func TestRouterPathSpecificity(t *testing.T) {
 			},
 			Spec: routeapi.RouteSpec{
 				Host: "www.example.com",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "myService",
 				},
 			},
@@ -696,7 +696,7 @@ func TestRouterDuplications(t *testing.T) {
 			},
 			Spec: routeapi.RouteSpec{
 				Host: "www.example.com",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "myService",
 				},
 			},
@@ -711,7 +711,7 @@ func TestRouterDuplications(t *testing.T) {
 			},
 			Spec: routeapi.RouteSpec{
 				Host: "www.example2.com",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "myService",
 				},
 			},
@@ -742,7 +742,7 @@ func TestRouterDuplications(t *testing.T) {
 			},
 			Spec: routeapi.RouteSpec{
 				Host: "www.example2.com",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "myService",
 				},
 			},
@@ -758,7 +758,7 @@ func TestRouterDuplications(t *testing.T) {
 			},
 			Spec: routeapi.RouteSpec{
 				Host: "www.example.com",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: "myService",
 				},
 			},
@@ -1292,7 +1292,7 @@ func generateTestEvents(fakeMasterAndPod *tr.TestHttpService, flag bool, service
 			Spec: routeapi.RouteSpec{
 				Host: routeAlias,
 				Path: "",
-				To: kapi.ObjectReference{
+				To: routeapi.RouteTargetReference{
 					Name: serviceName,
 				},
 				TLS: nil,
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
@@ -130,6 +131,10 @@ func TestTemplateTransformationFromConfig(t *testing.T) {
 	}
 
 	walkJSONFiles("../templates/fixtures", func(name, path string, data []byte) {
+		// template test fixtures are not templates
+		if strings.HasSuffix(name, "-test") {
+			return
+		}
 		template, err := runtime.Decode(kapi.Codecs.UniversalDecoder(), data)
 		if err != nil {
 			t.Errorf("%q: unexpected error: %v", path, err)